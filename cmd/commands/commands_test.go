@@ -18,15 +18,21 @@ package commands
 
 import (
 	"bytes"
+	"context"
 	"encoding/base64"
 	"encoding/json"
 	"io"
 	"os"
+	"path/filepath"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 
 	dfv1 "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1"
+	"github.com/numaproj/numaflow/pkg/isb/testutils"
+	"github.com/numaproj/numaflow/pkg/reduce/pbq/partition"
+	alignedfs "github.com/numaproj/numaflow/pkg/reduce/pbq/wal/aligned/fs"
 
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
@@ -135,6 +141,88 @@ func Test_Commands(t *testing.T) {
 		assert.Error(t, err)
 		assert.Contains(t, err.Error(), "unrecognized processor type")
 	})
+
+	t.Run("WAL", func(t *testing.T) {
+		cmd := NewWALCommand()
+		assert.Equal(t, "wal", cmd.Use)
+		assert.Equal(t, "string", cmd.PersistentFlags().Lookup("path").Value.Type())
+		assert.Equal(t, "string", cmd.PersistentFlags().Lookup("type").Value.Type())
+
+		pbqPath := t.TempDir()
+		segmentPath := filepath.Join(pbqPath, "wals")
+		id := partition.ID{Start: time.UnixMilli(60000), End: time.UnixMilli(120000), Slot: "slot-0"}
+		w, err := alignedfs.NewFSManager(&dfv1.VertexInstance{Vertex: &dfv1.Vertex{}}, alignedfs.WithStorePath(segmentPath)).CreateWAL(context.Background(), id)
+		assert.NoError(t, err)
+		messages := testutils.BuildTestReadMessagesIntOffset(5, id.Start, nil)
+		for _, msg := range messages {
+			assert.NoError(t, w.Write(&msg))
+		}
+		assert.NoError(t, w.Close())
+
+		b := bytes.NewBufferString("")
+		cmd.SetOut(b)
+		cmd.SetArgs([]string{"list", "--path", pbqPath})
+		assert.NoError(t, cmd.Execute())
+		assert.Contains(t, b.String(), id.String())
+		assert.Contains(t, b.String(), "OK")
+
+		// append a torn write
+		files, err := os.ReadDir(segmentPath)
+		assert.NoError(t, err)
+		assert.Len(t, files, 1)
+		filePath := filepath.Join(segmentPath, files[0].Name())
+		fp, err := os.OpenFile(filePath, os.O_APPEND|os.O_WRONLY, 0644)
+		assert.NoError(t, err)
+		_, err = fp.Write([]byte{1, 2, 3})
+		assert.NoError(t, err)
+		assert.NoError(t, fp.Close())
+
+		b.Reset()
+		cmd.SetArgs([]string{"validate", "--path", pbqPath})
+		err = cmd.Execute()
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "found 1 corrupted files")
+		assert.Contains(t, b.String(), "CORRUPTED")
+
+		b.Reset()
+		cmd.SetArgs([]string{"truncate", filePath})
+		assert.NoError(t, cmd.Execute())
+		assert.Contains(t, b.String(), "kept 5 entries")
+
+		b.Reset()
+		cmd.SetArgs([]string{"dump", filePath})
+		assert.NoError(t, cmd.Execute())
+		assert.Contains(t, b.String(), "entries: 5")
+		assert.Contains(t, b.String(), "status: OK")
+
+		cmd.SetArgs([]string{"list", "--path", pbqPath, "--type", "nonono"})
+		err = cmd.Execute()
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "unsupported wal type")
+	})
+
+	t.Run("MovedKeys", func(t *testing.T) {
+		cmd := NewMovedKeysCommand()
+		assert.Equal(t, "moved-keys [KEY...]", cmd.Use)
+		b := bytes.NewBufferString("")
+		cmd.SetOut(b)
+		cmd.SetArgs([]string{"--vertex", "reduce", "--from", "2", "--to", "2", "a", "b:c"})
+		assert.NoError(t, cmd.Execute())
+		assert.Contains(t, b.String(), "0 of 2 keys are moved")
+
+		// with jump hash, all the moved keys go to the new partition
+		b.Reset()
+		cmd.SetIn(bytes.NewBufferString("a\nb:c\nd\ne\nf\ng\n"))
+		cmd.SetArgs([]string{"--vertex", "reduce", "--from", "1", "--to", "2", "--partitioning", "jumpHash"})
+		assert.NoError(t, cmd.Execute())
+		assert.Contains(t, b.String(), "of 6 keys are moved")
+		assert.NotContains(t, b.String(), "\t1\t0")
+
+		cmd.SetArgs([]string{"--vertex", "reduce", "--from", "0", "--to", "2", "a"})
+		assert.ErrorContains(t, cmd.Execute(), "partition counts have to be positive")
+		cmd.SetArgs([]string{"--vertex", "reduce", "--from", "1", "--to", "2", "--partitioning", "nonono", "a"})
+		assert.ErrorContains(t, cmd.Execute(), "unsupported partitioning")
+	})
 }
 
 func generateEncodedVertexSpecs() string {
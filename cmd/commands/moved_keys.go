@@ -0,0 +1,94 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package commands
+
+import (
+	"bufio"
+	"fmt"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/spf13/cobra"
+
+	dfv1 "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1"
+	"github.com/numaproj/numaflow/pkg/shuffle"
+)
+
+// NewMovedKeysCommand returns the command to show which keys of a keyed vertex are assigned to a different partition
+// when its partition count changes, which can be used to plan the resizing of the vertex.
+func NewMovedKeysCommand() *cobra.Command {
+	var (
+		vertexName   string
+		from         int
+		to           int
+		partitioning string
+	)
+
+	command := &cobra.Command{
+		Use:   "moved-keys [KEY...]",
+		Short: "Show the keys moved to a different partition when the partition count of a keyed vertex changes",
+		Long: "Show the keys moved to a different partition when the partition count of a keyed vertex changes. " +
+			"The keys of a message are joined by \"" + dfv1.KeysDelimitter + "\", they are read from the standard input, " +
+			"one per line, if not given as arguments.",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if vertexName == "" {
+				return fmt.Errorf("vertex name is required")
+			}
+			if from <= 0 || to <= 0 {
+				return fmt.Errorf("partition counts have to be positive, got %d and %d", from, to)
+			}
+			strategy := dfv1.PartitioningStrategy(partitioning)
+			if strategy != dfv1.PartitioningStrategyModulo && strategy != dfv1.PartitioningStrategyJumpHash {
+				return fmt.Errorf("unsupported partitioning %q", partitioning)
+			}
+
+			lines := args
+			if len(lines) == 0 {
+				scanner := bufio.NewScanner(cmd.InOrStdin())
+				for scanner.Scan() {
+					if line := strings.TrimSpace(scanner.Text()); line != "" {
+						lines = append(lines, line)
+					}
+				}
+				if err := scanner.Err(); err != nil {
+					return fmt.Errorf("failed to read the keys, %w", err)
+				}
+			}
+			keys := make([][]string, 0, len(lines))
+			for _, line := range lines {
+				keys = append(keys, strings.Split(line, dfv1.KeysDelimitter))
+			}
+
+			moves := shuffle.MovedKeys(vertexName, keys, from, to, shuffle.WithPartitioning(strategy))
+			w := tabwriter.NewWriter(cmd.OutOrStdout(), 0, 0, 2, ' ', 0)
+			_, _ = fmt.Fprintln(w, "KEYS\tFROM\tTO")
+			for _, m := range moves {
+				_, _ = fmt.Fprintf(w, "%s\t%d\t%d\n", strings.Join(m.Keys, dfv1.KeysDelimitter), m.From, m.To)
+			}
+			if err := w.Flush(); err != nil {
+				return err
+			}
+			_, _ = fmt.Fprintf(cmd.OutOrStdout(), "%d of %d keys are moved\n", len(moves), len(keys))
+			return nil
+		},
+	}
+	command.Flags().StringVar(&vertexName, "vertex", "", "Name of the keyed vertex")
+	command.Flags().IntVar(&from, "from", 0, "Current partition count of the vertex")
+	command.Flags().IntVar(&to, "to", 0, "New partition count of the vertex")
+	command.Flags().StringVar(&partitioning, "partitioning", string(dfv1.PartitioningStrategyModulo), "Partitioning of the edges to the vertex, modulo or jumpHash")
+	return command
+}
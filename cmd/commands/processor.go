@@ -17,18 +17,22 @@ limitations under the License.
 package commands
 
 import (
+	"context"
 	"encoding/base64"
 	"encoding/json"
 	"fmt"
 	"os"
 	"strconv"
+	"time"
 
 	"github.com/spf13/cobra"
+	"go.uber.org/zap"
 	"sigs.k8s.io/controller-runtime/pkg/manager/signals"
 
 	"github.com/numaproj/numaflow"
 	dfv1 "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1"
 	"github.com/numaproj/numaflow/pkg/shared/logging"
+	"github.com/numaproj/numaflow/pkg/shared/tracing"
 	"github.com/numaproj/numaflow/pkg/sinks"
 	"github.com/numaproj/numaflow/pkg/sources"
 	"github.com/numaproj/numaflow/pkg/udf"
@@ -77,6 +81,19 @@ func NewProcessorCommand() *cobra.Command {
 				Replica:  int32(replica),
 			}
 			ctx := logging.WithLogger(signals.SetupSignalHandler(), log)
+			shutdownTracing, err := tracing.Init(ctx, fmt.Sprintf("%s-%s", vertex.Spec.PipelineName, vertex.Spec.Name),
+				tracing.WithResourceAttributes(tracing.VertexAttributes(vertex.Spec.PipelineName, vertex.Spec.Name, int32(replica))...))
+			if err != nil {
+				return fmt.Errorf("failed to initialize tracing, %w", err)
+			}
+			defer func() {
+				// the signal context is already done, flush the pending spans with a fresh one
+				shutdownCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+				defer cancel()
+				if err := shutdownTracing(shutdownCtx); err != nil {
+					log.Warnw("Failed to shutdown tracing", zap.Error(err))
+				}
+			}()
 			switch dfv1.VertexType(processorType) {
 			case dfv1.VertexTypeSource:
 				p := &sources.SourceProcessor{
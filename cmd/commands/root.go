@@ -50,4 +50,6 @@ func init() {
 	rootCmd.AddCommand(NewSideInputsSynchronizerCommand())
 	rootCmd.AddCommand(NewDexServerInitCommand())
 	rootCmd.AddCommand(NewMonoVtxDaemonServerCommand())
+	rootCmd.AddCommand(NewWALCommand())
+	rootCmd.AddCommand(NewMovedKeysCommand())
 }
@@ -32,6 +32,7 @@ func NewSideInputsInitCommand() *cobra.Command {
 		isbSvcType      string
 		sideInputsStore string
 		sideInputs      []string
+		pinnedVersions  map[string]int64
 	)
 	command := &cobra.Command{
 		Use:   "side-inputs-init",
@@ -48,12 +49,13 @@ func NewSideInputsInitCommand() *cobra.Command {
 			}
 			logger := logging.NewLogger().Named("side-inputs-init").With("pipeline", pipelineName)
 			ctx := logging.WithLogger(context.Background(), logger)
-			sideInputsInitializer := initializer.NewSideInputsInitializer(dfv1.ISBSvcType(isbSvcType), pipelineName, sideInputsStore, sideInputs)
+			sideInputsInitializer := initializer.NewSideInputsInitializer(dfv1.ISBSvcType(isbSvcType), pipelineName, sideInputsStore, sideInputs, pinnedVersions)
 			return sideInputsInitializer.Run(ctx)
 		},
 	}
 	command.Flags().StringVar(&isbSvcType, "isbsvc-type", "jetstream", "ISB Service type, e.g. jetstream")
 	command.Flags().StringVar(&sideInputsStore, "side-inputs-store", "", "Name of the side inputs store")
-	command.Flags().StringSliceVar(&sideInputs, "side-inputs", []string{}, "Side Input names") // --side-inputs=si1,si2 --side-inputs=si3
+	command.Flags().StringSliceVar(&sideInputs, "side-inputs", []string{}, "Side Input names")                                         // --side-inputs=si1,si2 --side-inputs=si3
+	command.Flags().StringToInt64Var(&pinnedVersions, "pinned-versions", map[string]int64{}, "Versions the side inputs are pinned to") // --pinned-versions=si1=3,si2=5
 	return command
 }
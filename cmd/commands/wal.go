@@ -0,0 +1,326 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package commands
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	dfv1 "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1"
+	"github.com/numaproj/numaflow/pkg/reduce/pbq/wal"
+	alignedfs "github.com/numaproj/numaflow/pkg/reduce/pbq/wal/aligned/fs"
+	unalignedfs "github.com/numaproj/numaflow/pkg/reduce/pbq/wal/unaligned/fs"
+)
+
+const (
+	walTypeAligned   = "aligned"
+	walTypeUnaligned = "unaligned"
+)
+
+// NewWALCommand returns the command to inspect and repair the WALs of a reduce vertex. The WALs must not be used by a
+// running reduce container while they are inspected, e.g. run it in a debug container of a crashlooping pod.
+func NewWALCommand() *cobra.Command {
+	var (
+		pbqPath string
+		walType string
+	)
+
+	command := &cobra.Command{
+		Use:   "wal",
+		Short: "Inspect and repair the WALs of a reduce vertex",
+		Run: func(cmd *cobra.Command, args []string) {
+			cmd.HelpFunc()(cmd, args)
+		},
+	}
+	command.PersistentFlags().StringVar(&pbqPath, "path", dfv1.PathPBQMount, "Path of the PBQ volume")
+	command.PersistentFlags().StringVar(&walType, "type", walTypeAligned, "WAL type, aligned for fixed and sliding windows, unaligned for session and accumulator windows")
+
+	command.AddCommand(newWALListCommand(&pbqPath, &walType))
+	command.AddCommand(newWALDumpCommand(&walType))
+	command.AddCommand(newWALValidateCommand(&pbqPath, &walType))
+	command.AddCommand(newWALGCEventsCommand(&pbqPath))
+	command.AddCommand(newWALTruncateCommand(&walType))
+	return command
+}
+
+func newWALListCommand(pbqPath, walType *string) *cobra.Command {
+	return &cobra.Command{
+		Use:   "list",
+		Short: "List the WAL segments",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			files, err := walSegmentFiles(*pbqPath, *walType)
+			if err != nil {
+				return err
+			}
+			w := tabwriter.NewWriter(cmd.OutOrStdout(), 0, 0, 2, ' ', 0)
+			_, _ = fmt.Fprintln(w, "FILE\tPARTITION\tENTRIES\tSIZE\tSTATUS")
+			for _, file := range files {
+				info, err := scanWALSegment(*walType, file, nil)
+				if err != nil {
+					return err
+				}
+				_, _ = fmt.Fprintf(w, "%s\t%s\t%d\t%d\t%s\n", file, partitionString(info), info.Entries, info.Size, segmentStatus(info))
+			}
+			return w.Flush()
+		},
+	}
+}
+
+func newWALDumpCommand(walType *string) *cobra.Command {
+	return &cobra.Command{
+		Use:   "dump FILE",
+		Short: "Dump the entries of a WAL segment",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			w := tabwriter.NewWriter(cmd.OutOrStdout(), 0, 0, 2, ' ', 0)
+			_, _ = fmt.Fprintln(w, "POSITION\tOFFSET\tEVENT-TIME\tWATERMARK\tKEYS")
+			info, err := scanWALSegment(*walType, args[0], func(entry *wal.EntryInfo) error {
+				_, err := fmt.Fprintf(w, "%d\t%d\t%s\t%s\t%s\n", entry.Position, entry.Offset, formatTime(entry.EventTime),
+					formatTime(entry.Watermark), strings.Join(entry.Keys, dfv1.KeysDelimitter))
+				return err
+			})
+			if err != nil {
+				return err
+			}
+			if err = w.Flush(); err != nil {
+				return err
+			}
+			printSegmentSummary(cmd.OutOrStdout(), info)
+			return nil
+		},
+	}
+}
+
+func newWALValidateCommand(pbqPath, walType *string) *cobra.Command {
+	return &cobra.Command{
+		Use:   "validate",
+		Short: "Validate the checksums of the WAL segments and GC events",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			files, err := walSegmentFiles(*pbqPath, *walType)
+			if err != nil {
+				return err
+			}
+			var infos []*wal.SegmentInfo
+			for _, file := range files {
+				info, err := scanWALSegment(*walType, file, nil)
+				if err != nil {
+					return err
+				}
+				infos = append(infos, info)
+			}
+			if *walType == walTypeUnaligned {
+				eventFiles, err := listWALFiles(filepath.Join(*pbqPath, filepath.Base(dfv1.DefaultGCEventsWALEventsPath)), "")
+				if err != nil {
+					return err
+				}
+				for _, file := range eventFiles {
+					info, err := unalignedfs.ScanGCEvents(file, nil)
+					if err != nil {
+						return err
+					}
+					infos = append(infos, info)
+				}
+			}
+
+			corrupted := 0
+			for _, info := range infos {
+				if info.Corrupted() {
+					corrupted++
+					_, _ = fmt.Fprintf(cmd.OutOrStdout(), "%s: %s\n", info.Path, segmentStatus(info))
+				}
+			}
+			if corrupted > 0 {
+				return fmt.Errorf("found %d corrupted files out of %d", corrupted, len(infos))
+			}
+			_, _ = fmt.Fprintf(cmd.OutOrStdout(), "validated %d files, no corruption found\n", len(infos))
+			return nil
+		},
+	}
+}
+
+func newWALGCEventsCommand(pbqPath *string) *cobra.Command {
+	return &cobra.Command{
+		Use:   "gc-events",
+		Short: "Show the GC events of the unaligned WALs, and the latest closed window of each key used by the compaction",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			files, err := listWALFiles(filepath.Join(*pbqPath, filepath.Base(dfv1.DefaultGCEventsWALEventsPath)), "")
+			if err != nil {
+				return err
+			}
+			// the compactor drops the messages of a key whose event time is before the latest closed window end
+			latestEnd := make(map[string]time.Time)
+			w := tabwriter.NewWriter(cmd.OutOrStdout(), 0, 0, 2, ' ', 0)
+			_, _ = fmt.Fprintln(w, "FILE\tEVENTS\tSIZE\tSTATUS")
+			for _, file := range files {
+				info, err := unalignedfs.ScanGCEvents(file, func(event *wal.GCEventInfo) error {
+					if end, ok := latestEnd[event.Key]; !ok || event.End.After(end) {
+						latestEnd[event.Key] = event.End
+					}
+					return nil
+				})
+				if err != nil {
+					return err
+				}
+				_, _ = fmt.Fprintf(w, "%s\t%d\t%d\t%s\n", file, info.Entries, info.Size, segmentStatus(info))
+			}
+			if err = w.Flush(); err != nil {
+				return err
+			}
+
+			keys := make([]string, 0, len(latestEnd))
+			for key := range latestEnd {
+				keys = append(keys, key)
+			}
+			sort.Strings(keys)
+			_, _ = fmt.Fprintln(cmd.OutOrStdout())
+			w = tabwriter.NewWriter(cmd.OutOrStdout(), 0, 0, 2, ' ', 0)
+			_, _ = fmt.Fprintln(w, "KEY\tLATEST-CLOSED-WINDOW-END")
+			for _, key := range keys {
+				_, _ = fmt.Fprintf(w, "%s\t%s\n", key, formatTime(latestEnd[key]))
+			}
+			return w.Flush()
+		},
+	}
+}
+
+func newWALTruncateCommand(walType *string) *cobra.Command {
+	var (
+		gcEvents bool
+		dryRun   bool
+	)
+
+	command := &cobra.Command{
+		Use:   "truncate FILE",
+		Short: "Truncate the corrupted tail of a WAL segment or GC events file, so that the reduce vertex can start",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			var info *wal.SegmentInfo
+			var err error
+			if gcEvents {
+				info, err = unalignedfs.ScanGCEvents(args[0], nil)
+			} else {
+				info, err = scanWALSegment(*walType, args[0], nil)
+			}
+			if err != nil {
+				return err
+			}
+			if !info.Corrupted() {
+				_, _ = fmt.Fprintf(cmd.OutOrStdout(), "%s is not corrupted, nothing to truncate\n", info.Path)
+				return nil
+			}
+			if !gcEvents && info.PartitionID == nil {
+				return fmt.Errorf("the header of %s is corrupted, the segment can not be truncated, %w", info.Path, info.Err)
+			}
+			if !dryRun {
+				if err = os.Truncate(info.Path, info.ValidSize); err != nil {
+					return err
+				}
+			}
+			_, _ = fmt.Fprintf(cmd.OutOrStdout(), "truncated %s from %d to %d bytes, kept %d entries, dropped the tail: %v\n",
+				info.Path, info.Size, info.ValidSize, info.Entries, info.Err)
+			return nil
+		},
+	}
+	command.Flags().BoolVar(&gcEvents, "gc-events", false, "Whether the file is a GC events file of the unaligned WALs")
+	command.Flags().BoolVar(&dryRun, "dry-run", false, "Print the result without truncating the file")
+	return command
+}
+
+// walSegmentFiles lists the segment files of the WALs in the PBQ volume. The compacted segments of the unaligned WALs
+// are listed before the data segments, in the order of replaying.
+func walSegmentFiles(pbqPath, walType string) ([]string, error) {
+	segmentPath := filepath.Join(pbqPath, filepath.Base(dfv1.DefaultSegmentWALPath))
+	switch walType {
+	case walTypeAligned:
+		return listWALFiles(segmentPath, alignedfs.SegmentPrefix)
+	case walTypeUnaligned:
+		compacted, err := listWALFiles(filepath.Join(pbqPath, filepath.Base(dfv1.DefaultCompactWALPath)), "")
+		if err != nil {
+			return nil, err
+		}
+		segments, err := listWALFiles(segmentPath, "")
+		if err != nil {
+			return nil, err
+		}
+		return append(compacted, segments...), nil
+	default:
+		return nil, fmt.Errorf("unsupported wal type %q", walType)
+	}
+}
+
+// listWALFiles lists the files with the given prefix in the directory, sorted by name. It returns an empty list if
+// the directory does not exist.
+func listWALFiles(dir, prefix string) ([]string, error) {
+	entries, err := os.ReadDir(dir)
+	if os.IsNotExist(err) {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+	var files []string
+	for _, entry := range entries {
+		if !entry.IsDir() && strings.HasPrefix(entry.Name(), prefix) {
+			files = append(files, filepath.Join(dir, entry.Name()))
+		}
+	}
+	return files, nil
+}
+
+func scanWALSegment(walType, filePath string, fn func(*wal.EntryInfo) error) (*wal.SegmentInfo, error) {
+	switch walType {
+	case walTypeAligned:
+		return alignedfs.ScanSegment(filePath, fn)
+	case walTypeUnaligned:
+		return unalignedfs.ScanSegment(filePath, fn)
+	default:
+		return nil, fmt.Errorf("unsupported wal type %q", walType)
+	}
+}
+
+func printSegmentSummary(out io.Writer, info *wal.SegmentInfo) {
+	_, _ = fmt.Fprintf(out, "\nfile: %s\npartition: %s\nentries: %d\nsize: %d\nstatus: %s\n", info.Path,
+		partitionString(info), info.Entries, info.Size, segmentStatus(info))
+}
+
+func partitionString(info *wal.SegmentInfo) string {
+	if info.PartitionID == nil {
+		return "-"
+	}
+	return info.PartitionID.String()
+}
+
+func segmentStatus(info *wal.SegmentInfo) string {
+	if !info.Corrupted() {
+		return "OK"
+	}
+	return fmt.Sprintf("CORRUPTED at %d (%d bytes after it): %v", info.ValidSize, info.Size-info.ValidSize, info.Err)
+}
+
+func formatTime(t time.Time) string {
+	return t.UTC().Format(time.RFC3339Nano)
+}
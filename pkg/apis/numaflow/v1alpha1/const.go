@@ -41,12 +41,21 @@ const (
 	KeyReplica             = "numaflow.numaproj.io/replica"
 	KeySideInputName       = "numaflow.numaproj.io/side-input-name"
 	KeyPauseTimestamp      = "numaflow.numaproj.io/pause-timestamp"
+	KeyShadowPipelineOf    = "numaflow.numaproj.io/shadow-pipeline-of"
+	KeyUpgradeHash         = "numaflow.numaproj.io/upgrade-hash" // hash of the vertex spec without the scale settings
 	KeyDefaultContainer    = "kubectl.kubernetes.io/default-container"
 
 	// ID key in the header of sources like http
 	KeyMetaID          = "X-Numaflow-Id"
 	KeyMetaEventTime   = "X-Numaflow-Event-Time"
 	KeyMetaCallbackURL = "X-Numaflow-Callback-Url"
+	KeyMetaFiring      = "X-Numaflow-Firing"
+	// Late data keys in the header of the messages dropped by reduce vertices
+	KeyMetaLateDataWatermark = "X-Numaflow-Late-Data-Watermark"
+	KeyMetaLateDataFallback  = "X-Numaflow-Late-Data-Fallback"
+	// Dead letter keys in the header of the messages failed by map vertices
+	KeyMetaDeadLetterError  = "X-Numaflow-Dead-Letter-Error"
+	KeyMetaDeadLetterVertex = "X-Numaflow-Dead-Letter-Vertex"
 
 	DefaultISBSvcName = "default"
 
@@ -116,6 +125,9 @@ const (
 	EnvMonoVertexName                   = "NUMAFLOW_MONO_VERTEX_NAME"
 	EnvCallbackEnabled                  = "NUMAFLOW_CALLBACK_ENABLED"
 	EnvCallbackURL                      = "NUMAFLOW_CALLBACK_URL"
+	EnvCallbackSigningKey               = "NUMAFLOW_CALLBACK_SIGNING_KEY"
+	EnvCallbackOutboxDir                = "NUMAFLOW_CALLBACK_OUTBOX_DIR"
+	EnvCallbackOutboxMaxBytes           = "NUMAFLOW_CALLBACK_OUTBOX_MAX_BYTES"
 	EnvPod                              = "NUMAFLOW_POD"
 	EnvReplica                          = "NUMAFLOW_REPLICA"
 	EnvVertexObject                     = "NUMAFLOW_VERTEX_OBJECT"
@@ -178,17 +190,32 @@ const (
 	MonoVertexDaemonServicePort = 4327
 	ServingServiceHttpsPort     = 8443
 	ServingServiceHttpPort      = 8090
+	SideInputsManagerPort       = 2469
+	SideInputsManagerPortName   = "https"
 
 	DefaultRequeueAfter = 10 * time.Second
 
+	// Blue/green upgrade of a pipeline
+	DefaultPipelineUpgradeProgressDeadlineSeconds = 600
+
 	PathSideInputsMount = "/var/numaflow/side-inputs"
 
+	// Side Inputs
+	DefaultSideInputRetainedVersions = 5 // Default number of versions of a side input retained in the side inputs store
+
 	// ISB
 	DefaultBufferLength     = 30000
 	DefaultBufferUsageLimit = 0.8
 	DefaultReadBatchSize    = 500
 	DefaultReadTimeout      = 1 * time.Second
 
+	// Firing
+	DefaultMaxFiringMessages = 100000 // Default max number of messages of a window kept in memory for the early and late firings
+
+	// Hot key salting
+	DefaultHotKeySubPartitions       = 2  // Default number of partitions a hot key is split across
+	DefaultHotKeyThresholdPercentage = 20 // Default percentage of the recent messages for a key to be considered hot
+
 	// Auto scaling
 	DefaultLookbackSeconds          = 120 // Default lookback seconds for calculating avg rate and pending
 	DefaultCooldownSeconds          = 90  // Default cooldown seconds after a scaling operation
@@ -199,6 +226,9 @@ const (
 	DefaultReplicasPerScale         = 2   // Default maximum replicas to be scaled up or down at once
 	MaxLookbackSeconds              = 600 // Max lookback seconds for calculating avg rate and pending
 
+	DefaultPredictiveLookaheadSeconds = 300   // Default seconds to forecast the processing rate ahead for the predictive scale policy
+	DefaultPredictivePeriodSeconds    = 86400 // Default period of the load pattern for the predictive scale policy
+
 	// Default persistent buffer queue options
 	DefaultPBQChannelBufferSize = 100             // Default channel size in int (what should be right value?)
 	DefaultPBQReadTimeout       = 1 * time.Second // Default read timeout for pbq
@@ -210,6 +240,7 @@ const (
 	// Default WAL options
 	DefaultWALSyncDuration            = 30 * time.Second       // Default sync duration for pbq
 	DefaultWALMaxSyncSize             = 5 * 1024 * 1024        // Default size to wait for an explicit sync
+	DefaultObjectStoreWALSyncDuration = 5 * time.Second        // Default sync duration for the object store pbq, the messages are acked once synced
 	DefaultSegmentWALPath             = PathPBQMount + "/wals" // Default segment wal path
 	DefaultWALSegmentRotationDuration = 60 * time.Second       // Default segment rotation duration
 	DefaultWALSegmentSize             = 30 * 1024 * 1024       // Default segment size
@@ -259,6 +290,18 @@ const (
 	// Serving source
 	DefaultServingTTL = 24 * time.Hour
 
+	// HTTP source
+	DefaultHTTPSourceAckTimeout = 30 * time.Second
+
+	// Source dedup window
+	DefaultDedupMaxEntries = 100000
+
+	// Built-in map functions
+	BuiltinFunctionCat       = "cat"
+	BuiltinFunctionFilter    = "filter"
+	BuiltinFunctionProject   = "project"
+	BuiltinFunctionEventTime = "eventTime"
+
 	// Retry Strategy
 
 	// DefaultRetryInterval specifies the default initial retry duration in case of exponential backoff.
@@ -309,6 +352,10 @@ const (
 	RuntimeDirVolume    = "runtime-vol"
 	RuntimeDirMountPath = "/var/numaflow/runtime"
 	RuntimeDirSizeLimit = 20 * 1024 * 1024
+
+	// DefaultCallbackOutboxMaxBytes is the default size limit of the callback outbox, half of the runtime volume
+	// so that an outbox in it doesn't get the pod evicted.
+	DefaultCallbackOutboxMaxBytes = RuntimeDirSizeLimit / 2
 )
 
 var (
@@ -0,0 +1,42 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// DedupWindow describes how the duplicated messages of a source are dropped. The messages are identified by the
+// offsets in their IDs, and the IDs are stored in a KV store of the ISB Service, so that they are shared across the
+// replicas and survive the pod restarts.
+type DedupWindow struct {
+	// Duration is how long the ID of a message is remembered, a message whose ID has been seen within the duration is
+	// dropped.
+	Duration metav1.Duration `json:"duration" protobuf:"bytes,1,opt,name=duration"`
+	// MaxEntries is the max number of the IDs cached in memory by each replica, the least recently seen ones are
+	// evicted first. The evicted IDs are still looked up in the KV store. With a JetStream ISB Service, it also caps the
+	// number of the IDs kept in the KV store, the oldest ones are discarded first. Defaults to 100000.
+	// +optional
+	MaxEntries *int32 `json:"maxEntries,omitempty" protobuf:"varint,2,opt,name=maxEntries"`
+}
+
+func (d DedupWindow) GetMaxEntries() int {
+	if d.MaxEntries == nil || *d.MaxEntries <= 0 {
+		return DefaultDedupMaxEntries
+	}
+	return int(*d.MaxEntries)
+}
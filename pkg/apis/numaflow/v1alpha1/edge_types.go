@@ -30,6 +30,46 @@ type Edge struct {
 	// +kubebuilder:validation:Enum=retryUntilSuccess;discardLatest
 	// +optional
 	OnFull *BufferFullWritingStrategy `json:"onFull,omitempty" protobuf:"bytes,4,opt,name=onFull"`
+	// Partitioning specifies how the messages are assigned to the partitions of the to vertex based on the keys.
+	// There are currently two options, modulo and jumpHash. With modulo, changing the partition count remaps nearly
+	// every key, while jumpHash only moves ~1/N of the keys, which is preferred for keyed reduce vertices.
+	// if not provided, the default value is set to "modulo"
+	// +kubebuilder:validation:Enum=modulo;jumpHash
+	// +optional
+	Partitioning *PartitioningStrategy `json:"partitioning,omitempty" protobuf:"bytes,5,opt,name=partitioning"`
+	// HotKeySalting splits the messages of a hot key across multiple partitions of the to vertex, only applicable
+	// when the to vertex is a keyed reduce vertex with more than one partition. The partial results of a salted key
+	// need to be combined by a downstream reduce vertex, so it only works with associative reducers.
+	// +optional
+	HotKeySalting *HotKeySalting `json:"hotKeySalting,omitempty" protobuf:"bytes,6,opt,name=hotKeySalting"`
+}
+
+// HotKeySalting defines when a key is considered hot, and how many partitions a hot key is split across.
+type HotKeySalting struct {
+	// SubPartitions is the number of partitions the messages of a hot key are split across, capped by
+	// the partition count of the to vertex. Defaults to 2.
+	// +optional
+	SubPartitions *int32 `json:"subPartitions,omitempty" protobuf:"varint,1,opt,name=subPartitions"`
+	// ThresholdPercentage is the minimum percentage of the recent messages a key needs to account for to be
+	// considered hot. Defaults to 20.
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=100
+	// +optional
+	ThresholdPercentage *int32 `json:"thresholdPercentage,omitempty" protobuf:"varint,2,opt,name=thresholdPercentage"`
+}
+
+func (hks HotKeySalting) GetSubPartitions() int {
+	if hks.SubPartitions == nil || *hks.SubPartitions < 1 {
+		return DefaultHotKeySubPartitions
+	}
+	return int(*hks.SubPartitions)
+}
+
+func (hks HotKeySalting) GetThresholdPercentage() int {
+	if hks.ThresholdPercentage == nil || *hks.ThresholdPercentage < 1 || *hks.ThresholdPercentage > 100 {
+		return DefaultHotKeyThresholdPercentage
+	}
+	return int(*hks.ThresholdPercentage)
 }
 
 // CombinedEdge is a combination of Edge and some other properties such as vertex type, partitions, limits.
@@ -72,7 +112,15 @@ func (ce CombinedEdge) GetToVertexPartitionCount() int {
 
 type ForwardConditions struct {
 	// Tags used to specify tags for conditional forwarding
+	// +optional
 	Tags *TagConditions `json:"tags" protobuf:"bytes,1,opt,name=tags"`
+	// Expression is a boolean expression evaluated against the payload and the headers of a message, the message is
+	// forwarded only if it evaluates to true. The payload is available as "payload" and the headers as "headers", along
+	// with the json(), int() and string() functions, e.g. `json(payload).amount > 100 && headers["type"] == "order"`.
+	// A message which fails the evaluation, e.g. a payload which is not a valid JSON, is not forwarded.
+	// If both tags and expression are specified, the message needs to match both of them.
+	// +optional
+	Expression string `json:"expression,omitempty" protobuf:"bytes,2,opt,name=expression"`
 }
 
 type LogicOperator string
@@ -117,6 +165,18 @@ func (e Edge) BufferFullWritingStrategy() BufferFullWritingStrategy {
 	}
 }
 
+func (e Edge) GetPartitioningStrategy() PartitioningStrategy {
+	if e.Partitioning == nil {
+		return PartitioningStrategyModulo
+	}
+	switch *e.Partitioning {
+	case PartitioningStrategyModulo, PartitioningStrategyJumpHash:
+		return *e.Partitioning
+	default:
+		return PartitioningStrategyModulo
+	}
+}
+
 func (e Edge) GetEdgeName() string {
 	return fmt.Sprintf("%s-%s", e.From, e.To)
 }
@@ -128,6 +188,13 @@ const (
 	DiscardLatest     BufferFullWritingStrategy = "discardLatest"
 )
 
+type PartitioningStrategy string
+
+const (
+	PartitioningStrategyModulo   PartitioningStrategy = "modulo"
+	PartitioningStrategyJumpHash PartitioningStrategy = "jumpHash"
+)
+
 func GenerateEdgeBucketName(namespace, pipeline, from, to string) string {
 	return fmt.Sprintf("%s-%s-%s-%s", namespace, pipeline, from, to)
 }
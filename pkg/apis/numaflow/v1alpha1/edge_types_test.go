@@ -58,6 +58,25 @@ func Test_EdgeBufferFullWritingStrategy(t *testing.T) {
 	}
 }
 
+func Test_EdgePartitioningStrategy(t *testing.T) {
+	assert.Equal(t, PartitioningStrategyModulo, Edge{}.GetPartitioningStrategy())
+	assert.Equal(t, PartitioningStrategyModulo, Edge{Partitioning: ptr.To(PartitioningStrategyModulo)}.GetPartitioningStrategy())
+	assert.Equal(t, PartitioningStrategyJumpHash, Edge{Partitioning: ptr.To(PartitioningStrategyJumpHash)}.GetPartitioningStrategy())
+	assert.Equal(t, PartitioningStrategyModulo, Edge{Partitioning: ptr.To[PartitioningStrategy]("invalid")}.GetPartitioningStrategy())
+}
+
+func Test_HotKeySalting(t *testing.T) {
+	hks := HotKeySalting{}
+	assert.Equal(t, DefaultHotKeySubPartitions, hks.GetSubPartitions())
+	assert.Equal(t, DefaultHotKeyThresholdPercentage, hks.GetThresholdPercentage())
+	hks = HotKeySalting{SubPartitions: ptr.To[int32](4), ThresholdPercentage: ptr.To[int32](50)}
+	assert.Equal(t, 4, hks.GetSubPartitions())
+	assert.Equal(t, 50, hks.GetThresholdPercentage())
+	hks = HotKeySalting{SubPartitions: ptr.To[int32](0), ThresholdPercentage: ptr.To[int32](101)}
+	assert.Equal(t, DefaultHotKeySubPartitions, hks.GetSubPartitions())
+	assert.Equal(t, DefaultHotKeyThresholdPercentage, hks.GetThresholdPercentage())
+}
+
 func Test_GenerateEdgeBucketName(t *testing.T) {
 	tests := []struct {
 		name      string
@@ -303,10 +303,38 @@ func (m *BufferServiceConfig) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_BufferServiceConfig proto.InternalMessageInfo
 
+func (m *CPUScalePolicy) Reset()      { *m = CPUScalePolicy{} }
+func (*CPUScalePolicy) ProtoMessage() {}
+func (*CPUScalePolicy) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9d0d1b17d3865563, []int{9}
+}
+func (m *CPUScalePolicy) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CPUScalePolicy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *CPUScalePolicy) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CPUScalePolicy.Merge(m, src)
+}
+func (m *CPUScalePolicy) XXX_Size() int {
+	return m.Size()
+}
+func (m *CPUScalePolicy) XXX_DiscardUnknown() {
+	xxx_messageInfo_CPUScalePolicy.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CPUScalePolicy proto.InternalMessageInfo
+
 func (m *CombinedEdge) Reset()      { *m = CombinedEdge{} }
 func (*CombinedEdge) ProtoMessage() {}
 func (*CombinedEdge) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9d0d1b17d3865563, []int{9}
+	return fileDescriptor_9d0d1b17d3865563, []int{10}
 }
 func (m *CombinedEdge) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -334,7 +362,7 @@ var xxx_messageInfo_CombinedEdge proto.InternalMessageInfo
 func (m *Compression) Reset()      { *m = Compression{} }
 func (*Compression) ProtoMessage() {}
 func (*Compression) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9d0d1b17d3865563, []int{10}
+	return fileDescriptor_9d0d1b17d3865563, []int{11}
 }
 func (m *Compression) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -362,7 +390,7 @@ var xxx_messageInfo_Compression proto.InternalMessageInfo
 func (m *Container) Reset()      { *m = Container{} }
 func (*Container) ProtoMessage() {}
 func (*Container) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9d0d1b17d3865563, []int{11}
+	return fileDescriptor_9d0d1b17d3865563, []int{12}
 }
 func (m *Container) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -390,7 +418,7 @@ var xxx_messageInfo_Container proto.InternalMessageInfo
 func (m *ContainerTemplate) Reset()      { *m = ContainerTemplate{} }
 func (*ContainerTemplate) ProtoMessage() {}
 func (*ContainerTemplate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9d0d1b17d3865563, []int{12}
+	return fileDescriptor_9d0d1b17d3865563, []int{13}
 }
 func (m *ContainerTemplate) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -415,10 +443,38 @@ func (m *ContainerTemplate) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_ContainerTemplate proto.InternalMessageInfo
 
+func (m *CountWindow) Reset()      { *m = CountWindow{} }
+func (*CountWindow) ProtoMessage() {}
+func (*CountWindow) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9d0d1b17d3865563, []int{14}
+}
+func (m *CountWindow) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CountWindow) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *CountWindow) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CountWindow.Merge(m, src)
+}
+func (m *CountWindow) XXX_Size() int {
+	return m.Size()
+}
+func (m *CountWindow) XXX_DiscardUnknown() {
+	xxx_messageInfo_CountWindow.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CountWindow proto.InternalMessageInfo
+
 func (m *DaemonTemplate) Reset()      { *m = DaemonTemplate{} }
 func (*DaemonTemplate) ProtoMessage() {}
 func (*DaemonTemplate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9d0d1b17d3865563, []int{13}
+	return fileDescriptor_9d0d1b17d3865563, []int{15}
 }
 func (m *DaemonTemplate) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -443,10 +499,94 @@ func (m *DaemonTemplate) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_DaemonTemplate proto.InternalMessageInfo
 
+func (m *DeadLetterOutput) Reset()      { *m = DeadLetterOutput{} }
+func (*DeadLetterOutput) ProtoMessage() {}
+func (*DeadLetterOutput) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9d0d1b17d3865563, []int{16}
+}
+func (m *DeadLetterOutput) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DeadLetterOutput) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *DeadLetterOutput) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeadLetterOutput.Merge(m, src)
+}
+func (m *DeadLetterOutput) XXX_Size() int {
+	return m.Size()
+}
+func (m *DeadLetterOutput) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeadLetterOutput.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeadLetterOutput proto.InternalMessageInfo
+
+func (m *DedupWindow) Reset()      { *m = DedupWindow{} }
+func (*DedupWindow) ProtoMessage() {}
+func (*DedupWindow) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9d0d1b17d3865563, []int{17}
+}
+func (m *DedupWindow) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DedupWindow) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *DedupWindow) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DedupWindow.Merge(m, src)
+}
+func (m *DedupWindow) XXX_Size() int {
+	return m.Size()
+}
+func (m *DedupWindow) XXX_DiscardUnknown() {
+	xxx_messageInfo_DedupWindow.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DedupWindow proto.InternalMessageInfo
+
+func (m *EarlyFiring) Reset()      { *m = EarlyFiring{} }
+func (*EarlyFiring) ProtoMessage() {}
+func (*EarlyFiring) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9d0d1b17d3865563, []int{18}
+}
+func (m *EarlyFiring) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EarlyFiring) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *EarlyFiring) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EarlyFiring.Merge(m, src)
+}
+func (m *EarlyFiring) XXX_Size() int {
+	return m.Size()
+}
+func (m *EarlyFiring) XXX_DiscardUnknown() {
+	xxx_messageInfo_EarlyFiring.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EarlyFiring proto.InternalMessageInfo
+
 func (m *Edge) Reset()      { *m = Edge{} }
 func (*Edge) ProtoMessage() {}
 func (*Edge) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9d0d1b17d3865563, []int{14}
+	return fileDescriptor_9d0d1b17d3865563, []int{19}
 }
 func (m *Edge) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -474,7 +614,7 @@ var xxx_messageInfo_Edge proto.InternalMessageInfo
 func (m *FixedWindow) Reset()      { *m = FixedWindow{} }
 func (*FixedWindow) ProtoMessage() {}
 func (*FixedWindow) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9d0d1b17d3865563, []int{15}
+	return fileDescriptor_9d0d1b17d3865563, []int{20}
 }
 func (m *FixedWindow) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -502,7 +642,7 @@ var xxx_messageInfo_FixedWindow proto.InternalMessageInfo
 func (m *ForwardConditions) Reset()      { *m = ForwardConditions{} }
 func (*ForwardConditions) ProtoMessage() {}
 func (*ForwardConditions) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9d0d1b17d3865563, []int{16}
+	return fileDescriptor_9d0d1b17d3865563, []int{21}
 }
 func (m *ForwardConditions) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -527,10 +667,38 @@ func (m *ForwardConditions) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_ForwardConditions proto.InternalMessageInfo
 
+func (m *Function) Reset()      { *m = Function{} }
+func (*Function) ProtoMessage() {}
+func (*Function) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9d0d1b17d3865563, []int{22}
+}
+func (m *Function) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Function) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *Function) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Function.Merge(m, src)
+}
+func (m *Function) XXX_Size() int {
+	return m.Size()
+}
+func (m *Function) XXX_DiscardUnknown() {
+	xxx_messageInfo_Function.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Function proto.InternalMessageInfo
+
 func (m *GSSAPI) Reset()      { *m = GSSAPI{} }
 func (*GSSAPI) ProtoMessage() {}
 func (*GSSAPI) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9d0d1b17d3865563, []int{17}
+	return fileDescriptor_9d0d1b17d3865563, []int{23}
 }
 func (m *GSSAPI) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -558,7 +726,7 @@ var xxx_messageInfo_GSSAPI proto.InternalMessageInfo
 func (m *GeneratorSource) Reset()      { *m = GeneratorSource{} }
 func (*GeneratorSource) ProtoMessage() {}
 func (*GeneratorSource) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9d0d1b17d3865563, []int{18}
+	return fileDescriptor_9d0d1b17d3865563, []int{24}
 }
 func (m *GeneratorSource) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -586,7 +754,7 @@ var xxx_messageInfo_GeneratorSource proto.InternalMessageInfo
 func (m *GetDaemonDeploymentReq) Reset()      { *m = GetDaemonDeploymentReq{} }
 func (*GetDaemonDeploymentReq) ProtoMessage() {}
 func (*GetDaemonDeploymentReq) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9d0d1b17d3865563, []int{19}
+	return fileDescriptor_9d0d1b17d3865563, []int{25}
 }
 func (m *GetDaemonDeploymentReq) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -614,7 +782,7 @@ var xxx_messageInfo_GetDaemonDeploymentReq proto.InternalMessageInfo
 func (m *GetJetStreamServiceSpecReq) Reset()      { *m = GetJetStreamServiceSpecReq{} }
 func (*GetJetStreamServiceSpecReq) ProtoMessage() {}
 func (*GetJetStreamServiceSpecReq) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9d0d1b17d3865563, []int{20}
+	return fileDescriptor_9d0d1b17d3865563, []int{26}
 }
 func (m *GetJetStreamServiceSpecReq) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -642,7 +810,7 @@ var xxx_messageInfo_GetJetStreamServiceSpecReq proto.InternalMessageInfo
 func (m *GetJetStreamStatefulSetSpecReq) Reset()      { *m = GetJetStreamStatefulSetSpecReq{} }
 func (*GetJetStreamStatefulSetSpecReq) ProtoMessage() {}
 func (*GetJetStreamStatefulSetSpecReq) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9d0d1b17d3865563, []int{21}
+	return fileDescriptor_9d0d1b17d3865563, []int{27}
 }
 func (m *GetJetStreamStatefulSetSpecReq) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -670,7 +838,7 @@ var xxx_messageInfo_GetJetStreamStatefulSetSpecReq proto.InternalMessageInfo
 func (m *GetMonoVertexDaemonDeploymentReq) Reset()      { *m = GetMonoVertexDaemonDeploymentReq{} }
 func (*GetMonoVertexDaemonDeploymentReq) ProtoMessage() {}
 func (*GetMonoVertexDaemonDeploymentReq) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9d0d1b17d3865563, []int{22}
+	return fileDescriptor_9d0d1b17d3865563, []int{28}
 }
 func (m *GetMonoVertexDaemonDeploymentReq) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -698,7 +866,7 @@ var xxx_messageInfo_GetMonoVertexDaemonDeploymentReq proto.InternalMessageInfo
 func (m *GetMonoVertexPodSpecReq) Reset()      { *m = GetMonoVertexPodSpecReq{} }
 func (*GetMonoVertexPodSpecReq) ProtoMessage() {}
 func (*GetMonoVertexPodSpecReq) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9d0d1b17d3865563, []int{23}
+	return fileDescriptor_9d0d1b17d3865563, []int{29}
 }
 func (m *GetMonoVertexPodSpecReq) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -726,7 +894,7 @@ var xxx_messageInfo_GetMonoVertexPodSpecReq proto.InternalMessageInfo
 func (m *GetRedisServiceSpecReq) Reset()      { *m = GetRedisServiceSpecReq{} }
 func (*GetRedisServiceSpecReq) ProtoMessage() {}
 func (*GetRedisServiceSpecReq) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9d0d1b17d3865563, []int{24}
+	return fileDescriptor_9d0d1b17d3865563, []int{30}
 }
 func (m *GetRedisServiceSpecReq) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -754,7 +922,7 @@ var xxx_messageInfo_GetRedisServiceSpecReq proto.InternalMessageInfo
 func (m *GetRedisStatefulSetSpecReq) Reset()      { *m = GetRedisStatefulSetSpecReq{} }
 func (*GetRedisStatefulSetSpecReq) ProtoMessage() {}
 func (*GetRedisStatefulSetSpecReq) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9d0d1b17d3865563, []int{25}
+	return fileDescriptor_9d0d1b17d3865563, []int{31}
 }
 func (m *GetRedisStatefulSetSpecReq) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -782,7 +950,7 @@ var xxx_messageInfo_GetRedisStatefulSetSpecReq proto.InternalMessageInfo
 func (m *GetServingPipelineResourceReq) Reset()      { *m = GetServingPipelineResourceReq{} }
 func (*GetServingPipelineResourceReq) ProtoMessage() {}
 func (*GetServingPipelineResourceReq) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9d0d1b17d3865563, []int{26}
+	return fileDescriptor_9d0d1b17d3865563, []int{32}
 }
 func (m *GetServingPipelineResourceReq) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -810,7 +978,7 @@ var xxx_messageInfo_GetServingPipelineResourceReq proto.InternalMessageInfo
 func (m *GetSideInputDeploymentReq) Reset()      { *m = GetSideInputDeploymentReq{} }
 func (*GetSideInputDeploymentReq) ProtoMessage() {}
 func (*GetSideInputDeploymentReq) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9d0d1b17d3865563, []int{27}
+	return fileDescriptor_9d0d1b17d3865563, []int{33}
 }
 func (m *GetSideInputDeploymentReq) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -838,7 +1006,7 @@ var xxx_messageInfo_GetSideInputDeploymentReq proto.InternalMessageInfo
 func (m *GetVertexPodSpecReq) Reset()      { *m = GetVertexPodSpecReq{} }
 func (*GetVertexPodSpecReq) ProtoMessage() {}
 func (*GetVertexPodSpecReq) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9d0d1b17d3865563, []int{28}
+	return fileDescriptor_9d0d1b17d3865563, []int{34}
 }
 func (m *GetVertexPodSpecReq) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -863,10 +1031,38 @@ func (m *GetVertexPodSpecReq) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_GetVertexPodSpecReq proto.InternalMessageInfo
 
+func (m *GlobalWindow) Reset()      { *m = GlobalWindow{} }
+func (*GlobalWindow) ProtoMessage() {}
+func (*GlobalWindow) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9d0d1b17d3865563, []int{35}
+}
+func (m *GlobalWindow) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GlobalWindow) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *GlobalWindow) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GlobalWindow.Merge(m, src)
+}
+func (m *GlobalWindow) XXX_Size() int {
+	return m.Size()
+}
+func (m *GlobalWindow) XXX_DiscardUnknown() {
+	xxx_messageInfo_GlobalWindow.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GlobalWindow proto.InternalMessageInfo
+
 func (m *GroupBy) Reset()      { *m = GroupBy{} }
 func (*GroupBy) ProtoMessage() {}
 func (*GroupBy) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9d0d1b17d3865563, []int{29}
+	return fileDescriptor_9d0d1b17d3865563, []int{36}
 }
 func (m *GroupBy) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -894,7 +1090,7 @@ var xxx_messageInfo_GroupBy proto.InternalMessageInfo
 func (m *HTTPSource) Reset()      { *m = HTTPSource{} }
 func (*HTTPSource) ProtoMessage() {}
 func (*HTTPSource) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9d0d1b17d3865563, []int{30}
+	return fileDescriptor_9d0d1b17d3865563, []int{37}
 }
 func (m *HTTPSource) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -919,10 +1115,38 @@ func (m *HTTPSource) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_HTTPSource proto.InternalMessageInfo
 
+func (m *HotKeySalting) Reset()      { *m = HotKeySalting{} }
+func (*HotKeySalting) ProtoMessage() {}
+func (*HotKeySalting) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9d0d1b17d3865563, []int{38}
+}
+func (m *HotKeySalting) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *HotKeySalting) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *HotKeySalting) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HotKeySalting.Merge(m, src)
+}
+func (m *HotKeySalting) XXX_Size() int {
+	return m.Size()
+}
+func (m *HotKeySalting) XXX_DiscardUnknown() {
+	xxx_messageInfo_HotKeySalting.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_HotKeySalting proto.InternalMessageInfo
+
 func (m *IdleSource) Reset()      { *m = IdleSource{} }
 func (*IdleSource) ProtoMessage() {}
 func (*IdleSource) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9d0d1b17d3865563, []int{31}
+	return fileDescriptor_9d0d1b17d3865563, []int{39}
 }
 func (m *IdleSource) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -950,7 +1174,7 @@ var xxx_messageInfo_IdleSource proto.InternalMessageInfo
 func (m *InterStepBuffer) Reset()      { *m = InterStepBuffer{} }
 func (*InterStepBuffer) ProtoMessage() {}
 func (*InterStepBuffer) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9d0d1b17d3865563, []int{32}
+	return fileDescriptor_9d0d1b17d3865563, []int{40}
 }
 func (m *InterStepBuffer) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -978,7 +1202,7 @@ var xxx_messageInfo_InterStepBuffer proto.InternalMessageInfo
 func (m *InterStepBufferService) Reset()      { *m = InterStepBufferService{} }
 func (*InterStepBufferService) ProtoMessage() {}
 func (*InterStepBufferService) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9d0d1b17d3865563, []int{33}
+	return fileDescriptor_9d0d1b17d3865563, []int{41}
 }
 func (m *InterStepBufferService) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1006,7 +1230,7 @@ var xxx_messageInfo_InterStepBufferService proto.InternalMessageInfo
 func (m *InterStepBufferServiceList) Reset()      { *m = InterStepBufferServiceList{} }
 func (*InterStepBufferServiceList) ProtoMessage() {}
 func (*InterStepBufferServiceList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9d0d1b17d3865563, []int{34}
+	return fileDescriptor_9d0d1b17d3865563, []int{42}
 }
 func (m *InterStepBufferServiceList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1034,7 +1258,7 @@ var xxx_messageInfo_InterStepBufferServiceList proto.InternalMessageInfo
 func (m *InterStepBufferServiceSpec) Reset()      { *m = InterStepBufferServiceSpec{} }
 func (*InterStepBufferServiceSpec) ProtoMessage() {}
 func (*InterStepBufferServiceSpec) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9d0d1b17d3865563, []int{35}
+	return fileDescriptor_9d0d1b17d3865563, []int{43}
 }
 func (m *InterStepBufferServiceSpec) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1062,7 +1286,7 @@ var xxx_messageInfo_InterStepBufferServiceSpec proto.InternalMessageInfo
 func (m *InterStepBufferServiceStatus) Reset()      { *m = InterStepBufferServiceStatus{} }
 func (*InterStepBufferServiceStatus) ProtoMessage() {}
 func (*InterStepBufferServiceStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9d0d1b17d3865563, []int{36}
+	return fileDescriptor_9d0d1b17d3865563, []int{44}
 }
 func (m *InterStepBufferServiceStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1090,7 +1314,7 @@ var xxx_messageInfo_InterStepBufferServiceStatus proto.InternalMessageInfo
 func (m *JetStreamBufferService) Reset()      { *m = JetStreamBufferService{} }
 func (*JetStreamBufferService) ProtoMessage() {}
 func (*JetStreamBufferService) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9d0d1b17d3865563, []int{37}
+	return fileDescriptor_9d0d1b17d3865563, []int{45}
 }
 func (m *JetStreamBufferService) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1118,7 +1342,7 @@ var xxx_messageInfo_JetStreamBufferService proto.InternalMessageInfo
 func (m *JetStreamConfig) Reset()      { *m = JetStreamConfig{} }
 func (*JetStreamConfig) ProtoMessage() {}
 func (*JetStreamConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9d0d1b17d3865563, []int{38}
+	return fileDescriptor_9d0d1b17d3865563, []int{46}
 }
 func (m *JetStreamConfig) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1146,7 +1370,7 @@ var xxx_messageInfo_JetStreamConfig proto.InternalMessageInfo
 func (m *JetStreamSource) Reset()      { *m = JetStreamSource{} }
 func (*JetStreamSource) ProtoMessage() {}
 func (*JetStreamSource) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9d0d1b17d3865563, []int{39}
+	return fileDescriptor_9d0d1b17d3865563, []int{47}
 }
 func (m *JetStreamSource) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1174,7 +1398,7 @@ var xxx_messageInfo_JetStreamSource proto.InternalMessageInfo
 func (m *JobTemplate) Reset()      { *m = JobTemplate{} }
 func (*JobTemplate) ProtoMessage() {}
 func (*JobTemplate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9d0d1b17d3865563, []int{40}
+	return fileDescriptor_9d0d1b17d3865563, []int{48}
 }
 func (m *JobTemplate) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1202,7 +1426,7 @@ var xxx_messageInfo_JobTemplate proto.InternalMessageInfo
 func (m *KafkaSink) Reset()      { *m = KafkaSink{} }
 func (*KafkaSink) ProtoMessage() {}
 func (*KafkaSink) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9d0d1b17d3865563, []int{41}
+	return fileDescriptor_9d0d1b17d3865563, []int{49}
 }
 func (m *KafkaSink) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1230,7 +1454,7 @@ var xxx_messageInfo_KafkaSink proto.InternalMessageInfo
 func (m *KafkaSource) Reset()      { *m = KafkaSource{} }
 func (*KafkaSource) ProtoMessage() {}
 func (*KafkaSource) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9d0d1b17d3865563, []int{42}
+	return fileDescriptor_9d0d1b17d3865563, []int{50}
 }
 func (m *KafkaSource) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1255,10 +1479,38 @@ func (m *KafkaSource) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_KafkaSource proto.InternalMessageInfo
 
+func (m *LateDataOutput) Reset()      { *m = LateDataOutput{} }
+func (*LateDataOutput) ProtoMessage() {}
+func (*LateDataOutput) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9d0d1b17d3865563, []int{51}
+}
+func (m *LateDataOutput) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *LateDataOutput) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *LateDataOutput) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LateDataOutput.Merge(m, src)
+}
+func (m *LateDataOutput) XXX_Size() int {
+	return m.Size()
+}
+func (m *LateDataOutput) XXX_DiscardUnknown() {
+	xxx_messageInfo_LateDataOutput.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LateDataOutput proto.InternalMessageInfo
+
 func (m *Lifecycle) Reset()      { *m = Lifecycle{} }
 func (*Lifecycle) ProtoMessage() {}
 func (*Lifecycle) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9d0d1b17d3865563, []int{43}
+	return fileDescriptor_9d0d1b17d3865563, []int{52}
 }
 func (m *Lifecycle) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1286,7 +1538,7 @@ var xxx_messageInfo_Lifecycle proto.InternalMessageInfo
 func (m *Log) Reset()      { *m = Log{} }
 func (*Log) ProtoMessage() {}
 func (*Log) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9d0d1b17d3865563, []int{44}
+	return fileDescriptor_9d0d1b17d3865563, []int{53}
 }
 func (m *Log) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1314,7 +1566,7 @@ var xxx_messageInfo_Log proto.InternalMessageInfo
 func (m *Metadata) Reset()      { *m = Metadata{} }
 func (*Metadata) ProtoMessage() {}
 func (*Metadata) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9d0d1b17d3865563, []int{45}
+	return fileDescriptor_9d0d1b17d3865563, []int{54}
 }
 func (m *Metadata) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1342,7 +1594,7 @@ var xxx_messageInfo_Metadata proto.InternalMessageInfo
 func (m *MonoVertex) Reset()      { *m = MonoVertex{} }
 func (*MonoVertex) ProtoMessage() {}
 func (*MonoVertex) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9d0d1b17d3865563, []int{46}
+	return fileDescriptor_9d0d1b17d3865563, []int{55}
 }
 func (m *MonoVertex) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1370,7 +1622,7 @@ var xxx_messageInfo_MonoVertex proto.InternalMessageInfo
 func (m *MonoVertexLifecycle) Reset()      { *m = MonoVertexLifecycle{} }
 func (*MonoVertexLifecycle) ProtoMessage() {}
 func (*MonoVertexLifecycle) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9d0d1b17d3865563, []int{47}
+	return fileDescriptor_9d0d1b17d3865563, []int{56}
 }
 func (m *MonoVertexLifecycle) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1398,7 +1650,7 @@ var xxx_messageInfo_MonoVertexLifecycle proto.InternalMessageInfo
 func (m *MonoVertexLimits) Reset()      { *m = MonoVertexLimits{} }
 func (*MonoVertexLimits) ProtoMessage() {}
 func (*MonoVertexLimits) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9d0d1b17d3865563, []int{48}
+	return fileDescriptor_9d0d1b17d3865563, []int{57}
 }
 func (m *MonoVertexLimits) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1426,7 +1678,7 @@ var xxx_messageInfo_MonoVertexLimits proto.InternalMessageInfo
 func (m *MonoVertexList) Reset()      { *m = MonoVertexList{} }
 func (*MonoVertexList) ProtoMessage() {}
 func (*MonoVertexList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9d0d1b17d3865563, []int{49}
+	return fileDescriptor_9d0d1b17d3865563, []int{58}
 }
 func (m *MonoVertexList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1454,7 +1706,7 @@ var xxx_messageInfo_MonoVertexList proto.InternalMessageInfo
 func (m *MonoVertexSpec) Reset()      { *m = MonoVertexSpec{} }
 func (*MonoVertexSpec) ProtoMessage() {}
 func (*MonoVertexSpec) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9d0d1b17d3865563, []int{50}
+	return fileDescriptor_9d0d1b17d3865563, []int{59}
 }
 func (m *MonoVertexSpec) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1482,7 +1734,7 @@ var xxx_messageInfo_MonoVertexSpec proto.InternalMessageInfo
 func (m *MonoVertexStatus) Reset()      { *m = MonoVertexStatus{} }
 func (*MonoVertexStatus) ProtoMessage() {}
 func (*MonoVertexStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9d0d1b17d3865563, []int{51}
+	return fileDescriptor_9d0d1b17d3865563, []int{60}
 }
 func (m *MonoVertexStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1510,7 +1762,7 @@ var xxx_messageInfo_MonoVertexStatus proto.InternalMessageInfo
 func (m *NativeRedis) Reset()      { *m = NativeRedis{} }
 func (*NativeRedis) ProtoMessage() {}
 func (*NativeRedis) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9d0d1b17d3865563, []int{52}
+	return fileDescriptor_9d0d1b17d3865563, []int{61}
 }
 func (m *NativeRedis) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1538,7 +1790,7 @@ var xxx_messageInfo_NativeRedis proto.InternalMessageInfo
 func (m *NatsAuth) Reset()      { *m = NatsAuth{} }
 func (*NatsAuth) ProtoMessage() {}
 func (*NatsAuth) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9d0d1b17d3865563, []int{53}
+	return fileDescriptor_9d0d1b17d3865563, []int{62}
 }
 func (m *NatsAuth) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1566,7 +1818,7 @@ var xxx_messageInfo_NatsAuth proto.InternalMessageInfo
 func (m *NatsSource) Reset()      { *m = NatsSource{} }
 func (*NatsSource) ProtoMessage() {}
 func (*NatsSource) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9d0d1b17d3865563, []int{54}
+	return fileDescriptor_9d0d1b17d3865563, []int{63}
 }
 func (m *NatsSource) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1594,7 +1846,7 @@ var xxx_messageInfo_NatsSource proto.InternalMessageInfo
 func (m *NoStore) Reset()      { *m = NoStore{} }
 func (*NoStore) ProtoMessage() {}
 func (*NoStore) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9d0d1b17d3865563, []int{55}
+	return fileDescriptor_9d0d1b17d3865563, []int{64}
 }
 func (m *NoStore) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1619,10 +1871,38 @@ func (m *NoStore) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_NoStore proto.InternalMessageInfo
 
+func (m *ObjectStoreStorage) Reset()      { *m = ObjectStoreStorage{} }
+func (*ObjectStoreStorage) ProtoMessage() {}
+func (*ObjectStoreStorage) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9d0d1b17d3865563, []int{65}
+}
+func (m *ObjectStoreStorage) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ObjectStoreStorage) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *ObjectStoreStorage) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ObjectStoreStorage.Merge(m, src)
+}
+func (m *ObjectStoreStorage) XXX_Size() int {
+	return m.Size()
+}
+func (m *ObjectStoreStorage) XXX_DiscardUnknown() {
+	xxx_messageInfo_ObjectStoreStorage.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ObjectStoreStorage proto.InternalMessageInfo
+
 func (m *PBQStorage) Reset()      { *m = PBQStorage{} }
 func (*PBQStorage) ProtoMessage() {}
 func (*PBQStorage) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9d0d1b17d3865563, []int{56}
+	return fileDescriptor_9d0d1b17d3865563, []int{66}
 }
 func (m *PBQStorage) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1650,7 +1930,7 @@ var xxx_messageInfo_PBQStorage proto.InternalMessageInfo
 func (m *PersistenceStrategy) Reset()      { *m = PersistenceStrategy{} }
 func (*PersistenceStrategy) ProtoMessage() {}
 func (*PersistenceStrategy) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9d0d1b17d3865563, []int{57}
+	return fileDescriptor_9d0d1b17d3865563, []int{67}
 }
 func (m *PersistenceStrategy) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1678,7 +1958,7 @@ var xxx_messageInfo_PersistenceStrategy proto.InternalMessageInfo
 func (m *Pipeline) Reset()      { *m = Pipeline{} }
 func (*Pipeline) ProtoMessage() {}
 func (*Pipeline) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9d0d1b17d3865563, []int{58}
+	return fileDescriptor_9d0d1b17d3865563, []int{68}
 }
 func (m *Pipeline) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1706,7 +1986,7 @@ var xxx_messageInfo_Pipeline proto.InternalMessageInfo
 func (m *PipelineLimits) Reset()      { *m = PipelineLimits{} }
 func (*PipelineLimits) ProtoMessage() {}
 func (*PipelineLimits) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9d0d1b17d3865563, []int{59}
+	return fileDescriptor_9d0d1b17d3865563, []int{69}
 }
 func (m *PipelineLimits) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1734,7 +2014,7 @@ var xxx_messageInfo_PipelineLimits proto.InternalMessageInfo
 func (m *PipelineList) Reset()      { *m = PipelineList{} }
 func (*PipelineList) ProtoMessage() {}
 func (*PipelineList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9d0d1b17d3865563, []int{60}
+	return fileDescriptor_9d0d1b17d3865563, []int{70}
 }
 func (m *PipelineList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1762,7 +2042,7 @@ var xxx_messageInfo_PipelineList proto.InternalMessageInfo
 func (m *PipelineSpec) Reset()      { *m = PipelineSpec{} }
 func (*PipelineSpec) ProtoMessage() {}
 func (*PipelineSpec) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9d0d1b17d3865563, []int{61}
+	return fileDescriptor_9d0d1b17d3865563, []int{71}
 }
 func (m *PipelineSpec) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1790,7 +2070,7 @@ var xxx_messageInfo_PipelineSpec proto.InternalMessageInfo
 func (m *PipelineStatus) Reset()      { *m = PipelineStatus{} }
 func (*PipelineStatus) ProtoMessage() {}
 func (*PipelineStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9d0d1b17d3865563, []int{62}
+	return fileDescriptor_9d0d1b17d3865563, []int{72}
 }
 func (m *PipelineStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1815,10 +2095,66 @@ func (m *PipelineStatus) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_PipelineStatus proto.InternalMessageInfo
 
+func (m *PipelineUpgradeStatus) Reset()      { *m = PipelineUpgradeStatus{} }
+func (*PipelineUpgradeStatus) ProtoMessage() {}
+func (*PipelineUpgradeStatus) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9d0d1b17d3865563, []int{73}
+}
+func (m *PipelineUpgradeStatus) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PipelineUpgradeStatus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *PipelineUpgradeStatus) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PipelineUpgradeStatus.Merge(m, src)
+}
+func (m *PipelineUpgradeStatus) XXX_Size() int {
+	return m.Size()
+}
+func (m *PipelineUpgradeStatus) XXX_DiscardUnknown() {
+	xxx_messageInfo_PipelineUpgradeStatus.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PipelineUpgradeStatus proto.InternalMessageInfo
+
+func (m *PipelineUpgradeStrategy) Reset()      { *m = PipelineUpgradeStrategy{} }
+func (*PipelineUpgradeStrategy) ProtoMessage() {}
+func (*PipelineUpgradeStrategy) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9d0d1b17d3865563, []int{74}
+}
+func (m *PipelineUpgradeStrategy) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PipelineUpgradeStrategy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *PipelineUpgradeStrategy) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PipelineUpgradeStrategy.Merge(m, src)
+}
+func (m *PipelineUpgradeStrategy) XXX_Size() int {
+	return m.Size()
+}
+func (m *PipelineUpgradeStrategy) XXX_DiscardUnknown() {
+	xxx_messageInfo_PipelineUpgradeStrategy.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PipelineUpgradeStrategy proto.InternalMessageInfo
+
 func (m *Ports) Reset()      { *m = Ports{} }
 func (*Ports) ProtoMessage() {}
 func (*Ports) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9d0d1b17d3865563, []int{63}
+	return fileDescriptor_9d0d1b17d3865563, []int{75}
 }
 func (m *Ports) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1843,10 +2179,38 @@ func (m *Ports) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Ports proto.InternalMessageInfo
 
+func (m *PredictiveScalePolicy) Reset()      { *m = PredictiveScalePolicy{} }
+func (*PredictiveScalePolicy) ProtoMessage() {}
+func (*PredictiveScalePolicy) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9d0d1b17d3865563, []int{76}
+}
+func (m *PredictiveScalePolicy) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PredictiveScalePolicy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *PredictiveScalePolicy) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PredictiveScalePolicy.Merge(m, src)
+}
+func (m *PredictiveScalePolicy) XXX_Size() int {
+	return m.Size()
+}
+func (m *PredictiveScalePolicy) XXX_DiscardUnknown() {
+	xxx_messageInfo_PredictiveScalePolicy.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PredictiveScalePolicy proto.InternalMessageInfo
+
 func (m *Probe) Reset()      { *m = Probe{} }
 func (*Probe) ProtoMessage() {}
 func (*Probe) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9d0d1b17d3865563, []int{64}
+	return fileDescriptor_9d0d1b17d3865563, []int{77}
 }
 func (m *Probe) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1874,7 +2238,7 @@ var xxx_messageInfo_Probe proto.InternalMessageInfo
 func (m *PulsarAuth) Reset()      { *m = PulsarAuth{} }
 func (*PulsarAuth) ProtoMessage() {}
 func (*PulsarAuth) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9d0d1b17d3865563, []int{65}
+	return fileDescriptor_9d0d1b17d3865563, []int{78}
 }
 func (m *PulsarAuth) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1902,7 +2266,7 @@ var xxx_messageInfo_PulsarAuth proto.InternalMessageInfo
 func (m *PulsarBasicAuth) Reset()      { *m = PulsarBasicAuth{} }
 func (*PulsarBasicAuth) ProtoMessage() {}
 func (*PulsarBasicAuth) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9d0d1b17d3865563, []int{66}
+	return fileDescriptor_9d0d1b17d3865563, []int{79}
 }
 func (m *PulsarBasicAuth) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1930,7 +2294,7 @@ var xxx_messageInfo_PulsarBasicAuth proto.InternalMessageInfo
 func (m *PulsarSink) Reset()      { *m = PulsarSink{} }
 func (*PulsarSink) ProtoMessage() {}
 func (*PulsarSink) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9d0d1b17d3865563, []int{67}
+	return fileDescriptor_9d0d1b17d3865563, []int{80}
 }
 func (m *PulsarSink) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1958,7 +2322,7 @@ var xxx_messageInfo_PulsarSink proto.InternalMessageInfo
 func (m *PulsarSource) Reset()      { *m = PulsarSource{} }
 func (*PulsarSource) ProtoMessage() {}
 func (*PulsarSource) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9d0d1b17d3865563, []int{68}
+	return fileDescriptor_9d0d1b17d3865563, []int{81}
 }
 func (m *PulsarSource) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1986,7 +2350,7 @@ var xxx_messageInfo_PulsarSource proto.InternalMessageInfo
 func (m *RedisBufferService) Reset()      { *m = RedisBufferService{} }
 func (*RedisBufferService) ProtoMessage() {}
 func (*RedisBufferService) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9d0d1b17d3865563, []int{69}
+	return fileDescriptor_9d0d1b17d3865563, []int{82}
 }
 func (m *RedisBufferService) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2014,7 +2378,7 @@ var xxx_messageInfo_RedisBufferService proto.InternalMessageInfo
 func (m *RedisConfig) Reset()      { *m = RedisConfig{} }
 func (*RedisConfig) ProtoMessage() {}
 func (*RedisConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9d0d1b17d3865563, []int{70}
+	return fileDescriptor_9d0d1b17d3865563, []int{83}
 }
 func (m *RedisConfig) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2042,7 +2406,7 @@ var xxx_messageInfo_RedisConfig proto.InternalMessageInfo
 func (m *RedisSettings) Reset()      { *m = RedisSettings{} }
 func (*RedisSettings) ProtoMessage() {}
 func (*RedisSettings) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9d0d1b17d3865563, []int{71}
+	return fileDescriptor_9d0d1b17d3865563, []int{84}
 }
 func (m *RedisSettings) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2070,7 +2434,7 @@ var xxx_messageInfo_RedisSettings proto.InternalMessageInfo
 func (m *RetryStrategy) Reset()      { *m = RetryStrategy{} }
 func (*RetryStrategy) ProtoMessage() {}
 func (*RetryStrategy) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9d0d1b17d3865563, []int{72}
+	return fileDescriptor_9d0d1b17d3865563, []int{85}
 }
 func (m *RetryStrategy) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2098,7 +2462,7 @@ var xxx_messageInfo_RetryStrategy proto.InternalMessageInfo
 func (m *RollingUpdateStrategy) Reset()      { *m = RollingUpdateStrategy{} }
 func (*RollingUpdateStrategy) ProtoMessage() {}
 func (*RollingUpdateStrategy) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9d0d1b17d3865563, []int{73}
+	return fileDescriptor_9d0d1b17d3865563, []int{86}
 }
 func (m *RollingUpdateStrategy) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2126,7 +2490,7 @@ var xxx_messageInfo_RollingUpdateStrategy proto.InternalMessageInfo
 func (m *SASL) Reset()      { *m = SASL{} }
 func (*SASL) ProtoMessage() {}
 func (*SASL) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9d0d1b17d3865563, []int{74}
+	return fileDescriptor_9d0d1b17d3865563, []int{87}
 }
 func (m *SASL) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2154,7 +2518,7 @@ var xxx_messageInfo_SASL proto.InternalMessageInfo
 func (m *SASLOAuth) Reset()      { *m = SASLOAuth{} }
 func (*SASLOAuth) ProtoMessage() {}
 func (*SASLOAuth) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9d0d1b17d3865563, []int{75}
+	return fileDescriptor_9d0d1b17d3865563, []int{88}
 }
 func (m *SASLOAuth) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2182,7 +2546,7 @@ var xxx_messageInfo_SASLOAuth proto.InternalMessageInfo
 func (m *SASLPlain) Reset()      { *m = SASLPlain{} }
 func (*SASLPlain) ProtoMessage() {}
 func (*SASLPlain) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9d0d1b17d3865563, []int{76}
+	return fileDescriptor_9d0d1b17d3865563, []int{89}
 }
 func (m *SASLPlain) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2210,7 +2574,7 @@ var xxx_messageInfo_SASLPlain proto.InternalMessageInfo
 func (m *Scale) Reset()      { *m = Scale{} }
 func (*Scale) ProtoMessage() {}
 func (*Scale) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9d0d1b17d3865563, []int{77}
+	return fileDescriptor_9d0d1b17d3865563, []int{90}
 }
 func (m *Scale) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2235,10 +2599,94 @@ func (m *Scale) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Scale proto.InternalMessageInfo
 
+func (m *ScalePolicy) Reset()      { *m = ScalePolicy{} }
+func (*ScalePolicy) ProtoMessage() {}
+func (*ScalePolicy) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9d0d1b17d3865563, []int{91}
+}
+func (m *ScalePolicy) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ScalePolicy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *ScalePolicy) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ScalePolicy.Merge(m, src)
+}
+func (m *ScalePolicy) XXX_Size() int {
+	return m.Size()
+}
+func (m *ScalePolicy) XXX_DiscardUnknown() {
+	xxx_messageInfo_ScalePolicy.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ScalePolicy proto.InternalMessageInfo
+
+func (m *ScaleSchedule) Reset()      { *m = ScaleSchedule{} }
+func (*ScaleSchedule) ProtoMessage() {}
+func (*ScaleSchedule) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9d0d1b17d3865563, []int{92}
+}
+func (m *ScaleSchedule) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ScaleSchedule) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *ScaleSchedule) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ScaleSchedule.Merge(m, src)
+}
+func (m *ScaleSchedule) XXX_Size() int {
+	return m.Size()
+}
+func (m *ScaleSchedule) XXX_DiscardUnknown() {
+	xxx_messageInfo_ScaleSchedule.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ScaleSchedule proto.InternalMessageInfo
+
+func (m *ScalingDecision) Reset()      { *m = ScalingDecision{} }
+func (*ScalingDecision) ProtoMessage() {}
+func (*ScalingDecision) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9d0d1b17d3865563, []int{93}
+}
+func (m *ScalingDecision) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ScalingDecision) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *ScalingDecision) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ScalingDecision.Merge(m, src)
+}
+func (m *ScalingDecision) XXX_Size() int {
+	return m.Size()
+}
+func (m *ScalingDecision) XXX_DiscardUnknown() {
+	xxx_messageInfo_ScalingDecision.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ScalingDecision proto.InternalMessageInfo
+
 func (m *ServeSink) Reset()      { *m = ServeSink{} }
 func (*ServeSink) ProtoMessage() {}
 func (*ServeSink) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9d0d1b17d3865563, []int{78}
+	return fileDescriptor_9d0d1b17d3865563, []int{94}
 }
 func (m *ServeSink) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2266,7 +2714,7 @@ var xxx_messageInfo_ServeSink proto.InternalMessageInfo
 func (m *ServingPipeline) Reset()      { *m = ServingPipeline{} }
 func (*ServingPipeline) ProtoMessage() {}
 func (*ServingPipeline) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9d0d1b17d3865563, []int{79}
+	return fileDescriptor_9d0d1b17d3865563, []int{95}
 }
 func (m *ServingPipeline) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2294,7 +2742,7 @@ var xxx_messageInfo_ServingPipeline proto.InternalMessageInfo
 func (m *ServingPipelineList) Reset()      { *m = ServingPipelineList{} }
 func (*ServingPipelineList) ProtoMessage() {}
 func (*ServingPipelineList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9d0d1b17d3865563, []int{80}
+	return fileDescriptor_9d0d1b17d3865563, []int{96}
 }
 func (m *ServingPipelineList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2322,7 +2770,7 @@ var xxx_messageInfo_ServingPipelineList proto.InternalMessageInfo
 func (m *ServingPipelineSpec) Reset()      { *m = ServingPipelineSpec{} }
 func (*ServingPipelineSpec) ProtoMessage() {}
 func (*ServingPipelineSpec) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9d0d1b17d3865563, []int{81}
+	return fileDescriptor_9d0d1b17d3865563, []int{97}
 }
 func (m *ServingPipelineSpec) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2350,7 +2798,7 @@ var xxx_messageInfo_ServingPipelineSpec proto.InternalMessageInfo
 func (m *ServingPipelineStatus) Reset()      { *m = ServingPipelineStatus{} }
 func (*ServingPipelineStatus) ProtoMessage() {}
 func (*ServingPipelineStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9d0d1b17d3865563, []int{82}
+	return fileDescriptor_9d0d1b17d3865563, []int{98}
 }
 func (m *ServingPipelineStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2378,7 +2826,7 @@ var xxx_messageInfo_ServingPipelineStatus proto.InternalMessageInfo
 func (m *ServingSource) Reset()      { *m = ServingSource{} }
 func (*ServingSource) ProtoMessage() {}
 func (*ServingSource) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9d0d1b17d3865563, []int{83}
+	return fileDescriptor_9d0d1b17d3865563, []int{99}
 }
 func (m *ServingSource) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2406,7 +2854,7 @@ var xxx_messageInfo_ServingSource proto.InternalMessageInfo
 func (m *ServingSpec) Reset()      { *m = ServingSpec{} }
 func (*ServingSpec) ProtoMessage() {}
 func (*ServingSpec) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9d0d1b17d3865563, []int{84}
+	return fileDescriptor_9d0d1b17d3865563, []int{100}
 }
 func (m *ServingSpec) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2434,7 +2882,7 @@ var xxx_messageInfo_ServingSpec proto.InternalMessageInfo
 func (m *ServingStore) Reset()      { *m = ServingStore{} }
 func (*ServingStore) ProtoMessage() {}
 func (*ServingStore) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9d0d1b17d3865563, []int{85}
+	return fileDescriptor_9d0d1b17d3865563, []int{101}
 }
 func (m *ServingStore) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2462,7 +2910,7 @@ var xxx_messageInfo_ServingStore proto.InternalMessageInfo
 func (m *SessionWindow) Reset()      { *m = SessionWindow{} }
 func (*SessionWindow) ProtoMessage() {}
 func (*SessionWindow) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9d0d1b17d3865563, []int{86}
+	return fileDescriptor_9d0d1b17d3865563, []int{102}
 }
 func (m *SessionWindow) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2490,7 +2938,7 @@ var xxx_messageInfo_SessionWindow proto.InternalMessageInfo
 func (m *SideInput) Reset()      { *m = SideInput{} }
 func (*SideInput) ProtoMessage() {}
 func (*SideInput) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9d0d1b17d3865563, []int{87}
+	return fileDescriptor_9d0d1b17d3865563, []int{103}
 }
 func (m *SideInput) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2518,7 +2966,7 @@ var xxx_messageInfo_SideInput proto.InternalMessageInfo
 func (m *SideInputTrigger) Reset()      { *m = SideInputTrigger{} }
 func (*SideInputTrigger) ProtoMessage() {}
 func (*SideInputTrigger) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9d0d1b17d3865563, []int{88}
+	return fileDescriptor_9d0d1b17d3865563, []int{104}
 }
 func (m *SideInputTrigger) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2543,10 +2991,38 @@ func (m *SideInputTrigger) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_SideInputTrigger proto.InternalMessageInfo
 
+func (m *SideInputWatch) Reset()      { *m = SideInputWatch{} }
+func (*SideInputWatch) ProtoMessage() {}
+func (*SideInputWatch) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9d0d1b17d3865563, []int{105}
+}
+func (m *SideInputWatch) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SideInputWatch) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *SideInputWatch) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SideInputWatch.Merge(m, src)
+}
+func (m *SideInputWatch) XXX_Size() int {
+	return m.Size()
+}
+func (m *SideInputWatch) XXX_DiscardUnknown() {
+	xxx_messageInfo_SideInputWatch.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SideInputWatch proto.InternalMessageInfo
+
 func (m *SideInputsManagerTemplate) Reset()      { *m = SideInputsManagerTemplate{} }
 func (*SideInputsManagerTemplate) ProtoMessage() {}
 func (*SideInputsManagerTemplate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9d0d1b17d3865563, []int{89}
+	return fileDescriptor_9d0d1b17d3865563, []int{106}
 }
 func (m *SideInputsManagerTemplate) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2574,7 +3050,7 @@ var xxx_messageInfo_SideInputsManagerTemplate proto.InternalMessageInfo
 func (m *Sink) Reset()      { *m = Sink{} }
 func (*Sink) ProtoMessage() {}
 func (*Sink) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9d0d1b17d3865563, []int{90}
+	return fileDescriptor_9d0d1b17d3865563, []int{107}
 }
 func (m *Sink) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2602,7 +3078,7 @@ var xxx_messageInfo_Sink proto.InternalMessageInfo
 func (m *SlidingWindow) Reset()      { *m = SlidingWindow{} }
 func (*SlidingWindow) ProtoMessage() {}
 func (*SlidingWindow) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9d0d1b17d3865563, []int{91}
+	return fileDescriptor_9d0d1b17d3865563, []int{108}
 }
 func (m *SlidingWindow) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2630,7 +3106,7 @@ var xxx_messageInfo_SlidingWindow proto.InternalMessageInfo
 func (m *Source) Reset()      { *m = Source{} }
 func (*Source) ProtoMessage() {}
 func (*Source) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9d0d1b17d3865563, []int{92}
+	return fileDescriptor_9d0d1b17d3865563, []int{109}
 }
 func (m *Source) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2658,7 +3134,7 @@ var xxx_messageInfo_Source proto.InternalMessageInfo
 func (m *SqsSink) Reset()      { *m = SqsSink{} }
 func (*SqsSink) ProtoMessage() {}
 func (*SqsSink) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9d0d1b17d3865563, []int{93}
+	return fileDescriptor_9d0d1b17d3865563, []int{110}
 }
 func (m *SqsSink) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2686,7 +3162,7 @@ var xxx_messageInfo_SqsSink proto.InternalMessageInfo
 func (m *SqsSource) Reset()      { *m = SqsSource{} }
 func (*SqsSource) ProtoMessage() {}
 func (*SqsSource) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9d0d1b17d3865563, []int{94}
+	return fileDescriptor_9d0d1b17d3865563, []int{111}
 }
 func (m *SqsSource) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2714,7 +3190,7 @@ var xxx_messageInfo_SqsSource proto.InternalMessageInfo
 func (m *Status) Reset()      { *m = Status{} }
 func (*Status) ProtoMessage() {}
 func (*Status) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9d0d1b17d3865563, []int{95}
+	return fileDescriptor_9d0d1b17d3865563, []int{112}
 }
 func (m *Status) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2742,7 +3218,7 @@ var xxx_messageInfo_Status proto.InternalMessageInfo
 func (m *TLS) Reset()      { *m = TLS{} }
 func (*TLS) ProtoMessage() {}
 func (*TLS) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9d0d1b17d3865563, []int{96}
+	return fileDescriptor_9d0d1b17d3865563, []int{113}
 }
 func (m *TLS) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2770,7 +3246,7 @@ var xxx_messageInfo_TLS proto.InternalMessageInfo
 func (m *TagConditions) Reset()      { *m = TagConditions{} }
 func (*TagConditions) ProtoMessage() {}
 func (*TagConditions) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9d0d1b17d3865563, []int{97}
+	return fileDescriptor_9d0d1b17d3865563, []int{114}
 }
 func (m *TagConditions) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2795,10 +3271,38 @@ func (m *TagConditions) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_TagConditions proto.InternalMessageInfo
 
+func (m *TargetLagScalePolicy) Reset()      { *m = TargetLagScalePolicy{} }
+func (*TargetLagScalePolicy) ProtoMessage() {}
+func (*TargetLagScalePolicy) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9d0d1b17d3865563, []int{115}
+}
+func (m *TargetLagScalePolicy) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TargetLagScalePolicy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *TargetLagScalePolicy) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TargetLagScalePolicy.Merge(m, src)
+}
+func (m *TargetLagScalePolicy) XXX_Size() int {
+	return m.Size()
+}
+func (m *TargetLagScalePolicy) XXX_DiscardUnknown() {
+	xxx_messageInfo_TargetLagScalePolicy.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TargetLagScalePolicy proto.InternalMessageInfo
+
 func (m *Templates) Reset()      { *m = Templates{} }
 func (*Templates) ProtoMessage() {}
 func (*Templates) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9d0d1b17d3865563, []int{98}
+	return fileDescriptor_9d0d1b17d3865563, []int{116}
 }
 func (m *Templates) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2826,7 +3330,7 @@ var xxx_messageInfo_Templates proto.InternalMessageInfo
 func (m *UDF) Reset()      { *m = UDF{} }
 func (*UDF) ProtoMessage() {}
 func (*UDF) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9d0d1b17d3865563, []int{99}
+	return fileDescriptor_9d0d1b17d3865563, []int{117}
 }
 func (m *UDF) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2854,7 +3358,7 @@ var xxx_messageInfo_UDF proto.InternalMessageInfo
 func (m *UDSink) Reset()      { *m = UDSink{} }
 func (*UDSink) ProtoMessage() {}
 func (*UDSink) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9d0d1b17d3865563, []int{100}
+	return fileDescriptor_9d0d1b17d3865563, []int{118}
 }
 func (m *UDSink) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2882,7 +3386,7 @@ var xxx_messageInfo_UDSink proto.InternalMessageInfo
 func (m *UDSource) Reset()      { *m = UDSource{} }
 func (*UDSource) ProtoMessage() {}
 func (*UDSource) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9d0d1b17d3865563, []int{101}
+	return fileDescriptor_9d0d1b17d3865563, []int{119}
 }
 func (m *UDSource) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2910,7 +3414,7 @@ var xxx_messageInfo_UDSource proto.InternalMessageInfo
 func (m *UDTransformer) Reset()      { *m = UDTransformer{} }
 func (*UDTransformer) ProtoMessage() {}
 func (*UDTransformer) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9d0d1b17d3865563, []int{102}
+	return fileDescriptor_9d0d1b17d3865563, []int{120}
 }
 func (m *UDTransformer) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2938,7 +3442,7 @@ var xxx_messageInfo_UDTransformer proto.InternalMessageInfo
 func (m *UpdateStrategy) Reset()      { *m = UpdateStrategy{} }
 func (*UpdateStrategy) ProtoMessage() {}
 func (*UpdateStrategy) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9d0d1b17d3865563, []int{103}
+	return fileDescriptor_9d0d1b17d3865563, []int{121}
 }
 func (m *UpdateStrategy) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2966,7 +3470,7 @@ var xxx_messageInfo_UpdateStrategy proto.InternalMessageInfo
 func (m *Vertex) Reset()      { *m = Vertex{} }
 func (*Vertex) ProtoMessage() {}
 func (*Vertex) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9d0d1b17d3865563, []int{104}
+	return fileDescriptor_9d0d1b17d3865563, []int{122}
 }
 func (m *Vertex) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2994,7 +3498,7 @@ var xxx_messageInfo_Vertex proto.InternalMessageInfo
 func (m *VertexInstance) Reset()      { *m = VertexInstance{} }
 func (*VertexInstance) ProtoMessage() {}
 func (*VertexInstance) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9d0d1b17d3865563, []int{105}
+	return fileDescriptor_9d0d1b17d3865563, []int{123}
 }
 func (m *VertexInstance) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3022,7 +3526,7 @@ var xxx_messageInfo_VertexInstance proto.InternalMessageInfo
 func (m *VertexLifecycle) Reset()      { *m = VertexLifecycle{} }
 func (*VertexLifecycle) ProtoMessage() {}
 func (*VertexLifecycle) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9d0d1b17d3865563, []int{106}
+	return fileDescriptor_9d0d1b17d3865563, []int{124}
 }
 func (m *VertexLifecycle) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3050,7 +3554,7 @@ var xxx_messageInfo_VertexLifecycle proto.InternalMessageInfo
 func (m *VertexLimits) Reset()      { *m = VertexLimits{} }
 func (*VertexLimits) ProtoMessage() {}
 func (*VertexLimits) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9d0d1b17d3865563, []int{107}
+	return fileDescriptor_9d0d1b17d3865563, []int{125}
 }
 func (m *VertexLimits) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3078,7 +3582,7 @@ var xxx_messageInfo_VertexLimits proto.InternalMessageInfo
 func (m *VertexList) Reset()      { *m = VertexList{} }
 func (*VertexList) ProtoMessage() {}
 func (*VertexList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9d0d1b17d3865563, []int{108}
+	return fileDescriptor_9d0d1b17d3865563, []int{126}
 }
 func (m *VertexList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3106,7 +3610,7 @@ var xxx_messageInfo_VertexList proto.InternalMessageInfo
 func (m *VertexSpec) Reset()      { *m = VertexSpec{} }
 func (*VertexSpec) ProtoMessage() {}
 func (*VertexSpec) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9d0d1b17d3865563, []int{109}
+	return fileDescriptor_9d0d1b17d3865563, []int{127}
 }
 func (m *VertexSpec) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3134,7 +3638,7 @@ var xxx_messageInfo_VertexSpec proto.InternalMessageInfo
 func (m *VertexStatus) Reset()      { *m = VertexStatus{} }
 func (*VertexStatus) ProtoMessage() {}
 func (*VertexStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9d0d1b17d3865563, []int{110}
+	return fileDescriptor_9d0d1b17d3865563, []int{128}
 }
 func (m *VertexStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3162,7 +3666,7 @@ var xxx_messageInfo_VertexStatus proto.InternalMessageInfo
 func (m *VertexTemplate) Reset()      { *m = VertexTemplate{} }
 func (*VertexTemplate) ProtoMessage() {}
 func (*VertexTemplate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9d0d1b17d3865563, []int{111}
+	return fileDescriptor_9d0d1b17d3865563, []int{129}
 }
 func (m *VertexTemplate) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3190,7 +3694,7 @@ var xxx_messageInfo_VertexTemplate proto.InternalMessageInfo
 func (m *Watermark) Reset()      { *m = Watermark{} }
 func (*Watermark) ProtoMessage() {}
 func (*Watermark) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9d0d1b17d3865563, []int{112}
+	return fileDescriptor_9d0d1b17d3865563, []int{130}
 }
 func (m *Watermark) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3218,7 +3722,7 @@ var xxx_messageInfo_Watermark proto.InternalMessageInfo
 func (m *Window) Reset()      { *m = Window{} }
 func (*Window) ProtoMessage() {}
 func (*Window) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9d0d1b17d3865563, []int{113}
+	return fileDescriptor_9d0d1b17d3865563, []int{131}
 }
 func (m *Window) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3243,6 +3747,34 @@ func (m *Window) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Window proto.InternalMessageInfo
 
+func (m *WindowTrigger) Reset()      { *m = WindowTrigger{} }
+func (*WindowTrigger) ProtoMessage() {}
+func (*WindowTrigger) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9d0d1b17d3865563, []int{132}
+}
+func (m *WindowTrigger) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *WindowTrigger) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *WindowTrigger) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WindowTrigger.Merge(m, src)
+}
+func (m *WindowTrigger) XXX_Size() int {
+	return m.Size()
+}
+func (m *WindowTrigger) XXX_DiscardUnknown() {
+	xxx_messageInfo_WindowTrigger.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WindowTrigger proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*AbstractPodTemplate)(nil), "github.com.numaproj.numaflow.pkg.apis.numaflow.v1alpha1.AbstractPodTemplate")
 	proto.RegisterMapType((map[string]string)(nil), "github.com.numaproj.numaflow.pkg.apis.numaflow.v1alpha1.AbstractPodTemplate.NodeSelectorEntry")
@@ -3254,14 +3786,21 @@ func init() {
 	proto.RegisterType((*BasicAuth)(nil), "github.com.numaproj.numaflow.pkg.apis.numaflow.v1alpha1.BasicAuth")
 	proto.RegisterType((*Blackhole)(nil), "github.com.numaproj.numaflow.pkg.apis.numaflow.v1alpha1.Blackhole")
 	proto.RegisterType((*BufferServiceConfig)(nil), "github.com.numaproj.numaflow.pkg.apis.numaflow.v1alpha1.BufferServiceConfig")
+	proto.RegisterType((*CPUScalePolicy)(nil), "github.com.numaproj.numaflow.pkg.apis.numaflow.v1alpha1.CPUScalePolicy")
 	proto.RegisterType((*CombinedEdge)(nil), "github.com.numaproj.numaflow.pkg.apis.numaflow.v1alpha1.CombinedEdge")
 	proto.RegisterType((*Compression)(nil), "github.com.numaproj.numaflow.pkg.apis.numaflow.v1alpha1.Compression")
 	proto.RegisterType((*Container)(nil), "github.com.numaproj.numaflow.pkg.apis.numaflow.v1alpha1.Container")
 	proto.RegisterType((*ContainerTemplate)(nil), "github.com.numaproj.numaflow.pkg.apis.numaflow.v1alpha1.ContainerTemplate")
+	proto.RegisterType((*CountWindow)(nil), "github.com.numaproj.numaflow.pkg.apis.numaflow.v1alpha1.CountWindow")
 	proto.RegisterType((*DaemonTemplate)(nil), "github.com.numaproj.numaflow.pkg.apis.numaflow.v1alpha1.DaemonTemplate")
+	proto.RegisterType((*DeadLetterOutput)(nil), "github.com.numaproj.numaflow.pkg.apis.numaflow.v1alpha1.DeadLetterOutput")
+	proto.RegisterType((*DedupWindow)(nil), "github.com.numaproj.numaflow.pkg.apis.numaflow.v1alpha1.DedupWindow")
+	proto.RegisterType((*EarlyFiring)(nil), "github.com.numaproj.numaflow.pkg.apis.numaflow.v1alpha1.EarlyFiring")
 	proto.RegisterType((*Edge)(nil), "github.com.numaproj.numaflow.pkg.apis.numaflow.v1alpha1.Edge")
 	proto.RegisterType((*FixedWindow)(nil), "github.com.numaproj.numaflow.pkg.apis.numaflow.v1alpha1.FixedWindow")
 	proto.RegisterType((*ForwardConditions)(nil), "github.com.numaproj.numaflow.pkg.apis.numaflow.v1alpha1.ForwardConditions")
+	proto.RegisterType((*Function)(nil), "github.com.numaproj.numaflow.pkg.apis.numaflow.v1alpha1.Function")
+	proto.RegisterMapType((map[string]string)(nil), "github.com.numaproj.numaflow.pkg.apis.numaflow.v1alpha1.Function.KwargsEntry")
 	proto.RegisterType((*GSSAPI)(nil), "github.com.numaproj.numaflow.pkg.apis.numaflow.v1alpha1.GSSAPI")
 	proto.RegisterType((*GeneratorSource)(nil), "github.com.numaproj.numaflow.pkg.apis.numaflow.v1alpha1.GeneratorSource")
 	proto.RegisterType((*GetDaemonDeploymentReq)(nil), "github.com.numaproj.numaflow.pkg.apis.numaflow.v1alpha1.GetDaemonDeploymentReq")
@@ -3278,8 +3817,10 @@ func init() {
 	proto.RegisterType((*GetServingPipelineResourceReq)(nil), "github.com.numaproj.numaflow.pkg.apis.numaflow.v1alpha1.GetServingPipelineResourceReq")
 	proto.RegisterType((*GetSideInputDeploymentReq)(nil), "github.com.numaproj.numaflow.pkg.apis.numaflow.v1alpha1.GetSideInputDeploymentReq")
 	proto.RegisterType((*GetVertexPodSpecReq)(nil), "github.com.numaproj.numaflow.pkg.apis.numaflow.v1alpha1.GetVertexPodSpecReq")
+	proto.RegisterType((*GlobalWindow)(nil), "github.com.numaproj.numaflow.pkg.apis.numaflow.v1alpha1.GlobalWindow")
 	proto.RegisterType((*GroupBy)(nil), "github.com.numaproj.numaflow.pkg.apis.numaflow.v1alpha1.GroupBy")
 	proto.RegisterType((*HTTPSource)(nil), "github.com.numaproj.numaflow.pkg.apis.numaflow.v1alpha1.HTTPSource")
+	proto.RegisterType((*HotKeySalting)(nil), "github.com.numaproj.numaflow.pkg.apis.numaflow.v1alpha1.HotKeySalting")
 	proto.RegisterType((*IdleSource)(nil), "github.com.numaproj.numaflow.pkg.apis.numaflow.v1alpha1.IdleSource")
 	proto.RegisterType((*InterStepBuffer)(nil), "github.com.numaproj.numaflow.pkg.apis.numaflow.v1alpha1.InterStepBuffer")
 	proto.RegisterType((*InterStepBufferService)(nil), "github.com.numaproj.numaflow.pkg.apis.numaflow.v1alpha1.InterStepBufferService")
@@ -3292,6 +3833,7 @@ func init() {
 	proto.RegisterType((*JobTemplate)(nil), "github.com.numaproj.numaflow.pkg.apis.numaflow.v1alpha1.JobTemplate")
 	proto.RegisterType((*KafkaSink)(nil), "github.com.numaproj.numaflow.pkg.apis.numaflow.v1alpha1.KafkaSink")
 	proto.RegisterType((*KafkaSource)(nil), "github.com.numaproj.numaflow.pkg.apis.numaflow.v1alpha1.KafkaSource")
+	proto.RegisterType((*LateDataOutput)(nil), "github.com.numaproj.numaflow.pkg.apis.numaflow.v1alpha1.LateDataOutput")
 	proto.RegisterType((*Lifecycle)(nil), "github.com.numaproj.numaflow.pkg.apis.numaflow.v1alpha1.Lifecycle")
 	proto.RegisterType((*Log)(nil), "github.com.numaproj.numaflow.pkg.apis.numaflow.v1alpha1.Log")
 	proto.RegisterType((*Metadata)(nil), "github.com.numaproj.numaflow.pkg.apis.numaflow.v1alpha1.Metadata")
@@ -3307,6 +3849,7 @@ func init() {
 	proto.RegisterType((*NatsAuth)(nil), "github.com.numaproj.numaflow.pkg.apis.numaflow.v1alpha1.NatsAuth")
 	proto.RegisterType((*NatsSource)(nil), "github.com.numaproj.numaflow.pkg.apis.numaflow.v1alpha1.NatsSource")
 	proto.RegisterType((*NoStore)(nil), "github.com.numaproj.numaflow.pkg.apis.numaflow.v1alpha1.NoStore")
+	proto.RegisterType((*ObjectStoreStorage)(nil), "github.com.numaproj.numaflow.pkg.apis.numaflow.v1alpha1.ObjectStoreStorage")
 	proto.RegisterType((*PBQStorage)(nil), "github.com.numaproj.numaflow.pkg.apis.numaflow.v1alpha1.PBQStorage")
 	proto.RegisterType((*PersistenceStrategy)(nil), "github.com.numaproj.numaflow.pkg.apis.numaflow.v1alpha1.PersistenceStrategy")
 	proto.RegisterType((*Pipeline)(nil), "github.com.numaproj.numaflow.pkg.apis.numaflow.v1alpha1.Pipeline")
@@ -3314,7 +3857,10 @@ func init() {
 	proto.RegisterType((*PipelineList)(nil), "github.com.numaproj.numaflow.pkg.apis.numaflow.v1alpha1.PipelineList")
 	proto.RegisterType((*PipelineSpec)(nil), "github.com.numaproj.numaflow.pkg.apis.numaflow.v1alpha1.PipelineSpec")
 	proto.RegisterType((*PipelineStatus)(nil), "github.com.numaproj.numaflow.pkg.apis.numaflow.v1alpha1.PipelineStatus")
+	proto.RegisterType((*PipelineUpgradeStatus)(nil), "github.com.numaproj.numaflow.pkg.apis.numaflow.v1alpha1.PipelineUpgradeStatus")
+	proto.RegisterType((*PipelineUpgradeStrategy)(nil), "github.com.numaproj.numaflow.pkg.apis.numaflow.v1alpha1.PipelineUpgradeStrategy")
 	proto.RegisterType((*Ports)(nil), "github.com.numaproj.numaflow.pkg.apis.numaflow.v1alpha1.Ports")
+	proto.RegisterType((*PredictiveScalePolicy)(nil), "github.com.numaproj.numaflow.pkg.apis.numaflow.v1alpha1.PredictiveScalePolicy")
 	proto.RegisterType((*Probe)(nil), "github.com.numaproj.numaflow.pkg.apis.numaflow.v1alpha1.Probe")
 	proto.RegisterType((*PulsarAuth)(nil), "github.com.numaproj.numaflow.pkg.apis.numaflow.v1alpha1.PulsarAuth")
 	proto.RegisterType((*PulsarBasicAuth)(nil), "github.com.numaproj.numaflow.pkg.apis.numaflow.v1alpha1.PulsarBasicAuth")
@@ -3329,6 +3875,9 @@ func init() {
 	proto.RegisterType((*SASLOAuth)(nil), "github.com.numaproj.numaflow.pkg.apis.numaflow.v1alpha1.SASLOAuth")
 	proto.RegisterType((*SASLPlain)(nil), "github.com.numaproj.numaflow.pkg.apis.numaflow.v1alpha1.SASLPlain")
 	proto.RegisterType((*Scale)(nil), "github.com.numaproj.numaflow.pkg.apis.numaflow.v1alpha1.Scale")
+	proto.RegisterType((*ScalePolicy)(nil), "github.com.numaproj.numaflow.pkg.apis.numaflow.v1alpha1.ScalePolicy")
+	proto.RegisterType((*ScaleSchedule)(nil), "github.com.numaproj.numaflow.pkg.apis.numaflow.v1alpha1.ScaleSchedule")
+	proto.RegisterType((*ScalingDecision)(nil), "github.com.numaproj.numaflow.pkg.apis.numaflow.v1alpha1.ScalingDecision")
 	proto.RegisterType((*ServeSink)(nil), "github.com.numaproj.numaflow.pkg.apis.numaflow.v1alpha1.ServeSink")
 	proto.RegisterType((*ServingPipeline)(nil), "github.com.numaproj.numaflow.pkg.apis.numaflow.v1alpha1.ServingPipeline")
 	proto.RegisterType((*ServingPipelineList)(nil), "github.com.numaproj.numaflow.pkg.apis.numaflow.v1alpha1.ServingPipelineList")
@@ -3340,6 +3889,7 @@ func init() {
 	proto.RegisterType((*SessionWindow)(nil), "github.com.numaproj.numaflow.pkg.apis.numaflow.v1alpha1.SessionWindow")
 	proto.RegisterType((*SideInput)(nil), "github.com.numaproj.numaflow.pkg.apis.numaflow.v1alpha1.SideInput")
 	proto.RegisterType((*SideInputTrigger)(nil), "github.com.numaproj.numaflow.pkg.apis.numaflow.v1alpha1.SideInputTrigger")
+	proto.RegisterType((*SideInputWatch)(nil), "github.com.numaproj.numaflow.pkg.apis.numaflow.v1alpha1.SideInputWatch")
 	proto.RegisterType((*SideInputsManagerTemplate)(nil), "github.com.numaproj.numaflow.pkg.apis.numaflow.v1alpha1.SideInputsManagerTemplate")
 	proto.RegisterType((*Sink)(nil), "github.com.numaproj.numaflow.pkg.apis.numaflow.v1alpha1.Sink")
 	proto.RegisterType((*SlidingWindow)(nil), "github.com.numaproj.numaflow.pkg.apis.numaflow.v1alpha1.SlidingWindow")
@@ -3349,6 +3899,7 @@ func init() {
 	proto.RegisterType((*Status)(nil), "github.com.numaproj.numaflow.pkg.apis.numaflow.v1alpha1.Status")
 	proto.RegisterType((*TLS)(nil), "github.com.numaproj.numaflow.pkg.apis.numaflow.v1alpha1.TLS")
 	proto.RegisterType((*TagConditions)(nil), "github.com.numaproj.numaflow.pkg.apis.numaflow.v1alpha1.TagConditions")
+	proto.RegisterType((*TargetLagScalePolicy)(nil), "github.com.numaproj.numaflow.pkg.apis.numaflow.v1alpha1.TargetLagScalePolicy")
 	proto.RegisterType((*Templates)(nil), "github.com.numaproj.numaflow.pkg.apis.numaflow.v1alpha1.Templates")
 	proto.RegisterType((*UDF)(nil), "github.com.numaproj.numaflow.pkg.apis.numaflow.v1alpha1.UDF")
 	proto.RegisterType((*UDSink)(nil), "github.com.numaproj.numaflow.pkg.apis.numaflow.v1alpha1.UDSink")
@@ -3365,6 +3916,7 @@ func init() {
 	proto.RegisterType((*VertexTemplate)(nil), "github.com.numaproj.numaflow.pkg.apis.numaflow.v1alpha1.VertexTemplate")
 	proto.RegisterType((*Watermark)(nil), "github.com.numaproj.numaflow.pkg.apis.numaflow.v1alpha1.Watermark")
 	proto.RegisterType((*Window)(nil), "github.com.numaproj.numaflow.pkg.apis.numaflow.v1alpha1.Window")
+	proto.RegisterType((*WindowTrigger)(nil), "github.com.numaproj.numaflow.pkg.apis.numaflow.v1alpha1.WindowTrigger")
 }
 
 func init() {
@@ -3372,576 +3924,664 @@ func init() {
 }
 
 var fileDescriptor_9d0d1b17d3865563 = []byte{
-	// 9100 bytes of a gzipped FileDescriptorProto
+	// 10498 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x7d, 0x5b, 0x6c, 0x24, 0xd9,
-	0x75, 0x98, 0xfa, 0x45, 0x76, 0x9f, 0xe6, 0x63, 0xe6, 0xce, 0x63, 0x39, 0xa3, 0xd9, 0xe1, 0xa8,
-	0xd6, 0xbb, 0x1a, 0xc7, 0x32, 0x99, 0x1d, 0x69, 0x57, 0x2b, 0xc9, 0xd2, 0x2e, 0x9b, 0x1c, 0xce,
-	0x70, 0x87, 0x9c, 0xe1, 0x9e, 0x26, 0x67, 0x57, 0xda, 0x48, 0x9b, 0x62, 0xf5, 0x65, 0xb3, 0x96,
-	0xd5, 0x55, 0x3d, 0x55, 0xd5, 0x9c, 0xe1, 0x3a, 0x82, 0x64, 0x09, 0xc9, 0x2a, 0x0f, 0x20, 0x81,
-	0x7f, 0x64, 0x20, 0x70, 0x82, 0x00, 0x01, 0xfc, 0x61, 0x38, 0x1f, 0x4e, 0x94, 0x8f, 0x7c, 0x24,
-	0xb1, 0x03, 0x38, 0x42, 0x6c, 0x27, 0x82, 0x61, 0x20, 0x0a, 0x92, 0x30, 0x11, 0x83, 0x7c, 0x24,
-	0x1f, 0x81, 0x13, 0x23, 0x89, 0x33, 0x09, 0xe2, 0xe0, 0xbe, 0xaa, 0x6e, 0x55, 0x57, 0xcf, 0x92,
-	0x5d, 0x4d, 0xee, 0xac, 0xb3, 0x5f, 0xdd, 0x75, 0xce, 0xb9, 0xe7, 0xdc, 0xba, 0x75, 0x1f, 0xe7,
-	0x9e, 0x73, 0xee, 0xb9, 0x70, 0xab, 0x6d, 0x87, 0x3b, 0xbd, 0xad, 0x39, 0xcb, 0xeb, 0xcc, 0xbb,
-	0xbd, 0x8e, 0xd9, 0xf5, 0xbd, 0x77, 0xf9, 0x9f, 0x6d, 0xc7, 0x7b, 0x38, 0xdf, 0xdd, 0x6d, 0xcf,
-	0x9b, 0x5d, 0x3b, 0x88, 0x21, 0x7b, 0x2f, 0x9a, 0x4e, 0x77, 0xc7, 0x7c, 0x71, 0xbe, 0x4d, 0x5d,
-	0xea, 0x9b, 0x21, 0x6d, 0xcd, 0x75, 0x7d, 0x2f, 0xf4, 0xc8, 0xe7, 0x63, 0x46, 0x73, 0x8a, 0xd1,
-	0x9c, 0x2a, 0x36, 0xd7, 0xdd, 0x6d, 0xcf, 0x31, 0x46, 0x31, 0x44, 0x31, 0xba, 0xfc, 0xb3, 0x5a,
-	0x0d, 0xda, 0x5e, 0xdb, 0x9b, 0xe7, 0xfc, 0xb6, 0x7a, 0xdb, 0xfc, 0x89, 0x3f, 0xf0, 0x7f, 0x42,
-	0xce, 0x65, 0x63, 0xf7, 0x95, 0x60, 0xce, 0xf6, 0x58, 0xb5, 0xe6, 0x2d, 0xcf, 0xa7, 0xf3, 0x7b,
-	0x7d, 0x75, 0xb9, 0xfc, 0xb9, 0x98, 0xa6, 0x63, 0x5a, 0x3b, 0xb6, 0x4b, 0xfd, 0x7d, 0xf5, 0x2e,
-	0xf3, 0x3e, 0x0d, 0xbc, 0x9e, 0x6f, 0xd1, 0x63, 0x95, 0x0a, 0xe6, 0x3b, 0x34, 0x34, 0xb3, 0x64,
-	0xcd, 0x0f, 0x2a, 0xe5, 0xf7, 0xdc, 0xd0, 0xee, 0xf4, 0x8b, 0x79, 0xf9, 0x83, 0x0a, 0x04, 0xd6,
-	0x0e, 0xed, 0x98, 0x7d, 0xe5, 0x3e, 0x3b, 0xa8, 0x5c, 0x2f, 0xb4, 0x9d, 0x79, 0xdb, 0x0d, 0x83,
-	0xd0, 0x4f, 0x17, 0x32, 0x7e, 0x03, 0xe0, 0xdc, 0xc2, 0x56, 0x10, 0xfa, 0xa6, 0x15, 0xae, 0x7b,
-	0xad, 0x0d, 0xda, 0xe9, 0x3a, 0x66, 0x48, 0xc9, 0x2e, 0x54, 0xd9, 0x0b, 0xb5, 0xcc, 0xd0, 0x9c,
-	0x29, 0x5c, 0x2b, 0x5c, 0xaf, 0xdf, 0x58, 0x98, 0x1b, 0xf2, 0x03, 0xce, 0xad, 0x49, 0x46, 0x8d,
-	0x89, 0xc3, 0x83, 0xd9, 0xaa, 0x7a, 0xc2, 0x48, 0x00, 0xf9, 0xa5, 0x02, 0x4c, 0xb8, 0x5e, 0x8b,
-	0x36, 0xa9, 0x43, 0xad, 0xd0, 0xf3, 0x67, 0x8a, 0xd7, 0x4a, 0xd7, 0xeb, 0x37, 0xbe, 0x31, 0xb4,
-	0xc4, 0x8c, 0x37, 0x9a, 0xbb, 0xab, 0x09, 0xb8, 0xe9, 0x86, 0xfe, 0x7e, 0xe3, 0xfc, 0x0f, 0x0f,
-	0x66, 0x3f, 0x71, 0x78, 0x30, 0x3b, 0xa1, 0xa3, 0x30, 0x51, 0x13, 0xb2, 0x09, 0xf5, 0xd0, 0x73,
-	0x58, 0x93, 0xd9, 0x9e, 0x1b, 0xcc, 0x94, 0x78, 0xc5, 0xae, 0xce, 0x89, 0xa6, 0x66, 0xe2, 0xe7,
-	0x58, 0x1f, 0x9b, 0xdb, 0x7b, 0x71, 0x6e, 0x23, 0x22, 0x6b, 0x9c, 0x93, 0x8c, 0xeb, 0x31, 0x2c,
-	0x40, 0x9d, 0x0f, 0xa1, 0x30, 0x1d, 0x50, 0xab, 0xe7, 0xdb, 0xe1, 0xfe, 0xa2, 0xe7, 0x86, 0xf4,
-	0x51, 0x38, 0x53, 0xe6, 0xad, 0xfc, 0x42, 0x16, 0xeb, 0x75, 0xaf, 0xd5, 0x4c, 0x52, 0x37, 0xce,
-	0x1d, 0x1e, 0xcc, 0x4e, 0xa7, 0x80, 0x98, 0xe6, 0x49, 0x5c, 0x38, 0x63, 0x77, 0xcc, 0x36, 0x5d,
-	0xef, 0x39, 0x4e, 0x93, 0x5a, 0x3e, 0x0d, 0x83, 0x99, 0x0a, 0x7f, 0x85, 0xeb, 0x59, 0x72, 0x56,
-	0x3d, 0xcb, 0x74, 0xee, 0x6d, 0xbd, 0x4b, 0xad, 0x10, 0xe9, 0x36, 0xf5, 0xa9, 0x6b, 0xd1, 0xc6,
-	0x8c, 0x7c, 0x99, 0x33, 0x2b, 0x29, 0x4e, 0xd8, 0xc7, 0x9b, 0xdc, 0x82, 0xb3, 0x5d, 0xdf, 0xf6,
-	0x78, 0x15, 0x1c, 0x33, 0x08, 0xee, 0x9a, 0x1d, 0x3a, 0x33, 0x76, 0xad, 0x70, 0xbd, 0xd6, 0xb8,
-	0x24, 0xd9, 0x9c, 0x5d, 0x4f, 0x13, 0x60, 0x7f, 0x19, 0x72, 0x1d, 0xaa, 0x0a, 0x38, 0x33, 0x7e,
-	0xad, 0x70, 0xbd, 0x22, 0xfa, 0x8e, 0x2a, 0x8b, 0x11, 0x96, 0x2c, 0x43, 0xd5, 0xdc, 0xde, 0xb6,
-	0x5d, 0x46, 0x59, 0xe5, 0x4d, 0x78, 0x25, 0xeb, 0xd5, 0x16, 0x24, 0x8d, 0xe0, 0xa3, 0x9e, 0x30,
-	0x2a, 0x4b, 0x5e, 0x07, 0x12, 0x50, 0x7f, 0xcf, 0xb6, 0xe8, 0x82, 0x65, 0x79, 0x3d, 0x37, 0xe4,
-	0x75, 0xaf, 0xf1, 0xba, 0x5f, 0x96, 0x75, 0x27, 0xcd, 0x3e, 0x0a, 0xcc, 0x28, 0x45, 0x5e, 0x83,
-	0x33, 0x72, 0xac, 0xc6, 0xad, 0x00, 0x9c, 0xd3, 0x79, 0xd6, 0x90, 0x98, 0xc2, 0x61, 0x1f, 0x35,
-	0x69, 0xc1, 0x15, 0xb3, 0x17, 0x7a, 0x1d, 0xc6, 0x32, 0x29, 0x74, 0xc3, 0xdb, 0xa5, 0xee, 0x4c,
-	0xfd, 0x5a, 0xe1, 0x7a, 0xb5, 0x71, 0xed, 0xf0, 0x60, 0xf6, 0xca, 0xc2, 0x13, 0xe8, 0xf0, 0x89,
-	0x5c, 0xc8, 0x3d, 0xa8, 0xb5, 0xdc, 0x60, 0xdd, 0x73, 0x6c, 0x6b, 0x7f, 0x66, 0x82, 0x57, 0xf0,
-	0x45, 0xf9, 0xaa, 0xb5, 0xa5, 0xbb, 0x4d, 0x81, 0x78, 0x7c, 0x30, 0x7b, 0xa5, 0x7f, 0x4a, 0x9d,
-	0x8b, 0xf0, 0x18, 0xf3, 0x20, 0x6b, 0x9c, 0xe1, 0xa2, 0xe7, 0x6e, 0xdb, 0xed, 0x99, 0x49, 0xfe,
-	0x35, 0xae, 0x0d, 0xe8, 0xd0, 0x4b, 0x77, 0x9b, 0x82, 0xae, 0x31, 0x29, 0xc5, 0x89, 0x47, 0x8c,
-	0x39, 0x90, 0x16, 0x4c, 0xa9, 0xc9, 0x78, 0xd1, 0x31, 0xed, 0x4e, 0x30, 0x33, 0xc5, 0x3b, 0xef,
-	0x4f, 0x0d, 0xe0, 0x89, 0x3a, 0x71, 0xe3, 0xa2, 0x7c, 0x95, 0xa9, 0x04, 0x38, 0xc0, 0x14, 0xcf,
-	0xcb, 0xaf, 0xc2, 0xd9, 0xbe, 0xb9, 0x81, 0x9c, 0x81, 0xd2, 0x2e, 0xdd, 0xe7, 0x53, 0x5f, 0x0d,
-	0xd9, 0x5f, 0x72, 0x1e, 0x2a, 0x7b, 0xa6, 0xd3, 0xa3, 0x33, 0x45, 0x0e, 0x13, 0x0f, 0x5f, 0x2c,
-	0xbe, 0x52, 0x30, 0x7e, 0xb7, 0x02, 0x13, 0x6a, 0xc6, 0x69, 0xda, 0xee, 0x2e, 0x79, 0x13, 0x4a,
-	0x8e, 0xd7, 0x96, 0xf3, 0xe6, 0xcf, 0x0d, 0x3d, 0x8b, 0xad, 0x7a, 0xed, 0xc6, 0xf8, 0xe1, 0xc1,
-	0x6c, 0x69, 0xd5, 0x6b, 0x23, 0xe3, 0x48, 0x2c, 0xa8, 0xec, 0x9a, 0xdb, 0xbb, 0x26, 0xaf, 0x43,
-	0xfd, 0x46, 0x63, 0x68, 0xd6, 0x77, 0x18, 0x17, 0x56, 0xd7, 0x46, 0xed, 0xf0, 0x60, 0xb6, 0xc2,
-	0x1f, 0x51, 0xf0, 0x26, 0x1e, 0xd4, 0xb6, 0x1c, 0xd3, 0xda, 0xdd, 0xf1, 0x1c, 0x3a, 0x53, 0xca,
-	0x29, 0xa8, 0xa1, 0x38, 0x89, 0xcf, 0x1c, 0x3d, 0x62, 0x2c, 0x83, 0x58, 0x30, 0xd6, 0x6b, 0x05,
-	0xb6, 0xbb, 0x2b, 0xe7, 0xc0, 0x57, 0x87, 0x96, 0xb6, 0xb9, 0xc4, 0xdf, 0x09, 0x0e, 0x0f, 0x66,
-	0xc7, 0xc4, 0x7f, 0x94, 0xac, 0x59, 0xd3, 0xb1, 0x91, 0x4a, 0x67, 0x2a, 0x39, 0xdf, 0x88, 0x0d,
-	0x24, 0x1a, 0x37, 0x1d, 0x7f, 0x44, 0xc1, 0x9b, 0xbc, 0x0d, 0xa5, 0xe0, 0x41, 0xc0, 0x67, 0xbc,
-	0xfa, 0x8d, 0xd7, 0x86, 0x17, 0xf1, 0x20, 0xe0, 0x02, 0xf8, 0xc7, 0x6f, 0x3e, 0x08, 0x90, 0x71,
-	0x25, 0x6d, 0x18, 0xeb, 0xf6, 0x9c, 0xc0, 0xf4, 0xf9, 0x8c, 0x58, 0xbf, 0xb1, 0x38, 0x34, 0xff,
-	0x75, 0xce, 0x26, 0x6e, 0x2a, 0xf1, 0x8c, 0x92, 0xbd, 0xf1, 0x47, 0x13, 0x30, 0xa5, 0xfa, 0xf3,
-	0x7d, 0xea, 0x87, 0xf4, 0x11, 0xb9, 0x06, 0x65, 0x97, 0xcd, 0x62, 0x7c, 0x3c, 0x34, 0x26, 0xe4,
-	0xc8, 0x2a, 0xf3, 0xd9, 0x8b, 0x63, 0xd8, 0x47, 0x14, 0xa3, 0x4a, 0xf6, 0xcd, 0xe1, 0x3f, 0x62,
-	0x93, 0xb3, 0x11, 0x35, 0x13, 0xff, 0x51, 0xb2, 0x26, 0x6f, 0x43, 0x99, 0xf7, 0x13, 0xd1, 0x2b,
-	0xbf, 0x3c, 0xbc, 0x08, 0xf6, 0xea, 0x55, 0xf6, 0x06, 0xbc, 0x8f, 0x70, 0xa6, 0x6c, 0xd4, 0xf6,
-	0x5a, 0xdb, 0xb2, 0x0f, 0xfe, 0x5c, 0x8e, 0x3e, 0xb8, 0x2c, 0x3e, 0xdc, 0xe6, 0xd2, 0x32, 0x32,
-	0x8e, 0xe4, 0xaf, 0x16, 0xe0, 0xac, 0xe5, 0xb9, 0xa1, 0xc9, 0x54, 0x32, 0xa5, 0x8f, 0xc8, 0x7e,
-	0xf8, 0xfa, 0xd0, 0x72, 0x16, 0xd3, 0x1c, 0x1b, 0x17, 0xd8, 0xf2, 0xda, 0x07, 0xc6, 0x7e, 0xd9,
-	0xe4, 0xaf, 0x17, 0xe0, 0x02, 0x5b, 0xf6, 0xfa, 0x88, 0x65, 0xd7, 0x1d, 0x65, 0xad, 0x2e, 0x1d,
-	0x1e, 0xcc, 0x5e, 0x58, 0xc9, 0x12, 0x86, 0xd9, 0x75, 0x60, 0xb5, 0x3b, 0x67, 0xf6, 0x6b, 0x70,
-	0xb2, 0xdb, 0xaf, 0x8e, 0x52, 0x2b, 0x6c, 0x7c, 0x52, 0x76, 0xe5, 0x2c, 0x25, 0x18, 0xb3, 0x6a,
-	0x41, 0x6e, 0xc2, 0xf8, 0x9e, 0xe7, 0xf4, 0x3a, 0x34, 0x98, 0xa9, 0xf2, 0xd5, 0xe8, 0x72, 0xd6,
-	0x6a, 0x74, 0x9f, 0x93, 0x34, 0xa6, 0x25, 0xfb, 0x71, 0xf1, 0x1c, 0xa0, 0x2a, 0x4b, 0x6c, 0x18,
-	0x73, 0xec, 0x8e, 0x1d, 0x06, 0x5c, 0xc7, 0xa8, 0xdf, 0xb8, 0x39, 0xf4, 0x6b, 0x89, 0x21, 0xba,
-	0xca, 0x99, 0x89, 0x51, 0x23, 0xfe, 0xa3, 0x14, 0xc0, 0xa7, 0x3e, 0xcb, 0x74, 0x84, 0x0e, 0x52,
-	0xbf, 0xf1, 0x95, 0xe1, 0x87, 0x0d, 0xe3, 0xd2, 0x98, 0x94, 0xef, 0x54, 0xe1, 0x8f, 0x28, 0x78,
-	0x93, 0xaf, 0xc3, 0x54, 0xe2, 0x6b, 0x06, 0x33, 0x75, 0xde, 0x3a, 0xcf, 0x66, 0xb5, 0x4e, 0x44,
-	0x15, 0x2f, 0xd2, 0x89, 0x1e, 0x12, 0x60, 0x8a, 0x19, 0xb9, 0x03, 0xd5, 0xc0, 0x6e, 0x51, 0xcb,
-	0xf4, 0x83, 0x99, 0x89, 0xa3, 0x30, 0x3e, 0x23, 0x19, 0x57, 0x9b, 0xb2, 0x18, 0x46, 0x0c, 0xc8,
-	0x1c, 0x40, 0xd7, 0xf4, 0x43, 0x5b, 0xe8, 0xf4, 0x93, 0x5c, 0xbf, 0x9c, 0x3a, 0x3c, 0x98, 0x85,
-	0xf5, 0x08, 0x8a, 0x1a, 0x05, 0xa3, 0x67, 0x65, 0x57, 0xdc, 0x6e, 0x2f, 0x14, 0x3a, 0x48, 0x4d,
-	0xd0, 0x37, 0x23, 0x28, 0x6a, 0x14, 0xe4, 0xd7, 0x0a, 0xf0, 0xc9, 0xf8, 0xb1, 0x7f, 0x90, 0x4d,
-	0x8f, 0x7c, 0x90, 0xcd, 0x1e, 0x1e, 0xcc, 0x7e, 0xb2, 0x39, 0x58, 0x24, 0x3e, 0xa9, 0x3e, 0xe4,
-	0xfd, 0x02, 0x4c, 0xf5, 0xba, 0x2d, 0x33, 0xa4, 0xcd, 0x90, 0x6d, 0x0e, 0xdb, 0xfb, 0x33, 0x67,
-	0x78, 0x15, 0x6f, 0x0d, 0x3f, 0x0b, 0x26, 0xd8, 0xc5, 0x9f, 0x39, 0x09, 0xc7, 0x94, 0x58, 0xe3,
-	0x5d, 0x38, 0xbb, 0x60, 0x59, 0xbd, 0x4e, 0xcf, 0x31, 0x43, 0xcf, 0x7f, 0xd3, 0x76, 0x5b, 0xde,
-	0x43, 0xb2, 0x09, 0xe3, 0x4c, 0x3b, 0xf6, 0x7a, 0xa1, 0x54, 0xa9, 0xe6, 0xb4, 0x4f, 0x1f, 0x6d,
-	0x75, 0xe3, 0xda, 0xb0, 0x7d, 0x25, 0xeb, 0x0c, 0x4b, 0x3d, 0xb9, 0x1f, 0xab, 0xb3, 0x11, 0xb8,
-	0x21, 0x58, 0xa0, 0xe2, 0x65, 0xbc, 0x09, 0x93, 0x0b, 0xbd, 0x70, 0xc7, 0xf3, 0xed, 0xf7, 0x38,
-	0x19, 0x59, 0x86, 0x4a, 0xc8, 0xb5, 0x6b, 0x21, 0xe5, 0xf9, 0xac, 0x0e, 0x26, 0x76, 0x3a, 0x77,
-	0xe8, 0xbe, 0x52, 0x17, 0x85, 0x16, 0x20, 0xb4, 0x6d, 0x51, 0xdc, 0xf8, 0x7e, 0x11, 0xc6, 0x1b,
-	0xa6, 0xb5, 0xeb, 0x6d, 0x6f, 0x93, 0xb7, 0xa0, 0x6a, 0xbb, 0x21, 0xf5, 0xf7, 0x4c, 0x67, 0xc8,
-	0xca, 0xf3, 0x0d, 0xcb, 0x8a, 0xe4, 0x81, 0x11, 0x37, 0x32, 0x0b, 0x95, 0x20, 0xa4, 0xdd, 0x80,
-	0xaf, 0xb7, 0x93, 0x52, 0x19, 0x61, 0x00, 0x14, 0x70, 0x62, 0xc0, 0xd8, 0xb6, 0xc9, 0xb7, 0xd3,
-	0x6c, 0xb9, 0x2c, 0x88, 0xa9, 0x61, 0x99, 0x43, 0x50, 0x62, 0xc8, 0x0a, 0x94, 0x2c, 0xb3, 0x2b,
-	0xd7, 0xbc, 0xe3, 0xd6, 0x8c, 0xaf, 0x72, 0x8b, 0x66, 0x17, 0x19, 0x0f, 0x26, 0xee, 0x5d, 0x3b,
-	0x0c, 0xa9, 0xcf, 0x57, 0x36, 0x29, 0xee, 0x75, 0x0e, 0x41, 0x89, 0x31, 0xfe, 0x56, 0x01, 0x6a,
-	0x0d, 0x33, 0xb0, 0x2d, 0xd6, 0xf0, 0x64, 0x11, 0xca, 0xbd, 0x80, 0xfa, 0xc7, 0x6b, 0x6e, 0xbe,
-	0x6a, 0x6f, 0x06, 0xd4, 0x47, 0x5e, 0x98, 0xdc, 0x83, 0x6a, 0xd7, 0x0c, 0x82, 0x87, 0x9e, 0xdf,
-	0x92, 0x9a, 0xc7, 0x11, 0x19, 0x89, 0x0d, 0xa5, 0x2c, 0x8a, 0x11, 0x13, 0xa3, 0x0e, 0xb1, 0x96,
-	0x6a, 0xfc, 0x61, 0x01, 0xce, 0x35, 0x7a, 0xdb, 0xdb, 0xd4, 0x97, 0xfb, 0x27, 0xb9, 0x33, 0xa1,
-	0x50, 0xf1, 0x69, 0xcb, 0x0e, 0x64, 0xdd, 0x97, 0x86, 0x1e, 0x27, 0xc8, 0xb8, 0xc8, 0x8d, 0x10,
-	0xff, 0x84, 0x1c, 0x80, 0x82, 0x3b, 0xe9, 0x41, 0xed, 0x5d, 0x1a, 0x06, 0xa1, 0x4f, 0xcd, 0x8e,
-	0x7c, 0xbb, 0xdb, 0x43, 0x8b, 0x7a, 0x9d, 0x86, 0x4d, 0xce, 0x49, 0xdf, 0x77, 0x45, 0x40, 0x8c,
-	0x25, 0x19, 0xbf, 0x51, 0x81, 0x89, 0x45, 0xaf, 0xb3, 0x65, 0xbb, 0xb4, 0x75, 0xb3, 0xd5, 0xa6,
-	0xe4, 0x1d, 0x28, 0xd3, 0x56, 0x9b, 0xca, 0xb7, 0x1d, 0x5e, 0xef, 0x62, 0xcc, 0x62, 0xed, 0x91,
-	0x3d, 0x21, 0x67, 0x4c, 0x56, 0x61, 0x6a, 0xdb, 0xf7, 0x3a, 0x62, 0x29, 0xdb, 0xd8, 0xef, 0xca,
-	0x5d, 0x56, 0xe3, 0xa7, 0xd4, 0xbc, 0xb1, 0x9c, 0xc0, 0x3e, 0x3e, 0x98, 0x85, 0xf8, 0x09, 0x53,
-	0x65, 0xc9, 0x5b, 0x30, 0x13, 0x43, 0xa2, 0x39, 0x7d, 0x91, 0x6d, 0x7c, 0xf9, 0x58, 0xa8, 0x34,
-	0xae, 0x1c, 0x1e, 0xcc, 0xce, 0x2c, 0x0f, 0xa0, 0xc1, 0x81, 0xa5, 0xd9, 0x4c, 0x79, 0x26, 0x46,
-	0x8a, 0x75, 0x56, 0x8e, 0x9e, 0x11, 0x2d, 0xe0, 0xdc, 0x42, 0xb0, 0x9c, 0x12, 0x81, 0x7d, 0x42,
-	0xc9, 0x32, 0x4c, 0x84, 0x9e, 0xd6, 0x5e, 0x15, 0xde, 0x5e, 0x86, 0x32, 0x69, 0x6d, 0x78, 0x03,
-	0x5b, 0x2b, 0x51, 0x8e, 0x20, 0x5c, 0x54, 0xcf, 0xa9, 0x96, 0x1a, 0xe3, 0x2d, 0x75, 0xf9, 0xf0,
-	0x60, 0xf6, 0xe2, 0x46, 0x26, 0x05, 0x0e, 0x28, 0x49, 0x7e, 0xa1, 0x00, 0x53, 0x0a, 0x25, 0xdb,
-	0x68, 0x7c, 0x94, 0x6d, 0x44, 0x58, 0x8f, 0xd8, 0x48, 0x08, 0xc0, 0x94, 0x40, 0xa3, 0x01, 0xf5,
-	0x45, 0xaf, 0xd3, 0xf5, 0x69, 0x10, 0xb0, 0xb9, 0xfd, 0xb3, 0x50, 0x0e, 0x59, 0x33, 0x89, 0x0d,
-	0xcc, 0xac, 0xea, 0x82, 0xb2, 0x79, 0xa6, 0x35, 0x52, 0xde, 0x46, 0x9c, 0xd8, 0xf8, 0xc1, 0x38,
-	0xd4, 0xa2, 0xd5, 0x92, 0x3c, 0x07, 0x15, 0x6e, 0xf0, 0x92, 0x3c, 0x22, 0x35, 0x88, 0xdb, 0xc5,
-	0x50, 0xe0, 0xc8, 0xf3, 0x30, 0x6e, 0x79, 0x9d, 0x8e, 0xe9, 0xb6, 0xb8, 0x11, 0xb3, 0x26, 0xd6,
-	0x9e, 0x45, 0x01, 0x42, 0x85, 0x23, 0x57, 0xa0, 0x6c, 0xfa, 0x6d, 0x61, 0x4f, 0xac, 0x89, 0x39,
-	0x6d, 0xc1, 0x6f, 0x07, 0xc8, 0xa1, 0xe4, 0x0b, 0x50, 0xa2, 0xee, 0xde, 0x4c, 0x79, 0xb0, 0x7a,
-	0x79, 0xd3, 0xdd, 0xbb, 0x6f, 0xfa, 0x8d, 0xba, 0xac, 0x43, 0xe9, 0xa6, 0xbb, 0x87, 0xac, 0x0c,
-	0x59, 0x85, 0x71, 0xea, 0xee, 0xb1, 0xfe, 0x23, 0x0d, 0x7d, 0x9f, 0x1a, 0x50, 0x9c, 0x91, 0xc8,
-	0x9d, 0x56, 0xa4, 0xa4, 0x4a, 0x30, 0x2a, 0x16, 0xe4, 0xab, 0x30, 0x21, 0xf4, 0xd5, 0x35, 0xf6,
-	0x5d, 0xd9, 0xc6, 0x96, 0xb1, 0x9c, 0x1d, 0xac, 0xf0, 0x72, 0xba, 0xd8, 0xb0, 0xaa, 0x01, 0x03,
-	0x4c, 0xb0, 0x22, 0x5f, 0x85, 0x9a, 0xb2, 0xc3, 0xa8, 0xde, 0x91, 0x69, 0x93, 0x54, 0xc6, 0x1b,
-	0xa4, 0x0f, 0x7a, 0xb6, 0x4f, 0x3b, 0xd4, 0x0d, 0x83, 0xc6, 0x59, 0x65, 0xa5, 0x52, 0xd8, 0x00,
-	0x63, 0x6e, 0x64, 0xab, 0xdf, 0xb8, 0x2a, 0x2c, 0x83, 0xcf, 0x0d, 0x58, 0x19, 0x86, 0xb0, 0xac,
-	0x7e, 0x03, 0xa6, 0x23, 0xeb, 0xa7, 0x34, 0xa0, 0x09, 0x5b, 0xe1, 0xe7, 0x58, 0xf1, 0x95, 0x24,
-	0xea, 0xf1, 0xc1, 0xec, 0xb3, 0x19, 0x26, 0xb4, 0x98, 0x00, 0xd3, 0xcc, 0xc8, 0x7b, 0x30, 0xe5,
-	0x53, 0xb3, 0x65, 0xbb, 0x34, 0x08, 0xd6, 0x7d, 0x6f, 0x2b, 0xbf, 0xf2, 0xce, 0xb9, 0x88, 0xa1,
-	0x83, 0x09, 0xce, 0x98, 0x92, 0x44, 0x1e, 0xc2, 0xa4, 0x63, 0xef, 0xd1, 0x58, 0x74, 0x7d, 0x24,
-	0xa2, 0xcf, 0x1e, 0x1e, 0xcc, 0x4e, 0xae, 0xea, 0x8c, 0x31, 0x29, 0x87, 0x29, 0x60, 0x5d, 0xcf,
-	0x0f, 0x95, 0x86, 0xff, 0xa9, 0x27, 0x6a, 0xf8, 0xeb, 0x9e, 0x1f, 0xc6, 0x83, 0x90, 0x3d, 0x05,
-	0x28, 0x8a, 0x1b, 0x7f, 0xaf, 0x02, 0xfd, 0xfb, 0xe0, 0x64, 0x8f, 0x2b, 0x8c, 0xba, 0xc7, 0xa5,
-	0x7b, 0x83, 0x58, 0xbf, 0x5e, 0x91, 0xc5, 0x46, 0xd0, 0x23, 0x32, 0x7a, 0x75, 0x69, 0xd4, 0xbd,
-	0xfa, 0xa9, 0x99, 0x78, 0xfa, 0xbb, 0xff, 0xd8, 0x87, 0xd7, 0xfd, 0xc7, 0x4f, 0xa7, 0xfb, 0x1b,
-	0xdf, 0x2b, 0xc3, 0xd4, 0x92, 0x49, 0x3b, 0x9e, 0xfb, 0x81, 0xa6, 0x90, 0xc2, 0x53, 0x61, 0x0a,
-	0xb9, 0x0e, 0x55, 0x9f, 0x76, 0x1d, 0xdb, 0x32, 0xc5, 0x2e, 0x44, 0x7a, 0x69, 0x50, 0xc2, 0x30,
-	0xc2, 0x0e, 0x30, 0x81, 0x95, 0x9e, 0x4a, 0x13, 0x58, 0xf9, 0xc3, 0x37, 0x81, 0x19, 0xbf, 0x50,
-	0x04, 0xae, 0x1e, 0x93, 0x6b, 0x50, 0x66, 0xaa, 0x5f, 0xda, 0xf0, 0xca, 0x47, 0x0b, 0xc7, 0x90,
-	0xcb, 0x50, 0x0c, 0x3d, 0x39, 0xdd, 0x80, 0xc4, 0x17, 0x37, 0x3c, 0x2c, 0x86, 0x1e, 0x79, 0x0f,
-	0xc0, 0xf2, 0xdc, 0x96, 0xad, 0x9c, 0x97, 0xf9, 0x5e, 0x6c, 0xd9, 0xf3, 0x1f, 0x9a, 0x7e, 0x6b,
-	0x31, 0xe2, 0x28, 0x8c, 0x20, 0xf1, 0x33, 0x6a, 0xd2, 0xc8, 0xab, 0x30, 0xe6, 0xb9, 0xcb, 0x3d,
-	0xc7, 0xe1, 0x0d, 0x5a, 0x6b, 0x7c, 0x9a, 0xed, 0x07, 0xef, 0x71, 0xc8, 0xe3, 0x83, 0xd9, 0x4b,
-	0x62, 0x57, 0xc5, 0x9e, 0xde, 0xf4, 0xed, 0xd0, 0x76, 0xdb, 0x91, 0x4d, 0x40, 0x16, 0x33, 0x7e,
-	0xb1, 0x00, 0xf5, 0x65, 0xfb, 0x11, 0x6d, 0x49, 0x33, 0x00, 0xc2, 0x98, 0x43, 0xdd, 0x76, 0xb8,
-	0x33, 0xe4, 0x46, 0x5a, 0x98, 0xc6, 0x38, 0x07, 0x94, 0x9c, 0xc8, 0x3c, 0xd4, 0xc4, 0x9e, 0xc7,
-	0x76, 0xdb, 0xbc, 0x0d, 0xab, 0xf1, 0x4c, 0xdf, 0x54, 0x08, 0x8c, 0x69, 0x8c, 0x7d, 0x38, 0xdb,
-	0xd7, 0x0c, 0xa4, 0x05, 0xe5, 0xd0, 0x6c, 0xab, 0x45, 0x65, 0x79, 0xe8, 0x06, 0xde, 0x30, 0xdb,
-	0x5a, 0xe3, 0x72, 0xad, 0x70, 0xc3, 0x64, 0x5a, 0x21, 0xe3, 0x6e, 0xfc, 0xfd, 0x32, 0x8c, 0xdd,
-	0x6a, 0x36, 0x17, 0xd6, 0x57, 0xc8, 0x4b, 0x50, 0x97, 0x6e, 0xc7, 0xbb, 0xb1, 0x55, 0x3e, 0xf2,
-	0x3a, 0x37, 0x63, 0x14, 0xea, 0x74, 0x4c, 0x83, 0xf5, 0xa9, 0xe9, 0x74, 0x64, 0x6f, 0x89, 0x16,
-	0x4f, 0x64, 0x40, 0x14, 0x38, 0x62, 0xc2, 0x14, 0xdb, 0x58, 0xbb, 0x66, 0x87, 0x8a, 0x4d, 0xb3,
-	0xec, 0x37, 0x47, 0xdc, 0x56, 0xf3, 0x19, 0x76, 0x33, 0xc1, 0x00, 0x53, 0x0c, 0xc9, 0x2b, 0x50,
-	0x35, 0x7b, 0xe1, 0x0e, 0xdf, 0xb7, 0x88, 0xce, 0x71, 0x85, 0x7b, 0x65, 0x25, 0xec, 0xf1, 0xc1,
-	0xec, 0xc4, 0x1d, 0x6c, 0xbc, 0xa4, 0x9e, 0x31, 0xa2, 0x66, 0x95, 0x53, 0x1b, 0x75, 0x59, 0xb9,
-	0xca, 0xb1, 0x2b, 0xb7, 0x9e, 0x60, 0x80, 0x29, 0x86, 0xe4, 0x6d, 0x98, 0xd8, 0xa5, 0xfb, 0xa1,
-	0xb9, 0x25, 0x05, 0x8c, 0x1d, 0x47, 0xc0, 0x19, 0xa6, 0xf5, 0xde, 0xd1, 0x8a, 0x63, 0x82, 0x19,
-	0x09, 0xe0, 0xfc, 0x2e, 0xf5, 0xb7, 0xa8, 0xef, 0xc9, 0x4d, 0xbf, 0x14, 0x32, 0x7e, 0x1c, 0x21,
-	0x33, 0x87, 0x07, 0xb3, 0xe7, 0xef, 0x64, 0xb0, 0xc1, 0x4c, 0xe6, 0xc6, 0xff, 0x2a, 0xc2, 0xf4,
-	0x2d, 0x11, 0xf7, 0xe1, 0xf9, 0x62, 0xe9, 0x25, 0x97, 0xa0, 0xe4, 0x77, 0x7b, 0xbc, 0xe7, 0x94,
-	0x84, 0x21, 0x07, 0xd7, 0x37, 0x91, 0xc1, 0xc8, 0x5b, 0x50, 0x6d, 0xc9, 0x31, 0x23, 0x6d, 0x0e,
-	0x43, 0x99, 0xac, 0xd4, 0x13, 0x46, 0xdc, 0xd8, 0xe6, 0xa8, 0x13, 0xb4, 0x9b, 0xf6, 0x7b, 0x54,
-	0x6e, 0xc3, 0xf9, 0xe6, 0x68, 0x4d, 0x80, 0x50, 0xe1, 0xd8, 0xb2, 0xb2, 0x4b, 0xf7, 0xc5, 0x26,
-	0xb4, 0x1c, 0x2f, 0x2b, 0x77, 0x24, 0x0c, 0x23, 0x2c, 0x99, 0x55, 0x3e, 0x59, 0xd6, 0x0b, 0xca,
-	0xc2, 0x80, 0x72, 0x9f, 0x01, 0xa4, 0x7b, 0x96, 0xcd, 0x19, 0xd2, 0x28, 0x35, 0x36, 0xfc, 0x9c,
-	0x91, 0x34, 0x62, 0x91, 0x9f, 0x81, 0x1a, 0x67, 0xde, 0x70, 0xbc, 0x2d, 0xfe, 0xe1, 0x6a, 0xc2,
-	0x94, 0x72, 0x5f, 0x01, 0x31, 0xc6, 0x1b, 0x7f, 0x5c, 0x84, 0x8b, 0xb7, 0x68, 0x28, 0x96, 0xf5,
-	0x25, 0xda, 0x75, 0xbc, 0x7d, 0xa6, 0x50, 0x22, 0x7d, 0x40, 0x5e, 0x03, 0xb0, 0x83, 0xad, 0xe6,
-	0x9e, 0xb5, 0x11, 0x6f, 0x4c, 0xaf, 0xc9, 0x21, 0x09, 0x2b, 0xcd, 0x86, 0xc4, 0x3c, 0x4e, 0x3c,
-	0xa1, 0x56, 0x26, 0xde, 0x91, 0x16, 0x9f, 0xb0, 0x23, 0x6d, 0x02, 0x74, 0x63, 0xb5, 0xb4, 0xc4,
-	0x29, 0x3f, 0xab, 0xc4, 0x1c, 0x47, 0x23, 0xd5, 0xd8, 0xe4, 0x51, 0x14, 0x5d, 0x38, 0xd3, 0xa2,
-	0xdb, 0x66, 0xcf, 0x09, 0x23, 0x55, 0x5a, 0x0e, 0xe2, 0xa3, 0x6b, 0xe3, 0x51, 0x4c, 0xca, 0x52,
-	0x8a, 0x13, 0xf6, 0xf1, 0x36, 0xfe, 0x41, 0x09, 0x2e, 0xdf, 0xa2, 0x61, 0x64, 0xe8, 0x92, 0xb3,
-	0x63, 0xb3, 0x4b, 0x2d, 0xf6, 0x15, 0xde, 0x2f, 0xc0, 0x98, 0x63, 0x6e, 0x51, 0x87, 0x4d, 0xdf,
-	0xec, 0x6d, 0xde, 0x19, 0x7a, 0xfa, 0x1e, 0x2c, 0x65, 0x6e, 0x95, 0x4b, 0x10, 0x61, 0x47, 0x53,
-	0xb2, 0xf2, 0x63, 0x02, 0x88, 0x52, 0x3c, 0x9b, 0xd4, 0x2d, 0xa7, 0x17, 0x84, 0x62, 0x6b, 0x23,
-	0x15, 0xaa, 0x68, 0x52, 0x5f, 0x8c, 0x51, 0xa8, 0xd3, 0x91, 0x1b, 0x00, 0x96, 0x63, 0x53, 0x37,
-	0xe4, 0xa5, 0xc4, 0xb8, 0x22, 0xea, 0xfb, 0x2e, 0x46, 0x18, 0xd4, 0xa8, 0x98, 0xa8, 0x8e, 0xe7,
-	0xda, 0xa1, 0x27, 0x44, 0x95, 0x93, 0xa2, 0xd6, 0x62, 0x14, 0xea, 0x74, 0xbc, 0x18, 0x0d, 0x7d,
-	0xdb, 0x0a, 0x78, 0xb1, 0x4a, 0xaa, 0x58, 0x8c, 0x42, 0x9d, 0xee, 0xf2, 0x17, 0xa0, 0xae, 0xbd,
-	0xff, 0xb1, 0x42, 0x2b, 0x7e, 0xb5, 0x06, 0x57, 0x13, 0xcd, 0x1a, 0x9a, 0x21, 0xdd, 0xee, 0x39,
-	0x4d, 0x1a, 0xaa, 0x0f, 0x38, 0xe4, 0x5a, 0xf8, 0x97, 0xe3, 0xef, 0x2e, 0xa2, 0xcd, 0xac, 0xd1,
-	0x7c, 0xf7, 0xbe, 0x0a, 0x1e, 0xe9, 0xdb, 0xcf, 0x43, 0xcd, 0x35, 0xc3, 0x80, 0x0f, 0x5c, 0x39,
-	0x46, 0x23, 0x3d, 0xe4, 0xae, 0x42, 0x60, 0x4c, 0x43, 0xd6, 0xe1, 0xbc, 0x6c, 0xe2, 0x9b, 0x8f,
-	0xd8, 0xa6, 0x97, 0xfa, 0xa2, 0xac, 0x5c, 0x4e, 0x65, 0xd9, 0xf3, 0x6b, 0x19, 0x34, 0x98, 0x59,
-	0x92, 0xac, 0xc1, 0x39, 0x4b, 0x44, 0xe0, 0x50, 0xc7, 0x33, 0x5b, 0x8a, 0xa1, 0xb0, 0x2b, 0x46,
-	0x7b, 0x83, 0xc5, 0x7e, 0x12, 0xcc, 0x2a, 0x97, 0xee, 0xcd, 0x63, 0x43, 0xf5, 0xe6, 0xf1, 0x61,
-	0x7a, 0x73, 0x75, 0xb8, 0xde, 0x5c, 0x3b, 0x5a, 0x6f, 0x66, 0x2d, 0xcf, 0x83, 0x3d, 0x7c, 0xa6,
-	0x9e, 0x88, 0x15, 0x56, 0x0b, 0xf0, 0x8a, 0x5a, 0xbe, 0x99, 0x41, 0x83, 0x99, 0x25, 0xc9, 0x16,
-	0x5c, 0x16, 0xf0, 0x9b, 0xae, 0xe5, 0xef, 0x77, 0xd9, 0xc2, 0xa3, 0xf1, 0xad, 0x27, 0x0c, 0xbb,
-	0x97, 0x9b, 0x03, 0x29, 0xf1, 0x09, 0x5c, 0xc8, 0x97, 0x60, 0x52, 0x7c, 0xa5, 0x35, 0xb3, 0xcb,
-	0xd9, 0x8a, 0x70, 0xaf, 0x0b, 0x92, 0xed, 0xe4, 0xa2, 0x8e, 0xc4, 0x24, 0x2d, 0x59, 0x80, 0xe9,
-	0xee, 0x9e, 0xc5, 0xfe, 0xae, 0x6c, 0xdf, 0xa5, 0xb4, 0x45, 0x5b, 0xdc, 0x69, 0x5a, 0x6b, 0x3c,
-	0xa3, 0xcc, 0x1b, 0xeb, 0x49, 0x34, 0xa6, 0xe9, 0xc9, 0x2b, 0x30, 0x11, 0x84, 0xa6, 0x1f, 0x4a,
-	0x4b, 0xe8, 0xcc, 0x94, 0x08, 0x87, 0x53, 0x86, 0xc2, 0xa6, 0x86, 0xc3, 0x04, 0x65, 0xe6, 0x7a,
-	0x31, 0x7d, 0x72, 0xeb, 0x45, 0x9e, 0xd9, 0xea, 0x9f, 0x16, 0xe1, 0xda, 0x2d, 0x1a, 0xae, 0x79,
-	0xae, 0xb4, 0x45, 0x67, 0x2d, 0xfb, 0x47, 0x32, 0x23, 0x27, 0x17, 0xed, 0xe2, 0x48, 0x17, 0xed,
-	0xd2, 0x88, 0x16, 0xed, 0xf2, 0x09, 0x2e, 0xda, 0xff, 0xb0, 0x08, 0xcf, 0x24, 0x5a, 0x72, 0xdd,
-	0x6b, 0xa9, 0x09, 0xff, 0xe3, 0x06, 0x3c, 0x42, 0x03, 0x3e, 0x16, 0x7a, 0x27, 0xf7, 0x26, 0xa6,
-	0x34, 0x9e, 0xef, 0xa6, 0x35, 0x9e, 0xb7, 0xf3, 0xac, 0x7c, 0x19, 0x12, 0x8e, 0xb4, 0xe2, 0xbd,
-	0x0e, 0xc4, 0x97, 0xbe, 0xcf, 0xd8, 0x9e, 0x2b, 0x95, 0x9e, 0x28, 0xde, 0x16, 0xfb, 0x28, 0x30,
-	0xa3, 0x14, 0x69, 0xc2, 0x85, 0x80, 0xba, 0xa1, 0xed, 0x52, 0x27, 0xc9, 0x4e, 0x68, 0x43, 0xcf,
-	0x4a, 0x76, 0x17, 0x9a, 0x59, 0x44, 0x98, 0x5d, 0x36, 0xcf, 0x3c, 0xf0, 0x3b, 0xc0, 0x55, 0x4e,
-	0xd1, 0x34, 0x23, 0xd3, 0x58, 0xde, 0x4f, 0x6b, 0x2c, 0xef, 0xe4, 0xff, 0x6e, 0xc3, 0x69, 0x2b,
-	0x37, 0x00, 0xf8, 0x57, 0xd0, 0xd5, 0x95, 0x68, 0x91, 0xc6, 0x08, 0x83, 0x1a, 0x15, 0x5b, 0x80,
-	0x54, 0x3b, 0xeb, 0x9a, 0x4a, 0xb4, 0x00, 0x35, 0x75, 0x24, 0x26, 0x69, 0x07, 0x6a, 0x3b, 0x95,
-	0xa1, 0xb5, 0x9d, 0xd7, 0x81, 0x24, 0x2c, 0x6f, 0x82, 0xdf, 0x58, 0x32, 0xdc, 0x7b, 0xa5, 0x8f,
-	0x02, 0x33, 0x4a, 0x0d, 0xe8, 0xca, 0xe3, 0xa3, 0xed, 0xca, 0xd5, 0xe1, 0xbb, 0x32, 0x79, 0x07,
-	0x2e, 0x71, 0x51, 0xb2, 0x7d, 0x92, 0x8c, 0x85, 0xde, 0xf3, 0x29, 0xc9, 0xf8, 0x12, 0x0e, 0x22,
-	0xc4, 0xc1, 0x3c, 0xd8, 0xf7, 0xb1, 0x7c, 0xda, 0x62, 0xc2, 0x4d, 0x67, 0xb0, 0x4e, 0xb4, 0x98,
-	0x41, 0x83, 0x99, 0x25, 0x59, 0x17, 0x0b, 0x59, 0x37, 0x34, 0xb7, 0x1c, 0xda, 0x92, 0xe1, 0xee,
-	0x51, 0x17, 0xdb, 0x58, 0x6d, 0x4a, 0x0c, 0x6a, 0x54, 0x59, 0x6a, 0xca, 0xc4, 0x31, 0xd5, 0x94,
-	0x5b, 0xdc, 0x4c, 0xbd, 0x9d, 0xd0, 0x86, 0xa4, 0xae, 0x13, 0x1d, 0x60, 0x58, 0x4c, 0x13, 0x60,
-	0x7f, 0x19, 0xae, 0x25, 0x5a, 0xbe, 0xdd, 0x0d, 0x83, 0x24, 0xaf, 0xa9, 0x94, 0x96, 0x98, 0x41,
-	0x83, 0x99, 0x25, 0x99, 0x7e, 0xbe, 0x43, 0x4d, 0x27, 0xdc, 0x49, 0x32, 0x9c, 0x4e, 0xea, 0xe7,
-	0xb7, 0xfb, 0x49, 0x30, 0xab, 0x5c, 0xe6, 0x82, 0x74, 0xe6, 0xe9, 0x54, 0xab, 0x7e, 0xb7, 0x04,
-	0xcf, 0xde, 0xa2, 0xe2, 0x04, 0x83, 0xdb, 0x5e, 0xb7, 0xbb, 0xd4, 0xb1, 0x5d, 0xaa, 0xd5, 0x88,
-	0xfc, 0x85, 0x02, 0x4c, 0x08, 0xbb, 0x88, 0x3c, 0x7b, 0x90, 0xd7, 0x3f, 0x92, 0x11, 0xf3, 0x13,
-	0x2b, 0xab, 0xc2, 0x1a, 0x23, 0x77, 0x42, 0x09, 0xb9, 0x1f, 0x5b, 0x64, 0x8e, 0xa2, 0x9b, 0x7c,
-	0xa7, 0x04, 0x97, 0xd8, 0xf7, 0x54, 0x11, 0x89, 0x1f, 0x9b, 0xc5, 0x3e, 0x84, 0x8f, 0xf0, 0x2b,
-	0x15, 0x38, 0x77, 0x8b, 0x86, 0x7d, 0xda, 0xf5, 0xff, 0xa7, 0xcd, 0xbf, 0x06, 0xe7, 0xe2, 0x08,
-	0xd9, 0x66, 0xe8, 0xf9, 0x42, 0x37, 0x4b, 0x59, 0x3f, 0x9a, 0xfd, 0x24, 0x98, 0x55, 0x8e, 0x7c,
-	0x15, 0x9e, 0x09, 0xc4, 0x74, 0x25, 0xec, 0xed, 0xc2, 0x38, 0xa4, 0x1d, 0x87, 0x53, 0x11, 0x48,
-	0xcf, 0x34, 0xb3, 0xc9, 0x70, 0x50, 0x79, 0xf2, 0x2d, 0x98, 0xe8, 0xca, 0x29, 0x90, 0x7d, 0xb3,
-	0xdc, 0x91, 0x55, 0xeb, 0x1a, 0xb3, 0x78, 0x8e, 0xd3, 0xa1, 0x98, 0x10, 0x98, 0xd9, 0x53, 0xab,
-	0x27, 0xd8, 0x53, 0xff, 0x5b, 0x11, 0xc6, 0x6f, 0xf9, 0x5e, 0xaf, 0xdb, 0xd8, 0x27, 0x6d, 0x18,
-	0x7b, 0xc8, 0xbd, 0x81, 0x72, 0x86, 0x1f, 0xfe, 0x94, 0x89, 0x70, 0x2a, 0xc6, 0x2a, 0xae, 0x78,
-	0x46, 0xc9, 0x9e, 0x75, 0xe2, 0x5d, 0xba, 0x4f, 0x5b, 0xd2, 0x29, 0x18, 0x75, 0xe2, 0x3b, 0x0c,
-	0x88, 0x02, 0x47, 0x3a, 0x30, 0x6d, 0x3a, 0x8e, 0xf7, 0x90, 0xb6, 0x56, 0xcd, 0x90, 0x3b, 0xf2,
-	0xa5, 0xaf, 0xec, 0xb8, 0x6e, 0x06, 0x1e, 0x9d, 0xb1, 0x90, 0x64, 0x85, 0x69, 0xde, 0xe4, 0x5d,
-	0x18, 0x0f, 0x42, 0xcf, 0x57, 0xca, 0x73, 0xae, 0x13, 0x40, 0x8d, 0x37, 0x9a, 0x82, 0x95, 0xf0,
-	0xc1, 0xc8, 0x07, 0x54, 0x02, 0x8c, 0x5f, 0x2e, 0x00, 0xdc, 0xde, 0xd8, 0x58, 0x97, 0xee, 0xa2,
-	0x16, 0x94, 0xcd, 0x5e, 0xe4, 0x79, 0x1d, 0xde, 0xc3, 0x99, 0x08, 0xb8, 0x96, 0x71, 0x6f, 0xbd,
-	0x70, 0x07, 0x39, 0x77, 0xf2, 0xd3, 0x30, 0x2e, 0x37, 0x3c, 0xb2, 0xd9, 0xa3, 0x00, 0x11, 0xb9,
-	0x12, 0xa3, 0xc2, 0x1b, 0xbf, 0x5e, 0x04, 0x58, 0x69, 0x39, 0xb4, 0xa9, 0x0e, 0x06, 0xd5, 0xc2,
-	0x1d, 0x9f, 0x06, 0x3b, 0x9e, 0xd3, 0x1a, 0xd2, 0x3d, 0xcc, 0x7d, 0x38, 0x1b, 0x8a, 0x09, 0xc6,
-	0xfc, 0x48, 0x0b, 0x26, 0x82, 0x90, 0x76, 0x55, 0x0c, 0xf6, 0x90, 0x4e, 0xb1, 0x33, 0xc2, 0xce,
-	0x15, 0xf3, 0xc1, 0x04, 0x57, 0x62, 0x42, 0xdd, 0x76, 0x2d, 0x31, 0x40, 0x1a, 0xfb, 0x43, 0x76,
-	0xa4, 0x69, 0xb6, 0x83, 0x5c, 0x89, 0xd9, 0xa0, 0xce, 0xd3, 0xf8, 0x4b, 0x05, 0x98, 0xe6, 0xf2,
-	0x58, 0x35, 0x84, 0x8a, 0x43, 0x1e, 0x42, 0xdd, 0x8a, 0x83, 0x1f, 0xe5, 0xbb, 0x2d, 0xe5, 0x08,
-	0x7e, 0x88, 0x78, 0x89, 0xca, 0x68, 0x00, 0xd4, 0x25, 0x19, 0x7f, 0x50, 0x84, 0x8b, 0xa9, 0xca,
-	0xc8, 0xaf, 0x4c, 0xfe, 0x6c, 0xdf, 0xe1, 0xf3, 0x3f, 0x7d, 0xb4, 0x76, 0x10, 0x67, 0x97, 0xd7,
-	0x68, 0x68, 0xc6, 0x9b, 0x85, 0x18, 0xa6, 0x9d, 0x38, 0xef, 0x41, 0x39, 0x60, 0x93, 0xa7, 0x78,
-	0xdd, 0xe6, 0xd0, 0xaf, 0x9b, 0xfd, 0x02, 0x7c, 0x2a, 0x8d, 0x62, 0x35, 0xf8, 0x14, 0xca, 0xc5,
-	0x91, 0x6f, 0xc2, 0x58, 0x10, 0x9a, 0x61, 0x4f, 0xcd, 0x13, 0x9b, 0xa3, 0x16, 0xcc, 0x99, 0xc7,
-	0x93, 0x9a, 0x78, 0x46, 0x29, 0xd4, 0xf8, 0x83, 0x02, 0x5c, 0xce, 0x2e, 0xb8, 0x6a, 0x07, 0x21,
-	0xf9, 0x33, 0x7d, 0xcd, 0x7e, 0xc4, 0xee, 0xc7, 0x4a, 0xf3, 0x46, 0x8f, 0x0e, 0xdd, 0x28, 0x88,
-	0xd6, 0xe4, 0x21, 0x54, 0xec, 0x90, 0x76, 0x94, 0xf1, 0xe2, 0xde, 0x88, 0x5f, 0x5d, 0xd3, 0x33,
-	0x98, 0x14, 0x14, 0xc2, 0x8c, 0xef, 0x15, 0x07, 0xbd, 0x32, 0x5f, 0xcb, 0x9c, 0x64, 0x1c, 0xff,
-	0x9d, 0x7c, 0x71, 0xfc, 0xc9, 0x0a, 0xf5, 0x87, 0xf3, 0xff, 0xb9, 0xfe, 0x70, 0xfe, 0x7b, 0xf9,
-	0xc3, 0xf9, 0x53, 0xcd, 0x30, 0x30, 0xaa, 0xff, 0xc7, 0x25, 0xb8, 0xf2, 0xa4, 0x6e, 0xc3, 0x16,
-	0x57, 0xd9, 0x3b, 0xf3, 0x2e, 0xae, 0x4f, 0xee, 0x87, 0xe4, 0x06, 0x54, 0xba, 0x3b, 0x66, 0xa0,
-	0x34, 0xc4, 0x2b, 0x51, 0x10, 0x27, 0x03, 0x3e, 0x66, 0x33, 0x18, 0xd7, 0x2c, 0xf9, 0x23, 0x0a,
-	0x52, 0xb6, 0x36, 0x74, 0x68, 0x10, 0xc4, 0x06, 0xa7, 0x68, 0x6d, 0x58, 0x13, 0x60, 0x54, 0x78,
-	0x12, 0xc2, 0x98, 0xf0, 0x5f, 0xc8, 0x65, 0x72, 0xb4, 0xdb, 0xc0, 0xe8, 0xa5, 0xe4, 0x06, 0x50,
-	0xca, 0x22, 0x73, 0x32, 0xc2, 0xbc, 0x92, 0xb0, 0x21, 0x95, 0x33, 0x94, 0x65, 0x4e, 0x47, 0x5e,
-	0x07, 0xe2, 0x6d, 0x71, 0x8f, 0x4d, 0x4b, 0x06, 0x67, 0xb0, 0xf9, 0x77, 0x8c, 0x07, 0x64, 0x44,
-	0x56, 0xa3, 0x7b, 0x7d, 0x14, 0x98, 0x51, 0xca, 0xf8, 0x17, 0x55, 0xb8, 0x98, 0xdd, 0x1f, 0x58,
-	0xbb, 0xed, 0x51, 0x9f, 0xcf, 0xed, 0x85, 0x64, 0xbb, 0xdd, 0x17, 0x60, 0x54, 0xf8, 0x8f, 0x74,
-	0x38, 0xdf, 0xaf, 0x14, 0xe0, 0x92, 0x2f, 0x1d, 0x90, 0xa7, 0x11, 0xd2, 0xf7, 0xac, 0xb0, 0x95,
-	0x0d, 0x10, 0x88, 0x83, 0xeb, 0x42, 0xfe, 0x76, 0x01, 0x66, 0x3a, 0x29, 0x23, 0xda, 0x09, 0x1e,
-	0x0a, 0xe6, 0x27, 0x5d, 0xd6, 0x06, 0xc8, 0xc3, 0x81, 0x35, 0x21, 0xdf, 0x82, 0x7a, 0x97, 0xf5,
-	0x8b, 0x20, 0xa4, 0xae, 0xa5, 0xc2, 0x6f, 0x87, 0x1f, 0x49, 0xeb, 0x31, 0xaf, 0xe8, 0x50, 0x20,
-	0xd7, 0x0f, 0x34, 0x04, 0xea, 0x12, 0x9f, 0xf2, 0x53, 0xc0, 0xd7, 0xa1, 0x1a, 0xd0, 0x30, 0xb4,
-	0xdd, 0xb6, 0xd8, 0xfc, 0xd4, 0xc4, 0x58, 0x69, 0x4a, 0x18, 0x46, 0x58, 0xf2, 0x33, 0x50, 0xe3,
-	0xfe, 0xcc, 0x05, 0xbf, 0x1d, 0xcc, 0xd4, 0xf8, 0x79, 0x8f, 0x49, 0x11, 0x5e, 0x28, 0x81, 0x18,
-	0xe3, 0xc9, 0xe7, 0x60, 0x62, 0x8b, 0x0f, 0x5f, 0x69, 0xc7, 0x12, 0x06, 0x54, 0xae, 0x3a, 0x36,
-	0x34, 0x38, 0x26, 0xa8, 0xc8, 0x0d, 0x00, 0x1a, 0x39, 0x7d, 0xd3, 0xc6, 0xd2, 0xd8, 0x1d, 0x8c,
-	0x1a, 0x15, 0x79, 0x16, 0x4a, 0xa1, 0x13, 0x70, 0x03, 0x69, 0x35, 0xde, 0x0f, 0x6f, 0xac, 0x36,
-	0x91, 0xc1, 0x8d, 0x3f, 0x2e, 0xc0, 0x74, 0xea, 0xc0, 0x18, 0x2b, 0xd2, 0xf3, 0x1d, 0x39, 0x8d,
-	0x44, 0x45, 0x36, 0x71, 0x15, 0x19, 0x9c, 0xbc, 0x23, 0xf7, 0x08, 0xc5, 0x9c, 0xe9, 0x82, 0xee,
-	0x9a, 0x61, 0xc0, 0x36, 0x05, 0x7d, 0xdb, 0x03, 0xee, 0x43, 0x8e, 0xeb, 0x23, 0xd7, 0x01, 0xcd,
-	0x87, 0x1c, 0xe3, 0x30, 0x41, 0x99, 0xb2, 0x26, 0x97, 0x8f, 0x62, 0x4d, 0x36, 0x7e, 0xb1, 0xa8,
-	0xb5, 0x80, 0xdc, 0x66, 0x7c, 0x40, 0x0b, 0xbc, 0xc0, 0x16, 0xd0, 0x68, 0x71, 0xaf, 0xe9, 0xeb,
-	0x1f, 0x5f, 0x8c, 0x25, 0x96, 0xbc, 0x29, 0xda, 0xbe, 0x94, 0x33, 0xd3, 0xc0, 0xc6, 0x6a, 0x53,
-	0x84, 0xee, 0xa9, 0xaf, 0x16, 0x7d, 0x82, 0xf2, 0x09, 0x7d, 0x02, 0xe3, 0x9f, 0x95, 0xa0, 0xfe,
-	0xba, 0xb7, 0xf5, 0x11, 0x89, 0x4f, 0xcf, 0x5e, 0xa6, 0x8a, 0x1f, 0xe2, 0x32, 0xb5, 0x09, 0xcf,
-	0x84, 0xa1, 0xd3, 0xa4, 0x96, 0xe7, 0xb6, 0x82, 0x85, 0xed, 0x90, 0xfa, 0xcb, 0xb6, 0x6b, 0x07,
-	0x3b, 0xb4, 0x25, 0x7d, 0x95, 0x9f, 0x3c, 0x3c, 0x98, 0x7d, 0x66, 0x63, 0x63, 0x35, 0x8b, 0x04,
-	0x07, 0x95, 0xe5, 0xd3, 0x86, 0x38, 0x70, 0xcc, 0x4f, 0xbf, 0xc9, 0x80, 0x2e, 0x31, 0x6d, 0x68,
-	0x70, 0x4c, 0x50, 0x19, 0xff, 0xb6, 0x08, 0xb5, 0x28, 0x11, 0x0c, 0x79, 0x1e, 0xc6, 0xb7, 0x7c,
-	0x6f, 0x97, 0xfa, 0xc2, 0x2d, 0x2c, 0x4f, 0xae, 0x35, 0x04, 0x08, 0x15, 0x8e, 0x3c, 0x07, 0x95,
-	0xd0, 0xeb, 0xda, 0x56, 0xda, 0xba, 0xb7, 0xc1, 0x80, 0x28, 0x70, 0x7c, 0x20, 0xf0, 0x98, 0x55,
-	0xfe, 0x56, 0x55, 0x6d, 0x20, 0x70, 0x28, 0x4a, 0xac, 0x1a, 0x08, 0xe5, 0x91, 0x0f, 0x84, 0x17,
-	0x22, 0x15, 0xb0, 0x92, 0x1c, 0x89, 0x29, 0xa5, 0xed, 0x6d, 0x28, 0x07, 0x66, 0xe0, 0xc8, 0xe5,
-	0x2d, 0x47, 0x42, 0x91, 0x85, 0xe6, 0xaa, 0x4c, 0x28, 0xb2, 0xd0, 0x5c, 0x45, 0xce, 0xd4, 0xf8,
-	0xf5, 0x12, 0xd4, 0x45, 0xfb, 0x8a, 0xd9, 0x63, 0x94, 0x2d, 0xfc, 0x2a, 0x8f, 0xe7, 0x09, 0x7a,
-	0x1d, 0xea, 0x73, 0xdb, 0x98, 0x9c, 0x0c, 0x75, 0x27, 0x55, 0x8c, 0x8c, 0x62, 0x7a, 0x62, 0xd0,
-	0x9f, 0xec, 0xa6, 0x67, 0x4b, 0x05, 0x4f, 0x66, 0x24, 0x75, 0x5c, 0x19, 0xa6, 0x1b, 0x2d, 0x15,
-	0x77, 0x34, 0x1c, 0x26, 0x28, 0x8d, 0xff, 0x5a, 0x84, 0xda, 0xaa, 0xbd, 0x4d, 0xad, 0x7d, 0xcb,
-	0xa1, 0xe4, 0x1b, 0x70, 0xb9, 0x45, 0x1d, 0xca, 0x56, 0xcc, 0x5b, 0xbe, 0x69, 0xd1, 0x75, 0xea,
-	0xdb, 0x3c, 0x19, 0x1b, 0x1b, 0x83, 0x32, 0x7a, 0xfa, 0xea, 0xe1, 0xc1, 0xec, 0xe5, 0xa5, 0x81,
-	0x54, 0xf8, 0x04, 0x0e, 0x64, 0x05, 0x26, 0x5a, 0x34, 0xb0, 0x7d, 0xda, 0x5a, 0xd7, 0x36, 0x44,
-	0xcf, 0xab, 0x7a, 0x2e, 0x69, 0xb8, 0xc7, 0x07, 0xb3, 0x93, 0xca, 0x2a, 0x2b, 0x76, 0x46, 0x89,
-	0xa2, 0x6c, 0x6a, 0xe9, 0x9a, 0xbd, 0x80, 0x66, 0xd4, 0xb3, 0xc4, 0xeb, 0xc9, 0xa7, 0x96, 0xf5,
-	0x6c, 0x12, 0x1c, 0x54, 0x96, 0x6c, 0xc1, 0x0c, 0xaf, 0x7f, 0x16, 0xdf, 0x32, 0xe7, 0xfb, 0xc2,
-	0xe1, 0xc1, 0xac, 0xb1, 0x44, 0xbb, 0x3e, 0xb5, 0xcc, 0x90, 0xb6, 0x96, 0x06, 0x50, 0xe3, 0x40,
-	0x3e, 0x46, 0x05, 0x4a, 0xab, 0x5e, 0xdb, 0xf8, 0x5e, 0x09, 0xa2, 0xec, 0x80, 0xe4, 0x2f, 0x16,
-	0xa0, 0x6e, 0xba, 0xae, 0x17, 0xca, 0xcc, 0x7b, 0x22, 0x54, 0x05, 0x73, 0x27, 0x21, 0x9c, 0x5b,
-	0x88, 0x99, 0x8a, 0x28, 0x87, 0x28, 0xf2, 0x42, 0xc3, 0xa0, 0x2e, 0x9b, 0xf4, 0x52, 0x81, 0x17,
-	0x6b, 0xf9, 0x6b, 0x71, 0x84, 0x30, 0x8b, 0xcb, 0x5f, 0x81, 0x33, 0xe9, 0xca, 0x1e, 0xc7, 0x6f,
-	0x9a, 0x2b, 0x82, 0xa5, 0x08, 0x10, 0x07, 0x5f, 0x9d, 0x82, 0x41, 0xce, 0x4e, 0x18, 0xe4, 0x86,
-	0xcf, 0x3b, 0x12, 0x57, 0x7a, 0xa0, 0x11, 0xee, 0x41, 0xca, 0x08, 0xb7, 0x32, 0x0a, 0x61, 0x4f,
-	0x36, 0xbc, 0x6d, 0xc1, 0xb9, 0x98, 0x36, 0x9e, 0x5d, 0xee, 0xa4, 0x46, 0xbf, 0xd0, 0x2b, 0x3f,
-	0x3d, 0x60, 0xf4, 0x4f, 0x6b, 0xd1, 0x70, 0xfd, 0xe3, 0xdf, 0xf8, 0x3b, 0x05, 0x38, 0xa3, 0x0b,
-	0xe1, 0x59, 0x02, 0x3e, 0x0f, 0x93, 0x3e, 0x35, 0x5b, 0x0d, 0x33, 0xb4, 0x76, 0xf8, 0xb9, 0x8b,
-	0x02, 0x3f, 0x28, 0xc1, 0xcf, 0x22, 0xa2, 0x8e, 0xc0, 0x24, 0x1d, 0x31, 0xa1, 0xce, 0x00, 0x32,
-	0x69, 0xca, 0x90, 0x26, 0x6f, 0xbe, 0xc1, 0xc3, 0x98, 0x0d, 0xea, 0x3c, 0x8d, 0x1f, 0x17, 0x60,
-	0x4a, 0xaf, 0xf0, 0x89, 0x5b, 0x20, 0x77, 0x92, 0x16, 0xc8, 0xc5, 0x11, 0x7c, 0xf7, 0x01, 0x56,
-	0xc7, 0xef, 0xd4, 0xf5, 0x57, 0xe3, 0x96, 0x46, 0xdd, 0xb8, 0x52, 0x78, 0xa2, 0x71, 0xe5, 0xa3,
-	0x9f, 0x49, 0x6d, 0xd0, 0xae, 0xa0, 0xfc, 0x14, 0xef, 0x0a, 0x3e, 0xcc, 0x74, 0x6c, 0x5a, 0x4a,
-	0xb1, 0xb1, 0x1c, 0x29, 0xc5, 0x3a, 0x51, 0x4a, 0xb1, 0xf1, 0x91, 0x4d, 0x6c, 0x47, 0x49, 0x2b,
-	0x56, 0x3d, 0xd5, 0xb4, 0x62, 0xb5, 0x93, 0x4a, 0x2b, 0x06, 0x79, 0xd3, 0x8a, 0x7d, 0xb7, 0x00,
-	0x53, 0xad, 0xc4, 0xf9, 0x6d, 0x99, 0x39, 0x61, 0xf8, 0xe5, 0x2c, 0x79, 0x1c, 0x5c, 0x9c, 0x5f,
-	0x4c, 0xc2, 0x30, 0x25, 0x32, 0x2b, 0x99, 0xd7, 0xc4, 0x87, 0x92, 0xcc, 0x8b, 0x7c, 0x13, 0x6a,
-	0x8e, 0x5a, 0xeb, 0x64, 0x36, 0xd8, 0xd5, 0x91, 0x74, 0x49, 0xc9, 0x33, 0x3e, 0x22, 0x13, 0x81,
-	0x30, 0x96, 0x68, 0xfc, 0xcf, 0x71, 0x7d, 0x41, 0x3c, 0x6d, 0x1f, 0xc7, 0xcb, 0x49, 0x1f, 0xc7,
-	0xb5, 0xb4, 0x8f, 0xa3, 0x6f, 0x35, 0x97, 0x7e, 0x8e, 0xcf, 0x68, 0xeb, 0x44, 0x89, 0x67, 0xf6,
-	0x8a, 0xba, 0x5c, 0xc6, 0x5a, 0xb1, 0x00, 0xd3, 0x52, 0x09, 0x50, 0x48, 0x3e, 0xc9, 0x4e, 0xc6,
-	0x21, 0x8f, 0x4b, 0x49, 0x34, 0xa6, 0xe9, 0x99, 0xc0, 0x40, 0xe5, 0xdd, 0x16, 0x3b, 0xb6, 0xb8,
-	0x8f, 0xab, 0x9c, 0xd8, 0x11, 0x05, 0xdb, 0xdd, 0xf9, 0xd4, 0x0c, 0xa4, 0xa7, 0x42, 0xdb, 0xdd,
-	0x21, 0x87, 0xa2, 0xc4, 0xea, 0xee, 0x9a, 0xf1, 0x0f, 0x70, 0xd7, 0x98, 0x50, 0x77, 0xcc, 0x20,
-	0x14, 0x9d, 0xa9, 0x25, 0x67, 0x93, 0x3f, 0x75, 0xb4, 0x75, 0x9f, 0xe9, 0x12, 0xb1, 0x02, 0xbf,
-	0x1a, 0xb3, 0x41, 0x9d, 0x27, 0x69, 0xc1, 0x04, 0x7b, 0xe4, 0x33, 0x4b, 0x6b, 0x21, 0x94, 0x29,
-	0x17, 0x8f, 0x23, 0x23, 0xda, 0x3a, 0xae, 0x6a, 0x7c, 0x30, 0xc1, 0x75, 0x80, 0x47, 0x07, 0x86,
-	0xf1, 0xe8, 0x90, 0x2f, 0x09, 0xc5, 0x6d, 0x3f, 0xfa, 0xac, 0x75, 0xfe, 0x59, 0xa3, 0x70, 0x69,
-	0xd4, 0x91, 0x98, 0xa4, 0x65, 0xbd, 0xa2, 0x27, 0x9b, 0x41, 0x15, 0x9f, 0x48, 0xf6, 0x8a, 0xcd,
-	0x24, 0x1a, 0xd3, 0xf4, 0x64, 0x1d, 0xce, 0x47, 0x20, 0xbd, 0x1a, 0x93, 0x9c, 0x4f, 0x14, 0xbf,
-	0xba, 0x99, 0x41, 0x83, 0x99, 0x25, 0xf9, 0x81, 0xb0, 0x9e, 0xef, 0x53, 0x37, 0xbc, 0x6d, 0x06,
-	0x3b, 0x32, 0x10, 0x36, 0x3e, 0x10, 0x16, 0xa3, 0x50, 0xa7, 0x23, 0x37, 0x00, 0x04, 0x3b, 0x5e,
-	0x6a, 0x3a, 0x19, 0x6b, 0xbe, 0x19, 0x61, 0x50, 0xa3, 0x32, 0xbe, 0x5b, 0x83, 0xfa, 0x5d, 0x33,
-	0xb4, 0xf7, 0x28, 0x77, 0xbf, 0x9e, 0x8c, 0x0f, 0xec, 0x6f, 0x14, 0xe0, 0x62, 0x32, 0x80, 0xfb,
-	0x04, 0x1d, 0x61, 0x3c, 0x0b, 0x17, 0x66, 0x4a, 0xc3, 0x01, 0xb5, 0xe0, 0x2e, 0xb1, 0xbe, 0x78,
-	0xf0, 0x93, 0x76, 0x89, 0x35, 0x07, 0x09, 0xc4, 0xc1, 0x75, 0xf9, 0xa8, 0xb8, 0xc4, 0x9e, 0xee,
-	0xac, 0xb9, 0x29, 0x87, 0xdd, 0xf8, 0x53, 0xe3, 0xb0, 0xab, 0x3e, 0x15, 0x5a, 0x7f, 0x57, 0x73,
-	0xd8, 0xd5, 0x72, 0x46, 0xb1, 0xc9, 0x33, 0x4f, 0x82, 0xdb, 0x20, 0xc7, 0x9f, 0xf1, 0x7f, 0x0a,
-	0x50, 0x55, 0x8e, 0x14, 0xa6, 0x2c, 0x6f, 0x99, 0x81, 0x6d, 0x49, 0xb5, 0x23, 0x47, 0x42, 0x75,
-	0x95, 0x3e, 0x53, 0xc4, 0x97, 0xf0, 0x47, 0x14, 0xbc, 0xe3, 0x04, 0xa6, 0xc5, 0x5c, 0x09, 0x4c,
-	0xc9, 0x22, 0x94, 0xdd, 0x5d, 0xba, 0x7f, 0xbc, 0xc4, 0x1f, 0x7c, 0x13, 0x78, 0xf7, 0x0e, 0xdd,
-	0x47, 0x5e, 0xd8, 0xf8, 0x41, 0x11, 0x80, 0xbd, 0xfe, 0xd1, 0x5c, 0x67, 0x3f, 0x0d, 0xe3, 0x41,
-	0x8f, 0x1b, 0x86, 0xa4, 0xc2, 0x14, 0x87, 0xfe, 0x09, 0x30, 0x2a, 0x3c, 0x79, 0x0e, 0x2a, 0x0f,
-	0x7a, 0xb4, 0xa7, 0xe2, 0x40, 0xa2, 0x7d, 0xc3, 0x1b, 0x0c, 0x88, 0x02, 0x77, 0x72, 0xe6, 0x6d,
-	0xe5, 0x62, 0xab, 0x9c, 0x94, 0x8b, 0xad, 0x06, 0xe3, 0x77, 0x3d, 0x1e, 0x49, 0x6c, 0xfc, 0xe7,
-	0x22, 0x40, 0x1c, 0xa9, 0x49, 0x7e, 0xb9, 0x00, 0x17, 0xa2, 0x01, 0x17, 0x8a, 0xed, 0x1f, 0xbf,
-	0xc3, 0x20, 0xb7, 0xbb, 0x2d, 0x6b, 0xb0, 0xf3, 0x19, 0x68, 0x3d, 0x4b, 0x1c, 0x66, 0xd7, 0x82,
-	0x20, 0x54, 0x69, 0xa7, 0x1b, 0xee, 0x2f, 0xd9, 0xbe, 0xec, 0x81, 0x99, 0x01, 0xc1, 0x37, 0x25,
-	0x8d, 0x28, 0x2a, 0x6d, 0x14, 0x7c, 0x10, 0x29, 0x0c, 0x46, 0x7c, 0xc8, 0x0e, 0x54, 0x5d, 0xef,
-	0x9d, 0x80, 0x35, 0x87, 0xec, 0x8e, 0xc3, 0xa7, 0xd5, 0x97, 0xcd, 0x2a, 0xdc, 0x2e, 0xf2, 0x01,
-	0xc7, 0x5d, 0xd9, 0xd8, 0xbf, 0x54, 0x84, 0x73, 0x19, 0xed, 0x40, 0x5e, 0x83, 0x33, 0x32, 0x28,
-	0x36, 0xbe, 0xcc, 0xa3, 0x10, 0x5f, 0xe6, 0xd1, 0x4c, 0xe1, 0xb0, 0x8f, 0x9a, 0xbc, 0x03, 0x60,
-	0x5a, 0x16, 0x0d, 0x82, 0x35, 0xaf, 0xa5, 0xf6, 0x03, 0xaf, 0x32, 0xf5, 0x65, 0x21, 0x82, 0x3e,
-	0x3e, 0x98, 0xfd, 0xd9, 0xac, 0x38, 0xf7, 0x54, 0x3b, 0xc7, 0x05, 0x50, 0x63, 0x49, 0xbe, 0x01,
-	0x20, 0x6c, 0x00, 0x51, 0x6a, 0x95, 0x0f, 0x30, 0x9c, 0xcd, 0xa9, 0xd4, 0x75, 0x73, 0x6f, 0xf4,
-	0x4c, 0x37, 0xb4, 0xc3, 0x7d, 0x91, 0xca, 0xe9, 0x7e, 0xc4, 0x05, 0x35, 0x8e, 0xc6, 0x6f, 0x15,
-	0xa1, 0xaa, 0x5c, 0x0f, 0xa7, 0x60, 0x0b, 0x6e, 0x27, 0x6c, 0xc1, 0x23, 0x8a, 0x6c, 0xcf, 0xb2,
-	0x04, 0x7b, 0x29, 0x4b, 0xf0, 0xad, 0xfc, 0xa2, 0x9e, 0x6c, 0x07, 0xfe, 0xb5, 0x22, 0x4c, 0x29,
-	0xd2, 0xbc, 0x16, 0xda, 0x2f, 0xc3, 0xb4, 0x08, 0x02, 0x59, 0x33, 0x1f, 0x89, 0xac, 0x56, 0xbc,
-	0xc1, 0xca, 0x22, 0x98, 0xbc, 0x91, 0x44, 0x61, 0x9a, 0x96, 0x75, 0x6b, 0x01, 0xda, 0x64, 0x9b,
-	0x30, 0xe1, 0x36, 0x16, 0xfb, 0x4d, 0xde, 0xad, 0x1b, 0x29, 0x1c, 0xf6, 0x51, 0xa7, 0x4d, 0xc4,
-	0xe5, 0x13, 0x30, 0x11, 0xff, 0x7e, 0x01, 0x26, 0xe2, 0xf6, 0x3a, 0x71, 0x03, 0xf1, 0x76, 0xd2,
-	0x40, 0xbc, 0x90, 0xbb, 0x3b, 0x0c, 0x30, 0x0f, 0x7f, 0xbf, 0x0a, 0x89, 0x03, 0x16, 0x64, 0x0b,
-	0x2e, 0xdb, 0x99, 0x91, 0x99, 0xda, 0x6c, 0x13, 0x65, 0x80, 0x58, 0x19, 0x48, 0x89, 0x4f, 0xe0,
-	0x42, 0x7a, 0x50, 0xdd, 0xa3, 0x7e, 0x68, 0x5b, 0x54, 0xbd, 0xdf, 0xad, 0xdc, 0x2a, 0x99, 0x34,
-	0x82, 0x47, 0x6d, 0x7a, 0x5f, 0x0a, 0xc0, 0x48, 0x14, 0xd9, 0x82, 0x0a, 0x6d, 0xb5, 0xa9, 0xba,
-	0x3a, 0x2b, 0x67, 0xee, 0xe8, 0xa8, 0x3d, 0xd9, 0x53, 0x80, 0x82, 0x35, 0x09, 0x74, 0x43, 0x53,
-	0x39, 0xa7, 0x82, 0x75, 0x44, 0xf3, 0x12, 0xd9, 0x8d, 0xac, 0xad, 0x95, 0x11, 0x4d, 0x1e, 0x4f,
-	0xb0, 0xb5, 0x06, 0x50, 0x7b, 0x68, 0x86, 0xd4, 0xef, 0x98, 0xfe, 0xae, 0xdc, 0x6d, 0x0c, 0xff,
-	0x86, 0x6f, 0x2a, 0x4e, 0xf1, 0x1b, 0x46, 0x20, 0x8c, 0xe5, 0x10, 0x0f, 0x6a, 0xa1, 0x54, 0x9f,
-	0x95, 0x49, 0x79, 0x78, 0xa1, 0x4a, 0x11, 0x0f, 0xe4, 0x41, 0x0b, 0xf5, 0x88, 0xb1, 0x0c, 0xb2,
-	0x97, 0xb8, 0x67, 0x41, 0xdc, 0xae, 0x91, 0xe3, 0xa2, 0x1e, 0xc5, 0x2a, 0x5e, 0x6e, 0x06, 0xdc,
-	0xd7, 0xf0, 0x7e, 0x01, 0xa6, 0x53, 0x23, 0x47, 0xee, 0x11, 0x6e, 0x8f, 0x2a, 0x4a, 0x5d, 0xcc,
-	0xca, 0x29, 0x20, 0xa6, 0xa5, 0x1a, 0xff, 0xbd, 0x12, 0x2f, 0x10, 0xa7, 0x6d, 0xb1, 0xfc, 0x5c,
-	0xd2, 0x62, 0x79, 0x35, 0x6d, 0xb1, 0x4c, 0x45, 0x1f, 0x1c, 0x3f, 0x2e, 0x3b, 0x65, 0xe8, 0x2b,
-	0x9f, 0x80, 0xa1, 0xef, 0x45, 0xa8, 0xef, 0xf1, 0x39, 0x49, 0x64, 0x8f, 0xab, 0xf0, 0x05, 0x8d,
-	0xaf, 0x31, 0xf7, 0x63, 0x30, 0xea, 0x34, 0xac, 0x88, 0xbc, 0x0e, 0x2c, 0xca, 0x7a, 0x2e, 0x8b,
-	0x34, 0x63, 0x30, 0xea, 0x34, 0x3c, 0xa4, 0xd3, 0x76, 0x77, 0x45, 0x81, 0x71, 0x5e, 0x40, 0x84,
-	0x74, 0x2a, 0x20, 0xc6, 0x78, 0x72, 0x1d, 0xaa, 0xbd, 0xd6, 0xb6, 0xa0, 0xad, 0x72, 0x5a, 0xae,
-	0xeb, 0x6e, 0x2e, 0x2d, 0xcb, 0x6c, 0x76, 0x0a, 0xcb, 0x6a, 0xd2, 0x31, 0xbb, 0x0a, 0xc1, 0x7b,
-	0xa0, 0xac, 0xc9, 0x5a, 0x0c, 0x46, 0x9d, 0x86, 0x7c, 0x11, 0xa6, 0x7c, 0xda, 0xea, 0x59, 0x34,
-	0x2a, 0x05, 0xbc, 0x94, 0xcc, 0x73, 0xab, 0x63, 0x30, 0x45, 0x39, 0xc0, 0x5c, 0x59, 0x1f, 0xca,
-	0x5c, 0xf9, 0x15, 0x98, 0x6a, 0xf9, 0xa6, 0xed, 0xd2, 0xd6, 0x3d, 0x97, 0x87, 0x98, 0xc8, 0xc0,
-	0xd2, 0xc8, 0x55, 0xb0, 0x94, 0xc0, 0x62, 0x8a, 0xda, 0x58, 0x06, 0x91, 0xc1, 0x99, 0xcc, 0x42,
-	0x65, 0x27, 0x0c, 0xbb, 0xca, 0x47, 0xca, 0xf7, 0xa6, 0xfc, 0x1c, 0x1a, 0x0a, 0x38, 0xb9, 0x02,
-	0x65, 0xf6, 0x47, 0x1a, 0xe7, 0xf8, 0xe6, 0x89, 0xe1, 0x91, 0x43, 0x8d, 0xdf, 0x2e, 0x42, 0x45,
-	0x64, 0xf1, 0x5d, 0x81, 0x73, 0xb6, 0x6b, 0x87, 0xb6, 0xe9, 0x2c, 0x51, 0xc7, 0xdc, 0xd7, 0x43,
-	0x76, 0x2a, 0x8d, 0x67, 0x0e, 0x0f, 0x66, 0xcf, 0xad, 0xf4, 0xa3, 0x31, 0xab, 0x0c, 0x6b, 0x64,
-	0x79, 0x67, 0x88, 0xe2, 0x22, 0x84, 0x8b, 0x34, 0xf4, 0x09, 0x0c, 0xa6, 0x28, 0x99, 0x7a, 0xd7,
-	0xed, 0x8b, 0xc5, 0xa9, 0x08, 0xf5, 0x2e, 0x19, 0x1e, 0x93, 0xa4, 0xe3, 0xdb, 0x8e, 0x1e, 0x57,
-	0xf1, 0xa3, 0x33, 0x69, 0x32, 0xac, 0x4f, 0x6c, 0x3b, 0x52, 0x38, 0xec, 0xa3, 0x66, 0x1c, 0xb6,
-	0x4d, 0xdb, 0xe9, 0xf9, 0x34, 0xe6, 0x50, 0x89, 0x39, 0x2c, 0xa7, 0x70, 0xd8, 0x47, 0x6d, 0xfc,
-	0x76, 0x01, 0x40, 0xdc, 0x0d, 0xc6, 0x6d, 0x18, 0x23, 0xba, 0x1f, 0x85, 0xf4, 0xa0, 0xb6, 0xa5,
-	0xac, 0x18, 0xb9, 0x6f, 0xb5, 0x10, 0xf5, 0x8b, 0xad, 0x22, 0xe2, 0x9a, 0x39, 0xf5, 0x88, 0xb1,
-	0x24, 0xe3, 0xef, 0x16, 0x60, 0x3a, 0x45, 0x4d, 0xee, 0x41, 0x55, 0xe5, 0x26, 0x3d, 0xde, 0x5b,
-	0x89, 0x31, 0x2c, 0x8b, 0x62, 0xc4, 0x64, 0xf4, 0xd7, 0x91, 0x7c, 0xa7, 0xa8, 0xbe, 0x01, 0x8f,
-	0xd2, 0xbc, 0x01, 0x20, 0x73, 0x88, 0xb5, 0x5a, 0xbe, 0xd4, 0x0c, 0xe3, 0xe5, 0x2d, 0xc2, 0xa0,
-	0x46, 0x75, 0xb4, 0x80, 0xc2, 0x57, 0x60, 0xa2, 0xeb, 0x7b, 0x6c, 0x82, 0xf0, 0xb9, 0xd2, 0x99,
-	0x0a, 0xae, 0x5e, 0xd7, 0x70, 0x98, 0xa0, 0x24, 0xa6, 0xb4, 0x88, 0x8c, 0x8d, 0xe4, 0x56, 0xba,
-	0x4c, 0x9b, 0xc8, 0x1f, 0x15, 0x61, 0x42, 0x36, 0x82, 0xb0, 0x26, 0x9d, 0x64, 0x33, 0xa8, 0x38,
-	0xc9, 0xac, 0x66, 0x58, 0xd4, 0x70, 0x98, 0xa0, 0x24, 0x4b, 0x6c, 0xc0, 0x6e, 0x89, 0xd4, 0x1d,
-	0xb6, 0xe7, 0xf2, 0xd2, 0x22, 0xc7, 0x4d, 0x74, 0xd8, 0xb9, 0x99, 0xc2, 0x63, 0x5f, 0x09, 0xf2,
-	0x19, 0xa8, 0x76, 0xcc, 0x47, 0x9b, 0xae, 0x69, 0xed, 0xca, 0xd5, 0x2b, 0x52, 0xae, 0xd7, 0x24,
-	0x1c, 0x23, 0x8a, 0xd3, 0x68, 0xfa, 0xff, 0x52, 0x00, 0xd2, 0x7f, 0xb8, 0x8d, 0xec, 0xc0, 0x98,
-	0xcb, 0x3d, 0x2c, 0xb9, 0x6f, 0xc0, 0xd1, 0x1c, 0x35, 0x42, 0xf5, 0x95, 0x00, 0xc9, 0x9f, 0xb8,
-	0x50, 0xa5, 0x8f, 0x42, 0x36, 0xbc, 0x9c, 0xdc, 0xa7, 0x53, 0xf5, 0xdb, 0x76, 0x84, 0xc5, 0x49,
-	0x72, 0xc6, 0x48, 0x86, 0xf1, 0x87, 0x45, 0xa8, 0x6b, 0x74, 0x1f, 0x64, 0xb8, 0xe4, 0xc9, 0x9c,
-	0x84, 0x63, 0x63, 0xd3, 0x77, 0x64, 0xdf, 0xd2, 0x92, 0x39, 0x49, 0x14, 0xae, 0xa2, 0x4e, 0xc7,
-	0x3a, 0x70, 0xc7, 0x0c, 0xc2, 0x44, 0x2f, 0x8b, 0x3a, 0xf0, 0x5a, 0x84, 0x41, 0x8d, 0x8a, 0x5c,
-	0x93, 0xf7, 0x25, 0x95, 0x93, 0xb9, 0xc0, 0x07, 0x5c, 0x86, 0x54, 0x19, 0xc1, 0xec, 0x43, 0xda,
-	0x70, 0x46, 0xd5, 0x5a, 0x61, 0x8f, 0x97, 0x10, 0x59, 0x2c, 0x56, 0x29, 0x16, 0xd8, 0xc7, 0xd4,
-	0xf8, 0x41, 0x01, 0x26, 0x13, 0x66, 0x75, 0x91, 0xac, 0x5a, 0x1d, 0xcd, 0x4c, 0x24, 0xab, 0xd6,
-	0x4e, 0x54, 0xbe, 0x00, 0x63, 0xa2, 0x81, 0xd2, 0x27, 0x2e, 0x44, 0x13, 0xa2, 0xc4, 0x32, 0x2d,
-	0x55, 0x3a, 0xee, 0xd2, 0x5a, 0xaa, 0xf4, 0xec, 0xa1, 0xc2, 0x0b, 0x7f, 0xb8, 0xa8, 0x9d, 0x6c,
-	0x69, 0xcd, 0x1f, 0x2e, 0xe0, 0x18, 0x51, 0x18, 0xff, 0x88, 0xd7, 0x3b, 0xf4, 0xf7, 0x23, 0x7b,
-	0x61, 0x1b, 0xc6, 0x65, 0x94, 0xbd, 0x1c, 0x1a, 0xaf, 0xe5, 0xb0, 0xf5, 0x73, 0x3e, 0x32, 0x4e,
-	0xdc, 0xb4, 0x76, 0xef, 0x6d, 0x6f, 0xa3, 0xe2, 0x4e, 0x6e, 0x42, 0xcd, 0x73, 0xe5, 0x2a, 0x2e,
-	0x5f, 0xff, 0xd3, 0x6c, 0xf1, 0xbb, 0xa7, 0x80, 0x8f, 0x0f, 0x66, 0x2f, 0x46, 0x0f, 0x89, 0x4a,
-	0x62, 0x5c, 0xd2, 0xf8, 0xf3, 0x05, 0xb8, 0x80, 0x9e, 0xe3, 0xd8, 0x6e, 0x3b, 0x19, 0xcf, 0x41,
-	0x1c, 0x98, 0x12, 0x33, 0xcd, 0x9e, 0x69, 0x3b, 0xe6, 0x96, 0x43, 0x3f, 0xd0, 0xde, 0xd7, 0x0b,
-	0x6d, 0x67, 0x4e, 0xdc, 0x34, 0xce, 0x36, 0x40, 0xf7, 0xfc, 0x66, 0xe8, 0xdb, 0x6e, 0x5b, 0x68,
-	0x4a, 0x6b, 0x09, 0x5e, 0x98, 0xe2, 0x6d, 0xfc, 0x9b, 0x32, 0xf0, 0x08, 0x6e, 0xf2, 0x79, 0xa8,
-	0x75, 0xa8, 0xb5, 0x63, 0xba, 0x76, 0xa0, 0xf2, 0xde, 0x5f, 0x62, 0xef, 0xb5, 0xa6, 0x80, 0x8f,
-	0xd9, 0xa7, 0x58, 0x68, 0xae, 0xf2, 0xc3, 0x94, 0x31, 0x2d, 0xb1, 0x60, 0xac, 0x1d, 0x04, 0x66,
-	0xd7, 0xce, 0x1d, 0x38, 0x27, 0xd2, 0xac, 0x8b, 0xe9, 0x48, 0xfc, 0x47, 0xc9, 0x9a, 0x58, 0x50,
-	0xe9, 0x3a, 0xa6, 0xed, 0xe6, 0xbe, 0x19, 0x97, 0xbd, 0xc1, 0x3a, 0xe3, 0x24, 0x34, 0x24, 0xfe,
-	0x17, 0x05, 0x6f, 0xd2, 0x83, 0x7a, 0x60, 0xf9, 0x66, 0x27, 0xd8, 0x31, 0x6f, 0xbc, 0xf4, 0x72,
-	0x6e, 0x93, 0x46, 0x2c, 0x4a, 0xec, 0x6b, 0x16, 0x71, 0x61, 0xad, 0x79, 0x7b, 0xe1, 0xc6, 0x4b,
-	0x2f, 0xa3, 0x2e, 0x47, 0x17, 0xfb, 0xd2, 0x8b, 0x37, 0xf2, 0xdf, 0x94, 0x9b, 0x2d, 0xf6, 0xa5,
-	0x17, 0x6f, 0xa0, 0x2e, 0x87, 0x35, 0xa9, 0xa7, 0x2d, 0x63, 0xf9, 0x04, 0xde, 0x8b, 0x7d, 0x63,
-	0xfc, 0x2f, 0x0a, 0xde, 0xc6, 0xff, 0x28, 0x40, 0x2d, 0xc2, 0xb3, 0x89, 0x52, 0x24, 0x90, 0x5d,
-	0x59, 0x1a, 0x42, 0xef, 0x5b, 0x94, 0x45, 0x31, 0x62, 0x42, 0xde, 0x86, 0x09, 0xf1, 0x5f, 0x26,
-	0x74, 0x2f, 0x1e, 0x3b, 0x6b, 0xfc, 0xa2, 0x56, 0x1c, 0x13, 0xcc, 0xc8, 0x97, 0x60, 0x92, 0x6b,
-	0xce, 0x37, 0xdd, 0x56, 0xd7, 0xb3, 0xe5, 0x25, 0x66, 0x5a, 0xee, 0xbc, 0x0d, 0x1d, 0x89, 0x49,
-	0xda, 0xe8, 0xc5, 0xf9, 0x97, 0x20, 0x9b, 0x00, 0x6c, 0xa5, 0x90, 0xb5, 0x3c, 0xd6, 0xab, 0x73,
-	0x0f, 0xc1, 0x66, 0x54, 0x18, 0x35, 0x46, 0x19, 0x79, 0xf9, 0x8b, 0xa3, 0xce, 0xcb, 0x3f, 0x0f,
-	0xb5, 0x1d, 0xd3, 0x6d, 0x05, 0x3b, 0xe6, 0x2e, 0x95, 0xc7, 0x8a, 0x22, 0xf3, 0xd5, 0x6d, 0x85,
-	0xc0, 0x98, 0xc6, 0xf8, 0xcd, 0x31, 0x10, 0xb1, 0x84, 0x6c, 0x4a, 0x6f, 0xd9, 0x81, 0x38, 0xfc,
-	0x57, 0xe0, 0x25, 0xa3, 0x29, 0x7d, 0x49, 0xc2, 0x31, 0xa2, 0x20, 0x97, 0xa0, 0xd4, 0xb1, 0x5d,
-	0xb9, 0xc7, 0xe3, 0xce, 0xbf, 0x35, 0xdb, 0x45, 0x06, 0xe3, 0x28, 0xf3, 0x91, 0xdc, 0xc3, 0x09,
-	0x94, 0xf9, 0x08, 0x19, 0x8c, 0x7c, 0x19, 0xa6, 0x1d, 0xcf, 0xdb, 0x65, 0x93, 0xb3, 0x7e, 0x3c,
-	0x62, 0x52, 0x18, 0x7e, 0x56, 0x93, 0x28, 0x4c, 0xd3, 0x92, 0x4d, 0x78, 0xe6, 0x3d, 0xea, 0x7b,
-	0x72, 0x35, 0x6a, 0x3a, 0x94, 0x76, 0x15, 0x1b, 0xa1, 0x06, 0xf2, 0xd3, 0x1b, 0x5f, 0xcb, 0x26,
-	0xc1, 0x41, 0x65, 0xf9, 0x79, 0x33, 0xd3, 0x6f, 0xd3, 0x70, 0xdd, 0xf7, 0xd8, 0xee, 0xd0, 0x76,
-	0xdb, 0x8a, 0xed, 0x58, 0xcc, 0x76, 0x23, 0x9b, 0x04, 0x07, 0x95, 0x25, 0x6f, 0xc1, 0x8c, 0x40,
-	0x09, 0xa5, 0x70, 0x41, 0x4c, 0xe2, 0xb6, 0xa3, 0xae, 0xeb, 0x9f, 0x14, 0x31, 0x16, 0x1b, 0x03,
-	0x68, 0x70, 0x60, 0x69, 0xf2, 0x3a, 0x9c, 0x51, 0x11, 0x36, 0xeb, 0xd4, 0x6f, 0x46, 0xf1, 0xa5,
-	0x93, 0xea, 0x98, 0x8d, 0x3a, 0x66, 0x82, 0x29, 0x2a, 0xec, 0x2b, 0x47, 0x10, 0x2e, 0xf2, 0x20,
-	0xd2, 0xcd, 0xee, 0xa2, 0xe7, 0x39, 0x2d, 0xef, 0xa1, 0xab, 0xde, 0x5d, 0x98, 0x56, 0x78, 0x50,
-	0x4d, 0x33, 0x93, 0x02, 0x07, 0x94, 0x64, 0x6f, 0xce, 0x31, 0x4b, 0xde, 0x43, 0x37, 0xcd, 0x15,
-	0xe2, 0x37, 0x6f, 0x0e, 0xa0, 0xc1, 0x81, 0xa5, 0xc9, 0x32, 0x90, 0xf4, 0x1b, 0x6c, 0x76, 0x65,
-	0xd8, 0xd7, 0x45, 0x91, 0x41, 0x32, 0x8d, 0xc5, 0x8c, 0x12, 0x64, 0x15, 0xce, 0xa7, 0xa1, 0x4c,
-	0x9c, 0x8c, 0x00, 0xe3, 0x77, 0x47, 0x60, 0x06, 0x1e, 0x33, 0x4b, 0x19, 0x75, 0xa8, 0x45, 0x17,
-	0x9e, 0x1b, 0xff, 0xba, 0x08, 0xd3, 0xa9, 0x2c, 0x7c, 0xa7, 0xe0, 0x0e, 0x74, 0x13, 0xee, 0xc0,
-	0xd5, 0x5c, 0x17, 0xb7, 0x6b, 0x35, 0x1f, 0xe8, 0x15, 0xdc, 0x4b, 0x79, 0x05, 0xef, 0x8e, 0x4c,
-	0xe2, 0x93, 0x9d, 0x83, 0x87, 0x05, 0x38, 0x97, 0x2a, 0x71, 0x0a, 0x3e, 0xaf, 0x4e, 0xd2, 0xe7,
-	0x75, 0x7b, 0x54, 0x2f, 0x3b, 0xc0, 0xf5, 0xf5, 0xbf, 0xfb, 0x5f, 0xb2, 0x29, 0x5c, 0xb1, 0xe3,
-	0x32, 0xe1, 0x59, 0xee, 0x0d, 0xa5, 0xca, 0xa8, 0xc6, 0xbe, 0x6f, 0x32, 0x81, 0x94, 0xdb, 0x46,
-	0x25, 0x85, 0x04, 0x50, 0x55, 0x59, 0xcd, 0x46, 0xeb, 0x68, 0x8e, 0x1a, 0x3b, 0x4a, 0x54, 0x19,
-	0x09, 0x32, 0xbe, 0x5f, 0x82, 0x0b, 0x99, 0x9d, 0xe2, 0xf4, 0xac, 0xfc, 0x5f, 0x4a, 0x5a, 0xf9,
-	0x9f, 0x4f, 0x5b, 0xf9, 0xcf, 0xa7, 0xea, 0xf7, 0x14, 0x1b, 0xfb, 0x47, 0x68, 0xc0, 0x36, 0xa6,
-	0x61, 0x32, 0x91, 0x89, 0xcf, 0xf8, 0xbd, 0x31, 0xa8, 0x6b, 0x3d, 0xe9, 0xa9, 0xcb, 0x80, 0x46,
-	0xde, 0x51, 0x97, 0x25, 0x96, 0xf2, 0x5e, 0x4f, 0xc7, 0xb8, 0xc8, 0x4d, 0x88, 0x76, 0x8b, 0x22,
-	0xf9, 0x22, 0x4c, 0x75, 0x82, 0xf6, 0xca, 0xd2, 0x6d, 0x6a, 0xb6, 0xa8, 0x7f, 0x87, 0xee, 0xcb,
-	0xed, 0xb0, 0xd8, 0xcc, 0x25, 0x30, 0x98, 0xa2, 0x24, 0xab, 0x70, 0xc1, 0xa7, 0x0f, 0x7a, 0x34,
-	0x08, 0x93, 0xf6, 0x71, 0xa9, 0xcc, 0xc8, 0xf5, 0x2c, 0x45, 0x10, 0x60, 0x76, 0x21, 0x36, 0x47,
-	0x89, 0x08, 0xa0, 0xb1, 0x9c, 0x03, 0x55, 0x7d, 0x50, 0x1e, 0x06, 0x24, 0x12, 0xb2, 0x69, 0x10,
-	0x14, 0x52, 0x06, 0x1c, 0x10, 0x1a, 0xff, 0x10, 0x0f, 0x08, 0xe9, 0x51, 0xc9, 0xd5, 0x27, 0x46,
-	0x25, 0x0f, 0x0a, 0xc2, 0xac, 0x3d, 0x0d, 0x41, 0x98, 0xc6, 0xb7, 0x20, 0xd1, 0xe0, 0xc4, 0x83,
-	0x5a, 0xf4, 0xb2, 0xb9, 0x23, 0x23, 0xe3, 0x43, 0x3a, 0xdc, 0x07, 0x10, 0x3d, 0x62, 0x2c, 0xc3,
-	0xd8, 0x66, 0xc3, 0x9c, 0xe7, 0x9f, 0x3b, 0xd9, 0xbb, 0xe5, 0xff, 0x65, 0x11, 0x6a, 0x91, 0xb3,
-	0x99, 0x5c, 0x83, 0xb2, 0x1b, 0x07, 0x72, 0x44, 0x3a, 0x07, 0x37, 0xf0, 0x71, 0x4c, 0xb2, 0x21,
-	0x8a, 0x27, 0xdf, 0x10, 0xfa, 0x91, 0xb3, 0x52, 0x8e, 0x23, 0x67, 0x5d, 0x18, 0x0f, 0x7d, 0xbb,
-	0xdd, 0x96, 0x56, 0xc9, 0x3c, 0x67, 0xce, 0xa2, 0xe6, 0xda, 0x10, 0x0c, 0x65, 0xcb, 0x8a, 0x07,
-	0x54, 0x62, 0x8c, 0x77, 0xe1, 0x4c, 0x9a, 0x92, 0x9b, 0xec, 0xac, 0x1d, 0xda, 0xea, 0x39, 0xaa,
-	0x8d, 0x63, 0x93, 0x9d, 0x84, 0x63, 0x44, 0xc1, 0x06, 0x13, 0xfb, 0x4c, 0xef, 0x79, 0xae, 0x5a,
-	0x04, 0xf9, 0x60, 0xda, 0x90, 0x30, 0x8c, 0xb0, 0xc6, 0x7f, 0x2a, 0xc1, 0xa5, 0x38, 0x64, 0x60,
-	0xcd, 0x74, 0xcd, 0x76, 0x32, 0x1c, 0xfc, 0xe3, 0xdc, 0x27, 0x23, 0xb9, 0x71, 0xb3, 0xf4, 0x14,
-	0xdc, 0xb8, 0xf9, 0x7f, 0x8b, 0xc0, 0x8f, 0xb0, 0x92, 0x6f, 0xc1, 0x84, 0x6a, 0x4f, 0xf6, 0x2c,
-	0x3f, 0xe7, 0xcd, 0xdc, 0x9f, 0x93, 0x9f, 0x94, 0x8d, 0x1c, 0x49, 0x3a, 0x14, 0x13, 0x02, 0x89,
-	0x07, 0xd5, 0x6d, 0xd3, 0x71, 0xb6, 0x4c, 0x6b, 0x37, 0xb7, 0x66, 0x9a, 0x10, 0xce, 0xbb, 0xf9,
-	0xb2, 0x64, 0x8d, 0x91, 0x10, 0xf2, 0xdd, 0x02, 0x4c, 0xfa, 0xba, 0x79, 0x58, 0x7e, 0x90, 0x3c,
-	0x01, 0xf2, 0x1a, 0x37, 0xfd, 0xd0, 0x92, 0x6e, 0x83, 0x4e, 0xca, 0x34, 0xfe, 0x63, 0x01, 0x26,
-	0x9b, 0x8e, 0xdd, 0xb2, 0xdd, 0xf6, 0x09, 0x5e, 0xf8, 0x79, 0x0f, 0x2a, 0x81, 0x63, 0xb7, 0xe8,
-	0x90, 0x27, 0xda, 0xb9, 0x96, 0xc4, 0x6a, 0xc9, 0x94, 0x05, 0xf6, 0x93, 0xbc, 0x41, 0xb4, 0x74,
-	0x84, 0x1b, 0x44, 0x7f, 0xab, 0x0a, 0xf2, 0x30, 0x36, 0xe9, 0x41, 0xad, 0xad, 0xee, 0x65, 0x94,
-	0xef, 0x78, 0x3b, 0xc7, 0x9d, 0x1e, 0x89, 0x1b, 0x1e, 0xc5, 0xdc, 0x1f, 0x01, 0x31, 0x96, 0x44,
-	0x28, 0x54, 0x78, 0xca, 0x93, 0xdc, 0xee, 0x34, 0x2d, 0xb9, 0x8d, 0x68, 0x19, 0x0e, 0x40, 0xc1,
-	0x9d, 0x98, 0x32, 0x52, 0xa3, 0x94, 0xd3, 0x39, 0x19, 0xa7, 0x21, 0x4e, 0x87, 0x7b, 0x30, 0x11,
-	0xae, 0x19, 0x06, 0xb9, 0xd3, 0x21, 0xc7, 0xe7, 0x14, 0xe4, 0x31, 0x06, 0x33, 0x0c, 0x90, 0xb3,
-	0x26, 0x3f, 0x0f, 0xf5, 0xd0, 0x37, 0xdd, 0x60, 0xdb, 0xf3, 0x3b, 0xd4, 0x97, 0x36, 0xf1, 0xe1,
-	0x47, 0xc6, 0xe6, 0xd2, 0x46, 0xcc, 0x4d, 0x84, 0x81, 0x24, 0x40, 0xa8, 0x4b, 0x23, 0xbb, 0x50,
-	0xed, 0xb5, 0x44, 0xc5, 0xa4, 0xee, 0xbb, 0x90, 0x43, 0xb2, 0x1e, 0x6a, 0xaf, 0x9e, 0x30, 0x12,
-	0xc0, 0x7a, 0x63, 0x9c, 0x9d, 0x74, 0x3c, 0x67, 0x6f, 0x4c, 0x65, 0x4e, 0x1b, 0x9c, 0x96, 0x94,
-	0x74, 0xe2, 0x9d, 0x7f, 0x35, 0x67, 0xe3, 0x26, 0x76, 0x70, 0x32, 0xb1, 0x75, 0x7a, 0xdf, 0x6f,
-	0xc3, 0x58, 0x97, 0x7b, 0xbb, 0xa5, 0x4a, 0x7c, 0x33, 0xa7, 0xd3, 0x5c, 0xcf, 0xb1, 0x20, 0x20,
-	0x28, 0x05, 0x90, 0xaf, 0x43, 0x29, 0x78, 0x10, 0xc8, 0x8b, 0xfb, 0x73, 0x78, 0x35, 0x1e, 0xa8,
-	0xbe, 0xc9, 0x2d, 0xce, 0xcd, 0x07, 0x01, 0x32, 0xbe, 0xc6, 0x3f, 0x29, 0xc0, 0x38, 0xc3, 0xb1,
-	0x35, 0x63, 0x1e, 0x6a, 0xe6, 0xc3, 0x00, 0x69, 0x3b, 0x3e, 0xe3, 0x18, 0xcd, 0x42, 0x0b, 0x6f,
-	0x36, 0x05, 0x02, 0x63, 0x1a, 0x56, 0x80, 0x1f, 0x94, 0xe1, 0xee, 0xe7, 0x62, 0xb2, 0xc0, 0x1b,
-	0x0a, 0x81, 0x31, 0x0d, 0xb9, 0x0f, 0x17, 0xf9, 0xc3, 0xbd, 0x87, 0x2e, 0xf5, 0x17, 0xde, 0x6c,
-	0x2e, 0x58, 0x96, 0xd7, 0xe3, 0xfe, 0x93, 0x52, 0x22, 0x5c, 0xf0, 0xe2, 0x1b, 0x99, 0x54, 0x38,
-	0xa0, 0xb4, 0xf1, 0xfb, 0x65, 0xa8, 0x45, 0x6f, 0xf8, 0xd1, 0x7d, 0x0f, 0xb2, 0x08, 0x67, 0xf7,
-	0xec, 0xc0, 0x16, 0x66, 0x6c, 0x3d, 0x26, 0xbe, 0x22, 0x54, 0xa4, 0xfb, 0x69, 0x24, 0xf6, 0xd3,
-	0x93, 0x15, 0x38, 0xd7, 0x31, 0x1f, 0xdd, 0xed, 0x75, 0xb6, 0xa8, 0x7f, 0x6f, 0x5b, 0xda, 0x54,
-	0x02, 0x19, 0xb5, 0xc5, 0x83, 0xd6, 0xd6, 0xfa, 0xd1, 0x98, 0x55, 0x86, 0x7c, 0x19, 0xa6, 0x1f,
-	0x9a, 0x36, 0xdf, 0x49, 0xeb, 0x16, 0xff, 0x8a, 0xf0, 0x47, 0xbc, 0x99, 0x44, 0x61, 0x9a, 0x96,
-	0xbc, 0x08, 0x75, 0x2a, 0x3d, 0x48, 0x9b, 0xbe, 0xa3, 0xce, 0x70, 0x1f, 0x1e, 0xcc, 0xd6, 0x95,
-	0x63, 0x89, 0x87, 0x34, 0x68, 0x34, 0xe4, 0x8b, 0x30, 0x65, 0x86, 0xa1, 0x6f, 0x6f, 0xf5, 0x42,
-	0xde, 0xd4, 0x22, 0x82, 0x57, 0xda, 0x0b, 0x16, 0x12, 0x18, 0x4c, 0x51, 0x92, 0x7b, 0x70, 0x41,
-	0x1a, 0x8e, 0x92, 0x84, 0x32, 0x61, 0x26, 0x57, 0xe7, 0xd6, 0xb2, 0x08, 0x30, 0xbb, 0x9c, 0xd1,
-	0x01, 0x69, 0xf8, 0x22, 0x56, 0xe2, 0x0e, 0x74, 0x91, 0x46, 0x6a, 0xfe, 0x68, 0xcb, 0x7e, 0x74,
-	0x19, 0xb7, 0x76, 0x05, 0x65, 0xe6, 0x65, 0xe7, 0xc6, 0xbf, 0x2a, 0x42, 0x69, 0x63, 0xb5, 0x29,
-	0xae, 0x95, 0x0a, 0xa8, 0xd5, 0xf3, 0x69, 0x73, 0xd7, 0xee, 0xde, 0xa7, 0xbe, 0xbd, 0xbd, 0x2f,
-	0x7d, 0x4e, 0xda, 0xb5, 0x52, 0x69, 0x0a, 0xcc, 0x28, 0xc5, 0x5d, 0x8a, 0xe6, 0x22, 0xf5, 0x73,
-	0xb8, 0x14, 0x17, 0xe2, 0xe2, 0x98, 0x60, 0x46, 0x36, 0x01, 0xac, 0x98, 0x75, 0xe9, 0xd8, 0x7e,
-	0x40, 0x8d, 0xb1, 0xc6, 0x88, 0x20, 0xd4, 0x76, 0x19, 0x29, 0xe7, 0x5a, 0x3e, 0x0e, 0x57, 0xbe,
-	0x40, 0xdc, 0x51, 0x65, 0x31, 0x66, 0x63, 0xb8, 0x30, 0x99, 0xb8, 0x18, 0x9d, 0x7c, 0x01, 0xaa,
-	0x5e, 0x57, 0xd3, 0x9a, 0x6a, 0xfc, 0xe8, 0x71, 0xf5, 0x9e, 0x84, 0x3d, 0x3e, 0x98, 0x9d, 0x5c,
-	0xf5, 0xda, 0xb6, 0xa5, 0x00, 0x18, 0x91, 0x13, 0x03, 0xc6, 0x78, 0x92, 0x2b, 0x61, 0xee, 0xae,
-	0x89, 0x69, 0x9b, 0x5f, 0xdc, 0x1c, 0xa0, 0xc4, 0x18, 0xdf, 0x2e, 0x43, 0x1c, 0x9e, 0x4e, 0x02,
-	0x18, 0x13, 0x09, 0x36, 0xa4, 0x82, 0x76, 0xa2, 0xb9, 0x3c, 0xa4, 0x28, 0xd2, 0x86, 0xd2, 0xbb,
-	0xde, 0x56, 0x6e, 0xfd, 0x4c, 0xcb, 0xd4, 0x29, 0xc6, 0xae, 0x06, 0x40, 0x26, 0x81, 0xfc, 0xcd,
-	0x02, 0x9c, 0x0d, 0xd2, 0x3b, 0x5c, 0xd9, 0x1d, 0x30, 0xff, 0x56, 0x3e, 0xbd, 0x67, 0x96, 0x67,
-	0xc4, 0x07, 0xa1, 0xb1, 0xbf, 0x2e, 0xac, 0xfd, 0x45, 0xb4, 0xb6, 0xec, 0x4e, 0xc3, 0xb7, 0xbf,
-	0x88, 0x00, 0x4f, 0xb6, 0x7f, 0x12, 0x86, 0x52, 0x94, 0xf1, 0xef, 0x0a, 0x50, 0xda, 0x5c, 0x5a,
-	0x3e, 0x75, 0xfb, 0x14, 0x69, 0xc3, 0x78, 0x5b, 0x5c, 0x75, 0x92, 0xfb, 0xb4, 0xa3, 0xbc, 0x32,
-	0x45, 0xa8, 0x41, 0xf2, 0x01, 0x15, 0x77, 0x63, 0x1f, 0xc6, 0x36, 0x97, 0xe4, 0x76, 0xf3, 0x94,
-	0x6d, 0x70, 0x3f, 0x0f, 0x91, 0xf6, 0x79, 0xfa, 0xc2, 0xbf, 0x5d, 0x80, 0xa4, 0xc2, 0x7d, 0xfa,
-	0x55, 0xf8, 0xbd, 0x02, 0xa4, 0x32, 0xe7, 0x90, 0x97, 0x65, 0xee, 0xf8, 0xe4, 0x49, 0x2f, 0x95,
-	0x3b, 0x9e, 0x24, 0xa9, 0xb5, 0x1c, 0xf2, 0xef, 0xb3, 0x9d, 0xbb, 0x1e, 0xbb, 0x25, 0xa7, 0x8c,
-	0xe1, 0x5d, 0x96, 0x99, 0x91, 0x60, 0xf2, 0x34, 0xa2, 0x8e, 0xc2, 0xa4, 0x5c, 0xe3, 0x1f, 0x17,
-	0x61, 0xec, 0xd4, 0x92, 0x05, 0xd2, 0x84, 0x47, 0x78, 0x31, 0xe7, 0x8c, 0x30, 0xd0, 0x11, 0xdc,
-	0x49, 0x39, 0x82, 0x6f, 0xe6, 0x15, 0xf4, 0x64, 0xff, 0xef, 0x3f, 0x2f, 0x80, 0x9c, 0x8f, 0x56,
-	0xdc, 0x20, 0x34, 0x5d, 0x8b, 0x12, 0x2b, 0x9a, 0xfc, 0xf2, 0x7a, 0x05, 0xe5, 0x49, 0x3d, 0xb1,
-	0xde, 0xf1, 0xff, 0x6a, 0xb2, 0x23, 0x9f, 0x81, 0xea, 0x8e, 0x17, 0x84, 0x6e, 0xac, 0x41, 0x47,
-	0xd6, 0xd3, 0xdb, 0x12, 0x8e, 0x11, 0x45, 0x3a, 0x92, 0xb2, 0x32, 0x38, 0x92, 0xd2, 0xf8, 0x1a,
-	0x4c, 0xa7, 0x33, 0x1e, 0xde, 0xca, 0xcc, 0x78, 0xf8, 0xdc, 0x80, 0x8c, 0x87, 0xf5, 0xc1, 0xd9,
-	0x0e, 0x7f, 0xb5, 0x08, 0x13, 0x1f, 0x95, 0x4c, 0x87, 0x59, 0x47, 0x75, 0x4b, 0x39, 0x8f, 0xea,
-	0x96, 0x8f, 0x73, 0x54, 0xd7, 0xf8, 0x51, 0x01, 0xe0, 0xd4, 0xd2, 0x2c, 0xb6, 0x92, 0x11, 0x05,
-	0xb9, 0xfb, 0x6c, 0x76, 0x20, 0xc1, 0x6f, 0x8e, 0xab, 0x57, 0xe2, 0xee, 0xd9, 0xf7, 0x0b, 0x30,
-	0x65, 0x26, 0x4e, 0xa5, 0xe6, 0xd6, 0xd7, 0x52, 0x87, 0x5c, 0xa3, 0xa3, 0x4c, 0x49, 0x38, 0xa6,
-	0xc4, 0xf2, 0x83, 0x14, 0xd2, 0x77, 0xae, 0x6d, 0x4a, 0xfb, 0x2e, 0x56, 0x93, 0x07, 0x29, 0xb4,
-	0xa7, 0x0f, 0x38, 0x05, 0x5c, 0x1a, 0xc9, 0x29, 0x60, 0xdd, 0x93, 0x58, 0x7e, 0xa2, 0x27, 0x71,
-	0x0f, 0x6a, 0xdb, 0xbe, 0xd7, 0xe1, 0x07, 0x6d, 0x67, 0x2a, 0xfc, 0x53, 0xde, 0xcc, 0x73, 0x2d,
-	0xd4, 0x96, 0xed, 0xd2, 0x16, 0x3f, 0xc4, 0x1b, 0x6d, 0xd0, 0x97, 0x15, 0x7f, 0x8c, 0x45, 0x71,
-	0x97, 0x92, 0x27, 0xa4, 0x8e, 0x8d, 0x52, 0x6a, 0x34, 0x4f, 0x6d, 0x08, 0xee, 0xa8, 0xc4, 0x24,
-	0x0f, 0xd7, 0x8e, 0x9f, 0xd2, 0xe1, 0xda, 0x7d, 0xfd, 0xcc, 0x72, 0x35, 0xa7, 0xb5, 0xed, 0x58,
-	0x89, 0xf1, 0x9e, 0xa2, 0xe3, 0xae, 0x7f, 0x65, 0x5c, 0xcd, 0xe2, 0x4f, 0xdd, 0x15, 0x44, 0x1f,
-	0xa7, 0xe6, 0x6b, 0xd3, 0xbe, 0xbc, 0x79, 0xd5, 0x53, 0xcc, 0x9b, 0x57, 0x1b, 0x4d, 0xde, 0x3c,
-	0xc8, 0x97, 0x37, 0xaf, 0x3e, 0xa2, 0xbc, 0x79, 0x13, 0xa3, 0xca, 0x9b, 0x37, 0x39, 0x54, 0xde,
-	0xbc, 0xa9, 0x23, 0xe5, 0xcd, 0x3b, 0x28, 0x41, 0x6a, 0x47, 0xfc, 0xb1, 0x93, 0xfb, 0x4f, 0x94,
-	0x93, 0xfb, 0x7b, 0x45, 0x88, 0x57, 0xa3, 0x63, 0x86, 0xc5, 0xbf, 0xc5, 0x4f, 0x26, 0xf2, 0x83,
-	0xd1, 0x43, 0x2a, 0xc9, 0x13, 0xf2, 0x14, 0x23, 0xe7, 0x81, 0x11, 0x37, 0x12, 0x00, 0xd8, 0xd1,
-	0x55, 0x9e, 0xb9, 0xdd, 0x85, 0xf1, 0xad, 0xa0, 0xc2, 0x52, 0x19, 0x3f, 0xa3, 0x26, 0xc6, 0xf8,
-	0x9d, 0x12, 0xc8, 0x3b, 0x5f, 0x09, 0x85, 0xca, 0xb6, 0xfd, 0x88, 0xb6, 0x72, 0x47, 0x9e, 0x2e,
-	0x33, 0x2e, 0xf2, 0x62, 0x59, 0xee, 0x0f, 0xe5, 0x00, 0x14, 0xdc, 0xb9, 0xa3, 0x4b, 0xf8, 0xb7,
-	0x65, 0xfb, 0xe5, 0x70, 0x74, 0xe9, 0x7e, 0x72, 0xe9, 0xe8, 0x12, 0x20, 0x54, 0x32, 0x84, 0x5f,
-	0x4d, 0x5c, 0xea, 0x59, 0xca, 0xed, 0x57, 0xd3, 0x42, 0xa6, 0x94, 0x5f, 0x4d, 0x5c, 0xe9, 0xa9,
-	0x64, 0x90, 0x6f, 0x42, 0xdd, 0xb4, 0xac, 0x5e, 0xa7, 0xe7, 0x70, 0xbb, 0x6c, 0xde, 0xf4, 0x92,
-	0x0b, 0x31, 0x2f, 0x29, 0x96, 0x6f, 0xb1, 0x34, 0x30, 0xea, 0xf2, 0x1a, 0x5f, 0xff, 0xe1, 0x4f,
-	0xae, 0x7e, 0xe2, 0x47, 0x3f, 0xb9, 0xfa, 0x89, 0x1f, 0xff, 0xe4, 0xea, 0x27, 0xbe, 0x7d, 0x78,
-	0xb5, 0xf0, 0xc3, 0xc3, 0xab, 0x85, 0x1f, 0x1d, 0x5e, 0x2d, 0xfc, 0xf8, 0xf0, 0x6a, 0xe1, 0xdf,
-	0x1f, 0x5e, 0x2d, 0xfc, 0xb5, 0xff, 0x70, 0xf5, 0x13, 0x5f, 0xfb, 0x7c, 0x5c, 0x9d, 0x79, 0x55,
-	0x9d, 0x79, 0x25, 0x7c, 0xbe, 0xbb, 0xdb, 0x9e, 0x67, 0xd5, 0x89, 0x21, 0xaa, 0x3a, 0xff, 0x2f,
-	0x00, 0x00, 0xff, 0xff, 0x4d, 0x7b, 0x84, 0x95, 0x06, 0xb5, 0x00, 0x00,
+	0x75, 0x98, 0xfa, 0xc9, 0xee, 0xd3, 0x7c, 0xcc, 0xdc, 0x79, 0x6c, 0xed, 0x68, 0x77, 0x38, 0xaa,
+	0xb5, 0xe4, 0x4d, 0x6c, 0x73, 0xb2, 0x23, 0xad, 0xb4, 0xb2, 0x6c, 0xad, 0xd8, 0xe4, 0x70, 0x86,
+	0x3b, 0xe4, 0x0c, 0x75, 0x9a, 0x9c, 0x91, 0xb4, 0x96, 0x36, 0xc5, 0xee, 0xcb, 0x66, 0x2d, 0xab,
+	0xab, 0x7a, 0xaa, 0xaa, 0x39, 0xc3, 0x75, 0x64, 0x29, 0x12, 0x12, 0x29, 0xce, 0x47, 0x02, 0xf9,
+	0xc3, 0x46, 0x8c, 0x38, 0x08, 0x10, 0xc0, 0x08, 0x0c, 0x25, 0xb0, 0x13, 0xe5, 0xc3, 0x1f, 0x49,
+	0x1c, 0x20, 0x8e, 0x10, 0x47, 0x89, 0x20, 0xf8, 0x43, 0x41, 0x12, 0x22, 0x62, 0x90, 0x8f, 0x04,
+	0x49, 0xe0, 0xc0, 0x40, 0x60, 0x4f, 0x82, 0x38, 0xb8, 0x8f, 0xba, 0x75, 0xab, 0xba, 0x7a, 0x86,
+	0xec, 0x6a, 0xce, 0xce, 0x3a, 0xfb, 0xd5, 0x5d, 0xf7, 0x9c, 0x7b, 0xce, 0xad, 0x5b, 0xf7, 0x71,
+	0xee, 0x79, 0x5d, 0xb8, 0xd1, 0xb5, 0xc3, 0xdd, 0xc1, 0xf6, 0x42, 0xdb, 0xeb, 0x5d, 0x75, 0x07,
+	0x3d, 0xab, 0xef, 0x7b, 0x6f, 0xf3, 0x3f, 0x3b, 0x8e, 0xf7, 0xe0, 0x6a, 0x7f, 0xaf, 0x7b, 0xd5,
+	0xea, 0xdb, 0x41, 0x5c, 0xb2, 0xff, 0x8a, 0xe5, 0xf4, 0x77, 0xad, 0x57, 0xae, 0x76, 0xa9, 0x4b,
+	0x7d, 0x2b, 0xa4, 0x9d, 0x85, 0xbe, 0xef, 0x85, 0x1e, 0xf9, 0x44, 0x4c, 0x68, 0x21, 0x22, 0xb4,
+	0x10, 0x55, 0x5b, 0xe8, 0xef, 0x75, 0x17, 0x18, 0xa1, 0xb8, 0x24, 0x22, 0x74, 0xe9, 0xa7, 0xb4,
+	0x16, 0x74, 0xbd, 0xae, 0x77, 0x95, 0xd3, 0xdb, 0x1e, 0xec, 0xf0, 0x27, 0xfe, 0xc0, 0xff, 0x09,
+	0x3e, 0x97, 0xcc, 0xbd, 0xd7, 0x82, 0x05, 0xdb, 0x63, 0xcd, 0xba, 0xda, 0xf6, 0x7c, 0x7a, 0x75,
+	0x7f, 0xa8, 0x2d, 0x97, 0x3e, 0x16, 0xe3, 0xf4, 0xac, 0xf6, 0xae, 0xed, 0x52, 0xff, 0x20, 0x7a,
+	0x97, 0xab, 0x3e, 0x0d, 0xbc, 0x81, 0xdf, 0xa6, 0x27, 0xaa, 0x15, 0x5c, 0xed, 0xd1, 0xd0, 0xca,
+	0xe2, 0x75, 0x75, 0x54, 0x2d, 0x7f, 0xe0, 0x86, 0x76, 0x6f, 0x98, 0xcd, 0xc7, 0x9f, 0x54, 0x21,
+	0x68, 0xef, 0xd2, 0x9e, 0x35, 0x54, 0xef, 0xa3, 0xa3, 0xea, 0x0d, 0x42, 0xdb, 0xb9, 0x6a, 0xbb,
+	0x61, 0x10, 0xfa, 0xe9, 0x4a, 0xe6, 0xef, 0x00, 0x9c, 0x5b, 0xdc, 0x0e, 0x42, 0xdf, 0x6a, 0x87,
+	0x1b, 0x5e, 0x67, 0x93, 0xf6, 0xfa, 0x8e, 0x15, 0x52, 0xb2, 0x07, 0x35, 0xf6, 0x42, 0x1d, 0x2b,
+	0xb4, 0x8c, 0xc2, 0x95, 0xc2, 0xcb, 0x8d, 0x6b, 0x8b, 0x0b, 0x63, 0x7e, 0xc0, 0x85, 0x75, 0x49,
+	0xa8, 0x39, 0x7d, 0x74, 0x38, 0x5f, 0x8b, 0x9e, 0x50, 0x31, 0x20, 0xbf, 0x52, 0x80, 0x69, 0xd7,
+	0xeb, 0xd0, 0x16, 0x75, 0x68, 0x3b, 0xf4, 0x7c, 0xa3, 0x78, 0xa5, 0xf4, 0x72, 0xe3, 0xda, 0x97,
+	0xc6, 0xe6, 0x98, 0xf1, 0x46, 0x0b, 0xb7, 0x35, 0x06, 0xd7, 0xdd, 0xd0, 0x3f, 0x68, 0x9e, 0xff,
+	0xee, 0xe1, 0xfc, 0x07, 0x8e, 0x0e, 0xe7, 0xa7, 0x75, 0x10, 0x26, 0x5a, 0x42, 0xb6, 0xa0, 0x11,
+	0x7a, 0x0e, 0xeb, 0x32, 0xdb, 0x73, 0x03, 0xa3, 0xc4, 0x1b, 0x76, 0x79, 0x41, 0x74, 0x35, 0x63,
+	0xbf, 0xc0, 0xc6, 0xd8, 0xc2, 0xfe, 0x2b, 0x0b, 0x9b, 0x0a, 0xad, 0x79, 0x4e, 0x12, 0x6e, 0xc4,
+	0x65, 0x01, 0xea, 0x74, 0x08, 0x85, 0xb9, 0x80, 0xb6, 0x07, 0xbe, 0x1d, 0x1e, 0x2c, 0x79, 0x6e,
+	0x48, 0x1f, 0x86, 0x46, 0x99, 0xf7, 0xf2, 0x47, 0xb2, 0x48, 0x6f, 0x78, 0x9d, 0x56, 0x12, 0xbb,
+	0x79, 0xee, 0xe8, 0x70, 0x7e, 0x2e, 0x55, 0x88, 0x69, 0x9a, 0xc4, 0x85, 0x33, 0x76, 0xcf, 0xea,
+	0xd2, 0x8d, 0x81, 0xe3, 0xb4, 0x68, 0xdb, 0xa7, 0x61, 0x60, 0x54, 0xf8, 0x2b, 0xbc, 0x9c, 0xc5,
+	0x67, 0xcd, 0x6b, 0x5b, 0xce, 0x9d, 0xed, 0xb7, 0x69, 0x3b, 0x44, 0xba, 0x43, 0x7d, 0xea, 0xb6,
+	0x69, 0xd3, 0x90, 0x2f, 0x73, 0x66, 0x35, 0x45, 0x09, 0x87, 0x68, 0x93, 0x1b, 0x70, 0xb6, 0xef,
+	0xdb, 0x1e, 0x6f, 0x82, 0x63, 0x05, 0xc1, 0x6d, 0xab, 0x47, 0x8d, 0xea, 0x95, 0xc2, 0xcb, 0xf5,
+	0xe6, 0xf3, 0x92, 0xcc, 0xd9, 0x8d, 0x34, 0x02, 0x0e, 0xd7, 0x21, 0x2f, 0x43, 0x2d, 0x2a, 0x34,
+	0xa6, 0xae, 0x14, 0x5e, 0xae, 0x88, 0xb1, 0x13, 0xd5, 0x45, 0x05, 0x25, 0x2b, 0x50, 0xb3, 0x76,
+	0x76, 0x6c, 0x97, 0x61, 0xd6, 0x78, 0x17, 0xbe, 0x90, 0xf5, 0x6a, 0x8b, 0x12, 0x47, 0xd0, 0x89,
+	0x9e, 0x50, 0xd5, 0x25, 0x6f, 0x00, 0x09, 0xa8, 0xbf, 0x6f, 0xb7, 0xe9, 0x62, 0xbb, 0xed, 0x0d,
+	0xdc, 0x90, 0xb7, 0xbd, 0xce, 0xdb, 0x7e, 0x49, 0xb6, 0x9d, 0xb4, 0x86, 0x30, 0x30, 0xa3, 0x16,
+	0xf9, 0x0c, 0x9c, 0x91, 0x73, 0x35, 0xee, 0x05, 0xe0, 0x94, 0xce, 0xb3, 0x8e, 0xc4, 0x14, 0x0c,
+	0x87, 0xb0, 0x49, 0x07, 0x5e, 0xb0, 0x06, 0xa1, 0xd7, 0x63, 0x24, 0x93, 0x4c, 0x37, 0xbd, 0x3d,
+	0xea, 0x1a, 0x8d, 0x2b, 0x85, 0x97, 0x6b, 0xcd, 0x2b, 0x47, 0x87, 0xf3, 0x2f, 0x2c, 0x3e, 0x06,
+	0x0f, 0x1f, 0x4b, 0x85, 0xdc, 0x81, 0x7a, 0xc7, 0x0d, 0x36, 0x3c, 0xc7, 0x6e, 0x1f, 0x18, 0xd3,
+	0xbc, 0x81, 0xaf, 0xc8, 0x57, 0xad, 0x2f, 0xdf, 0x6e, 0x09, 0xc0, 0xa3, 0xc3, 0xf9, 0x17, 0x86,
+	0x97, 0xd4, 0x05, 0x05, 0xc7, 0x98, 0x06, 0x59, 0xe7, 0x04, 0x97, 0x3c, 0x77, 0xc7, 0xee, 0x1a,
+	0x33, 0xfc, 0x6b, 0x5c, 0x19, 0x31, 0xa0, 0x97, 0x6f, 0xb7, 0x04, 0x5e, 0x73, 0x46, 0xb2, 0x13,
+	0x8f, 0x18, 0x53, 0x20, 0x1d, 0x98, 0x8d, 0x16, 0xe3, 0x25, 0xc7, 0xb2, 0x7b, 0x81, 0x31, 0xcb,
+	0x07, 0xef, 0x8f, 0x8d, 0xa0, 0x89, 0x3a, 0x72, 0xf3, 0xa2, 0x7c, 0x95, 0xd9, 0x44, 0x71, 0x80,
+	0x29, 0x9a, 0x97, 0x5e, 0x87, 0xb3, 0x43, 0x6b, 0x03, 0x39, 0x03, 0xa5, 0x3d, 0x7a, 0xc0, 0x97,
+	0xbe, 0x3a, 0xb2, 0xbf, 0xe4, 0x3c, 0x54, 0xf6, 0x2d, 0x67, 0x40, 0x8d, 0x22, 0x2f, 0x13, 0x0f,
+	0x3f, 0x5d, 0x7c, 0xad, 0x60, 0x7e, 0xaf, 0x02, 0xd3, 0xd1, 0x8a, 0xd3, 0xb2, 0xdd, 0x3d, 0x72,
+	0x0f, 0x4a, 0x8e, 0xd7, 0x95, 0xeb, 0xe6, 0xcf, 0x8c, 0xbd, 0x8a, 0xad, 0x79, 0xdd, 0xe6, 0xd4,
+	0xd1, 0xe1, 0x7c, 0x69, 0xcd, 0xeb, 0x22, 0xa3, 0x48, 0xda, 0x50, 0xd9, 0xb3, 0x76, 0xf6, 0x2c,
+	0xde, 0x86, 0xc6, 0xb5, 0xe6, 0xd8, 0xa4, 0x6f, 0x31, 0x2a, 0xac, 0xad, 0xcd, 0xfa, 0xd1, 0xe1,
+	0x7c, 0x85, 0x3f, 0xa2, 0xa0, 0x4d, 0x3c, 0xa8, 0x6f, 0x3b, 0x56, 0x7b, 0x6f, 0xd7, 0x73, 0xa8,
+	0x51, 0xca, 0xc9, 0xa8, 0x19, 0x51, 0x12, 0x9f, 0x59, 0x3d, 0x62, 0xcc, 0x83, 0xb4, 0xa1, 0x3a,
+	0xe8, 0x04, 0xb6, 0xbb, 0x27, 0xd7, 0xc0, 0xd7, 0xc7, 0xe6, 0xb6, 0xb5, 0xcc, 0xdf, 0x09, 0x8e,
+	0x0e, 0xe7, 0xab, 0xe2, 0x3f, 0x4a, 0xd2, 0xac, 0xeb, 0xd8, 0x4c, 0xa5, 0x46, 0x25, 0xe7, 0x1b,
+	0xb1, 0x89, 0x44, 0xe3, 0xae, 0xe3, 0x8f, 0x28, 0x68, 0x93, 0x37, 0xa1, 0x14, 0xdc, 0x0f, 0xf8,
+	0x8a, 0xd7, 0xb8, 0xf6, 0x99, 0xf1, 0x59, 0xdc, 0x0f, 0x38, 0x03, 0xfe, 0xf1, 0x5b, 0xf7, 0x03,
+	0x64, 0x54, 0x49, 0x17, 0xaa, 0xfd, 0x81, 0x13, 0x58, 0x3e, 0x5f, 0x11, 0x1b, 0xd7, 0x96, 0xc6,
+	0xa6, 0xbf, 0xc1, 0xc9, 0xc4, 0x5d, 0x25, 0x9e, 0x51, 0x92, 0x37, 0xff, 0x68, 0x1a, 0x66, 0xa3,
+	0xf1, 0x7c, 0x97, 0xfa, 0x21, 0x7d, 0x48, 0xae, 0x40, 0xd9, 0x65, 0xab, 0x18, 0x9f, 0x0f, 0xcd,
+	0x69, 0x39, 0xb3, 0xca, 0x7c, 0xf5, 0xe2, 0x10, 0xf6, 0x11, 0xc5, 0xac, 0x32, 0x8a, 0x39, 0x3f,
+	0x62, 0x8b, 0x93, 0x11, 0x2d, 0x13, 0xff, 0x51, 0x92, 0x26, 0x6f, 0x42, 0x99, 0x8f, 0x13, 0x31,
+	0x2a, 0x7f, 0x76, 0x7c, 0x16, 0xec, 0xd5, 0x6b, 0xec, 0x0d, 0xd8, 0x3f, 0x2c, 0x07, 0x72, 0xd6,
+	0x0e, 0x3a, 0x3b, 0x46, 0x39, 0xe7, 0xac, 0xdd, 0x5a, 0x5e, 0x11, 0x1f, 0x6e, 0x6b, 0x79, 0x05,
+	0x19, 0x45, 0xf2, 0xd7, 0x0a, 0x70, 0xb6, 0xed, 0xb9, 0xa1, 0xc5, 0x44, 0xb2, 0x48, 0x1e, 0x91,
+	0xe3, 0xf0, 0x8d, 0xb1, 0xf9, 0x2c, 0xa5, 0x29, 0x36, 0x2f, 0xb0, 0xed, 0x75, 0xa8, 0x18, 0x87,
+	0x79, 0x93, 0x5f, 0x2d, 0xc0, 0x05, 0xb6, 0xed, 0x0d, 0x21, 0x1b, 0xd5, 0x89, 0xb7, 0xea, 0xf9,
+	0xa3, 0xc3, 0xf9, 0x0b, 0xab, 0x59, 0xcc, 0x30, 0xbb, 0x0d, 0xac, 0x75, 0xe7, 0xac, 0x61, 0x09,
+	0x4e, 0x0e, 0xfb, 0xb5, 0x49, 0x4a, 0x85, 0xcd, 0x0f, 0xca, 0xa1, 0x9c, 0x25, 0x04, 0x63, 0x56,
+	0x2b, 0xc8, 0x75, 0x98, 0xda, 0xf7, 0x9c, 0x41, 0x8f, 0x06, 0x46, 0x8d, 0xef, 0x46, 0x97, 0xb2,
+	0x76, 0xa3, 0xbb, 0x1c, 0xa5, 0x39, 0x27, 0xc9, 0x4f, 0x89, 0xe7, 0x00, 0xa3, 0xba, 0xc4, 0x86,
+	0xaa, 0x63, 0xf7, 0xec, 0x30, 0xe0, 0x32, 0x46, 0xe3, 0xda, 0xf5, 0xb1, 0x5f, 0x4b, 0x4c, 0xd1,
+	0x35, 0x4e, 0x4c, 0xcc, 0x1a, 0xf1, 0x1f, 0x25, 0x03, 0xbe, 0xf4, 0xb5, 0x2d, 0x47, 0xc8, 0x20,
+	0x8d, 0x6b, 0x9f, 0x1e, 0x7f, 0xda, 0x30, 0x2a, 0xcd, 0x19, 0xf9, 0x4e, 0x15, 0xfe, 0x88, 0x82,
+	0x36, 0xf9, 0x22, 0xcc, 0x26, 0xbe, 0x66, 0x60, 0x34, 0x78, 0xef, 0xbc, 0x98, 0xd5, 0x3b, 0x0a,
+	0x2b, 0xde, 0xa4, 0x13, 0x23, 0x24, 0xc0, 0x14, 0x31, 0x72, 0x0b, 0x6a, 0x81, 0xdd, 0xa1, 0x6d,
+	0xcb, 0x0f, 0x8c, 0xe9, 0xe3, 0x10, 0x3e, 0x23, 0x09, 0xd7, 0x5a, 0xb2, 0x1a, 0x2a, 0x02, 0x64,
+	0x01, 0xa0, 0x6f, 0xf9, 0xa1, 0x2d, 0x64, 0xfa, 0x19, 0x2e, 0x5f, 0xce, 0x1e, 0x1d, 0xce, 0xc3,
+	0x86, 0x2a, 0x45, 0x0d, 0x83, 0xe1, 0xb3, 0xba, 0xab, 0x6e, 0x7f, 0x10, 0x0a, 0x19, 0xa4, 0x2e,
+	0xf0, 0x5b, 0xaa, 0x14, 0x35, 0x0c, 0xf2, 0xed, 0x02, 0x7c, 0x30, 0x7e, 0x1c, 0x9e, 0x64, 0x73,
+	0x13, 0x9f, 0x64, 0xf3, 0x47, 0x87, 0xf3, 0x1f, 0x6c, 0x8d, 0x66, 0x89, 0x8f, 0x6b, 0x0f, 0xf9,
+	0x46, 0x01, 0x66, 0x07, 0xfd, 0x8e, 0x15, 0xd2, 0x56, 0xe8, 0x5b, 0x21, 0xed, 0x1e, 0x18, 0x67,
+	0x78, 0x13, 0x6f, 0x8c, 0xbf, 0x0a, 0x26, 0xc8, 0xc5, 0x9f, 0x39, 0x59, 0x8e, 0x29, 0xb6, 0xe6,
+	0xdb, 0x70, 0x76, 0xb1, 0xdd, 0x1e, 0xf4, 0x06, 0x8e, 0x15, 0x7a, 0xfe, 0x3d, 0xdb, 0xed, 0x78,
+	0x0f, 0xc8, 0x16, 0x4c, 0x31, 0xe9, 0xd8, 0x1b, 0x84, 0x52, 0xa4, 0x5a, 0xd0, 0x3e, 0xbd, 0x3a,
+	0xea, 0xc6, 0xad, 0xe9, 0xd1, 0xd0, 0x62, 0x83, 0x61, 0x79, 0x20, 0xcf, 0x63, 0x0d, 0x36, 0x03,
+	0x37, 0x05, 0x09, 0x8c, 0x68, 0x99, 0xf7, 0x60, 0x66, 0x71, 0x10, 0xee, 0x7a, 0xbe, 0xfd, 0x0e,
+	0x47, 0x23, 0x2b, 0x50, 0x09, 0xb9, 0x74, 0x2d, 0xb8, 0x7c, 0x38, 0x6b, 0x80, 0x89, 0x93, 0xce,
+	0x2d, 0x7a, 0x10, 0x89, 0x8b, 0x42, 0x0a, 0x10, 0xd2, 0xb6, 0xa8, 0x6e, 0xfe, 0x72, 0x11, 0xa6,
+	0x9a, 0x56, 0x7b, 0xcf, 0xdb, 0xd9, 0x21, 0x9f, 0x83, 0x9a, 0xed, 0x86, 0xd4, 0xdf, 0xb7, 0x9c,
+	0x31, 0x1b, 0xcf, 0x0f, 0x2c, 0xab, 0x92, 0x06, 0x2a, 0x6a, 0x64, 0x1e, 0x2a, 0x41, 0x48, 0xfb,
+	0x01, 0xdf, 0x6f, 0x67, 0xa4, 0x30, 0xc2, 0x0a, 0x50, 0x94, 0x13, 0x13, 0xaa, 0x3b, 0x16, 0x3f,
+	0x4e, 0xb3, 0xed, 0xb2, 0x20, 0x96, 0x86, 0x15, 0x5e, 0x82, 0x12, 0x42, 0x56, 0xa1, 0xd4, 0xb6,
+	0xfa, 0x46, 0x79, 0xac, 0x96, 0xf1, 0x5d, 0x6e, 0xc9, 0xea, 0x23, 0xa3, 0xc1, 0xd8, 0xbd, 0x6d,
+	0x87, 0x21, 0xf5, 0x8d, 0x4a, 0xcc, 0xee, 0x0d, 0x5e, 0x82, 0x12, 0x62, 0xfe, 0xed, 0x02, 0xd4,
+	0x9b, 0x56, 0x60, 0xb7, 0x59, 0xc7, 0x93, 0x25, 0x28, 0x0f, 0x02, 0xea, 0x9f, 0xac, 0xbb, 0xf9,
+	0xae, 0xbd, 0x15, 0x50, 0x1f, 0x79, 0x65, 0x72, 0x07, 0x6a, 0x7d, 0x2b, 0x08, 0x1e, 0x78, 0x7e,
+	0xc7, 0x28, 0x9e, 0x84, 0x90, 0x38, 0x50, 0xca, 0xaa, 0xa8, 0x88, 0x98, 0x0d, 0x88, 0xa5, 0x54,
+	0xf3, 0x0f, 0x0b, 0x70, 0xae, 0x39, 0xd8, 0xd9, 0xa1, 0xbe, 0x3c, 0x3f, 0xc9, 0x93, 0x09, 0x85,
+	0x8a, 0x4f, 0x3b, 0x76, 0x20, 0xdb, 0xbe, 0x3c, 0xf6, 0x3c, 0x41, 0x46, 0x45, 0x1e, 0x84, 0xf8,
+	0x27, 0xe4, 0x05, 0x28, 0xa8, 0x93, 0x01, 0xd4, 0xdf, 0xa6, 0x61, 0x10, 0xfa, 0xd4, 0xea, 0xc9,
+	0xb7, 0xbb, 0x39, 0x36, 0xab, 0x37, 0x68, 0xd8, 0xe2, 0x94, 0xf4, 0x73, 0x97, 0x2a, 0xc4, 0x98,
+	0x93, 0xf9, 0x79, 0x98, 0x5d, 0xda, 0xd8, 0xe2, 0xcb, 0xbb, 0x3c, 0xd8, 0xdd, 0x80, 0xb3, 0xa1,
+	0xe5, 0x77, 0x69, 0xb8, 0x15, 0xda, 0x8e, 0x9c, 0x2f, 0xfc, 0xdd, 0x67, 0xe2, 0x83, 0xfd, 0x66,
+	0x1a, 0x01, 0x87, 0xeb, 0x98, 0xbf, 0x53, 0x81, 0xe9, 0x25, 0xaf, 0xb7, 0x6d, 0xbb, 0xb4, 0x73,
+	0xbd, 0xd3, 0xa5, 0xe4, 0x2d, 0x28, 0xd3, 0x4e, 0x97, 0x1a, 0x85, 0x9c, 0x22, 0x1d, 0x23, 0x16,
+	0x0b, 0xa6, 0xec, 0x09, 0x39, 0x61, 0xb2, 0x06, 0xb3, 0x3b, 0xbe, 0xd7, 0x13, 0xbb, 0xe4, 0xe6,
+	0x41, 0x5f, 0x1e, 0xe0, 0x9a, 0x3f, 0x16, 0x2d, 0x49, 0x2b, 0x09, 0xe8, 0xa3, 0xc3, 0x79, 0x88,
+	0x9f, 0x30, 0x55, 0x97, 0x7c, 0x0e, 0x8c, 0xb8, 0x44, 0x6d, 0x17, 0x4b, 0xec, 0x4c, 0xcd, 0xa7,
+	0x59, 0xa5, 0xf9, 0xc2, 0xd1, 0xe1, 0xbc, 0xb1, 0x32, 0x02, 0x07, 0x47, 0xd6, 0x66, 0x8b, 0xf0,
+	0x99, 0x18, 0x28, 0xb6, 0x70, 0xa3, 0x3c, 0x49, 0xd9, 0x80, 0x2b, 0x1f, 0x56, 0x52, 0x2c, 0x70,
+	0x88, 0x29, 0x59, 0x81, 0xe9, 0xd0, 0xd3, 0xfa, 0xab, 0xc2, 0xfb, 0xcb, 0x8c, 0xb4, 0x65, 0x9b,
+	0xde, 0xc8, 0xde, 0x4a, 0xd4, 0x23, 0x08, 0x17, 0x43, 0x2f, 0xeb, 0x5d, 0xb9, 0x94, 0x59, 0x69,
+	0x5e, 0x3a, 0x3a, 0x9c, 0xbf, 0xb8, 0x99, 0x89, 0x81, 0x23, 0x6a, 0x92, 0xbf, 0x58, 0x80, 0xd9,
+	0xd0, 0xd3, 0x9b, 0x6b, 0x4c, 0x4d, 0xb2, 0x8f, 0x08, 0x1b, 0x11, 0x9b, 0x09, 0x06, 0x98, 0x62,
+	0x68, 0x36, 0xa1, 0xb1, 0xe4, 0xf5, 0xfa, 0x3e, 0x0d, 0x02, 0xb6, 0x6d, 0x7c, 0x14, 0xca, 0xe1,
+	0x41, 0x5f, 0x8c, 0xe0, 0x7a, 0x73, 0x3e, 0x1a, 0x82, 0xb2, 0x7b, 0xe6, 0x34, 0x54, 0xde, 0x47,
+	0x1c, 0xd9, 0xfc, 0xce, 0x14, 0xd4, 0xd5, 0x46, 0x4c, 0x5e, 0x82, 0x0a, 0xd7, 0xa5, 0x49, 0x1a,
+	0x4a, 0xc2, 0xe2, 0x2a, 0x37, 0x14, 0x30, 0xf2, 0x61, 0x98, 0x6a, 0x7b, 0xbd, 0x9e, 0xe5, 0x76,
+	0xb8, 0x7e, 0xb4, 0x2e, 0xb6, 0xb5, 0x25, 0x51, 0x84, 0x11, 0x8c, 0xbc, 0x00, 0x65, 0xcb, 0xef,
+	0x0a, 0x55, 0x65, 0x5d, 0x2c, 0x97, 0x8b, 0x7e, 0x37, 0x40, 0x5e, 0x4a, 0x3e, 0x09, 0x25, 0xea,
+	0xee, 0x1b, 0xe5, 0xd1, 0x92, 0xeb, 0x75, 0x77, 0xff, 0xae, 0xe5, 0x37, 0x1b, 0xb2, 0x0d, 0xa5,
+	0xeb, 0xee, 0x3e, 0xb2, 0x3a, 0x64, 0x0d, 0xa6, 0xa8, 0xbb, 0xcf, 0xc6, 0x8f, 0xd4, 0x21, 0x7e,
+	0x68, 0x44, 0x75, 0x86, 0x22, 0x0f, 0x71, 0x4a, 0xfe, 0x95, 0xc5, 0x18, 0x91, 0x20, 0x9f, 0x87,
+	0x69, 0x21, 0x0a, 0xaf, 0xb3, 0xef, 0xca, 0xce, 0xcc, 0x8c, 0xe4, 0xfc, 0x68, 0x59, 0x9a, 0xe3,
+	0xc5, 0x3a, 0x5b, 0xad, 0x30, 0xc0, 0x04, 0x29, 0xf2, 0x79, 0xa8, 0x47, 0x2a, 0x9e, 0x68, 0x74,
+	0x64, 0xaa, 0x3b, 0x23, 0xbd, 0x10, 0xd2, 0xfb, 0x03, 0xdb, 0xa7, 0x3d, 0xea, 0x86, 0x41, 0xf3,
+	0x6c, 0xa4, 0x00, 0x8b, 0xa0, 0x01, 0xc6, 0xd4, 0xc8, 0xf6, 0xb0, 0xde, 0x56, 0x28, 0x1d, 0x5f,
+	0x1a, 0xb1, 0xe9, 0x8c, 0xa1, 0xb4, 0xfd, 0x12, 0xcc, 0x29, 0xc5, 0xaa, 0xd4, 0xcd, 0x09, 0x35,
+	0xe4, 0xc7, 0x58, 0xf5, 0xd5, 0x24, 0xe8, 0xd1, 0xe1, 0xfc, 0x8b, 0x19, 0xda, 0xb9, 0x18, 0x01,
+	0xd3, 0xc4, 0xc8, 0x3b, 0x4c, 0xab, 0x66, 0x75, 0x6c, 0x97, 0x06, 0xc1, 0x86, 0xef, 0x6d, 0xe7,
+	0x3f, 0x17, 0x70, 0x2a, 0x62, 0xea, 0x60, 0x82, 0x32, 0xa6, 0x38, 0x91, 0x07, 0x30, 0xe3, 0xd8,
+	0xfb, 0x34, 0x66, 0xdd, 0x98, 0x08, 0xeb, 0xb3, 0x47, 0x87, 0xf3, 0x33, 0x6b, 0x3a, 0x61, 0x4c,
+	0xf2, 0x61, 0xb2, 0x5d, 0xdf, 0xf3, 0xc3, 0xe8, 0xf0, 0xf0, 0xa1, 0xc7, 0x1e, 0x1e, 0x36, 0x3c,
+	0x3f, 0x8c, 0x27, 0x21, 0x7b, 0x0a, 0x50, 0x54, 0x37, 0xff, 0x61, 0x05, 0x86, 0x8f, 0xd8, 0xc9,
+	0x11, 0x57, 0x98, 0xf4, 0x88, 0x4b, 0x8f, 0x06, 0xb1, 0x7f, 0xbd, 0x26, 0xab, 0x4d, 0x60, 0x44,
+	0x64, 0x8c, 0xea, 0xd2, 0xa4, 0x47, 0xf5, 0x33, 0xb3, 0xf0, 0x0c, 0x0f, 0xff, 0xea, 0xbb, 0x37,
+	0xfc, 0xa7, 0x9e, 0xce, 0xf0, 0x37, 0xff, 0x4a, 0x81, 0xed, 0x59, 0x03, 0x37, 0x94, 0x47, 0xaa,
+	0x97, 0xa0, 0xc2, 0xed, 0x00, 0x7c, 0xb0, 0x56, 0xe2, 0xb1, 0x2e, 0x36, 0x5f, 0x01, 0xd3, 0xcf,
+	0x5d, 0xc5, 0x09, 0x9e, 0xbb, 0xbe, 0x59, 0x86, 0xd9, 0x65, 0x8b, 0xf6, 0x3c, 0xf7, 0x89, 0x1a,
+	0x9f, 0xc2, 0x33, 0xa1, 0xf1, 0x79, 0x19, 0x6a, 0x3e, 0xed, 0x3b, 0x76, 0xdb, 0x12, 0x87, 0x2d,
+	0x69, 0x8c, 0x42, 0x59, 0x86, 0x0a, 0x3a, 0x42, 0xd3, 0x57, 0x7a, 0x26, 0x35, 0x7d, 0xe5, 0x77,
+	0x5f, 0xd3, 0x67, 0x7e, 0x06, 0xce, 0x2c, 0x53, 0xab, 0xb3, 0x46, 0xc3, 0x90, 0xfa, 0x77, 0x06,
+	0x61, 0x7f, 0x10, 0x92, 0x9f, 0x84, 0x5a, 0x24, 0x6f, 0x49, 0x71, 0x48, 0xa9, 0x72, 0x22, 0xb9,
+	0x0c, 0x15, 0x86, 0xf9, 0xab, 0x05, 0x68, 0x2c, 0xd3, 0xce, 0xa0, 0x2f, 0x07, 0xf6, 0xcf, 0x41,
+	0xad, 0x23, 0x87, 0xdf, 0x98, 0xe7, 0x6d, 0xc5, 0x2d, 0x2a, 0x41, 0x45, 0x91, 0x29, 0x82, 0x7a,
+	0xd6, 0x43, 0x66, 0x21, 0xb2, 0x69, 0x34, 0x16, 0xb8, 0x22, 0x68, 0x5d, 0x95, 0xa2, 0x86, 0x61,
+	0x7e, 0xb3, 0x00, 0x8d, 0xeb, 0x96, 0xef, 0x1c, 0xac, 0xd8, 0xbe, 0xed, 0x76, 0x4f, 0x57, 0x1b,
+	0x20, 0x26, 0xb4, 0x68, 0x54, 0x3d, 0x3d, 0x99, 0xcd, 0xef, 0x97, 0x80, 0x9f, 0x8a, 0x98, 0x2a,
+	0x9f, 0x49, 0xfc, 0x69, 0x55, 0x3e, 0x5f, 0x24, 0x39, 0x84, 0x5c, 0x82, 0x62, 0xe8, 0xc9, 0x5d,
+	0x06, 0x24, 0xbc, 0xb8, 0xe9, 0x61, 0x31, 0xf4, 0xc8, 0x3b, 0x00, 0x6d, 0xcf, 0xed, 0xd8, 0x91,
+	0x39, 0x3c, 0xdf, 0x18, 0x5a, 0xf1, 0xfc, 0x07, 0x96, 0xdf, 0x59, 0x52, 0x14, 0x45, 0x6f, 0xc6,
+	0xcf, 0xa8, 0x71, 0x23, 0xaf, 0x43, 0xd5, 0x73, 0x57, 0x06, 0x8e, 0xc3, 0xc7, 0x6e, 0xbd, 0xf9,
+	0xe3, 0x4c, 0xc3, 0x70, 0x87, 0x97, 0x3c, 0x3a, 0x9c, 0x7f, 0x5e, 0x9c, 0xd3, 0xd9, 0xd3, 0x3d,
+	0xdf, 0x0e, 0x6d, 0xb7, 0xab, 0xb4, 0x4c, 0xb2, 0x1a, 0x59, 0x83, 0x69, 0xa5, 0xd5, 0xb3, 0xdd,
+	0xae, 0x3c, 0xd8, 0xbc, 0xcc, 0xc4, 0xc9, 0x0d, 0xad, 0xfc, 0xd1, 0xe1, 0xfc, 0x79, 0xfd, 0x59,
+	0xd1, 0x49, 0xd4, 0x26, 0x5f, 0x81, 0x99, 0x5d, 0x8f, 0xab, 0x14, 0x2c, 0x87, 0xb1, 0x93, 0xfb,
+	0xc8, 0xca, 0xd8, 0xbd, 0x71, 0x53, 0xa7, 0x26, 0x16, 0xf5, 0x44, 0x11, 0x26, 0xf9, 0x99, 0xdf,
+	0x2a, 0x40, 0x63, 0xc5, 0x7e, 0x48, 0x3b, 0x72, 0xec, 0x23, 0x54, 0x1d, 0xea, 0x76, 0xc3, 0xdd,
+	0x31, 0xc7, 0x96, 0xd0, 0x1d, 0x73, 0x0a, 0x28, 0x29, 0x91, 0xab, 0x50, 0x17, 0x4a, 0x01, 0xf6,
+	0x82, 0x45, 0x6e, 0x75, 0x56, 0xf2, 0x4a, 0x2b, 0x02, 0x60, 0x8c, 0x63, 0x7e, 0xbb, 0x00, 0x67,
+	0x87, 0x3e, 0x2b, 0xe9, 0x40, 0x39, 0xb4, 0xba, 0x91, 0x6c, 0x34, 0x7e, 0x17, 0x6d, 0x5a, 0x5d,
+	0x6d, 0xb0, 0xf0, 0xc3, 0xcd, 0xa6, 0xc5, 0x0e, 0x37, 0x8c, 0x3a, 0xb9, 0x06, 0x40, 0x1f, 0x46,
+	0x87, 0x2d, 0x39, 0x80, 0x89, 0x6c, 0x2d, 0x5c, 0x57, 0x10, 0xd4, 0xb0, 0xcc, 0xc3, 0x02, 0xd4,
+	0x56, 0x06, 0x6e, 0x9b, 0xcf, 0xef, 0x27, 0x9b, 0xb9, 0x06, 0x50, 0xdd, 0x7b, 0xc0, 0xcf, 0x57,
+	0xc2, 0x47, 0x65, 0x7d, 0xfc, 0xb1, 0x2f, 0x99, 0x2e, 0xdc, 0xe2, 0xf4, 0x84, 0x4b, 0xca, 0xac,
+	0x64, 0x59, 0xbd, 0x75, 0x8f, 0x1f, 0xda, 0x24, 0xb3, 0x4b, 0x9f, 0x84, 0x86, 0x86, 0x76, 0x22,
+	0xeb, 0xf4, 0x3f, 0x2a, 0x43, 0xf5, 0x46, 0xab, 0xb5, 0xb8, 0xb1, 0x4a, 0x5e, 0x85, 0x86, 0xf4,
+	0x56, 0xb8, 0x1d, 0xbf, 0xa5, 0x72, 0x56, 0x69, 0xc5, 0x20, 0xd4, 0xf1, 0x98, 0xb0, 0xe0, 0x53,
+	0xcb, 0xe9, 0xc9, 0x1e, 0x55, 0xc2, 0x02, 0xb2, 0x42, 0x14, 0x30, 0x62, 0xc1, 0x2c, 0xd3, 0xc7,
+	0xb1, 0x4e, 0x12, 0xba, 0x36, 0xa3, 0x74, 0x12, 0x6d, 0x1c, 0x97, 0x9e, 0xb6, 0x12, 0x04, 0x30,
+	0x45, 0x90, 0xbc, 0x06, 0x35, 0x6b, 0x10, 0xee, 0x72, 0x9d, 0x84, 0x58, 0x01, 0x5e, 0xe0, 0xce,
+	0x1c, 0xb2, 0xec, 0xd1, 0xe1, 0xfc, 0xf4, 0x2d, 0x6c, 0xbe, 0x1a, 0x3d, 0xa3, 0xc2, 0x66, 0x8d,
+	0x8b, 0xf4, 0x7b, 0xb2, 0x71, 0x95, 0x13, 0x37, 0x6e, 0x23, 0x41, 0x00, 0x53, 0x04, 0xc9, 0x9b,
+	0x30, 0xbd, 0x47, 0x0f, 0x42, 0x6b, 0x5b, 0x32, 0xa8, 0x9e, 0x84, 0xc1, 0x19, 0xb6, 0x04, 0xdd,
+	0xd2, 0xaa, 0x63, 0x82, 0x18, 0x09, 0xe0, 0xfc, 0x1e, 0xf5, 0xb7, 0xa9, 0xef, 0x49, 0x5d, 0xa1,
+	0x64, 0x32, 0x75, 0x12, 0x26, 0xc6, 0xd1, 0xe1, 0xfc, 0xf9, 0x5b, 0x19, 0x64, 0x30, 0x93, 0xb8,
+	0xf9, 0xc7, 0x45, 0x98, 0xbb, 0x21, 0xdc, 0xc5, 0x3c, 0x5f, 0x88, 0xd5, 0xe4, 0x79, 0x28, 0xf9,
+	0xfd, 0x01, 0x1f, 0x39, 0x25, 0xa1, 0xff, 0xc5, 0x8d, 0x2d, 0x64, 0x65, 0x6c, 0x6f, 0x53, 0x3b,
+	0x6f, 0x71, 0xfc, 0xbd, 0x2d, 0x63, 0xd7, 0xfd, 0x30, 0x4c, 0xf5, 0x82, 0x6e, 0xcb, 0x7e, 0x87,
+	0x4a, 0x15, 0x1b, 0x97, 0x2b, 0xd7, 0x45, 0x11, 0x46, 0x30, 0x26, 0xa6, 0xed, 0xd1, 0x03, 0xa1,
+	0x60, 0x2a, 0xc7, 0x62, 0xda, 0x2d, 0x59, 0x86, 0x0a, 0xca, 0x36, 0x4b, 0x31, 0x59, 0xd8, 0x28,
+	0x28, 0x8b, 0xcd, 0xf2, 0x2e, 0x2b, 0x90, 0xf3, 0x86, 0xad, 0xa4, 0x52, 0x97, 0x5d, 0x1d, 0x7f,
+	0x25, 0x4d, 0xea, 0xbe, 0xc9, 0x4f, 0x40, 0x9d, 0x13, 0x6f, 0x3a, 0xde, 0x36, 0xff, 0x70, 0x75,
+	0xa1, 0x81, 0xbd, 0x1b, 0x15, 0x62, 0x0c, 0x37, 0xff, 0xa4, 0x08, 0x17, 0x6f, 0xd0, 0x50, 0x88,
+	0xc9, 0xcb, 0xb4, 0xef, 0x78, 0x07, 0xec, 0xb0, 0x88, 0xf4, 0x3e, 0xf9, 0x0c, 0x80, 0x1d, 0x6c,
+	0xb7, 0xf6, 0xdb, 0x9b, 0xb1, 0xd2, 0xe9, 0x4a, 0xb4, 0xc8, 0xad, 0xb6, 0x9a, 0x12, 0xf2, 0x28,
+	0xf1, 0x84, 0x5a, 0x9d, 0x58, 0xdb, 0x54, 0x7c, 0x8c, 0xb6, 0xa9, 0x05, 0xd0, 0x8f, 0x8f, 0x9c,
+	0x25, 0x8e, 0xf9, 0xd1, 0x88, 0xcd, 0x49, 0x4e, 0x9b, 0x1a, 0x99, 0x3c, 0x87, 0x40, 0x17, 0xce,
+	0x74, 0xe8, 0x8e, 0x35, 0x70, 0x42, 0x75, 0x4c, 0x36, 0x2a, 0x27, 0x3c, 0x69, 0x2b, 0x57, 0xb6,
+	0xe5, 0x14, 0x25, 0x1c, 0xa2, 0x6d, 0xfe, 0x76, 0x09, 0x2e, 0xdd, 0xa0, 0xa1, 0xd2, 0x8f, 0xcb,
+	0xd5, 0xb1, 0xd5, 0xa7, 0x6d, 0xf6, 0x15, 0xbe, 0x51, 0x80, 0xaa, 0x63, 0x6d, 0x53, 0x87, 0xed,
+	0x69, 0xec, 0x6d, 0xde, 0x1a, 0x7b, 0x23, 0x18, 0xcd, 0x65, 0x61, 0x8d, 0x73, 0x48, 0x6d, 0x0d,
+	0xa2, 0x10, 0x25, 0x7b, 0xb6, 0xa8, 0xb7, 0x9d, 0x41, 0x10, 0x0a, 0xb5, 0x85, 0x94, 0xff, 0xd4,
+	0xa2, 0xbe, 0x14, 0x83, 0x50, 0xc7, 0x63, 0x7b, 0x65, 0xdb, 0xb1, 0xa9, 0x1b, 0xf2, 0x5a, 0x62,
+	0x5e, 0xa9, 0xbd, 0x72, 0x49, 0x41, 0x50, 0xc3, 0x62, 0xac, 0x7a, 0x9e, 0x6b, 0x87, 0x9e, 0x60,
+	0x55, 0x4e, 0xb2, 0x5a, 0x8f, 0x41, 0xa8, 0xe3, 0xf1, 0x6a, 0x34, 0xf4, 0xed, 0x76, 0xc0, 0xab,
+	0x55, 0x52, 0xd5, 0x62, 0x10, 0xea, 0x78, 0x6c, 0xcf, 0xd3, 0xde, 0xff, 0x44, 0x7b, 0xde, 0x6f,
+	0xd4, 0xe1, 0x72, 0xa2, 0x5b, 0x43, 0x2b, 0xa4, 0x3b, 0x03, 0xa7, 0x45, 0xc3, 0xe8, 0x03, 0x8e,
+	0xb9, 0x17, 0xfe, 0xd5, 0xf8, 0xbb, 0x0b, 0x01, 0xa0, 0x3d, 0x99, 0xef, 0x3e, 0xd4, 0xc0, 0x63,
+	0x7d, 0xfb, 0xab, 0x50, 0x77, 0xad, 0x30, 0xe0, 0x13, 0x57, 0xce, 0x51, 0x25, 0x9d, 0xdd, 0x8e,
+	0x00, 0x18, 0xe3, 0x90, 0x0d, 0x38, 0x2f, 0xbb, 0xf8, 0xfa, 0x43, 0xa6, 0xd0, 0xa2, 0xbe, 0xa8,
+	0x2b, 0xb7, 0x53, 0x59, 0xf7, 0xfc, 0x7a, 0x06, 0x0e, 0x66, 0xd6, 0x24, 0xeb, 0x70, 0xae, 0x2d,
+	0x1c, 0xf7, 0xa8, 0xe3, 0x59, 0x9d, 0x88, 0xa0, 0x10, 0xad, 0xd5, 0x59, 0x7b, 0x69, 0x18, 0x05,
+	0xb3, 0xea, 0xa5, 0x47, 0x73, 0x75, 0xac, 0xd1, 0x3c, 0x35, 0xce, 0x68, 0xae, 0x8d, 0x37, 0x9a,
+	0xeb, 0xc7, 0x1b, 0xcd, 0xac, 0xe7, 0xd9, 0x38, 0xa2, 0x3e, 0x13, 0x4f, 0xc4, 0x0e, 0xab, 0xf9,
+	0x85, 0xaa, 0x9e, 0x6f, 0x65, 0xe0, 0x60, 0x66, 0x4d, 0xb2, 0x0d, 0x97, 0x44, 0xf9, 0x75, 0xb7,
+	0xed, 0x1f, 0xf4, 0xd9, 0xc6, 0xa3, 0xd1, 0x6d, 0x24, 0x8c, 0x36, 0x97, 0x5a, 0x23, 0x31, 0xf1,
+	0x31, 0x54, 0xc8, 0xa7, 0x60, 0x46, 0x7c, 0xa5, 0x75, 0xab, 0xcf, 0xc9, 0x0a, 0x2f, 0xd1, 0x0b,
+	0x92, 0xec, 0xcc, 0x92, 0x0e, 0xc4, 0x24, 0x2e, 0x59, 0x84, 0xb9, 0xfe, 0x7e, 0x9b, 0xfd, 0x5d,
+	0xdd, 0xb9, 0x4d, 0x69, 0x87, 0x76, 0xb8, 0xaf, 0x45, 0xbd, 0xf9, 0x5c, 0xa4, 0xba, 0xdc, 0x48,
+	0x82, 0x31, 0x8d, 0x4f, 0x5e, 0x83, 0xe9, 0x20, 0xb4, 0xfc, 0x50, 0x5a, 0x39, 0x8c, 0x59, 0xe1,
+	0x45, 0x1b, 0x19, 0x01, 0x5a, 0x1a, 0x0c, 0x13, 0x98, 0x99, 0xfb, 0xc5, 0xdc, 0xe9, 0xed, 0x17,
+	0x79, 0x56, 0xab, 0x7f, 0x51, 0x84, 0x2b, 0x37, 0x68, 0xb8, 0xee, 0xb9, 0x52, 0xab, 0x91, 0xb5,
+	0xed, 0x1f, 0xcb, 0x44, 0x94, 0xdc, 0xb4, 0x8b, 0x13, 0xdd, 0xb4, 0x4b, 0x13, 0xda, 0xb4, 0xcb,
+	0xa7, 0xb8, 0x69, 0xff, 0xe3, 0x22, 0x3c, 0x97, 0xe8, 0x49, 0xe6, 0x39, 0x2f, 0x17, 0xfc, 0xf7,
+	0x3b, 0xf0, 0x18, 0x1d, 0xf8, 0x48, 0xc8, 0x9d, 0xdc, 0x09, 0x21, 0x25, 0xf1, 0x7c, 0x3d, 0x2d,
+	0xf1, 0xbc, 0x99, 0x67, 0xe7, 0xcb, 0xe0, 0x70, 0xac, 0x1d, 0xef, 0x0d, 0x20, 0xbe, 0x74, 0x99,
+	0x88, 0x6d, 0x35, 0x52, 0xe8, 0x51, 0x6e, 0xfa, 0x38, 0x84, 0x81, 0x19, 0xb5, 0x48, 0x0b, 0x2e,
+	0x04, 0xd4, 0x0d, 0x6d, 0x97, 0x3a, 0x49, 0x72, 0x42, 0x1a, 0x7a, 0x51, 0x92, 0xbb, 0xd0, 0xca,
+	0x42, 0xc2, 0xec, 0xba, 0x79, 0xd6, 0x81, 0x7f, 0x05, 0x5c, 0xe4, 0x14, 0x5d, 0x33, 0x31, 0x89,
+	0xe5, 0x1b, 0x69, 0x89, 0xe5, 0xad, 0xfc, 0xdf, 0x6d, 0x3c, 0x69, 0xe5, 0x1a, 0x00, 0xff, 0x0a,
+	0xba, 0xb8, 0xa2, 0x36, 0x69, 0x54, 0x10, 0xd4, 0xb0, 0xd8, 0x06, 0x14, 0xf5, 0xb3, 0x2e, 0xa9,
+	0xa8, 0x0d, 0xa8, 0xa5, 0x03, 0x31, 0x89, 0x3b, 0x52, 0xda, 0xa9, 0x8c, 0x2d, 0xed, 0xbc, 0x01,
+	0x24, 0xa1, 0xc9, 0x16, 0xf4, 0xaa, 0xc9, 0x28, 0x91, 0xd5, 0x21, 0x0c, 0xcc, 0xa8, 0x35, 0x62,
+	0x28, 0x4f, 0x4d, 0x76, 0x28, 0xd7, 0xc6, 0x1f, 0xca, 0xe4, 0x2d, 0x78, 0x9e, 0xb3, 0x92, 0xfd,
+	0x93, 0x24, 0x2c, 0xe4, 0x9e, 0x0f, 0x49, 0xc2, 0xcf, 0xe3, 0x28, 0x44, 0x1c, 0x4d, 0x83, 0x7d,
+	0x9f, 0xb6, 0x4f, 0x3b, 0x8c, 0xb9, 0xe5, 0x8c, 0x96, 0x89, 0x96, 0x32, 0x70, 0x30, 0xb3, 0x26,
+	0x1b, 0x62, 0x21, 0x1b, 0x86, 0xd6, 0xb6, 0x43, 0x3b, 0x32, 0x4a, 0x46, 0x0d, 0xb1, 0xcd, 0xb5,
+	0x96, 0x84, 0xa0, 0x86, 0x95, 0x25, 0xa6, 0x4c, 0x9f, 0x50, 0x4c, 0xb9, 0xc1, 0xcd, 0x3e, 0x3b,
+	0x09, 0x69, 0x48, 0xca, 0x3a, 0xca, 0x3d, 0x6a, 0x29, 0x8d, 0x80, 0xc3, 0x75, 0xb8, 0x94, 0xd8,
+	0xf6, 0xed, 0x7e, 0x18, 0x24, 0x69, 0xcd, 0xa6, 0xa4, 0xc4, 0x0c, 0x1c, 0xcc, 0xac, 0xc9, 0xe4,
+	0xf3, 0x5d, 0x6a, 0x39, 0xe1, 0x6e, 0x92, 0xe0, 0x5c, 0x52, 0x3e, 0xbf, 0x39, 0x8c, 0x82, 0x59,
+	0xf5, 0x32, 0x37, 0xa4, 0x33, 0xcf, 0xa6, 0x58, 0xf5, 0xbd, 0x12, 0xbc, 0x78, 0x83, 0x8a, 0xc0,
+	0x27, 0xb7, 0xbb, 0x61, 0xf7, 0xa9, 0x63, 0xbb, 0x54, 0x6b, 0x11, 0xf9, 0xcb, 0x05, 0x98, 0x16,
+	0x7a, 0x11, 0xf1, 0x92, 0xb9, 0xed, 0x8d, 0x19, 0xae, 0x82, 0xb1, 0xb0, 0x2a, 0xb4, 0x31, 0xa2,
+	0x14, 0x13, 0x7c, 0xdf, 0xd7, 0xc8, 0x1c, 0x47, 0x36, 0xf9, 0x5a, 0x09, 0x9e, 0x67, 0xdf, 0x33,
+	0x72, 0x64, 0x7e, 0x5f, 0x2d, 0xf6, 0x2e, 0x7c, 0x84, 0x5f, 0xaf, 0xc0, 0xb9, 0x1b, 0x34, 0x1c,
+	0x92, 0xae, 0xff, 0x3f, 0xed, 0xfe, 0x75, 0x38, 0x17, 0x3b, 0xd6, 0xb7, 0x42, 0xcf, 0x17, 0xb2,
+	0x59, 0x4a, 0xfb, 0xd1, 0x1a, 0x46, 0xc1, 0xac, 0x7a, 0xe4, 0xf3, 0xf0, 0x5c, 0x20, 0x96, 0x2b,
+	0xa1, 0x6f, 0x17, 0xca, 0x21, 0x2d, 0x8a, 0x36, 0xf2, 0x2e, 0x7c, 0xae, 0x95, 0x8d, 0x86, 0xa3,
+	0xea, 0x93, 0xaf, 0xc0, 0x74, 0x5f, 0x2e, 0x81, 0xec, 0x9b, 0xe5, 0xf6, 0x9a, 0xdc, 0xd0, 0x88,
+	0xc5, 0x6b, 0x9c, 0x5e, 0x8a, 0x09, 0x86, 0x99, 0x23, 0xb5, 0x76, 0x8a, 0x23, 0xf5, 0xcb, 0x30,
+	0x7d, 0xc3, 0xf1, 0xb6, 0x2d, 0x47, 0x5a, 0x47, 0x7b, 0x30, 0x15, 0xfa, 0x76, 0xb7, 0x4b, 0xfd,
+	0xdc, 0x56, 0x48, 0x41, 0x71, 0x53, 0x50, 0x93, 0x5e, 0x2e, 0xe2, 0x01, 0x23, 0x1e, 0xe6, 0xb7,
+	0x2a, 0x30, 0x75, 0xc3, 0xf7, 0x06, 0xfd, 0xe6, 0x01, 0x8b, 0xdc, 0x7b, 0xc0, 0xab, 0x18, 0x85,
+	0x9c, 0xb1, 0x71, 0x82, 0x73, 0x2c, 0x61, 0x8b, 0x67, 0x94, 0xe4, 0xd9, 0x1c, 0xda, 0xa3, 0x07,
+	0xb4, 0x23, 0x2d, 0xb5, 0x6a, 0x0e, 0xdd, 0x62, 0x85, 0x28, 0x60, 0xa4, 0x07, 0x73, 0x96, 0xe3,
+	0x78, 0x0f, 0x68, 0x67, 0xcd, 0x0a, 0xb9, 0x8f, 0x90, 0x51, 0x1a, 0xcb, 0xca, 0xc1, 0x1d, 0xbf,
+	0x16, 0x93, 0xa4, 0x30, 0x4d, 0x9b, 0xbc, 0x0d, 0x53, 0x41, 0xe8, 0xf9, 0x91, 0xec, 0x9e, 0x2b,
+	0x6e, 0xb1, 0xf9, 0xd9, 0x96, 0x20, 0x25, 0x3a, 0x5d, 0x3e, 0x60, 0xc4, 0x80, 0x3c, 0x80, 0x06,
+	0x8d, 0xdd, 0x2d, 0x8c, 0x4a, 0x4e, 0xe7, 0x7c, 0xcd, 0x75, 0xa3, 0x39, 0xc7, 0x0e, 0x59, 0x5a,
+	0x01, 0xea, 0x9c, 0x98, 0xdc, 0xe9, 0x58, 0x21, 0x95, 0x7c, 0xab, 0x49, 0xb9, 0x73, 0x4d, 0x41,
+	0x50, 0xc3, 0x22, 0xf7, 0xa1, 0xc6, 0x9e, 0x96, 0xad, 0xd0, 0x32, 0xa6, 0x72, 0x86, 0xdb, 0xac,
+	0x49, 0x42, 0xc2, 0x87, 0x46, 0x18, 0xbe, 0xa2, 0x32, 0x54, 0x6c, 0xcc, 0xdf, 0x2c, 0x02, 0xdc,
+	0xdc, 0xdc, 0xdc, 0x90, 0xd6, 0xbc, 0x0e, 0x94, 0x99, 0x89, 0x34, 0xf7, 0x7c, 0x48, 0x84, 0xd1,
+	0x48, 0x97, 0xe3, 0x41, 0xb8, 0x8b, 0x9c, 0x3a, 0xf9, 0x33, 0x30, 0x25, 0xcf, 0xa3, 0x72, 0x58,
+	0x2a, 0xdf, 0x3c, 0x29, 0x28, 0x61, 0x04, 0x67, 0xdd, 0xc8, 0xac, 0x7e, 0xdb, 0x0e, 0x5d, 0x6c,
+	0x8b, 0x28, 0x4f, 0xad, 0x1b, 0x97, 0x15, 0x04, 0x35, 0x2c, 0xf2, 0x25, 0x00, 0xab, 0xbd, 0x27,
+	0xbd, 0xcc, 0xc6, 0x8c, 0x64, 0xe1, 0x5e, 0x27, 0x8b, 0x8a, 0x0a, 0x6a, 0x14, 0xcd, 0x5f, 0x2a,
+	0x40, 0xd2, 0x0d, 0x83, 0x7c, 0x02, 0x66, 0x82, 0xc1, 0x76, 0x1c, 0x2b, 0x26, 0x9d, 0xe8, 0xb8,
+	0xc3, 0x46, 0x4b, 0x07, 0x60, 0x12, 0x8f, 0xac, 0xc2, 0xb9, 0x70, 0xd7, 0xa7, 0xc1, 0xae, 0xe7,
+	0x74, 0x36, 0xa8, 0xdf, 0xa6, 0x6e, 0x18, 0x6d, 0x78, 0x95, 0xe6, 0x73, 0x6c, 0xa7, 0xd8, 0x1c,
+	0x06, 0x63, 0x56, 0x1d, 0xf3, 0xb7, 0x8a, 0x00, 0xab, 0x1d, 0x87, 0xb6, 0xa2, 0xc0, 0xd8, 0xba,
+	0xc2, 0x1a, 0xd3, 0xfb, 0x83, 0x1b, 0x23, 0x15, 0x7f, 0x8c, 0xe9, 0x91, 0x0e, 0x53, 0xc2, 0xd2,
+	0x7e, 0xe4, 0x75, 0x34, 0xa6, 0x75, 0xf7, 0x8c, 0x50, 0xd8, 0xc6, 0x74, 0x30, 0x41, 0x95, 0x58,
+	0xd0, 0xb0, 0xdd, 0xb6, 0x58, 0xe9, 0x9b, 0x07, 0x63, 0x2e, 0x49, 0x7c, 0x96, 0xae, 0xc6, 0x64,
+	0x50, 0xa7, 0x69, 0xfe, 0x62, 0x01, 0xe6, 0x38, 0x3f, 0xd6, 0x0c, 0x21, 0xab, 0xb3, 0x25, 0xa3,
+	0x1d, 0x7b, 0xe8, 0x1b, 0xc5, 0x9c, 0x4b, 0x86, 0xe6, 0xed, 0x2f, 0x1a, 0xa3, 0x15, 0xa0, 0xce,
+	0xc9, 0xfc, 0x83, 0x22, 0x5c, 0x4c, 0x35, 0x46, 0xce, 0x07, 0xf2, 0xe7, 0x87, 0x92, 0xaf, 0xfc,
+	0xb9, 0xe3, 0xf5, 0x83, 0xc8, 0xdd, 0xc1, 0x32, 0xac, 0xc4, 0xd3, 0x26, 0x2e, 0xd3, 0x32, 0xae,
+	0x0c, 0xa0, 0x1c, 0x30, 0x29, 0x40, 0xbc, 0x6e, 0x6b, 0xec, 0xd7, 0xcd, 0x7e, 0x01, 0x2e, 0x13,
+	0x28, 0xef, 0x19, 0xf6, 0x84, 0x9c, 0x1d, 0xf9, 0x32, 0x54, 0x83, 0xd0, 0x0a, 0x07, 0xd1, 0x8e,
+	0xb3, 0x35, 0x69, 0xc6, 0x9c, 0x78, 0xbc, 0x3d, 0x8a, 0x67, 0x94, 0x4c, 0xcd, 0x3f, 0x28, 0xc0,
+	0xa5, 0xec, 0x8a, 0x6b, 0x76, 0x10, 0x32, 0xdf, 0xc1, 0x54, 0xb7, 0x1f, 0x73, 0xf8, 0xb1, 0xda,
+	0xbc, 0xd3, 0x95, 0xef, 0x60, 0x54, 0xa2, 0x75, 0x79, 0x08, 0x15, 0x3b, 0xa4, 0xbd, 0x48, 0x0b,
+	0x77, 0x67, 0xc2, 0xaf, 0xae, 0x09, 0xcc, 0x8c, 0x0b, 0x0a, 0x66, 0xe6, 0x37, 0x8b, 0xa3, 0x5e,
+	0x99, 0x0b, 0x65, 0x4e, 0x32, 0x8e, 0xed, 0x56, 0xbe, 0x38, 0xb6, 0x64, 0x83, 0x86, 0xc3, 0xd9,
+	0xfe, 0xc2, 0x70, 0x38, 0xdb, 0x9d, 0xfc, 0xe1, 0x6c, 0xa9, 0x6e, 0x18, 0x19, 0xd5, 0xf6, 0xc3,
+	0x12, 0xbc, 0xf0, 0xb8, 0x61, 0xc3, 0xc4, 0x34, 0x39, 0x3a, 0xf3, 0x8a, 0x69, 0x8f, 0x1f, 0x87,
+	0xe4, 0x1a, 0x54, 0xfa, 0xbb, 0x56, 0x10, 0x1d, 0x75, 0x5e, 0x50, 0x91, 0x06, 0xac, 0xf0, 0x11,
+	0x5b, 0xc1, 0xf8, 0x11, 0x89, 0x3f, 0xa2, 0x40, 0x65, 0xbb, 0x68, 0x8f, 0x06, 0x41, 0xac, 0x39,
+	0x55, 0xbb, 0xe8, 0xba, 0x28, 0xc6, 0x08, 0x4e, 0x42, 0xa8, 0x0a, 0x43, 0x9c, 0x51, 0x3e, 0x05,
+	0x7d, 0x86, 0x7a, 0x29, 0xf1, 0x8c, 0x92, 0x17, 0x59, 0x90, 0x61, 0x50, 0x95, 0x84, 0x32, 0xb4,
+	0x9c, 0x71, 0xea, 0xe3, 0x78, 0x4c, 0xfd, 0xe9, 0x6d, 0x73, 0xd3, 0x63, 0x47, 0x7a, 0x19, 0xb1,
+	0xf5, 0xb7, 0xca, 0x3d, 0x8b, 0xa2, 0xda, 0xe4, 0xce, 0x10, 0x06, 0x66, 0xd4, 0x32, 0xff, 0x4d,
+	0x0d, 0x2e, 0x66, 0x8f, 0x07, 0xd6, 0x6f, 0xfb, 0xd4, 0x0f, 0x22, 0x7f, 0x60, 0xad, 0xdf, 0xee,
+	0x8a, 0x62, 0x8c, 0xe0, 0xef, 0x69, 0x3f, 0xef, 0x5f, 0x2f, 0x30, 0x65, 0xad, 0xb0, 0xa4, 0x3f,
+	0x0d, 0x5f, 0xef, 0x17, 0x85, 0xd2, 0x77, 0x04, 0x43, 0x1c, 0xdd, 0x16, 0xf2, 0x77, 0x0a, 0x60,
+	0xf4, 0x52, 0xda, 0xe0, 0x53, 0x4c, 0x8a, 0xc1, 0xc3, 0x31, 0xd7, 0x47, 0xf0, 0xc3, 0x91, 0x2d,
+	0x21, 0x5f, 0x81, 0x46, 0x9f, 0x8d, 0x8b, 0x20, 0xa4, 0x6e, 0x3b, 0x8a, 0x11, 0x19, 0x7f, 0x26,
+	0x6d, 0xc4, 0xb4, 0x54, 0x50, 0x3c, 0x97, 0x0f, 0x34, 0x00, 0xea, 0x1c, 0x9f, 0xf1, 0x2c, 0x18,
+	0x2f, 0x43, 0x2d, 0xa0, 0x21, 0x13, 0x87, 0xc5, 0x29, 0xbe, 0x2e, 0xe6, 0x4a, 0x4b, 0x96, 0xa1,
+	0x82, 0x32, 0xbf, 0x37, 0x6e, 0x98, 0x67, 0xfe, 0xac, 0x46, 0x9d, 0x07, 0x25, 0xce, 0x08, 0xef,
+	0x61, 0x59, 0x88, 0x31, 0x9c, 0x7c, 0x0c, 0xa6, 0xb7, 0xf9, 0xf4, 0x95, 0x0a, 0x59, 0x61, 0x09,
+	0xe0, 0xa2, 0x63, 0x53, 0x2b, 0xc7, 0x04, 0x16, 0xf7, 0xfb, 0x55, 0xde, 0x0b, 0x69, 0xad, 0x7f,
+	0xec, 0xd7, 0x80, 0x1a, 0x16, 0x79, 0x11, 0x4a, 0xa1, 0x13, 0x70, 0x4d, 0x7f, 0x2d, 0x56, 0xec,
+	0x6c, 0xae, 0xb5, 0x90, 0x95, 0x9b, 0x7f, 0x52, 0x80, 0xb9, 0x54, 0xc0, 0x34, 0xab, 0x32, 0xf0,
+	0x1d, 0xb9, 0x8c, 0xa8, 0x2a, 0x5b, 0xb8, 0x86, 0xac, 0x9c, 0x45, 0x32, 0xf3, 0xd3, 0x54, 0x31,
+	0x67, 0xba, 0x3c, 0xe6, 0xb8, 0xc3, 0x8e, 0x4f, 0x43, 0x07, 0x29, 0xee, 0x0c, 0x11, 0xb7, 0x47,
+	0xee, 0x03, 0x9a, 0x33, 0x44, 0x0c, 0xc3, 0x04, 0x66, 0xca, 0x2c, 0x52, 0x3e, 0x8e, 0x59, 0xc4,
+	0xfc, 0x56, 0x51, 0xeb, 0x01, 0x79, 0xcc, 0x78, 0x42, 0x0f, 0x7c, 0x84, 0x6d, 0xa0, 0x6a, 0x73,
+	0xaf, 0xeb, 0xfb, 0x1f, 0x2b, 0x45, 0x09, 0x25, 0xf7, 0x44, 0xdf, 0x97, 0x72, 0x66, 0xda, 0xd9,
+	0x5c, 0x6b, 0x35, 0xa7, 0xf4, 0xaf, 0xa6, 0x3e, 0x41, 0xf9, 0x94, 0x3e, 0x81, 0xf9, 0x2f, 0x4b,
+	0xd0, 0x78, 0xc3, 0xdb, 0x7e, 0x8f, 0x04, 0x2e, 0x65, 0x6f, 0x53, 0xc5, 0x77, 0x71, 0x9b, 0xda,
+	0x82, 0xe7, 0xc2, 0x90, 0x19, 0xec, 0x3c, 0xb7, 0x13, 0x2c, 0xee, 0x84, 0xd4, 0x5f, 0xb1, 0x5d,
+	0x3b, 0xd8, 0xa5, 0x1d, 0x69, 0x74, 0xff, 0x20, 0x53, 0x6e, 0x6e, 0x6e, 0xae, 0x65, 0xa1, 0xe0,
+	0xa8, 0xba, 0x7c, 0xd9, 0x10, 0x09, 0x37, 0x78, 0x88, 0xb6, 0xf4, 0x4c, 0x14, 0xcb, 0x86, 0x56,
+	0x8e, 0x09, 0x2c, 0xf3, 0x3f, 0x14, 0xa1, 0xae, 0x12, 0xa1, 0x31, 0x2f, 0xe3, 0x6d, 0xdf, 0xdb,
+	0xa3, 0xbe, 0xf0, 0x6f, 0x90, 0xe1, 0xd5, 0x4d, 0x51, 0x84, 0x11, 0x8c, 0xa9, 0xd8, 0x42, 0xaf,
+	0x6f, 0xb7, 0xd3, 0x6a, 0xea, 0x4d, 0x56, 0x88, 0x02, 0xc6, 0x27, 0x02, 0x77, 0xbe, 0x96, 0x3a,
+	0x8c, 0x78, 0x22, 0xf0, 0x52, 0x94, 0xd0, 0x68, 0x22, 0x94, 0x27, 0x3e, 0x11, 0x3e, 0xa2, 0x44,
+	0xc0, 0x4a, 0x72, 0x26, 0xa6, 0x84, 0x36, 0x96, 0x50, 0xcb, 0x0a, 0x1c, 0xa3, 0x9a, 0x33, 0xfb,
+	0x42, 0x6b, 0xb1, 0xb5, 0x26, 0x13, 0x6a, 0x2d, 0xb6, 0xd6, 0x90, 0x13, 0x35, 0x7f, 0xab, 0x04,
+	0x0d, 0xd1, 0xbf, 0x62, 0xf5, 0x98, 0x64, 0x0f, 0xbf, 0xce, 0x1d, 0xd3, 0x82, 0x41, 0x8f, 0xfa,
+	0x5c, 0xcb, 0x6a, 0x94, 0x86, 0xac, 0xad, 0x31, 0x50, 0x39, 0xa7, 0xc5, 0x45, 0x7f, 0xba, 0xbb,
+	0x9e, 0x6d, 0x15, 0x3c, 0x99, 0x9f, 0x94, 0x71, 0x8d, 0xa9, 0xe4, 0x56, 0x71, 0x4b, 0x83, 0x61,
+	0x02, 0xd3, 0x74, 0x60, 0x36, 0xa9, 0x4c, 0x3c, 0x59, 0x40, 0x1e, 0xc3, 0xde, 0xb1, 0x1c, 0x87,
+	0x4d, 0x34, 0xa9, 0xee, 0x53, 0xd8, 0x2b, 0xb2, 0x1c, 0x15, 0x86, 0xf9, 0x3f, 0x8b, 0x50, 0x5f,
+	0xb3, 0x77, 0x68, 0xfb, 0xa0, 0xed, 0x50, 0xf2, 0x25, 0xb8, 0xd4, 0xa1, 0x0e, 0x65, 0xfb, 0xf3,
+	0x0d, 0xdf, 0x6a, 0xd3, 0x0d, 0xea, 0xdb, 0x5e, 0x47, 0xce, 0x78, 0x19, 0x74, 0x70, 0x99, 0x79,
+	0x33, 0x2e, 0x8f, 0xc4, 0xc2, 0xc7, 0x50, 0x20, 0xab, 0x30, 0xdd, 0xa1, 0x81, 0xed, 0xd3, 0xce,
+	0x86, 0x76, 0xfc, 0xfa, 0x70, 0xd4, 0x2b, 0xcb, 0x1a, 0xec, 0xd1, 0xe1, 0xfc, 0x4c, 0x64, 0xcc,
+	0xe0, 0x05, 0x98, 0xa8, 0xca, 0x16, 0xb2, 0xbe, 0x35, 0x08, 0x68, 0x46, 0x3b, 0x4b, 0xbc, 0x9d,
+	0x7c, 0x21, 0xdb, 0xc8, 0x46, 0xc1, 0x51, 0x75, 0xc9, 0x36, 0x18, 0xbc, 0xfd, 0x59, 0x74, 0xcb,
+	0x9c, 0xee, 0x47, 0x8e, 0x0e, 0xe7, 0xcd, 0x65, 0xda, 0xf7, 0x69, 0xdb, 0x0a, 0x69, 0x67, 0x79,
+	0x04, 0x36, 0x8e, 0xa4, 0x63, 0x56, 0x80, 0x25, 0x94, 0x34, 0xbf, 0x59, 0x02, 0x95, 0x8b, 0x97,
+	0xb0, 0xf8, 0x60, 0xcb, 0x75, 0xbd, 0xd0, 0x8a, 0x34, 0x9a, 0x4c, 0x47, 0x81, 0xb9, 0x53, 0xfe,
+	0x2e, 0x2c, 0xc6, 0x44, 0x85, 0x73, 0x90, 0x72, 0x58, 0xd2, 0x20, 0xa8, 0xf3, 0x66, 0x21, 0x56,
+	0x09, 0x7f, 0xa5, 0xf5, 0xfc, 0xad, 0x38, 0x86, 0x77, 0xd2, 0xa5, 0x4f, 0xc3, 0x99, 0x74, 0x63,
+	0x4f, 0xe2, 0x6e, 0x90, 0xcb, 0xf1, 0xab, 0x08, 0x10, 0xfb, 0x2c, 0x3e, 0x05, 0xf5, 0x9f, 0x9d,
+	0x50, 0xff, 0x8d, 0x6f, 0x76, 0x88, 0x1b, 0x3d, 0x52, 0xe5, 0x77, 0x3f, 0xa5, 0xf2, 0x5b, 0x9d,
+	0x04, 0xb3, 0xc7, 0xab, 0xf9, 0xb6, 0xe1, 0x5c, 0x8c, 0x1b, 0xaf, 0x2e, 0xb7, 0x52, 0xb3, 0x5f,
+	0xac, 0x65, 0x3f, 0x3e, 0x62, 0xf6, 0xcf, 0xc5, 0x24, 0x32, 0xe6, 0xbf, 0xf9, 0xf7, 0x0a, 0x70,
+	0x46, 0x67, 0xc2, 0x13, 0xe7, 0x7c, 0x02, 0x66, 0x7c, 0x6a, 0x75, 0x9a, 0x56, 0xd8, 0xde, 0xe5,
+	0xe1, 0x4a, 0x05, 0x1e, 0x5f, 0xc4, 0x0d, 0x03, 0xa8, 0x03, 0x30, 0x89, 0xc7, 0x74, 0xdf, 0xac,
+	0x60, 0x33, 0x57, 0xb4, 0x3d, 0x3f, 0x4e, 0x62, 0x4c, 0x06, 0x75, 0x9a, 0xe6, 0x0f, 0x0b, 0x30,
+	0xab, 0x37, 0xf8, 0xd4, 0xf5, 0x9d, 0xbb, 0x49, 0x7d, 0xe7, 0xd2, 0x04, 0xbe, 0xfb, 0x08, 0x1d,
+	0xe7, 0xd7, 0x1a, 0xfa, 0xab, 0x71, 0xbd, 0xa6, 0xae, 0xca, 0x29, 0x3c, 0x56, 0x95, 0xf3, 0xde,
+	0xcf, 0x5b, 0x3a, 0xea, 0x0c, 0x52, 0x7e, 0x86, 0xcf, 0x20, 0xef, 0x66, 0xf2, 0x53, 0x2d, 0x81,
+	0x67, 0x35, 0x47, 0x02, 0xcf, 0x9e, 0x4a, 0xe0, 0x39, 0x35, 0xb1, 0x85, 0xed, 0x38, 0x49, 0x3c,
+	0x6b, 0x4f, 0x35, 0x89, 0x67, 0xfd, 0xb4, 0x92, 0x78, 0x42, 0xde, 0x24, 0x9e, 0x5f, 0x2f, 0xc0,
+	0x6c, 0x27, 0x91, 0x46, 0xc4, 0x68, 0xe4, 0xdc, 0xce, 0x92, 0x59, 0x49, 0x44, 0xd8, 0x6f, 0xb2,
+	0x0c, 0x53, 0x2c, 0xb3, 0x52, 0x67, 0x4e, 0xbf, 0x2b, 0xa9, 0x33, 0xc9, 0x97, 0xa1, 0xee, 0x44,
+	0x7b, 0x9d, 0x31, 0x93, 0x73, 0xee, 0x67, 0xec, 0x9f, 0x71, 0x64, 0x99, 0x2a, 0xc2, 0x98, 0xa3,
+	0xf9, 0xbb, 0x35, 0x7d, 0x43, 0x7c, 0xda, 0x16, 0x95, 0x8f, 0x27, 0x2d, 0x2a, 0x57, 0xd2, 0x16,
+	0x95, 0xa1, 0xdd, 0x5c, 0xa0, 0xb3, 0xd3, 0x8a, 0xda, 0x27, 0x4a, 0x3c, 0x9d, 0xa1, 0x1a, 0x72,
+	0x19, 0x7b, 0xc5, 0x22, 0xcc, 0x49, 0x21, 0x20, 0x02, 0xf2, 0x45, 0x76, 0x26, 0xf6, 0x14, 0x5e,
+	0x4e, 0x82, 0x31, 0x8d, 0xcf, 0x18, 0x06, 0xd1, 0x2d, 0x17, 0x95, 0xe4, 0x61, 0x4a, 0xdd, 0x40,
+	0xa1, 0x30, 0xd8, 0x59, 0xd2, 0xa7, 0x56, 0x20, 0xed, 0x22, 0xda, 0x59, 0x12, 0x79, 0x29, 0x4a,
+	0xa8, 0x6e, 0x1c, 0x9a, 0x7a, 0x82, 0x71, 0xc8, 0x82, 0x86, 0x63, 0x05, 0xa1, 0x18, 0x4c, 0x1d,
+	0xb9, 0x9a, 0xfc, 0xd9, 0xe3, 0xed, 0xfb, 0x4c, 0x96, 0x88, 0x05, 0xf8, 0xb5, 0x98, 0x0c, 0xea,
+	0x34, 0x99, 0xbf, 0x00, 0x7b, 0xe4, 0x2b, 0x4b, 0x67, 0x31, 0x34, 0xea, 0x27, 0xe6, 0xa1, 0x0e,
+	0xaa, 0x6b, 0x1a, 0x1d, 0x4c, 0x50, 0x1d, 0x61, 0x3f, 0x82, 0x71, 0xec, 0x47, 0x2c, 0xca, 0x80,
+	0xc9, 0x4a, 0x07, 0xea, 0xb3, 0x36, 0xf8, 0x67, 0x55, 0x51, 0x06, 0xa8, 0x03, 0x31, 0x89, 0xcb,
+	0x46, 0xc5, 0x40, 0x76, 0x43, 0x54, 0x7d, 0x3a, 0x39, 0x2a, 0xb6, 0x92, 0x60, 0x4c, 0xe3, 0x33,
+	0xb7, 0x6f, 0x55, 0xa4, 0x37, 0x63, 0x86, 0xd3, 0x51, 0x6e, 0xdf, 0x5b, 0x19, 0x38, 0x98, 0x59,
+	0x93, 0xc7, 0x51, 0x0e, 0x7c, 0x9f, 0xba, 0xe1, 0x4d, 0x2b, 0xd8, 0x95, 0xfe, 0xe3, 0x71, 0x1c,
+	0x65, 0x0c, 0x42, 0x1d, 0x8f, 0x29, 0x8a, 0x05, 0x39, 0x5e, 0x6b, 0x2e, 0x19, 0xa2, 0xb1, 0xa5,
+	0x20, 0xa8, 0x61, 0x31, 0x1f, 0x48, 0xab, 0x1d, 0xda, 0xfb, 0x94, 0x7f, 0x9a, 0x56, 0x7b, 0x97,
+	0x76, 0x06, 0x0e, 0x35, 0xce, 0x24, 0x7d, 0x20, 0x17, 0x87, 0x51, 0x30, 0xab, 0x9e, 0xf9, 0xf5,
+	0x3a, 0x34, 0x6e, 0x5b, 0xac, 0x9c, 0xdb, 0x8e, 0x4f, 0xc7, 0x80, 0xf7, 0x6b, 0x05, 0xb8, 0x98,
+	0x0c, 0xa3, 0x38, 0x45, 0x2b, 0x1e, 0xcf, 0x73, 0x89, 0x99, 0xdc, 0x70, 0x44, 0x2b, 0xb8, 0x3d,
+	0x6f, 0x28, 0x2a, 0xe3, 0xb4, 0xed, 0x79, 0xad, 0x51, 0x0c, 0x71, 0x74, 0x5b, 0xde, 0x2b, 0xf6,
+	0xbc, 0x67, 0x3b, 0xe5, 0x7d, 0xca, 0xda, 0x38, 0xf5, 0xcc, 0x58, 0x1b, 0x6b, 0xcf, 0xc4, 0x21,
+	0xa2, 0xaf, 0x59, 0x1b, 0xeb, 0x39, 0x9d, 0x15, 0x65, 0xe4, 0xa1, 0xa0, 0x36, 0xca, 0x6a, 0x69,
+	0xfe, 0x9f, 0x02, 0xd4, 0x22, 0x2b, 0x10, 0x93, 0xbd, 0xb7, 0xad, 0xc0, 0x6e, 0x1b, 0x85, 0x9c,
+	0x77, 0x87, 0xa8, 0xdc, 0xd7, 0xc2, 0x39, 0x86, 0x3f, 0xa2, 0xa0, 0x1d, 0x67, 0x1f, 0x2f, 0xe6,
+	0xca, 0x3e, 0xce, 0xb2, 0x6a, 0xbb, 0x7b, 0xf4, 0xe0, 0x64, 0xe9, 0x77, 0xf8, 0x99, 0xf2, 0x36,
+	0x33, 0x4d, 0xf0, 0xca, 0xe6, 0x77, 0x8a, 0x00, 0xec, 0xf5, 0x8f, 0x67, 0xf7, 0x63, 0x1e, 0x9e,
+	0x03, 0xae, 0x67, 0x32, 0x8a, 0xc9, 0x25, 0xba, 0x25, 0x8a, 0x31, 0x82, 0x33, 0xe5, 0xfe, 0xfd,
+	0x01, 0x1d, 0x44, 0x4e, 0x2c, 0xea, 0x18, 0xf2, 0x59, 0x56, 0x88, 0x02, 0x76, 0x7a, 0xba, 0xf9,
+	0xc8, 0x3e, 0x58, 0x39, 0x2d, 0xfb, 0x60, 0x1d, 0xa6, 0x6e, 0x7b, 0xdc, 0x9f, 0xdf, 0xfc, 0xe3,
+	0x02, 0x10, 0xa1, 0x7c, 0xe3, 0xcf, 0xd2, 0x57, 0x99, 0x89, 0x74, 0xdb, 0x83, 0xf6, 0x1e, 0x0d,
+	0x8d, 0x42, 0x52, 0xa4, 0x6b, 0xf2, 0x52, 0x94, 0x50, 0x86, 0xd7, 0xf7, 0xe9, 0x8e, 0xfd, 0x30,
+	0x6d, 0x4b, 0xdd, 0xe0, 0xa5, 0x28, 0xa1, 0x42, 0x44, 0xec, 0xb2, 0xdd, 0xb1, 0x94, 0x16, 0x11,
+	0xbb, 0xb6, 0x10, 0x11, 0xd9, 0x2f, 0x79, 0x05, 0x1a, 0xd4, 0xed, 0xf4, 0x3d, 0xdb, 0x0d, 0xb7,
+	0xfc, 0x28, 0x83, 0x9a, 0x70, 0x6a, 0x8e, 0x8a, 0x71, 0x0d, 0x75, 0x1c, 0x66, 0x44, 0x18, 0x04,
+	0x74, 0xc3, 0x0a, 0x77, 0x5b, 0xe1, 0x81, 0x23, 0x16, 0xf3, 0x5a, 0x2c, 0x9b, 0x6d, 0x69, 0x30,
+	0x4c, 0x60, 0x9a, 0xff, 0xb5, 0x04, 0x10, 0x3b, 0x6b, 0x93, 0xbf, 0x59, 0x80, 0x0b, 0x6a, 0xb1,
+	0x09, 0xc5, 0x49, 0x9a, 0x5f, 0xbe, 0x94, 0xdb, 0x4e, 0x9a, 0xb5, 0xd0, 0xf1, 0xd5, 0x77, 0x23,
+	0x8b, 0x1d, 0x66, 0xb7, 0x82, 0x20, 0xd4, 0x68, 0xaf, 0x1f, 0x1e, 0x2c, 0xdb, 0xbe, 0x51, 0x1c,
+	0x1d, 0x92, 0x70, 0x5d, 0xe2, 0x88, 0xaa, 0x52, 0xdd, 0xc3, 0x17, 0x90, 0x08, 0x82, 0x8a, 0x0e,
+	0xd9, 0x85, 0x9a, 0xeb, 0xbd, 0x15, 0xb0, 0x4f, 0x6f, 0x94, 0x72, 0xde, 0x07, 0x24, 0x87, 0x94,
+	0xb0, 0x97, 0xc9, 0x07, 0x9c, 0x72, 0xc5, 0x1f, 0xf2, 0x0b, 0xd0, 0xf0, 0xe2, 0x71, 0x66, 0x94,
+	0x73, 0x7a, 0xf2, 0x0d, 0x8f, 0x59, 0x31, 0x4c, 0xb4, 0x72, 0xd4, 0x19, 0x9a, 0xbf, 0x52, 0x84,
+	0x73, 0x19, 0xdf, 0x81, 0xdd, 0x82, 0x26, 0xfd, 0xf2, 0xe3, 0x5b, 0xd0, 0x0a, 0xf1, 0x2d, 0x68,
+	0xad, 0x14, 0x0c, 0x87, 0xb0, 0xc9, 0x5b, 0xcc, 0xb5, 0xbb, 0x4d, 0x83, 0x60, 0xdd, 0xeb, 0x44,
+	0x47, 0xbb, 0xd7, 0x85, 0xab, 0x76, 0x54, 0xfa, 0xe8, 0x70, 0xfe, 0xa7, 0xb2, 0x22, 0x7d, 0x52,
+	0xdf, 0x39, 0xae, 0x80, 0x1a, 0x49, 0xe6, 0x3b, 0x2e, 0xd4, 0x39, 0x2a, 0xb9, 0xd4, 0x13, 0x74,
+	0xa0, 0x0b, 0x51, 0x62, 0xde, 0x85, 0xcf, 0x0e, 0x2c, 0x37, 0x64, 0x17, 0xca, 0x71, 0xdf, 0xf1,
+	0xbb, 0x8a, 0x0a, 0x6a, 0x14, 0xcd, 0xdf, 0x2d, 0x42, 0x2d, 0xb2, 0x22, 0x3d, 0x05, 0xb5, 0x7e,
+	0x37, 0xa1, 0xd6, 0x9f, 0x50, 0x6c, 0x4f, 0x96, 0x52, 0xdf, 0x4b, 0x29, 0xf5, 0x6f, 0xe4, 0x67,
+	0xf5, 0x78, 0x95, 0xfe, 0xb7, 0x8b, 0x30, 0x1b, 0xa1, 0xe6, 0x55, 0xb6, 0xff, 0x2c, 0xcc, 0x09,
+	0xef, 0xa1, 0x75, 0xeb, 0xa1, 0xc8, 0x76, 0xc8, 0x3b, 0xac, 0x2c, 0xe2, 0x59, 0x9a, 0x49, 0x10,
+	0xa6, 0x71, 0xd9, 0xb0, 0x16, 0x45, 0x5b, 0xec, 0x3c, 0xcd, 0x1b, 0x23, 0x55, 0x07, 0x7c, 0x58,
+	0x37, 0x53, 0x30, 0x1c, 0xc2, 0x4e, 0x6b, 0xfb, 0xcb, 0xa7, 0xa0, 0xed, 0xff, 0xfd, 0x02, 0x4c,
+	0xc7, 0xfd, 0x75, 0xea, 0xba, 0xfe, 0x9d, 0xa4, 0xae, 0x7f, 0x31, 0xf7, 0x70, 0x18, 0xa1, 0xe9,
+	0xff, 0xbb, 0x75, 0x48, 0x84, 0x98, 0xb1, 0x1c, 0x38, 0x76, 0xa6, 0x4b, 0xaf, 0xb6, 0xda, 0xa8,
+	0x1c, 0x38, 0xab, 0x23, 0x31, 0xf1, 0x31, 0x54, 0xc8, 0x00, 0x6a, 0xfb, 0xd4, 0x0f, 0xed, 0x36,
+	0x8d, 0xde, 0xef, 0x46, 0x6e, 0x71, 0x58, 0xda, 0x33, 0x54, 0x9f, 0xde, 0x95, 0x0c, 0x50, 0xb1,
+	0x22, 0xdb, 0x50, 0xa1, 0x9d, 0x2e, 0x8d, 0xee, 0x1c, 0xcd, 0x79, 0x33, 0x86, 0xea, 0x4f, 0xf6,
+	0x14, 0xa0, 0x20, 0x4d, 0x02, 0x5d, 0x67, 0x58, 0xce, 0x29, 0xdc, 0x1e, 0x53, 0x53, 0x48, 0xf6,
+	0x94, 0xe2, 0xbc, 0x32, 0xa1, 0xc5, 0xe3, 0x31, 0x6a, 0xf3, 0x00, 0xea, 0x0f, 0xac, 0x90, 0xfa,
+	0x3d, 0xcb, 0xdf, 0x33, 0xaa, 0x39, 0xdf, 0xf0, 0x5e, 0x44, 0x29, 0x7e, 0x43, 0x55, 0x84, 0x31,
+	0x1f, 0x76, 0x83, 0x62, 0x28, 0x8f, 0x2e, 0x91, 0x75, 0x60, 0x7c, 0xa6, 0xd1, 0x21, 0x28, 0x90,
+	0x11, 0x3a, 0xd1, 0x23, 0xc6, 0x3c, 0xc8, 0x7e, 0xe2, 0x82, 0x2a, 0x71, 0x2d, 0x59, 0x33, 0x87,
+	0x95, 0x49, 0x92, 0x8a, 0xb7, 0x9b, 0x11, 0x17, 0x5d, 0x7d, 0xa3, 0x00, 0x73, 0xa9, 0x99, 0x63,
+	0xd4, 0x73, 0x5e, 0x53, 0x93, 0x9a, 0xa5, 0x62, 0x55, 0x4e, 0x15, 0x62, 0x9a, 0x2b, 0x33, 0x33,
+	0xcd, 0x0d, 0xfa, 0x5d, 0xdf, 0xea, 0xc4, 0x8a, 0x78, 0x71, 0xad, 0xc1, 0x46, 0xee, 0xe1, 0xb5,
+	0x95, 0xa4, 0x2b, 0x5a, 0x94, 0x2a, 0xc4, 0x34, 0x77, 0xf3, 0xbf, 0x55, 0xe3, 0x2d, 0xeb, 0x69,
+	0xab, 0xc3, 0x3f, 0x96, 0x54, 0x87, 0x5f, 0x4e, 0xab, 0xc3, 0x53, 0xae, 0x2d, 0x27, 0x0f, 0x31,
+	0x48, 0x69, 0x91, 0xcb, 0xa7, 0xa0, 0x45, 0x7e, 0x05, 0x1a, 0xfb, 0x7c, 0x95, 0x14, 0x19, 0x3d,
+	0x2b, 0x7c, 0x8b, 0xe5, 0xbb, 0xde, 0xdd, 0xb8, 0x18, 0x75, 0x1c, 0x56, 0x45, 0xde, 0xec, 0xaa,
+	0x6e, 0x99, 0x91, 0x55, 0x5a, 0x71, 0x31, 0xea, 0x38, 0xdc, 0x3b, 0xd9, 0x76, 0xf7, 0x44, 0x85,
+	0x29, 0x5e, 0x41, 0x78, 0x27, 0x47, 0x85, 0x18, 0xc3, 0x99, 0x7e, 0x71, 0xd0, 0xd9, 0x11, 0xb8,
+	0x35, 0x8e, 0xcb, 0xa5, 0xff, 0xad, 0xe5, 0x15, 0x81, 0xaa, 0xa0, 0xac, 0x25, 0x3d, 0xab, 0x1f,
+	0x01, 0x8c, 0x7a, 0xdc, 0x92, 0xf5, 0xb8, 0x18, 0x75, 0x1c, 0xf2, 0xd3, 0xec, 0x5e, 0x82, 0xce,
+	0xa0, 0x4d, 0x55, 0x2d, 0xe0, 0xb5, 0xe4, 0xbd, 0x02, 0x3a, 0x04, 0x53, 0x98, 0x23, 0x74, 0xe1,
+	0x8d, 0xb1, 0x74, 0xe1, 0x9f, 0x86, 0xd9, 0x8e, 0x6f, 0xd9, 0x2e, 0xed, 0xdc, 0x71, 0xb9, 0xff,
+	0x92, 0xf4, 0x91, 0x56, 0x76, 0xa8, 0xe5, 0x04, 0x14, 0x53, 0xd8, 0x64, 0x00, 0x53, 0x72, 0x2a,
+	0x48, 0x2b, 0xd4, 0xed, 0xc9, 0x4d, 0x40, 0x3e, 0xe8, 0xf9, 0x29, 0x48, 0x16, 0x61, 0xc4, 0xcb,
+	0xfc, 0x6e, 0x09, 0x2e, 0x64, 0xe2, 0x93, 0x4f, 0x45, 0x93, 0xa1, 0x90, 0x70, 0xf7, 0x52, 0x93,
+	0xe1, 0x7c, 0xaa, 0x5a, 0x62, 0x4e, 0x30, 0xcf, 0x69, 0x7e, 0x89, 0x15, 0x57, 0x88, 0xa7, 0x52,
+	0x4a, 0x6f, 0x2a, 0x08, 0x6a, 0x58, 0xac, 0x07, 0x83, 0x5d, 0xab, 0xe3, 0x3d, 0x88, 0x08, 0xcb,
+	0xe9, 0xa4, 0x7a, 0xb0, 0x95, 0x80, 0x62, 0x0a, 0x5b, 0x9f, 0x87, 0xe5, 0x27, 0xcc, 0xc3, 0x37,
+	0xa5, 0x73, 0x3d, 0xb7, 0xb3, 0x54, 0x4e, 0x3c, 0x0b, 0xb5, 0x54, 0xde, 0x92, 0x08, 0xc6, 0xf4,
+	0xc8, 0x3e, 0x10, 0x36, 0x21, 0x37, 0x7d, 0xcb, 0x0d, 0x78, 0x04, 0x2b, 0xab, 0x63, 0x54, 0x4f,
+	0xcc, 0x45, 0x8d, 0xc0, 0xb5, 0x21, 0x6a, 0x98, 0xc1, 0xc1, 0xfc, 0xcd, 0x02, 0x3c, 0x37, 0x62,
+	0xed, 0x25, 0xaf, 0x27, 0x2e, 0x5b, 0xfa, 0x89, 0x54, 0x94, 0xd1, 0x07, 0x47, 0x54, 0xd3, 0xc2,
+	0x8e, 0x98, 0xe3, 0x9e, 0xef, 0x75, 0x7d, 0x1a, 0x04, 0xec, 0xea, 0x01, 0xbe, 0x3a, 0x4b, 0x07,
+	0xbb, 0xa2, 0xe6, 0xb8, 0x97, 0x8d, 0x82, 0xa3, 0xea, 0x9a, 0x2b, 0x20, 0xee, 0x89, 0x61, 0xb9,
+	0x85, 0x77, 0xc3, 0xb0, 0x1f, 0xb9, 0x9d, 0x70, 0xfd, 0x1c, 0x0f, 0xb9, 0x46, 0x51, 0xce, 0xee,
+	0x67, 0x62, 0x7f, 0xa4, 0x81, 0x82, 0x2b, 0x90, 0x18, 0x1c, 0x79, 0x29, 0xcb, 0xe9, 0x7e, 0x61,
+	0x83, 0xd9, 0x04, 0x62, 0xcb, 0x88, 0xcc, 0x52, 0xf1, 0x19, 0x38, 0xe3, 0x78, 0xde, 0x9e, 0xb5,
+	0x4b, 0xad, 0x84, 0x4b, 0xa4, 0x3c, 0x77, 0xac, 0xa5, 0x60, 0x38, 0x84, 0xcd, 0x4e, 0x4c, 0xfd,
+	0x84, 0x47, 0xa1, 0xb8, 0x39, 0x90, 0x9f, 0x98, 0x92, 0xce, 0x83, 0x49, 0x3c, 0xf3, 0xf7, 0x8a,
+	0x50, 0x11, 0x17, 0x98, 0xac, 0xc2, 0x39, 0xa6, 0xc0, 0xb6, 0x2d, 0x67, 0x99, 0x3a, 0xd6, 0x81,
+	0xde, 0x0e, 0x19, 0xc1, 0xbc, 0x3a, 0x0c, 0xc6, 0xac, 0x3a, 0x6c, 0xbd, 0x93, 0x37, 0x82, 0xe8,
+	0xcd, 0xa9, 0xc8, 0x1b, 0xb8, 0x12, 0x10, 0x4c, 0x61, 0x0e, 0xbf, 0x49, 0x29, 0x8e, 0xc0, 0x7e,
+	0xdc, 0x9b, 0x70, 0x9d, 0xc4, 0x80, 0x9f, 0xff, 0x55, 0xa4, 0xb3, 0x74, 0x16, 0x17, 0x3a, 0x89,
+	0x14, 0x0c, 0x87, 0xb0, 0x19, 0x85, 0x1d, 0xcb, 0x76, 0x06, 0x3e, 0x8d, 0x29, 0x54, 0x62, 0x0a,
+	0x2b, 0x29, 0x18, 0x0e, 0x61, 0x9b, 0xbf, 0x57, 0x00, 0x10, 0x37, 0x2e, 0x73, 0xe5, 0xf2, 0x84,
+	0x6e, 0x9d, 0x64, 0x77, 0x05, 0x6e, 0x47, 0xea, 0xe5, 0xdc, 0x77, 0x05, 0x8a, 0xf6, 0xc5, 0xea,
+	0x6a, 0x71, 0x79, 0x77, 0xf4, 0x88, 0x31, 0x27, 0xf3, 0x1f, 0x14, 0x60, 0x2e, 0x85, 0xcd, 0xee,
+	0x64, 0x8c, 0x52, 0xb7, 0x9f, 0xec, 0xad, 0xc4, 0x76, 0x2a, 0xab, 0xa2, 0x22, 0x32, 0xf9, 0x4b,
+	0x1e, 0xbf, 0x56, 0x8c, 0xbe, 0x01, 0xf7, 0xfd, 0xbf, 0x06, 0x20, 0x53, 0xac, 0x76, 0x3a, 0xbe,
+	0x51, 0x48, 0xae, 0xf2, 0x2d, 0x05, 0x41, 0x0d, 0xeb, 0x78, 0x6e, 0xea, 0xaf, 0xc1, 0x74, 0xdf,
+	0xf7, 0xd8, 0x5e, 0xed, 0xf3, 0x13, 0x69, 0x2a, 0x64, 0x67, 0x43, 0x83, 0x61, 0x02, 0x93, 0x58,
+	0x52, 0x55, 0x5d, 0x9d, 0xc8, 0x5d, 0xdf, 0x99, 0xca, 0xea, 0x3f, 0x2a, 0xc2, 0xb4, 0xec, 0x04,
+	0xa1, 0xe6, 0x3f, 0xcd, 0x6e, 0x88, 0xbc, 0xef, 0xb3, 0xba, 0x61, 0x49, 0x83, 0x61, 0x02, 0x93,
+	0x2c, 0xb3, 0x09, 0xbb, 0x2d, 0x32, 0x9b, 0xd9, 0x9e, 0xcb, 0x6b, 0x8b, 0x4d, 0x51, 0xe5, 0x82,
+	0x69, 0xa5, 0xe0, 0x38, 0x54, 0x83, 0x79, 0x5d, 0xf4, 0xac, 0x87, 0x5b, 0x2e, 0x73, 0x4a, 0xaf,
+	0x24, 0xdd, 0x3c, 0xd6, 0x65, 0x39, 0x2a, 0x8c, 0xa7, 0xd1, 0xf5, 0xff, 0xa3, 0x00, 0x64, 0x38,
+	0x64, 0x9a, 0xec, 0x42, 0xd5, 0xe5, 0xa6, 0xef, 0xdc, 0xf7, 0x8a, 0x6a, 0x16, 0x74, 0x71, 0x2e,
+	0x96, 0x05, 0x92, 0x3e, 0x71, 0xa1, 0x46, 0x1f, 0x86, 0x6c, 0x7a, 0x39, 0xb9, 0x73, 0x1e, 0xe8,
+	0x77, 0x98, 0x0a, 0x75, 0xb8, 0xa4, 0x8c, 0x8a, 0x87, 0xf9, 0x87, 0x45, 0x68, 0x68, 0x78, 0x4f,
+	0xb2, 0x28, 0xf1, 0x5c, 0x97, 0xc2, 0xe2, 0xbc, 0xe5, 0x8b, 0x16, 0x26, 0x72, 0x5d, 0x4a, 0x10,
+	0xb3, 0x58, 0x68, 0x78, 0x6c, 0x00, 0xf7, 0xac, 0x20, 0x4c, 0x8c, 0x32, 0x35, 0x80, 0xd7, 0x15,
+	0x04, 0x35, 0x2c, 0x76, 0xe7, 0x07, 0xbf, 0x85, 0xb6, 0x9c, 0xbc, 0xf3, 0x63, 0xc4, 0x15, 0xb3,
+	0x95, 0x09, 0xac, 0x3e, 0xa4, 0x0b, 0x67, 0xa2, 0x56, 0x47, 0xd0, 0x93, 0xdd, 0x17, 0x21, 0x36,
+	0xab, 0x14, 0x09, 0x1c, 0x22, 0x6a, 0x7e, 0xa7, 0x00, 0x33, 0x09, 0x7b, 0x27, 0x79, 0x49, 0x0f,
+	0xf8, 0x4f, 0xdc, 0xe5, 0xa1, 0xc5, 0xe9, 0x7f, 0x04, 0xaa, 0xa2, 0x83, 0xd2, 0xb6, 0x27, 0xd1,
+	0x85, 0x28, 0xa1, 0x4c, 0x50, 0x95, 0x1e, 0x15, 0xe9, 0x03, 0xa3, 0x74, 0xb9, 0xc0, 0x08, 0x2e,
+	0xfc, 0x9e, 0x44, 0xeb, 0x64, 0x4f, 0x6b, 0x7e, 0x4f, 0xa2, 0x1c, 0x15, 0x86, 0xf9, 0x4f, 0x78,
+	0xbb, 0x43, 0xff, 0x40, 0xc9, 0x7d, 0x5d, 0x98, 0x92, 0xb1, 0x5b, 0x46, 0x21, 0xa7, 0x35, 0x45,
+	0x46, 0x84, 0xc9, 0xe8, 0x23, 0xab, 0xbd, 0x77, 0x67, 0x67, 0x07, 0x23, 0xea, 0xe4, 0x3a, 0xd4,
+	0x3d, 0x57, 0xee, 0xe2, 0x46, 0x51, 0xdd, 0x33, 0x54, 0xbf, 0x13, 0x15, 0x3e, 0x3a, 0x9c, 0xbf,
+	0xa8, 0x1e, 0x12, 0x8d, 0xc4, 0xb8, 0xa6, 0xf9, 0x97, 0x0a, 0x70, 0x01, 0x3d, 0xc7, 0xb1, 0xdd,
+	0x6e, 0xd2, 0x6f, 0x8f, 0x38, 0x30, 0x2b, 0x56, 0x9a, 0x7d, 0xcb, 0x76, 0x58, 0xa8, 0xe5, 0x13,
+	0x8d, 0x01, 0x83, 0xd0, 0x76, 0x16, 0x6c, 0x37, 0x0c, 0x42, 0x9f, 0x69, 0x47, 0xee, 0xf8, 0xad,
+	0x90, 0xa7, 0x24, 0xe2, 0x92, 0xd2, 0x7a, 0x82, 0x16, 0xa6, 0x68, 0x9b, 0xff, 0xbe, 0x0c, 0x3c,
+	0x2e, 0x88, 0x7c, 0x02, 0xea, 0x3d, 0xda, 0xde, 0xb5, 0x5c, 0x3b, 0x88, 0xee, 0x7e, 0x62, 0x86,
+	0xb2, 0xfa, 0x7a, 0x54, 0xf8, 0x88, 0x7d, 0x8a, 0xc5, 0xd6, 0x1a, 0x97, 0x95, 0x63, 0x5c, 0xe6,
+	0x20, 0xdd, 0x0d, 0x02, 0xab, 0x6f, 0xe7, 0x76, 0x90, 0x16, 0xb7, 0xd0, 0x88, 0xe5, 0x48, 0xfc,
+	0x47, 0x49, 0x9a, 0x59, 0xd8, 0xfb, 0x8e, 0x65, 0xbb, 0xd2, 0x9e, 0xd0, 0xcc, 0x15, 0x0d, 0xb5,
+	0xc1, 0x28, 0x09, 0x09, 0x89, 0xff, 0x45, 0x41, 0x9b, 0x0c, 0xa0, 0x11, 0xb4, 0x7d, 0xab, 0x17,
+	0xec, 0x5a, 0xd7, 0x5e, 0xfd, 0xb8, 0x51, 0x9e, 0x18, 0x2b, 0xa1, 0x62, 0x58, 0xc2, 0xc5, 0xf5,
+	0xd6, 0xcd, 0xc5, 0x6b, 0xaf, 0x7e, 0x1c, 0x75, 0x3e, 0x3a, 0xdb, 0x57, 0x5f, 0xb9, 0x66, 0x54,
+	0x4e, 0x87, 0xed, 0xab, 0xaf, 0x5c, 0x43, 0x9d, 0x0f, 0xeb, 0x52, 0x4f, 0xdb, 0xc6, 0xf2, 0x31,
+	0xbc, 0x13, 0x3b, 0x2d, 0xf0, 0xbf, 0x28, 0x68, 0x9b, 0xff, 0xab, 0x00, 0x75, 0x05, 0x67, 0x0b,
+	0xa5, 0xc8, 0xaf, 0xbf, 0xba, 0x3c, 0x86, 0xdc, 0xb7, 0x24, 0xab, 0xa2, 0x22, 0xc2, 0x2e, 0xd5,
+	0x11, 0xff, 0x45, 0x95, 0x93, 0xc9, 0x7e, 0x3c, 0xfc, 0x73, 0x49, 0xab, 0x8e, 0x09, 0x62, 0xcc,
+	0xe9, 0x8f, 0x4b, 0xce, 0x91, 0xfd, 0x5b, 0xae, 0x61, 0xca, 0xe9, 0x6f, 0x53, 0x07, 0x62, 0x12,
+	0x57, 0xbd, 0x38, 0xff, 0x12, 0x64, 0x0b, 0x80, 0xed, 0x14, 0xb2, 0x95, 0x27, 0x7a, 0x75, 0x6e,
+	0x3e, 0xdc, 0x52, 0x95, 0x51, 0x23, 0x94, 0x71, 0x6d, 0x51, 0x71, 0xd2, 0xd7, 0x16, 0x5d, 0x85,
+	0xfa, 0xae, 0xe5, 0x76, 0x82, 0x5d, 0x6b, 0x8f, 0xca, 0x60, 0x55, 0xa5, 0x14, 0xb8, 0x19, 0x01,
+	0x30, 0xc6, 0x31, 0xbf, 0x57, 0x03, 0xe1, 0x33, 0xce, 0x96, 0xf4, 0x8e, 0x1d, 0x88, 0x90, 0xf2,
+	0x42, 0x32, 0xd2, 0x6f, 0x59, 0x96, 0xa3, 0xc2, 0x60, 0x37, 0x07, 0xf5, 0x6c, 0x57, 0x9e, 0xf1,
+	0xb8, 0x57, 0xc6, 0xba, 0xed, 0x22, 0x2b, 0xe3, 0x20, 0xeb, 0xa1, 0x51, 0xd2, 0x40, 0xd6, 0x43,
+	0x64, 0x65, 0xcc, 0x56, 0xc7, 0x8e, 0xb1, 0x6c, 0x71, 0xd6, 0xc3, 0xe0, 0x66, 0x84, 0x0e, 0x76,
+	0x2d, 0x09, 0xc2, 0x34, 0x2e, 0x3b, 0xec, 0xbf, 0x43, 0x7d, 0x4f, 0xee, 0x46, 0x2d, 0x87, 0xd2,
+	0x7e, 0x44, 0x46, 0x88, 0x81, 0xfc, 0xb0, 0xff, 0x85, 0x6c, 0x14, 0x1c, 0x55, 0x97, 0x91, 0x15,
+	0xea, 0x9e, 0x0d, 0xdf, 0x63, 0xa7, 0x43, 0x96, 0x6f, 0x51, 0x92, 0xad, 0xc6, 0x64, 0x37, 0xb3,
+	0x51, 0x70, 0x54, 0x5d, 0x76, 0xb7, 0xb8, 0x00, 0x09, 0xa1, 0x70, 0x51, 0x2c, 0xe2, 0xb6, 0x63,
+	0x87, 0x07, 0x52, 0x35, 0xc9, 0x9d, 0xdf, 0x36, 0x47, 0xe0, 0xe0, 0xc8, 0xda, 0xe4, 0x0d, 0x38,
+	0x13, 0xb9, 0x3e, 0x6e, 0x50, 0xbf, 0xa5, 0xe2, 0x08, 0x66, 0xa2, 0x70, 0xca, 0x28, 0x9c, 0x10,
+	0x53, 0x58, 0x38, 0x54, 0x8f, 0xdd, 0xea, 0xcd, 0x83, 0x05, 0xb6, 0xfa, 0x4b, 0x9e, 0xe7, 0x74,
+	0xbc, 0x07, 0x6e, 0xf4, 0xee, 0x42, 0xcb, 0xc9, 0xbd, 0x1d, 0x5b, 0x99, 0x18, 0x38, 0xa2, 0x26,
+	0x7b, 0x73, 0x0e, 0x59, 0xf6, 0x1e, 0xb8, 0x69, 0xaa, 0x10, 0xbf, 0x79, 0x6b, 0x04, 0x0e, 0x8e,
+	0xac, 0x4d, 0x56, 0x80, 0xa4, 0xdf, 0x60, 0xab, 0x2f, 0xdd, 0x7b, 0x2f, 0x8a, 0x04, 0xdb, 0x69,
+	0x28, 0x66, 0xd4, 0x20, 0x6b, 0x70, 0x3e, 0x5d, 0xca, 0xd8, 0x49, 0x4f, 0x5f, 0x7e, 0xb5, 0x16,
+	0x66, 0xc0, 0x31, 0xb3, 0x16, 0x93, 0xf3, 0xfb, 0x22, 0x85, 0xe9, 0x4c, 0x4e, 0xd9, 0x5b, 0xd3,
+	0x10, 0x89, 0x8d, 0x55, 0xfc, 0x47, 0x49, 0x9f, 0x89, 0x72, 0x1d, 0xff, 0x00, 0x07, 0xae, 0x31,
+	0x9b, 0x8c, 0x44, 0x5f, 0xe6, 0xa5, 0x28, 0xa1, 0xe4, 0x01, 0xd4, 0x03, 0xe9, 0x81, 0xcb, 0xee,
+	0xc9, 0x28, 0xe5, 0x72, 0xb1, 0x4b, 0x38, 0xf4, 0x6a, 0x4a, 0xc6, 0x88, 0x01, 0xc6, 0xbc, 0xcc,
+	0xff, 0x5e, 0x84, 0x86, 0xae, 0xe6, 0x7a, 0x07, 0xea, 0x62, 0x18, 0xaf, 0x59, 0x51, 0x3e, 0xe6,
+	0xf5, 0x1c, 0xd7, 0x05, 0x4a, 0x4a, 0x7a, 0x37, 0x09, 0x33, 0x5a, 0x04, 0xc1, 0x98, 0x1d, 0xd9,
+	0x86, 0x52, 0xbb, 0x3f, 0xc8, 0x1d, 0x15, 0xb9, 0xb4, 0xb1, 0xa5, 0xf3, 0xe3, 0x2b, 0xda, 0x12,
+	0xbb, 0x26, 0xad, 0xdd, 0x1f, 0x90, 0x5f, 0x00, 0xe8, 0x2b, 0xfd, 0x9e, 0x51, 0xca, 0xab, 0x21,
+	0xcf, 0x52, 0x15, 0x8a, 0x3d, 0x25, 0x06, 0xa1, 0xc6, 0x91, 0x79, 0xeb, 0xcc, 0x24, 0xbe, 0xcf,
+	0x31, 0x2e, 0x3d, 0x7c, 0x09, 0x2a, 0x5c, 0x2b, 0x9c, 0x3e, 0xe3, 0x73, 0xad, 0x31, 0x0a, 0x58,
+	0xe2, 0xe6, 0xd5, 0xd2, 0xc4, 0x6f, 0x5e, 0x65, 0x41, 0xe8, 0x76, 0x8f, 0x7e, 0xc1, 0x73, 0x69,
+	0xfa, 0xfc, 0xb0, 0x29, 0xcb, 0x51, 0x61, 0x44, 0x9b, 0x4d, 0x65, 0xf4, 0x66, 0x53, 0x1d, 0xde,
+	0x6c, 0xcc, 0xbf, 0x51, 0x84, 0x39, 0xd6, 0x35, 0xb6, 0xdb, 0x5d, 0xa6, 0x6d, 0x9b, 0xbb, 0x94,
+	0x7f, 0x52, 0xcd, 0x54, 0xd1, 0x3d, 0x1f, 0x52, 0x6e, 0x78, 0x51, 0xa2, 0xe1, 0x39, 0xad, 0xe7,
+	0xb9, 0xec, 0x1c, 0x4d, 0xbd, 0x9f, 0x4c, 0x79, 0xa3, 0x9f, 0x38, 0xb6, 0xa4, 0x74, 0xc2, 0xd8,
+	0x92, 0x37, 0xa1, 0xde, 0xa1, 0x6d, 0xbb, 0xc3, 0x8d, 0x01, 0xe5, 0xf1, 0x8d, 0x01, 0xcb, 0x11,
+	0x11, 0x8c, 0xe9, 0x99, 0x0d, 0xa8, 0x73, 0x0d, 0x10, 0xd3, 0x97, 0x99, 0xff, 0x8e, 0xf5, 0x54,
+	0x32, 0xaf, 0xfa, 0x53, 0x70, 0x6f, 0x72, 0x13, 0xee, 0x4d, 0xe3, 0x3b, 0x0d, 0xa6, 0x5a, 0x3e,
+	0xd2, 0xcb, 0x69, 0x3f, 0xe5, 0xe5, 0x74, 0x7b, 0x62, 0x1c, 0x1f, 0xef, 0xec, 0x74, 0x54, 0x80,
+	0x73, 0xa9, 0x1a, 0x4f, 0xc1, 0x87, 0xa7, 0x97, 0xf4, 0xe1, 0xb9, 0x39, 0xa9, 0x97, 0x1d, 0xe1,
+	0xca, 0xf3, 0xbf, 0x87, 0x5f, 0xb2, 0x25, 0x5c, 0xcb, 0xa6, 0x64, 0x0a, 0xeb, 0xdc, 0x3a, 0x30,
+	0x49, 0x9e, 0x7f, 0xdf, 0x64, 0xce, 0x59, 0xb7, 0x8b, 0x11, 0x17, 0x12, 0x40, 0x2d, 0xca, 0x53,
+	0x3d, 0x59, 0xc7, 0x39, 0xd5, 0xd9, 0x51, 0x29, 0x2a, 0x46, 0xe6, 0x2f, 0x97, 0xe0, 0x42, 0xe6,
+	0xa0, 0x78, 0x7a, 0x3e, 0x02, 0x9f, 0x4a, 0xfa, 0x08, 0x0c, 0x9b, 0x45, 0x53, 0xed, 0x7b, 0x86,
+	0x5d, 0x05, 0x26, 0x68, 0xfe, 0x36, 0xe7, 0x60, 0x26, 0x91, 0x5b, 0xdd, 0xfc, 0x41, 0x15, 0x1a,
+	0xda, 0x48, 0x7a, 0xf6, 0x92, 0x26, 0xbf, 0x05, 0x95, 0x3e, 0x33, 0x3d, 0x1a, 0xa5, 0x9c, 0x91,
+	0xc1, 0xdc, 0x80, 0x29, 0xf5, 0x26, 0xec, 0x2f, 0x0a, 0xba, 0xcc, 0x52, 0xd7, 0x0b, 0xba, 0xab,
+	0xcb, 0x37, 0xa9, 0xd5, 0xa1, 0x3e, 0xcb, 0x6a, 0x24, 0x76, 0x60, 0xa1, 0x7f, 0x4a, 0x40, 0x30,
+	0x85, 0x49, 0xd6, 0xe0, 0x82, 0x4f, 0xef, 0x0f, 0x68, 0x10, 0x26, 0x4d, 0x7a, 0x46, 0x45, 0x17,
+	0xc1, 0x53, 0x08, 0x01, 0x66, 0x57, 0x62, 0x6b, 0x94, 0xf0, 0xa8, 0xae, 0xe6, 0x9c, 0xa8, 0xd1,
+	0x07, 0x65, 0xc4, 0x64, 0x66, 0x62, 0xad, 0x04, 0x05, 0x97, 0x11, 0xb1, 0xeb, 0x53, 0xef, 0x62,
+	0xec, 0xba, 0x1e, 0xe1, 0x56, 0x7b, 0x6c, 0x84, 0xdb, 0xa8, 0x80, 0x9e, 0xfa, 0xb3, 0x10, 0xd0,
+	0x63, 0x7e, 0x05, 0x12, 0x1d, 0xce, 0x3c, 0xe6, 0xd4, 0xcb, 0xe6, 0x8e, 0xb2, 0x89, 0xe3, 0xc7,
+	0xb9, 0xa8, 0xaf, 0x1e, 0x31, 0xe6, 0x61, 0xee, 0xb0, 0x69, 0xce, 0x13, 0x31, 0xcb, 0xeb, 0x01,
+	0xb6, 0x60, 0x4a, 0x1a, 0x99, 0xc7, 0xcc, 0x9f, 0x2d, 0xae, 0x01, 0x10, 0x24, 0x30, 0xa2, 0x65,
+	0xfe, 0xa0, 0x04, 0x75, 0xe5, 0x3c, 0x77, 0x0c, 0x51, 0x3b, 0xd1, 0x11, 0xc5, 0xd3, 0xef, 0x08,
+	0x3d, 0x1b, 0x42, 0x29, 0x47, 0x36, 0x84, 0x7e, 0x7c, 0xbb, 0x42, 0x39, 0x67, 0x3a, 0x04, 0xd5,
+	0x5d, 0x8f, 0xbd, 0x60, 0x81, 0x19, 0xe2, 0x7d, 0xca, 0x5f, 0xa2, 0x23, 0x03, 0x41, 0x03, 0xdd,
+	0x10, 0x8f, 0x29, 0x18, 0x0e, 0x61, 0x73, 0x2f, 0x02, 0xdb, 0x8d, 0x4b, 0x64, 0xf2, 0x59, 0xe1,
+	0x45, 0xa0, 0x03, 0x30, 0x89, 0x67, 0xfe, 0x76, 0x11, 0xce, 0xa4, 0x5b, 0xc9, 0x2d, 0x1c, 0x51,
+	0xec, 0x6b, 0x2a, 0x4d, 0x96, 0x0a, 0x78, 0x55, 0x18, 0x6c, 0x22, 0xb3, 0x21, 0xf2, 0x8e, 0xe7,
+	0x46, 0x1b, 0xf0, 0x74, 0x74, 0x96, 0x79, 0x47, 0x9d, 0x65, 0xd8, 0x3f, 0x96, 0x47, 0xe5, 0x01,
+	0xf3, 0x5d, 0xcf, 0xed, 0x6a, 0xaf, 0x5a, 0x7c, 0x8f, 0x91, 0x13, 0xeb, 0x3c, 0xff, 0x8b, 0x82,
+	0x81, 0xda, 0xd9, 0xca, 0xa7, 0xb9, 0xb3, 0x99, 0x5d, 0x98, 0x4d, 0xb6, 0x84, 0x2c, 0x00, 0xa8,
+	0x8b, 0x43, 0xa3, 0xd4, 0x70, 0xfc, 0x08, 0xab, 0xae, 0x9e, 0x0a, 0x50, 0xc3, 0x60, 0x79, 0xe4,
+	0x02, 0xae, 0xbd, 0x14, 0xb2, 0xaa, 0xcc, 0x23, 0x27, 0x14, 0x9a, 0x01, 0x46, 0x30, 0xf3, 0xbf,
+	0x94, 0xe0, 0x79, 0xc5, 0x29, 0x58, 0xb7, 0x5c, 0xab, 0x9b, 0x8c, 0x07, 0x7d, 0x3f, 0x73, 0xe3,
+	0x09, 0x76, 0x9e, 0xd1, 0xf1, 0xb3, 0xa5, 0x77, 0x3f, 0x7e, 0xd6, 0xfc, 0xbf, 0x45, 0xe0, 0x29,
+	0x71, 0xd8, 0x35, 0x37, 0x51, 0x7f, 0xb2, 0x67, 0xa3, 0x90, 0x53, 0x50, 0x58, 0xd4, 0x88, 0xc5,
+	0x0e, 0x0b, 0x7a, 0x29, 0x26, 0x18, 0x12, 0x2f, 0x95, 0xff, 0x6e, 0x62, 0xcc, 0xa7, 0xb3, 0x53,
+	0xe8, 0xb1, 0x3c, 0x28, 0x33, 0xbe, 0x6e, 0x86, 0x34, 0x4a, 0x39, 0xe7, 0x6f, 0xc2, 0xa8, 0xa9,
+	0x27, 0x41, 0xd0, 0x8a, 0x31, 0xc9, 0xd3, 0xfc, 0xcf, 0x05, 0x98, 0x69, 0x39, 0x76, 0xc7, 0x76,
+	0xbb, 0x72, 0x43, 0x45, 0xa8, 0x3a, 0x22, 0xba, 0xa6, 0x30, 0xfe, 0x1d, 0xfa, 0x32, 0x08, 0x47,
+	0x52, 0x22, 0x77, 0xa0, 0x12, 0x38, 0x76, 0x87, 0x8e, 0x99, 0x21, 0x8b, 0x2f, 0x79, 0xac, 0x95,
+	0x4c, 0xc2, 0x63, 0x3f, 0xcc, 0xfc, 0x21, 0x72, 0xd2, 0xb2, 0xf3, 0x66, 0xca, 0xfc, 0xd1, 0x8a,
+	0x00, 0x18, 0xe3, 0x98, 0xdf, 0xae, 0x83, 0x4c, 0xee, 0xc4, 0x1c, 0xae, 0xba, 0xe2, 0xdc, 0xe0,
+	0x45, 0x32, 0xcb, 0xcd, 0x1c, 0x57, 0x6b, 0x4a, 0x4a, 0x82, 0xb8, 0xd8, 0xb0, 0x55, 0x21, 0xc6,
+	0x9c, 0x08, 0x85, 0x0a, 0x4f, 0xd8, 0x98, 0xdb, 0x6d, 0x43, 0x4b, 0xcd, 0x29, 0x7a, 0x86, 0x17,
+	0xa0, 0xa0, 0xce, 0x9c, 0x60, 0xb8, 0x9b, 0x62, 0x29, 0xa7, 0x13, 0x4c, 0x7c, 0xdd, 0x4c, 0xda,
+	0xd7, 0x91, 0xb1, 0x70, 0xad, 0x30, 0xc8, 0x7d, 0x2d, 0x50, 0x1c, 0xa8, 0x2c, 0xe3, 0x98, 0xad,
+	0x30, 0x40, 0x4e, 0x9a, 0xfc, 0x3c, 0x34, 0x42, 0xdf, 0x72, 0x83, 0x1d, 0xcf, 0xef, 0x51, 0xdf,
+	0xa8, 0xe4, 0x9c, 0x19, 0x5b, 0xcb, 0x9b, 0x31, 0x35, 0x21, 0x28, 0x24, 0x8a, 0x50, 0xe7, 0x46,
+	0xf6, 0x98, 0xbb, 0xb8, 0x68, 0x98, 0x3c, 0xb0, 0x2c, 0xe6, 0xe0, 0xac, 0xc7, 0x9b, 0x46, 0x4f,
+	0xa8, 0x18, 0xb0, 0xd1, 0x18, 0xdf, 0xad, 0x30, 0x95, 0x73, 0x34, 0xa6, 0xf2, 0x3e, 0x8f, 0xbe,
+	0x54, 0x81, 0xdd, 0xaa, 0x15, 0xa9, 0x6b, 0x6a, 0x39, 0x3b, 0x37, 0x71, 0xec, 0x8e, 0xf6, 0xf4,
+	0x94, 0xb2, 0xc6, 0x86, 0x6a, 0x9f, 0x7b, 0x55, 0x19, 0xf5, 0x9c, 0x6b, 0xab, 0xee, 0xf8, 0x26,
+	0x2d, 0x27, 0xbc, 0x04, 0x25, 0x03, 0xf2, 0x45, 0x28, 0x05, 0xf7, 0x03, 0x03, 0x72, 0xca, 0xe0,
+	0xad, 0xfb, 0xd1, 0xd8, 0xe4, 0xca, 0xe6, 0xd6, 0xfd, 0x00, 0x19, 0x5d, 0x36, 0x8d, 0x3b, 0xb4,
+	0x33, 0xe8, 0x1b, 0x8d, 0x9c, 0xd3, 0x78, 0x99, 0x51, 0x91, 0xf7, 0x82, 0xf1, 0x69, 0xcc, 0x0b,
+	0x50, 0x50, 0x67, 0x76, 0xea, 0x29, 0xd6, 0x04, 0xb6, 0x35, 0x5d, 0x85, 0xba, 0xf5, 0x20, 0x10,
+	0x71, 0xe1, 0x52, 0x44, 0x55, 0x8b, 0xdd, 0xe2, 0xbd, 0x96, 0x00, 0x60, 0x8c, 0xc3, 0x2a, 0xf0,
+	0x80, 0x7c, 0xee, 0x4d, 0x55, 0x4c, 0x56, 0xf8, 0x6c, 0x04, 0xc0, 0x18, 0x87, 0xdc, 0x85, 0x8b,
+	0xfc, 0xe1, 0xce, 0x03, 0x97, 0xfa, 0x8b, 0xf7, 0x5a, 0x8b, 0xed, 0xb6, 0x37, 0xe0, 0xee, 0x00,
+	0xa5, 0x44, 0x20, 0xca, 0xc5, 0xcf, 0x66, 0x62, 0xe1, 0x88, 0xda, 0x63, 0x04, 0xaf, 0x9b, 0xbf,
+	0x5f, 0x86, 0xba, 0xea, 0xfb, 0xf7, 0xf0, 0xab, 0x2f, 0xc1, 0xd9, 0x7d, 0x3b, 0xb0, 0x85, 0x21,
+	0x57, 0x0f, 0x19, 0xad, 0x08, 0xe1, 0xed, 0x6e, 0x1a, 0x88, 0xc3, 0xf8, 0xcc, 0x6d, 0xbb, 0x67,
+	0x3d, 0xbc, 0x3d, 0xe8, 0x6d, 0x53, 0xff, 0xce, 0x8e, 0x54, 0xd1, 0x45, 0xc7, 0x25, 0xee, 0xb6,
+	0xbd, 0x3e, 0x0c, 0xc6, 0xac, 0x3a, 0xcc, 0x22, 0xff, 0xc0, 0xb2, 0xb9, 0x62, 0x46, 0xb7, 0x79,
+	0x57, 0x84, 0x45, 0xfe, 0x5e, 0x12, 0x84, 0x69, 0xdc, 0xf4, 0x97, 0x9c, 0x7a, 0xf2, 0x97, 0x64,
+	0xea, 0x27, 0x2b, 0x0c, 0x7d, 0x7b, 0x7b, 0x10, 0xf2, 0xae, 0x16, 0x01, 0x6e, 0x52, 0xfd, 0xb4,
+	0x98, 0x80, 0x60, 0x0a, 0x93, 0xdc, 0x81, 0x0b, 0x52, 0x0f, 0x99, 0x44, 0x94, 0x17, 0x11, 0x70,
+	0x41, 0x73, 0x3d, 0x0b, 0x01, 0xb3, 0xeb, 0x99, 0x3d, 0x90, 0x7a, 0x54, 0xd2, 0xe6, 0xa7, 0x96,
+	0x8e, 0xad, 0x27, 0xcc, 0xbd, 0x7a, 0x3c, 0x81, 0x64, 0x29, 0xaa, 0x17, 0x1b, 0x3c, 0x54, 0x91,
+	0x38, 0xea, 0xc8, 0xff, 0xe6, 0xbf, 0x2d, 0x02, 0xcb, 0x5e, 0x21, 0xee, 0x9d, 0x0e, 0x68, 0x7b,
+	0xe0, 0xd3, 0xd6, 0x9e, 0xdd, 0xbf, 0x4b, 0x7d, 0x7b, 0xe7, 0x40, 0x7a, 0x5d, 0x68, 0xf7, 0x4e,
+	0xa7, 0x31, 0x30, 0xa3, 0x16, 0x77, 0xaa, 0xb1, 0x96, 0xa8, 0x9f, 0xc3, 0xa9, 0x66, 0x31, 0xae,
+	0x8e, 0x09, 0x62, 0xcc, 0x13, 0xa6, 0x1d, 0x93, 0x2e, 0x9d, 0xd8, 0x13, 0x46, 0x23, 0xac, 0x11,
+	0x22, 0x08, 0xf5, 0x3d, 0x7a, 0x20, 0x1e, 0x8c, 0xf2, 0x49, 0xa8, 0xf2, 0xad, 0xeb, 0x56, 0x54,
+	0x17, 0x63, 0x32, 0xa6, 0x0b, 0x33, 0x9b, 0x56, 0x37, 0xee, 0x78, 0xf2, 0x49, 0xa8, 0x79, 0x7d,
+	0x4d, 0x9e, 0xab, 0xf3, 0xac, 0x48, 0xb5, 0x3b, 0xb2, 0x8c, 0xc5, 0xcd, 0xad, 0x79, 0x5d, 0xbb,
+	0x1d, 0x15, 0xa0, 0x42, 0x27, 0x26, 0x54, 0x79, 0x3a, 0xdf, 0xe8, 0x44, 0xca, 0x37, 0x94, 0xbb,
+	0xbc, 0x04, 0x25, 0xc4, 0xfc, 0x39, 0x38, 0x9f, 0x65, 0x8f, 0x66, 0x2e, 0xce, 0xca, 0x04, 0x9d,
+	0x0c, 0xec, 0x50, 0x2e, 0xce, 0x9b, 0x29, 0x38, 0x0e, 0xd5, 0x30, 0xbf, 0x5a, 0x86, 0x38, 0x36,
+	0x94, 0x04, 0x50, 0x15, 0x89, 0x0a, 0x8d, 0x42, 0x4e, 0xad, 0xc1, 0x31, 0x72, 0x22, 0x4a, 0x56,
+	0xa4, 0x0b, 0xa5, 0xb7, 0xbd, 0xed, 0xdc, 0x72, 0xa9, 0x76, 0xbf, 0x82, 0x58, 0x19, 0xb4, 0x02,
+	0x64, 0x1c, 0xc8, 0xdf, 0x2a, 0xc0, 0xd9, 0x20, 0x7d, 0xb2, 0x97, 0x83, 0x0d, 0xf3, 0xeb, 0x47,
+	0xd2, 0xba, 0x02, 0x99, 0x1c, 0x6b, 0x14, 0x18, 0x87, 0xdb, 0xc2, 0xfa, 0x5f, 0x04, 0x26, 0x1a,
+	0xe5, 0x9c, 0xfd, 0x2f, 0x82, 0x1d, 0x93, 0xfd, 0x9f, 0x2c, 0x43, 0xc9, 0xca, 0xfc, 0xe7, 0x65,
+	0x28, 0x6d, 0x2d, 0xaf, 0x3c, 0x75, 0x65, 0x2a, 0xd9, 0x85, 0xa9, 0xed, 0x81, 0xed, 0x84, 0xb6,
+	0x9b, 0xfb, 0xf2, 0x93, 0x95, 0x81, 0xdb, 0x8e, 0xd5, 0xa9, 0x4d, 0x41, 0x15, 0x23, 0xf2, 0xcc,
+	0x0d, 0xb8, 0x2b, 0x2e, 0x55, 0xcd, 0x9d, 0x54, 0x45, 0x5e, 0xce, 0x2a, 0x18, 0xc9, 0x07, 0x8c,
+	0xa8, 0x93, 0xaf, 0xa4, 0x0f, 0xd5, 0xe5, 0x89, 0x1e, 0xaa, 0xcf, 0x3e, 0xe9, 0x40, 0x4d, 0x0e,
+	0x00, 0x3a, 0xd4, 0xea, 0xac, 0xd1, 0x30, 0x54, 0x07, 0x97, 0xd5, 0x1c, 0x42, 0x62, 0x44, 0x4a,
+	0xde, 0x10, 0xca, 0x17, 0xdb, 0xb8, 0x14, 0x35, 0x66, 0xe6, 0x01, 0x54, 0xb7, 0x96, 0xa5, 0x32,
+	0xe3, 0x29, 0xab, 0xe5, 0x7f, 0x1e, 0xd4, 0xd9, 0xe6, 0xe9, 0x33, 0xff, 0x6a, 0x01, 0x92, 0xc7,
+	0xb9, 0xa7, 0xdf, 0x84, 0x1f, 0x14, 0x20, 0x95, 0xe7, 0x95, 0x7c, 0x3c, 0x11, 0xf1, 0x68, 0xa6,
+	0x22, 0x1e, 0x49, 0x12, 0x5b, 0x0b, 0x74, 0xfc, 0x06, 0xd3, 0x0b, 0xe9, 0x1e, 0xe8, 0x46, 0x31,
+	0xa7, 0x17, 0x43, 0xa6, 0x3f, 0xbb, 0x1c, 0xca, 0x3a, 0x08, 0x93, 0x7c, 0xcd, 0x7f, 0x5a, 0x84,
+	0xea, 0x53, 0x4b, 0x6d, 0x4f, 0x13, 0x4e, 0x22, 0x4b, 0x39, 0xd7, 0xdd, 0x91, 0xbe, 0x21, 0xbd,
+	0x94, 0x6f, 0xc8, 0xf5, 0xbc, 0x8c, 0x1e, 0xef, 0x12, 0xf2, 0xaf, 0x0b, 0x20, 0x57, 0xfd, 0x55,
+	0x37, 0x08, 0x2d, 0xb7, 0x4d, 0x99, 0x5f, 0xfe, 0x7e, 0x7c, 0x29, 0x47, 0x1e, 0x47, 0x01, 0x41,
+	0x58, 0xca, 0x2c, 0xfc, 0x7f, 0xb4, 0xa5, 0x30, 0xa3, 0xc6, 0xae, 0x17, 0x84, 0x6e, 0x7c, 0x0a,
+	0x52, 0x46, 0x8d, 0x9b, 0xb2, 0x1c, 0x15, 0x46, 0x3a, 0x1e, 0xa4, 0x32, 0x3a, 0x1e, 0xc4, 0xfc,
+	0x02, 0xcc, 0xa5, 0xf3, 0xf3, 0xdf, 0xc8, 0xcc, 0xcf, 0xff, 0xd2, 0x88, 0xfc, 0xfc, 0x8d, 0xd1,
+	0xb9, 0xf9, 0x7f, 0xa3, 0x08, 0xd3, 0xef, 0x95, 0xbc, 0xfc, 0x59, 0xd9, 0x88, 0x4a, 0x39, 0xb3,
+	0x11, 0x95, 0x4f, 0x92, 0x8d, 0xc8, 0xfc, 0x7e, 0x01, 0xe0, 0xa9, 0x5d, 0x0a, 0xd0, 0x49, 0x3a,
+	0x19, 0xe5, 0x1e, 0xb3, 0xd9, 0xbe, 0x45, 0xff, 0x6c, 0x2a, 0x7a, 0x25, 0xee, 0xb1, 0xc1, 0x72,
+	0x74, 0x5b, 0x89, 0xc4, 0x3b, 0xb9, 0xa5, 0xe2, 0x54, 0x1e, 0x1f, 0x15, 0xd9, 0x9f, 0x2c, 0xc7,
+	0x14, 0x5b, 0x1e, 0x0e, 0x2a, 0xdd, 0x69, 0x34, 0xc5, 0xc2, 0xd0, 0xed, 0xf9, 0x32, 0x1c, 0x54,
+	0x7b, 0x7a, 0x42, 0xa2, 0xa3, 0xd2, 0x44, 0x12, 0x1d, 0xe9, 0xce, 0x05, 0xe5, 0xc7, 0x3a, 0x17,
+	0xec, 0x43, 0x7d, 0xc7, 0xf7, 0x7a, 0x3c, 0x97, 0x90, 0x51, 0xb9, 0x52, 0xca, 0xb5, 0x00, 0x2e,
+	0x79, 0xbd, 0x6d, 0x66, 0x97, 0x65, 0xd4, 0x62, 0x25, 0xcb, 0x4a, 0x44, 0x1f, 0x63, 0x56, 0xdc,
+	0xca, 0xec, 0x09, 0xae, 0xd5, 0x49, 0x72, 0x55, 0xeb, 0xd4, 0xa6, 0xa0, 0x8e, 0x11, 0x9b, 0x64,
+	0xfe, 0xa0, 0xa9, 0xa7, 0x94, 0x3f, 0xe8, 0x40, 0x4f, 0xcb, 0x54, 0xcb, 0xa9, 0xcb, 0x3d, 0x51,
+	0x1a, 0xf7, 0x67, 0x27, 0xa3, 0x8f, 0xf9, 0xf7, 0xeb, 0xd1, 0x2a, 0xfe, 0xcc, 0x5d, 0xcf, 0xfb,
+	0x7e, 0x22, 0xf9, 0x2e, 0x1d, 0xca, 0xf2, 0x5e, 0x7b, 0x8a, 0x59, 0xde, 0xeb, 0x93, 0xc9, 0xf2,
+	0x0e, 0xf9, 0xb2, 0xbc, 0x37, 0x26, 0x94, 0xe5, 0x7d, 0x7a, 0x52, 0x59, 0xde, 0x67, 0xc6, 0xca,
+	0xf2, 0x3e, 0x7b, 0xac, 0x2c, 0xef, 0xbf, 0x54, 0x80, 0x73, 0xd1, 0x97, 0xd1, 0x1c, 0xe4, 0x8d,
+	0xb9, 0x9c, 0x8b, 0x43, 0x8a, 0x9e, 0xd0, 0x46, 0xaf, 0x0d, 0x33, 0xc2, 0x2c, 0xee, 0x13, 0xce,
+	0x3d, 0x4f, 0x7e, 0x06, 0x9e, 0xdf, 0xf1, 0x7c, 0xda, 0x66, 0xbe, 0xa5, 0x5a, 0x50, 0xad, 0x98,
+	0x39, 0x67, 0x19, 0x51, 0x1c, 0x8d, 0x60, 0x1e, 0x96, 0x20, 0xa5, 0x9a, 0x79, 0xdf, 0xcb, 0xe4,
+	0x4f, 0x95, 0x97, 0xc9, 0x37, 0x8b, 0x10, 0x6f, 0xd8, 0x27, 0x8c, 0x7f, 0xfc, 0x1c, 0x4f, 0x41,
+	0xc1, 0x33, 0xe0, 0x8c, 0x79, 0x8e, 0x98, 0x96, 0xe9, 0x2a, 0x38, 0x0d, 0x54, 0xd4, 0x48, 0x00,
+	0x60, 0x77, 0x1c, 0x99, 0x8f, 0x38, 0xb7, 0xbd, 0x7e, 0x55, 0x91, 0x12, 0x3a, 0xa2, 0xf8, 0x19,
+	0x35, 0x36, 0xe6, 0xaf, 0x55, 0xa0, 0x2a, 0x1d, 0x3d, 0x28, 0x54, 0x76, 0xec, 0x87, 0xb2, 0x13,
+	0xf2, 0x28, 0x7e, 0x57, 0x18, 0x15, 0xdd, 0x92, 0xc9, 0x0b, 0x50, 0x50, 0xe7, 0x96, 0x66, 0xe1,
+	0x60, 0x62, 0x14, 0x73, 0xea, 0xe2, 0x12, 0x8e, 0x2a, 0xd2, 0xd2, 0x2c, 0x8a, 0x30, 0xe2, 0xc1,
+	0xd9, 0x09, 0x07, 0xd1, 0xdc, 0xfe, 0x34, 0x09, 0x47, 0x53, 0xc9, 0x4e, 0x14, 0x61, 0xc4, 0x83,
+	0x7c, 0x19, 0x1a, 0x56, 0xbb, 0x3d, 0xe8, 0x0d, 0x1c, 0x6e, 0x7e, 0xc8, 0x7b, 0xc1, 0xc3, 0x62,
+	0x4c, 0x4b, 0xb2, 0xe5, 0xa7, 0x50, 0xad, 0x18, 0x75, 0x7e, 0xec, 0x1b, 0xb6, 0x55, 0x9a, 0xbd,
+	0x3c, 0xdf, 0x90, 0xe7, 0xa3, 0xd3, 0xbf, 0x21, 0x2f, 0x40, 0x41, 0x9d, 0x99, 0xef, 0xbb, 0x8e,
+	0xb7, 0x6d, 0x39, 0xb9, 0x1d, 0xb8, 0x6f, 0x70, 0x32, 0x92, 0x91, 0xc8, 0x28, 0xc0, 0x4b, 0x50,
+	0x32, 0x30, 0x7f, 0xb1, 0x00, 0x33, 0x02, 0x1c, 0x39, 0x68, 0xce, 0x47, 0xef, 0xa8, 0x65, 0xe6,
+	0x4a, 0xb4, 0xee, 0x73, 0x50, 0xe3, 0x42, 0xe8, 0xbe, 0xe5, 0xe4, 0x99, 0xa2, 0xab, 0x92, 0x06,
+	0x2a, 0x6a, 0xcd, 0x2f, 0x7e, 0xe1, 0x13, 0xf1, 0x8b, 0x5e, 0x8d, 0x5e, 0xf4, 0x6a, 0xf4, 0x5a,
+	0x57, 0xfb, 0x7b, 0x5d, 0x96, 0xbe, 0x3a, 0x88, 0x4b, 0xa2, 0x17, 0xfd, 0xee, 0x8f, 0x2e, 0x7f,
+	0xe0, 0xfb, 0x3f, 0xba, 0xfc, 0x81, 0x1f, 0xfe, 0xe8, 0xf2, 0x07, 0xbe, 0x7a, 0x74, 0xb9, 0xf0,
+	0xdd, 0xa3, 0xcb, 0x85, 0xef, 0x1f, 0x5d, 0x2e, 0xfc, 0xf0, 0xe8, 0x72, 0xe1, 0x3f, 0x1e, 0x5d,
+	0x2e, 0xfc, 0xf5, 0xff, 0x74, 0xf9, 0x03, 0xff, 0x6f, 0x00, 0x6d, 0xc9, 0xf2, 0x45, 0xa4, 0xcd,
+	0x00, 0x00,
 }
 
 func (m *AbstractPodTemplate) Marshal() (dAtA []byte, err error) {
@@ -4676,6 +5316,32 @@ func (m *BufferServiceConfig) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *CPUScalePolicy) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CPUScalePolicy) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CPUScalePolicy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i = encodeVarintGenerated(dAtA, i, uint64(m.TargetUtilization))
+	i--
+	dAtA[i] = 0x8
+	return len(dAtA) - i, nil
+}
+
 func (m *CombinedEdge) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -5038,6 +5704,44 @@ func (m *ContainerTemplate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *CountWindow) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CountWindow) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CountWindow) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Timeout != nil {
+		{
+			size, err := m.Timeout.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	i = encodeVarintGenerated(dAtA, i, uint64(m.Count))
+	i--
+	dAtA[i] = 0x8
+	return len(dAtA) - i, nil
+}
+
 func (m *DaemonTemplate) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -5100,6 +5804,112 @@ func (m *DaemonTemplate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *DeadLetterOutput) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DeadLetterOutput) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DeadLetterOutput) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i -= len(m.ToVertex)
+	copy(dAtA[i:], m.ToVertex)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.ToVertex)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *DedupWindow) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DedupWindow) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DedupWindow) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MaxEntries != nil {
+		i = encodeVarintGenerated(dAtA, i, uint64(*m.MaxEntries))
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size, err := m.Duration.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *EarlyFiring) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EarlyFiring) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EarlyFiring) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Count != nil {
+		i = encodeVarintGenerated(dAtA, i, uint64(*m.Count))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Interval != nil {
+		{
+			size, err := m.Interval.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *Edge) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -5120,6 +5930,25 @@ func (m *Edge) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.HotKeySalting != nil {
+		{
+			size, err := m.HotKeySalting.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.Partitioning != nil {
+		i -= len(*m.Partitioning)
+		copy(dAtA[i:], *m.Partitioning)
+		i = encodeVarintGenerated(dAtA, i, uint64(len(*m.Partitioning)))
+		i--
+		dAtA[i] = 0x2a
+	}
 	if m.OnFull != nil {
 		i -= len(*m.OnFull)
 		copy(dAtA[i:], *m.OnFull)
@@ -5215,6 +6044,11 @@ func (m *ForwardConditions) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	i -= len(m.Expression)
+	copy(dAtA[i:], m.Expression)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Expression)))
+	i--
+	dAtA[i] = 0x12
 	if m.Tags != nil {
 		{
 			size, err := m.Tags.MarshalToSizedBuffer(dAtA[:i])
@@ -5230,6 +6064,58 @@ func (m *ForwardConditions) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *Function) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Function) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Function) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.KWArgs) > 0 {
+		keysForKWArgs := make([]string, 0, len(m.KWArgs))
+		for k := range m.KWArgs {
+			keysForKWArgs = append(keysForKWArgs, string(k))
+		}
+		github_com_gogo_protobuf_sortkeys.Strings(keysForKWArgs)
+		for iNdEx := len(keysForKWArgs) - 1; iNdEx >= 0; iNdEx-- {
+			v := m.KWArgs[string(keysForKWArgs[iNdEx])]
+			baseI := i
+			i -= len(v)
+			copy(dAtA[i:], v)
+			i = encodeVarintGenerated(dAtA, i, uint64(len(v)))
+			i--
+			dAtA[i] = 0x12
+			i -= len(keysForKWArgs[iNdEx])
+			copy(dAtA[i:], keysForKWArgs[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(keysForKWArgs[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintGenerated(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	i -= len(m.Name)
+	copy(dAtA[i:], m.Name)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Name)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *GSSAPI) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -6131,6 +7017,41 @@ func (m *GetVertexPodSpecReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *GlobalWindow) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GlobalWindow) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GlobalWindow) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Trigger != nil {
+		{
+			size, err := m.Trigger.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *GroupBy) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -6151,6 +7072,38 @@ func (m *GroupBy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.LateData != nil {
+		{
+			size, err := m.LateData.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
+	}
+	i--
+	if m.LateFiring {
+		dAtA[i] = 1
+	} else {
+		dAtA[i] = 0
+	}
+	i--
+	dAtA[i] = 0x30
+	if m.EarlyFiring != nil {
+		{
+			size, err := m.EarlyFiring.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
 	if m.Storage != nil {
 		{
 			size, err := m.Storage.MarshalToSizedBuffer(dAtA[:i])
@@ -6216,6 +7169,26 @@ func (m *HTTPSource) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.AckTimeout != nil {
+		{
+			size, err := m.AckTimeout.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	i--
+	if m.DurableAck {
+		dAtA[i] = 1
+	} else {
+		dAtA[i] = 0
+	}
+	i--
+	dAtA[i] = 0x18
 	i--
 	if m.Service {
 		dAtA[i] = 1
@@ -6239,6 +7212,39 @@ func (m *HTTPSource) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *HotKeySalting) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *HotKeySalting) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *HotKeySalting) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ThresholdPercentage != nil {
+		i = encodeVarintGenerated(dAtA, i, uint64(*m.ThresholdPercentage))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.SubPartitions != nil {
+		i = encodeVarintGenerated(dAtA, i, uint64(*m.SubPartitions))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *IdleSource) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -6986,6 +7992,42 @@ func (m *KafkaSource) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *LateDataOutput) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LateDataOutput) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LateDataOutput) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i--
+	if m.Fallback {
+		dAtA[i] = 1
+	} else {
+		dAtA[i] = 0
+	}
+	i--
+	dAtA[i] = 0x10
+	i -= len(m.ToVertex)
+	copy(dAtA[i:], m.ToVertex)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.ToVertex)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *Lifecycle) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -7481,6 +8523,13 @@ func (m *MonoVertexStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	i -= len(m.ActiveScaleSchedule)
+	copy(dAtA[i:], m.ActiveScaleSchedule)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.ActiveScaleSchedule)))
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0x82
 	i -= len(m.UpdateHash)
 	copy(dAtA[i:], m.UpdateHash)
 	i = encodeVarintGenerated(dAtA, i, uint64(len(m.UpdateHash)))
@@ -7821,6 +8870,59 @@ func (m *NoStore) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ObjectStoreStorage) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ObjectStoreStorage) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ObjectStoreStorage) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i--
+	if m.UsePathStyle {
+		dAtA[i] = 1
+	} else {
+		dAtA[i] = 0
+	}
+	i--
+	dAtA[i] = 0x28
+	if m.EndpointURL != nil {
+		i -= len(*m.EndpointURL)
+		copy(dAtA[i:], *m.EndpointURL)
+		i = encodeVarintGenerated(dAtA, i, uint64(len(*m.EndpointURL)))
+		i--
+		dAtA[i] = 0x22
+	}
+	i -= len(m.Region)
+	copy(dAtA[i:], m.Region)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Region)))
+	i--
+	dAtA[i] = 0x1a
+	i -= len(m.Prefix)
+	copy(dAtA[i:], m.Prefix)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Prefix)))
+	i--
+	dAtA[i] = 0x12
+	i -= len(m.Bucket)
+	copy(dAtA[i:], m.Bucket)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Bucket)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *PBQStorage) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -7841,6 +8943,18 @@ func (m *PBQStorage) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.ObjectStore != nil {
+		{
+			size, err := m.ObjectStore.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
 	if m.NoStore != nil {
 		{
 			size, err := m.NoStore.MarshalToSizedBuffer(dAtA[:i])
@@ -8099,6 +9213,18 @@ func (m *PipelineSpec) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.UpgradeStrategy != nil {
+		{
+			size, err := m.UpgradeStrategy.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x52
+	}
 	if m.InterStepBuffer != nil {
 		{
 			size, err := m.InterStepBuffer.MarshalToSizedBuffer(dAtA[:i])
@@ -8225,6 +9351,18 @@ func (m *PipelineStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Upgrade != nil {
+		{
+			size, err := m.Upgrade.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x6a
+	}
 	i--
 	if m.DrainedOnPause {
 		dAtA[i] = 1
@@ -8299,6 +9437,102 @@ func (m *PipelineStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *PipelineUpgradeStatus) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PipelineUpgradeStatus) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PipelineUpgradeStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.LastTransitionTime.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	{
+		size, err := m.StartedAt.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	i -= len(m.Message)
+	copy(dAtA[i:], m.Message)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Message)))
+	i--
+	dAtA[i] = 0x22
+	i -= len(m.ShadowPipeline)
+	copy(dAtA[i:], m.ShadowPipeline)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.ShadowPipeline)))
+	i--
+	dAtA[i] = 0x1a
+	i -= len(m.TargetHash)
+	copy(dAtA[i:], m.TargetHash)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.TargetHash)))
+	i--
+	dAtA[i] = 0x12
+	i -= len(m.Phase)
+	copy(dAtA[i:], m.Phase)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Phase)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *PipelineUpgradeStrategy) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PipelineUpgradeStrategy) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PipelineUpgradeStrategy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ProgressDeadlineSeconds != nil {
+		i = encodeVarintGenerated(dAtA, i, uint64(*m.ProgressDeadlineSeconds))
+		i--
+		dAtA[i] = 0x10
+	}
+	i -= len(m.Type)
+	copy(dAtA[i:], m.Type)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Type)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *Ports) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -8332,6 +9566,39 @@ func (m *Ports) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *PredictiveScalePolicy) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PredictiveScalePolicy) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PredictiveScalePolicy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.PeriodSeconds != nil {
+		i = encodeVarintGenerated(dAtA, i, uint64(*m.PeriodSeconds))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.LookaheadSeconds != nil {
+		i = encodeVarintGenerated(dAtA, i, uint64(*m.LookaheadSeconds))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *Probe) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -9033,6 +10300,40 @@ func (m *Scale) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Schedules) > 0 {
+		for iNdEx := len(m.Schedules) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Schedules[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x7a
+		}
+	}
+	i--
+	if m.DryRun {
+		dAtA[i] = 1
+	} else {
+		dAtA[i] = 0
+	}
+	i--
+	dAtA[i] = 0x70
+	if m.Policy != nil {
+		{
+			size, err := m.Policy.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x6a
+	}
 	if m.ReplicasPerScaleDown != nil {
 		i = encodeVarintGenerated(dAtA, i, uint64(*m.ReplicasPerScaleDown))
 		i--
@@ -9099,6 +10400,167 @@ func (m *Scale) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ScalePolicy) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ScalePolicy) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ScalePolicy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Predictive != nil {
+		{
+			size, err := m.Predictive.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.CPU != nil {
+		{
+			size, err := m.CPU.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.TargetLag != nil {
+		{
+			size, err := m.TargetLag.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ScaleSchedule) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ScaleSchedule) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ScaleSchedule) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Max != nil {
+		i = encodeVarintGenerated(dAtA, i, uint64(*m.Max))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.Min != nil {
+		i = encodeVarintGenerated(dAtA, i, uint64(*m.Min))
+		i--
+		dAtA[i] = 0x28
+	}
+	i -= len(m.TimeZone)
+	copy(dAtA[i:], m.TimeZone)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.TimeZone)))
+	i--
+	dAtA[i] = 0x22
+	{
+		size, err := m.Duration.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	i -= len(m.Start)
+	copy(dAtA[i:], m.Start)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Start)))
+	i--
+	dAtA[i] = 0x12
+	i -= len(m.Name)
+	copy(dAtA[i:], m.Name)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Name)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *ScalingDecision) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ScalingDecision) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ScalingDecision) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.DecidedAt.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	i = encodeVarintGenerated(dAtA, i, uint64(m.DesiredReplicas))
+	i--
+	dAtA[i] = 0x18
+	i = encodeVarintGenerated(dAtA, i, uint64(m.Replicas))
+	i--
+	dAtA[i] = 0x10
+	i -= len(m.Policy)
+	copy(dAtA[i:], m.Policy)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Policy)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *ServeSink) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -9540,6 +11002,16 @@ func (m *SideInput) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.PinnedVersion != nil {
+		i = encodeVarintGenerated(dAtA, i, uint64(*m.PinnedVersion))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.RetainedVersions != nil {
+		i = encodeVarintGenerated(dAtA, i, uint64(*m.RetainedVersions))
+		i--
+		dAtA[i] = 0x28
+	}
 	if m.Trigger != nil {
 		{
 			size, err := m.Trigger.MarshalToSizedBuffer(dAtA[:i])
@@ -9606,6 +11078,30 @@ func (m *SideInputTrigger) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Auth != nil {
+		{
+			size, err := m.Auth.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Watch != nil {
+		{
+			size, err := m.Watch.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
 	if m.Timezone != nil {
 		i -= len(*m.Timezone)
 		copy(dAtA[i:], *m.Timezone)
@@ -9621,6 +11117,47 @@ func (m *SideInputTrigger) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *SideInputWatch) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SideInputWatch) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SideInputWatch) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Secrets) > 0 {
+		for iNdEx := len(m.Secrets) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Secrets[iNdEx])
+			copy(dAtA[i:], m.Secrets[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(m.Secrets[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.ConfigMaps) > 0 {
+		for iNdEx := len(m.ConfigMaps) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.ConfigMaps[iNdEx])
+			copy(dAtA[i:], m.ConfigMaps[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(m.ConfigMaps[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *SideInputsManagerTemplate) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -9808,6 +11345,18 @@ func (m *Source) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Dedup != nil {
+		{
+			size, err := m.Dedup.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x5a
+	}
 	if m.Sqs != nil {
 		{
 			size, err := m.Sqs.MarshalToSizedBuffer(dAtA[:i])
@@ -9951,6 +11500,13 @@ func (m *SqsSink) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.EndpointURL != nil {
+		i -= len(*m.EndpointURL)
+		copy(dAtA[i:], *m.EndpointURL)
+		i = encodeVarintGenerated(dAtA, i, uint64(len(*m.EndpointURL)))
+		i--
+		dAtA[i] = 0x22
+	}
 	i -= len(m.QueueOwnerAWSAccountID)
 	copy(dAtA[i:], m.QueueOwnerAWSAccountID)
 	i = encodeVarintGenerated(dAtA, i, uint64(len(m.QueueOwnerAWSAccountID)))
@@ -10190,6 +11746,32 @@ func (m *TagConditions) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *TargetLagScalePolicy) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TargetLagScalePolicy) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TargetLagScalePolicy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i = encodeVarintGenerated(dAtA, i, uint64(m.TargetLagSeconds))
+	i--
+	dAtA[i] = 0x8
+	return len(dAtA) - i, nil
+}
+
 func (m *Templates) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -10281,6 +11863,30 @@ func (m *UDF) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.DeadLetter != nil {
+		{
+			size, err := m.DeadLetter.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.RetryStrategy != nil {
+		{
+			size, err := m.RetryStrategy.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
 	if m.GroupBy != nil {
 		{
 			size, err := m.GroupBy.MarshalToSizedBuffer(dAtA[:i])
@@ -10293,6 +11899,18 @@ func (m *UDF) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x1a
 	}
+	if m.Builtin != nil {
+		{
+			size, err := m.Builtin.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
 	if m.Container != nil {
 		{
 			size, err := m.Container.MarshalToSizedBuffer(dAtA[:i])
@@ -10802,6 +12420,32 @@ func (m *VertexStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	i -= len(m.ForecastUnavailableReason)
+	copy(dAtA[i:], m.ForecastUnavailableReason)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.ForecastUnavailableReason)))
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0x8a
+	i -= len(m.ActiveScaleSchedule)
+	copy(dAtA[i:], m.ActiveScaleSchedule)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.ActiveScaleSchedule)))
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0x82
+	if m.LastScalingDecision != nil {
+		{
+			size, err := m.LastScalingDecision.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x7a
+	}
 	i -= len(m.UpdateHash)
 	copy(dAtA[i:], m.UpdateHash)
 	i = encodeVarintGenerated(dAtA, i, uint64(len(m.UpdateHash)))
@@ -11005,6 +12649,30 @@ func (m *Window) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Global != nil {
+		{
+			size, err := m.Global.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.Count != nil {
+		{
+			size, err := m.Count.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
 	if m.Accumulator != nil {
 		{
 			size, err := m.Accumulator.MarshalToSizedBuffer(dAtA[:i])
@@ -11056,6 +12724,46 @@ func (m *Window) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *WindowTrigger) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *WindowTrigger) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *WindowTrigger) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Interval != nil {
+		{
+			size, err := m.Interval.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Count != nil {
+		i = encodeVarintGenerated(dAtA, i, uint64(*m.Count))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintGenerated(dAtA []byte, offset int, v uint64) int {
 	offset -= sovGenerated(v)
 	base := offset
@@ -11338,6 +13046,16 @@ func (m *BufferServiceConfig) Size() (n int) {
 	return n
 }
 
+func (m *CPUScalePolicy) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	n += 1 + sovGenerated(uint64(m.TargetUtilization))
+	return n
+}
+
 func (m *CombinedEdge) Size() (n int) {
 	if m == nil {
 		return 0
@@ -11480,6 +13198,20 @@ func (m *ContainerTemplate) Size() (n int) {
 	return n
 }
 
+func (m *CountWindow) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	n += 1 + sovGenerated(uint64(m.Count))
+	if m.Timeout != nil {
+		l = m.Timeout.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	return n
+}
+
 func (m *DaemonTemplate) Size() (n int) {
 	if m == nil {
 		return 0
@@ -11502,6 +13234,47 @@ func (m *DaemonTemplate) Size() (n int) {
 	return n
 }
 
+func (m *DeadLetterOutput) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ToVertex)
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
+func (m *DedupWindow) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Duration.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	if m.MaxEntries != nil {
+		n += 1 + sovGenerated(uint64(*m.MaxEntries))
+	}
+	return n
+}
+
+func (m *EarlyFiring) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Interval != nil {
+		l = m.Interval.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.Count != nil {
+		n += 1 + sovGenerated(uint64(*m.Count))
+	}
+	return n
+}
+
 func (m *Edge) Size() (n int) {
 	if m == nil {
 		return 0
@@ -11520,6 +13293,14 @@ func (m *Edge) Size() (n int) {
 		l = len(*m.OnFull)
 		n += 1 + l + sovGenerated(uint64(l))
 	}
+	if m.Partitioning != nil {
+		l = len(*m.Partitioning)
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.HotKeySalting != nil {
+		l = m.HotKeySalting.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
 	return n
 }
 
@@ -11547,6 +13328,27 @@ func (m *ForwardConditions) Size() (n int) {
 		l = m.Tags.Size()
 		n += 1 + l + sovGenerated(uint64(l))
 	}
+	l = len(m.Expression)
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
+func (m *Function) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.KWArgs) > 0 {
+		for k, v := range m.KWArgs {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovGenerated(uint64(len(k))) + 1 + len(v) + sovGenerated(uint64(len(v)))
+			n += mapEntrySize + 1 + sovGenerated(uint64(mapEntrySize))
+		}
+	}
 	return n
 }
 
@@ -11880,6 +13682,19 @@ func (m *GetVertexPodSpecReq) Size() (n int) {
 	return n
 }
 
+func (m *GlobalWindow) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Trigger != nil {
+		l = m.Trigger.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	return n
+}
+
 func (m *GroupBy) Size() (n int) {
 	if m == nil {
 		return 0
@@ -11897,6 +13712,15 @@ func (m *GroupBy) Size() (n int) {
 		l = m.Storage.Size()
 		n += 1 + l + sovGenerated(uint64(l))
 	}
+	if m.EarlyFiring != nil {
+		l = m.EarlyFiring.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	n += 2
+	if m.LateData != nil {
+		l = m.LateData.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
 	return n
 }
 
@@ -11911,6 +13735,26 @@ func (m *HTTPSource) Size() (n int) {
 		n += 1 + l + sovGenerated(uint64(l))
 	}
 	n += 2
+	n += 2
+	if m.AckTimeout != nil {
+		l = m.AckTimeout.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	return n
+}
+
+func (m *HotKeySalting) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SubPartitions != nil {
+		n += 1 + sovGenerated(uint64(*m.SubPartitions))
+	}
+	if m.ThresholdPercentage != nil {
+		n += 1 + sovGenerated(uint64(*m.ThresholdPercentage))
+	}
 	return n
 }
 
@@ -12184,6 +14028,18 @@ func (m *KafkaSource) Size() (n int) {
 	return n
 }
 
+func (m *LateDataOutput) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ToVertex)
+	n += 1 + l + sovGenerated(uint64(l))
+	n += 2
+	return n
+}
+
 func (m *Lifecycle) Size() (n int) {
 	if m == nil {
 		return 0
@@ -12385,6 +14241,8 @@ func (m *MonoVertexStatus) Size() (n int) {
 	n += 1 + l + sovGenerated(uint64(l))
 	l = len(m.UpdateHash)
 	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.ActiveScaleSchedule)
+	n += 2 + l + sovGenerated(uint64(l))
 	return n
 }
 
@@ -12481,6 +14339,26 @@ func (m *NoStore) Size() (n int) {
 	return n
 }
 
+func (m *ObjectStoreStorage) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Bucket)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Prefix)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Region)
+	n += 1 + l + sovGenerated(uint64(l))
+	if m.EndpointURL != nil {
+		l = len(*m.EndpointURL)
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	n += 2
+	return n
+}
+
 func (m *PBQStorage) Size() (n int) {
 	if m == nil {
 		return 0
@@ -12499,6 +14377,10 @@ func (m *PBQStorage) Size() (n int) {
 		l = m.NoStore.Size()
 		n += 1 + l + sovGenerated(uint64(l))
 	}
+	if m.ObjectStore != nil {
+		l = m.ObjectStore.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
 	return n
 }
 
@@ -12619,6 +14501,10 @@ func (m *PipelineSpec) Size() (n int) {
 		l = m.InterStepBuffer.Size()
 		n += 1 + l + sovGenerated(uint64(l))
 	}
+	if m.UpgradeStrategy != nil {
+		l = m.UpgradeStrategy.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
 	return n
 }
 
@@ -12656,6 +14542,45 @@ func (m *PipelineStatus) Size() (n int) {
 	}
 	n += 1 + sovGenerated(uint64(m.ObservedGeneration))
 	n += 2
+	if m.Upgrade != nil {
+		l = m.Upgrade.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	return n
+}
+
+func (m *PipelineUpgradeStatus) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Phase)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.TargetHash)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.ShadowPipeline)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Message)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = m.StartedAt.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	l = m.LastTransitionTime.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
+func (m *PipelineUpgradeStrategy) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Type)
+	n += 1 + l + sovGenerated(uint64(l))
+	if m.ProgressDeadlineSeconds != nil {
+		n += 1 + sovGenerated(uint64(*m.ProgressDeadlineSeconds))
+	}
 	return n
 }
 
@@ -12674,6 +14599,21 @@ func (m *Ports) Size() (n int) {
 	return n
 }
 
+func (m *PredictiveScalePolicy) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.LookaheadSeconds != nil {
+		n += 1 + sovGenerated(uint64(*m.LookaheadSeconds))
+	}
+	if m.PeriodSeconds != nil {
+		n += 1 + sovGenerated(uint64(*m.PeriodSeconds))
+	}
+	return n
+}
+
 func (m *Probe) Size() (n int) {
 	if m == nil {
 		return 0
@@ -12972,6 +14912,76 @@ func (m *Scale) Size() (n int) {
 	if m.ReplicasPerScaleDown != nil {
 		n += 1 + sovGenerated(uint64(*m.ReplicasPerScaleDown))
 	}
+	if m.Policy != nil {
+		l = m.Policy.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	n += 2
+	if len(m.Schedules) > 0 {
+		for _, e := range m.Schedules {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ScalePolicy) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TargetLag != nil {
+		l = m.TargetLag.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.CPU != nil {
+		l = m.CPU.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.Predictive != nil {
+		l = m.Predictive.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	return n
+}
+
+func (m *ScaleSchedule) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Start)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = m.Duration.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.TimeZone)
+	n += 1 + l + sovGenerated(uint64(l))
+	if m.Min != nil {
+		n += 1 + sovGenerated(uint64(*m.Min))
+	}
+	if m.Max != nil {
+		n += 1 + sovGenerated(uint64(*m.Max))
+	}
+	return n
+}
+
+func (m *ScalingDecision) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Policy)
+	n += 1 + l + sovGenerated(uint64(l))
+	n += 1 + sovGenerated(uint64(m.Replicas))
+	n += 1 + sovGenerated(uint64(m.DesiredReplicas))
+	l = m.DecidedAt.Size()
+	n += 1 + l + sovGenerated(uint64(l))
 	return n
 }
 
@@ -13142,6 +15152,12 @@ func (m *SideInput) Size() (n int) {
 		l = m.Trigger.Size()
 		n += 1 + l + sovGenerated(uint64(l))
 	}
+	if m.RetainedVersions != nil {
+		n += 1 + sovGenerated(uint64(*m.RetainedVersions))
+	}
+	if m.PinnedVersion != nil {
+		n += 1 + sovGenerated(uint64(*m.PinnedVersion))
+	}
 	return n
 }
 
@@ -13157,6 +15173,35 @@ func (m *SideInputTrigger) Size() (n int) {
 		l = len(*m.Timezone)
 		n += 1 + l + sovGenerated(uint64(l))
 	}
+	if m.Watch != nil {
+		l = m.Watch.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.Auth != nil {
+		l = m.Auth.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	return n
+}
+
+func (m *SideInputWatch) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.ConfigMaps) > 0 {
+		for _, s := range m.ConfigMaps {
+			l = len(s)
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	if len(m.Secrets) > 0 {
+		for _, s := range m.Secrets {
+			l = len(s)
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -13260,6 +15305,10 @@ func (m *Source) Size() (n int) {
 		l = m.Sqs.Size()
 		n += 1 + l + sovGenerated(uint64(l))
 	}
+	if m.Dedup != nil {
+		l = m.Dedup.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
 	return n
 }
 
@@ -13275,6 +15324,10 @@ func (m *SqsSink) Size() (n int) {
 	n += 1 + l + sovGenerated(uint64(l))
 	l = len(m.QueueOwnerAWSAccountID)
 	n += 1 + l + sovGenerated(uint64(l))
+	if m.EndpointURL != nil {
+		l = len(*m.EndpointURL)
+		n += 1 + l + sovGenerated(uint64(l))
+	}
 	return n
 }
 
@@ -13374,6 +15427,16 @@ func (m *TagConditions) Size() (n int) {
 	return n
 }
 
+func (m *TargetLagScalePolicy) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	n += 1 + sovGenerated(uint64(m.TargetLagSeconds))
+	return n
+}
+
 func (m *Templates) Size() (n int) {
 	if m == nil {
 		return 0
@@ -13409,10 +15472,22 @@ func (m *UDF) Size() (n int) {
 		l = m.Container.Size()
 		n += 1 + l + sovGenerated(uint64(l))
 	}
+	if m.Builtin != nil {
+		l = m.Builtin.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
 	if m.GroupBy != nil {
 		l = m.GroupBy.Size()
 		n += 1 + l + sovGenerated(uint64(l))
 	}
+	if m.RetryStrategy != nil {
+		l = m.RetryStrategy.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.DeadLetter != nil {
+		l = m.DeadLetter.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
 	return n
 }
 
@@ -13617,6 +15692,14 @@ func (m *VertexStatus) Size() (n int) {
 	n += 1 + l + sovGenerated(uint64(l))
 	l = len(m.UpdateHash)
 	n += 1 + l + sovGenerated(uint64(l))
+	if m.LastScalingDecision != nil {
+		l = m.LastScalingDecision.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	l = len(m.ActiveScaleSchedule)
+	n += 2 + l + sovGenerated(uint64(l))
+	l = len(m.ForecastUnavailableReason)
+	n += 2 + l + sovGenerated(uint64(l))
 	return n
 }
 
@@ -13679,6 +15762,30 @@ func (m *Window) Size() (n int) {
 		l = m.Accumulator.Size()
 		n += 1 + l + sovGenerated(uint64(l))
 	}
+	if m.Count != nil {
+		l = m.Count.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.Global != nil {
+		l = m.Global.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	return n
+}
+
+func (m *WindowTrigger) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Count != nil {
+		n += 1 + sovGenerated(uint64(*m.Count))
+	}
+	if m.Interval != nil {
+		l = m.Interval.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
 	return n
 }
 
@@ -13857,6 +15964,16 @@ func (this *BufferServiceConfig) String() string {
 	}, "")
 	return s
 }
+func (this *CPUScalePolicy) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&CPUScalePolicy{`,
+		`TargetUtilization:` + fmt.Sprintf("%v", this.TargetUtilization) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *CombinedEdge) String() string {
 	if this == nil {
 		return "nil"
@@ -13950,6 +16067,17 @@ func (this *ContainerTemplate) String() string {
 	}, "")
 	return s
 }
+func (this *CountWindow) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&CountWindow{`,
+		`Count:` + fmt.Sprintf("%v", this.Count) + `,`,
+		`Timeout:` + strings.Replace(fmt.Sprintf("%v", this.Timeout), "Duration", "v11.Duration", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *DaemonTemplate) String() string {
 	if this == nil {
 		return "nil"
@@ -13963,6 +16091,38 @@ func (this *DaemonTemplate) String() string {
 	}, "")
 	return s
 }
+func (this *DeadLetterOutput) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&DeadLetterOutput{`,
+		`ToVertex:` + fmt.Sprintf("%v", this.ToVertex) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *DedupWindow) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&DedupWindow{`,
+		`Duration:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.Duration), "Duration", "v11.Duration", 1), `&`, ``, 1) + `,`,
+		`MaxEntries:` + valueToStringGenerated(this.MaxEntries) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *EarlyFiring) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&EarlyFiring{`,
+		`Interval:` + strings.Replace(fmt.Sprintf("%v", this.Interval), "Duration", "v11.Duration", 1) + `,`,
+		`Count:` + valueToStringGenerated(this.Count) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *Edge) String() string {
 	if this == nil {
 		return "nil"
@@ -13972,6 +16132,8 @@ func (this *Edge) String() string {
 		`To:` + fmt.Sprintf("%v", this.To) + `,`,
 		`Conditions:` + strings.Replace(this.Conditions.String(), "ForwardConditions", "ForwardConditions", 1) + `,`,
 		`OnFull:` + valueToStringGenerated(this.OnFull) + `,`,
+		`Partitioning:` + valueToStringGenerated(this.Partitioning) + `,`,
+		`HotKeySalting:` + strings.Replace(this.HotKeySalting.String(), "HotKeySalting", "HotKeySalting", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -13993,6 +16155,28 @@ func (this *ForwardConditions) String() string {
 	}
 	s := strings.Join([]string{`&ForwardConditions{`,
 		`Tags:` + strings.Replace(this.Tags.String(), "TagConditions", "TagConditions", 1) + `,`,
+		`Expression:` + fmt.Sprintf("%v", this.Expression) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *Function) String() string {
+	if this == nil {
+		return "nil"
+	}
+	keysForKWArgs := make([]string, 0, len(this.KWArgs))
+	for k := range this.KWArgs {
+		keysForKWArgs = append(keysForKWArgs, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForKWArgs)
+	mapStringForKWArgs := "map[string]string{"
+	for _, k := range keysForKWArgs {
+		mapStringForKWArgs += fmt.Sprintf("%v: %v,", k, this.KWArgs[k])
+	}
+	mapStringForKWArgs += "}"
+	s := strings.Join([]string{`&Function{`,
+		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
+		`KWArgs:` + mapStringForKWArgs + `,`,
 		`}`,
 	}, "")
 	return s
@@ -14259,6 +16443,16 @@ func (this *GetVertexPodSpecReq) String() string {
 	}, "")
 	return s
 }
+func (this *GlobalWindow) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&GlobalWindow{`,
+		`Trigger:` + strings.Replace(this.Trigger.String(), "WindowTrigger", "WindowTrigger", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *GroupBy) String() string {
 	if this == nil {
 		return "nil"
@@ -14268,6 +16462,9 @@ func (this *GroupBy) String() string {
 		`Keyed:` + fmt.Sprintf("%v", this.Keyed) + `,`,
 		`AllowedLateness:` + strings.Replace(fmt.Sprintf("%v", this.AllowedLateness), "Duration", "v11.Duration", 1) + `,`,
 		`Storage:` + strings.Replace(this.Storage.String(), "PBQStorage", "PBQStorage", 1) + `,`,
+		`EarlyFiring:` + strings.Replace(this.EarlyFiring.String(), "EarlyFiring", "EarlyFiring", 1) + `,`,
+		`LateFiring:` + fmt.Sprintf("%v", this.LateFiring) + `,`,
+		`LateData:` + strings.Replace(this.LateData.String(), "LateDataOutput", "LateDataOutput", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -14279,6 +16476,19 @@ func (this *HTTPSource) String() string {
 	s := strings.Join([]string{`&HTTPSource{`,
 		`Auth:` + strings.Replace(this.Auth.String(), "Authorization", "Authorization", 1) + `,`,
 		`Service:` + fmt.Sprintf("%v", this.Service) + `,`,
+		`DurableAck:` + fmt.Sprintf("%v", this.DurableAck) + `,`,
+		`AckTimeout:` + strings.Replace(fmt.Sprintf("%v", this.AckTimeout), "Duration", "v11.Duration", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *HotKeySalting) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&HotKeySalting{`,
+		`SubPartitions:` + valueToStringGenerated(this.SubPartitions) + `,`,
+		`ThresholdPercentage:` + valueToStringGenerated(this.ThresholdPercentage) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -14450,6 +16660,17 @@ func (this *KafkaSource) String() string {
 	}, "")
 	return s
 }
+func (this *LateDataOutput) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&LateDataOutput{`,
+		`ToVertex:` + fmt.Sprintf("%v", this.ToVertex) + `,`,
+		`Fallback:` + fmt.Sprintf("%v", this.Fallback) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *Lifecycle) String() string {
 	if this == nil {
 		return "nil"
@@ -14609,6 +16830,7 @@ func (this *MonoVertexStatus) String() string {
 		`UpdatedReadyReplicas:` + fmt.Sprintf("%v", this.UpdatedReadyReplicas) + `,`,
 		`CurrentHash:` + fmt.Sprintf("%v", this.CurrentHash) + `,`,
 		`UpdateHash:` + fmt.Sprintf("%v", this.UpdateHash) + `,`,
+		`ActiveScaleSchedule:` + fmt.Sprintf("%v", this.ActiveScaleSchedule) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -14666,6 +16888,20 @@ func (this *NoStore) String() string {
 	}, "")
 	return s
 }
+func (this *ObjectStoreStorage) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ObjectStoreStorage{`,
+		`Bucket:` + fmt.Sprintf("%v", this.Bucket) + `,`,
+		`Prefix:` + fmt.Sprintf("%v", this.Prefix) + `,`,
+		`Region:` + fmt.Sprintf("%v", this.Region) + `,`,
+		`EndpointURL:` + valueToStringGenerated(this.EndpointURL) + `,`,
+		`UsePathStyle:` + fmt.Sprintf("%v", this.UsePathStyle) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *PBQStorage) String() string {
 	if this == nil {
 		return "nil"
@@ -14674,6 +16910,7 @@ func (this *PBQStorage) String() string {
 		`PersistentVolumeClaim:` + strings.Replace(this.PersistentVolumeClaim.String(), "PersistenceStrategy", "PersistenceStrategy", 1) + `,`,
 		`EmptyDir:` + strings.Replace(fmt.Sprintf("%v", this.EmptyDir), "EmptyDirVolumeSource", "v1.EmptyDirVolumeSource", 1) + `,`,
 		`NoStore:` + strings.Replace(this.NoStore.String(), "NoStore", "NoStore", 1) + `,`,
+		`ObjectStore:` + strings.Replace(this.ObjectStore.String(), "ObjectStoreStorage", "ObjectStoreStorage", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -14760,6 +16997,7 @@ func (this *PipelineSpec) String() string {
 		`Templates:` + strings.Replace(this.Templates.String(), "Templates", "Templates", 1) + `,`,
 		`SideInputs:` + repeatedStringForSideInputs + `,`,
 		`InterStepBuffer:` + strings.Replace(this.InterStepBuffer.String(), "InterStepBuffer", "InterStepBuffer", 1) + `,`,
+		`UpgradeStrategy:` + strings.Replace(this.UpgradeStrategy.String(), "PipelineUpgradeStrategy", "PipelineUpgradeStrategy", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -14781,6 +17019,33 @@ func (this *PipelineStatus) String() string {
 		`ReduceUDFCount:` + valueToStringGenerated(this.ReduceUDFCount) + `,`,
 		`ObservedGeneration:` + fmt.Sprintf("%v", this.ObservedGeneration) + `,`,
 		`DrainedOnPause:` + fmt.Sprintf("%v", this.DrainedOnPause) + `,`,
+		`Upgrade:` + strings.Replace(this.Upgrade.String(), "PipelineUpgradeStatus", "PipelineUpgradeStatus", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *PipelineUpgradeStatus) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&PipelineUpgradeStatus{`,
+		`Phase:` + fmt.Sprintf("%v", this.Phase) + `,`,
+		`TargetHash:` + fmt.Sprintf("%v", this.TargetHash) + `,`,
+		`ShadowPipeline:` + fmt.Sprintf("%v", this.ShadowPipeline) + `,`,
+		`Message:` + fmt.Sprintf("%v", this.Message) + `,`,
+		`StartedAt:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.StartedAt), "Time", "v11.Time", 1), `&`, ``, 1) + `,`,
+		`LastTransitionTime:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.LastTransitionTime), "Time", "v11.Time", 1), `&`, ``, 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *PipelineUpgradeStrategy) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&PipelineUpgradeStrategy{`,
+		`Type:` + fmt.Sprintf("%v", this.Type) + `,`,
+		`ProgressDeadlineSeconds:` + valueToStringGenerated(this.ProgressDeadlineSeconds) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -14796,6 +17061,17 @@ func (this *Ports) String() string {
 	}, "")
 	return s
 }
+func (this *PredictiveScalePolicy) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&PredictiveScalePolicy{`,
+		`LookaheadSeconds:` + valueToStringGenerated(this.LookaheadSeconds) + `,`,
+		`PeriodSeconds:` + valueToStringGenerated(this.PeriodSeconds) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *Probe) String() string {
 	if this == nil {
 		return "nil"
@@ -14963,6 +17239,11 @@ func (this *Scale) String() string {
 	if this == nil {
 		return "nil"
 	}
+	repeatedStringForSchedules := "[]ScaleSchedule{"
+	for _, f := range this.Schedules {
+		repeatedStringForSchedules += strings.Replace(strings.Replace(f.String(), "ScaleSchedule", "ScaleSchedule", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForSchedules += "}"
 	s := strings.Join([]string{`&Scale{`,
 		`Disabled:` + fmt.Sprintf("%v", this.Disabled) + `,`,
 		`Min:` + valueToStringGenerated(this.Min) + `,`,
@@ -14976,6 +17257,49 @@ func (this *Scale) String() string {
 		`ScaleDownCooldownSeconds:` + valueToStringGenerated(this.ScaleDownCooldownSeconds) + `,`,
 		`ReplicasPerScaleUp:` + valueToStringGenerated(this.ReplicasPerScaleUp) + `,`,
 		`ReplicasPerScaleDown:` + valueToStringGenerated(this.ReplicasPerScaleDown) + `,`,
+		`Policy:` + strings.Replace(this.Policy.String(), "ScalePolicy", "ScalePolicy", 1) + `,`,
+		`DryRun:` + fmt.Sprintf("%v", this.DryRun) + `,`,
+		`Schedules:` + repeatedStringForSchedules + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ScalePolicy) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ScalePolicy{`,
+		`TargetLag:` + strings.Replace(this.TargetLag.String(), "TargetLagScalePolicy", "TargetLagScalePolicy", 1) + `,`,
+		`CPU:` + strings.Replace(this.CPU.String(), "CPUScalePolicy", "CPUScalePolicy", 1) + `,`,
+		`Predictive:` + strings.Replace(this.Predictive.String(), "PredictiveScalePolicy", "PredictiveScalePolicy", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ScaleSchedule) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ScaleSchedule{`,
+		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
+		`Start:` + fmt.Sprintf("%v", this.Start) + `,`,
+		`Duration:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.Duration), "Duration", "v11.Duration", 1), `&`, ``, 1) + `,`,
+		`TimeZone:` + fmt.Sprintf("%v", this.TimeZone) + `,`,
+		`Min:` + valueToStringGenerated(this.Min) + `,`,
+		`Max:` + valueToStringGenerated(this.Max) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ScalingDecision) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ScalingDecision{`,
+		`Policy:` + fmt.Sprintf("%v", this.Policy) + `,`,
+		`Replicas:` + fmt.Sprintf("%v", this.Replicas) + `,`,
+		`DesiredReplicas:` + fmt.Sprintf("%v", this.DesiredReplicas) + `,`,
+		`DecidedAt:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.DecidedAt), "Time", "v11.Time", 1), `&`, ``, 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -15103,6 +17427,8 @@ func (this *SideInput) String() string {
 		`Container:` + strings.Replace(this.Container.String(), "Container", "Container", 1) + `,`,
 		`Volumes:` + repeatedStringForVolumes + `,`,
 		`Trigger:` + strings.Replace(this.Trigger.String(), "SideInputTrigger", "SideInputTrigger", 1) + `,`,
+		`RetainedVersions:` + valueToStringGenerated(this.RetainedVersions) + `,`,
+		`PinnedVersion:` + valueToStringGenerated(this.PinnedVersion) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -15114,6 +17440,19 @@ func (this *SideInputTrigger) String() string {
 	s := strings.Join([]string{`&SideInputTrigger{`,
 		`Schedule:` + fmt.Sprintf("%v", this.Schedule) + `,`,
 		`Timezone:` + valueToStringGenerated(this.Timezone) + `,`,
+		`Watch:` + strings.Replace(this.Watch.String(), "SideInputWatch", "SideInputWatch", 1) + `,`,
+		`Auth:` + strings.Replace(this.Auth.String(), "Authorization", "Authorization", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *SideInputWatch) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&SideInputWatch{`,
+		`ConfigMaps:` + fmt.Sprintf("%v", this.ConfigMaps) + `,`,
+		`Secrets:` + fmt.Sprintf("%v", this.Secrets) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -15169,6 +17508,7 @@ func (this *Source) String() string {
 		`Serving:` + strings.Replace(this.Serving.String(), "ServingSource", "ServingSource", 1) + `,`,
 		`Pulsar:` + strings.Replace(this.Pulsar.String(), "PulsarSource", "PulsarSource", 1) + `,`,
 		`Sqs:` + strings.Replace(this.Sqs.String(), "SqsSource", "SqsSource", 1) + `,`,
+		`Dedup:` + strings.Replace(this.Dedup.String(), "DedupWindow", "DedupWindow", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -15181,6 +17521,7 @@ func (this *SqsSink) String() string {
 		`AWSRegion:` + fmt.Sprintf("%v", this.AWSRegion) + `,`,
 		`QueueName:` + fmt.Sprintf("%v", this.QueueName) + `,`,
 		`QueueOwnerAWSAccountID:` + fmt.Sprintf("%v", this.QueueOwnerAWSAccountID) + `,`,
+		`EndpointURL:` + valueToStringGenerated(this.EndpointURL) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -15242,6 +17583,16 @@ func (this *TagConditions) String() string {
 	}, "")
 	return s
 }
+func (this *TargetLagScalePolicy) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&TargetLagScalePolicy{`,
+		`TargetLagSeconds:` + fmt.Sprintf("%v", this.TargetLagSeconds) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *Templates) String() string {
 	if this == nil {
 		return "nil"
@@ -15261,7 +17612,10 @@ func (this *UDF) String() string {
 	}
 	s := strings.Join([]string{`&UDF{`,
 		`Container:` + strings.Replace(this.Container.String(), "Container", "Container", 1) + `,`,
+		`Builtin:` + strings.Replace(this.Builtin.String(), "Function", "Function", 1) + `,`,
 		`GroupBy:` + strings.Replace(this.GroupBy.String(), "GroupBy", "GroupBy", 1) + `,`,
+		`RetryStrategy:` + strings.Replace(this.RetryStrategy.String(), "RetryStrategy", "RetryStrategy", 1) + `,`,
+		`DeadLetter:` + strings.Replace(this.DeadLetter.String(), "DeadLetterOutput", "DeadLetterOutput", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -15417,6 +17771,9 @@ func (this *VertexStatus) String() string {
 		`UpdatedReadyReplicas:` + fmt.Sprintf("%v", this.UpdatedReadyReplicas) + `,`,
 		`CurrentHash:` + fmt.Sprintf("%v", this.CurrentHash) + `,`,
 		`UpdateHash:` + fmt.Sprintf("%v", this.UpdateHash) + `,`,
+		`LastScalingDecision:` + strings.Replace(this.LastScalingDecision.String(), "ScalingDecision", "ScalingDecision", 1) + `,`,
+		`ActiveScaleSchedule:` + fmt.Sprintf("%v", this.ActiveScaleSchedule) + `,`,
+		`ForecastUnavailableReason:` + fmt.Sprintf("%v", this.ForecastUnavailableReason) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -15454,6 +17811,19 @@ func (this *Window) String() string {
 		`Sliding:` + strings.Replace(this.Sliding.String(), "SlidingWindow", "SlidingWindow", 1) + `,`,
 		`Session:` + strings.Replace(this.Session.String(), "SessionWindow", "SessionWindow", 1) + `,`,
 		`Accumulator:` + strings.Replace(this.Accumulator.String(), "AccumulatorWindow", "AccumulatorWindow", 1) + `,`,
+		`Count:` + strings.Replace(this.Count.String(), "CountWindow", "CountWindow", 1) + `,`,
+		`Global:` + strings.Replace(this.Global.String(), "GlobalWindow", "GlobalWindow", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *WindowTrigger) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&WindowTrigger{`,
+		`Count:` + valueToStringGenerated(this.Count) + `,`,
+		`Interval:` + strings.Replace(fmt.Sprintf("%v", this.Interval), "Duration", "v11.Duration", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -17580,6 +19950,75 @@ func (m *BufferServiceConfig) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *CPUScalePolicy) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CPUScalePolicy: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CPUScalePolicy: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetUtilization", wireType)
+			}
+			m.TargetUtilization = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TargetUtilization |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *CombinedEdge) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -18668,7 +21107,7 @@ func (m *ContainerTemplate) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DaemonTemplate) Unmarshal(dAtA []byte) error {
+func (m *CountWindow) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -18691,15 +21130,34 @@ func (m *DaemonTemplate) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DaemonTemplate: wiretype end group for non-group")
+			return fmt.Errorf("proto: CountWindow: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DaemonTemplate: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CountWindow: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
+			}
+			m.Count = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Count |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AbstractPodTemplate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Timeout", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -18726,33 +21184,119 @@ func (m *DaemonTemplate) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.AbstractPodTemplate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Timeout == nil {
+				m.Timeout = &v11.Duration{}
+			}
+			if err := m.Timeout.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Replicas", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			var v int32
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
 			}
-			m.Replicas = &v
-		case 3:
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DaemonTemplate) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DaemonTemplate: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DaemonTemplate: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ContainerTemplate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AbstractPodTemplate", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.AbstractPodTemplate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Replicas", wireType)
+			}
+			var v int32
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Replicas = &v
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContainerTemplate", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -18843,7 +21387,7 @@ func (m *DaemonTemplate) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Edge) Unmarshal(dAtA []byte) error {
+func (m *DeadLetterOutput) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -18866,47 +21410,15 @@ func (m *Edge) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Edge: wiretype end group for non-group")
+			return fmt.Errorf("proto: DeadLetterOutput: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Edge: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DeadLetterOutput: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field From", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.From = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field To", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ToVertex", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -18934,76 +21446,7 @@ func (m *Edge) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.To = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Conditions", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Conditions == nil {
-				m.Conditions = &ForwardConditions{}
-			}
-			if err := m.Conditions.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OnFull", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			s := BufferFullWritingStrategy(dAtA[iNdEx:postIndex])
-			m.OnFull = &s
+			m.ToVertex = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -19026,7 +21469,7 @@ func (m *Edge) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *FixedWindow) Unmarshal(dAtA []byte) error {
+func (m *DedupWindow) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -19049,15 +21492,15 @@ func (m *FixedWindow) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: FixedWindow: wiretype end group for non-group")
+			return fmt.Errorf("proto: DedupWindow: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: FixedWindow: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DedupWindow: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Length", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Duration", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -19084,18 +21527,15 @@ func (m *FixedWindow) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Length == nil {
-				m.Length = &v11.Duration{}
-			}
-			if err := m.Length.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Duration.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Streaming", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxEntries", wireType)
 			}
-			var v int
+			var v int32
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -19105,12 +21545,12 @@ func (m *FixedWindow) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				v |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Streaming = bool(v != 0)
+			m.MaxEntries = &v
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -19132,7 +21572,7 @@ func (m *FixedWindow) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ForwardConditions) Unmarshal(dAtA []byte) error {
+func (m *EarlyFiring) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -19155,15 +21595,15 @@ func (m *ForwardConditions) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ForwardConditions: wiretype end group for non-group")
+			return fmt.Errorf("proto: EarlyFiring: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ForwardConditions: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EarlyFiring: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Tags", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Interval", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -19190,13 +21630,33 @@ func (m *ForwardConditions) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Tags == nil {
-				m.Tags = &TagConditions{}
+			if m.Interval == nil {
+				m.Interval = &v11.Duration{}
 			}
-			if err := m.Tags.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Interval.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
+			}
+			var v int32
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Count = &v
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -19218,7 +21678,7 @@ func (m *ForwardConditions) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GSSAPI) Unmarshal(dAtA []byte) error {
+func (m *Edge) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -19241,15 +21701,15 @@ func (m *GSSAPI) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GSSAPI: wiretype end group for non-group")
+			return fmt.Errorf("proto: Edge: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GSSAPI: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Edge: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ServiceName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field From", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -19277,11 +21737,11 @@ func (m *GSSAPI) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ServiceName = string(dAtA[iNdEx:postIndex])
+			m.From = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Realm", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field To", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -19309,11 +21769,11 @@ func (m *GSSAPI) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Realm = string(dAtA[iNdEx:postIndex])
+			m.To = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UsernameSecret", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Conditions", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -19340,16 +21800,16 @@ func (m *GSSAPI) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.UsernameSecret == nil {
-				m.UsernameSecret = &v1.SecretKeySelector{}
+			if m.Conditions == nil {
+				m.Conditions = &ForwardConditions{}
 			}
-			if err := m.UsernameSecret.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Conditions.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AuthType", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OnFull", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -19377,12 +21837,45 @@ func (m *GSSAPI) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			s := KRB5AuthType(dAtA[iNdEx:postIndex])
-			m.AuthType = &s
+			s := BufferFullWritingStrategy(dAtA[iNdEx:postIndex])
+			m.OnFull = &s
 			iNdEx = postIndex
 		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PasswordSecret", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Partitioning", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := PartitioningStrategy(dAtA[iNdEx:postIndex])
+			m.Partitioning = &s
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HotKeySalting", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -19409,82 +21902,10 @@ func (m *GSSAPI) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.PasswordSecret == nil {
-				m.PasswordSecret = &v1.SecretKeySelector{}
-			}
-			if err := m.PasswordSecret.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 6:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field KeytabSecret", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.KeytabSecret == nil {
-				m.KeytabSecret = &v1.SecretKeySelector{}
-			}
-			if err := m.KeytabSecret.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 7:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field KerberosConfigSecret", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
+			if m.HotKeySalting == nil {
+				m.HotKeySalting = &HotKeySalting{}
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.KerberosConfigSecret == nil {
-				m.KerberosConfigSecret = &v1.SecretKeySelector{}
-			}
-			if err := m.KerberosConfigSecret.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.HotKeySalting.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -19509,7 +21930,7 @@ func (m *GSSAPI) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GeneratorSource) Unmarshal(dAtA []byte) error {
+func (m *FixedWindow) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -19532,35 +21953,15 @@ func (m *GeneratorSource) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GeneratorSource: wiretype end group for non-group")
+			return fmt.Errorf("proto: FixedWindow: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GeneratorSource: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: FixedWindow: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RPU", wireType)
-			}
-			var v int64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.RPU = &v
-		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Duration", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Length", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -19587,114 +21988,18 @@ func (m *GeneratorSource) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Duration == nil {
-				m.Duration = &v11.Duration{}
+			if m.Length == nil {
+				m.Length = &v11.Duration{}
 			}
-			if err := m.Duration.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Length.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MsgSize", wireType)
-			}
-			var v int32
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.MsgSize = &v
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field KeyCount", wireType)
-			}
-			var v int32
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.KeyCount = &v
-		case 5:
+		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
-			}
-			var v uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.Value = &v
-		case 6:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Jitter", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Jitter == nil {
-				m.Jitter = &v11.Duration{}
-			}
-			if err := m.Jitter.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 7:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValueBlob", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Streaming", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -19704,25 +22009,12 @@ func (m *GeneratorSource) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			s := string(dAtA[iNdEx:postIndex])
-			m.ValueBlob = &s
-			iNdEx = postIndex
+			m.Streaming = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -19744,7 +22036,7 @@ func (m *GeneratorSource) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetDaemonDeploymentReq) Unmarshal(dAtA []byte) error {
+func (m *ForwardConditions) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -19767,17 +22059,17 @@ func (m *GetDaemonDeploymentReq) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetDaemonDeploymentReq: wiretype end group for non-group")
+			return fmt.Errorf("proto: ForwardConditions: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetDaemonDeploymentReq: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ForwardConditions: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ISBSvcType", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Tags", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -19787,59 +22079,31 @@ func (m *GetDaemonDeploymentReq) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ISBSvcType = ISBSvcType(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Image", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
+			if m.Tags == nil {
+				m.Tags = &TagConditions{}
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			if err := m.Tags.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.Image = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PullPolicy", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Expression", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -19867,74 +22131,7 @@ func (m *GetDaemonDeploymentReq) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PullPolicy = k8s_io_api_core_v1.PullPolicy(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Env", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Env = append(m.Env, v1.EnvVar{})
-			if err := m.Env[len(m.Env)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DefaultResources", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.DefaultResources.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Expression = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -19957,7 +22154,7 @@ func (m *GetDaemonDeploymentReq) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetJetStreamServiceSpecReq) Unmarshal(dAtA []byte) error {
+func (m *Function) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -19980,15 +22177,47 @@ func (m *GetJetStreamServiceSpecReq) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetJetStreamServiceSpecReq: wiretype end group for non-group")
+			return fmt.Errorf("proto: Function: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetJetStreamServiceSpecReq: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Function: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Labels", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KWArgs", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -20015,8 +22244,8 @@ func (m *GetJetStreamServiceSpecReq) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Labels == nil {
-				m.Labels = make(map[string]string)
+			if m.KWArgs == nil {
+				m.KWArgs = make(map[string]string)
 			}
 			var mapkey string
 			var mapvalue string
@@ -20111,84 +22340,8 @@ func (m *GetJetStreamServiceSpecReq) Unmarshal(dAtA []byte) error {
 					iNdEx += skippy
 				}
 			}
-			m.Labels[mapkey] = mapvalue
+			m.KWArgs[mapkey] = mapvalue
 			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ClusterPort", wireType)
-			}
-			m.ClusterPort = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.ClusterPort |= int32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ClientPort", wireType)
-			}
-			m.ClientPort = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.ClientPort |= int32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MonitorPort", wireType)
-			}
-			m.MonitorPort = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.MonitorPort |= int32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MetricsPort", wireType)
-			}
-			m.MetricsPort = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.MetricsPort |= int32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -20210,7 +22363,7 @@ func (m *GetJetStreamServiceSpecReq) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetJetStreamStatefulSetSpecReq) Unmarshal(dAtA []byte) error {
+func (m *GSSAPI) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -20233,10 +22386,10 @@ func (m *GetJetStreamStatefulSetSpecReq) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetJetStreamStatefulSetSpecReq: wiretype end group for non-group")
+			return fmt.Errorf("proto: GSSAPI: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetJetStreamStatefulSetSpecReq: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GSSAPI: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -20273,7 +22426,39 @@ func (m *GetJetStreamStatefulSetSpecReq) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Labels", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Realm", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Realm = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UsernameSecret", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -20300,107 +22485,16 @@ func (m *GetJetStreamStatefulSetSpecReq) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Labels == nil {
-				m.Labels = make(map[string]string)
+			if m.UsernameSecret == nil {
+				m.UsernameSecret = &v1.SecretKeySelector{}
 			}
-			var mapkey string
-			var mapvalue string
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowGenerated
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					var stringLenmapkey uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapkey |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapkey := int(stringLenmapkey)
-					if intStringLenmapkey < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					postStringIndexmapkey := iNdEx + intStringLenmapkey
-					if postStringIndexmapkey < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if postStringIndexmapkey > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
-					iNdEx = postStringIndexmapkey
-				} else if fieldNum == 2 {
-					var stringLenmapvalue uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapvalue |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapvalue := int(stringLenmapvalue)
-					if intStringLenmapvalue < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
-					if postStringIndexmapvalue < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if postStringIndexmapvalue > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
-					iNdEx = postStringIndexmapvalue
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipGenerated(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if (skippy < 0) || (iNdEx+skippy) < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
-				}
+			if err := m.UsernameSecret.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.Labels[mapkey] = mapvalue
 			iNdEx = postIndex
-		case 3:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NatsImage", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AuthType", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -20428,13 +22522,14 @@ func (m *GetJetStreamStatefulSetSpecReq) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.NatsImage = string(dAtA[iNdEx:postIndex])
+			s := KRB5AuthType(dAtA[iNdEx:postIndex])
+			m.AuthType = &s
 			iNdEx = postIndex
-		case 4:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MetricsExporterImage", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PasswordSecret", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -20444,29 +22539,33 @@ func (m *GetJetStreamStatefulSetSpecReq) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.MetricsExporterImage = string(dAtA[iNdEx:postIndex])
+			if m.PasswordSecret == nil {
+				m.PasswordSecret = &v1.SecretKeySelector{}
+			}
+			if err := m.PasswordSecret.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 5:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ConfigReloaderImage", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field KeytabSecret", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -20476,48 +22575,33 @@ func (m *GetJetStreamStatefulSetSpecReq) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ConfigReloaderImage = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ClusterPort", wireType)
+			if m.KeytabSecret == nil {
+				m.KeytabSecret = &v1.SecretKeySelector{}
 			}
-			m.ClusterPort = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.ClusterPort |= int32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if err := m.KeytabSecret.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
+			iNdEx = postIndex
 		case 7:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ClientPort", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KerberosConfigSecret", wireType)
 			}
-			m.ClientPort = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -20527,35 +22611,83 @@ func (m *GetJetStreamStatefulSetSpecReq) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ClientPort |= int32(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 8:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MonitorPort", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
 			}
-			m.MonitorPort = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.MonitorPort |= int32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
 			}
-		case 9:
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.KerberosConfigSecret == nil {
+				m.KerberosConfigSecret = &v1.SecretKeySelector{}
+			}
+			if err := m.KerberosConfigSecret.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GeneratorSource) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GeneratorSource: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GeneratorSource: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MetricsPort", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RPU", wireType)
 			}
-			m.MetricsPort = 0
+			var v int64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -20565,16 +22697,17 @@ func (m *GetJetStreamStatefulSetSpecReq) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MetricsPort |= int32(b&0x7F) << shift
+				v |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 10:
+			m.RPU = &v
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ServerAuthSecretName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Duration", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -20584,29 +22717,33 @@ func (m *GetJetStreamStatefulSetSpecReq) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ServerAuthSecretName = string(dAtA[iNdEx:postIndex])
+			if m.Duration == nil {
+				m.Duration = &v11.Duration{}
+			}
+			if err := m.Duration.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 11:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ServerEncryptionSecretName", wireType)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgSize", wireType)
 			}
-			var stringLen uint64
+			var v int32
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -20616,29 +22753,17 @@ func (m *GetJetStreamStatefulSetSpecReq) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ServerEncryptionSecretName = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 12:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ConfigMapName", wireType)
+			m.MsgSize = &v
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KeyCount", wireType)
 			}
-			var stringLen uint64
+			var v int32
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -20648,29 +22773,17 @@ func (m *GetJetStreamStatefulSetSpecReq) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ConfigMapName = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 13:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PvcNameIfNeeded", wireType)
+			m.KeyCount = &v
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
 			}
-			var stringLen uint64
+			var v uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -20680,29 +22793,17 @@ func (m *GetJetStreamStatefulSetSpecReq) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.PvcNameIfNeeded = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 14:
+			m.Value = &v
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StartCommand", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Jitter", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -20712,29 +22813,33 @@ func (m *GetJetStreamStatefulSetSpecReq) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.StartCommand = string(dAtA[iNdEx:postIndex])
+			if m.Jitter == nil {
+				m.Jitter = &v11.Duration{}
+			}
+			if err := m.Jitter.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 15:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DefaultResources", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ValueBlob", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -20744,24 +22849,24 @@ func (m *GetJetStreamStatefulSetSpecReq) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.DefaultResources.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.ValueBlob = &s
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -20784,7 +22889,7 @@ func (m *GetJetStreamStatefulSetSpecReq) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetMonoVertexDaemonDeploymentReq) Unmarshal(dAtA []byte) error {
+func (m *GetDaemonDeploymentReq) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -20807,13 +22912,45 @@ func (m *GetMonoVertexDaemonDeploymentReq) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetMonoVertexDaemonDeploymentReq: wiretype end group for non-group")
+			return fmt.Errorf("proto: GetDaemonDeploymentReq: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetMonoVertexDaemonDeploymentReq: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GetDaemonDeploymentReq: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ISBSvcType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ISBSvcType = ISBSvcType(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Image", wireType)
 			}
@@ -20845,7 +22982,7 @@ func (m *GetMonoVertexDaemonDeploymentReq) Unmarshal(dAtA []byte) error {
 			}
 			m.Image = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field PullPolicy", wireType)
 			}
@@ -20877,7 +23014,7 @@ func (m *GetMonoVertexDaemonDeploymentReq) Unmarshal(dAtA []byte) error {
 			}
 			m.PullPolicy = k8s_io_api_core_v1.PullPolicy(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 4:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Env", wireType)
 			}
@@ -20911,7 +23048,7 @@ func (m *GetMonoVertexDaemonDeploymentReq) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
+		case 5:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field DefaultResources", wireType)
 			}
@@ -20965,7 +23102,7 @@ func (m *GetMonoVertexDaemonDeploymentReq) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetMonoVertexPodSpecReq) Unmarshal(dAtA []byte) error {
+func (m *GetJetStreamServiceSpecReq) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -20988,79 +23125,15 @@ func (m *GetMonoVertexPodSpecReq) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetMonoVertexPodSpecReq: wiretype end group for non-group")
+			return fmt.Errorf("proto: GetJetStreamServiceSpecReq: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetMonoVertexPodSpecReq: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GetJetStreamServiceSpecReq: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Image", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Image = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PullPolicy", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.PullPolicy = k8s_io_api_core_v1.PullPolicy(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Env", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Labels", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -21087,125 +23160,8 @@ func (m *GetMonoVertexPodSpecReq) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Env = append(m.Env, v1.EnvVar{})
-			if err := m.Env[len(m.Env)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DefaultResources", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.DefaultResources.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *GetRedisServiceSpecReq) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: GetRedisServiceSpecReq: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetRedisServiceSpecReq: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Labels", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Labels == nil {
-				m.Labels = make(map[string]string)
+			if m.Labels == nil {
+				m.Labels = make(map[string]string)
 			}
 			var mapkey string
 			var mapvalue string
@@ -21304,9 +23260,9 @@ func (m *GetRedisServiceSpecReq) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RedisContainerPort", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ClusterPort", wireType)
 			}
-			m.RedisContainerPort = 0
+			m.ClusterPort = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -21316,16 +23272,16 @@ func (m *GetRedisServiceSpecReq) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.RedisContainerPort |= int32(b&0x7F) << shift
+				m.ClusterPort |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SentinelContainerPort", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientPort", wireType)
 			}
-			m.SentinelContainerPort = 0
+			m.ClientPort = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -21335,7 +23291,45 @@ func (m *GetRedisServiceSpecReq) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.SentinelContainerPort |= int32(b&0x7F) << shift
+				m.ClientPort |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MonitorPort", wireType)
+			}
+			m.MonitorPort = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MonitorPort |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MetricsPort", wireType)
+			}
+			m.MetricsPort = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MetricsPort |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -21361,7 +23355,7 @@ func (m *GetRedisServiceSpecReq) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetRedisStatefulSetSpecReq) Unmarshal(dAtA []byte) error {
+func (m *GetJetStreamStatefulSetSpecReq) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -21384,10 +23378,10 @@ func (m *GetRedisStatefulSetSpecReq) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetRedisStatefulSetSpecReq: wiretype end group for non-group")
+			return fmt.Errorf("proto: GetJetStreamStatefulSetSpecReq: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetRedisStatefulSetSpecReq: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GetJetStreamStatefulSetSpecReq: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -21551,7 +23545,7 @@ func (m *GetRedisStatefulSetSpecReq) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RedisImage", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NatsImage", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -21579,11 +23573,11 @@ func (m *GetRedisStatefulSetSpecReq) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RedisImage = string(dAtA[iNdEx:postIndex])
+			m.NatsImage = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SentinelImage", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MetricsExporterImage", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -21611,11 +23605,11 @@ func (m *GetRedisStatefulSetSpecReq) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SentinelImage = string(dAtA[iNdEx:postIndex])
+			m.MetricsExporterImage = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MetricsExporterImage", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ConfigReloaderImage", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -21643,11 +23637,87 @@ func (m *GetRedisStatefulSetSpecReq) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.MetricsExporterImage = string(dAtA[iNdEx:postIndex])
+			m.ConfigReloaderImage = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClusterPort", wireType)
+			}
+			m.ClusterPort = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ClusterPort |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientPort", wireType)
+			}
+			m.ClientPort = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ClientPort |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MonitorPort", wireType)
+			}
+			m.MonitorPort = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MonitorPort |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MetricsPort", wireType)
+			}
+			m.MetricsPort = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MetricsPort |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InitContainerImage", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ServerAuthSecretName", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -21675,68 +23745,11 @@ func (m *GetRedisStatefulSetSpecReq) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.InitContainerImage = string(dAtA[iNdEx:postIndex])
+			m.ServerAuthSecretName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 7:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RedisContainerPort", wireType)
-			}
-			m.RedisContainerPort = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.RedisContainerPort |= int32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 8:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SentinelContainerPort", wireType)
-			}
-			m.SentinelContainerPort = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.SentinelContainerPort |= int32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 9:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RedisMetricsContainerPort", wireType)
-			}
-			m.RedisMetricsContainerPort = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.RedisMetricsContainerPort |= int32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 10:
+		case 11:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CredentialSecretName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ServerEncryptionSecretName", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -21764,31 +23777,11 @@ func (m *GetRedisStatefulSetSpecReq) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CredentialSecretName = string(dAtA[iNdEx:postIndex])
+			m.ServerEncryptionSecretName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 11:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TLSEnabled", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.TLSEnabled = bool(v != 0)
 		case 12:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PvcNameIfNeeded", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ConfigMapName", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -21816,11 +23809,11 @@ func (m *GetRedisStatefulSetSpecReq) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PvcNameIfNeeded = string(dAtA[iNdEx:postIndex])
+			m.ConfigMapName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 13:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ConfConfigMapName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PvcNameIfNeeded", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -21848,11 +23841,11 @@ func (m *GetRedisStatefulSetSpecReq) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ConfConfigMapName = string(dAtA[iNdEx:postIndex])
+			m.PvcNameIfNeeded = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 14:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ScriptsConfigMapName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StartCommand", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -21880,41 +23873,9 @@ func (m *GetRedisStatefulSetSpecReq) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ScriptsConfigMapName = string(dAtA[iNdEx:postIndex])
+			m.StartCommand = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 15:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HealthConfigMapName", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.HealthConfigMapName = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 16:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field DefaultResources", wireType)
 			}
@@ -21968,7 +23929,7 @@ func (m *GetRedisStatefulSetSpecReq) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetServingPipelineResourceReq) Unmarshal(dAtA []byte) error {
+func (m *GetMonoVertexDaemonDeploymentReq) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -21991,46 +23952,13 @@ func (m *GetServingPipelineResourceReq) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetServingPipelineResourceReq: wiretype end group for non-group")
+			return fmt.Errorf("proto: GetMonoVertexDaemonDeploymentReq: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetServingPipelineResourceReq: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GetMonoVertexDaemonDeploymentReq: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ISBSvcConfig", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.ISBSvcConfig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Image", wireType)
 			}
@@ -22062,7 +23990,7 @@ func (m *GetServingPipelineResourceReq) Unmarshal(dAtA []byte) error {
 			}
 			m.Image = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 2:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field PullPolicy", wireType)
 			}
@@ -22094,7 +24022,7 @@ func (m *GetServingPipelineResourceReq) Unmarshal(dAtA []byte) error {
 			}
 			m.PullPolicy = k8s_io_api_core_v1.PullPolicy(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 3:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Env", wireType)
 			}
@@ -22128,7 +24056,7 @@ func (m *GetServingPipelineResourceReq) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
+		case 4:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field DefaultResources", wireType)
 			}
@@ -22182,7 +24110,7 @@ func (m *GetServingPipelineResourceReq) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetSideInputDeploymentReq) Unmarshal(dAtA []byte) error {
+func (m *GetMonoVertexPodSpecReq) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -22205,15 +24133,15 @@ func (m *GetSideInputDeploymentReq) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetSideInputDeploymentReq: wiretype end group for non-group")
+			return fmt.Errorf("proto: GetMonoVertexPodSpecReq: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetSideInputDeploymentReq: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GetMonoVertexPodSpecReq: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ISBSvcType", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Image", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -22241,11 +24169,11 @@ func (m *GetSideInputDeploymentReq) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ISBSvcType = ISBSvcType(dAtA[iNdEx:postIndex])
+			m.Image = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Image", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PullPolicy", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -22273,13 +24201,13 @@ func (m *GetSideInputDeploymentReq) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Image = string(dAtA[iNdEx:postIndex])
+			m.PullPolicy = k8s_io_api_core_v1.PullPolicy(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PullPolicy", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Env", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -22289,27 +24217,29 @@ func (m *GetSideInputDeploymentReq) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PullPolicy = k8s_io_api_core_v1.PullPolicy(dAtA[iNdEx:postIndex])
+			m.Env = append(m.Env, v1.EnvVar{})
+			if err := m.Env[len(m.Env)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Env", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DefaultResources", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -22336,14 +24266,63 @@ func (m *GetSideInputDeploymentReq) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Env = append(m.Env, v1.EnvVar{})
-			if err := m.Env[len(m.Env)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.DefaultResources.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetRedisServiceSpecReq) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetRedisServiceSpecReq: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetRedisServiceSpecReq: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DefaultResources", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Labels", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -22370,10 +24349,142 @@ func (m *GetSideInputDeploymentReq) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.DefaultResources.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			if m.Labels == nil {
+				m.Labels = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenerated
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipGenerated(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
 			}
+			m.Labels[mapkey] = mapvalue
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RedisContainerPort", wireType)
+			}
+			m.RedisContainerPort = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RedisContainerPort |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SentinelContainerPort", wireType)
+			}
+			m.SentinelContainerPort = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SentinelContainerPort |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -22395,7 +24506,7 @@ func (m *GetSideInputDeploymentReq) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetVertexPodSpecReq) Unmarshal(dAtA []byte) error {
+func (m *GetRedisStatefulSetSpecReq) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -22418,15 +24529,15 @@ func (m *GetVertexPodSpecReq) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetVertexPodSpecReq: wiretype end group for non-group")
+			return fmt.Errorf("proto: GetRedisStatefulSetSpecReq: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetVertexPodSpecReq: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GetRedisStatefulSetSpecReq: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ISBSvcType", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ServiceName", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -22454,13 +24565,13 @@ func (m *GetVertexPodSpecReq) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ISBSvcType = ISBSvcType(dAtA[iNdEx:postIndex])
+			m.ServiceName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Image", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Labels", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -22470,27 +24581,122 @@ func (m *GetVertexPodSpecReq) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Image = string(dAtA[iNdEx:postIndex])
+			if m.Labels == nil {
+				m.Labels = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenerated
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipGenerated(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Labels[mapkey] = mapvalue
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PullPolicy", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RedisImage", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -22518,13 +24724,13 @@ func (m *GetVertexPodSpecReq) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PullPolicy = k8s_io_api_core_v1.PullPolicy(dAtA[iNdEx:postIndex])
+			m.RedisImage = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Env", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SentinelImage", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -22534,29 +24740,27 @@ func (m *GetVertexPodSpecReq) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Env = append(m.Env, v1.EnvVar{})
-			if err := m.Env[len(m.Env)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.SentinelImage = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SideInputsStoreName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MetricsExporterImage", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -22584,11 +24788,11 @@ func (m *GetVertexPodSpecReq) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SideInputsStoreName = string(dAtA[iNdEx:postIndex])
+			m.MetricsExporterImage = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ServingSourceStreamName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field InitContainerImage", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -22616,13 +24820,13 @@ func (m *GetVertexPodSpecReq) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ServingSourceStreamName = string(dAtA[iNdEx:postIndex])
+			m.InitContainerImage = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 7:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PipelineSpec", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RedisContainerPort", wireType)
 			}
-			var msglen int
+			m.RedisContainerPort = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -22632,30 +24836,54 @@ func (m *GetVertexPodSpecReq) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.RedisContainerPort |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SentinelContainerPort", wireType)
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
+			m.SentinelContainerPort = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SentinelContainerPort |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RedisMetricsContainerPort", wireType)
 			}
-			if err := m.PipelineSpec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.RedisMetricsContainerPort = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RedisMetricsContainerPort |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
-		case 8:
+		case 10:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DefaultResources", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CredentialSecretName", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -22665,80 +24893,81 @@ func (m *GetVertexPodSpecReq) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.DefaultResources.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.CredentialSecretName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TLSEnabled", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			m.TLSEnabled = bool(v != 0)
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PvcNameIfNeeded", wireType)
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *GroupBy) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: GroupBy: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GroupBy: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PvcNameIfNeeded = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 13:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Window", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ConfConfigMapName", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -22748,30 +24977,29 @@ func (m *GroupBy) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Window.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ConfConfigMapName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Keyed", wireType)
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScriptsConfigMapName", wireType)
 			}
-			var v int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -22781,17 +25009,29 @@ func (m *GroupBy) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Keyed = bool(v != 0)
-		case 3:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ScriptsConfigMapName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 15:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AllowedLateness", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field HealthConfigMapName", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -22801,31 +25041,27 @@ func (m *GroupBy) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.AllowedLateness == nil {
-				m.AllowedLateness = &v11.Duration{}
-			}
-			if err := m.AllowedLateness.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.HealthConfigMapName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 16:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Storage", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DefaultResources", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -22852,10 +25088,7 @@ func (m *GroupBy) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Storage == nil {
-				m.Storage = &PBQStorage{}
-			}
-			if err := m.Storage.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.DefaultResources.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -22880,7 +25113,7 @@ func (m *GroupBy) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *HTTPSource) Unmarshal(dAtA []byte) error {
+func (m *GetServingPipelineResourceReq) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -22903,15 +25136,15 @@ func (m *HTTPSource) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: HTTPSource: wiretype end group for non-group")
+			return fmt.Errorf("proto: GetServingPipelineResourceReq: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: HTTPSource: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GetServingPipelineResourceReq: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Auth", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ISBSvcConfig", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -22938,88 +25171,15 @@ func (m *HTTPSource) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Auth == nil {
-				m.Auth = &Authorization{}
-			}
-			if err := m.Auth.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ISBSvcConfig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Service", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.Service = bool(v != 0)
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *IdleSource) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: IdleSource: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: IdleSource: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Threshold", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Image", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -23029,33 +25189,29 @@ func (m *IdleSource) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Threshold == nil {
-				m.Threshold = &v11.Duration{}
-			}
-			if err := m.Threshold.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Image = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StepInterval", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PullPolicy", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -23065,31 +25221,27 @@ func (m *IdleSource) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.StepInterval == nil {
-				m.StepInterval = &v11.Duration{}
-			}
-			if err := m.StepInterval.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.PullPolicy = k8s_io_api_core_v1.PullPolicy(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IncrementBy", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Env", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -23116,66 +25268,14 @@ func (m *IdleSource) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.IncrementBy == nil {
-				m.IncrementBy = &v11.Duration{}
-			}
-			if err := m.IncrementBy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Env = append(m.Env, v1.EnvVar{})
+			if err := m.Env[len(m.Env)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *InterStepBuffer) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: InterStepBuffer: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: InterStepBuffer: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 2:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Compression", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DefaultResources", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -23202,10 +25302,7 @@ func (m *InterStepBuffer) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Compression == nil {
-				m.Compression = &Compression{}
-			}
-			if err := m.Compression.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.DefaultResources.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -23230,7 +25327,7 @@ func (m *InterStepBuffer) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *InterStepBufferService) Unmarshal(dAtA []byte) error {
+func (m *GetSideInputDeploymentReq) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -23253,17 +25350,17 @@ func (m *InterStepBufferService) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: InterStepBufferService: wiretype end group for non-group")
+			return fmt.Errorf("proto: GetSideInputDeploymentReq: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: InterStepBufferService: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GetSideInputDeploymentReq: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ObjectMeta", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ISBSvcType", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -23273,30 +25370,29 @@ func (m *InterStepBufferService) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ObjectMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ISBSvcType = ISBSvcType(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Spec", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Image", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -23306,30 +25402,29 @@ func (m *InterStepBufferService) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Spec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Image = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PullPolicy", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -23339,78 +25434,27 @@ func (m *InterStepBufferService) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Status.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.PullPolicy = k8s_io_api_core_v1.PullPolicy(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *InterStepBufferServiceList) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: InterStepBufferServiceList: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: InterStepBufferServiceList: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ListMeta", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Env", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -23437,13 +25481,14 @@ func (m *InterStepBufferServiceList) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ListMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Env = append(m.Env, v1.EnvVar{})
+			if err := m.Env[len(m.Env)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DefaultResources", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -23470,8 +25515,7 @@ func (m *InterStepBufferServiceList) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Items = append(m.Items, InterStepBufferService{})
-			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.DefaultResources.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -23496,7 +25540,7 @@ func (m *InterStepBufferServiceList) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *InterStepBufferServiceSpec) Unmarshal(dAtA []byte) error {
+func (m *GetVertexPodSpecReq) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -23519,17 +25563,17 @@ func (m *InterStepBufferServiceSpec) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: InterStepBufferServiceSpec: wiretype end group for non-group")
+			return fmt.Errorf("proto: GetVertexPodSpecReq: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: InterStepBufferServiceSpec: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GetVertexPodSpecReq: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Redis", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ISBSvcType", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -23539,33 +25583,29 @@ func (m *InterStepBufferServiceSpec) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Redis == nil {
-				m.Redis = &RedisBufferService{}
-			}
-			if err := m.Redis.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ISBSvcType = ISBSvcType(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field JetStream", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Image", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -23575,81 +25615,59 @@ func (m *InterStepBufferServiceSpec) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.JetStream == nil {
-				m.JetStream = &JetStreamBufferService{}
-			}
-			if err := m.JetStream.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Image = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PullPolicy", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *InterStepBufferServiceStatus) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: InterStepBufferServiceStatus: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: InterStepBufferServiceStatus: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.PullPolicy = k8s_io_api_core_v1.PullPolicy(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Env", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -23676,13 +25694,14 @@ func (m *InterStepBufferServiceStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Status.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Env = append(m.Env, v1.EnvVar{})
+			if err := m.Env[len(m.Env)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Phase", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SideInputsStoreName", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -23710,11 +25729,11 @@ func (m *InterStepBufferServiceStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Phase = ISBSvcPhase(dAtA[iNdEx:postIndex])
+			m.SideInputsStoreName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ServingSourceStreamName", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -23742,11 +25761,11 @@ func (m *InterStepBufferServiceStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Message = string(dAtA[iNdEx:postIndex])
+			m.ServingSourceStreamName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Config", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PipelineSpec", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -23773,15 +25792,15 @@ func (m *InterStepBufferServiceStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Config.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.PipelineSpec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DefaultResources", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -23791,43 +25810,25 @@ func (m *InterStepBufferServiceStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Type = ISBSvcType(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ObservedGeneration", wireType)
-			}
-			m.ObservedGeneration = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.ObservedGeneration |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if err := m.DefaultResources.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -23849,7 +25850,7 @@ func (m *InterStepBufferServiceStatus) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *JetStreamBufferService) Unmarshal(dAtA []byte) error {
+func (m *GlobalWindow) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -23872,17 +25873,17 @@ func (m *JetStreamBufferService) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: JetStreamBufferService: wiretype end group for non-group")
+			return fmt.Errorf("proto: GlobalWindow: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: JetStreamBufferService: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GlobalWindow: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Trigger", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -23892,47 +25893,81 @@ func (m *JetStreamBufferService) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Version = string(dAtA[iNdEx:postIndex])
+			if m.Trigger == nil {
+				m.Trigger = &WindowTrigger{}
+			}
+			if err := m.Trigger.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Replicas", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			var v int32
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
 			}
-			m.Replicas = &v
-		case 3:
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GroupBy) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GroupBy: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GroupBy: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ContainerTemplate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Window", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -23959,16 +25994,33 @@ func (m *JetStreamBufferService) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ContainerTemplate == nil {
-				m.ContainerTemplate = &ContainerTemplate{}
-			}
-			if err := m.ContainerTemplate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Window.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Keyed", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Keyed = bool(v != 0)
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ReloaderContainerTemplate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowedLateness", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -23995,16 +26047,16 @@ func (m *JetStreamBufferService) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ReloaderContainerTemplate == nil {
-				m.ReloaderContainerTemplate = &ContainerTemplate{}
+			if m.AllowedLateness == nil {
+				m.AllowedLateness = &v11.Duration{}
 			}
-			if err := m.ReloaderContainerTemplate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.AllowedLateness.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MetricsContainerTemplate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Storage", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -24031,16 +26083,16 @@ func (m *JetStreamBufferService) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.MetricsContainerTemplate == nil {
-				m.MetricsContainerTemplate = &ContainerTemplate{}
+			if m.Storage == nil {
+				m.Storage = &PBQStorage{}
 			}
-			if err := m.MetricsContainerTemplate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Storage.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 6:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Persistence", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EarlyFiring", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -24067,16 +26119,36 @@ func (m *JetStreamBufferService) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Persistence == nil {
-				m.Persistence = &PersistenceStrategy{}
+			if m.EarlyFiring == nil {
+				m.EarlyFiring = &EarlyFiring{}
 			}
-			if err := m.Persistence.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.EarlyFiring.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LateFiring", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.LateFiring = bool(v != 0)
 		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AbstractPodTemplate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LateData", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -24103,15 +26175,68 @@ func (m *JetStreamBufferService) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.AbstractPodTemplate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.LateData == nil {
+				m.LateData = &LateDataOutput{}
+			}
+			if err := m.LateData.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 8:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *HTTPSource) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: HTTPSource: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: HTTPSource: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Settings", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Auth", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -24121,30 +26246,33 @@ func (m *JetStreamBufferService) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			s := string(dAtA[iNdEx:postIndex])
-			m.Settings = &s
+			if m.Auth == nil {
+				m.Auth = &Authorization{}
+			}
+			if err := m.Auth.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 9:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StartArgs", wireType)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Service", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -24154,29 +26282,37 @@ func (m *JetStreamBufferService) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
+			m.Service = bool(v != 0)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DurableAck", wireType)
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			m.StartArgs = append(m.StartArgs, string(dAtA[iNdEx:postIndex]))
-			iNdEx = postIndex
-		case 10:
+			m.DurableAck = bool(v != 0)
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BufferConfig", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AckTimeout", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -24186,30 +26322,83 @@ func (m *JetStreamBufferService) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			s := string(dAtA[iNdEx:postIndex])
-			m.BufferConfig = &s
+			if m.AckTimeout == nil {
+				m.AckTimeout = &v11.Duration{}
+			}
+			if err := m.AckTimeout.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 11:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *HotKeySalting) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: HotKeySalting: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: HotKeySalting: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Encryption", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SubPartitions", wireType)
 			}
-			var v int
+			var v int32
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -24219,17 +26408,17 @@ func (m *JetStreamBufferService) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				v |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Encryption = bool(v != 0)
-		case 12:
+			m.SubPartitions = &v
+		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TLS", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ThresholdPercentage", wireType)
 			}
-			var v int
+			var v int32
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -24239,12 +26428,12 @@ func (m *JetStreamBufferService) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				v |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.TLS = bool(v != 0)
+			m.ThresholdPercentage = &v
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -24266,7 +26455,7 @@ func (m *JetStreamBufferService) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *JetStreamConfig) Unmarshal(dAtA []byte) error {
+func (m *IdleSource) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -24289,17 +26478,17 @@ func (m *JetStreamConfig) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: JetStreamConfig: wiretype end group for non-group")
+			return fmt.Errorf("proto: IdleSource: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: JetStreamConfig: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: IdleSource: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field URL", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Threshold", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -24309,27 +26498,31 @@ func (m *JetStreamConfig) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.URL = string(dAtA[iNdEx:postIndex])
+			if m.Threshold == nil {
+				m.Threshold = &v11.Duration{}
+			}
+			if err := m.Threshold.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Auth", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StepInterval", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -24356,18 +26549,18 @@ func (m *JetStreamConfig) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Auth == nil {
-				m.Auth = &NatsAuth{}
+			if m.StepInterval == nil {
+				m.StepInterval = &v11.Duration{}
 			}
-			if err := m.Auth.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.StepInterval.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StreamConfig", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field IncrementBy", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -24377,44 +26570,28 @@ func (m *JetStreamConfig) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.StreamConfig = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TLSEnabled", wireType)
+			if m.IncrementBy == nil {
+				m.IncrementBy = &v11.Duration{}
 			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if err := m.IncrementBy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.TLSEnabled = bool(v != 0)
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -24436,7 +26613,7 @@ func (m *JetStreamConfig) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *JetStreamSource) Unmarshal(dAtA []byte) error {
+func (m *InterStepBuffer) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -24459,17 +26636,17 @@ func (m *JetStreamSource) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: JetStreamSource: wiretype end group for non-group")
+			return fmt.Errorf("proto: InterStepBuffer: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: JetStreamSource: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: InterStepBuffer: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field URL", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Compression", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -24479,29 +26656,83 @@ func (m *JetStreamSource) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.URL = string(dAtA[iNdEx:postIndex])
+			if m.Compression == nil {
+				m.Compression = &Compression{}
+			}
+			if err := m.Compression.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *InterStepBufferService) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: InterStepBufferService: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: InterStepBufferService: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Stream", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectMeta", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -24511,27 +26742,28 @@ func (m *JetStreamSource) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Stream = string(dAtA[iNdEx:postIndex])
+			if err := m.ObjectMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 3:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TLS", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Spec", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -24558,16 +26790,13 @@ func (m *JetStreamSource) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.TLS == nil {
-				m.TLS = &TLS{}
-			}
-			if err := m.TLS.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Spec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Auth", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -24594,10 +26823,7 @@ func (m *JetStreamSource) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Auth == nil {
-				m.Auth = &NatsAuth{}
-			}
-			if err := m.Auth.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Status.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -24622,7 +26848,7 @@ func (m *JetStreamSource) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *JobTemplate) Unmarshal(dAtA []byte) error {
+func (m *InterStepBufferServiceList) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -24645,15 +26871,15 @@ func (m *JobTemplate) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: JobTemplate: wiretype end group for non-group")
+			return fmt.Errorf("proto: InterStepBufferServiceList: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: JobTemplate: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: InterStepBufferServiceList: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AbstractPodTemplate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ListMeta", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -24680,13 +26906,13 @@ func (m *JobTemplate) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.AbstractPodTemplate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ListMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ContainerTemplate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -24713,18 +26939,66 @@ func (m *JobTemplate) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ContainerTemplate == nil {
-				m.ContainerTemplate = &ContainerTemplate{}
-			}
-			if err := m.ContainerTemplate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Items = append(m.Items, InterStepBufferService{})
+			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TTLSecondsAfterFinished", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			var v int32
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *InterStepBufferServiceSpec) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: InterStepBufferServiceSpec: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: InterStepBufferServiceSpec: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Redis", wireType)
+			}
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -24734,17 +27008,33 @@ func (m *JobTemplate) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int32(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.TTLSecondsAfterFinished = &v
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BackoffLimit", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
 			}
-			var v int32
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Redis == nil {
+				m.Redis = &RedisBufferService{}
+			}
+			if err := m.Redis.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JetStream", wireType)
+			}
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -24754,12 +27044,28 @@ func (m *JobTemplate) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int32(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.BackoffLimit = &v
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.JetStream == nil {
+				m.JetStream = &JetStreamBufferService{}
+			}
+			if err := m.JetStream.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -24781,7 +27087,7 @@ func (m *JobTemplate) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *KafkaSink) Unmarshal(dAtA []byte) error {
+func (m *InterStepBufferServiceStatus) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -24804,17 +27110,17 @@ func (m *KafkaSink) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: KafkaSink: wiretype end group for non-group")
+			return fmt.Errorf("proto: InterStepBufferServiceStatus: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: KafkaSink: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: InterStepBufferServiceStatus: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Brokers", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -24824,27 +27130,28 @@ func (m *KafkaSink) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Brokers = append(m.Brokers, string(dAtA[iNdEx:postIndex]))
+			if err := m.Status.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Topic", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Phase", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -24872,13 +27179,13 @@ func (m *KafkaSink) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Topic = string(dAtA[iNdEx:postIndex])
+			m.Phase = ISBSvcPhase(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SetKey", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
 			}
-			var v int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -24888,15 +27195,27 @@ func (m *KafkaSink) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.SetKey = bool(v != 0)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Message = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TLS", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Config", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -24923,16 +27242,13 @@ func (m *KafkaSink) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.TLS == nil {
-				m.TLS = &TLS{}
-			}
-			if err := m.TLS.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Config.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Config", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -24960,13 +27276,13 @@ func (m *KafkaSink) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Config = string(dAtA[iNdEx:postIndex])
+			m.Type = ISBSvcType(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 6:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SASL", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ObservedGeneration", wireType)
 			}
-			var msglen int
+			m.ObservedGeneration = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -24976,28 +27292,11 @@ func (m *KafkaSink) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.ObservedGeneration |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.SASL == nil {
-				m.SASL = &SASL{}
-			}
-			if err := m.SASL.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -25019,7 +27318,7 @@ func (m *KafkaSink) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *KafkaSource) Unmarshal(dAtA []byte) error {
+func (m *JetStreamBufferService) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -25042,15 +27341,15 @@ func (m *KafkaSource) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: KafkaSource: wiretype end group for non-group")
+			return fmt.Errorf("proto: JetStreamBufferService: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: KafkaSource: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: JetStreamBufferService: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Brokers", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -25078,13 +27377,33 @@ func (m *KafkaSource) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Brokers = append(m.Brokers, string(dAtA[iNdEx:postIndex]))
+			m.Version = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Replicas", wireType)
+			}
+			var v int32
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Replicas = &v
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Topic", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ContainerTemplate", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -25094,29 +27413,33 @@ func (m *KafkaSource) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Topic = string(dAtA[iNdEx:postIndex])
+			if m.ContainerTemplate == nil {
+				m.ContainerTemplate = &ContainerTemplate{}
+			}
+			if err := m.ContainerTemplate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 3:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ConsumerGroupName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ReloaderContainerTemplate", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -25126,27 +27449,31 @@ func (m *KafkaSource) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ConsumerGroupName = string(dAtA[iNdEx:postIndex])
+			if m.ReloaderContainerTemplate == nil {
+				m.ReloaderContainerTemplate = &ContainerTemplate{}
+			}
+			if err := m.ReloaderContainerTemplate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 4:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TLS", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MetricsContainerTemplate", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -25173,18 +27500,18 @@ func (m *KafkaSource) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.TLS == nil {
-				m.TLS = &TLS{}
+			if m.MetricsContainerTemplate == nil {
+				m.MetricsContainerTemplate = &ContainerTemplate{}
 			}
-			if err := m.TLS.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.MetricsContainerTemplate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Config", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Persistence", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -25194,27 +27521,31 @@ func (m *KafkaSource) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Config = string(dAtA[iNdEx:postIndex])
+			if m.Persistence == nil {
+				m.Persistence = &PersistenceStrategy{}
+			}
+			if err := m.Persistence.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 6:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SASL", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AbstractPodTemplate", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -25241,16 +27572,13 @@ func (m *KafkaSource) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.SASL == nil {
-				m.SASL = &SASL{}
-			}
-			if err := m.SASL.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.AbstractPodTemplate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 7:
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field KafkaVersion", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Settings", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -25278,8 +27606,114 @@ func (m *KafkaSource) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.KafkaVersion = string(dAtA[iNdEx:postIndex])
+			s := string(dAtA[iNdEx:postIndex])
+			m.Settings = &s
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartArgs", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StartArgs = append(m.StartArgs, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BufferConfig", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.BufferConfig = &s
 			iNdEx = postIndex
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Encryption", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Encryption = bool(v != 0)
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TLS", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.TLS = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -25301,7 +27735,7 @@ func (m *KafkaSource) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Lifecycle) Unmarshal(dAtA []byte) error {
+func (m *JetStreamConfig) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -25324,17 +27758,17 @@ func (m *Lifecycle) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Lifecycle: wiretype end group for non-group")
+			return fmt.Errorf("proto: JetStreamConfig: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Lifecycle: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: JetStreamConfig: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DeletionGracePeriodSeconds", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field URL", wireType)
 			}
-			var v int64
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -25344,17 +27778,29 @@ func (m *Lifecycle) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.DeletionGracePeriodSeconds = &v
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.URL = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DesiredPhase", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Auth", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -25364,29 +27810,33 @@ func (m *Lifecycle) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DesiredPhase = PipelinePhase(dAtA[iNdEx:postIndex])
+			if m.Auth == nil {
+				m.Auth = &NatsAuth{}
+			}
+			if err := m.Auth.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PauseGracePeriodSeconds", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StreamConfig", wireType)
 			}
-			var v int64
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -25396,17 +27846,29 @@ func (m *Lifecycle) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.PauseGracePeriodSeconds = &v
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StreamConfig = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DeprecatedDeleteGracePeriodSeconds", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TLSEnabled", wireType)
 			}
-			var v int64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -25416,12 +27878,12 @@ func (m *Lifecycle) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.DeprecatedDeleteGracePeriodSeconds = &v
+			m.TLSEnabled = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -25443,7 +27905,7 @@ func (m *Lifecycle) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Log) Unmarshal(dAtA []byte) error {
+func (m *JetStreamSource) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -25466,65 +27928,79 @@ func (m *Log) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Log: wiretype end group for non-group")
+			return fmt.Errorf("proto: JetStreamSource: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Log: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: JetStreamSource: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field URL", wireType)
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *Metadata) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if iNdEx >= l {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.URL = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Stream", wireType)
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Metadata: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Metadata: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Stream = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Annotations", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TLS", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -25551,107 +28027,16 @@ func (m *Metadata) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Annotations == nil {
-				m.Annotations = make(map[string]string)
+			if m.TLS == nil {
+				m.TLS = &TLS{}
 			}
-			var mapkey string
-			var mapvalue string
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowGenerated
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					var stringLenmapkey uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapkey |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapkey := int(stringLenmapkey)
-					if intStringLenmapkey < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					postStringIndexmapkey := iNdEx + intStringLenmapkey
-					if postStringIndexmapkey < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if postStringIndexmapkey > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
-					iNdEx = postStringIndexmapkey
-				} else if fieldNum == 2 {
-					var stringLenmapvalue uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapvalue |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapvalue := int(stringLenmapvalue)
-					if intStringLenmapvalue < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
-					if postStringIndexmapvalue < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if postStringIndexmapvalue > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
-					iNdEx = postStringIndexmapvalue
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipGenerated(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if (skippy < 0) || (iNdEx+skippy) < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
-				}
+			if err := m.TLS.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.Annotations[mapkey] = mapvalue
 			iNdEx = postIndex
-		case 2:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Labels", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Auth", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -25678,103 +28063,12 @@ func (m *Metadata) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Labels == nil {
-				m.Labels = make(map[string]string)
+			if m.Auth == nil {
+				m.Auth = &NatsAuth{}
 			}
-			var mapkey string
-			var mapvalue string
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowGenerated
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					var stringLenmapkey uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapkey |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapkey := int(stringLenmapkey)
-					if intStringLenmapkey < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					postStringIndexmapkey := iNdEx + intStringLenmapkey
-					if postStringIndexmapkey < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if postStringIndexmapkey > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
-					iNdEx = postStringIndexmapkey
-				} else if fieldNum == 2 {
-					var stringLenmapvalue uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapvalue |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapvalue := int(stringLenmapvalue)
-					if intStringLenmapvalue < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
-					if postStringIndexmapvalue < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if postStringIndexmapvalue > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
-					iNdEx = postStringIndexmapvalue
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipGenerated(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if (skippy < 0) || (iNdEx+skippy) < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
-				}
+			if err := m.Auth.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.Labels[mapkey] = mapvalue
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -25797,7 +28091,7 @@ func (m *Metadata) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MonoVertex) Unmarshal(dAtA []byte) error {
+func (m *JobTemplate) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -25820,15 +28114,15 @@ func (m *MonoVertex) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MonoVertex: wiretype end group for non-group")
+			return fmt.Errorf("proto: JobTemplate: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MonoVertex: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: JobTemplate: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ObjectMeta", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AbstractPodTemplate", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -25855,13 +28149,13 @@ func (m *MonoVertex) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ObjectMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.AbstractPodTemplate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Spec", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ContainerTemplate", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -25888,15 +28182,18 @@ func (m *MonoVertex) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Spec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.ContainerTemplate == nil {
+				m.ContainerTemplate = &ContainerTemplate{}
+			}
+			if err := m.ContainerTemplate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TTLSecondsAfterFinished", wireType)
 			}
-			var msglen int
+			var v int32
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -25906,25 +28203,32 @@ func (m *MonoVertex) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			m.TTLSecondsAfterFinished = &v
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BackoffLimit", wireType)
 			}
-			if err := m.Status.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			var v int32
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
+			m.BackoffLimit = &v
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -25946,7 +28250,7 @@ func (m *MonoVertex) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MonoVertexLifecycle) Unmarshal(dAtA []byte) error {
+func (m *KafkaSink) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -25969,15 +28273,15 @@ func (m *MonoVertexLifecycle) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MonoVertexLifecycle: wiretype end group for non-group")
+			return fmt.Errorf("proto: KafkaSink: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MonoVertexLifecycle: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: KafkaSink: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DesiredPhase", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Brokers", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -26005,63 +28309,101 @@ func (m *MonoVertexLifecycle) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DesiredPhase = MonoVertexPhase(dAtA[iNdEx:postIndex])
+			m.Brokers = append(m.Brokers, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Topic", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			if (iNdEx + skippy) > l {
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *MonoVertexLimits) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
+			m.Topic = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SetKey", wireType)
 			}
-			if iNdEx >= l {
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.SetKey = bool(v != 0)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TLS", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if m.TLS == nil {
+				m.TLS = &TLS{}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: MonoVertexLimits: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MonoVertexLimits: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ReadBatchSize", wireType)
+			if err := m.TLS.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			var v uint64
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Config", wireType)
+			}
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -26071,15 +28413,27 @@ func (m *MonoVertexLimits) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.ReadBatchSize = &v
-		case 2:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Config = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ReadTimeout", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SASL", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -26106,10 +28460,10 @@ func (m *MonoVertexLimits) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ReadTimeout == nil {
-				m.ReadTimeout = &v11.Duration{}
+			if m.SASL == nil {
+				m.SASL = &SASL{}
 			}
-			if err := m.ReadTimeout.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.SASL.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -26119,55 +28473,2246 @@ func (m *MonoVertexLimits) Unmarshal(dAtA []byte) error {
 			if err != nil {
 				return err
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *KafkaSource) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: KafkaSource: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: KafkaSource: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Brokers", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Brokers = append(m.Brokers, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Topic", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Topic = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsumerGroupName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConsumerGroupName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TLS", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.TLS == nil {
+				m.TLS = &TLS{}
+			}
+			if err := m.TLS.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Config", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Config = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SASL", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.SASL == nil {
+				m.SASL = &SASL{}
+			}
+			if err := m.SASL.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KafkaVersion", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.KafkaVersion = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *LateDataOutput) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LateDataOutput: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LateDataOutput: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ToVertex", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ToVertex = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fallback", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Fallback = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Lifecycle) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Lifecycle: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Lifecycle: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeletionGracePeriodSeconds", wireType)
+			}
+			var v int64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.DeletionGracePeriodSeconds = &v
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DesiredPhase", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DesiredPhase = PipelinePhase(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PauseGracePeriodSeconds", wireType)
+			}
+			var v int64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.PauseGracePeriodSeconds = &v
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeprecatedDeleteGracePeriodSeconds", wireType)
+			}
+			var v int64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.DeprecatedDeleteGracePeriodSeconds = &v
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Log) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Log: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Log: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Metadata) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Metadata: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Metadata: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Annotations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Annotations == nil {
+				m.Annotations = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenerated
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipGenerated(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Annotations[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Labels", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Labels == nil {
+				m.Labels = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenerated
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipGenerated(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Labels[mapkey] = mapvalue
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MonoVertex) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MonoVertex: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MonoVertex: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectMeta", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ObjectMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Spec", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Spec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Status.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MonoVertexLifecycle) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MonoVertexLifecycle: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MonoVertexLifecycle: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DesiredPhase", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DesiredPhase = MonoVertexPhase(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MonoVertexLimits) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MonoVertexLimits: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MonoVertexLimits: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReadBatchSize", wireType)
+			}
+			var v uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ReadBatchSize = &v
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReadTimeout", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ReadTimeout == nil {
+				m.ReadTimeout = &v11.Duration{}
+			}
+			if err := m.ReadTimeout.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MonoVertexList) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MonoVertexList: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MonoVertexList: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ListMeta", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ListMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Items = append(m.Items, MonoVertex{})
+			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MonoVertexSpec) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MonoVertexSpec: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MonoVertexSpec: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Replicas", wireType)
+			}
+			var v int32
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Replicas = &v
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Source", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Source == nil {
+				m.Source = &Source{}
+			}
+			if err := m.Source.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sink", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Sink == nil {
+				m.Sink = &Sink{}
+			}
+			if err := m.Sink.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AbstractPodTemplate", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.AbstractPodTemplate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContainerTemplate", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ContainerTemplate == nil {
+				m.ContainerTemplate = &ContainerTemplate{}
+			}
+			if err := m.ContainerTemplate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Volumes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Volumes = append(m.Volumes, v1.Volume{})
+			if err := m.Volumes[len(m.Volumes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Limits", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Limits == nil {
+				m.Limits = &MonoVertexLimits{}
+			}
+			if err := m.Limits.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Scale", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Scale.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InitContainers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.InitContainers = append(m.InitContainers, v1.Container{})
+			if err := m.InitContainers[len(m.InitContainers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sidecars", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sidecars = append(m.Sidecars, v1.Container{})
+			if err := m.Sidecars[len(m.Sidecars)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DaemonTemplate", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.DaemonTemplate == nil {
+				m.DaemonTemplate = &DaemonTemplate{}
+			}
+			if err := m.DaemonTemplate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdateStrategy", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.UpdateStrategy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Lifecycle", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Lifecycle.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MonoVertexStatus) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MonoVertexStatus: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MonoVertexStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Status.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Phase", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Phase = MonoVertexPhase(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Replicas", wireType)
+			}
+			m.Replicas = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Replicas |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DesiredReplicas", wireType)
+			}
+			m.DesiredReplicas = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DesiredReplicas |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Selector", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Selector = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Message = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastUpdated", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.LastUpdated.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastScaledAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.LastScaledAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ObservedGeneration", wireType)
+			}
+			m.ObservedGeneration = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ObservedGeneration |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReadyReplicas", wireType)
+			}
+			m.ReadyReplicas = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ReadyReplicas |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdatedReplicas", wireType)
+			}
+			m.UpdatedReplicas = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UpdatedReplicas |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdatedReadyReplicas", wireType)
+			}
+			m.UpdatedReadyReplicas = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UpdatedReadyReplicas |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrentHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *MonoVertexList) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: MonoVertexList: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MonoVertexList: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.CurrentHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 15:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ListMeta", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdateHash", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -26177,30 +30722,29 @@ func (m *MonoVertexList) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ListMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.UpdateHash = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 16:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ActiveScaleSchedule", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -26210,25 +30754,23 @@ func (m *MonoVertexList) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Items = append(m.Items, MonoVertex{})
-			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ActiveScaleSchedule = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -26251,7 +30793,7 @@ func (m *MonoVertexList) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MonoVertexSpec) Unmarshal(dAtA []byte) error {
+func (m *NativeRedis) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -26274,17 +30816,17 @@ func (m *MonoVertexSpec) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MonoVertexSpec: wiretype end group for non-group")
+			return fmt.Errorf("proto: NativeRedis: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MonoVertexSpec: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: NativeRedis: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Replicas", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
 			}
-			var v int32
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -26294,17 +30836,29 @@ func (m *MonoVertexSpec) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int32(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Replicas = &v
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Version = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Source", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Replicas", wireType)
 			}
-			var msglen int
+			var v int32
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -26314,31 +30868,15 @@ func (m *MonoVertexSpec) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Source == nil {
-				m.Source = &Source{}
-			}
-			if err := m.Source.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
+			m.Replicas = &v
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sink", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RedisContainerTemplate", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -26365,16 +30903,16 @@ func (m *MonoVertexSpec) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Sink == nil {
-				m.Sink = &Sink{}
+			if m.RedisContainerTemplate == nil {
+				m.RedisContainerTemplate = &ContainerTemplate{}
 			}
-			if err := m.Sink.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.RedisContainerTemplate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AbstractPodTemplate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SentinelContainerTemplate", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -26401,13 +30939,16 @@ func (m *MonoVertexSpec) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.AbstractPodTemplate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.SentinelContainerTemplate == nil {
+				m.SentinelContainerTemplate = &ContainerTemplate{}
+			}
+			if err := m.SentinelContainerTemplate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ContainerTemplate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MetricsContainerTemplate", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -26434,16 +30975,16 @@ func (m *MonoVertexSpec) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ContainerTemplate == nil {
-				m.ContainerTemplate = &ContainerTemplate{}
+			if m.MetricsContainerTemplate == nil {
+				m.MetricsContainerTemplate = &ContainerTemplate{}
 			}
-			if err := m.ContainerTemplate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.MetricsContainerTemplate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Volumes", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field InitContainerTemplate", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -26470,14 +31011,16 @@ func (m *MonoVertexSpec) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Volumes = append(m.Volumes, v1.Volume{})
-			if err := m.Volumes[len(m.Volumes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.InitContainerTemplate == nil {
+				m.InitContainerTemplate = &ContainerTemplate{}
+			}
+			if err := m.InitContainerTemplate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Limits", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Persistence", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -26504,16 +31047,16 @@ func (m *MonoVertexSpec) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Limits == nil {
-				m.Limits = &MonoVertexLimits{}
+			if m.Persistence == nil {
+				m.Persistence = &PersistenceStrategy{}
 			}
-			if err := m.Limits.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Persistence.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Scale", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AbstractPodTemplate", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -26540,13 +31083,13 @@ func (m *MonoVertexSpec) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Scale.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.AbstractPodTemplate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InitContainers", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Settings", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -26573,48 +31116,66 @@ func (m *MonoVertexSpec) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.InitContainers = append(m.InitContainers, v1.Container{})
-			if err := m.InitContainers[len(m.InitContainers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Settings == nil {
+				m.Settings = &RedisSettings{}
+			}
+			if err := m.Settings.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 10:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sidecars", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			if msglen < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
-			if postIndex > l {
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *NatsAuth) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Sidecars = append(m.Sidecars, v1.Container{})
-			if err := m.Sidecars[len(m.Sidecars)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			iNdEx = postIndex
-		case 11:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: NatsAuth: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: NatsAuth: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DaemonTemplate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Basic", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -26641,16 +31202,16 @@ func (m *MonoVertexSpec) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.DaemonTemplate == nil {
-				m.DaemonTemplate = &DaemonTemplate{}
+			if m.Basic == nil {
+				m.Basic = &BasicAuth{}
 			}
-			if err := m.DaemonTemplate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Basic.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 12:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UpdateStrategy", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Token", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -26677,13 +31238,16 @@ func (m *MonoVertexSpec) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.UpdateStrategy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Token == nil {
+				m.Token = &v1.SecretKeySelector{}
+			}
+			if err := m.Token.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 13:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Lifecycle", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NKey", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -26710,7 +31274,10 @@ func (m *MonoVertexSpec) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Lifecycle.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.NKey == nil {
+				m.NKey = &v1.SecretKeySelector{}
+			}
+			if err := m.NKey.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -26735,7 +31302,7 @@ func (m *MonoVertexSpec) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MonoVertexStatus) Unmarshal(dAtA []byte) error {
+func (m *NatsSource) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -26758,48 +31325,15 @@ func (m *MonoVertexStatus) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MonoVertexStatus: wiretype end group for non-group")
+			return fmt.Errorf("proto: NatsSource: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MonoVertexStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: NatsSource: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.Status.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Phase", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field URL", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -26827,49 +31361,11 @@ func (m *MonoVertexStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Phase = MonoVertexPhase(dAtA[iNdEx:postIndex])
+			m.URL = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Replicas", wireType)
-			}
-			m.Replicas = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Replicas |= uint32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DesiredReplicas", wireType)
-			}
-			m.DesiredReplicas = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.DesiredReplicas |= uint32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 5:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Selector", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Subject", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -26897,11 +31393,11 @@ func (m *MonoVertexStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Selector = string(dAtA[iNdEx:postIndex])
+			m.Subject = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Queue", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -26929,13 +31425,13 @@ func (m *MonoVertexStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Reason = string(dAtA[iNdEx:postIndex])
+			m.Queue = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 7:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TLS", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -26945,27 +31441,31 @@ func (m *MonoVertexStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Message = string(dAtA[iNdEx:postIndex])
+			if m.TLS == nil {
+				m.TLS = &TLS{}
+			}
+			if err := m.TLS.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 8:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LastUpdated", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Auth", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -26992,48 +31492,118 @@ func (m *MonoVertexStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.LastUpdated.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Auth == nil {
+				m.Auth = &NatsAuth{}
+			}
+			if err := m.Auth.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 9:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LastScaledAt", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			if msglen < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
-			if postIndex > l {
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *NoStore) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.LastScaledAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: NoStore: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: NoStore: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
 				return err
 			}
-			iNdEx = postIndex
-		case 10:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ObservedGeneration", wireType)
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ObjectStoreStorage) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			m.ObservedGeneration = 0
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ObjectStoreStorage: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ObjectStoreStorage: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Bucket", wireType)
+			}
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -27043,35 +31613,29 @@ func (m *MonoVertexStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ObservedGeneration |= int64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 11:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ReadyReplicas", wireType)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
 			}
-			m.ReadyReplicas = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.ReadyReplicas |= uint32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
 			}
-		case 12:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UpdatedReplicas", wireType)
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
-			m.UpdatedReplicas = 0
+			m.Bucket = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Prefix", wireType)
+			}
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -27081,33 +31645,27 @@ func (m *MonoVertexStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.UpdatedReplicas |= uint32(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 13:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UpdatedReadyReplicas", wireType)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
 			}
-			m.UpdatedReadyReplicas = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.UpdatedReadyReplicas |= uint32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
 			}
-		case 14:
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Prefix = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CurrentHash", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Region", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -27135,11 +31693,11 @@ func (m *MonoVertexStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CurrentHash = string(dAtA[iNdEx:postIndex])
+			m.Region = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 15:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UpdateHash", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EndpointURL", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -27167,8 +31725,29 @@ func (m *MonoVertexStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.UpdateHash = string(dAtA[iNdEx:postIndex])
+			s := string(dAtA[iNdEx:postIndex])
+			m.EndpointURL = &s
 			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UsePathStyle", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.UsePathStyle = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -27190,7 +31769,7 @@ func (m *MonoVertexStatus) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *NativeRedis) Unmarshal(dAtA []byte) error {
+func (m *PBQStorage) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -27213,17 +31792,17 @@ func (m *NativeRedis) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: NativeRedis: wiretype end group for non-group")
+			return fmt.Errorf("proto: PBQStorage: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: NativeRedis: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PBQStorage: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PersistentVolumeClaim", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -27233,47 +31812,31 @@ func (m *NativeRedis) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Version = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Replicas", wireType)
+			if m.PersistentVolumeClaim == nil {
+				m.PersistentVolumeClaim = &PersistenceStrategy{}
 			}
-			var v int32
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if err := m.PersistentVolumeClaim.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.Replicas = &v
-		case 3:
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RedisContainerTemplate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EmptyDir", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -27300,16 +31863,16 @@ func (m *NativeRedis) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.RedisContainerTemplate == nil {
-				m.RedisContainerTemplate = &ContainerTemplate{}
+			if m.EmptyDir == nil {
+				m.EmptyDir = &v1.EmptyDirVolumeSource{}
 			}
-			if err := m.RedisContainerTemplate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.EmptyDir.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SentinelContainerTemplate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NoStore", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -27336,16 +31899,16 @@ func (m *NativeRedis) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.SentinelContainerTemplate == nil {
-				m.SentinelContainerTemplate = &ContainerTemplate{}
+			if m.NoStore == nil {
+				m.NoStore = &NoStore{}
 			}
-			if err := m.SentinelContainerTemplate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.NoStore.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MetricsContainerTemplate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectStore", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -27372,54 +31935,68 @@ func (m *NativeRedis) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.MetricsContainerTemplate == nil {
-				m.MetricsContainerTemplate = &ContainerTemplate{}
+			if m.ObjectStore == nil {
+				m.ObjectStore = &ObjectStoreStorage{}
 			}
-			if err := m.MetricsContainerTemplate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ObjectStore.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 6:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InitContainerTemplate", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.InitContainerTemplate == nil {
-				m.InitContainerTemplate = &ContainerTemplate{}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PersistenceStrategy) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
 			}
-			if err := m.InitContainerTemplate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			iNdEx = postIndex
-		case 7:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PersistenceStrategy: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PersistenceStrategy: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Persistence", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StorageClassName", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -27429,33 +32006,30 @@ func (m *NativeRedis) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Persistence == nil {
-				m.Persistence = &PersistenceStrategy{}
-			}
-			if err := m.Persistence.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.StorageClassName = &s
 			iNdEx = postIndex
-		case 8:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AbstractPodTemplate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AccessMode", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -27465,28 +32039,28 @@ func (m *NativeRedis) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.AbstractPodTemplate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			s := k8s_io_api_core_v1.PersistentVolumeAccessMode(dAtA[iNdEx:postIndex])
+			m.AccessMode = &s
 			iNdEx = postIndex
-		case 9:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Settings", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field VolumeSize", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -27513,10 +32087,10 @@ func (m *NativeRedis) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Settings == nil {
-				m.Settings = &RedisSettings{}
+			if m.VolumeSize == nil {
+				m.VolumeSize = &resource.Quantity{}
 			}
-			if err := m.Settings.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.VolumeSize.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -27541,7 +32115,7 @@ func (m *NativeRedis) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *NatsAuth) Unmarshal(dAtA []byte) error {
+func (m *Pipeline) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -27564,15 +32138,15 @@ func (m *NatsAuth) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: NatsAuth: wiretype end group for non-group")
+			return fmt.Errorf("proto: Pipeline: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: NatsAuth: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Pipeline: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Basic", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectMeta", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -27599,16 +32173,13 @@ func (m *NatsAuth) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Basic == nil {
-				m.Basic = &BasicAuth{}
-			}
-			if err := m.Basic.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ObjectMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Token", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Spec", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -27635,16 +32206,13 @@ func (m *NatsAuth) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Token == nil {
-				m.Token = &v1.SecretKeySelector{}
-			}
-			if err := m.Token.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Spec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NKey", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -27671,10 +32239,7 @@ func (m *NatsAuth) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.NKey == nil {
-				m.NKey = &v1.SecretKeySelector{}
-			}
-			if err := m.NKey.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Status.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -27699,7 +32264,7 @@ func (m *NatsAuth) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *NatsSource) Unmarshal(dAtA []byte) error {
+func (m *PipelineLimits) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -27722,17 +32287,17 @@ func (m *NatsSource) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: NatsSource: wiretype end group for non-group")
+			return fmt.Errorf("proto: PipelineLimits: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: NatsSource: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PipelineLimits: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field URL", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReadBatchSize", wireType)
 			}
-			var stringLen uint64
+			var v uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -27742,29 +32307,17 @@ func (m *NatsSource) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.URL = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
+			m.ReadBatchSize = &v
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Subject", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BufferMaxLength", wireType)
 			}
-			var stringLen uint64
+			var v uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -27774,29 +32327,17 @@ func (m *NatsSource) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Subject = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
+			m.BufferMaxLength = &v
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Queue", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BufferUsageLimit", wireType)
 			}
-			var stringLen uint64
+			var v uint32
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -27806,63 +32347,15 @@ func (m *NatsSource) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Queue = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
+			m.BufferUsageLimit = &v
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TLS", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.TLS == nil {
-				m.TLS = &TLS{}
-			}
-			if err := m.TLS.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Auth", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ReadTimeout", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -27889,10 +32382,10 @@ func (m *NatsSource) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Auth == nil {
-				m.Auth = &NatsAuth{}
+			if m.ReadTimeout == nil {
+				m.ReadTimeout = &v11.Duration{}
 			}
-			if err := m.Auth.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ReadTimeout.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -27917,7 +32410,7 @@ func (m *NatsSource) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *NoStore) Unmarshal(dAtA []byte) error {
+func (m *PipelineList) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -27940,12 +32433,79 @@ func (m *NoStore) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: NoStore: wiretype end group for non-group")
+			return fmt.Errorf("proto: PipelineList: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: NoStore: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PipelineList: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ListMeta", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ListMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Items = append(m.Items, Pipeline{})
+			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -27967,7 +32527,7 @@ func (m *NoStore) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PBQStorage) Unmarshal(dAtA []byte) error {
+func (m *PipelineSpec) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -27990,17 +32550,17 @@ func (m *PBQStorage) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PBQStorage: wiretype end group for non-group")
+			return fmt.Errorf("proto: PipelineSpec: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PBQStorage: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PipelineSpec: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PersistentVolumeClaim", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field InterStepBufferServiceName", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -28010,31 +32570,27 @@ func (m *PBQStorage) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.PersistentVolumeClaim == nil {
-				m.PersistentVolumeClaim = &PersistenceStrategy{}
-			}
-			if err := m.PersistentVolumeClaim.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.InterStepBufferServiceName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EmptyDir", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Vertices", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -28061,16 +32617,14 @@ func (m *PBQStorage) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.EmptyDir == nil {
-				m.EmptyDir = &v1.EmptyDirVolumeSource{}
-			}
-			if err := m.EmptyDir.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Vertices = append(m.Vertices, AbstractVertex{})
+			if err := m.Vertices[len(m.Vertices)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NoStore", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Edges", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -28097,68 +32651,49 @@ func (m *PBQStorage) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.NoStore == nil {
-				m.NoStore = &NoStore{}
-			}
-			if err := m.NoStore.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Edges = append(m.Edges, Edge{})
+			if err := m.Edges[len(m.Edges)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Lifecycle", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *PersistenceStrategy) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if err := m.Lifecycle.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: PersistenceStrategy: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PersistenceStrategy: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StorageClassName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Limits", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -28168,30 +32703,33 @@ func (m *PersistenceStrategy) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			s := string(dAtA[iNdEx:postIndex])
-			m.StorageClassName = &s
+			if m.Limits == nil {
+				m.Limits = &PipelineLimits{}
+			}
+			if err := m.Limits.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AccessMode", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Watermark", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -28201,28 +32739,28 @@ func (m *PersistenceStrategy) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			s := k8s_io_api_core_v1.PersistentVolumeAccessMode(dAtA[iNdEx:postIndex])
-			m.AccessMode = &s
+			if err := m.Watermark.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 3:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VolumeSize", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Templates", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -28249,66 +32787,16 @@ func (m *PersistenceStrategy) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.VolumeSize == nil {
-				m.VolumeSize = &resource.Quantity{}
+			if m.Templates == nil {
+				m.Templates = &Templates{}
 			}
-			if err := m.VolumeSize.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Templates.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *Pipeline) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Pipeline: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Pipeline: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ObjectMeta", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SideInputs", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -28335,13 +32823,14 @@ func (m *Pipeline) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ObjectMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.SideInputs = append(m.SideInputs, SideInput{})
+			if err := m.SideInputs[len(m.SideInputs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Spec", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field InterStepBuffer", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -28368,13 +32857,16 @@ func (m *Pipeline) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Spec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.InterStepBuffer == nil {
+				m.InterStepBuffer = &InterStepBuffer{}
+			}
+			if err := m.InterStepBuffer.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		case 10:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field UpgradeStrategy", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -28401,7 +32893,10 @@ func (m *Pipeline) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Status.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.UpgradeStrategy == nil {
+				m.UpgradeStrategy = &PipelineUpgradeStrategy{}
+			}
+			if err := m.UpgradeStrategy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -28426,7 +32921,7 @@ func (m *Pipeline) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PipelineLimits) Unmarshal(dAtA []byte) error {
+func (m *PipelineStatus) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -28449,17 +32944,17 @@ func (m *PipelineLimits) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PipelineLimits: wiretype end group for non-group")
+			return fmt.Errorf("proto: PipelineStatus: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PipelineLimits: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PipelineStatus: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ReadBatchSize", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
 			}
-			var v uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -28469,17 +32964,30 @@ func (m *PipelineLimits) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.ReadBatchSize = &v
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Status.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BufferMaxLength", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Phase", wireType)
 			}
-			var v uint64
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -28489,17 +32997,29 @@ func (m *PipelineLimits) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.BufferMaxLength = &v
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Phase = PipelinePhase(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BufferUsageLimit", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
 			}
-			var v uint32
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -28509,15 +33029,27 @@ func (m *PipelineLimits) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= uint32(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.BufferUsageLimit = &v
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Message = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ReadTimeout", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LastUpdated", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -28544,68 +33076,75 @@ func (m *PipelineLimits) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ReadTimeout == nil {
-				m.ReadTimeout = &v11.Duration{}
-			}
-			if err := m.ReadTimeout.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.LastUpdated.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VertexCount", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
+			var v uint32
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			m.VertexCount = &v
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SourceCount", wireType)
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *PipelineList) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
+			var v uint32
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			m.SourceCount = &v
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SinkCount", wireType)
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			var v uint32
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: PipelineList: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PipelineList: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ListMeta", wireType)
+			m.SinkCount = &v
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UDFCount", wireType)
 			}
-			var msglen int
+			var v uint32
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -28615,28 +33154,94 @@ func (m *PipelineList) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
+			m.UDFCount = &v
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MapUDFCount", wireType)
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
+			var v uint32
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			m.MapUDFCount = &v
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReduceUDFCount", wireType)
 			}
-			if err := m.ListMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			var v uint32
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
-		case 2:
+			m.ReduceUDFCount = &v
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ObservedGeneration", wireType)
+			}
+			m.ObservedGeneration = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ObservedGeneration |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DrainedOnPause", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.DrainedOnPause = bool(v != 0)
+		case 13:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Upgrade", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -28663,8 +33268,10 @@ func (m *PipelineList) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Items = append(m.Items, Pipeline{})
-			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Upgrade == nil {
+				m.Upgrade = &PipelineUpgradeStatus{}
+			}
+			if err := m.Upgrade.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -28689,7 +33296,7 @@ func (m *PipelineList) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PipelineSpec) Unmarshal(dAtA []byte) error {
+func (m *PipelineUpgradeStatus) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -28712,15 +33319,15 @@ func (m *PipelineSpec) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PipelineSpec: wiretype end group for non-group")
+			return fmt.Errorf("proto: PipelineUpgradeStatus: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PipelineSpec: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PipelineUpgradeStatus: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InterStepBufferServiceName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Phase", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -28748,13 +33355,13 @@ func (m *PipelineSpec) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.InterStepBufferServiceName = string(dAtA[iNdEx:postIndex])
+			m.Phase = PipelineUpgradePhase(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Vertices", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetHash", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -28764,31 +33371,29 @@ func (m *PipelineSpec) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Vertices = append(m.Vertices, AbstractVertex{})
-			if err := m.Vertices[len(m.Vertices)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.TargetHash = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Edges", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ShadowPipeline", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -28798,31 +33403,29 @@ func (m *PipelineSpec) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Edges = append(m.Edges, Edge{})
-			if err := m.Edges[len(m.Edges)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ShadowPipeline = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Lifecycle", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -28832,28 +33435,27 @@ func (m *PipelineSpec) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Lifecycle.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Message = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Limits", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StartedAt", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -28880,16 +33482,13 @@ func (m *PipelineSpec) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Limits == nil {
-				m.Limits = &PipelineLimits{}
-			}
-			if err := m.Limits.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.StartedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Watermark", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LastTransitionTime", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -28916,51 +33515,65 @@ func (m *PipelineSpec) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Watermark.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.LastTransitionTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 7:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Templates", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Templates == nil {
-				m.Templates = &Templates{}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PipelineUpgradeStrategy) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
 			}
-			if err := m.Templates.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			iNdEx = postIndex
-		case 8:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PipelineUpgradeStrategy: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PipelineUpgradeStrategy: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SideInputs", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -28970,31 +33583,29 @@ func (m *PipelineSpec) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SideInputs = append(m.SideInputs, SideInput{})
-			if err := m.SideInputs[len(m.SideInputs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Type = PipelineUpgradeStrategyType(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 9:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InterStepBuffer", wireType)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProgressDeadlineSeconds", wireType)
 			}
-			var msglen int
+			var v int64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -29004,28 +33615,12 @@ func (m *PipelineSpec) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.InterStepBuffer == nil {
-				m.InterStepBuffer = &InterStepBuffer{}
-			}
-			if err := m.InterStepBuffer.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
+			m.ProgressDeadlineSeconds = &v
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -29047,7 +33642,7 @@ func (m *PipelineSpec) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PipelineStatus) Unmarshal(dAtA []byte) error {
+func (m *Ports) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -29070,17 +33665,17 @@ func (m *PipelineStatus) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PipelineStatus: wiretype end group for non-group")
+			return fmt.Errorf("proto: Ports: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PipelineStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Ports: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HTTPS", wireType)
 			}
-			var msglen int
+			var v int32
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -29090,30 +33685,17 @@ func (m *PipelineStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.Status.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
+			m.HTTPS = &v
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Phase", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HTTP", wireType)
 			}
-			var stringLen uint64
+			var v int32
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -29123,29 +33705,67 @@ func (m *PipelineStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
+			m.HTTP = &v
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Phase = PipelinePhase(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PredictiveScalePolicy) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
 			}
-			var stringLen uint64
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PredictiveScalePolicy: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PredictiveScalePolicy: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LookaheadSeconds", wireType)
+			}
+			var v uint32
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -29155,29 +33775,17 @@ func (m *PipelineStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Message = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LastUpdated", wireType)
+			m.LookaheadSeconds = &v
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PeriodSeconds", wireType)
 			}
-			var msglen int
+			var v uint32
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -29187,50 +33795,67 @@ func (m *PipelineStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
+			m.PeriodSeconds = &v
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.LastUpdated.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Probe) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
 			}
-			iNdEx = postIndex
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VertexCount", wireType)
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			var v uint32
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= uint32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			m.VertexCount = &v
-		case 6:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Probe: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Probe: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SourceCount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field InitialDelaySeconds", wireType)
 			}
-			var v uint32
+			var v int32
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -29240,17 +33865,17 @@ func (m *PipelineStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= uint32(b&0x7F) << shift
+				v |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.SourceCount = &v
-		case 7:
+			m.InitialDelaySeconds = &v
+		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SinkCount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeoutSeconds", wireType)
 			}
-			var v uint32
+			var v int32
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -29260,17 +33885,17 @@ func (m *PipelineStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= uint32(b&0x7F) << shift
+				v |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.SinkCount = &v
-		case 8:
+			m.TimeoutSeconds = &v
+		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UDFCount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PeriodSeconds", wireType)
 			}
-			var v uint32
+			var v int32
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -29280,17 +33905,17 @@ func (m *PipelineStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= uint32(b&0x7F) << shift
+				v |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.UDFCount = &v
-		case 9:
+			m.PeriodSeconds = &v
+		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MapUDFCount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SuccessThreshold", wireType)
 			}
-			var v uint32
+			var v int32
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -29300,17 +33925,17 @@ func (m *PipelineStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= uint32(b&0x7F) << shift
+				v |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.MapUDFCount = &v
-		case 10:
+			m.SuccessThreshold = &v
+		case 5:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ReduceUDFCount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FailureThreshold", wireType)
 			}
-			var v uint32
+			var v int32
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -29320,17 +33945,67 @@ func (m *PipelineStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= uint32(b&0x7F) << shift
+				v |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.ReduceUDFCount = &v
-		case 11:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ObservedGeneration", wireType)
+			m.FailureThreshold = &v
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			m.ObservedGeneration = 0
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PulsarAuth) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PulsarAuth: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PulsarAuth: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Token", wireType)
+			}
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -29340,16 +34015,33 @@ func (m *PipelineStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ObservedGeneration |= int64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 12:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DrainedOnPause", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
 			}
-			var v int
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Token == nil {
+				m.Token = &v1.SecretKeySelector{}
+			}
+			if err := m.Token.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BasicAuth", wireType)
+			}
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -29359,12 +34051,28 @@ func (m *PipelineStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.DrainedOnPause = bool(v != 0)
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.BasicAuth == nil {
+				m.BasicAuth = &PulsarBasicAuth{}
+			}
+			if err := m.BasicAuth.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -29386,7 +34094,7 @@ func (m *PipelineStatus) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Ports) Unmarshal(dAtA []byte) error {
+func (m *PulsarBasicAuth) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -29409,17 +34117,17 @@ func (m *Ports) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Ports: wiretype end group for non-group")
+			return fmt.Errorf("proto: PulsarBasicAuth: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Ports: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PulsarBasicAuth: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HTTPS", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Username", wireType)
 			}
-			var v int32
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -29429,17 +34137,33 @@ func (m *Ports) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int32(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.HTTPS = &v
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Username == nil {
+				m.Username = &v1.SecretKeySelector{}
+			}
+			if err := m.Username.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HTTP", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Password", wireType)
 			}
-			var v int32
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -29449,12 +34173,28 @@ func (m *Ports) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int32(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.HTTP = &v
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Password == nil {
+				m.Password = &v1.SecretKeySelector{}
+			}
+			if err := m.Password.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -29476,7 +34216,7 @@ func (m *Ports) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Probe) Unmarshal(dAtA []byte) error {
+func (m *PulsarSink) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -29499,17 +34239,17 @@ func (m *Probe) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Probe: wiretype end group for non-group")
+			return fmt.Errorf("proto: PulsarSink: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Probe: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PulsarSink: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InitialDelaySeconds", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ServerAddr", wireType)
 			}
-			var v int32
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -29519,17 +34259,29 @@ func (m *Probe) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int32(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.InitialDelaySeconds = &v
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ServerAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TimeoutSeconds", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Topic", wireType)
 			}
-			var v int32
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -29539,17 +34291,29 @@ func (m *Probe) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int32(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.TimeoutSeconds = &v
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Topic = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PeriodSeconds", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProducerName", wireType)
 			}
-			var v int32
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -29559,37 +34323,29 @@ func (m *Probe) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int32(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.PeriodSeconds = &v
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SuccessThreshold", wireType)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
 			}
-			var v int32
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
 			}
-			m.SuccessThreshold = &v
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FailureThreshold", wireType)
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
-			var v int32
+			m.ProducerName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Auth", wireType)
+			}
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -29599,12 +34355,28 @@ func (m *Probe) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int32(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.FailureThreshold = &v
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Auth == nil {
+				m.Auth = &PulsarAuth{}
+			}
+			if err := m.Auth.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -29626,7 +34398,7 @@ func (m *Probe) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PulsarAuth) Unmarshal(dAtA []byte) error {
+func (m *PulsarSource) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -29649,17 +34421,17 @@ func (m *PulsarAuth) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PulsarAuth: wiretype end group for non-group")
+			return fmt.Errorf("proto: PulsarSource: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PulsarAuth: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PulsarSource: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Token", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ServerAddr", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -29669,31 +34441,142 @@ func (m *PulsarAuth) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Token == nil {
-				m.Token = &v1.SecretKeySelector{}
+			m.ServerAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Topic", wireType)
 			}
-			if err := m.Token.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
 			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Topic = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BasicAuth", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsumerName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConsumerName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SubscriptionName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SubscriptionName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxUnack", wireType)
+			}
+			m.MaxUnack = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxUnack |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Auth", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -29720,10 +34603,10 @@ func (m *PulsarAuth) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.BasicAuth == nil {
-				m.BasicAuth = &PulsarBasicAuth{}
+			if m.Auth == nil {
+				m.Auth = &PulsarAuth{}
 			}
-			if err := m.BasicAuth.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Auth.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -29748,7 +34631,7 @@ func (m *PulsarAuth) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PulsarBasicAuth) Unmarshal(dAtA []byte) error {
+func (m *RedisBufferService) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -29771,15 +34654,15 @@ func (m *PulsarBasicAuth) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PulsarBasicAuth: wiretype end group for non-group")
+			return fmt.Errorf("proto: RedisBufferService: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PulsarBasicAuth: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RedisBufferService: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Username", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Native", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -29806,16 +34689,16 @@ func (m *PulsarBasicAuth) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Username == nil {
-				m.Username = &v1.SecretKeySelector{}
+			if m.Native == nil {
+				m.Native = &NativeRedis{}
 			}
-			if err := m.Username.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Native.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Password", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field External", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -29842,10 +34725,10 @@ func (m *PulsarBasicAuth) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Password == nil {
-				m.Password = &v1.SecretKeySelector{}
+			if m.External == nil {
+				m.External = &RedisConfig{}
 			}
-			if err := m.Password.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.External.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -29870,7 +34753,7 @@ func (m *PulsarBasicAuth) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PulsarSink) Unmarshal(dAtA []byte) error {
+func (m *RedisConfig) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -29893,15 +34776,15 @@ func (m *PulsarSink) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PulsarSink: wiretype end group for non-group")
+			return fmt.Errorf("proto: RedisConfig: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PulsarSink: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RedisConfig: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ServerAddr", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field URL", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -29929,11 +34812,11 @@ func (m *PulsarSink) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ServerAddr = string(dAtA[iNdEx:postIndex])
+			m.URL = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Topic", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SentinelURL", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -29961,11 +34844,11 @@ func (m *PulsarSink) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Topic = string(dAtA[iNdEx:postIndex])
+			m.SentinelURL = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProducerName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MasterName", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -29993,11 +34876,79 @@ func (m *PulsarSink) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ProducerName = string(dAtA[iNdEx:postIndex])
+			m.MasterName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field User", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.User = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Password", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Password == nil {
+				m.Password = &v1.SecretKeySelector{}
+			}
+			if err := m.Password.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Auth", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SentinelPassword", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -30024,10 +34975,10 @@ func (m *PulsarSink) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Auth == nil {
-				m.Auth = &PulsarAuth{}
+			if m.SentinelPassword == nil {
+				m.SentinelPassword = &v1.SecretKeySelector{}
 			}
-			if err := m.Auth.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.SentinelPassword.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -30052,7 +35003,7 @@ func (m *PulsarSink) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PulsarSource) Unmarshal(dAtA []byte) error {
+func (m *RedisSettings) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -30075,15 +35026,15 @@ func (m *PulsarSource) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PulsarSource: wiretype end group for non-group")
+			return fmt.Errorf("proto: RedisSettings: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PulsarSource: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RedisSettings: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ServerAddr", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Redis", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -30111,11 +35062,11 @@ func (m *PulsarSource) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ServerAddr = string(dAtA[iNdEx:postIndex])
+			m.Redis = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Topic", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Master", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -30143,77 +35094,13 @@ func (m *PulsarSource) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Topic = string(dAtA[iNdEx:postIndex])
+			m.Master = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ConsumerName", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ConsumerName = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SubscriptionName", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.SubscriptionName = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxUnack", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Replica", wireType)
 			}
-			m.MaxUnack = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -30223,16 +35110,29 @@ func (m *PulsarSource) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MaxUnack |= uint32(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 6:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Replica = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Auth", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Sentinel", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -30242,27 +35142,23 @@ func (m *PulsarSource) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Auth == nil {
-				m.Auth = &PulsarAuth{}
-			}
-			if err := m.Auth.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Sentinel = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -30285,7 +35181,7 @@ func (m *PulsarSource) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RedisBufferService) Unmarshal(dAtA []byte) error {
+func (m *RetryStrategy) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -30308,15 +35204,15 @@ func (m *RedisBufferService) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RedisBufferService: wiretype end group for non-group")
+			return fmt.Errorf("proto: RetryStrategy: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RedisBufferService: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RetryStrategy: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Native", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BackOff", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -30343,16 +35239,99 @@ func (m *RedisBufferService) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Native == nil {
-				m.Native = &NativeRedis{}
+			if m.BackOff == nil {
+				m.BackOff = &Backoff{}
 			}
-			if err := m.Native.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.BackOff.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field External", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OnFailure", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := OnFailureRetryStrategy(dAtA[iNdEx:postIndex])
+			m.OnFailure = &s
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RollingUpdateStrategy) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RollingUpdateStrategy: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RollingUpdateStrategy: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxUnavailable", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -30379,10 +35358,10 @@ func (m *RedisBufferService) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.External == nil {
-				m.External = &RedisConfig{}
+			if m.MaxUnavailable == nil {
+				m.MaxUnavailable = &intstr.IntOrString{}
 			}
-			if err := m.External.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.MaxUnavailable.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -30407,7 +35386,7 @@ func (m *RedisBufferService) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RedisConfig) Unmarshal(dAtA []byte) error {
+func (m *SASL) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -30430,15 +35409,15 @@ func (m *RedisConfig) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RedisConfig: wiretype end group for non-group")
+			return fmt.Errorf("proto: SASL: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RedisConfig: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SASL: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field URL", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Mechanism", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -30466,13 +35445,14 @@ func (m *RedisConfig) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.URL = string(dAtA[iNdEx:postIndex])
+			s := SASLType(dAtA[iNdEx:postIndex])
+			m.Mechanism = &s
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SentinelURL", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GSSAPI", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -30482,29 +35462,33 @@ func (m *RedisConfig) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SentinelURL = string(dAtA[iNdEx:postIndex])
+			if m.GSSAPI == nil {
+				m.GSSAPI = &GSSAPI{}
+			}
+			if err := m.GSSAPI.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MasterName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Plain", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -30514,29 +35498,33 @@ func (m *RedisConfig) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.MasterName = string(dAtA[iNdEx:postIndex])
+			if m.Plain == nil {
+				m.Plain = &SASLPlain{}
+			}
+			if err := m.Plain.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field User", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SCRAMSHA256", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -30546,27 +35534,31 @@ func (m *RedisConfig) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.User = string(dAtA[iNdEx:postIndex])
+			if m.SCRAMSHA256 == nil {
+				m.SCRAMSHA256 = &SASLPlain{}
+			}
+			if err := m.SCRAMSHA256.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Password", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SCRAMSHA512", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -30593,16 +35585,16 @@ func (m *RedisConfig) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Password == nil {
-				m.Password = &v1.SecretKeySelector{}
+			if m.SCRAMSHA512 == nil {
+				m.SCRAMSHA512 = &SASLPlain{}
 			}
-			if err := m.Password.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.SCRAMSHA512.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SentinelPassword", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OAuth", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -30629,10 +35621,10 @@ func (m *RedisConfig) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.SentinelPassword == nil {
-				m.SentinelPassword = &v1.SecretKeySelector{}
+			if m.OAuth == nil {
+				m.OAuth = &SASLOAuth{}
 			}
-			if err := m.SentinelPassword.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.OAuth.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -30657,7 +35649,7 @@ func (m *RedisConfig) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RedisSettings) Unmarshal(dAtA []byte) error {
+func (m *SASLOAuth) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -30680,17 +35672,17 @@ func (m *RedisSettings) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RedisSettings: wiretype end group for non-group")
+			return fmt.Errorf("proto: SASLOAuth: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RedisSettings: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SASLOAuth: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Redis", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientID", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -30700,29 +35692,33 @@ func (m *RedisSettings) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Redis = string(dAtA[iNdEx:postIndex])
+			if m.ClientID == nil {
+				m.ClientID = &v1.SecretKeySelector{}
+			}
+			if err := m.ClientID.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Master", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientSecret", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -30732,59 +35728,31 @@ func (m *RedisSettings) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Master = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Replica", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
+			if m.ClientSecret == nil {
+				m.ClientSecret = &v1.SecretKeySelector{}
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			if err := m.ClientSecret.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.Replica = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sentinel", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenEndpoint", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -30812,7 +35780,7 @@ func (m *RedisSettings) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Sentinel = string(dAtA[iNdEx:postIndex])
+			m.TokenEndpoint = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -30835,7 +35803,7 @@ func (m *RedisSettings) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RetryStrategy) Unmarshal(dAtA []byte) error {
+func (m *SASLPlain) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -30858,15 +35826,15 @@ func (m *RetryStrategy) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RetryStrategy: wiretype end group for non-group")
+			return fmt.Errorf("proto: SASLPlain: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RetryStrategy: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SASLPlain: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BackOff", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field UserSecret", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -30893,18 +35861,18 @@ func (m *RetryStrategy) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.BackOff == nil {
-				m.BackOff = &Backoff{}
+			if m.UserSecret == nil {
+				m.UserSecret = &v1.SecretKeySelector{}
 			}
-			if err := m.BackOff.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.UserSecret.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OnFailure", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PasswordSecret", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -30914,25 +35882,48 @@ func (m *RetryStrategy) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			s := OnFailureRetryStrategy(dAtA[iNdEx:postIndex])
-			m.OnFailure = &s
+			if m.PasswordSecret == nil {
+				m.PasswordSecret = &v1.SecretKeySelector{}
+			}
+			if err := m.PasswordSecret.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Handshake", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Handshake = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -30954,7 +35945,7 @@ func (m *RetryStrategy) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RollingUpdateStrategy) Unmarshal(dAtA []byte) error {
+func (m *Scale) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -30977,17 +35968,17 @@ func (m *RollingUpdateStrategy) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RollingUpdateStrategy: wiretype end group for non-group")
+			return fmt.Errorf("proto: Scale: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RollingUpdateStrategy: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Scale: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxUnavailable", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Disabled", wireType)
 			}
-			var msglen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -30997,83 +35988,117 @@ func (m *RollingUpdateStrategy) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			m.Disabled = bool(v != 0)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Min", wireType)
 			}
-			if m.MaxUnavailable == nil {
-				m.MaxUnavailable = &intstr.IntOrString{}
+			var v int32
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if err := m.MaxUnavailable.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Min = &v
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Max", wireType)
 			}
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
+			var v int32
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
+			m.Max = &v
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LookbackSeconds", wireType)
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			var v uint32
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *SASL) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
+			m.LookbackSeconds = &v
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ZeroReplicaSleepSeconds", wireType)
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			var v uint32
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.ZeroReplicaSleepSeconds = &v
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetProcessingSeconds", wireType)
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: SASL: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SASL: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Mechanism", wireType)
+			var v uint32
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			var stringLen uint64
+			m.TargetProcessingSeconds = &v
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetBufferAvailability", wireType)
+			}
+			var v uint32
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -31083,30 +36108,17 @@ func (m *SASL) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			s := SASLType(dAtA[iNdEx:postIndex])
-			m.Mechanism = &s
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GSSAPI", wireType)
+			m.TargetBufferAvailability = &v
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeprecatedReplicasPerScale", wireType)
 			}
-			var msglen int
+			var v uint32
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -31116,33 +36128,37 @@ func (m *SASL) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.GSSAPI == nil {
-				m.GSSAPI = &GSSAPI{}
+			m.DeprecatedReplicasPerScale = &v
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScaleUpCooldownSeconds", wireType)
 			}
-			if err := m.GSSAPI.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			var v uint32
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Plain", wireType)
+			m.ScaleUpCooldownSeconds = &v
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScaleDownCooldownSeconds", wireType)
 			}
-			var msglen int
+			var v uint32
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -31152,31 +36168,55 @@ func (m *SASL) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
+			m.ScaleDownCooldownSeconds = &v
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReplicasPerScaleUp", wireType)
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			var v uint32
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if m.Plain == nil {
-				m.Plain = &SASLPlain{}
+			m.ReplicasPerScaleUp = &v
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReplicasPerScaleDown", wireType)
 			}
-			if err := m.Plain.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			var v uint32
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
-		case 4:
+			m.ReplicasPerScaleDown = &v
+		case 13:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SCRAMSHA256", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Policy", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -31203,18 +36243,18 @@ func (m *SASL) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.SCRAMSHA256 == nil {
-				m.SCRAMSHA256 = &SASLPlain{}
+			if m.Policy == nil {
+				m.Policy = &ScalePolicy{}
 			}
-			if err := m.SCRAMSHA256.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Policy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SCRAMSHA512", wireType)
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DryRun", wireType)
 			}
-			var msglen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -31224,31 +36264,15 @@ func (m *SASL) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.SCRAMSHA512 == nil {
-				m.SCRAMSHA512 = &SASLPlain{}
-			}
-			if err := m.SCRAMSHA512.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 6:
+			m.DryRun = bool(v != 0)
+		case 15:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OAuth", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Schedules", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -31275,10 +36299,8 @@ func (m *SASL) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.OAuth == nil {
-				m.OAuth = &SASLOAuth{}
-			}
-			if err := m.OAuth.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Schedules = append(m.Schedules, ScaleSchedule{})
+			if err := m.Schedules[len(m.Schedules)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -31303,7 +36325,7 @@ func (m *SASL) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SASLOAuth) Unmarshal(dAtA []byte) error {
+func (m *ScalePolicy) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -31326,15 +36348,15 @@ func (m *SASLOAuth) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SASLOAuth: wiretype end group for non-group")
+			return fmt.Errorf("proto: ScalePolicy: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SASLOAuth: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ScalePolicy: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ClientID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetLag", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -31361,16 +36383,16 @@ func (m *SASLOAuth) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ClientID == nil {
-				m.ClientID = &v1.SecretKeySelector{}
+			if m.TargetLag == nil {
+				m.TargetLag = &TargetLagScalePolicy{}
 			}
-			if err := m.ClientID.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.TargetLag.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ClientSecret", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CPU", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -31397,18 +36419,18 @@ func (m *SASLOAuth) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ClientSecret == nil {
-				m.ClientSecret = &v1.SecretKeySelector{}
+			if m.CPU == nil {
+				m.CPU = &CPUScalePolicy{}
 			}
-			if err := m.ClientSecret.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.CPU.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TokenEndpoint", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Predictive", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -31418,23 +36440,27 @@ func (m *SASLOAuth) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TokenEndpoint = string(dAtA[iNdEx:postIndex])
+			if m.Predictive == nil {
+				m.Predictive = &PredictiveScalePolicy{}
+			}
+			if err := m.Predictive.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -31457,7 +36483,7 @@ func (m *SASLOAuth) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SASLPlain) Unmarshal(dAtA []byte) error {
+func (m *ScaleSchedule) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -31480,17 +36506,17 @@ func (m *SASLPlain) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SASLPlain: wiretype end group for non-group")
+			return fmt.Errorf("proto: ScaleSchedule: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SASLPlain: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ScaleSchedule: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UserSecret", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -31500,33 +36526,29 @@ func (m *SASLPlain) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.UserSecret == nil {
-				m.UserSecret = &v1.SecretKeySelector{}
-			}
-			if err := m.UserSecret.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PasswordSecret", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Start", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -31536,33 +36558,29 @@ func (m *SASLPlain) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.PasswordSecret == nil {
-				m.PasswordSecret = &v1.SecretKeySelector{}
-			}
-			if err := m.PasswordSecret.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Start = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Handshake", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Duration", wireType)
 			}
-			var v int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -31572,67 +36590,30 @@ func (m *SASLPlain) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Handshake = bool(v != 0)
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *Scale) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if err := m.Duration.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Scale: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Scale: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Disabled", wireType)
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeZone", wireType)
 			}
-			var v int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -31642,13 +36623,25 @@ func (m *Scale) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Disabled = bool(v != 0)
-		case 2:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TimeZone = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Min", wireType)
 			}
@@ -31668,7 +36661,7 @@ func (m *Scale) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.Min = &v
-		case 3:
+		case 6:
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Max", wireType)
 			}
@@ -31688,71 +36681,61 @@ func (m *Scale) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.Max = &v
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LookbackSeconds", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			var v uint32
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= uint32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
 			}
-			m.LookbackSeconds = &v
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ZeroReplicaSleepSeconds", wireType)
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
-			var v uint32
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= uint32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ScalingDecision) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
 			}
-			m.ZeroReplicaSleepSeconds = &v
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TargetProcessingSeconds", wireType)
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			var v uint32
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= uint32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			m.TargetProcessingSeconds = &v
-		case 7:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TargetBufferAvailability", wireType)
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ScalingDecision: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ScalingDecision: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Policy", wireType)
 			}
-			var v uint32
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -31762,37 +36745,29 @@ func (m *Scale) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= uint32(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.TargetBufferAvailability = &v
-		case 8:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DeprecatedReplicasPerScale", wireType)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
 			}
-			var v uint32
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= uint32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
 			}
-			m.DeprecatedReplicasPerScale = &v
-		case 9:
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Policy = ScalePolicyType(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ScaleUpCooldownSeconds", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Replicas", wireType)
 			}
-			var v uint32
+			m.Replicas = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -31802,17 +36777,16 @@ func (m *Scale) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= uint32(b&0x7F) << shift
+				m.Replicas |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.ScaleUpCooldownSeconds = &v
-		case 10:
+		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ScaleDownCooldownSeconds", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DesiredReplicas", wireType)
 			}
-			var v uint32
+			m.DesiredReplicas = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -31822,17 +36796,16 @@ func (m *Scale) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= uint32(b&0x7F) << shift
+				m.DesiredReplicas |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.ScaleDownCooldownSeconds = &v
-		case 11:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ReplicasPerScaleUp", wireType)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DecidedAt", wireType)
 			}
-			var v uint32
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -31842,32 +36815,25 @@ func (m *Scale) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= uint32(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.ReplicasPerScaleUp = &v
-		case 12:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ReplicasPerScaleDown", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
 			}
-			var v uint32
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= uint32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
 			}
-			m.ReplicasPerScaleDown = &v
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.DecidedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -32809,36 +37775,359 @@ func (m *ServingSpec) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ContainerTemplate == nil {
-				m.ContainerTemplate = &ContainerTemplate{}
+			if m.ContainerTemplate == nil {
+				m.ContainerTemplate = &ContainerTemplate{}
+			}
+			if err := m.ContainerTemplate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Replicas", wireType)
+			}
+			var v int32
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Replicas = &v
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AbstractPodTemplate", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.AbstractPodTemplate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ServingStore) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ServingStore: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ServingStore: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Container", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Container == nil {
+				m.Container = &Container{}
+			}
+			if err := m.Container.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SessionWindow) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SessionWindow: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SessionWindow: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timeout", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Timeout == nil {
+				m.Timeout = &v11.Duration{}
+			}
+			if err := m.Timeout.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SideInput) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SideInput: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SideInput: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Container", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Container == nil {
+				m.Container = &Container{}
 			}
-			if err := m.ContainerTemplate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Container.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 8:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Replicas", wireType)
-			}
-			var v int32
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.Replicas = &v
-		case 9:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AbstractPodTemplate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Volumes", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -32865,63 +38154,14 @@ func (m *ServingSpec) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.AbstractPodTemplate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Volumes = append(m.Volumes, v1.Volume{})
+			if err := m.Volumes[len(m.Volumes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ServingStore) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ServingStore: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ServingStore: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Container", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Trigger", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -32948,68 +38188,18 @@ func (m *ServingStore) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Container == nil {
-				m.Container = &Container{}
+			if m.Trigger == nil {
+				m.Trigger = &SideInputTrigger{}
 			}
-			if err := m.Container.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Trigger.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *SessionWindow) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: SessionWindow: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SessionWindow: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Timeout", wireType)
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RetainedVersions", wireType)
 			}
-			var msglen int
+			var v int32
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -33019,28 +38209,32 @@ func (m *SessionWindow) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Timeout == nil {
-				m.Timeout = &v11.Duration{}
+			m.RetainedVersions = &v
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PinnedVersion", wireType)
 			}
-			if err := m.Timeout.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			var v int64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
+			m.PinnedVersion = &v
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -33062,7 +38256,7 @@ func (m *SessionWindow) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SideInput) Unmarshal(dAtA []byte) error {
+func (m *SideInputTrigger) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -33085,15 +38279,15 @@ func (m *SideInput) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SideInput: wiretype end group for non-group")
+			return fmt.Errorf("proto: SideInputTrigger: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SideInput: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SideInputTrigger: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Schedule", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -33121,13 +38315,13 @@ func (m *SideInput) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.Schedule = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Container", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Timezone", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -33137,31 +38331,28 @@ func (m *SideInput) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Container == nil {
-				m.Container = &Container{}
-			}
-			if err := m.Container.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.Timezone = &s
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Volumes", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Watch", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -33188,14 +38379,16 @@ func (m *SideInput) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Volumes = append(m.Volumes, v1.Volume{})
-			if err := m.Volumes[len(m.Volumes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Watch == nil {
+				m.Watch = &SideInputWatch{}
+			}
+			if err := m.Watch.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Trigger", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Auth", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -33222,10 +38415,10 @@ func (m *SideInput) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Trigger == nil {
-				m.Trigger = &SideInputTrigger{}
+			if m.Auth == nil {
+				m.Auth = &Authorization{}
 			}
-			if err := m.Trigger.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Auth.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -33250,7 +38443,7 @@ func (m *SideInput) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SideInputTrigger) Unmarshal(dAtA []byte) error {
+func (m *SideInputWatch) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -33273,15 +38466,15 @@ func (m *SideInputTrigger) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SideInputTrigger: wiretype end group for non-group")
+			return fmt.Errorf("proto: SideInputWatch: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SideInputTrigger: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SideInputWatch: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Schedule", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ConfigMaps", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -33309,11 +38502,11 @@ func (m *SideInputTrigger) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Schedule = string(dAtA[iNdEx:postIndex])
+			m.ConfigMaps = append(m.ConfigMaps, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Timezone", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Secrets", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -33341,8 +38534,7 @@ func (m *SideInputTrigger) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			s := string(dAtA[iNdEx:postIndex])
-			m.Timezone = &s
+			m.Secrets = append(m.Secrets, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -33980,16 +39172,52 @@ func (m *Source) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Nats == nil {
-				m.Nats = &NatsSource{}
+			if m.Nats == nil {
+				m.Nats = &NatsSource{}
+			}
+			if err := m.Nats.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UDTransformer", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.UDTransformer == nil {
+				m.UDTransformer = &UDTransformer{}
 			}
-			if err := m.Nats.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.UDTransformer.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UDTransformer", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field UDSource", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -34016,16 +39244,16 @@ func (m *Source) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.UDTransformer == nil {
-				m.UDTransformer = &UDTransformer{}
+			if m.UDSource == nil {
+				m.UDSource = &UDSource{}
 			}
-			if err := m.UDTransformer.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.UDSource.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 6:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UDSource", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field JetStream", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -34052,16 +39280,16 @@ func (m *Source) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.UDSource == nil {
-				m.UDSource = &UDSource{}
+			if m.JetStream == nil {
+				m.JetStream = &JetStreamSource{}
 			}
-			if err := m.UDSource.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.JetStream.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 7:
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field JetStream", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Serving", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -34088,16 +39316,16 @@ func (m *Source) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.JetStream == nil {
-				m.JetStream = &JetStreamSource{}
+			if m.Serving == nil {
+				m.Serving = &ServingSource{}
 			}
-			if err := m.JetStream.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Serving.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 8:
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Serving", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pulsar", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -34124,16 +39352,16 @@ func (m *Source) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Serving == nil {
-				m.Serving = &ServingSource{}
+			if m.Pulsar == nil {
+				m.Pulsar = &PulsarSource{}
 			}
-			if err := m.Serving.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Pulsar.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 9:
+		case 10:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pulsar", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Sqs", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -34160,16 +39388,16 @@ func (m *Source) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pulsar == nil {
-				m.Pulsar = &PulsarSource{}
+			if m.Sqs == nil {
+				m.Sqs = &SqsSource{}
 			}
-			if err := m.Pulsar.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Sqs.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 10:
+		case 11:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sqs", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Dedup", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -34196,10 +39424,10 @@ func (m *Source) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Sqs == nil {
-				m.Sqs = &SqsSource{}
+			if m.Dedup == nil {
+				m.Dedup = &DedupWindow{}
 			}
-			if err := m.Sqs.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Dedup.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -34349,6 +39577,39 @@ func (m *SqsSink) Unmarshal(dAtA []byte) error {
 			}
 			m.QueueOwnerAWSAccountID = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndpointURL", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.EndpointURL = &s
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -35050,6 +40311,75 @@ func (m *TagConditions) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *TargetLagScalePolicy) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TargetLagScalePolicy: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TargetLagScalePolicy: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetLagSeconds", wireType)
+			}
+			m.TargetLagSeconds = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TargetLagSeconds |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *Templates) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -35309,6 +40639,42 @@ func (m *UDF) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Builtin", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Builtin == nil {
+				m.Builtin = &Function{}
+			}
+			if err := m.Builtin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field GroupBy", wireType)
@@ -35338,10 +40704,82 @@ func (m *UDF) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.GroupBy == nil {
-				m.GroupBy = &GroupBy{}
+			if m.GroupBy == nil {
+				m.GroupBy = &GroupBy{}
+			}
+			if err := m.GroupBy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RetryStrategy", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.RetryStrategy == nil {
+				m.RetryStrategy = &RetryStrategy{}
+			}
+			if err := m.RetryStrategy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeadLetter", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.DeadLetter == nil {
+				m.DeadLetter = &DeadLetterOutput{}
 			}
-			if err := m.GroupBy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.DeadLetter.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -37111,6 +42549,106 @@ func (m *VertexStatus) Unmarshal(dAtA []byte) error {
 			}
 			m.UpdateHash = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastScalingDecision", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.LastScalingDecision == nil {
+				m.LastScalingDecision = &ScalingDecision{}
+			}
+			if err := m.LastScalingDecision.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActiveScaleSchedule", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ActiveScaleSchedule = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ForecastUnavailableReason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ForecastUnavailableReason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -37602,6 +43140,184 @@ func (m *Window) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Count == nil {
+				m.Count = &CountWindow{}
+			}
+			if err := m.Count.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Global", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Global == nil {
+				m.Global = &GlobalWindow{}
+			}
+			if err := m.Global.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *WindowTrigger) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: WindowTrigger: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: WindowTrigger: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
+			}
+			var v int32
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Count = &v
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Interval", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Interval == nil {
+				m.Interval = &v11.Duration{}
+			}
+			if err := m.Interval.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
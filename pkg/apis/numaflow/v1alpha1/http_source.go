@@ -16,7 +16,12 @@ limitations under the License.
 
 package v1alpha1
 
-import corev1 "k8s.io/api/core/v1"
+import (
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
 
 type HTTPSource struct {
 	// +optional
@@ -24,6 +29,22 @@ type HTTPSource struct {
 	// Whether to create a ClusterIP Service
 	// +optional
 	Service bool `json:"service" protobuf:"bytes,2,opt,name=service"`
+	// DurableAck makes the requests return only after their messages are written to the inter-step buffer, so that an
+	// accepted request is not lost when the pod restarts. When the source is not able to keep up, the requests are
+	// rejected with 429 and a Retry-After header instead of being blocked.
+	// +optional
+	DurableAck bool `json:"durableAck,omitempty" protobuf:"varint,3,opt,name=durableAck"`
+	// AckTimeout is the maximum time a request waits for its messages to be written with DurableAck, the request gets
+	// 503 with a Retry-After header once it times out. Defaults to 30s.
+	// +optional
+	AckTimeout *metav1.Duration `json:"ackTimeout,omitempty" protobuf:"bytes,4,opt,name=ackTimeout"`
+}
+
+func (s HTTPSource) GetAckTimeout() time.Duration {
+	if s.AckTimeout == nil || s.AckTimeout.Duration <= 0 {
+		return DefaultHTTPSourceAckTimeout
+	}
+	return s.AckTimeout.Duration
 }
 
 type Authorization struct {
@@ -104,8 +104,9 @@ func (mv MonoVertex) CalculateReplicas() int {
 	desiredReplicas := mv.getReplicas()
 	// Don't allow replicas to be out of the range of min and max when auto scaling is enabled
 	if s := mv.Spec.Scale; !s.Disabled {
-		max := int(s.GetMaxReplicas())
-		min := int(s.GetMinReplicas())
+		now := time.Now()
+		max := int(s.GetEffectiveMaxReplicas(now))
+		min := int(s.GetEffectiveMinReplicas(now))
 		if desiredReplicas < min {
 			desiredReplicas = min
 		} else if desiredReplicas > max {
@@ -600,6 +601,9 @@ type MonoVertexStatus struct {
 	CurrentHash string `json:"currentHash,omitempty" protobuf:"bytes,14,opt,name=currentHash"`
 	// If not empty, indicates the updated version of the MonoVertex used to generate Pods.
 	UpdateHash string `json:"updateHash,omitempty" protobuf:"bytes,15,opt,name=updateHash"`
+	// The name of the active scale schedule, if there's any.
+	// +optional
+	ActiveScaleSchedule string `json:"activeScaleSchedule,omitempty" protobuf:"bytes,16,opt,name=activeScaleSchedule"`
 }
 
 // SetObservedGeneration sets the Status ObservedGeneration
@@ -214,6 +214,19 @@ func (p Pipeline) GetDaemonServiceName() string {
 	return fmt.Sprintf("%s-daemon-svc", p.Name)
 }
 
+// GetShadowPipelineName returns the name of the shadow pipeline started in a BlueGreen upgrade.
+func (p Pipeline) GetShadowPipelineName() string {
+	return fmt.Sprintf("%s-shadow", p.Name)
+}
+
+// GetUpgradeStrategy returns the upgrade strategy of the pipeline.
+func (p Pipeline) GetUpgradeStrategy() PipelineUpgradeStrategy {
+	if p.Spec.UpgradeStrategy == nil {
+		return PipelineUpgradeStrategy{}
+	}
+	return *p.Spec.UpgradeStrategy
+}
+
 func (p Pipeline) GetDaemonDeploymentName() string {
 	return fmt.Sprintf("%s-daemon", p.Name)
 }
@@ -508,6 +521,9 @@ type PipelineSpec struct {
 	// InterStepBuffer configuration specific to this pipeline.
 	// +optional
 	InterStepBuffer *InterStepBuffer `json:"interStepBuffer,omitempty" protobuf:"bytes,9,opt,name=interStepBuffer"`
+	// UpgradeStrategy defines how the changes of the vertices are rolled out, defaults to updating the vertices in place.
+	// +optional
+	UpgradeStrategy *PipelineUpgradeStrategy `json:"upgradeStrategy,omitempty" protobuf:"bytes,10,opt,name=upgradeStrategy"`
 }
 
 // InterStepBuffer configuration specifically for the pipeline.
@@ -693,6 +709,9 @@ type PipelineStatus struct {
 	// True means it has been successfully drained.
 	// +optional
 	DrainedOnPause bool `json:"drainedOnPause,omitempty" protobuf:"bytes,12,opt,name=drainedOnPause"`
+	// Upgrade is the status of the latest BlueGreen upgrade of the pipeline.
+	// +optional
+	Upgrade *PipelineUpgradeStatus `json:"upgrade,omitempty" protobuf:"bytes,13,opt,name=upgrade"`
 }
 
 // SetVertexCounts sets the counts of vertices.
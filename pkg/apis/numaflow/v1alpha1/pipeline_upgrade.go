@@ -0,0 +1,128 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// PipelineUpgradeStrategyType is the type of the upgrade strategy of a pipeline.
+// +enum
+type PipelineUpgradeStrategyType string
+
+const (
+	// PipelineUpgradeStrategyInPlace updates the vertices of the pipeline in place, the pods are rolled out with the
+	// update strategy of each vertex.
+	PipelineUpgradeStrategyInPlace PipelineUpgradeStrategyType = "InPlace"
+	// PipelineUpgradeStrategyBlueGreen starts a shadow pipeline with the new spec to take over the processing, while
+	// the pipeline is drained and updated, and then drains and deletes the shadow pipeline.
+	PipelineUpgradeStrategyBlueGreen PipelineUpgradeStrategyType = "BlueGreen"
+)
+
+// PipelineUpgradeStrategy defines how the changes of the vertices of a pipeline are rolled out.
+type PipelineUpgradeStrategy struct {
+	// Type is the type of the upgrade strategy, defaults to InPlace.
+	// +kubebuilder:validation:Enum="";InPlace;BlueGreen
+	// +optional
+	Type PipelineUpgradeStrategyType `json:"type,omitempty" protobuf:"bytes,1,opt,name=type,casttype=PipelineUpgradeStrategyType"`
+	// ProgressDeadlineSeconds is the max time for the shadow pipeline to become healthy, and for the pipeline to be
+	// drained in a BlueGreen upgrade, the upgrade is rolled back if any of them is exceeded. Defaults to 600.
+	// +kubebuilder:default=600
+	// +optional
+	ProgressDeadlineSeconds *int64 `json:"progressDeadlineSeconds,omitempty" protobuf:"varint,2,opt,name=progressDeadlineSeconds"`
+}
+
+func (pus PipelineUpgradeStrategy) GetType() PipelineUpgradeStrategyType {
+	if pus.Type == PipelineUpgradeStrategyBlueGreen {
+		return pus.Type
+	}
+	return PipelineUpgradeStrategyInPlace
+}
+
+func (pus PipelineUpgradeStrategy) GetProgressDeadlineSeconds() int64 {
+	if pus.ProgressDeadlineSeconds == nil || *pus.ProgressDeadlineSeconds <= 0 {
+		return DefaultPipelineUpgradeProgressDeadlineSeconds
+	}
+	return *pus.ProgressDeadlineSeconds
+}
+
+// PipelineUpgradePhase is the phase of a BlueGreen upgrade of a pipeline.
+// +enum
+type PipelineUpgradePhase string
+
+const (
+	// PipelineUpgradePhaseStartingShadow means the shadow pipeline is starting with the new spec.
+	PipelineUpgradePhaseStartingShadow PipelineUpgradePhase = "StartingShadow"
+	// PipelineUpgradePhaseDraining means the sources of the pipeline are paused, and the pipeline is draining.
+	PipelineUpgradePhaseDraining PipelineUpgradePhase = "Draining"
+	// PipelineUpgradePhaseCuttingOver means the pipeline is drained, and being updated to the new spec.
+	PipelineUpgradePhaseCuttingOver PipelineUpgradePhase = "CuttingOver"
+	// PipelineUpgradePhaseDrainingShadow means the pipeline is running with the new spec, and the shadow pipeline is
+	// being paused, drained and deleted.
+	PipelineUpgradePhaseDrainingShadow PipelineUpgradePhase = "DrainingShadow"
+	// PipelineUpgradePhaseSucceeded means the upgrade is completed.
+	PipelineUpgradePhaseSucceeded PipelineUpgradePhase = "Succeeded"
+	// PipelineUpgradePhaseRollingBack means the sources of the pipeline are being resumed, and the shadow pipeline is
+	// being deleted.
+	PipelineUpgradePhaseRollingBack PipelineUpgradePhase = "RollingBack"
+	// PipelineUpgradePhaseRolledBack means the upgrade failed, and the pipeline keeps running with the old spec until
+	// the spec is changed again.
+	PipelineUpgradePhaseRolledBack PipelineUpgradePhase = "RolledBack"
+)
+
+// PipelineUpgradeStatus is the status of a BlueGreen upgrade of a pipeline.
+type PipelineUpgradeStatus struct {
+	// Phase is the phase of the upgrade.
+	// +optional
+	Phase PipelineUpgradePhase `json:"phase,omitempty" protobuf:"bytes,1,opt,name=phase,casttype=PipelineUpgradePhase"`
+	// TargetHash is the hash of the vertices the pipeline is upgraded to.
+	// +optional
+	TargetHash string `json:"targetHash,omitempty" protobuf:"bytes,2,opt,name=targetHash"`
+	// ShadowPipeline is the name of the shadow pipeline.
+	// +optional
+	ShadowPipeline string `json:"shadowPipeline,omitempty" protobuf:"bytes,3,opt,name=shadowPipeline"`
+	// Message is a human-readable message about the upgrade, e.g. the reason of the rollback.
+	// +optional
+	Message string `json:"message,omitempty" protobuf:"bytes,4,opt,name=message"`
+	// StartedAt is the time when the upgrade started.
+	// +optional
+	StartedAt metav1.Time `json:"startedAt,omitempty" protobuf:"bytes,5,opt,name=startedAt"`
+	// LastTransitionTime is the last time the phase changed.
+	// +optional
+	LastTransitionTime metav1.Time `json:"lastTransitionTime,omitempty" protobuf:"bytes,6,opt,name=lastTransitionTime"`
+}
+
+// InProgress returns true if the upgrade is neither completed nor rolled back.
+func (pus *PipelineUpgradeStatus) InProgress() bool {
+	if pus == nil {
+		return false
+	}
+	switch pus.Phase {
+	case PipelineUpgradePhaseStartingShadow, PipelineUpgradePhaseDraining, PipelineUpgradePhaseCuttingOver,
+		PipelineUpgradePhaseDrainingShadow, PipelineUpgradePhaseRollingBack:
+		return true
+	default:
+		return false
+	}
+}
+
+// SetPhase sets the phase of the upgrade with a message.
+func (pus *PipelineUpgradeStatus) SetPhase(phase PipelineUpgradePhase, msg string) {
+	pus.Phase = phase
+	pus.Message = msg
+	pus.LastTransitionTime = metav1.Now()
+}
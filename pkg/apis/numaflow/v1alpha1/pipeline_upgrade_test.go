@@ -0,0 +1,98 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"k8s.io/utils/ptr"
+)
+
+func TestPipelineUpgradeStrategy_GetType(t *testing.T) {
+	tests := []struct {
+		name     string
+		strategy PipelineUpgradeStrategy
+		expected PipelineUpgradeStrategyType
+	}{
+		{
+			name:     "EmptyType",
+			strategy: PipelineUpgradeStrategy{},
+			expected: PipelineUpgradeStrategyInPlace,
+		},
+		{
+			name:     "InPlace",
+			strategy: PipelineUpgradeStrategy{Type: PipelineUpgradeStrategyInPlace},
+			expected: PipelineUpgradeStrategyInPlace,
+		},
+		{
+			name:     "BlueGreen",
+			strategy: PipelineUpgradeStrategy{Type: PipelineUpgradeStrategyBlueGreen},
+			expected: PipelineUpgradeStrategyBlueGreen,
+		},
+		{
+			name:     "UnsupportedType",
+			strategy: PipelineUpgradeStrategy{Type: "UnsupportedType"},
+			expected: PipelineUpgradeStrategyInPlace,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, tt.strategy.GetType())
+		})
+	}
+}
+
+func TestPipelineUpgradeStrategy_GetProgressDeadlineSeconds(t *testing.T) {
+	assert.Equal(t, int64(DefaultPipelineUpgradeProgressDeadlineSeconds), PipelineUpgradeStrategy{}.GetProgressDeadlineSeconds())
+	assert.Equal(t, int64(DefaultPipelineUpgradeProgressDeadlineSeconds), PipelineUpgradeStrategy{ProgressDeadlineSeconds: ptr.To[int64](0)}.GetProgressDeadlineSeconds())
+	assert.Equal(t, int64(120), PipelineUpgradeStrategy{ProgressDeadlineSeconds: ptr.To[int64](120)}.GetProgressDeadlineSeconds())
+}
+
+func TestPipelineUpgradeStatus_InProgress(t *testing.T) {
+	var s *PipelineUpgradeStatus
+	assert.False(t, s.InProgress())
+	s = &PipelineUpgradeStatus{}
+	assert.False(t, s.InProgress())
+	for _, phase := range []PipelineUpgradePhase{PipelineUpgradePhaseStartingShadow, PipelineUpgradePhaseDraining,
+		PipelineUpgradePhaseCuttingOver, PipelineUpgradePhaseDrainingShadow, PipelineUpgradePhaseRollingBack} {
+		s.Phase = phase
+		assert.True(t, s.InProgress(), phase)
+	}
+	for _, phase := range []PipelineUpgradePhase{PipelineUpgradePhaseSucceeded, PipelineUpgradePhaseRolledBack} {
+		s.Phase = phase
+		assert.False(t, s.InProgress(), phase)
+	}
+}
+
+func TestPipelineUpgradeStatus_SetPhase(t *testing.T) {
+	s := &PipelineUpgradeStatus{}
+	s.SetPhase(PipelineUpgradePhaseRolledBack, "rolled back")
+	assert.Equal(t, PipelineUpgradePhaseRolledBack, s.Phase)
+	assert.Equal(t, "rolled back", s.Message)
+	assert.False(t, s.LastTransitionTime.IsZero())
+}
+
+func TestPipeline_GetUpgradeStrategy(t *testing.T) {
+	p := Pipeline{}
+	p.Name = "pl"
+	assert.Equal(t, "pl-shadow", p.GetShadowPipelineName())
+	assert.Equal(t, PipelineUpgradeStrategyInPlace, p.GetUpgradeStrategy().GetType())
+	p.Spec.UpgradeStrategy = &PipelineUpgradeStrategy{Type: PipelineUpgradeStrategyBlueGreen}
+	assert.Equal(t, PipelineUpgradeStrategyBlueGreen, p.GetUpgradeStrategy().GetType())
+}
@@ -16,6 +16,23 @@ limitations under the License.
 
 package v1alpha1
 
+import (
+	"fmt"
+	"time"
+
+	"github.com/robfig/cron/v3"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+type ScalePolicyType string
+
+const (
+	ScalePolicyTypeDefault    ScalePolicyType = "default"
+	ScalePolicyTypeTargetLag  ScalePolicyType = "targetLag"
+	ScalePolicyTypeCPU        ScalePolicyType = "cpu"
+	ScalePolicyTypePredictive ScalePolicyType = "predictive"
+)
+
 // Scale defines the parameters for autoscaling.
 type Scale struct {
 	// Whether to disable autoscaling.
@@ -65,6 +82,161 @@ type Scale struct {
 	// The is use to prevent from too aggressive scaling down operations
 	// +optional
 	ReplicasPerScaleDown *uint32 `json:"replicasPerScaleDown,omitempty" protobuf:"varint,12,opt,name=replicasPerScaleDown"`
+	// Policy defines the algorithm used to calculate the desired replicas.
+	// If not specified, the desired replicas are calculated with the pending messages and processing rate for source vertices,
+	// and with the buffer availability for UDF and Sink vertices.
+	// It's not supported by MonoVertex yet.
+	// +optional
+	Policy *ScalePolicy `json:"policy,omitempty" protobuf:"bytes,13,opt,name=policy"`
+	// DryRun is used to only calculate the desired replicas and record the decision in the vertex status,
+	// the replicas of the vertex will not be changed.
+	// It's not supported by MonoVertex yet.
+	// +optional
+	DryRun bool `json:"dryRun,omitempty" protobuf:"varint,14,opt,name=dryRun"`
+	// Schedules override the min and max replicas during the scheduled time windows, for example,
+	// to keep more replicas running during the predictable traffic peaks.
+	// If multiple schedules are active at the same time, the first one in the list takes effect.
+	// +optional
+	Schedules []ScaleSchedule `json:"schedules,omitempty" protobuf:"bytes,15,rep,name=schedules"`
+}
+
+// ScaleSchedule defines a recurring time window, during which the min and max replicas are overridden.
+type ScaleSchedule struct {
+	// Name of the schedule, it is reported in the status when the schedule is active.
+	Name string `json:"name" protobuf:"bytes,1,opt,name=name"`
+	// Start is a cron expression of when the time window starts, for example, "0 8 * * *".
+	Start string `json:"start" protobuf:"bytes,2,opt,name=start"`
+	// Duration of the time window, for example, "10h".
+	Duration metav1.Duration `json:"duration" protobuf:"bytes,3,opt,name=duration"`
+	// TimeZone is the IANA time zone name used to interpret the cron expression, for example, "Europe/Berlin".
+	// Defaults to UTC.
+	// +optional
+	TimeZone string `json:"timeZone,omitempty" protobuf:"bytes,4,opt,name=timeZone"`
+	// Minimum replicas during the time window, defaults to the min of the scale spec.
+	// +optional
+	Min *int32 `json:"min,omitempty" protobuf:"varint,5,opt,name=min"`
+	// Maximum replicas during the time window, defaults to the max of the scale spec.
+	// +optional
+	Max *int32 `json:"max,omitempty" protobuf:"varint,6,opt,name=max"`
+}
+
+// Evaluate returns whether the schedule is active at the given time, and the time of the next start or end of the time window,
+// which is zero if the schedule never starts again.
+func (ss ScaleSchedule) Evaluate(t time.Time) (bool, time.Time, error) {
+	loc := time.UTC
+	if ss.TimeZone != "" {
+		var err error
+		if loc, err = time.LoadLocation(ss.TimeZone); err != nil {
+			return false, time.Time{}, fmt.Errorf("invalid time zone %q, %w", ss.TimeZone, err)
+		}
+	}
+	schedule, err := cron.ParseStandard(ss.Start)
+	if err != nil {
+		return false, time.Time{}, fmt.Errorf("invalid start %q, %w", ss.Start, err)
+	}
+	if ss.Duration.Duration <= 0 {
+		return false, time.Time{}, fmt.Errorf("duration should be greater than 0")
+	}
+	t = t.In(loc)
+	next := schedule.Next(t)
+	// The time window containing t is the one starting in (t - duration, t].
+	// Zero time is returned by the cron schedule if there's no matching time.
+	if start := schedule.Next(t.Add(-ss.Duration.Duration)); !start.IsZero() && !start.After(t) {
+		if end := start.Add(ss.Duration.Duration); next.IsZero() || end.Before(next) {
+			next = end
+		}
+		return true, next, nil
+	}
+	return false, next, nil
+}
+
+// ScalePolicy defines the algorithm used to calculate the desired replicas, only one of the policies can be specified.
+type ScalePolicy struct {
+	// TargetLag scales the vertex to get the pending messages processed within the target lag seconds.
+	// +optional
+	TargetLag *TargetLagScalePolicy `json:"targetLag,omitempty" protobuf:"bytes,1,opt,name=targetLag"`
+	// CPU scales the vertex to keep the average CPU utilization of the pods at the target level.
+	// It requires the Kubernetes metrics server to be installed.
+	// +optional
+	CPU *CPUScalePolicy `json:"cpu,omitempty" protobuf:"bytes,2,opt,name=cpu"`
+	// Predictive forecasts the processing rate with the rates observed at the same time of the previous period,
+	// and scales the vertex up ahead of the periodic load.
+	// +optional
+	Predictive *PredictiveScalePolicy `json:"predictive,omitempty" protobuf:"bytes,3,opt,name=predictive"`
+}
+
+// GetType returns the type of the scale policy.
+func (sp *ScalePolicy) GetType() ScalePolicyType {
+	switch {
+	case sp == nil:
+		return ScalePolicyTypeDefault
+	case sp.TargetLag != nil:
+		return ScalePolicyTypeTargetLag
+	case sp.CPU != nil:
+		return ScalePolicyTypeCPU
+	case sp.Predictive != nil:
+		return ScalePolicyTypePredictive
+	default:
+		return ScalePolicyTypeDefault
+	}
+}
+
+type TargetLagScalePolicy struct {
+	// TargetLagSeconds is the expected seconds to get the pending messages processed,
+	// which is calculated with the pending messages divided by the processing rate.
+	TargetLagSeconds uint32 `json:"targetLagSeconds" protobuf:"varint,1,opt,name=targetLagSeconds"`
+}
+
+type CPUScalePolicy struct {
+	// TargetUtilization is the target average CPU utilization of the pods,
+	// represented as a percentage of the requested CPU, for example, 70.
+	TargetUtilization uint32 `json:"targetUtilization" protobuf:"varint,1,opt,name=targetUtilization"`
+}
+
+func (cp CPUScalePolicy) GetTargetUtilization() float64 {
+	return float64(cp.TargetUtilization) / 100
+}
+
+type PredictiveScalePolicy struct {
+	// LookaheadSeconds is how many seconds ahead the processing rate is forecast, defaults to 300.
+	// It must be less than the period.
+	// +optional
+	LookaheadSeconds *uint32 `json:"lookaheadSeconds,omitempty" protobuf:"varint,1,opt,name=lookaheadSeconds"`
+	// PeriodSeconds is the period of the load pattern, defaults to 86400 (a day). The rate in the lookahead seconds
+	// is forecast with the rates observed at the same time of the previous period, no forecast is made until
+	// the rates of a full period have been observed.
+	// +optional
+	PeriodSeconds *uint32 `json:"periodSeconds,omitempty" protobuf:"varint,2,opt,name=periodSeconds"`
+}
+
+func (pp PredictiveScalePolicy) GetLookaheadSeconds() int {
+	if pp.LookaheadSeconds != nil {
+		return int(*pp.LookaheadSeconds)
+	}
+	return DefaultPredictiveLookaheadSeconds
+}
+
+func (pp PredictiveScalePolicy) GetPeriodSeconds() int {
+	if pp.PeriodSeconds != nil {
+		return int(*pp.PeriodSeconds)
+	}
+	return DefaultPredictivePeriodSeconds
+}
+
+// ScalingDecision is the decision made by the autoscaler.
+type ScalingDecision struct {
+	// Policy used to calculate the desired replicas.
+	Policy ScalePolicyType `json:"policy" protobuf:"bytes,1,opt,name=policy,casttype=ScalePolicyType"`
+	// Replicas at the time the decision was made.
+	Replicas uint32 `json:"replicas" protobuf:"varint,2,opt,name=replicas"`
+	// DesiredReplicas is the number of replicas the vertex would be scaled to.
+	DesiredReplicas uint32 `json:"desiredReplicas" protobuf:"varint,3,opt,name=desiredReplicas"`
+	// Time the decision was made.
+	DecidedAt metav1.Time `json:"decidedAt" protobuf:"bytes,4,opt,name=decidedAt"`
+}
+
+func (s Scale) GetPolicyType() ScalePolicyType {
+	return s.Policy.GetType()
 }
 
 func (s Scale) GetLookbackSeconds() int {
@@ -145,3 +317,56 @@ func (s Scale) GetMaxReplicas() int32 {
 		return *x
 	}
 }
+
+// GetEffectiveMinReplicas returns the min replicas at the given time, it's overridden by the active schedule if
+// there's any.
+func (s Scale) GetEffectiveMinReplicas(t time.Time) int32 {
+	if as := s.GetActiveSchedule(t); as != nil && as.Min != nil {
+		return Scale{Min: as.Min}.GetMinReplicas()
+	}
+	return s.GetMinReplicas()
+}
+
+// GetEffectiveMaxReplicas returns the max replicas at the given time, it's overridden by the active schedule if
+// there's any.
+func (s Scale) GetEffectiveMaxReplicas(t time.Time) int32 {
+	if as := s.GetActiveSchedule(t); as != nil && as.Max != nil {
+		return Scale{Max: as.Max}.GetMaxReplicas()
+	}
+	return s.GetMaxReplicas()
+}
+
+// GetHighestMaxReplicas returns the highest of the max replicas and the max replicas of all the schedules, which
+// bounds the replicas at any time, including the pods left over from a schedule which has ended.
+func (s Scale) GetHighestMaxReplicas() int32 {
+	result := s.GetMaxReplicas()
+	for _, ss := range s.Schedules {
+		if ss.Max != nil && *ss.Max > result {
+			result = *ss.Max
+		}
+	}
+	return result
+}
+
+// GetActiveSchedule returns the first schedule active at the given time, or nil if there's none.
+// Invalid schedules are ignored.
+func (s Scale) GetActiveSchedule(t time.Time) *ScaleSchedule {
+	for i := range s.Schedules {
+		if active, _, err := s.Schedules[i].Evaluate(t); err == nil && active {
+			return &s.Schedules[i]
+		}
+	}
+	return nil
+}
+
+// GetNextScheduleTransition returns the earliest time after the given time when any of the schedules starts or ends,
+// or zero time if there are no valid schedules.
+func (s Scale) GetNextScheduleTransition(t time.Time) time.Time {
+	var result time.Time
+	for _, ss := range s.Schedules {
+		if _, next, err := ss.Evaluate(t); err == nil && !next.IsZero() && (result.IsZero() || next.Before(result)) {
+			result = next
+		}
+	}
+	return result
+}
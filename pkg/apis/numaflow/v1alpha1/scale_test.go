@@ -18,8 +18,10 @@ package v1alpha1
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/utils/ptr"
 )
 
@@ -68,3 +70,67 @@ func Test_Scale_Parameters(t *testing.T) {
 	s.Max = ptr.To[int32](500)
 	assert.Equal(t, int32(500), s.GetMaxReplicas())
 }
+
+func Test_ScaleSchedule_Evaluate(t *testing.T) {
+	ss := ScaleSchedule{
+		Name:     "office-hours",
+		Start:    "0 8 * * *",
+		Duration: metav1.Duration{Duration: 10 * time.Hour},
+		TimeZone: "Europe/Berlin",
+	}
+	berlin, _ := time.LoadLocation("Europe/Berlin")
+	active, next, err := ss.Evaluate(time.Date(2024, 6, 3, 9, 0, 0, 0, berlin))
+	assert.NoError(t, err)
+	assert.True(t, active)
+	assert.True(t, time.Date(2024, 6, 3, 18, 0, 0, 0, berlin).Equal(next))
+	// 07:00 UTC is 09:00 in Berlin in summer time
+	active, _, err = ss.Evaluate(time.Date(2024, 6, 3, 7, 0, 0, 0, time.UTC))
+	assert.NoError(t, err)
+	assert.True(t, active)
+	active, next, err = ss.Evaluate(time.Date(2024, 6, 3, 18, 0, 0, 0, berlin))
+	assert.NoError(t, err)
+	assert.False(t, active)
+	assert.True(t, time.Date(2024, 6, 4, 8, 0, 0, 0, berlin).Equal(next))
+
+	ss.TimeZone = "Mars/Olympus"
+	_, _, err = ss.Evaluate(time.Now())
+	assert.ErrorContains(t, err, "invalid time zone")
+	ss.TimeZone = ""
+	ss.Start = "0 8 * *"
+	_, _, err = ss.Evaluate(time.Now())
+	assert.ErrorContains(t, err, "invalid start")
+	ss.Start = "0 8 * * *"
+	ss.Duration = metav1.Duration{}
+	_, _, err = ss.Evaluate(time.Now())
+	assert.ErrorContains(t, err, "duration should be greater than 0")
+}
+
+func Test_Scale_Schedules(t *testing.T) {
+	s := Scale{
+		Min: ptr.To[int32](1),
+		Max: ptr.To[int32](5),
+		Schedules: []ScaleSchedule{
+			{Name: "never", Start: "0 0 31 2 *", Duration: metav1.Duration{Duration: time.Minute}, Min: ptr.To[int32](20)},
+			{Name: "always", Start: "* * * * *", Duration: metav1.Duration{Duration: 2 * time.Minute}, Min: ptr.To[int32](10), Max: ptr.To[int32](100)},
+			{Name: "always-too", Start: "* * * * *", Duration: metav1.Duration{Duration: 2 * time.Minute}, Min: ptr.To[int32](30)},
+		},
+	}
+	now := time.Now()
+	assert.Equal(t, "always", s.GetActiveSchedule(now).Name)
+	assert.Equal(t, int32(10), s.GetEffectiveMinReplicas(now))
+	assert.Equal(t, int32(100), s.GetEffectiveMaxReplicas(now))
+	// the getters of the spec are not affected by the schedules
+	assert.Equal(t, int32(1), s.GetMinReplicas())
+	assert.Equal(t, int32(5), s.GetMaxReplicas())
+	assert.Equal(t, int32(100), s.GetHighestMaxReplicas())
+	next := s.GetNextScheduleTransition(now)
+	assert.True(t, next.After(now))
+	assert.True(t, next.Before(now.Add(time.Minute+time.Second)))
+	s.Schedules = s.Schedules[:1]
+	assert.Nil(t, s.GetActiveSchedule(now))
+	assert.Equal(t, int32(1), s.GetEffectiveMinReplicas(now))
+	assert.Equal(t, int32(5), s.GetEffectiveMaxReplicas(now))
+	assert.Equal(t, int32(5), s.GetHighestMaxReplicas())
+	s.Schedules = nil
+	assert.True(t, s.GetNextScheduleTransition(now).IsZero())
+}
@@ -35,15 +35,59 @@ type SideInput struct {
 	// +patchMergeKey=name
 	Volumes []corev1.Volume   `json:"volumes,omitempty" patchStrategy:"merge" patchMergeKey:"name" protobuf:"bytes,3,rep,name=volumes"`
 	Trigger *SideInputTrigger `json:"trigger" protobuf:"bytes,4,opt,name=trigger"`
+	// RetainedVersions is the number of versions of the side input retained in the side inputs store,
+	// including the latest one. The retained versions can be used to roll back. Defaults to 5.
+	// +optional
+	RetainedVersions *int32 `json:"retainedVersions,omitempty" protobuf:"varint,5,opt,name=retainedVersions"`
+	// PinnedVersion pins the vertices to the given version of the side input, instead of the latest
+	// broadcast one. The version must be retained in the side inputs store.
+	// +optional
+	PinnedVersion *int64 `json:"pinnedVersion,omitempty" protobuf:"varint,6,opt,name=pinnedVersion"`
+}
+
+// GetRetainedVersions returns the number of versions of the side input to retain.
+func (si SideInput) GetRetainedVersions() int {
+	if si.RetainedVersions != nil {
+		return int(*si.RetainedVersions)
+	}
+	return DefaultSideInputRetainedVersions
 }
 
 type SideInputTrigger struct {
 	// The schedule to trigger the retrievement of the side input data.
 	// It supports cron format, for example, "0 30 * * * *".
 	// Or interval based format, such as "@hourly", "@every 1h30m", etc.
-	Schedule string `json:"schedule" protobuf:"bytes,1,opt,name=schedule"`
+	// Either schedule or watch is required.
+	// +optional
+	Schedule string `json:"schedule,omitempty" protobuf:"bytes,1,opt,name=schedule"`
 	// +optional
 	Timezone *string `json:"timezone" protobuf:"bytes,2,opt,name=timezone"`
+	// Watch triggers the retrievement of the side input data whenever one of the
+	// watched ConfigMaps or Secrets changes.
+	// +optional
+	Watch *SideInputWatch `json:"watch,omitempty" protobuf:"bytes,3,opt,name=watch"`
+	// Auth enables the on-demand trigger endpoint of the side inputs manager, the requests
+	// need to have the bearer token in the "Authorization" header. The endpoint is disabled if it's not configured.
+	// +optional
+	Auth *Authorization `json:"auth,omitempty" protobuf:"bytes,4,opt,name=auth"`
+}
+
+// SideInputWatch defines the ConfigMaps and Secrets, in the namespace of the pipeline,
+// which trigger the retrievement of the side input data when they are changed.
+// The service account of the side inputs manager requires "get", "list" and "watch"
+// permissions on them.
+type SideInputWatch struct {
+	// Names of the ConfigMaps to watch.
+	// +optional
+	ConfigMaps []string `json:"configMaps,omitempty" protobuf:"bytes,1,rep,name=configMaps"`
+	// Names of the Secrets to watch.
+	// +optional
+	Secrets []string `json:"secrets,omitempty" protobuf:"bytes,2,rep,name=secrets"`
+}
+
+// HasWatch returns true if there is any ConfigMap or Secret to watch.
+func (sit SideInputTrigger) HasWatch() bool {
+	return sit.Watch != nil && (len(sit.Watch.ConfigMaps) > 0 || len(sit.Watch.Secrets) > 0)
 }
 
 func (si SideInput) getManagerDeploymentObj(pipeline Pipeline, req GetSideInputDeploymentReq) (*appv1.Deployment, error) {
@@ -136,8 +180,10 @@ func (si SideInput) getInitContainer(pipeline Pipeline, req GetSideInputDeployme
 
 func (si SideInput) getNumaContainer(pipeline Pipeline, req GetSideInputDeploymentReq) (*corev1.Container, error) {
 	sideInputCopy := &SideInput{
-		Name:    si.Name,
-		Trigger: si.Trigger,
+		Name:             si.Name,
+		Trigger:          si.Trigger,
+		RetainedVersions: si.RetainedVersions,
+		PinnedVersion:    si.PinnedVersion,
 	}
 	siBytes, err := json.Marshal(sideInputCopy)
 	if err != nil {
@@ -155,6 +201,7 @@ func (si SideInput) getNumaContainer(pipeline Pipeline, req GetSideInputDeployme
 		ImagePullPolicy: req.PullPolicy,
 		Resources:       req.DefaultResources,
 		Args:            []string{"side-inputs-manager", "--isbsvc-type=" + string(req.ISBSvcType), "--side-inputs-store=" + pipeline.GetSideInputsStoreName()},
+		Ports:           []corev1.ContainerPort{{Name: SideInputsManagerPortName, ContainerPort: SideInputsManagerPort}},
 	}
 	if x := pipeline.Spec.Templates; x != nil && x.SideInputsManagerTemplate != nil && x.SideInputsManagerTemplate.ContainerTemplate != nil {
 		x.SideInputsManagerTemplate.ContainerTemplate.ApplyToContainer(c)
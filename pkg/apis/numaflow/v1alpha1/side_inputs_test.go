@@ -78,6 +78,17 @@ func Test_getNumaContainer(t *testing.T) {
 	}
 	assert.Equal(t, testGetSideInputDeploymentReq.PullPolicy, c.ImagePullPolicy)
 	assert.Equal(t, CtrMain, c.Name)
+	assert.Len(t, c.Ports, 1)
+	assert.Equal(t, int32(SideInputsManagerPort), c.Ports[0].ContainerPort)
+}
+
+func TestSideInputTrigger_HasWatch(t *testing.T) {
+	trigger := SideInputTrigger{Schedule: "@every 1h"}
+	assert.False(t, trigger.HasWatch())
+	trigger.Watch = &SideInputWatch{}
+	assert.False(t, trigger.HasWatch())
+	trigger.Watch.Secrets = []string{"my-secret"}
+	assert.True(t, trigger.HasWatch())
 }
 
 func Test_getInitContainer(t *testing.T) {
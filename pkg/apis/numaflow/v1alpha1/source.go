@@ -42,6 +42,10 @@ type Source struct {
 	Pulsar *PulsarSource `json:"pulsar,omitempty" protobuf:"bytes,9,opt,name=pulsar"`
 	// +optional
 	Sqs *SqsSource `json:"sqs,omitempty" protobuf:"bytes,10,opt,name=sqs"`
+	// Dedup drops the messages whose IDs have been seen within a window, e.g. the retries of the clients of an HTTP
+	// source with the same "X-Numaflow-Id". Only supported by HTTP and JetStream sources.
+	// +optional
+	Dedup *DedupWindow `json:"dedup,omitempty" protobuf:"bytes,11,opt,name=dedup"`
 }
 
 func (s Source) getContainers(req getContainerReq) ([]corev1.Container, []corev1.Container, error) {
@@ -27,13 +27,38 @@ import (
 type UDF struct {
 	// +optional
 	Container *Container `json:"container" protobuf:"bytes,1,opt,name=container"`
+	// Builtin runs a built-in map function in the numa container instead of a UDF container, it is exclusive with
+	// Container, and is not supported by reduce vertices.
+	// +optional
+	Builtin *Function `json:"builtin,omitempty" protobuf:"bytes,2,opt,name=builtin"`
 	// +optional
 	GroupBy *GroupBy `json:"groupBy" protobuf:"bytes,3,opt,name=groupBy"`
+	// RetryStrategy defines how the failures of a map UDF are handled, it is not supported by reduce vertices.
+	// Without it, a failing batch stops the forwarder and is processed again after the restart. With it, the batch is
+	// retried with the BackOff, after which the UDF is applied to each of the messages on its own, and the ones still
+	// failing are dropped or routed to the DeadLetter vertex, depending on OnFailure ("drop" or "fallback").
+	// +optional
+	RetryStrategy *RetryStrategy `json:"retryStrategy,omitempty" protobuf:"bytes,4,opt,name=retryStrategy"`
+	// DeadLetter routes the messages failed by the map UDF to a side output, once the RetryStrategy is exhausted with
+	// the "fallback" OnFailure strategy.
+	// +optional
+	DeadLetter *DeadLetterOutput `json:"deadLetter,omitempty" protobuf:"bytes,5,opt,name=deadLetter"`
+}
+
+// DeadLetterOutput describes where the messages failed by a map vertex are written to. The dead letters are the
+// messages read by the map vertex, they keep their original headers, and carry the error of the UDF.
+type DeadLetterOutput struct {
+	// ToVertex is the name of the vertex the dead letters are written to, it has to be connected to the map vertex
+	// with an edge. The dead letters bypass the conditions of the edge.
+	ToVertex string `json:"toVertex" protobuf:"bytes,1,opt,name=toVertex"`
 }
 
 func (in UDF) getContainers(req getContainerReq) ([]corev1.Container, []corev1.Container, error) {
 	monitorContainer := buildMonitorContainer(req)
-	sidecarContainers := []corev1.Container{monitorContainer, in.getUDFContainer(req)}
+	sidecarContainers := []corev1.Container{monitorContainer}
+	if in.Builtin == nil {
+		sidecarContainers = append(sidecarContainers, in.getUDFContainer(req))
+	}
 	return sidecarContainers, []corev1.Container{in.getMainContainer(req)}, nil
 }
 
@@ -91,6 +116,15 @@ func (in UDF) getUDFContainer(mainContainerReq getContainerReq) corev1.Container
 	return container
 }
 
+// Function describes a built-in map function.
+type Function struct {
+	// Name of the built-in function, one of "cat", "filter", "project" and "eventTime".
+	Name string `json:"name" protobuf:"bytes,1,opt,name=name"`
+	// KWArgs are the keyword arguments of the function.
+	// +optional
+	KWArgs map[string]string `json:"kwargs,omitempty" protobuf:"bytes,2,rep,name=kwargs"`
+}
+
 // GroupBy indicates it is a reducer UDF
 type GroupBy struct {
 	// Window describes the windowing strategy.
@@ -103,6 +137,40 @@ type GroupBy struct {
 	AllowedLateness *metav1.Duration `json:"allowedLateness,omitempty" protobuf:"bytes,3,opt,name=allowedLateness"`
 	// Storage is used to define the PBQ storage for a reduce vertex.
 	Storage *PBQStorage `json:"storage,omitempty" protobuf:"bytes,4,opt,name=storage"`
+	// EarlyFiring emits speculative partial results of a fixed or sliding window before the window is closed.
+	// +optional
+	EarlyFiring *EarlyFiring `json:"earlyFiring,omitempty" protobuf:"bytes,5,opt,name=earlyFiring"`
+	// LateFiring emits the result of a fixed or sliding window once the watermark passes the end of the window, and
+	// re-emits the updated result whenever late data arrives within AllowedLateness.
+	// +optional
+	LateFiring bool `json:"lateFiring,omitempty" protobuf:"varint,6,opt,name=lateFiring"`
+	// LateData routes the messages which are dropped because their windows have already been closed to a side output,
+	// so that they can be audited and reprocessed. If not set, the late messages are dropped.
+	// +optional
+	LateData *LateDataOutput `json:"lateData,omitempty" protobuf:"bytes,7,opt,name=lateData"`
+}
+
+// LateDataOutput describes where the late messages dropped by a reduce vertex are written to. The late messages keep
+// their original headers, and carry the watermark of the reduce vertex at the time they were dropped.
+type LateDataOutput struct {
+	// ToVertex is the name of the vertex the late messages are written to, it has to be connected to the reduce vertex
+	// with an edge. The late messages bypass the conditions of the edge.
+	ToVertex string `json:"toVertex" protobuf:"bytes,1,opt,name=toVertex"`
+	// Fallback writes the late messages to the fallback sink of ToVertex instead of the primary sink, ToVertex has to
+	// be a sink vertex with a fallback sink.
+	// +optional
+	Fallback bool `json:"fallback,omitempty" protobuf:"varint,2,opt,name=fallback"`
+}
+
+// EarlyFiring describes when the speculative partial results of a window are emitted, a partial result is emitted as
+// soon as any of the conditions is met.
+type EarlyFiring struct {
+	// Interval emits a partial result every Interval (processing time) while the window is open.
+	// +optional
+	Interval *metav1.Duration `json:"interval,omitempty" protobuf:"bytes,1,opt,name=interval"`
+	// Count emits a partial result every Count messages of the window.
+	// +optional
+	Count *int32 `json:"count,omitempty" protobuf:"varint,2,opt,name=count"`
 }
 
 // Window describes windowing strategy
@@ -115,6 +183,10 @@ type Window struct {
 	Session *SessionWindow `json:"session" protobuf:"bytes,3,opt,name=session"`
 	// +optional
 	Accumulator *AccumulatorWindow `json:"accumulator" protobuf:"bytes,4,opt,name=accumulator"`
+	// +optional
+	Count *CountWindow `json:"count" protobuf:"bytes,5,opt,name=count"`
+	// +optional
+	Global *GlobalWindow `json:"global" protobuf:"bytes,6,opt,name=global"`
 }
 
 // FixedWindow describes a fixed window
@@ -151,6 +223,33 @@ type AccumulatorWindow struct {
 	Timeout *metav1.Duration `json:"timeout,omitempty" protobuf:"bytes,1,opt,name=timeout"`
 }
 
+// CountWindow describes a tumbling count window, which closes once it has seen a fixed number of messages for a key.
+type CountWindow struct {
+	// Count is the number of messages of a key after which the window closes.
+	Count int32 `json:"count" protobuf:"varint,1,opt,name=count"`
+	// Timeout is the duration of inactivity after which a partially filled window closes.
+	// If not set, a partially filled window stays open until it receives enough messages.
+	// +optional
+	Timeout *metav1.Duration `json:"timeout,omitempty" protobuf:"bytes,2,opt,name=timeout"`
+}
+
+// GlobalWindow describes a window which spans all the messages of a key, it emits the results whenever the trigger fires.
+type GlobalWindow struct {
+	// Trigger decides when the window fires.
+	Trigger *WindowTrigger `json:"trigger,omitempty" protobuf:"bytes,1,opt,name=trigger"`
+}
+
+// WindowTrigger describes when a global window fires. The window fires as soon as any of the conditions is met,
+// the fired messages are then discarded and the next message of the key starts a new pane.
+type WindowTrigger struct {
+	// Count fires the window once it has seen Count messages of a key.
+	// +optional
+	Count *int32 `json:"count,omitempty" protobuf:"varint,1,opt,name=count"`
+	// Interval fires the window once the watermark has progressed Interval past the first message of the pane.
+	// +optional
+	Interval *metav1.Duration `json:"interval,omitempty" protobuf:"bytes,2,opt,name=interval"`
+}
+
 // PBQStorage defines the persistence configuration for a vertex.
 type PBQStorage struct {
 	// +optional
@@ -159,6 +258,32 @@ type PBQStorage struct {
 	EmptyDir *corev1.EmptyDirVolumeSource `json:"emptyDir,omitempty" protobuf:"bytes,2,opt,name=emptyDir"`
 	// +optional
 	NoStore *NoStore `json:"no_store,omitempty" protobuf:"bytes,3,opt,name=no_store"`
+	// ObjectStore persists the PBQ in an S3-compatible object store instead of a volume, it is only supported by
+	// fixed and sliding windows.
+	// +optional
+	ObjectStore *ObjectStoreStorage `json:"objectStore,omitempty" protobuf:"bytes,4,opt,name=objectStore"`
+}
+
+// ObjectStoreStorage describes an S3-compatible object store used to persist the PBQ, so that the reduce pods do not
+// need a persistent volume and can be rescheduled across zones. Credentials are resolved using the default AWS
+// credential chain, e.g. environment variables or the IAM role of the service account.
+type ObjectStoreStorage struct {
+	// Bucket is the name of the bucket the WAL segments are written to.
+	Bucket string `json:"bucket" protobuf:"bytes,1,opt,name=bucket"`
+	// Prefix is the key prefix of the WAL segments in the bucket, the segments of a reduce pod are written under
+	// "{prefix}/{namespace}/{pipeline}/{vertex}/{replica}/". Defaults to "numaflow".
+	// +optional
+	Prefix string `json:"prefix,omitempty" protobuf:"bytes,2,opt,name=prefix"`
+	// Region is the region of the bucket.
+	// +optional
+	Region string `json:"region,omitempty" protobuf:"bytes,3,opt,name=region"`
+	// EndpointURL is the custom endpoint URL of the object store, e.g. a MinIO endpoint.
+	// +optional
+	EndpointURL *string `json:"endpointUrl,omitempty" protobuf:"bytes,4,opt,name=endpointUrl"`
+	// UsePathStyle addresses the bucket with path style URLs instead of virtual hosted style URLs, which is required
+	// by most of the S3-compatible object stores.
+	// +optional
+	UsePathStyle bool `json:"usePathStyle,omitempty" protobuf:"varint,5,opt,name=usePathStyle"`
 }
 
 // NoStore means there will be no persistence storage and there will be data loss during pod restarts.
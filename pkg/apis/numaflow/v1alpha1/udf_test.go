@@ -90,6 +90,24 @@ func TestUDF_getContainers(t *testing.T) {
 	assert.Equal(t, int32(5), sc[1].LivenessProbe.FailureThreshold)
 }
 
+func TestUDF_getContainers_Builtin(t *testing.T) {
+	x := UDF{
+		Builtin: &Function{
+			Name:   "filter",
+			KWArgs: map[string]string{"expression": "int(json(payload).amount) > 100"},
+		},
+	}
+	sc, c, err := x.getContainers(getContainerReq{
+		image:           "main-image",
+		imagePullPolicy: corev1.PullAlways,
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, 1, len(c))
+	assert.Equal(t, "main-image", c[0].Image)
+	assert.Equal(t, 1, len(sc))
+	assert.Equal(t, CtrMonitor, sc[0].Name)
+}
+
 func Test_getUDFContainer(t *testing.T) {
 	t.Run("with customized image", func(t *testing.T) {
 		x := UDF{
@@ -20,8 +20,10 @@ import (
 	"errors"
 	"fmt"
 	"os"
+	"slices"
 	"sort"
 	"strings"
+	"time"
 
 	corev1 "k8s.io/api/core/v1"
 	resource "k8s.io/apimachinery/pkg/api/resource"
@@ -357,7 +359,7 @@ func (v Vertex) GetPodSpec(req GetVertexPodSpecReq) (*corev1.PodSpec, error) {
 			Image:           req.Image,
 			ImagePullPolicy: req.PullPolicy,
 			Resources:       req.DefaultResources,
-			Args:            []string{"side-inputs-synchronizer", "--isbsvc-type=" + string(req.ISBSvcType), "--side-inputs-store=" + req.SideInputsStoreName, "--side-inputs=" + strings.Join(v.Spec.SideInputs, ",")},
+			Args:            append([]string{"side-inputs-synchronizer"}, v.sideInputsArgs(req)...),
 		}
 		sideInputsWatcher.Env = append(sideInputsWatcher.Env, v.commonEnvs()...)
 		if x := v.Spec.SideInputsContainerTemplate; x != nil {
@@ -421,7 +423,7 @@ func (v Vertex) getInitContainers(req GetVertexPodSpecReq) []corev1.Container {
 			Image:           req.Image,
 			ImagePullPolicy: req.PullPolicy,
 			Resources:       req.DefaultResources,
-			Args:            []string{"side-inputs-init", "--isbsvc-type=" + string(req.ISBSvcType), "--side-inputs-store=" + req.SideInputsStoreName, "--side-inputs=" + strings.Join(v.Spec.SideInputs, ",")},
+			Args:            append([]string{"side-inputs-init"}, v.sideInputsArgs(req)...),
 		})
 	}
 
@@ -431,6 +433,21 @@ func (v Vertex) getInitContainers(req GetVertexPodSpecReq) []corev1.Container {
 	return append(initContainers, v.Spec.InitContainers...)
 }
 
+// sideInputsArgs returns the args of the side inputs init and synchronizer containers.
+func (v Vertex) sideInputsArgs(req GetVertexPodSpecReq) []string {
+	args := []string{"--isbsvc-type=" + string(req.ISBSvcType), "--side-inputs-store=" + req.SideInputsStoreName, "--side-inputs=" + strings.Join(v.Spec.SideInputs, ",")}
+	var pinned []string
+	for _, si := range req.PipelineSpec.SideInputs {
+		if si.PinnedVersion != nil && slices.Contains(v.Spec.SideInputs, si.Name) {
+			pinned = append(pinned, fmt.Sprintf("%s=%d", si.Name, *si.PinnedVersion))
+		}
+	}
+	if len(pinned) > 0 {
+		args = append(args, "--pinned-versions="+strings.Join(pinned, ","))
+	}
+	return args
+}
+
 func (vs VertexSpec) DeepCopyWithoutReplicasAndLifecycle() VertexSpec {
 	x := *vs.DeepCopy()
 	x.Replicas = ptr.To[int32](0)
@@ -438,6 +455,14 @@ func (vs VertexSpec) DeepCopyWithoutReplicasAndLifecycle() VertexSpec {
 	return x
 }
 
+// GetCompressionType returns the compression type of the messages written to the InterStepBuffer.
+func (vs VertexSpec) GetCompressionType() CompressionType {
+	if vs.InterStepBuffer == nil || vs.InterStepBuffer.Compression == nil || vs.InterStepBuffer.Compression.Type == "" {
+		return CompressionTypeNone
+	}
+	return vs.InterStepBuffer.Compression.Type
+}
+
 // OwnedBuffers returns the buffers that the vertex owns
 func (v Vertex) OwnedBuffers() []string {
 	return v.Spec.OwnedBufferNames(v.Namespace, v.Spec.PipelineName)
@@ -500,8 +525,9 @@ func (v Vertex) CalculateReplicas() int {
 	desiredReplicas := v.getReplicas()
 	// Don't allow replicas to be out of the range of min and max when auto scaling is enabled
 	if s := v.Spec.Scale; !s.Disabled {
-		max := int(s.GetMaxReplicas())
-		min := int(s.GetMinReplicas())
+		now := time.Now()
+		max := int(s.GetEffectiveMaxReplicas(now))
+		min := int(s.GetEffectiveMinReplicas(now))
 		if desiredReplicas < min {
 			desiredReplicas = min
 		} else if desiredReplicas > max {
@@ -733,6 +759,16 @@ type VertexStatus struct {
 	CurrentHash string `json:"currentHash,omitempty" protobuf:"bytes,13,opt,name=currentHash"`
 	// If not empty, indicates the updated version of the Vertex used to generate Pods.
 	UpdateHash string `json:"updateHash,omitempty" protobuf:"bytes,14,opt,name=updateHash"`
+	// The last scaling decision made by the autoscaler, only recorded when scale.dryRun is enabled.
+	// +optional
+	LastScalingDecision *ScalingDecision `json:"lastScalingDecision,omitempty" protobuf:"bytes,15,opt,name=lastScalingDecision"`
+	// The name of the active scale schedule, if there's any.
+	// +optional
+	ActiveScaleSchedule string `json:"activeScaleSchedule,omitempty" protobuf:"bytes,16,opt,name=activeScaleSchedule"`
+	// The reason the predictive scale policy can not forecast the processing rate, in which case the default policy is used.
+	// Empty when the forecast is available, or the vertex doesn't use the predictive scale policy.
+	// +optional
+	ForecastUnavailableReason string `json:"forecastUnavailableReason,omitempty" protobuf:"bytes,17,opt,name=forecastUnavailableReason"`
 }
 
 func (vs *VertexStatus) MarkPhase(phase VertexPhase, reason, message string) {
@@ -130,6 +130,17 @@ func TestWithoutReplicas(t *testing.T) {
 	assert.Equal(t, VertexLifecycle{}, dc.Lifecycle)
 }
 
+func TestGetCompressionType(t *testing.T) {
+	s := VertexSpec{}
+	assert.Equal(t, CompressionTypeNone, s.GetCompressionType())
+	s.InterStepBuffer = &InterStepBuffer{}
+	assert.Equal(t, CompressionTypeNone, s.GetCompressionType())
+	s.InterStepBuffer.Compression = &Compression{}
+	assert.Equal(t, CompressionTypeNone, s.GetCompressionType())
+	s.InterStepBuffer.Compression.Type = CompressionTypeZSTD
+	assert.Equal(t, CompressionTypeZSTD, s.GetCompressionType())
+}
+
 func TestGetVertexReplicas(t *testing.T) {
 	v := Vertex{
 		Spec: VertexSpec{
@@ -519,6 +530,27 @@ func TestGetPodSpec(t *testing.T) {
 		assert.Equal(t, 1, len(s.Containers[1].VolumeMounts))
 		assert.Equal(t, "var-run-side-inputs", s.Containers[1].VolumeMounts[0].Name)
 		assert.False(t, s.Containers[1].VolumeMounts[0].ReadOnly)
+		assert.NotContains(t, strings.Join(s.Containers[1].Args, " "), "--pinned-versions")
+	})
+
+	t.Run("test udf with pinned side inputs", func(t *testing.T) {
+		testObj := testVertex.DeepCopy()
+		testObj.Spec.SideInputs = []string{"input1", "input2"}
+		testObj.Spec.UDF = &UDF{
+			Container: &Container{
+				Image: "test-image",
+			},
+		}
+		pinnedReq := req
+		pinnedReq.PipelineSpec = PipelineSpec{SideInputs: []SideInput{
+			{Name: "input1", PinnedVersion: ptr.To[int64](3)},
+			{Name: "input2"},
+			{Name: "input3", PinnedVersion: ptr.To[int64](1)},
+		}}
+		s, err := testObj.GetPodSpec(pinnedReq)
+		assert.NoError(t, err)
+		assert.Contains(t, s.Containers[1].Args, "--pinned-versions=input1=3")
+		assert.Contains(t, s.InitContainers[1].Args, "--pinned-versions=input1=3")
 	})
 }
 
@@ -136,7 +136,7 @@ func (in *AbstractSink) DeepCopyInto(out *AbstractSink) {
 	if in.Sqs != nil {
 		in, out := &in.Sqs, &out.Sqs
 		*out = new(SqsSink)
-		**out = **in
+		(*in).DeepCopyInto(*out)
 	}
 	if in.Pulsar != nil {
 		in, out := &in.Pulsar, &out.Pulsar
@@ -392,6 +392,22 @@ func (in *BufferServiceConfig) DeepCopy() *BufferServiceConfig {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CPUScalePolicy) DeepCopyInto(out *CPUScalePolicy) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CPUScalePolicy.
+func (in *CPUScalePolicy) DeepCopy() *CPUScalePolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(CPUScalePolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *CombinedEdge) DeepCopyInto(out *CombinedEdge) {
 	*out = *in
@@ -564,6 +580,27 @@ func (in *ContainerTemplate) DeepCopy() *ContainerTemplate {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CountWindow) DeepCopyInto(out *CountWindow) {
+	*out = *in
+	if in.Timeout != nil {
+		in, out := &in.Timeout, &out.Timeout
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CountWindow.
+func (in *CountWindow) DeepCopy() *CountWindow {
+	if in == nil {
+		return nil
+	}
+	out := new(CountWindow)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *DaemonTemplate) DeepCopyInto(out *DaemonTemplate) {
 	*out = *in
@@ -596,6 +633,70 @@ func (in *DaemonTemplate) DeepCopy() *DaemonTemplate {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DeadLetterOutput) DeepCopyInto(out *DeadLetterOutput) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DeadLetterOutput.
+func (in *DeadLetterOutput) DeepCopy() *DeadLetterOutput {
+	if in == nil {
+		return nil
+	}
+	out := new(DeadLetterOutput)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DedupWindow) DeepCopyInto(out *DedupWindow) {
+	*out = *in
+	out.Duration = in.Duration
+	if in.MaxEntries != nil {
+		in, out := &in.MaxEntries, &out.MaxEntries
+		*out = new(int32)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DedupWindow.
+func (in *DedupWindow) DeepCopy() *DedupWindow {
+	if in == nil {
+		return nil
+	}
+	out := new(DedupWindow)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EarlyFiring) DeepCopyInto(out *EarlyFiring) {
+	*out = *in
+	if in.Interval != nil {
+		in, out := &in.Interval, &out.Interval
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	if in.Count != nil {
+		in, out := &in.Count, &out.Count
+		*out = new(int32)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EarlyFiring.
+func (in *EarlyFiring) DeepCopy() *EarlyFiring {
+	if in == nil {
+		return nil
+	}
+	out := new(EarlyFiring)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Edge) DeepCopyInto(out *Edge) {
 	*out = *in
@@ -609,6 +710,16 @@ func (in *Edge) DeepCopyInto(out *Edge) {
 		*out = new(BufferFullWritingStrategy)
 		**out = **in
 	}
+	if in.Partitioning != nil {
+		in, out := &in.Partitioning, &out.Partitioning
+		*out = new(PartitioningStrategy)
+		**out = **in
+	}
+	if in.HotKeySalting != nil {
+		in, out := &in.HotKeySalting, &out.HotKeySalting
+		*out = new(HotKeySalting)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -664,6 +775,29 @@ func (in *ForwardConditions) DeepCopy() *ForwardConditions {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Function) DeepCopyInto(out *Function) {
+	*out = *in
+	if in.KWArgs != nil {
+		in, out := &in.KWArgs, &out.KWArgs
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Function.
+func (in *Function) DeepCopy() *Function {
+	if in == nil {
+		return nil
+	}
+	out := new(Function)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *GSSAPI) DeepCopyInto(out *GSSAPI) {
 	*out = *in
@@ -996,6 +1130,27 @@ func (in *GetVertexPodSpecReq) DeepCopy() *GetVertexPodSpecReq {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GlobalWindow) DeepCopyInto(out *GlobalWindow) {
+	*out = *in
+	if in.Trigger != nil {
+		in, out := &in.Trigger, &out.Trigger
+		*out = new(WindowTrigger)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GlobalWindow.
+func (in *GlobalWindow) DeepCopy() *GlobalWindow {
+	if in == nil {
+		return nil
+	}
+	out := new(GlobalWindow)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *GroupBy) DeepCopyInto(out *GroupBy) {
 	*out = *in
@@ -1010,6 +1165,16 @@ func (in *GroupBy) DeepCopyInto(out *GroupBy) {
 		*out = new(PBQStorage)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.EarlyFiring != nil {
+		in, out := &in.EarlyFiring, &out.EarlyFiring
+		*out = new(EarlyFiring)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.LateData != nil {
+		in, out := &in.LateData, &out.LateData
+		*out = new(LateDataOutput)
+		**out = **in
+	}
 	return
 }
 
@@ -1031,6 +1196,11 @@ func (in *HTTPSource) DeepCopyInto(out *HTTPSource) {
 		*out = new(Authorization)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.AckTimeout != nil {
+		in, out := &in.AckTimeout, &out.AckTimeout
+		*out = new(metav1.Duration)
+		**out = **in
+	}
 	return
 }
 
@@ -1044,6 +1214,32 @@ func (in *HTTPSource) DeepCopy() *HTTPSource {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HotKeySalting) DeepCopyInto(out *HotKeySalting) {
+	*out = *in
+	if in.SubPartitions != nil {
+		in, out := &in.SubPartitions, &out.SubPartitions
+		*out = new(int32)
+		**out = **in
+	}
+	if in.ThresholdPercentage != nil {
+		in, out := &in.ThresholdPercentage, &out.ThresholdPercentage
+		*out = new(int32)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HotKeySalting.
+func (in *HotKeySalting) DeepCopy() *HotKeySalting {
+	if in == nil {
+		return nil
+	}
+	out := new(HotKeySalting)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *IdleSource) DeepCopyInto(out *IdleSource) {
 	*out = *in
@@ -1399,6 +1595,22 @@ func (in *KafkaSource) DeepCopy() *KafkaSource {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LateDataOutput) DeepCopyInto(out *LateDataOutput) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LateDataOutput.
+func (in *LateDataOutput) DeepCopy() *LateDataOutput {
+	if in == nil {
+		return nil
+	}
+	out := new(LateDataOutput)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Lifecycle) DeepCopyInto(out *Lifecycle) {
 	*out = *in
@@ -1794,6 +2006,27 @@ func (in *NoStore) DeepCopy() *NoStore {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ObjectStoreStorage) DeepCopyInto(out *ObjectStoreStorage) {
+	*out = *in
+	if in.EndpointURL != nil {
+		in, out := &in.EndpointURL, &out.EndpointURL
+		*out = new(string)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ObjectStoreStorage.
+func (in *ObjectStoreStorage) DeepCopy() *ObjectStoreStorage {
+	if in == nil {
+		return nil
+	}
+	out := new(ObjectStoreStorage)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *PBQStorage) DeepCopyInto(out *PBQStorage) {
 	*out = *in
@@ -1812,6 +2045,11 @@ func (in *PBQStorage) DeepCopyInto(out *PBQStorage) {
 		*out = new(NoStore)
 		**out = **in
 	}
+	if in.ObjectStore != nil {
+		in, out := &in.ObjectStore, &out.ObjectStore
+		*out = new(ObjectStoreStorage)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -1994,6 +2232,11 @@ func (in *PipelineSpec) DeepCopyInto(out *PipelineSpec) {
 		*out = new(InterStepBuffer)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.UpgradeStrategy != nil {
+		in, out := &in.UpgradeStrategy, &out.UpgradeStrategy
+		*out = new(PipelineUpgradeStrategy)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -2042,6 +2285,11 @@ func (in *PipelineStatus) DeepCopyInto(out *PipelineStatus) {
 		*out = new(uint32)
 		**out = **in
 	}
+	if in.Upgrade != nil {
+		in, out := &in.Upgrade, &out.Upgrade
+		*out = new(PipelineUpgradeStatus)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -2055,6 +2303,45 @@ func (in *PipelineStatus) DeepCopy() *PipelineStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PipelineUpgradeStatus) DeepCopyInto(out *PipelineUpgradeStatus) {
+	*out = *in
+	in.StartedAt.DeepCopyInto(&out.StartedAt)
+	in.LastTransitionTime.DeepCopyInto(&out.LastTransitionTime)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PipelineUpgradeStatus.
+func (in *PipelineUpgradeStatus) DeepCopy() *PipelineUpgradeStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(PipelineUpgradeStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PipelineUpgradeStrategy) DeepCopyInto(out *PipelineUpgradeStrategy) {
+	*out = *in
+	if in.ProgressDeadlineSeconds != nil {
+		in, out := &in.ProgressDeadlineSeconds, &out.ProgressDeadlineSeconds
+		*out = new(int64)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PipelineUpgradeStrategy.
+func (in *PipelineUpgradeStrategy) DeepCopy() *PipelineUpgradeStrategy {
+	if in == nil {
+		return nil
+	}
+	out := new(PipelineUpgradeStrategy)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Ports) DeepCopyInto(out *Ports) {
 	*out = *in
@@ -2081,6 +2368,32 @@ func (in *Ports) DeepCopy() *Ports {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PredictiveScalePolicy) DeepCopyInto(out *PredictiveScalePolicy) {
+	*out = *in
+	if in.LookaheadSeconds != nil {
+		in, out := &in.LookaheadSeconds, &out.LookaheadSeconds
+		*out = new(uint32)
+		**out = **in
+	}
+	if in.PeriodSeconds != nil {
+		in, out := &in.PeriodSeconds, &out.PeriodSeconds
+		*out = new(uint32)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PredictiveScalePolicy.
+func (in *PredictiveScalePolicy) DeepCopy() *PredictiveScalePolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(PredictiveScalePolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Probe) DeepCopyInto(out *Probe) {
 	*out = *in
@@ -2487,6 +2800,18 @@ func (in *Scale) DeepCopyInto(out *Scale) {
 		*out = new(uint32)
 		**out = **in
 	}
+	if in.Policy != nil {
+		in, out := &in.Policy, &out.Policy
+		*out = new(ScalePolicy)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Schedules != nil {
+		in, out := &in.Schedules, &out.Schedules
+		*out = make([]ScaleSchedule, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	return
 }
 
@@ -2500,6 +2825,81 @@ func (in *Scale) DeepCopy() *Scale {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ScalePolicy) DeepCopyInto(out *ScalePolicy) {
+	*out = *in
+	if in.TargetLag != nil {
+		in, out := &in.TargetLag, &out.TargetLag
+		*out = new(TargetLagScalePolicy)
+		**out = **in
+	}
+	if in.CPU != nil {
+		in, out := &in.CPU, &out.CPU
+		*out = new(CPUScalePolicy)
+		**out = **in
+	}
+	if in.Predictive != nil {
+		in, out := &in.Predictive, &out.Predictive
+		*out = new(PredictiveScalePolicy)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ScalePolicy.
+func (in *ScalePolicy) DeepCopy() *ScalePolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(ScalePolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ScaleSchedule) DeepCopyInto(out *ScaleSchedule) {
+	*out = *in
+	out.Duration = in.Duration
+	if in.Min != nil {
+		in, out := &in.Min, &out.Min
+		*out = new(int32)
+		**out = **in
+	}
+	if in.Max != nil {
+		in, out := &in.Max, &out.Max
+		*out = new(int32)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ScaleSchedule.
+func (in *ScaleSchedule) DeepCopy() *ScaleSchedule {
+	if in == nil {
+		return nil
+	}
+	out := new(ScaleSchedule)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ScalingDecision) DeepCopyInto(out *ScalingDecision) {
+	*out = *in
+	in.DecidedAt.DeepCopyInto(&out.DecidedAt)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ScalingDecision.
+func (in *ScalingDecision) DeepCopy() *ScalingDecision {
+	if in == nil {
+		return nil
+	}
+	out := new(ScalingDecision)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ServeSink) DeepCopyInto(out *ServeSink) {
 	*out = *in
@@ -2743,6 +3143,16 @@ func (in *SideInput) DeepCopyInto(out *SideInput) {
 		*out = new(SideInputTrigger)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.RetainedVersions != nil {
+		in, out := &in.RetainedVersions, &out.RetainedVersions
+		*out = new(int32)
+		**out = **in
+	}
+	if in.PinnedVersion != nil {
+		in, out := &in.PinnedVersion, &out.PinnedVersion
+		*out = new(int64)
+		**out = **in
+	}
 	return
 }
 
@@ -2764,6 +3174,16 @@ func (in *SideInputTrigger) DeepCopyInto(out *SideInputTrigger) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.Watch != nil {
+		in, out := &in.Watch, &out.Watch
+		*out = new(SideInputWatch)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Auth != nil {
+		in, out := &in.Auth, &out.Auth
+		*out = new(Authorization)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -2777,6 +3197,32 @@ func (in *SideInputTrigger) DeepCopy() *SideInputTrigger {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SideInputWatch) DeepCopyInto(out *SideInputWatch) {
+	*out = *in
+	if in.ConfigMaps != nil {
+		in, out := &in.ConfigMaps, &out.ConfigMaps
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Secrets != nil {
+		in, out := &in.Secrets, &out.Secrets
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SideInputWatch.
+func (in *SideInputWatch) DeepCopy() *SideInputWatch {
+	if in == nil {
+		return nil
+	}
+	out := new(SideInputWatch)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SideInputsManagerTemplate) DeepCopyInto(out *SideInputsManagerTemplate) {
 	*out = *in
@@ -2906,6 +3352,11 @@ func (in *Source) DeepCopyInto(out *Source) {
 		*out = new(SqsSource)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Dedup != nil {
+		in, out := &in.Dedup, &out.Dedup
+		*out = new(DedupWindow)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -2922,6 +3373,11 @@ func (in *Source) DeepCopy() *Source {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SqsSink) DeepCopyInto(out *SqsSink) {
 	*out = *in
+	if in.EndpointURL != nil {
+		in, out := &in.EndpointURL, &out.EndpointURL
+		*out = new(string)
+		**out = **in
+	}
 	return
 }
 
@@ -3061,6 +3517,22 @@ func (in *TagConditions) DeepCopy() *TagConditions {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TargetLagScalePolicy) DeepCopyInto(out *TargetLagScalePolicy) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TargetLagScalePolicy.
+func (in *TargetLagScalePolicy) DeepCopy() *TargetLagScalePolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(TargetLagScalePolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Templates) DeepCopyInto(out *Templates) {
 	*out = *in
@@ -3105,11 +3577,26 @@ func (in *UDF) DeepCopyInto(out *UDF) {
 		*out = new(Container)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Builtin != nil {
+		in, out := &in.Builtin, &out.Builtin
+		*out = new(Function)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.GroupBy != nil {
 		in, out := &in.GroupBy, &out.GroupBy
 		*out = new(GroupBy)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.RetryStrategy != nil {
+		in, out := &in.RetryStrategy, &out.RetryStrategy
+		*out = new(RetryStrategy)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.DeadLetter != nil {
+		in, out := &in.DeadLetter, &out.DeadLetter
+		*out = new(DeadLetterOutput)
+		**out = **in
+	}
 	return
 }
 
@@ -3389,6 +3876,11 @@ func (in *VertexStatus) DeepCopyInto(out *VertexStatus) {
 	*out = *in
 	in.Status.DeepCopyInto(&out.Status)
 	in.LastScaledAt.DeepCopyInto(&out.LastScaledAt)
+	if in.LastScalingDecision != nil {
+		in, out := &in.LastScalingDecision, &out.LastScalingDecision
+		*out = new(ScalingDecision)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -3478,6 +3970,16 @@ func (in *Window) DeepCopyInto(out *Window) {
 		*out = new(AccumulatorWindow)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Count != nil {
+		in, out := &in.Count, &out.Count
+		*out = new(CountWindow)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Global != nil {
+		in, out := &in.Global, &out.Global
+		*out = new(GlobalWindow)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -3490,3 +3992,29 @@ func (in *Window) DeepCopy() *Window {
 	in.DeepCopyInto(out)
 	return out
 }
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WindowTrigger) DeepCopyInto(out *WindowTrigger) {
+	*out = *in
+	if in.Count != nil {
+		in, out := &in.Count, &out.Count
+		*out = new(int32)
+		**out = **in
+	}
+	if in.Interval != nil {
+		in, out := &in.Interval, &out.Interval
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WindowTrigger.
+func (in *WindowTrigger) DeepCopy() *WindowTrigger {
+	if in == nil {
+		return nil
+	}
+	out := new(WindowTrigger)
+	in.DeepCopyInto(out)
+	return out
+}
@@ -37,14 +37,20 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.BasicAuth":                        schema_pkg_apis_numaflow_v1alpha1_BasicAuth(ref),
 		"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.Blackhole":                        schema_pkg_apis_numaflow_v1alpha1_Blackhole(ref),
 		"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.BufferServiceConfig":              schema_pkg_apis_numaflow_v1alpha1_BufferServiceConfig(ref),
+		"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.CPUScalePolicy":                   schema_pkg_apis_numaflow_v1alpha1_CPUScalePolicy(ref),
 		"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.CombinedEdge":                     schema_pkg_apis_numaflow_v1alpha1_CombinedEdge(ref),
 		"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.Compression":                      schema_pkg_apis_numaflow_v1alpha1_Compression(ref),
 		"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.Container":                        schema_pkg_apis_numaflow_v1alpha1_Container(ref),
 		"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.ContainerTemplate":                schema_pkg_apis_numaflow_v1alpha1_ContainerTemplate(ref),
+		"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.CountWindow":                      schema_pkg_apis_numaflow_v1alpha1_CountWindow(ref),
 		"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.DaemonTemplate":                   schema_pkg_apis_numaflow_v1alpha1_DaemonTemplate(ref),
+		"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.DeadLetterOutput":                 schema_pkg_apis_numaflow_v1alpha1_DeadLetterOutput(ref),
+		"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.DedupWindow":                      schema_pkg_apis_numaflow_v1alpha1_DedupWindow(ref),
+		"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.EarlyFiring":                      schema_pkg_apis_numaflow_v1alpha1_EarlyFiring(ref),
 		"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.Edge":                             schema_pkg_apis_numaflow_v1alpha1_Edge(ref),
 		"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.FixedWindow":                      schema_pkg_apis_numaflow_v1alpha1_FixedWindow(ref),
 		"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.ForwardConditions":                schema_pkg_apis_numaflow_v1alpha1_ForwardConditions(ref),
+		"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.Function":                         schema_pkg_apis_numaflow_v1alpha1_Function(ref),
 		"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.GSSAPI":                           schema_pkg_apis_numaflow_v1alpha1_GSSAPI(ref),
 		"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.GeneratorSource":                  schema_pkg_apis_numaflow_v1alpha1_GeneratorSource(ref),
 		"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.GetDaemonDeploymentReq":           schema_pkg_apis_numaflow_v1alpha1_GetDaemonDeploymentReq(ref),
@@ -57,8 +63,10 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.GetServingPipelineResourceReq":    schema_pkg_apis_numaflow_v1alpha1_GetServingPipelineResourceReq(ref),
 		"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.GetSideInputDeploymentReq":        schema_pkg_apis_numaflow_v1alpha1_GetSideInputDeploymentReq(ref),
 		"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.GetVertexPodSpecReq":              schema_pkg_apis_numaflow_v1alpha1_GetVertexPodSpecReq(ref),
+		"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.GlobalWindow":                     schema_pkg_apis_numaflow_v1alpha1_GlobalWindow(ref),
 		"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.GroupBy":                          schema_pkg_apis_numaflow_v1alpha1_GroupBy(ref),
 		"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.HTTPSource":                       schema_pkg_apis_numaflow_v1alpha1_HTTPSource(ref),
+		"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.HotKeySalting":                    schema_pkg_apis_numaflow_v1alpha1_HotKeySalting(ref),
 		"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.IdleSource":                       schema_pkg_apis_numaflow_v1alpha1_IdleSource(ref),
 		"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.InterStepBuffer":                  schema_pkg_apis_numaflow_v1alpha1_InterStepBuffer(ref),
 		"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.InterStepBufferService":           schema_pkg_apis_numaflow_v1alpha1_InterStepBufferService(ref),
@@ -71,6 +79,7 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.JobTemplate":                      schema_pkg_apis_numaflow_v1alpha1_JobTemplate(ref),
 		"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.KafkaSink":                        schema_pkg_apis_numaflow_v1alpha1_KafkaSink(ref),
 		"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.KafkaSource":                      schema_pkg_apis_numaflow_v1alpha1_KafkaSource(ref),
+		"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.LateDataOutput":                   schema_pkg_apis_numaflow_v1alpha1_LateDataOutput(ref),
 		"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.Lifecycle":                        schema_pkg_apis_numaflow_v1alpha1_Lifecycle(ref),
 		"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.Log":                              schema_pkg_apis_numaflow_v1alpha1_Log(ref),
 		"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.Metadata":                         schema_pkg_apis_numaflow_v1alpha1_Metadata(ref),
@@ -84,6 +93,7 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.NatsAuth":                         schema_pkg_apis_numaflow_v1alpha1_NatsAuth(ref),
 		"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.NatsSource":                       schema_pkg_apis_numaflow_v1alpha1_NatsSource(ref),
 		"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.NoStore":                          schema_pkg_apis_numaflow_v1alpha1_NoStore(ref),
+		"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.ObjectStoreStorage":               schema_pkg_apis_numaflow_v1alpha1_ObjectStoreStorage(ref),
 		"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.PBQStorage":                       schema_pkg_apis_numaflow_v1alpha1_PBQStorage(ref),
 		"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.PersistenceStrategy":              schema_pkg_apis_numaflow_v1alpha1_PersistenceStrategy(ref),
 		"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.Pipeline":                         schema_pkg_apis_numaflow_v1alpha1_Pipeline(ref),
@@ -91,7 +101,10 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.PipelineList":                     schema_pkg_apis_numaflow_v1alpha1_PipelineList(ref),
 		"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.PipelineSpec":                     schema_pkg_apis_numaflow_v1alpha1_PipelineSpec(ref),
 		"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.PipelineStatus":                   schema_pkg_apis_numaflow_v1alpha1_PipelineStatus(ref),
+		"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.PipelineUpgradeStatus":            schema_pkg_apis_numaflow_v1alpha1_PipelineUpgradeStatus(ref),
+		"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.PipelineUpgradeStrategy":          schema_pkg_apis_numaflow_v1alpha1_PipelineUpgradeStrategy(ref),
 		"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.Ports":                            schema_pkg_apis_numaflow_v1alpha1_Ports(ref),
+		"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.PredictiveScalePolicy":            schema_pkg_apis_numaflow_v1alpha1_PredictiveScalePolicy(ref),
 		"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.Probe":                            schema_pkg_apis_numaflow_v1alpha1_Probe(ref),
 		"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.PulsarAuth":                       schema_pkg_apis_numaflow_v1alpha1_PulsarAuth(ref),
 		"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.PulsarBasicAuth":                  schema_pkg_apis_numaflow_v1alpha1_PulsarBasicAuth(ref),
@@ -106,6 +119,9 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.SASLOAuth":                        schema_pkg_apis_numaflow_v1alpha1_SASLOAuth(ref),
 		"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.SASLPlain":                        schema_pkg_apis_numaflow_v1alpha1_SASLPlain(ref),
 		"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.Scale":                            schema_pkg_apis_numaflow_v1alpha1_Scale(ref),
+		"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.ScalePolicy":                      schema_pkg_apis_numaflow_v1alpha1_ScalePolicy(ref),
+		"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.ScaleSchedule":                    schema_pkg_apis_numaflow_v1alpha1_ScaleSchedule(ref),
+		"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.ScalingDecision":                  schema_pkg_apis_numaflow_v1alpha1_ScalingDecision(ref),
 		"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.ServeSink":                        schema_pkg_apis_numaflow_v1alpha1_ServeSink(ref),
 		"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.ServingPipeline":                  schema_pkg_apis_numaflow_v1alpha1_ServingPipeline(ref),
 		"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.ServingPipelineList":              schema_pkg_apis_numaflow_v1alpha1_ServingPipelineList(ref),
@@ -117,6 +133,7 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.SessionWindow":                    schema_pkg_apis_numaflow_v1alpha1_SessionWindow(ref),
 		"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.SideInput":                        schema_pkg_apis_numaflow_v1alpha1_SideInput(ref),
 		"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.SideInputTrigger":                 schema_pkg_apis_numaflow_v1alpha1_SideInputTrigger(ref),
+		"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.SideInputWatch":                   schema_pkg_apis_numaflow_v1alpha1_SideInputWatch(ref),
 		"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.SideInputsManagerTemplate":        schema_pkg_apis_numaflow_v1alpha1_SideInputsManagerTemplate(ref),
 		"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.Sink":                             schema_pkg_apis_numaflow_v1alpha1_Sink(ref),
 		"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.SlidingWindow":                    schema_pkg_apis_numaflow_v1alpha1_SlidingWindow(ref),
@@ -126,6 +143,7 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.Status":                           schema_pkg_apis_numaflow_v1alpha1_Status(ref),
 		"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.TLS":                              schema_pkg_apis_numaflow_v1alpha1_TLS(ref),
 		"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.TagConditions":                    schema_pkg_apis_numaflow_v1alpha1_TagConditions(ref),
+		"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.TargetLagScalePolicy":             schema_pkg_apis_numaflow_v1alpha1_TargetLagScalePolicy(ref),
 		"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.Templates":                        schema_pkg_apis_numaflow_v1alpha1_Templates(ref),
 		"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.UDF":                              schema_pkg_apis_numaflow_v1alpha1_UDF(ref),
 		"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.UDSink":                           schema_pkg_apis_numaflow_v1alpha1_UDSink(ref),
@@ -142,6 +160,7 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.VertexTemplate":                   schema_pkg_apis_numaflow_v1alpha1_VertexTemplate(ref),
 		"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.Watermark":                        schema_pkg_apis_numaflow_v1alpha1_Watermark(ref),
 		"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.Window":                           schema_pkg_apis_numaflow_v1alpha1_Window(ref),
+		"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.WindowTrigger":                    schema_pkg_apis_numaflow_v1alpha1_WindowTrigger(ref),
 		"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.containerBuilder":                 schema_pkg_apis_numaflow_v1alpha1_containerBuilder(ref),
 		"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.getContainerReq":                  schema_pkg_apis_numaflow_v1alpha1_getContainerReq(ref),
 	}
@@ -785,6 +804,27 @@ func schema_pkg_apis_numaflow_v1alpha1_BufferServiceConfig(ref common.ReferenceC
 	}
 }
 
+func schema_pkg_apis_numaflow_v1alpha1_CPUScalePolicy(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"targetUtilization": {
+						SchemaProps: spec.SchemaProps{
+							Description: "TargetUtilization is the target average CPU utilization of the pods, represented as a percentage of the requested CPU, for example, 70.",
+							Default:     0,
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+				},
+				Required: []string{"targetUtilization"},
+			},
+		},
+	}
+}
+
 func schema_pkg_apis_numaflow_v1alpha1_CombinedEdge(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -819,6 +859,19 @@ func schema_pkg_apis_numaflow_v1alpha1_CombinedEdge(ref common.ReferenceCallback
 							Format:      "",
 						},
 					},
+					"partitioning": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Partitioning specifies how the messages are assigned to the partitions of the to vertex based on the keys. There are currently two options, modulo and jumpHash. With modulo, changing the partition count remaps nearly every key, while jumpHash only moves ~1/N of the keys, which is preferred for keyed reduce vertices. if not provided, the default value is set to \"modulo\"",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"hotKeySalting": {
+						SchemaProps: spec.SchemaProps{
+							Description: "HotKeySalting splits the messages of a hot key across multiple partitions of the to vertex, only applicable when the to vertex is a keyed reduce vertex with more than one partition. The partial results of a salted key need to be combined by a downstream reduce vertex, so it only works with associative reducers.",
+							Ref:         ref("github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.HotKeySalting"),
+						},
+					},
 					"fromVertexType": {
 						SchemaProps: spec.SchemaProps{
 							Description: "From vertex type.",
@@ -864,7 +917,7 @@ func schema_pkg_apis_numaflow_v1alpha1_CombinedEdge(ref common.ReferenceCallback
 			},
 		},
 		Dependencies: []string{
-			"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.ForwardConditions", "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.VertexLimits"},
+			"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.ForwardConditions", "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.HotKeySalting", "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.VertexLimits"},
 	}
 }
 
@@ -1096,6 +1149,36 @@ func schema_pkg_apis_numaflow_v1alpha1_ContainerTemplate(ref common.ReferenceCal
 	}
 }
 
+func schema_pkg_apis_numaflow_v1alpha1_CountWindow(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "CountWindow describes a tumbling count window, which closes once it has seen a fixed number of messages for a key.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"count": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Count is the number of messages of a key after which the window closes.",
+							Default:     0,
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"timeout": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Timeout is the duration of inactivity after which a partially filled window closes. If not set, a partially filled window stays open until it receives enough messages.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
+						},
+					},
+				},
+				Required: []string{"count"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Duration"},
+	}
+}
+
 func schema_pkg_apis_numaflow_v1alpha1_DaemonTemplate(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -1263,6 +1346,85 @@ func schema_pkg_apis_numaflow_v1alpha1_DaemonTemplate(ref common.ReferenceCallba
 	}
 }
 
+func schema_pkg_apis_numaflow_v1alpha1_DeadLetterOutput(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "DeadLetterOutput describes where the messages failed by a map vertex are written to. The dead letters are the messages read by the map vertex, they keep their original headers, and carry the error of the UDF.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"toVertex": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ToVertex is the name of the vertex the dead letters are written to, it has to be connected to the map vertex with an edge. The dead letters bypass the conditions of the edge.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"toVertex"},
+			},
+		},
+	}
+}
+
+func schema_pkg_apis_numaflow_v1alpha1_DedupWindow(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "DedupWindow describes how the duplicated messages of a source are dropped. The messages are identified by the offsets in their IDs, and the IDs are stored in a KV store of the ISB Service, so that they are shared across the replicas and survive the pod restarts.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"duration": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Duration is how long the ID of a message is remembered, a message whose ID has been seen within the duration is dropped.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
+						},
+					},
+					"maxEntries": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MaxEntries is the max number of the IDs cached in memory by each replica, the least recently seen ones are evicted first. The evicted IDs are still looked up in the KV store. With a JetStream ISB Service, it also caps the number of the IDs kept in the KV store, the oldest ones are discarded first. Defaults to 100000.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+				},
+				Required: []string{"duration"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Duration"},
+	}
+}
+
+func schema_pkg_apis_numaflow_v1alpha1_EarlyFiring(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "EarlyFiring describes when the speculative partial results of a window are emitted, a partial result is emitted as soon as any of the conditions is met.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"interval": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Interval emits a partial result every Interval (processing time) while the window is open.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
+						},
+					},
+					"count": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Count emits a partial result every Count messages of the window.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Duration"},
+	}
+}
+
 func schema_pkg_apis_numaflow_v1alpha1_Edge(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -1296,12 +1458,25 @@ func schema_pkg_apis_numaflow_v1alpha1_Edge(ref common.ReferenceCallback) common
 							Format:      "",
 						},
 					},
+					"partitioning": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Partitioning specifies how the messages are assigned to the partitions of the to vertex based on the keys. There are currently two options, modulo and jumpHash. With modulo, changing the partition count remaps nearly every key, while jumpHash only moves ~1/N of the keys, which is preferred for keyed reduce vertices. if not provided, the default value is set to \"modulo\"",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"hotKeySalting": {
+						SchemaProps: spec.SchemaProps{
+							Description: "HotKeySalting splits the messages of a hot key across multiple partitions of the to vertex, only applicable when the to vertex is a keyed reduce vertex with more than one partition. The partial results of a salted key need to be combined by a downstream reduce vertex, so it only works with associative reducers.",
+							Ref:         ref("github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.HotKeySalting"),
+						},
+					},
 				},
 				Required: []string{"from", "to"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.ForwardConditions"},
+			"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.ForwardConditions", "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.HotKeySalting"},
 	}
 }
 
@@ -1345,8 +1520,14 @@ func schema_pkg_apis_numaflow_v1alpha1_ForwardConditions(ref common.ReferenceCal
 							Ref:         ref("github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.TagConditions"),
 						},
 					},
+					"expression": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Expression is a boolean expression evaluated against the payload and the headers of a message, the message is forwarded only if it evaluates to true. The payload is available as \"payload\" and the headers as \"headers\", along with the json(), int() and string() functions, e.g. `json(payload).amount > 100 && headers[\"type\"] == \"order\"`. A message which fails the evaluation, e.g. a payload which is not a valid JSON, is not forwarded. If both tags and expression are specified, the message needs to match both of them.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
 				},
-				Required: []string{"tags"},
 			},
 		},
 		Dependencies: []string{
@@ -1354,6 +1535,44 @@ func schema_pkg_apis_numaflow_v1alpha1_ForwardConditions(ref common.ReferenceCal
 	}
 }
 
+func schema_pkg_apis_numaflow_v1alpha1_Function(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "Function describes a built-in map function.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name of the built-in function, one of \"cat\", \"filter\", \"project\" and \"eventTime\".",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"kwargs": {
+						SchemaProps: spec.SchemaProps{
+							Description: "KWArgs are the keyword arguments of the function.",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"name"},
+			},
+		},
+	}
+}
+
 func schema_pkg_apis_numaflow_v1alpha1_GSSAPI(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -2169,6 +2388,27 @@ func schema_pkg_apis_numaflow_v1alpha1_GetVertexPodSpecReq(ref common.ReferenceC
 	}
 }
 
+func schema_pkg_apis_numaflow_v1alpha1_GlobalWindow(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "GlobalWindow describes a window which spans all the messages of a key, it emits the results whenever the trigger fires.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"trigger": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Trigger decides when the window fires.",
+							Ref:         ref("github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.WindowTrigger"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.WindowTrigger"},
+	}
+}
+
 func schema_pkg_apis_numaflow_v1alpha1_GroupBy(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -2202,12 +2442,31 @@ func schema_pkg_apis_numaflow_v1alpha1_GroupBy(ref common.ReferenceCallback) com
 							Ref:         ref("github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.PBQStorage"),
 						},
 					},
+					"earlyFiring": {
+						SchemaProps: spec.SchemaProps{
+							Description: "EarlyFiring emits speculative partial results of a fixed or sliding window before the window is closed.",
+							Ref:         ref("github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.EarlyFiring"),
+						},
+					},
+					"lateFiring": {
+						SchemaProps: spec.SchemaProps{
+							Description: "LateFiring emits the result of a fixed or sliding window once the watermark passes the end of the window, and re-emits the updated result whenever late data arrives within AllowedLateness.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"lateData": {
+						SchemaProps: spec.SchemaProps{
+							Description: "LateData routes the messages which are dropped because their windows have already been closed to a side output, so that they can be audited and reprocessed. If not set, the late messages are dropped.",
+							Ref:         ref("github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.LateDataOutput"),
+						},
+					},
 				},
 				Required: []string{"window"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.PBQStorage", "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.Window", "k8s.io/apimachinery/pkg/apis/meta/v1.Duration"},
+			"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.EarlyFiring", "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.LateDataOutput", "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.PBQStorage", "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.Window", "k8s.io/apimachinery/pkg/apis/meta/v1.Duration"},
 	}
 }
 
@@ -2230,11 +2489,51 @@ func schema_pkg_apis_numaflow_v1alpha1_HTTPSource(ref common.ReferenceCallback)
 							Format:      "",
 						},
 					},
+					"durableAck": {
+						SchemaProps: spec.SchemaProps{
+							Description: "DurableAck makes the requests return only after their messages are written to the inter-step buffer, so that an accepted request is not lost when the pod restarts. When the source is not able to keep up, the requests are rejected with 429 and a Retry-After header instead of being blocked.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"ackTimeout": {
+						SchemaProps: spec.SchemaProps{
+							Description: "AckTimeout is the maximum time a request waits for its messages to be written with DurableAck, the request gets 503 with a Retry-After header once it times out. Defaults to 30s.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
+						},
+					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.Authorization"},
+			"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.Authorization", "k8s.io/apimachinery/pkg/apis/meta/v1.Duration"},
+	}
+}
+
+func schema_pkg_apis_numaflow_v1alpha1_HotKeySalting(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "HotKeySalting defines when a key is considered hot, and how many partitions a hot key is split across.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"subPartitions": {
+						SchemaProps: spec.SchemaProps{
+							Description: "SubPartitions is the number of partitions the messages of a hot key are split across, capped by the partition count of the to vertex. Defaults to 2.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"thresholdPercentage": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ThresholdPercentage is the minimum percentage of the recent messages a key needs to account for to be considered hot. Defaults to 20.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+				},
+			},
+		},
 	}
 }
 
@@ -3084,6 +3383,35 @@ func schema_pkg_apis_numaflow_v1alpha1_KafkaSource(ref common.ReferenceCallback)
 	}
 }
 
+func schema_pkg_apis_numaflow_v1alpha1_LateDataOutput(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "LateDataOutput describes where the late messages dropped by a reduce vertex are written to. The late messages keep their original headers, and carry the watermark of the reduce vertex at the time they were dropped.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"toVertex": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ToVertex is the name of the vertex the late messages are written to, it has to be connected to the reduce vertex with an edge. The late messages bypass the conditions of the edge.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"fallback": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Fallback writes the late messages to the fallback sink of ToVertex instead of the primary sink, ToVertex has to be a sink vertex with a fallback sink.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"toVertex"},
+			},
+		},
+	}
+}
+
 func schema_pkg_apis_numaflow_v1alpha1_Lifecycle(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -3688,6 +4016,13 @@ func schema_pkg_apis_numaflow_v1alpha1_MonoVertexStatus(ref common.ReferenceCall
 							Format:      "",
 						},
 					},
+					"activeScaleSchedule": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The name of the active scale schedule, if there's any.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
 				},
 			},
 		},
@@ -3989,33 +4324,89 @@ func schema_pkg_apis_numaflow_v1alpha1_NoStore(ref common.ReferenceCallback) com
 	}
 }
 
-func schema_pkg_apis_numaflow_v1alpha1_PBQStorage(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_numaflow_v1alpha1_ObjectStoreStorage(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "PBQStorage defines the persistence configuration for a vertex.",
+				Description: "ObjectStoreStorage describes an S3-compatible object store used to persist the PBQ, so that the reduce pods do not need a persistent volume and can be rescheduled across zones. Credentials are resolved using the default AWS credential chain, e.g. environment variables or the IAM role of the service account.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"persistentVolumeClaim": {
+					"bucket": {
 						SchemaProps: spec.SchemaProps{
-							Ref: ref("github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.PersistenceStrategy"),
+							Description: "Bucket is the name of the bucket the WAL segments are written to.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"emptyDir": {
+					"prefix": {
 						SchemaProps: spec.SchemaProps{
-							Ref: ref("k8s.io/api/core/v1.EmptyDirVolumeSource"),
+							Description: "Prefix is the key prefix of the WAL segments in the bucket, the segments of a reduce pod are written under \"{prefix}/{namespace}/{pipeline}/{vertex}/{replica}/\". Defaults to \"numaflow\".",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"no_store": {
+					"region": {
 						SchemaProps: spec.SchemaProps{
-							Ref: ref("github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.NoStore"),
+							Description: "Region is the region of the bucket.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"endpointUrl": {
+						SchemaProps: spec.SchemaProps{
+							Description: "EndpointURL is the custom endpoint URL of the object store, e.g. a MinIO endpoint.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"usePathStyle": {
+						SchemaProps: spec.SchemaProps{
+							Description: "UsePathStyle addresses the bucket with path style URLs instead of virtual hosted style URLs, which is required by most of the S3-compatible object stores.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"bucket"},
+			},
+		},
+	}
+}
+
+func schema_pkg_apis_numaflow_v1alpha1_PBQStorage(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "PBQStorage defines the persistence configuration for a vertex.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"persistentVolumeClaim": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.PersistenceStrategy"),
+						},
+					},
+					"emptyDir": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("k8s.io/api/core/v1.EmptyDirVolumeSource"),
+						},
+					},
+					"no_store": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.NoStore"),
+						},
+					},
+					"objectStore": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ObjectStore persists the PBQ in an S3-compatible object store instead of a volume, it is only supported by fixed and sliding windows.",
+							Ref:         ref("github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.ObjectStoreStorage"),
 						},
 					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.NoStore", "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.PersistenceStrategy", "k8s.io/api/core/v1.EmptyDirVolumeSource"},
+			"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.NoStore", "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.ObjectStoreStorage", "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.PersistenceStrategy", "k8s.io/api/core/v1.EmptyDirVolumeSource"},
 	}
 }
 
@@ -4282,11 +4673,17 @@ func schema_pkg_apis_numaflow_v1alpha1_PipelineSpec(ref common.ReferenceCallback
 							Ref:         ref("github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.InterStepBuffer"),
 						},
 					},
+					"upgradeStrategy": {
+						SchemaProps: spec.SchemaProps{
+							Description: "UpgradeStrategy defines how the changes of the vertices are rolled out, defaults to updating the vertices in place.",
+							Ref:         ref("github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.PipelineUpgradeStrategy"),
+						},
+					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.AbstractVertex", "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.Edge", "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.InterStepBuffer", "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.Lifecycle", "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.PipelineLimits", "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.SideInput", "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.Templates", "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.Watermark"},
+			"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.AbstractVertex", "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.Edge", "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.InterStepBuffer", "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.Lifecycle", "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.PipelineLimits", "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.PipelineUpgradeStrategy", "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.SideInput", "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.Templates", "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.Watermark"},
 	}
 }
 
@@ -4383,11 +4780,101 @@ func schema_pkg_apis_numaflow_v1alpha1_PipelineStatus(ref common.ReferenceCallba
 							Format:      "",
 						},
 					},
+					"upgrade": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Upgrade is the status of the latest BlueGreen upgrade of the pipeline.",
+							Ref:         ref("github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.PipelineUpgradeStatus"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.PipelineUpgradeStatus", "k8s.io/apimachinery/pkg/apis/meta/v1.Condition", "k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+	}
+}
+
+func schema_pkg_apis_numaflow_v1alpha1_PipelineUpgradeStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "PipelineUpgradeStatus is the status of a BlueGreen upgrade of a pipeline.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"phase": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Phase is the phase of the upgrade.\n\nPossible enum values:\n - `\"CuttingOver\"` means the pipeline is drained, and being updated to the new spec.\n - `\"Draining\"` means the sources of the pipeline are paused, and the pipeline is draining.\n - `\"DrainingShadow\"` means the pipeline is running with the new spec, and the shadow pipeline is being paused, drained and deleted.\n - `\"RolledBack\"` means the upgrade failed, and the pipeline keeps running with the old spec until the spec is changed again.\n - `\"RollingBack\"` means the sources of the pipeline are being resumed, and the shadow pipeline is being deleted.\n - `\"StartingShadow\"` means the shadow pipeline is starting with the new spec.\n - `\"Succeeded\"` means the upgrade is completed.",
+							Type:        []string{"string"},
+							Format:      "",
+							Enum:        []interface{}{"CuttingOver", "Draining", "DrainingShadow", "RolledBack", "RollingBack", "StartingShadow", "Succeeded"},
+						},
+					},
+					"targetHash": {
+						SchemaProps: spec.SchemaProps{
+							Description: "TargetHash is the hash of the vertices the pipeline is upgraded to.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"shadowPipeline": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ShadowPipeline is the name of the shadow pipeline.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"message": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Message is a human-readable message about the upgrade, e.g. the reason of the rollback.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"startedAt": {
+						SchemaProps: spec.SchemaProps{
+							Description: "StartedAt is the time when the upgrade started.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"lastTransitionTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "LastTransitionTime is the last time the phase changed.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"k8s.io/apimachinery/pkg/apis/meta/v1.Condition", "k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+	}
+}
+
+func schema_pkg_apis_numaflow_v1alpha1_PipelineUpgradeStrategy(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "PipelineUpgradeStrategy defines how the changes of the vertices of a pipeline are rolled out.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"type": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Type is the type of the upgrade strategy, defaults to InPlace.\n\nPossible enum values:\n - `\"BlueGreen\"` starts a shadow pipeline with the new spec to take over the processing, while the pipeline is drained and updated, and then drains and deletes the shadow pipeline.\n - `\"InPlace\"` updates the vertices of the pipeline in place, the pods are rolled out with the update strategy of each vertex.",
+							Type:        []string{"string"},
+							Format:      "",
+							Enum:        []interface{}{"BlueGreen", "InPlace"},
+						},
+					},
+					"progressDeadlineSeconds": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ProgressDeadlineSeconds is the max time for the shadow pipeline to become healthy, and for the pipeline to be drained in a BlueGreen upgrade, the upgrade is rolled back if any of them is exceeded. Defaults to 600.",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+				},
+			},
+		},
 	}
 }
 
@@ -4415,6 +4902,32 @@ func schema_pkg_apis_numaflow_v1alpha1_Ports(ref common.ReferenceCallback) commo
 	}
 }
 
+func schema_pkg_apis_numaflow_v1alpha1_PredictiveScalePolicy(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"lookaheadSeconds": {
+						SchemaProps: spec.SchemaProps{
+							Description: "LookaheadSeconds is how many seconds ahead the processing rate is forecast, defaults to 300. It must be less than the period.",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"periodSeconds": {
+						SchemaProps: spec.SchemaProps{
+							Description: "PeriodSeconds is the period of the load pattern, defaults to 86400 (a day). The rate in the lookahead seconds is forecast with the rates observed at the same time of the previous period, no forecast is made until the rates of a full period have been observed.",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
 func schema_pkg_apis_numaflow_v1alpha1_Probe(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -4993,9 +5506,176 @@ func schema_pkg_apis_numaflow_v1alpha1_Scale(ref common.ReferenceCallback) commo
 							Format:      "int64",
 						},
 					},
+					"policy": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Policy defines the algorithm used to calculate the desired replicas. If not specified, the desired replicas are calculated with the pending messages and processing rate for source vertices, and with the buffer availability for UDF and Sink vertices. It's not supported by MonoVertex yet.",
+							Ref:         ref("github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.ScalePolicy"),
+						},
+					},
+					"dryRun": {
+						SchemaProps: spec.SchemaProps{
+							Description: "DryRun is used to only calculate the desired replicas and record the decision in the vertex status, the replicas of the vertex will not be changed. It's not supported by MonoVertex yet.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"schedules": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Schedules override the min and max replicas during the scheduled time windows, for example, to keep more replicas running during the predictable traffic peaks. If multiple schedules are active at the same time, the first one in the list takes effect.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.ScaleSchedule"),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.ScalePolicy", "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.ScaleSchedule"},
+	}
+}
+
+func schema_pkg_apis_numaflow_v1alpha1_ScalePolicy(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ScalePolicy defines the algorithm used to calculate the desired replicas, only one of the policies can be specified.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"targetLag": {
+						SchemaProps: spec.SchemaProps{
+							Description: "TargetLag scales the vertex to get the pending messages processed within the target lag seconds.",
+							Ref:         ref("github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.TargetLagScalePolicy"),
+						},
+					},
+					"cpu": {
+						SchemaProps: spec.SchemaProps{
+							Description: "CPU scales the vertex to keep the average CPU utilization of the pods at the target level. It requires the Kubernetes metrics server to be installed.",
+							Ref:         ref("github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.CPUScalePolicy"),
+						},
+					},
+					"predictive": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Predictive forecasts the processing rate with the rates observed at the same time of the previous period, and scales the vertex up ahead of the periodic load.",
+							Ref:         ref("github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.PredictiveScalePolicy"),
+						},
+					},
 				},
 			},
 		},
+		Dependencies: []string{
+			"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.CPUScalePolicy", "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.PredictiveScalePolicy", "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.TargetLagScalePolicy"},
+	}
+}
+
+func schema_pkg_apis_numaflow_v1alpha1_ScaleSchedule(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ScaleSchedule defines a recurring time window, during which the min and max replicas are overridden.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name of the schedule, it is reported in the status when the schedule is active.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"start": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Start is a cron expression of when the time window starts, for example, \"0 8 * * *\".",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"duration": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Duration of the time window, for example, \"10h\".",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
+						},
+					},
+					"timeZone": {
+						SchemaProps: spec.SchemaProps{
+							Description: "TimeZone is the IANA time zone name used to interpret the cron expression, for example, \"Europe/Berlin\". Defaults to UTC.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"min": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Minimum replicas during the time window, defaults to the min of the scale spec.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"max": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Maximum replicas during the time window, defaults to the max of the scale spec.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+				},
+				Required: []string{"name", "start", "duration"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Duration"},
+	}
+}
+
+func schema_pkg_apis_numaflow_v1alpha1_ScalingDecision(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ScalingDecision is the decision made by the autoscaler.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"policy": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Policy used to calculate the desired replicas.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"replicas": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Replicas at the time the decision was made.",
+							Default:     0,
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"desiredReplicas": {
+						SchemaProps: spec.SchemaProps{
+							Description: "DesiredReplicas is the number of replicas the vertex would be scaled to.",
+							Default:     0,
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"decidedAt": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Time the decision was made.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+				},
+				Required: []string{"policy", "replicas", "desiredReplicas", "decidedAt"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
 	}
 }
 
@@ -5486,6 +6166,20 @@ func schema_pkg_apis_numaflow_v1alpha1_SideInput(ref common.ReferenceCallback) c
 							Ref: ref("github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.SideInputTrigger"),
 						},
 					},
+					"retainedVersions": {
+						SchemaProps: spec.SchemaProps{
+							Description: "RetainedVersions is the number of versions of the side input retained in the side inputs store, including the latest one. The retained versions can be used to roll back. Defaults to 5.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"pinnedVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "PinnedVersion pins the vertices to the given version of the side input, instead of the latest broadcast one. The version must be retained in the side inputs store.",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
 				},
 				Required: []string{"name", "container", "trigger"},
 			},
@@ -5503,8 +6197,7 @@ func schema_pkg_apis_numaflow_v1alpha1_SideInputTrigger(ref common.ReferenceCall
 				Properties: map[string]spec.Schema{
 					"schedule": {
 						SchemaProps: spec.SchemaProps{
-							Description: "The schedule to trigger the retrievement of the side input data. It supports cron format, for example, \"0 30 * * * *\". Or interval based format, such as \"@hourly\", \"@every 1h30m\", etc.",
-							Default:     "",
+							Description: "The schedule to trigger the retrievement of the side input data. It supports cron format, for example, \"0 30 * * * *\". Or interval based format, such as \"@hourly\", \"@every 1h30m\", etc. Either schedule or watch is required.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
@@ -5515,8 +6208,64 @@ func schema_pkg_apis_numaflow_v1alpha1_SideInputTrigger(ref common.ReferenceCall
 							Format: "",
 						},
 					},
+					"watch": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Watch triggers the retrievement of the side input data whenever one of the watched ConfigMaps or Secrets changes.",
+							Ref:         ref("github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.SideInputWatch"),
+						},
+					},
+					"auth": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Auth enables the on-demand trigger endpoint of the side inputs manager, the requests need to have the bearer token in the \"Authorization\" header. The endpoint is disabled if it's not configured.",
+							Ref:         ref("github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.Authorization"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.Authorization", "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.SideInputWatch"},
+	}
+}
+
+func schema_pkg_apis_numaflow_v1alpha1_SideInputWatch(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "SideInputWatch defines the ConfigMaps and Secrets, in the namespace of the pipeline, which trigger the retrievement of the side input data when they are changed. The service account of the side inputs manager requires \"get\", \"list\" and \"watch\" permissions on them.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"configMaps": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Names of the ConfigMaps to watch.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"secrets": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Names of the Secrets to watch.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
 				},
-				Required: []string{"schedule"},
 			},
 		},
 	}
@@ -5843,11 +6592,17 @@ func schema_pkg_apis_numaflow_v1alpha1_Source(ref common.ReferenceCallback) comm
 							Ref: ref("github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.SqsSource"),
 						},
 					},
+					"dedup": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Dedup drops the messages whose IDs have been seen within a window, e.g. the retries of the clients of an HTTP source with the same \"X-Numaflow-Id\". Only supported by HTTP and JetStream sources.",
+							Ref:         ref("github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.DedupWindow"),
+						},
+					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.GeneratorSource", "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.HTTPSource", "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.JetStreamSource", "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.KafkaSource", "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.NatsSource", "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.PulsarSource", "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.ServingSource", "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.SqsSource", "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.UDSource", "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.UDTransformer"},
+			"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.DedupWindow", "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.GeneratorSource", "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.HTTPSource", "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.JetStreamSource", "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.KafkaSource", "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.NatsSource", "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.PulsarSource", "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.ServingSource", "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.SqsSource", "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.UDSource", "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.UDTransformer"},
 	}
 }
 
@@ -5881,6 +6636,13 @@ func schema_pkg_apis_numaflow_v1alpha1_SqsSink(ref common.ReferenceCallback) com
 							Format:      "",
 						},
 					},
+					"endpointUrl": {
+						SchemaProps: spec.SchemaProps{
+							Description: "EndpointURL is the custom endpoint URL for the AWS SQS API. This is useful for testing with localstack or when using VPC endpoints.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
 				},
 				Required: []string{"awsRegion", "queueName", "queueOwnerAWSAccountID"},
 			},
@@ -6092,6 +6854,27 @@ func schema_pkg_apis_numaflow_v1alpha1_TagConditions(ref common.ReferenceCallbac
 	}
 }
 
+func schema_pkg_apis_numaflow_v1alpha1_TargetLagScalePolicy(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"targetLagSeconds": {
+						SchemaProps: spec.SchemaProps{
+							Description: "TargetLagSeconds is the expected seconds to get the pending messages processed, which is calculated with the pending messages divided by the processing rate.",
+							Default:     0,
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+				},
+				Required: []string{"targetLagSeconds"},
+			},
+		},
+	}
+}
+
 func schema_pkg_apis_numaflow_v1alpha1_Templates(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -6141,16 +6924,34 @@ func schema_pkg_apis_numaflow_v1alpha1_UDF(ref common.ReferenceCallback) common.
 							Ref: ref("github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.Container"),
 						},
 					},
+					"builtin": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Builtin runs a built-in map function in the numa container instead of a UDF container, it is exclusive with Container, and is not supported by reduce vertices.",
+							Ref:         ref("github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.Function"),
+						},
+					},
 					"groupBy": {
 						SchemaProps: spec.SchemaProps{
 							Ref: ref("github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.GroupBy"),
 						},
 					},
+					"retryStrategy": {
+						SchemaProps: spec.SchemaProps{
+							Description: "RetryStrategy defines how the failures of a map UDF are handled, it is not supported by reduce vertices. Without it, a failing batch stops the forwarder and is processed again after the restart. With it, the batch is retried with the BackOff, after which the UDF is applied to each of the messages on its own, and the ones still failing are dropped or routed to the DeadLetter vertex, depending on OnFailure (\"drop\" or \"fallback\").",
+							Ref:         ref("github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.RetryStrategy"),
+						},
+					},
+					"deadLetter": {
+						SchemaProps: spec.SchemaProps{
+							Description: "DeadLetter routes the messages failed by the map UDF to a side output, once the RetryStrategy is exhausted with the \"fallback\" OnFailure strategy.",
+							Ref:         ref("github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.DeadLetterOutput"),
+						},
+					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.Container", "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.GroupBy"},
+			"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.Container", "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.DeadLetterOutput", "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.Function", "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.GroupBy", "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.RetryStrategy"},
 	}
 }
 
@@ -6890,11 +7691,31 @@ func schema_pkg_apis_numaflow_v1alpha1_VertexStatus(ref common.ReferenceCallback
 							Format:      "",
 						},
 					},
+					"lastScalingDecision": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The last scaling decision made by the autoscaler, only recorded when scale.dryRun is enabled.",
+							Ref:         ref("github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.ScalingDecision"),
+						},
+					},
+					"activeScaleSchedule": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The name of the active scale schedule, if there's any.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"forecastUnavailableReason": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The reason the predictive scale policy can not forecast the processing rate, in which case the default policy is used. Empty when the forecast is available, or the vertex doesn't use the predictive scale policy.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"k8s.io/apimachinery/pkg/apis/meta/v1.Condition", "k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+			"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.ScalingDecision", "k8s.io/apimachinery/pkg/apis/meta/v1.Condition", "k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
 	}
 }
 
@@ -7120,11 +7941,49 @@ func schema_pkg_apis_numaflow_v1alpha1_Window(ref common.ReferenceCallback) comm
 							Ref: ref("github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.AccumulatorWindow"),
 						},
 					},
+					"count": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.CountWindow"),
+						},
+					},
+					"global": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.GlobalWindow"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.AccumulatorWindow", "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.CountWindow", "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.FixedWindow", "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.GlobalWindow", "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.SessionWindow", "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.SlidingWindow"},
+	}
+}
+
+func schema_pkg_apis_numaflow_v1alpha1_WindowTrigger(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "WindowTrigger describes when a global window fires. The window fires as soon as any of the conditions is met, the fired messages are then discarded and the next message of the key starts a new pane.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"count": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Count fires the window once it has seen Count messages of a key.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"interval": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Interval fires the window once the watermark has progressed Interval past the first message of the pane.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
+						},
+					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.AccumulatorWindow", "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.FixedWindow", "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.SessionWindow", "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.SlidingWindow"},
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Duration"},
 	}
 }
 
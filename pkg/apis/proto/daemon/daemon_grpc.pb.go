@@ -40,6 +40,10 @@ const (
 	DaemonService_GetPipelineWatermarks_FullMethodName = "/daemon.DaemonService/GetPipelineWatermarks"
 	DaemonService_GetPipelineStatus_FullMethodName     = "/daemon.DaemonService/GetPipelineStatus"
 	DaemonService_GetVertexErrors_FullMethodName       = "/daemon.DaemonService/GetVertexErrors"
+	DaemonService_ListSideInputVersions_FullMethodName = "/daemon.DaemonService/ListSideInputVersions"
+	DaemonService_RollbackSideInput_FullMethodName     = "/daemon.DaemonService/RollbackSideInput"
+	DaemonService_PeekBuffer_FullMethodName            = "/daemon.DaemonService/PeekBuffer"
+	DaemonService_ReplayFromOffset_FullMethodName      = "/daemon.DaemonService/ReplayFromOffset"
 )
 
 // DaemonServiceClient is the client API for DaemonService service.
@@ -55,6 +59,14 @@ type DaemonServiceClient interface {
 	GetPipelineWatermarks(ctx context.Context, in *GetPipelineWatermarksRequest, opts ...grpc.CallOption) (*GetPipelineWatermarksResponse, error)
 	GetPipelineStatus(ctx context.Context, in *GetPipelineStatusRequest, opts ...grpc.CallOption) (*GetPipelineStatusResponse, error)
 	GetVertexErrors(ctx context.Context, in *GetVertexErrorsRequest, opts ...grpc.CallOption) (*GetVertexErrorsResponse, error)
+	// ListSideInputVersions returns the retained versions of a side input, the latest first.
+	ListSideInputVersions(ctx context.Context, in *ListSideInputVersionsRequest, opts ...grpc.CallOption) (*ListSideInputVersionsResponse, error)
+	// RollbackSideInput broadcasts a retained version of a side input to the vertices.
+	RollbackSideInput(ctx context.Context, in *RollbackSideInputRequest, opts ...grpc.CallOption) (*RollbackSideInputResponse, error)
+	// PeekBuffer returns the messages of a buffer partition without acknowledging them.
+	PeekBuffer(ctx context.Context, in *PeekBufferRequest, opts ...grpc.CallOption) (*PeekBufferResponse, error)
+	// ReplayFromOffset writes the messages of a buffer partition from an offset to it again, so that they are processed once more.
+	ReplayFromOffset(ctx context.Context, in *ReplayFromOffsetRequest, opts ...grpc.CallOption) (*ReplayFromOffsetResponse, error)
 }
 
 type daemonServiceClient struct {
@@ -125,6 +137,46 @@ func (c *daemonServiceClient) GetVertexErrors(ctx context.Context, in *GetVertex
 	return out, nil
 }
 
+func (c *daemonServiceClient) ListSideInputVersions(ctx context.Context, in *ListSideInputVersionsRequest, opts ...grpc.CallOption) (*ListSideInputVersionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListSideInputVersionsResponse)
+	err := c.cc.Invoke(ctx, DaemonService_ListSideInputVersions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *daemonServiceClient) RollbackSideInput(ctx context.Context, in *RollbackSideInputRequest, opts ...grpc.CallOption) (*RollbackSideInputResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RollbackSideInputResponse)
+	err := c.cc.Invoke(ctx, DaemonService_RollbackSideInput_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *daemonServiceClient) PeekBuffer(ctx context.Context, in *PeekBufferRequest, opts ...grpc.CallOption) (*PeekBufferResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PeekBufferResponse)
+	err := c.cc.Invoke(ctx, DaemonService_PeekBuffer_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *daemonServiceClient) ReplayFromOffset(ctx context.Context, in *ReplayFromOffsetRequest, opts ...grpc.CallOption) (*ReplayFromOffsetResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReplayFromOffsetResponse)
+	err := c.cc.Invoke(ctx, DaemonService_ReplayFromOffset_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // DaemonServiceServer is the server API for DaemonService service.
 // All implementations must embed UnimplementedDaemonServiceServer
 // for forward compatibility
@@ -138,6 +190,14 @@ type DaemonServiceServer interface {
 	GetPipelineWatermarks(context.Context, *GetPipelineWatermarksRequest) (*GetPipelineWatermarksResponse, error)
 	GetPipelineStatus(context.Context, *GetPipelineStatusRequest) (*GetPipelineStatusResponse, error)
 	GetVertexErrors(context.Context, *GetVertexErrorsRequest) (*GetVertexErrorsResponse, error)
+	// ListSideInputVersions returns the retained versions of a side input, the latest first.
+	ListSideInputVersions(context.Context, *ListSideInputVersionsRequest) (*ListSideInputVersionsResponse, error)
+	// RollbackSideInput broadcasts a retained version of a side input to the vertices.
+	RollbackSideInput(context.Context, *RollbackSideInputRequest) (*RollbackSideInputResponse, error)
+	// PeekBuffer returns the messages of a buffer partition without acknowledging them.
+	PeekBuffer(context.Context, *PeekBufferRequest) (*PeekBufferResponse, error)
+	// ReplayFromOffset writes the messages of a buffer partition from an offset to it again, so that they are processed once more.
+	ReplayFromOffset(context.Context, *ReplayFromOffsetRequest) (*ReplayFromOffsetResponse, error)
 	mustEmbedUnimplementedDaemonServiceServer()
 }
 
@@ -163,6 +223,18 @@ func (UnimplementedDaemonServiceServer) GetPipelineStatus(context.Context, *GetP
 func (UnimplementedDaemonServiceServer) GetVertexErrors(context.Context, *GetVertexErrorsRequest) (*GetVertexErrorsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetVertexErrors not implemented")
 }
+func (UnimplementedDaemonServiceServer) ListSideInputVersions(context.Context, *ListSideInputVersionsRequest) (*ListSideInputVersionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListSideInputVersions not implemented")
+}
+func (UnimplementedDaemonServiceServer) RollbackSideInput(context.Context, *RollbackSideInputRequest) (*RollbackSideInputResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RollbackSideInput not implemented")
+}
+func (UnimplementedDaemonServiceServer) PeekBuffer(context.Context, *PeekBufferRequest) (*PeekBufferResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PeekBuffer not implemented")
+}
+func (UnimplementedDaemonServiceServer) ReplayFromOffset(context.Context, *ReplayFromOffsetRequest) (*ReplayFromOffsetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReplayFromOffset not implemented")
+}
 func (UnimplementedDaemonServiceServer) mustEmbedUnimplementedDaemonServiceServer() {}
 
 // UnsafeDaemonServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -284,6 +356,78 @@ func _DaemonService_GetVertexErrors_Handler(srv interface{}, ctx context.Context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _DaemonService_ListSideInputVersions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListSideInputVersionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DaemonServiceServer).ListSideInputVersions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DaemonService_ListSideInputVersions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DaemonServiceServer).ListSideInputVersions(ctx, req.(*ListSideInputVersionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DaemonService_RollbackSideInput_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RollbackSideInputRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DaemonServiceServer).RollbackSideInput(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DaemonService_RollbackSideInput_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DaemonServiceServer).RollbackSideInput(ctx, req.(*RollbackSideInputRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DaemonService_PeekBuffer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PeekBufferRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DaemonServiceServer).PeekBuffer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DaemonService_PeekBuffer_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DaemonServiceServer).PeekBuffer(ctx, req.(*PeekBufferRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DaemonService_ReplayFromOffset_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReplayFromOffsetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DaemonServiceServer).ReplayFromOffset(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DaemonService_ReplayFromOffset_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DaemonServiceServer).ReplayFromOffset(ctx, req.(*ReplayFromOffsetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // DaemonService_ServiceDesc is the grpc.ServiceDesc for DaemonService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -315,6 +459,22 @@ var DaemonService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetVertexErrors",
 			Handler:    _DaemonService_GetVertexErrors_Handler,
 		},
+		{
+			MethodName: "ListSideInputVersions",
+			Handler:    _DaemonService_ListSideInputVersions_Handler,
+		},
+		{
+			MethodName: "RollbackSideInput",
+			Handler:    _DaemonService_RollbackSideInput_Handler,
+		},
+		{
+			MethodName: "PeekBuffer",
+			Handler:    _DaemonService_PeekBuffer_Handler,
+		},
+		{
+			MethodName: "ReplayFromOffset",
+			Handler:    _DaemonService_ReplayFromOffset_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "pkg/apis/proto/daemon/daemon.proto",
@@ -132,3 +132,48 @@ func (dc *grpcDaemonClient) GetVertexErrors(ctx context.Context, pipeline, verte
 		return rspn.Errors, nil
 	}
 }
+
+// ListSideInputVersions returns the retained versions of a side input, the latest first
+func (dc *grpcDaemonClient) ListSideInputVersions(ctx context.Context, pipeline, sideInput string) ([]*daemon.SideInputVersion, error) {
+	if rspn, err := dc.client.ListSideInputVersions(ctx, &daemon.ListSideInputVersionsRequest{
+		Pipeline:  pipeline,
+		SideInput: sideInput,
+	}); err != nil {
+		return nil, err
+	} else {
+		return rspn.Versions, nil
+	}
+}
+
+// RollbackSideInput broadcasts a retained version of a side input to the vertices
+func (dc *grpcDaemonClient) RollbackSideInput(ctx context.Context, pipeline, sideInput string, version int64) (*daemon.SideInputVersion, error) {
+	if rspn, err := dc.client.RollbackSideInput(ctx, &daemon.RollbackSideInputRequest{
+		Pipeline:  pipeline,
+		SideInput: sideInput,
+		Version:   version,
+	}); err != nil {
+		return nil, err
+	} else {
+		return rspn.Version, nil
+	}
+}
+
+// PeekBuffer returns the messages of a buffer partition from the offset without acknowledging them
+func (dc *grpcDaemonClient) PeekBuffer(ctx context.Context, pipeline, buffer string, offset, count int64) (*daemon.PeekBufferResponse, error) {
+	return dc.client.PeekBuffer(ctx, &daemon.PeekBufferRequest{
+		Pipeline: pipeline,
+		Buffer:   buffer,
+		Offset:   offset,
+		Count:    count,
+	})
+}
+
+// ReplayFromOffset writes the messages of a buffer partition from the offset to it again
+func (dc *grpcDaemonClient) ReplayFromOffset(ctx context.Context, pipeline, buffer string, offset, count int64) (*daemon.ReplayFromOffsetResponse, error) {
+	return dc.client.ReplayFromOffset(ctx, &daemon.ReplayFromOffsetRequest{
+		Pipeline: pipeline,
+		Buffer:   buffer,
+		Offset:   offset,
+		Count:    count,
+	})
+}
@@ -65,6 +65,26 @@ func (m *mockDaemonServiceClient) GetVertexErrors(ctx context.Context, in *daemo
 	return args.Get(0).(*daemon.GetVertexErrorsResponse), args.Error(1)
 }
 
+func (m *mockDaemonServiceClient) ListSideInputVersions(ctx context.Context, in *daemon.ListSideInputVersionsRequest, opts ...grpc.CallOption) (*daemon.ListSideInputVersionsResponse, error) {
+	args := m.Called(ctx, in, opts)
+	return args.Get(0).(*daemon.ListSideInputVersionsResponse), args.Error(1)
+}
+
+func (m *mockDaemonServiceClient) RollbackSideInput(ctx context.Context, in *daemon.RollbackSideInputRequest, opts ...grpc.CallOption) (*daemon.RollbackSideInputResponse, error) {
+	args := m.Called(ctx, in, opts)
+	return args.Get(0).(*daemon.RollbackSideInputResponse), args.Error(1)
+}
+
+func (m *mockDaemonServiceClient) PeekBuffer(ctx context.Context, in *daemon.PeekBufferRequest, opts ...grpc.CallOption) (*daemon.PeekBufferResponse, error) {
+	args := m.Called(ctx, in, opts)
+	return args.Get(0).(*daemon.PeekBufferResponse), args.Error(1)
+}
+
+func (m *mockDaemonServiceClient) ReplayFromOffset(ctx context.Context, in *daemon.ReplayFromOffsetRequest, opts ...grpc.CallOption) (*daemon.ReplayFromOffsetResponse, error) {
+	args := m.Called(ctx, in, opts)
+	return args.Get(0).(*daemon.ReplayFromOffsetResponse), args.Error(1)
+}
+
 func TestGrpcDaemonClient_ListPipelineBuffers(t *testing.T) {
 	t.Run("successful listing", func(t *testing.T) {
 		mockClient := new(mockDaemonServiceClient)
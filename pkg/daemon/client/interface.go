@@ -32,4 +32,8 @@ type DaemonClient interface {
 	GetPipelineWatermarks(ctx context.Context, pipeline string) ([]*daemon.EdgeWatermark, error)
 	GetPipelineStatus(ctx context.Context, pipeline string) (*daemon.PipelineStatus, error)
 	GetVertexErrors(ctx context.Context, pipeline, vertex string) ([]*daemon.ReplicaErrors, error)
+	ListSideInputVersions(ctx context.Context, pipeline, sideInput string) ([]*daemon.SideInputVersion, error)
+	RollbackSideInput(ctx context.Context, pipeline, sideInput string, version int64) (*daemon.SideInputVersion, error)
+	PeekBuffer(ctx context.Context, pipeline, buffer string, offset, count int64) (*daemon.PeekBufferResponse, error)
+	ReplayFromOffset(ctx context.Context, pipeline, buffer string, offset, count int64) (*daemon.ReplayFromOffsetResponse, error)
 }
@@ -17,6 +17,7 @@ limitations under the License.
 package client
 
 import (
+	"bytes"
 	"context"
 	"crypto/tls"
 	"fmt"
@@ -174,3 +175,59 @@ func (rc *restfulDaemonClient) GetVertexErrors(ctx context.Context, pipeline, ve
 		return res.Errors, nil
 	}
 }
+
+// ListSideInputVersions returns the retained versions of a side input, the latest first
+func (rc *restfulDaemonClient) ListSideInputVersions(ctx context.Context, pipeline, sideInput string) ([]*daemon.SideInputVersion, error) {
+	resp, err := rc.httpClient.Get(fmt.Sprintf("%s/api/v1/pipelines/%s/side-inputs/%s/versions", rc.hostURL, pipeline, sideInput))
+	if err != nil {
+		return nil, fmt.Errorf("failed to call list side input versions RESTful API, %w", err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+	if res, err := unmarshalResponse[daemon.ListSideInputVersionsResponse](resp); err != nil {
+		return nil, err
+	} else {
+		return res.Versions, nil
+	}
+}
+
+// RollbackSideInput broadcasts a retained version of a side input to the vertices
+func (rc *restfulDaemonClient) RollbackSideInput(ctx context.Context, pipeline, sideInput string, version int64) (*daemon.SideInputVersion, error) {
+	body, err := jsonMarshaller.Marshal(&daemon.RollbackSideInputRequest{Version: version})
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal rollback side input request, %w", err)
+	}
+	resp, err := rc.httpClient.Post(fmt.Sprintf("%s/api/v1/pipelines/%s/side-inputs/%s/rollback", rc.hostURL, pipeline, sideInput), "application/json", bytes.NewReader(body))
+	if err != nil {
+		return nil, fmt.Errorf("failed to call rollback side input RESTful API, %w", err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+	if res, err := unmarshalResponse[daemon.RollbackSideInputResponse](resp); err != nil {
+		return nil, err
+	} else {
+		return res.Version, nil
+	}
+}
+
+// PeekBuffer returns the messages of a buffer partition from the offset without acknowledging them
+func (rc *restfulDaemonClient) PeekBuffer(ctx context.Context, pipeline, buffer string, offset, count int64) (*daemon.PeekBufferResponse, error) {
+	resp, err := rc.httpClient.Get(fmt.Sprintf("%s/api/v1/pipelines/%s/buffers/%s/messages?offset=%d&count=%d", rc.hostURL, pipeline, buffer, offset, count))
+	if err != nil {
+		return nil, fmt.Errorf("failed to call peek buffer RESTful API, %w", err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+	return unmarshalResponse[daemon.PeekBufferResponse](resp)
+}
+
+// ReplayFromOffset writes the messages of a buffer partition from the offset to it again
+func (rc *restfulDaemonClient) ReplayFromOffset(ctx context.Context, pipeline, buffer string, offset, count int64) (*daemon.ReplayFromOffsetResponse, error) {
+	body, err := jsonMarshaller.Marshal(&daemon.ReplayFromOffsetRequest{Offset: offset, Count: count})
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal replay from offset request, %w", err)
+	}
+	resp, err := rc.httpClient.Post(fmt.Sprintf("%s/api/v1/pipelines/%s/buffers/%s/replay", rc.hostURL, pipeline, buffer), "application/json", bytes.NewReader(body))
+	if err != nil {
+		return nil, fmt.Errorf("failed to call replay from offset RESTful API, %w", err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+	return unmarshalResponse[daemon.ReplayFromOffsetResponse](resp)
+}
@@ -230,6 +230,78 @@ func TestRestfulDaemonClient_GetVertexErrors(t *testing.T) {
 	})
 }
 
+func TestRestfulDaemonClient_ListSideInputVersions(t *testing.T) {
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/api/v1/pipelines/simple-pipeline/side-inputs/s3/versions", r.URL.Path)
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write([]byte(`{"versions":[{"version":"2","checksum":"abc","createdAt":"2023-10-01T12:00:00Z","current":true},{"version":"1","checksum":"def","createdAt":"2023-10-01T11:00:00Z"}]}`))
+	}))
+	defer server.Close()
+
+	client, _ := NewRESTfulDaemonServiceClient(server.URL)
+	versions, err := client.ListSideInputVersions(context.Background(), "simple-pipeline", "s3")
+	assert.NoError(t, err)
+	assert.Len(t, versions, 2)
+	assert.Equal(t, int64(2), versions[0].Version)
+	assert.True(t, versions[0].Current)
+}
+
+func TestRestfulDaemonClient_RollbackSideInput(t *testing.T) {
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "/api/v1/pipelines/simple-pipeline/side-inputs/s3/rollback", r.URL.Path)
+		body, _ := io.ReadAll(r.Body)
+		assert.Contains(t, string(body), `"version":"1"`)
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write([]byte(`{"version":{"version":"1","checksum":"def","createdAt":"2023-10-01T11:00:00Z","current":true}}`))
+	}))
+	defer server.Close()
+
+	client, _ := NewRESTfulDaemonServiceClient(server.URL)
+	v, err := client.RollbackSideInput(context.Background(), "simple-pipeline", "s3", 1)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(1), v.Version)
+	assert.True(t, v.Current)
+}
+
+func TestRestfulDaemonClient_PeekBuffer(t *testing.T) {
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/api/v1/pipelines/simple-pipeline/buffers/buffer-0/messages", r.URL.Path)
+		assert.Equal(t, "5", r.URL.Query().Get("offset"))
+		assert.Equal(t, "2", r.URL.Query().Get("count"))
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write([]byte(`{"messages":[{"offset":"5","id":"in-5-0","headers":{"k":"v"},"payload":"aGVsbG8="},{"offset":"7","id":"in-7-0"}],"nextOffset":"8"}`))
+	}))
+	defer server.Close()
+
+	client, _ := NewRESTfulDaemonServiceClient(server.URL)
+	resp, err := client.PeekBuffer(context.Background(), "simple-pipeline", "buffer-0", 5, 2)
+	assert.NoError(t, err)
+	assert.Len(t, resp.Messages, 2)
+	assert.Equal(t, int64(5), resp.Messages[0].Offset)
+	assert.Equal(t, "hello", string(resp.Messages[0].Payload))
+	assert.Equal(t, "v", resp.Messages[0].Headers["k"])
+	assert.Equal(t, int64(8), resp.NextOffset)
+}
+
+func TestRestfulDaemonClient_ReplayFromOffset(t *testing.T) {
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "/api/v1/pipelines/simple-pipeline/buffers/buffer-0/replay", r.URL.Path)
+		body, _ := io.ReadAll(r.Body)
+		assert.Contains(t, string(body), `"count":"3"`)
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write([]byte(`{"replayed":"3","nextOffset":"8"}`))
+	}))
+	defer server.Close()
+
+	client, _ := NewRESTfulDaemonServiceClient(server.URL)
+	resp, err := client.ReplayFromOffset(context.Background(), "simple-pipeline", "buffer-0", 5, 3)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(3), resp.Replayed)
+	assert.Equal(t, int64(8), resp.NextOffset)
+}
+
 func TestRestfulDaemonClient_Close(t *testing.T) {
 	t.Run("close without error", func(t *testing.T) {
 		client := &restfulDaemonClient{}
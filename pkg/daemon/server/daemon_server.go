@@ -46,6 +46,9 @@ import (
 	"github.com/numaproj/numaflow/pkg/metrics"
 	jsclient "github.com/numaproj/numaflow/pkg/shared/clients/nats"
 	redisclient "github.com/numaproj/numaflow/pkg/shared/clients/redis"
+	"github.com/numaproj/numaflow/pkg/shared/kvs"
+	"github.com/numaproj/numaflow/pkg/shared/kvs/jetstream"
+	rediskv "github.com/numaproj/numaflow/pkg/shared/kvs/redis"
 	"github.com/numaproj/numaflow/pkg/shared/logging"
 	sharedtls "github.com/numaproj/numaflow/pkg/shared/tls"
 	"github.com/numaproj/numaflow/pkg/watermark/fetch"
@@ -68,14 +71,23 @@ func NewDaemonServer(pl *v1alpha1.Pipeline, isbSvcType v1alpha1.ISBSvcType) *dae
 func (ds *daemonServer) Run(ctx context.Context) error {
 	log := logging.FromContext(ctx)
 	var (
-		isbSvcClient   isbsvc.ISBService
-		err            error
-		natsClientPool *jsclient.ClientPool
+		isbSvcClient    isbsvc.ISBService
+		err             error
+		natsClientPool  *jsclient.ClientPool
+		sideInputsStore kvs.KVStorer
 	)
 
+	hasSideInputs := len(ds.pipeline.Spec.SideInputs) > 0
 	switch ds.isbSvcType {
 	case v1alpha1.ISBSvcTypeRedis:
-		isbSvcClient = isbsvc.NewISBRedisSvc(redisclient.NewInClusterRedisClient())
+		redisClient := redisclient.NewInClusterRedisClient()
+		isbSvcClient = isbsvc.NewISBRedisSvc(redisClient)
+		if hasSideInputs {
+			kvName := isbsvc.RedisSideInputsStoreKVName(ds.pipeline.GetSideInputsStoreName())
+			if sideInputsStore, err = rediskv.NewKVRedisKVStore(ctx, kvName, redisClient); err != nil {
+				return fmt.Errorf("failed to create the side inputs store, %w", err)
+			}
+		}
 	case v1alpha1.ISBSvcTypeJetStream:
 		natsClientPool, err = jsclient.NewClientPool(ctx, jsclient.WithClientPoolSize(1))
 		if err != nil {
@@ -88,9 +100,18 @@ func (ds *daemonServer) Run(ctx context.Context) error {
 			log.Errorw("Failed to get an ISB Service client.", zap.Error(err))
 			return err
 		}
+		if hasSideInputs {
+			kvName := isbsvc.JetStreamSideInputsStoreKVName(ds.pipeline.GetSideInputsStoreName())
+			if sideInputsStore, err = jetstream.NewKVJetStreamKVStore(ctx, kvName, natsClientPool.NextAvailableClient()); err != nil {
+				return fmt.Errorf("failed to create the side inputs store, %w", err)
+			}
+		}
 	default:
 		return fmt.Errorf("unsupported isbsvc buffer type %q", ds.isbSvcType)
 	}
+	if sideInputsStore != nil {
+		defer sideInputsStore.Close()
+	}
 	wmStores, err := service.BuildWatermarkStores(ctx, ds.pipeline, isbSvcClient)
 	if err != nil {
 		return fmt.Errorf("failed to get watermark stores, %w", err)
@@ -129,7 +150,7 @@ func (ds *daemonServer) Run(ctx context.Context) error {
 	}
 
 	tlsConfig := &tls.Config{Certificates: []tls.Certificate{*cer}, MinVersion: tls.VersionTLS12, NextProtos: []string{"http/1.1", "h2"}}
-	grpcServer, err := ds.newGRPCServer(isbSvcClient, wmFetchers, rater, pipelineRuntimeCache)
+	grpcServer, err := ds.newGRPCServer(isbSvcClient, wmFetchers, rater, pipelineRuntimeCache, sideInputsStore)
 	if err != nil {
 		return fmt.Errorf("failed to create grpc server: %w", err)
 	}
@@ -180,7 +201,8 @@ func (ds *daemonServer) newGRPCServer(
 	isbSvcClient isbsvc.ISBService,
 	wmFetchers map[v1alpha1.Edge][]fetch.HeadFetcher,
 	rater server.Ratable,
-	pipelineRuntimeCache runtimeinfo.PipelineRuntimeCache) (*grpc.Server, error) {
+	pipelineRuntimeCache runtimeinfo.PipelineRuntimeCache,
+	sideInputsStore kvs.KVStorer) (*grpc.Server, error) {
 	// "Prometheus histograms are a great way to measure latency distributions of your RPCs.
 	// However, since it is a bad practice to have metrics of high cardinality the latency monitoring metrics are disabled by default.
 	// To enable them please call the following in your server initialization code:"
@@ -194,7 +216,11 @@ func (ds *daemonServer) newGRPCServer(
 	}
 	grpcServer := grpc.NewServer(sOpts...)
 	grpc_prometheus.Register(grpcServer)
-	pipelineMetadataQuery, err := service.NewPipelineMetadataQuery(isbSvcClient, ds.pipeline, wmFetchers, rater, pipelineRuntimeCache)
+	var opts []service.PipelineMetadataQueryOption
+	if sideInputsStore != nil {
+		opts = append(opts, service.WithSideInputsStore(sideInputsStore))
+	}
+	pipelineMetadataQuery, err := service.NewPipelineMetadataQuery(isbSvcClient, ds.pipeline, wmFetchers, rater, pipelineRuntimeCache, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -0,0 +1,104 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package service
+
+import (
+	"context"
+	"fmt"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1"
+	"github.com/numaproj/numaflow/pkg/apis/proto/daemon"
+	"github.com/numaproj/numaflow/pkg/isbsvc"
+	"github.com/numaproj/numaflow/pkg/shared/logging"
+)
+
+const (
+	// defaultPeekCount is the number of messages peeked if the request doesn't specify one
+	defaultPeekCount = 10
+	// maxPeekCount is the maximum number of messages to peek in one request
+	maxPeekCount = 100
+	// maxReplayCount is the maximum number of messages to replay in one request
+	maxReplayCount = 1000
+)
+
+// PeekBuffer is used to read the messages of a buffer partition without acknowledging them.
+func (ps *PipelineMetadataQuery) PeekBuffer(ctx context.Context, req *daemon.PeekBufferRequest) (*daemon.PeekBufferResponse, error) {
+	if err := ps.validateBufferOffset(req.GetBuffer(), req.GetOffset()); err != nil {
+		return nil, err
+	}
+	count := req.GetCount()
+	if count <= 0 {
+		count = defaultPeekCount
+	}
+	if count > maxPeekCount {
+		return nil, fmt.Errorf("count %d exceeds the maximum of %d messages to peek", count, maxPeekCount)
+	}
+	compressionType := v1alpha1.CompressionTypeNone
+	if x := ps.pipeline.Spec.InterStepBuffer; x != nil && x.Compression != nil {
+		compressionType = x.Compression.Type
+	}
+	messages, next, err := ps.isbSvcClient.PeekBuffer(ctx, req.GetBuffer(), uint64(req.GetOffset()), int(count), compressionType)
+	if err != nil {
+		return nil, fmt.Errorf("failed to peek messages of buffer %q, %w", req.GetBuffer(), err)
+	}
+	resp := &daemon.PeekBufferResponse{NextOffset: int64(next)}
+	for _, m := range messages {
+		resp.Messages = append(resp.Messages, toBufferMessage(m))
+	}
+	return resp, nil
+}
+
+// ReplayFromOffset is used to write the messages of a buffer partition from an offset to it again, so that they are
+// processed once more.
+func (ps *PipelineMetadataQuery) ReplayFromOffset(ctx context.Context, req *daemon.ReplayFromOffsetRequest) (*daemon.ReplayFromOffsetResponse, error) {
+	if err := ps.validateBufferOffset(req.GetBuffer(), req.GetOffset()); err != nil {
+		return nil, err
+	}
+	if req.GetCount() <= 0 || req.GetCount() > maxReplayCount {
+		return nil, fmt.Errorf("count has to be between 1 and %d, got %d", maxReplayCount, req.GetCount())
+	}
+	replayed, next, err := ps.isbSvcClient.ReplayBuffer(ctx, req.GetBuffer(), uint64(req.GetOffset()), int(req.GetCount()))
+	if err != nil {
+		return nil, fmt.Errorf("failed to replay messages of buffer %q after %d replayed, %w", req.GetBuffer(), replayed, err)
+	}
+	logging.FromContext(ctx).Infow("Replayed buffer messages", "buffer", req.GetBuffer(), "offset", req.GetOffset(), "replayed", replayed, "nextOffset", next)
+	return &daemon.ReplayFromOffsetResponse{Replayed: int64(replayed), NextOffset: int64(next)}, nil
+}
+
+func (ps *PipelineMetadataQuery) validateBufferOffset(buffer string, offset int64) error {
+	if ps.pipeline.FindVertexWithBuffer(buffer) == nil {
+		return fmt.Errorf("buffer %q not found in pipeline %q", buffer, ps.pipeline.Name)
+	}
+	if offset < 0 {
+		return fmt.Errorf("offset can not be negative, got %d", offset)
+	}
+	return nil
+}
+
+func toBufferMessage(m *isbsvc.BufferMessage) *daemon.BufferMessage {
+	return &daemon.BufferMessage{
+		Offset:      int64(m.Offset),
+		Id:          m.Message.ID.String(),
+		Keys:        m.Message.Keys,
+		EventTime:   timestamppb.New(m.Message.EventTime),
+		PublishedAt: timestamppb.New(m.PublishedAt),
+		Headers:     m.Message.Headers,
+		Payload:     m.Message.Payload,
+	}
+}
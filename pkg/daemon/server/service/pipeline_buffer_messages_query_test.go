@@ -0,0 +1,80 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package service
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1"
+	"github.com/numaproj/numaflow/pkg/apis/proto/daemon"
+)
+
+func TestBufferMessages(t *testing.T) {
+	ctx := context.Background()
+	pipeline := &v1alpha1.Pipeline{
+		ObjectMeta: metav1.ObjectMeta{Name: "simple-pipeline", Namespace: "numaflow-system"},
+		Spec: v1alpha1.PipelineSpec{
+			Vertices: []v1alpha1.AbstractVertex{{Name: "in"}, {Name: "cat"}},
+			Edges:    []v1alpha1.Edge{{From: "in", To: "cat"}},
+		},
+	}
+	ps, err := NewPipelineMetadataQuery(&mockIsbSvcClient{}, pipeline, nil, nil, nil)
+	assert.NoError(t, err)
+	buffer := "numaflow-system-simple-pipeline-cat-0"
+
+	t.Run("unknown buffer", func(t *testing.T) {
+		_, err := ps.PeekBuffer(ctx, &daemon.PeekBufferRequest{Pipeline: "simple-pipeline", Buffer: "numaflow-system-simple-pipeline-out-0"})
+		assert.ErrorContains(t, err, "not found in pipeline")
+		_, err = ps.ReplayFromOffset(ctx, &daemon.ReplayFromOffsetRequest{Pipeline: "simple-pipeline", Buffer: "unknown", Count: 1})
+		assert.ErrorContains(t, err, "not found in pipeline")
+	})
+
+	t.Run("peek", func(t *testing.T) {
+		resp, err := ps.PeekBuffer(ctx, &daemon.PeekBufferRequest{Pipeline: "simple-pipeline", Buffer: buffer})
+		assert.NoError(t, err)
+		assert.Len(t, resp.Messages, defaultPeekCount)
+		assert.Equal(t, int64(1), resp.Messages[0].Offset)
+		assert.Equal(t, "in-1-0", resp.Messages[0].Id)
+		assert.Equal(t, []string{"key"}, resp.Messages[0].Keys)
+		assert.Equal(t, buffer, string(resp.Messages[0].Payload))
+		assert.Equal(t, int64(11), resp.NextOffset)
+
+		resp, err = ps.PeekBuffer(ctx, &daemon.PeekBufferRequest{Pipeline: "simple-pipeline", Buffer: buffer, Offset: resp.NextOffset, Count: 2})
+		assert.NoError(t, err)
+		assert.Len(t, resp.Messages, 2)
+		assert.Equal(t, int64(11), resp.Messages[0].Offset)
+
+		_, err = ps.PeekBuffer(ctx, &daemon.PeekBufferRequest{Pipeline: "simple-pipeline", Buffer: buffer, Count: maxPeekCount + 1})
+		assert.ErrorContains(t, err, "exceeds the maximum")
+		_, err = ps.PeekBuffer(ctx, &daemon.PeekBufferRequest{Pipeline: "simple-pipeline", Buffer: buffer, Offset: -1})
+		assert.ErrorContains(t, err, "can not be negative")
+	})
+
+	t.Run("replay", func(t *testing.T) {
+		resp, err := ps.ReplayFromOffset(ctx, &daemon.ReplayFromOffsetRequest{Pipeline: "simple-pipeline", Buffer: buffer, Offset: 5, Count: 3})
+		assert.NoError(t, err)
+		assert.Equal(t, int64(3), resp.Replayed)
+		assert.Equal(t, int64(8), resp.NextOffset)
+
+		_, err = ps.ReplayFromOffset(ctx, &daemon.ReplayFromOffsetRequest{Pipeline: "simple-pipeline", Buffer: buffer, Offset: 5})
+		assert.ErrorContains(t, err, "count has to be between")
+	})
+}
@@ -36,6 +36,7 @@ import (
 	runtimeinfo "github.com/numaproj/numaflow/pkg/daemon/server/service/runtime"
 	"github.com/numaproj/numaflow/pkg/isbsvc"
 	"github.com/numaproj/numaflow/pkg/metrics"
+	"github.com/numaproj/numaflow/pkg/shared/kvs"
 	"github.com/numaproj/numaflow/pkg/shared/logging"
 	"github.com/numaproj/numaflow/pkg/watermark/fetch"
 )
@@ -56,6 +57,17 @@ type PipelineMetadataQuery struct {
 	rater                rater.Ratable
 	pipelineRuntimeCache runtimeinfo.PipelineRuntimeCache
 	healthChecker        *HealthChecker
+	sideInputsStore      kvs.KVStorer
+}
+
+// PipelineMetadataQueryOption is an option of the PipelineMetadataQuery
+type PipelineMetadataQueryOption func(*PipelineMetadataQuery)
+
+// WithSideInputsStore sets the side inputs store, which is required by the side input versions APIs
+func WithSideInputsStore(store kvs.KVStorer) PipelineMetadataQueryOption {
+	return func(ps *PipelineMetadataQuery) {
+		ps.sideInputsStore = store
+	}
 }
 
 // NewPipelineMetadataQuery returns a new instance of pipelineMetadataQuery
@@ -64,7 +76,8 @@ func NewPipelineMetadataQuery(
 	pipeline *v1alpha1.Pipeline,
 	wmFetchers map[v1alpha1.Edge][]fetch.HeadFetcher,
 	rater rater.Ratable,
-	pipelineRuntimeCache runtimeinfo.PipelineRuntimeCache) (*PipelineMetadataQuery, error) {
+	pipelineRuntimeCache runtimeinfo.PipelineRuntimeCache,
+	opts ...PipelineMetadataQueryOption) (*PipelineMetadataQuery, error) {
 	ps := PipelineMetadataQuery{
 		isbSvcClient: isbSvcClient,
 		pipeline:     pipeline,
@@ -79,6 +92,9 @@ func NewPipelineMetadataQuery(
 		healthChecker:        NewHealthChecker(pipeline, isbSvcClient),
 		pipelineRuntimeCache: pipelineRuntimeCache,
 	}
+	for _, opt := range opts {
+		opt(&ps)
+	}
 	return &ps, nil
 }
 
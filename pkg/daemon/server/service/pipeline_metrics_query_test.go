@@ -19,6 +19,7 @@ package service
 import (
 	"bytes"
 	"context"
+	"fmt"
 	"io"
 	"net/http"
 	"testing"
@@ -30,6 +31,7 @@ import (
 
 	"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1"
 	"github.com/numaproj/numaflow/pkg/apis/proto/daemon"
+	"github.com/numaproj/numaflow/pkg/isb"
 	"github.com/numaproj/numaflow/pkg/isbsvc"
 	nats2 "github.com/numaproj/numaflow/pkg/shared/clients/nats"
 	"github.com/numaproj/numaflow/pkg/shared/clients/nats/test"
@@ -74,6 +76,25 @@ func (ms *mockIsbSvcClient) CreateWatermarkStores(ctx context.Context, bucketNam
 	return nil, nil
 }
 
+func (ms *mockIsbSvcClient) PeekBuffer(ctx context.Context, buffer string, offset uint64, count int, compressionType v1alpha1.CompressionType) ([]*isbsvc.BufferMessage, uint64, error) {
+	offset = max(offset, 1)
+	var messages []*isbsvc.BufferMessage
+	for i := 0; i < count; i++ {
+		messages = append(messages, &isbsvc.BufferMessage{
+			Offset: offset + uint64(i),
+			Message: &isb.Message{
+				Header: isb.Header{ID: isb.MessageID{VertexName: "in", Offset: fmt.Sprint(offset + uint64(i))}, Keys: []string{"key"}},
+				Body:   isb.Body{Payload: []byte(buffer)},
+			},
+		})
+	}
+	return messages, offset + uint64(count), nil
+}
+
+func (ms *mockIsbSvcClient) ReplayBuffer(ctx context.Context, buffer string, offset uint64, count int) (int, uint64, error) {
+	return count, max(offset, 1) + uint64(count), nil
+}
+
 // mock rater
 type mockRater_TestGetVertexMetrics struct {
 }
@@ -0,0 +1,77 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package service
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"time"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1"
+	"github.com/numaproj/numaflow/pkg/apis/proto/daemon"
+	"github.com/numaproj/numaflow/pkg/sideinputs/versions"
+)
+
+// ListSideInputVersions is used to obtain the retained versions of a side input, the latest first.
+func (ps *PipelineMetadataQuery) ListSideInputVersions(ctx context.Context, req *daemon.ListSideInputVersionsRequest) (*daemon.ListSideInputVersionsResponse, error) {
+	if err := ps.validateSideInput(req.GetSideInput()); err != nil {
+		return nil, err
+	}
+	h, err := versions.GetHistory(ctx, ps.sideInputsStore, req.GetSideInput())
+	if err != nil {
+		return nil, err
+	}
+	resp := &daemon.ListSideInputVersionsResponse{}
+	for i := len(h.Versions) - 1; i >= 0; i-- {
+		resp.Versions = append(resp.Versions, toSideInputVersion(h.Versions[i], h.Current))
+	}
+	return resp, nil
+}
+
+// RollbackSideInput is used to broadcast a retained version of a side input to the vertices.
+func (ps *PipelineMetadataQuery) RollbackSideInput(ctx context.Context, req *daemon.RollbackSideInputRequest) (*daemon.RollbackSideInputResponse, error) {
+	if err := ps.validateSideInput(req.GetSideInput()); err != nil {
+		return nil, err
+	}
+	v, err := versions.Rollback(ctx, ps.sideInputsStore, req.GetSideInput(), req.GetVersion())
+	if err != nil {
+		return nil, err
+	}
+	return &daemon.RollbackSideInputResponse{Version: toSideInputVersion(*v, v.Version)}, nil
+}
+
+func (ps *PipelineMetadataQuery) validateSideInput(sideInput string) error {
+	if !slices.ContainsFunc(ps.pipeline.Spec.SideInputs, func(si v1alpha1.SideInput) bool { return si.Name == sideInput }) {
+		return fmt.Errorf("side input %q is not defined in pipeline %q", sideInput, ps.pipeline.Name)
+	}
+	if ps.sideInputsStore == nil {
+		return fmt.Errorf("side inputs store is not available for pipeline %q", ps.pipeline.Name)
+	}
+	return nil
+}
+
+func toSideInputVersion(v versions.Version, current int64) *daemon.SideInputVersion {
+	return &daemon.SideInputVersion{
+		Version:   v.Version,
+		Checksum:  v.Checksum,
+		CreatedAt: timestamppb.New(time.UnixMilli(v.CreatedAt)),
+		Current:   v.Version == current,
+	}
+}
@@ -0,0 +1,81 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package service
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1"
+	"github.com/numaproj/numaflow/pkg/apis/proto/daemon"
+	"github.com/numaproj/numaflow/pkg/shared/kvs/inmem"
+	"github.com/numaproj/numaflow/pkg/sideinputs/versions"
+)
+
+func TestSideInputVersions(t *testing.T) {
+	ctx := context.Background()
+	pipeline := &v1alpha1.Pipeline{
+		ObjectMeta: metav1.ObjectMeta{Name: "simple-pipeline"},
+		Spec: v1alpha1.PipelineSpec{
+			SideInputs: []v1alpha1.SideInput{{Name: "s3"}},
+		},
+	}
+	store, err := inmem.NewKVInMemKVStore(ctx, "side-inputs")
+	assert.NoError(t, err)
+	defer store.Close()
+	_, err = versions.Broadcast(ctx, store, "s3", []byte("good"), 5, nil)
+	assert.NoError(t, err)
+	_, err = versions.Broadcast(ctx, store, "s3", []byte("bad"), 5, nil)
+	assert.NoError(t, err)
+
+	t.Run("without store", func(t *testing.T) {
+		ps, err := NewPipelineMetadataQuery(&mockIsbSvcClient{}, pipeline, nil, nil, nil)
+		assert.NoError(t, err)
+		_, err = ps.ListSideInputVersions(ctx, &daemon.ListSideInputVersionsRequest{Pipeline: "simple-pipeline", SideInput: "s3"})
+		assert.ErrorContains(t, err, "side inputs store is not available")
+	})
+
+	ps, err := NewPipelineMetadataQuery(&mockIsbSvcClient{}, pipeline, nil, nil, nil, WithSideInputsStore(store))
+	assert.NoError(t, err)
+
+	t.Run("undefined side input", func(t *testing.T) {
+		_, err := ps.ListSideInputVersions(ctx, &daemon.ListSideInputVersionsRequest{Pipeline: "simple-pipeline", SideInput: "s4"})
+		assert.ErrorContains(t, err, "is not defined")
+	})
+
+	t.Run("list and rollback", func(t *testing.T) {
+		resp, err := ps.ListSideInputVersions(ctx, &daemon.ListSideInputVersionsRequest{Pipeline: "simple-pipeline", SideInput: "s3"})
+		assert.NoError(t, err)
+		assert.Len(t, resp.Versions, 2)
+		assert.Equal(t, int64(2), resp.Versions[0].Version)
+		assert.True(t, resp.Versions[0].Current)
+		assert.Equal(t, versions.Checksum([]byte("good")), resp.Versions[1].Checksum)
+
+		rollback, err := ps.RollbackSideInput(ctx, &daemon.RollbackSideInputRequest{Pipeline: "simple-pipeline", SideInput: "s3", Version: 1})
+		assert.NoError(t, err)
+		assert.Equal(t, int64(1), rollback.Version.Version)
+		assert.True(t, rollback.Version.Current)
+
+		resp, err = ps.ListSideInputVersions(ctx, &daemon.ListSideInputVersionsRequest{Pipeline: "simple-pipeline", SideInput: "s3"})
+		assert.NoError(t, err)
+		assert.False(t, resp.Versions[0].Current)
+		assert.True(t, resp.Versions[1].Current)
+	})
+}
@@ -102,7 +102,7 @@ func (pt *PodTracker) updateActivePods() {
 	var wg sync.WaitGroup
 
 	for _, v := range pt.pipeline.Spec.Vertices {
-		for i := range int(v.Scale.GetMaxReplicas()) {
+		for i := range int(v.Scale.GetHighestMaxReplicas()) {
 			wg.Add(1)
 			go func(vertexName string, index int) {
 				defer wg.Done()
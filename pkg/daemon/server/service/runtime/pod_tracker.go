@@ -100,7 +100,7 @@ func (pt *PodTracker) updateActivePods() {
 		maxActiveIndex[vertexName] = -1
 		mu.Unlock()
 
-		for i := range int(v.Scale.GetMaxReplicas()) {
+		for i := range int(v.Scale.GetHighestMaxReplicas()) {
 			wg.Add(1)
 			go func(vertexName string, index int) {
 				defer wg.Done()
@@ -0,0 +1,98 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package forwarder
+
+import (
+	"fmt"
+	"strconv"
+
+	dfv1 "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1"
+	"github.com/numaproj/numaflow/pkg/isb"
+	"github.com/numaproj/numaflow/pkg/metrics"
+	"github.com/numaproj/numaflow/pkg/shared/expr"
+	sharedutil "github.com/numaproj/numaflow/pkg/shared/util"
+)
+
+// EdgeConditions evaluates the conditional forwarding conditions of the edges to the downstream vertices.
+type EdgeConditions struct {
+	vertexName   string
+	pipelineName string
+	vertexType   dfv1.VertexType
+	replicaIndex int32
+	// expressions are the compiled expression conditions, keyed by the to vertex name.
+	expressions map[string]*expr.BoolExpression
+}
+
+// NewEdgeConditions compiles the expression conditions of the edges to the downstream vertices.
+func NewEdgeConditions(vertexInstance *dfv1.VertexInstance) (*EdgeConditions, error) {
+	ec := &EdgeConditions{
+		vertexName:   vertexInstance.Vertex.Spec.Name,
+		pipelineName: vertexInstance.Vertex.Spec.PipelineName,
+		vertexType:   vertexInstance.Vertex.GetVertexType(),
+		replicaIndex: vertexInstance.Replica,
+		expressions:  make(map[string]*expr.BoolExpression),
+	}
+	for _, edge := range vertexInstance.Vertex.Spec.ToEdges {
+		if edge.Conditions == nil || edge.Conditions.Expression == "" {
+			continue
+		}
+		e, err := expr.CompileBool(edge.Conditions.Expression)
+		if err != nil {
+			return nil, fmt.Errorf("invalid expression condition of the edge from %q to %q, %w", edge.From, edge.To, err)
+		}
+		ec.expressions[edge.To] = e
+	}
+	return ec, nil
+}
+
+// Match returns true if the message should be forwarded to the edge, that is the edge has no conditions, or the tags
+// of the message match the tag conditions and the message matches the expression condition.
+func (ec *EdgeConditions) Match(edge dfv1.CombinedEdge, tags []string, msg *isb.Message) bool {
+	if edge.Conditions == nil {
+		return true
+	}
+	if t := edge.Conditions.Tags; t != nil && len(t.Values) > 0 && !sharedutil.CompareSlice(t.GetOperator(), tags, t.Values) {
+		return false
+	}
+	return ec.MatchExpression(edge, msg)
+}
+
+// MatchExpression returns true if the edge has no expression condition, or the message matches it. A message which
+// fails the evaluation is not forwarded.
+func (ec *EdgeConditions) MatchExpression(edge dfv1.CombinedEdge, msg *isb.Message) bool {
+	e, ok := ec.expressions[edge.To]
+	if !ok {
+		return true
+	}
+	var payload []byte
+	var headers map[string]string
+	if msg != nil {
+		payload = msg.Payload
+		headers = msg.Headers
+	}
+	matched, err := e.Eval(payload, headers)
+	if err != nil {
+		metrics.EdgeConditionErrors.With(map[string]string{
+			metrics.LabelVertex:             ec.vertexName,
+			metrics.LabelPipeline:           ec.pipelineName,
+			metrics.LabelVertexType:         string(ec.vertexType),
+			metrics.LabelVertexReplicaIndex: strconv.Itoa(int(ec.replicaIndex)),
+		}).Inc()
+		return false
+	}
+	return matched
+}
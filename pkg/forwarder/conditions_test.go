@@ -0,0 +1,71 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package forwarder
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	dfv1 "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1"
+	"github.com/numaproj/numaflow/pkg/isb"
+)
+
+func TestEdgeConditions(t *testing.T) {
+	operatorAnd := dfv1.LogicOperatorAnd
+	edges := []dfv1.CombinedEdge{
+		{Edge: dfv1.Edge{From: "in", To: "all"}},
+		{Edge: dfv1.Edge{From: "in", To: "tags", Conditions: &dfv1.ForwardConditions{Tags: &dfv1.TagConditions{Operator: &operatorAnd, Values: []string{"a", "b"}}}}},
+		{Edge: dfv1.Edge{From: "in", To: "expression", Conditions: &dfv1.ForwardConditions{Expression: `json(payload).amount > 100 && headers["type"] == "order"`}}},
+		{Edge: dfv1.Edge{From: "in", To: "both", Conditions: &dfv1.ForwardConditions{Tags: &dfv1.TagConditions{Values: []string{"a"}}, Expression: `int(payload) > 100`}}},
+	}
+	vertexInstance := &dfv1.VertexInstance{
+		Vertex: &dfv1.Vertex{Spec: dfv1.VertexSpec{
+			PipelineName:   "test-pipeline",
+			AbstractVertex: dfv1.AbstractVertex{Name: "in"},
+			ToEdges:        edges,
+		}},
+	}
+	ec, err := NewEdgeConditions(vertexInstance)
+	assert.NoError(t, err)
+
+	message := func(payload string, headers map[string]string) *isb.Message {
+		return &isb.Message{Header: isb.Header{Headers: headers}, Body: isb.Body{Payload: []byte(payload)}}
+	}
+	order := message(`{"amount": 120}`, map[string]string{"type": "order"})
+
+	assert.True(t, ec.Match(edges[0], nil, order))
+	assert.True(t, ec.Match(edges[1], []string{"a", "b"}, order))
+	assert.False(t, ec.Match(edges[1], []string{"c"}, order))
+	assert.True(t, ec.Match(edges[2], nil, order))
+	assert.False(t, ec.Match(edges[2], nil, message(`{"amount": 120}`, nil)))
+	assert.False(t, ec.Match(edges[2], nil, message(`{"amount": 80}`, map[string]string{"type": "order"})))
+	// a message which fails the evaluation is not forwarded
+	assert.False(t, ec.Match(edges[2], nil, message(`not a json`, map[string]string{"type": "order"})))
+	assert.True(t, ec.Match(edges[3], []string{"a"}, message(`120`, nil)))
+	assert.False(t, ec.Match(edges[3], []string{"b"}, message(`120`, nil)))
+	assert.False(t, ec.Match(edges[3], []string{"a"}, message(`80`, nil)))
+	// only the expression is checked without the tags
+	assert.True(t, ec.MatchExpression(edges[1], order))
+	assert.True(t, ec.MatchExpression(edges[3], message(`120`, nil)))
+
+	vertexInstance.Vertex.Spec.ToEdges = []dfv1.CombinedEdge{
+		{Edge: dfv1.Edge{From: "in", To: "out", Conditions: &dfv1.ForwardConditions{Expression: `payload ==`}}},
+	}
+	_, err = NewEdgeConditions(vertexInstance)
+	assert.Error(t, err)
+}
@@ -16,6 +16,8 @@ limitations under the License.
 
 package forwarder
 
+import "github.com/numaproj/numaflow/pkg/isb"
+
 // VertexBuffer points to the partition of a buffer owned by the vertex.
 type VertexBuffer struct {
 	ToVertexName         string
@@ -36,15 +38,17 @@ type ToWhichStepDecider interface {
 	//
 	// - id: Used by shuffle to decide which partition to write, if the toVertex is a 'map' and has
 	// multiple partitions. It is deterministic messages with same id will always go to the same partition.
-	WhereTo([]string, []string, string) ([]VertexBuffer, error)
+	//
+	// - msg: Used for conditional forwarding, the expression conditions are evaluated against its payload and headers.
+	WhereTo([]string, []string, string, *isb.Message) ([]VertexBuffer, error)
 }
 
 // GoWhere is the step decider on where it needs to go
-type GoWhere func([]string, []string, string) ([]VertexBuffer, error)
+type GoWhere func([]string, []string, string, *isb.Message) ([]VertexBuffer, error)
 
 // WhereTo decides where the data goes to.
-func (gw GoWhere) WhereTo(ks []string, ts []string, id string) ([]VertexBuffer, error) {
-	return gw(ks, ts, id)
+func (gw GoWhere) WhereTo(ks []string, ts []string, id string, msg *isb.Message) ([]VertexBuffer, error) {
+	return gw(ks, ts, id, msg)
 }
 
 // StarterStopper starts/stops the forwarding.
@@ -107,3 +107,11 @@ var isbAckTime = promauto.NewHistogramVec(prometheus.HistogramOpts{
 	Help:      "Processing times of acks for jetstream",
 	Buckets:   prometheus.ExponentialBucketsRange(100, 60000000*2, 10),
 }, []string{"buffer"})
+
+// isbCompressionRatio is a histogram to Observe the ratio of compressed to uncompressed payload size for a buffer and codec
+var isbCompressionRatio = promauto.NewHistogramVec(prometheus.HistogramOpts{
+	Subsystem: "isb_jetstream",
+	Name:      "compression_ratio",
+	Help:      "Ratio of compressed payload size to uncompressed payload size",
+	Buckets:   prometheus.LinearBuckets(0.1, 0.1, 10),
+}, []string{"buffer", "codec"})
@@ -32,6 +32,8 @@ type writeOptions struct {
 	refreshInterval time.Duration
 	// bufferFullWritingStrategy is the writing strategy when buffer is full
 	bufferFullWritingStrategy dfv1.BufferFullWritingStrategy
+	// compressionType is the compression type used to compress the message payload
+	compressionType dfv1.CompressionType
 }
 
 func defaultWriteOptions() *writeOptions {
@@ -40,6 +42,7 @@ func defaultWriteOptions() *writeOptions {
 		bufferUsageLimit:          dfv1.DefaultBufferUsageLimit,
 		refreshInterval:           1 * time.Second,
 		bufferFullWritingStrategy: dfv1.RetryUntilSuccess,
+		compressionType:           dfv1.CompressionTypeNone,
 	}
 }
 
@@ -77,10 +80,20 @@ func WithBufferFullWritingStrategy(s dfv1.BufferFullWritingStrategy) WriteOption
 	}
 }
 
+// WithCompressionType sets the compression type used to compress the message payload
+func WithCompressionType(t dfv1.CompressionType) WriteOption {
+	return func(o *writeOptions) error {
+		o.compressionType = t
+		return nil
+	}
+}
+
 // options for reading from JetStream
 type readOptions struct {
 	// readTimeOut is the timeout needed for read timeout
 	readTimeOut time.Duration
+	// compressionType is the codec of the messages written without the compression header, e.g. by the Rust runtime
+	compressionType dfv1.CompressionType
 }
 
 type ReadOption func(*readOptions) error
@@ -93,8 +106,17 @@ func WithReadTimeOut(timeout time.Duration) ReadOption {
 	}
 }
 
+// WithDefaultCompressionType sets the compression type assumed for the messages without the compression header
+func WithDefaultCompressionType(t dfv1.CompressionType) ReadOption {
+	return func(o *readOptions) error {
+		o.compressionType = t
+		return nil
+	}
+}
+
 func defaultReadOptions() *readOptions {
 	return &readOptions{
-		readTimeOut: time.Second,
+		readTimeOut:     time.Second,
+		compressionType: dfv1.CompressionTypeNone,
 	}
 }
@@ -27,8 +27,10 @@ import (
 	"github.com/nats-io/nats.go"
 	"go.uber.org/zap"
 
+	dfv1 "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1"
 	"github.com/numaproj/numaflow/pkg/isb"
 	jsclient "github.com/numaproj/numaflow/pkg/shared/clients/nats"
+	"github.com/numaproj/numaflow/pkg/shared/compression"
 	"github.com/numaproj/numaflow/pkg/shared/logging"
 )
 
@@ -125,11 +127,10 @@ func (jr *jetStreamReader) Read(_ context.Context, count int64) ([]*isb.ReadMess
 		return nil, fmt.Errorf("failed to fetch messages from jet stream subject %q, %w", jr.subject, err)
 	}
 	for _, msg := range msgs {
-		var m = new(isb.Message)
-		// err should be nil as we have our own marshaller/unmarshaller
-		err = m.UnmarshalBinary(msg.Data)
+		m, err := ToISBMessage(msg, jr.opts.compressionType)
 		if err != nil {
-			return nil, fmt.Errorf("failed to unmarshal the message into isb.Message, %w", err)
+			isbReadErrors.With(labels).Inc()
+			return nil, err
 		}
 		msgMetadata, err := msg.Metadata()
 		if err != nil {
@@ -147,6 +148,34 @@ func (jr *jetStreamReader) Read(_ context.Context, count int64) ([]*isb.ReadMess
 	return result, nil
 }
 
+// ToISBMessage converts the nats message to an isb.Message, decompressing the payload with the codec
+// in the compression header. The Go writer sets the header whenever it compresses, so a message without
+// the header is either uncompressed (e.g. written before compression was enabled) or written by the Rust
+// runtime, which compresses with the pipeline config without setting the header. Such messages are
+// decompressed with defaultCodec, and kept as they are if that fails.
+func ToISBMessage(msg *nats.Msg, defaultCodec dfv1.CompressionType) (*isb.Message, error) {
+	var m = new(isb.Message)
+	// err should be nil as we have our own marshaller/unmarshaller
+	if err := m.UnmarshalBinary(msg.Data); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal the message into isb.Message, %w", err)
+	}
+	if codec := dfv1.CompressionType(msg.Header.Get(compression.HeaderKey)); codec != "" {
+		payload, err := compression.Decompress(codec, m.Body.Payload)
+		if err != nil {
+			return nil, fmt.Errorf("failed to decompress the message payload, %w", err)
+		}
+		m.Body.Payload = payload
+		return m, nil
+	}
+	if m.Header.Kind != isb.WMB && compression.IsEnabled(defaultCodec) {
+		// all the supported codecs start with a magic number, so an uncompressed payload fails to decompress.
+		if payload, err := compression.Decompress(defaultCodec, m.Body.Payload); err == nil {
+			m.Body.Payload = payload
+		}
+	}
+	return m, nil
+}
+
 func (jr *jetStreamReader) Ack(_ context.Context, offsets []isb.Offset) []error {
 	labels := map[string]string{"buffer": jr.GetName()}
 	defer func(t time.Time) {
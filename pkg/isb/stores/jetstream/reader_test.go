@@ -26,10 +26,12 @@ import (
 	"github.com/nats-io/nats.go"
 	"github.com/stretchr/testify/assert"
 
+	dfv1 "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1"
 	"github.com/numaproj/numaflow/pkg/isb"
 	"github.com/numaproj/numaflow/pkg/isb/testutils"
 	natsclient "github.com/numaproj/numaflow/pkg/shared/clients/nats"
 	natstest "github.com/numaproj/numaflow/pkg/shared/clients/nats/test"
+	"github.com/numaproj/numaflow/pkg/shared/compression"
 )
 
 func TestMain(m *testing.M) {
@@ -131,6 +133,155 @@ func TestJetStreamBufferRead(t *testing.T) {
 
 }
 
+// TestJetStreamBufferReadCompressed tests reading the messages written with each compression type
+func TestJetStreamBufferReadCompressed(t *testing.T) {
+	s := natstest.RunJetStreamServer(t)
+	defer natstest.ShutdownJetStreamServer(t, s)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 1*time.Minute)
+	defer cancel()
+
+	defaultJetStreamClient := natsclient.NewTestClientWithServer(t, s)
+	defer defaultJetStreamClient.Close()
+	js, err := defaultJetStreamClient.JetStreamContext()
+	assert.NoError(t, err)
+
+	for _, ct := range []dfv1.CompressionType{dfv1.CompressionTypeGZIP, dfv1.CompressionTypeZSTD, dfv1.CompressionTypeLZ4} {
+		t.Run(string(ct), func(t *testing.T) {
+			streamName := "testJetStreamBufferReadCompressed" + string(ct)
+			addStream(t, js, streamName)
+			defer deleteStream(t, js, streamName)
+
+			bw, err := NewJetStreamBufferWriter(ctx, defaultJetStreamClient, streamName, streamName, streamName, defaultPartitionIdx, WithCompressionType(ct))
+			assert.NoError(t, err)
+			jw, _ := bw.(*jetStreamWriter)
+			defer jw.Close()
+			for jw.isFull.Load() {
+				select {
+				case <-ctx.Done():
+					t.Fatalf("expected not to be full, %s", ctx.Err())
+				default:
+					time.Sleep(1 * time.Millisecond)
+				}
+			}
+			startTime := time.Unix(1636470000, 0)
+			messages := testutils.BuildTestWriteMessages(int64(10), startTime, nil, "testVertex")
+			_, errs := jw.Write(ctx, messages)
+			for _, e := range errs {
+				assert.NoError(t, e)
+			}
+
+			// the payload in the stream should be compressed and marked with the codec
+			raw, err := js.GetMsg(streamName, 1)
+			assert.NoError(t, err)
+			assert.Equal(t, string(ct), raw.Header.Get(compression.HeaderKey))
+			var stored isb.Message
+			assert.NoError(t, stored.UnmarshalBinary(raw.Data))
+			assert.NotEqual(t, messages[0].Body.Payload, stored.Body.Payload)
+
+			bufferReader, err := NewJetStreamBufferReader(ctx, defaultJetStreamClient, streamName, streamName, streamName, defaultPartitionIdx)
+			assert.NoError(t, err)
+			fromStep := bufferReader.(*jetStreamReader)
+			defer fromStep.Close()
+
+			readMessages, err := fromStep.Read(ctx, 10)
+			assert.NoError(t, err)
+			assert.Equal(t, 10, len(readMessages))
+			// messages are written concurrently, so the order is not guaranteed.
+			for _, m := range readMessages {
+				assert.Equal(t, messages[m.Header.ID.Index].Body.Payload, m.Body.Payload)
+			}
+		})
+	}
+}
+
+// TestJetStreamBufferReadBacklogBeforeCompression tests reading the messages written before compression was enabled
+func TestJetStreamBufferReadBacklogBeforeCompression(t *testing.T) {
+	s := natstest.RunJetStreamServer(t)
+	defer natstest.ShutdownJetStreamServer(t, s)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 1*time.Minute)
+	defer cancel()
+
+	defaultJetStreamClient := natsclient.NewTestClientWithServer(t, s)
+	defer defaultJetStreamClient.Close()
+	js, err := defaultJetStreamClient.JetStreamContext()
+	assert.NoError(t, err)
+
+	streamName := "testJetStreamBufferReadBacklogBeforeCompression"
+	addStream(t, js, streamName)
+	defer deleteStream(t, js, streamName)
+
+	startTime := time.Unix(1636470000, 0)
+	messages := testutils.BuildTestWriteMessages(int64(10), startTime, nil, "testVertex")
+	// the first half is written before compression is enabled, the second half after.
+	for i, ct := range []dfv1.CompressionType{dfv1.CompressionTypeNone, dfv1.CompressionTypeZSTD} {
+		bw, err := NewJetStreamBufferWriter(ctx, defaultJetStreamClient, streamName, streamName, streamName, defaultPartitionIdx, WithCompressionType(ct))
+		assert.NoError(t, err)
+		jw, _ := bw.(*jetStreamWriter)
+		for jw.isFull.Load() {
+			select {
+			case <-ctx.Done():
+				t.Fatalf("expected not to be full, %s", ctx.Err())
+			default:
+				time.Sleep(1 * time.Millisecond)
+			}
+		}
+		_, errs := jw.Write(ctx, messages[i*5:(i+1)*5])
+		for _, e := range errs {
+			assert.NoError(t, e)
+		}
+		_ = jw.Close()
+	}
+
+	bufferReader, err := NewJetStreamBufferReader(ctx, defaultJetStreamClient, streamName, streamName, streamName, defaultPartitionIdx, WithDefaultCompressionType(dfv1.CompressionTypeZSTD))
+	assert.NoError(t, err)
+	fromStep := bufferReader.(*jetStreamReader)
+	defer fromStep.Close()
+
+	readMessages, err := fromStep.Read(ctx, 10)
+	assert.NoError(t, err)
+	assert.Equal(t, 10, len(readMessages))
+	for _, m := range readMessages {
+		assert.Equal(t, messages[m.Header.ID.Index].Body.Payload, m.Body.Payload)
+	}
+}
+
+// TestToISBMessage_WithoutCompressionHeader tests decoding the messages written without the compression header, e.g. by the Rust runtime
+func TestToISBMessage_WithoutCompressionHeader(t *testing.T) {
+	payload := []byte("test-payload")
+	compressed, err := compression.Compress(dfv1.CompressionTypeZSTD, payload)
+	assert.NoError(t, err)
+
+	data, err := (&isb.Message{Header: isb.Header{Kind: isb.Data}, Body: isb.Body{Payload: compressed}}).MarshalBinary()
+	assert.NoError(t, err)
+	m, err := ToISBMessage(&nats.Msg{Data: data}, dfv1.CompressionTypeZSTD)
+	assert.NoError(t, err)
+	assert.Equal(t, payload, m.Body.Payload)
+
+	// the compression header takes precedence over the default codec
+	gzipped, err := compression.Compress(dfv1.CompressionTypeGZIP, payload)
+	assert.NoError(t, err)
+	data, err = (&isb.Message{Header: isb.Header{Kind: isb.Data}, Body: isb.Body{Payload: gzipped}}).MarshalBinary()
+	assert.NoError(t, err)
+	m, err = ToISBMessage(&nats.Msg{Header: nats.Header{compression.HeaderKey: []string{string(dfv1.CompressionTypeGZIP)}}, Data: data}, dfv1.CompressionTypeZSTD)
+	assert.NoError(t, err)
+	assert.Equal(t, payload, m.Body.Payload)
+
+	// the uncompressed messages without the header are kept as they are
+	data, err = (&isb.Message{Header: isb.Header{Kind: isb.Data}, Body: isb.Body{Payload: payload}}).MarshalBinary()
+	assert.NoError(t, err)
+	m, err = ToISBMessage(&nats.Msg{Data: data}, dfv1.CompressionTypeZSTD)
+	assert.NoError(t, err)
+	assert.Equal(t, payload, m.Body.Payload)
+
+	// the control messages are never compressed
+	data, err = (&isb.Message{Header: isb.Header{Kind: isb.WMB}}).MarshalBinary()
+	assert.NoError(t, err)
+	_, err = ToISBMessage(&nats.Msg{Data: data}, dfv1.CompressionTypeZSTD)
+	assert.NoError(t, err)
+}
+
 // TestGetName is used to test the GetName function
 func TestGetName(t *testing.T) {
 	s := natstest.RunJetStreamServer(t)
@@ -29,6 +29,7 @@ import (
 	"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1"
 	"github.com/numaproj/numaflow/pkg/isb"
 	jsclient "github.com/numaproj/numaflow/pkg/shared/clients/nats"
+	"github.com/numaproj/numaflow/pkg/shared/compression"
 	"github.com/numaproj/numaflow/pkg/shared/logging"
 	sharedutil "github.com/numaproj/numaflow/pkg/shared/util"
 )
@@ -188,15 +189,11 @@ func (jw *jetStreamWriter) asyncWrite(_ context.Context, messages []isb.Message,
 	var writeOffsets = make([]isb.Offset, len(messages))
 	var futures = make([]nats.PubAckFuture, len(messages))
 	for index, message := range messages {
-		payload, err := message.MarshalBinary()
+		m, err := jw.toNatsMsg(message)
 		if err != nil {
 			errs[index] = err
 			continue
 		}
-		m := &nats.Msg{
-			Subject: jw.subject,
-			Data:    payload,
-		}
 		var pubOpts []nats.PubOpt
 		// nats.MsgId() is for exactly-once writing
 		// we don't need to set MsgId for control message
@@ -265,15 +262,11 @@ func (jw *jetStreamWriter) syncWrite(_ context.Context, messages []isb.Message,
 		wg.Add(1)
 		go func(message isb.Message, idx int) {
 			defer wg.Done()
-			payload, err := message.MarshalBinary()
+			m, err := jw.toNatsMsg(message)
 			if err != nil {
 				errs[idx] = err
 				return
 			}
-			m := &nats.Msg{
-				Subject: jw.subject,
-				Data:    payload,
-			}
 			pubOpts := []nats.PubOpt{nats.AckWait(2 * time.Second)}
 			// nats.MsgId() is for exactly-once writing
 			// we don't need to set MsgId for control message
@@ -300,6 +293,34 @@ func (jw *jetStreamWriter) syncWrite(_ context.Context, messages []isb.Message,
 	return writeOffsets, errs
 }
 
+// toNatsMsg converts the isb.Message to a nats.Msg. If compression is enabled, the payload of the
+// data messages gets compressed, and the codec is recorded in the nats message header so that
+// readers can decompress it.
+func (jw *jetStreamWriter) toNatsMsg(message isb.Message) (*nats.Msg, error) {
+	m := &nats.Msg{
+		Subject: jw.subject,
+	}
+	if message.Header.Kind != isb.WMB && compression.IsEnabled(jw.opts.compressionType) {
+		compressed, err := compression.Compress(jw.opts.compressionType, message.Body.Payload)
+		if err != nil {
+			return nil, err
+		}
+		if len(message.Body.Payload) > 0 {
+			isbCompressionRatio.With(map[string]string{"buffer": jw.GetName(), "codec": string(jw.opts.compressionType)}).Observe(float64(len(compressed)) / float64(len(message.Body.Payload)))
+		}
+		// message is passed by value, so replacing the payload doesn't change the caller's message.
+		message.Body.Payload = compressed
+		m.Header = nats.Header{}
+		m.Header.Set(compression.HeaderKey, string(jw.opts.compressionType))
+	}
+	payload, err := message.MarshalBinary()
+	if err != nil {
+		return nil, err
+	}
+	m.Data = payload
+	return m, nil
+}
+
 // writeOffset is the offset of the location in the JS stream we wrote to.
 type writeOffset struct {
 	seq          uint64
@@ -27,8 +27,10 @@ import (
 	"go.uber.org/atomic"
 	"go.uber.org/zap"
 
+	dfv1 "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1"
 	"github.com/numaproj/numaflow/pkg/isb"
 	redisclient "github.com/numaproj/numaflow/pkg/shared/clients/redis"
+	"github.com/numaproj/numaflow/pkg/shared/compression"
 	"github.com/numaproj/numaflow/pkg/shared/logging"
 )
 
@@ -169,6 +171,15 @@ func getHeaderAndBody(field string, value interface{}) (msg isb.Message, err err
 	}
 
 	msg.Body.Payload = []byte(value.(string))
+	if codec, ok := msg.Header.Headers[compression.HeaderKey]; ok {
+		if msg.Body.Payload, err = compression.Decompress(dfv1.CompressionType(codec), msg.Body.Payload); err != nil {
+			return msg, fmt.Errorf("payload decompress error %w", err)
+		}
+		delete(msg.Header.Headers, compression.HeaderKey)
+		if len(msg.Header.Headers) == 0 {
+			msg.Header.Headers = nil
+		}
+	}
 	return msg, nil
 }
 
@@ -30,6 +30,7 @@ import (
 	dfv1 "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1"
 	"github.com/numaproj/numaflow/pkg/isb"
 	redisclient "github.com/numaproj/numaflow/pkg/shared/clients/redis"
+	"github.com/numaproj/numaflow/pkg/shared/compression"
 	"github.com/numaproj/numaflow/pkg/shared/logging"
 )
 
@@ -178,6 +179,11 @@ func (bw *BufferWrite) Write(_ context.Context, messages []isb.Message) ([]isb.O
 	// Maybe just do pipelined write, always?
 	if !bw.Pipelining {
 		for idx, message := range messages {
+			message, err := compressMessage(bw.CompressionType, message)
+			if err != nil {
+				errs[idx] = err
+				continue
+			}
 			// Reference the Payload in Body directly when writing to Redis ISB to avoid extra marshaling.
 			// TODO: revisit directly Payload reference when Body structure changes
 			errs[idx] = script.Run(ctx, bw.Client, []string{bw.GetHashKeyName(message.EventTime), bw.Stream}, message.Header.ID.String(), message.Header, message.Body.Payload, bw.BufferWriteInfo.minId.String()).Err()
@@ -215,6 +221,11 @@ func (bw *BufferWrite) pipelinedWrite(ctx context.Context, script *redis.Script,
 	pipe := bw.Client.Pipeline()
 
 	for idx, message := range messages {
+		message, err := compressMessage(bw.CompressionType, message)
+		if err != nil {
+			errs[idx] = err
+			continue
+		}
 		// Reference the Payload in Body directly when writing to Redis ISB to avoid extra marshaling.
 		// TODO: revisit directly Payload reference when Body structure changes
 		headerBytes, err := message.Header.MarshalBinary()
@@ -246,6 +257,28 @@ func (bw *BufferWrite) pipelinedWrite(ctx context.Context, script *redis.Script,
 	return errs, scriptMissing
 }
 
+// compressMessage compresses the payload of the message with the given compression type. Since the redis
+// stream entry only holds the header and the payload, the codec is recorded in a reserved header key
+// which is removed by the reader after decompressing.
+func compressMessage(t dfv1.CompressionType, message isb.Message) (isb.Message, error) {
+	if !compression.IsEnabled(t) || message.Header.Kind == isb.WMB {
+		return message, nil
+	}
+	payload, err := compression.Compress(t, message.Body.Payload)
+	if err != nil {
+		return message, err
+	}
+	// copy the headers so that the caller's message is not modified.
+	headers := make(map[string]string, len(message.Header.Headers)+1)
+	for k, v := range message.Header.Headers {
+		headers[k] = v
+	}
+	headers[compression.HeaderKey] = string(t)
+	message.Header.Headers = headers
+	message.Body.Payload = payload
+	return message, nil
+}
+
 // GetHashKeyName gets the hash key name.
 func (bw *BufferWrite) GetHashKeyName(startTime time.Time) string {
 	return fmt.Sprintf("%s-h-%d", bw.Stream, startTime.Truncate(exactlyOnceHashWindow).Unix())
@@ -32,6 +32,7 @@ import (
 	"github.com/numaproj/numaflow/pkg/isb"
 	"github.com/numaproj/numaflow/pkg/isb/testutils"
 	redisclient "github.com/numaproj/numaflow/pkg/shared/clients/redis"
+	"github.com/numaproj/numaflow/pkg/shared/compression"
 	"github.com/numaproj/numaflow/pkg/udf/forward"
 	"github.com/numaproj/numaflow/pkg/watermark/generic"
 	"github.com/numaproj/numaflow/pkg/watermark/wmb"
@@ -313,6 +314,30 @@ func Test_updateIsFullFlag(t *testing.T) {
 	}
 }
 
+func Test_compressMessage(t *testing.T) {
+	startTime := time.Unix(1636470000, 0)
+	messages := testutils.BuildTestWriteMessages(1, startTime, nil, "testVertex")
+	messages[0].Header.Headers = map[string]string{"foo": "bar"}
+	for _, ct := range []dfv1.CompressionType{dfv1.CompressionTypeGZIP, dfv1.CompressionTypeZSTD, dfv1.CompressionTypeLZ4} {
+		compressed, err := compressMessage(ct, messages[0])
+		assert.NoError(t, err)
+		assert.Equal(t, string(ct), compressed.Header.Headers[compression.HeaderKey])
+		// the original message should not be modified
+		assert.NotContains(t, messages[0].Header.Headers, compression.HeaderKey)
+
+		headerBytes, err := compressed.Header.MarshalBinary()
+		assert.NoError(t, err)
+		msg, err := getHeaderAndBody(string(headerBytes), string(compressed.Body.Payload))
+		assert.NoError(t, err)
+		assert.Equal(t, messages[0].Body.Payload, msg.Body.Payload)
+		assert.Equal(t, map[string]string{"foo": "bar"}, msg.Header.Headers)
+	}
+
+	notCompressed, err := compressMessage(dfv1.CompressionTypeNone, messages[0])
+	assert.NoError(t, err)
+	assert.Equal(t, messages[0], notCompressed)
+}
+
 func Test_GetName(t *testing.T) {
 	client := redisclient.NewRedisClient(redisOptions)
 	stream := "getName"
@@ -344,7 +369,7 @@ func Test_GetRefreshFullError(t *testing.T) {
 type myForwardRedisTest struct {
 }
 
-func (f myForwardRedisTest) WhereTo(_ []string, _ []string, _ string) ([]forwarder.VertexBuffer, error) {
+func (f myForwardRedisTest) WhereTo(_ []string, _ []string, _ string, _ *isb.Message) ([]forwarder.VertexBuffer, error) {
 	return []forwarder.VertexBuffer{{
 		ToVertexName:         "to1",
 		ToVertexPartitionIdx: 0,
@@ -18,7 +18,10 @@ package isbsvc
 
 import (
 	"context"
+	"time"
 
+	dfv1 "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1"
+	"github.com/numaproj/numaflow/pkg/isb"
 	"github.com/numaproj/numaflow/pkg/watermark/store"
 )
 
@@ -34,6 +37,12 @@ type ISBService interface {
 	GetBufferInfo(ctx context.Context, buffer string) (*BufferInfo, error)
 	// CreateWatermarkStores creates watermark stores
 	CreateWatermarkStores(ctx context.Context, bucketName string, partitions int, isReduce bool) ([]store.WatermarkStore, error)
+	// PeekBuffer reads up to count data messages of the given buffer from the offset without acknowledging them,
+	// it also returns the offset to continue from. The payloads without the compression header are decompressed with the given compression type
+	PeekBuffer(ctx context.Context, buffer string, offset uint64, count int, compressionType dfv1.CompressionType) ([]*BufferMessage, uint64, error)
+	// ReplayBuffer writes up to count data messages of the given buffer from the offset to the buffer again,
+	// it returns the number of the replayed messages and the offset to continue from
+	ReplayBuffer(ctx context.Context, buffer string, offset uint64, count int) (int, uint64, error)
 }
 
 // createOptions describes the options for creating buffers and buckets
@@ -59,3 +68,12 @@ type BufferInfo struct {
 	AckPendingCount int64
 	TotalMessages   int64
 }
+
+// BufferMessage wraps a data message read from a buffer without acknowledging it
+type BufferMessage struct {
+	// Offset is the offset of the message in the buffer
+	Offset uint64
+	// PublishedAt is the time the message was written to the buffer
+	PublishedAt time.Time
+	Message     *isb.Message
+}
@@ -27,6 +27,9 @@ import (
 	"github.com/spf13/viper"
 	"go.uber.org/zap"
 
+	dfv1 "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1"
+	"github.com/numaproj/numaflow/pkg/isb"
+	"github.com/numaproj/numaflow/pkg/isb/stores/jetstream"
 	jsclient "github.com/numaproj/numaflow/pkg/shared/clients/nats"
 	"github.com/numaproj/numaflow/pkg/shared/logging"
 	wmstore "github.com/numaproj/numaflow/pkg/watermark/store"
@@ -69,15 +72,15 @@ func (jss *jetStreamSvc) CreateBuffersAndBuckets(ctx context.Context, buffers, b
 
 	if sideInputsStore != "" {
 		kvName := JetStreamSideInputsStoreKVName(sideInputsStore)
-		if _, err := jss.js.KeyValue(kvName); err != nil {
+		if kv, err := jss.js.KeyValue(kvName); err != nil {
 			if !errors.Is(err, nats.ErrBucketNotFound) && !errors.Is(err, nats.ErrStreamNotFound) {
 				return fmt.Errorf("failed to query information of KV %q, %w", kvName, err)
 			}
 			if _, err := jss.js.CreateKeyValue(&nats.KeyValueConfig{
 				Bucket:       kvName,
 				MaxValueSize: 0,
-				History:      1,                   // No history
-				TTL:          time.Hour * 24 * 30, // 30 days
+				History:      1, // No history
+				TTL:          0, // The retained versions of the side inputs are kept until they are evicted
 				MaxBytes:     0,
 				Storage:      nats.FileStorage,
 				Replicas:     v.GetInt("stream.replicas"),
@@ -85,6 +88,8 @@ func (jss *jetStreamSvc) CreateBuffersAndBuckets(ctx context.Context, buffers, b
 				return fmt.Errorf("failed to create side inputs KV %q, %w", kvName, err)
 			}
 			log.Infow("Succeeded to create a side inputs KV", zap.String("kvName", kvName))
+		} else if err := jss.removeKVTTL(log, kv); err != nil {
+			return fmt.Errorf("failed to remove the TTL of side inputs KV %q, %w", kvName, err)
 		}
 	}
 
@@ -271,6 +276,11 @@ func (jss *jetStreamSvc) DeleteBuffersAndBuckets(ctx context.Context, buffers, b
 			return fmt.Errorf("failed to delete processor KV %q, %w", procKVName, err)
 		}
 		log.Infow("Succeeded to delete a processor KV", zap.String("kvName", procKVName))
+		// the dedup KV only exists for the source buckets with a dedup window, it is created by the source vertex.
+		dedupKVName := JetStreamDedupKVName(bucket)
+		if err := jss.js.DeleteKeyValue(dedupKVName); err != nil && !errors.Is(err, nats.ErrBucketNotFound) && !errors.Is(err, nats.ErrStreamNotFound) {
+			return fmt.Errorf("failed to delete dedup KV %q, %w", dedupKVName, err)
+		}
 	}
 
 	if sideInputsStore != "" {
@@ -388,6 +398,95 @@ func (jss *jetStreamSvc) CreateWatermarkStores(ctx context.Context, bucketName s
 	return wmStores, nil
 }
 
+// PeekBuffer reads up to count data messages of a buffer from the offset without acknowledging them. The offset is the
+// sequence of the message in the stream, 0 means the first message not yet acknowledged by the consumer of the buffer.
+// The payloads written without the compression header, e.g. by the Rust runtime, are decompressed with compressionType.
+func (jss *jetStreamSvc) PeekBuffer(ctx context.Context, buffer string, offset uint64, count int, compressionType dfv1.CompressionType) ([]*BufferMessage, uint64, error) {
+	var messages []*BufferMessage
+	next, err := jss.scanBuffer(ctx, buffer, offset, compressionType, func(raw *nats.RawStreamMsg, m *isb.Message) (bool, error) {
+		messages = append(messages, &BufferMessage{
+			Offset:      raw.Sequence,
+			PublishedAt: raw.Time,
+			Message:     m,
+		})
+		return len(messages) < count, nil
+	})
+	if err != nil {
+		return nil, 0, err
+	}
+	return messages, next, nil
+}
+
+// ReplayBuffer publishes up to count data messages of a buffer from the offset to the stream again, so that they are
+// consumed once more. The messages are published as they are stored, without the message ID used for deduplication.
+func (jss *jetStreamSvc) ReplayBuffer(ctx context.Context, buffer string, offset uint64, count int) (int, uint64, error) {
+	replayed := 0
+	// the messages are published as they are stored, there is no need to decompress them.
+	next, err := jss.scanBuffer(ctx, buffer, offset, dfv1.CompressionTypeNone, func(raw *nats.RawStreamMsg, _ *isb.Message) (bool, error) {
+		// the stored message ID would get the replayed message dropped as a duplicate within the duplicates window
+		header := nats.Header{}
+		for k, v := range raw.Header {
+			if k != nats.MsgIdHdr {
+				header[k] = v
+			}
+		}
+		if _, err := jss.js.PublishMsg(&nats.Msg{Subject: raw.Subject, Header: header, Data: raw.Data}, nats.Context(ctx)); err != nil {
+			return false, fmt.Errorf("failed to replay message %d of stream %q, %w", raw.Sequence, JetStreamName(buffer), err)
+		}
+		replayed++
+		return replayed < count, nil
+	})
+	return replayed, next, err
+}
+
+// scanBuffer calls fn with the data messages of a buffer from the offset, until fn returns false or the last message
+// at the time of the call is reached. It returns the offset of the message to continue from.
+func (jss *jetStreamSvc) scanBuffer(ctx context.Context, buffer string, offset uint64, compressionType dfv1.CompressionType, fn func(*nats.RawStreamMsg, *isb.Message) (bool, error)) (uint64, error) {
+	streamName := JetStreamName(buffer)
+	stream, err := jss.js.StreamInfo(streamName, nats.Context(ctx))
+	if err != nil {
+		return 0, fmt.Errorf("failed to get information of stream %q, %w", streamName, err)
+	}
+	if offset == 0 {
+		consumer, err := jss.js.ConsumerInfo(streamName, streamName, nats.Context(ctx))
+		if err != nil {
+			return 0, fmt.Errorf("failed to get consumer information of stream %q, %w", streamName, err)
+		}
+		offset = consumer.AckFloor.Stream + 1
+	}
+	// the messages before the first sequence have been removed from the stream
+	offset = max(offset, stream.State.FirstSeq)
+	for ; offset <= stream.State.LastSeq; offset++ {
+		if err := ctx.Err(); err != nil {
+			return offset, err
+		}
+		raw, err := jss.js.GetMsg(streamName, offset, nats.Context(ctx))
+		if err != nil {
+			// with the work queue and interest retention policies, acknowledged messages are removed from the stream
+			if errors.Is(err, nats.ErrMsgNotFound) {
+				continue
+			}
+			return offset, fmt.Errorf("failed to get message %d of stream %q, %w", offset, streamName, err)
+		}
+		m, err := jetstream.ToISBMessage(&nats.Msg{Header: raw.Header, Data: raw.Data}, compressionType)
+		if err != nil {
+			return offset, fmt.Errorf("failed to decode message %d of stream %q, %w", offset, streamName, err)
+		}
+		// the watermark barriers are control messages, skip them
+		if m.Kind != isb.Data {
+			continue
+		}
+		more, err := fn(raw, m)
+		if err != nil {
+			return offset, err
+		}
+		if !more {
+			return offset + 1, nil
+		}
+	}
+	return offset, nil
+}
+
 func JetStreamName(bufferName string) string {
 	return bufferName
 }
@@ -396,6 +495,33 @@ func JetStreamSideInputsStoreKVName(sideInputStoreName string) string {
 	return fmt.Sprintf("%s_SIDE_INPUTS", sideInputStoreName)
 }
 
+// removeKVTTL removes the TTL of a KV, which is created with a TTL by the older versions.
+func (jss *jetStreamSvc) removeKVTTL(log *zap.SugaredLogger, kv nats.KeyValue) error {
+	status, err := kv.Status()
+	if err != nil {
+		return err
+	}
+	if status.TTL() == 0 {
+		return nil
+	}
+	// the KV is backed by a stream, the TTL is the max age of the stream
+	info, err := jss.js.StreamInfo("KV_" + kv.Bucket())
+	if err != nil {
+		return err
+	}
+	cfg := info.Config
+	cfg.MaxAge = 0
+	if _, err := jss.js.UpdateStream(&cfg); err != nil {
+		return err
+	}
+	log.Infow("Removed the TTL of the KV", zap.String("kvName", kv.Bucket()), zap.Duration("ttl", status.TTL()))
+	return nil
+}
+
+func JetStreamDedupKVName(sourceBucketName string) string {
+	return fmt.Sprintf("%s_DEDUP", sourceBucketName)
+}
+
 func JetStreamServingCallbackStoreName(servingSourceStoreName string) string {
 	return fmt.Sprintf("%s_SERVING_CALLBACK_STORE", servingSourceStoreName)
 }
@@ -2,11 +2,15 @@ package isbsvc
 
 import (
 	"context"
+	"fmt"
 	"testing"
+	"time"
 
 	"github.com/nats-io/nats.go"
 	"github.com/stretchr/testify/assert"
 
+	dfv1 "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1"
+	"github.com/numaproj/numaflow/pkg/isb"
 	nats2 "github.com/numaproj/numaflow/pkg/shared/clients/nats"
 	"github.com/numaproj/numaflow/pkg/shared/clients/nats/test"
 )
@@ -41,6 +45,40 @@ func TestJetstreamSvc_CreationDeletionValidation(t *testing.T) {
 	assert.NoError(t, err)
 }
 
+func TestJetstreamSvc_SideInputsStoreTTL(t *testing.T) {
+	ctx := context.Background()
+	s := test.RunJetStreamServer(t)
+	defer test.ShutdownJetStreamServer(t, s)
+
+	client := nats2.NewTestClient(t, s.ClientURL())
+	defer client.Close()
+	js, err := client.JetStreamContext()
+	assert.NoError(t, err)
+
+	isbSvc, err := NewISBJetStreamSvc(client)
+	assert.NoError(t, err)
+
+	ttl := func() time.Duration {
+		kv, err := js.KeyValue(JetStreamSideInputsStoreKVName("test-side-input-store"))
+		assert.NoError(t, err)
+		status, err := kv.Status()
+		assert.NoError(t, err)
+		return status.TTL()
+	}
+
+	// the side inputs KV created by the older versions has a TTL
+	_, err = js.CreateKeyValue(&nats.KeyValueConfig{
+		Bucket: JetStreamSideInputsStoreKVName("test-side-input-store"),
+		TTL:    time.Hour,
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, time.Hour, ttl())
+
+	err = isbSvc.CreateBuffersAndBuckets(ctx, nil, []string{"test-bucket"}, "test-side-input-store", "")
+	assert.NoError(t, err)
+	assert.Equal(t, time.Duration(0), ttl())
+}
+
 func TestJetstreamSvc_GetBufferInfo(t *testing.T) {
 	ctx := context.Background()
 	s := test.RunJetStreamServer(t)
@@ -104,3 +142,80 @@ func TestJetstreamSvc_CreateWatermarkStores(t *testing.T) {
 	_, err = isbSvc.CreateWatermarkStores(ctx, bucketName, partitions, false)
 	assert.NoError(t, err)
 }
+
+func TestJetstreamSvc_PeekAndReplayBuffer(t *testing.T) {
+	ctx := context.Background()
+	s := test.RunJetStreamServer(t)
+	defer test.ShutdownJetStreamServer(t, s)
+
+	client := nats2.NewTestClient(t, s.ClientURL())
+	defer client.Close()
+
+	jsCtx, err := client.JetStreamContext()
+	assert.NoError(t, err)
+
+	buffer := "test-buffer"
+	_, err = jsCtx.AddStream(&nats.StreamConfig{
+		Name:     buffer,
+		Subjects: []string{buffer},
+	})
+	assert.NoError(t, err)
+	_, err = jsCtx.AddConsumer(buffer, &nats.ConsumerConfig{
+		Durable:   buffer,
+		AckPolicy: nats.AckExplicitPolicy,
+	})
+	assert.NoError(t, err)
+
+	// 3 data messages with a watermark barrier in between
+	for i, kind := range []isb.MessageKind{isb.Data, isb.WMB, isb.Data, isb.Data} {
+		m := isb.Message{
+			Header: isb.Header{
+				MessageInfo: isb.MessageInfo{EventTime: time.UnixMilli(int64(i))},
+				Kind:        kind,
+				ID:          isb.MessageID{VertexName: "test-vertex", Offset: fmt.Sprint(i)},
+				Headers:     map[string]string{"index": fmt.Sprint(i)},
+			},
+			Body: isb.Body{Payload: []byte(fmt.Sprintf("payload-%d", i))},
+		}
+		data, err := m.MarshalBinary()
+		assert.NoError(t, err)
+		// the message ID is set like the ISB writer does, the replay must not be deduplicated by it
+		_, err = jsCtx.Publish(buffer, data, nats.MsgId(m.ID.String()))
+		assert.NoError(t, err)
+	}
+
+	isbSvc, err := NewISBJetStreamSvc(client)
+	assert.NoError(t, err)
+
+	messages, next, err := isbSvc.PeekBuffer(ctx, buffer, 0, 2, dfv1.CompressionTypeNone)
+	assert.NoError(t, err)
+	assert.Len(t, messages, 2)
+	assert.Equal(t, uint64(1), messages[0].Offset)
+	assert.Equal(t, "payload-0", string(messages[0].Message.Payload))
+	assert.Equal(t, uint64(3), messages[1].Offset)
+	assert.Equal(t, "2", messages[1].Message.Headers["index"])
+	assert.Equal(t, uint64(4), next)
+
+	messages, next, err = isbSvc.PeekBuffer(ctx, buffer, next, 2, dfv1.CompressionTypeNone)
+	assert.NoError(t, err)
+	assert.Len(t, messages, 1)
+	assert.Equal(t, "payload-3", string(messages[0].Message.Payload))
+	assert.Equal(t, uint64(5), next)
+
+	// peeking doesn't acknowledge the messages
+	info, err := isbSvc.GetBufferInfo(ctx, buffer)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(4), info.PendingCount)
+
+	replayed, next, err := isbSvc.ReplayBuffer(ctx, buffer, 2, 10)
+	assert.NoError(t, err)
+	assert.Equal(t, 2, replayed)
+	assert.Equal(t, uint64(5), next)
+
+	messages, _, err = isbSvc.PeekBuffer(ctx, buffer, next, 10, dfv1.CompressionTypeNone)
+	assert.NoError(t, err)
+	assert.Len(t, messages, 2)
+	assert.Equal(t, uint64(5), messages[0].Offset)
+	assert.Equal(t, "payload-2", string(messages[0].Message.Payload))
+	assert.Equal(t, "payload-3", string(messages[1].Message.Payload))
+}
@@ -23,8 +23,10 @@ import (
 	"go.uber.org/multierr"
 	"go.uber.org/zap"
 
+	dfv1 "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1"
 	redis2 "github.com/numaproj/numaflow/pkg/isb/stores/redis"
 	redisclient "github.com/numaproj/numaflow/pkg/shared/clients/redis"
+	rediskv "github.com/numaproj/numaflow/pkg/shared/kvs/redis"
 	"github.com/numaproj/numaflow/pkg/shared/logging"
 	"github.com/numaproj/numaflow/pkg/watermark/store"
 )
@@ -95,6 +97,21 @@ func (r *isbsRedisSvc) DeleteBuffersAndBuckets(ctx context.Context, buffers, buc
 			log.Infow("Redis keys deleted", zap.String("stream", stream))
 		}
 	}
+	var kvNames []string
+	for _, bucket := range buckets {
+		kvNames = append(kvNames, store.RedisOTKVName(bucket), store.RedisProcessorKVName(bucket))
+	}
+	if sideInputsStore != "" {
+		kvNames = append(kvNames, RedisSideInputsStoreKVName(sideInputsStore))
+	}
+	for _, kvName := range kvNames {
+		if err := r.client.DeleteKeys(ctx, rediskv.KVHashKey(kvName), rediskv.KVUpdatesKey(kvName)); err != nil {
+			errList = multierr.Append(errList, err)
+			log.Errorw("Failed to delete Redis KV store.", zap.String("kvName", kvName), zap.Error(err))
+		} else {
+			log.Infow("Redis KV store deleted", zap.String("kvName", kvName))
+		}
+	}
 	if errList != nil {
 		return fmt.Errorf("failed to delete all or some Redis StreamGroups and keys")
 	}
@@ -138,16 +155,39 @@ func (r *isbsRedisSvc) GetBufferInfo(ctx context.Context, buffer string) (*Buffe
 
 // CreateWatermarkStores is used to create the watermark stores.
 func (r *isbsRedisSvc) CreateWatermarkStores(ctx context.Context, bucketName string, fromBufferPartitionCount int, isReduce bool) ([]store.WatermarkStore, error) {
-	// Watermark fetching is not supported for Redis ATM. Creating noop watermark fetcher.
+	log := logging.FromContext(ctx).With("bucket", bucketName)
+	ctx = logging.WithLogger(ctx, log)
 	var wmStores []store.WatermarkStore
 	partitions := 1
 	if isReduce {
 		partitions = fromBufferPartitionCount
 	}
+	// if it's not a reduce vertex, we only need one store to store the watermark
 	for i := 0; i < partitions; i++ {
-		wmStore, _ := store.BuildNoOpWatermarkStore()
+		wmStore, err := store.BuildRedisWatermarkStore(ctx, bucketName, r.client)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create new Redis watermark store, %w", err)
+		}
 		wmStores = append(wmStores, wmStore)
 	}
-
 	return wmStores, nil
 }
+
+// PeekBuffer is not supported by the Redis ISB Service.
+func (r *isbsRedisSvc) PeekBuffer(ctx context.Context, buffer string, offset uint64, count int, compressionType dfv1.CompressionType) ([]*BufferMessage, uint64, error) {
+	return nil, 0, fmt.Errorf("peeking buffer messages is not supported by the redis ISB service")
+}
+
+// ReplayBuffer is not supported by the Redis ISB Service.
+func (r *isbsRedisSvc) ReplayBuffer(ctx context.Context, buffer string, offset uint64, count int) (int, uint64, error) {
+	return 0, 0, fmt.Errorf("replaying buffer messages is not supported by the redis ISB service")
+}
+
+func RedisSideInputsStoreKVName(sideInputStoreName string) string {
+	return fmt.Sprintf("%s_SIDE_INPUTS", sideInputStoreName)
+}
+
+// RedisDedupKVName returns the name of the Redis dedup store of a source vertex.
+func RedisDedupKVName(sourceBucketName string) string {
+	return fmt.Sprintf("%s_DEDUP", sourceBucketName)
+}
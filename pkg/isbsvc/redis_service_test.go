@@ -30,6 +30,8 @@ import (
 
 	"github.com/numaproj/numaflow/pkg/isb/testutils"
 	redisclient "github.com/numaproj/numaflow/pkg/shared/clients/redis"
+	rediskv "github.com/numaproj/numaflow/pkg/shared/kvs/redis"
+	wmstore "github.com/numaproj/numaflow/pkg/watermark/store"
 )
 
 func TestIsbsRedisSvc_Buffers(t *testing.T) {
@@ -81,3 +83,29 @@ func TestIsbsRedisSvc_Buffers(t *testing.T) {
 	// delete buffer
 	assert.NoError(t, isbsRedisSvc.DeleteBuffersAndBuckets(ctx, buffers, nil, "", ""))
 }
+
+func TestIsbsRedisSvc_WatermarkStores(t *testing.T) {
+	ctx := context.Background()
+	redisOptions := &goredis.UniversalOptions{
+		Addrs: []string{":6379"},
+	}
+	redisClient := redisclient.NewRedisClient(redisOptions)
+	isbsRedisSvc := NewISBRedisSvc(redisClient)
+	bucket := "isbsRedisSvcBucket"
+
+	wmStores, err := isbsRedisSvc.CreateWatermarkStores(ctx, bucket, 2, true)
+	assert.NoError(t, err)
+	assert.Len(t, wmStores, 2)
+	assert.NoError(t, wmStores[0].OffsetTimelineStore().PutKV(ctx, "key", []byte("value")))
+	value, err := wmStores[1].OffsetTimelineStore().GetValue(ctx, "key")
+	assert.NoError(t, err)
+	assert.Equal(t, []byte("value"), value)
+	for _, s := range wmStores {
+		_ = s.Close()
+	}
+
+	assert.NoError(t, isbsRedisSvc.DeleteBuffersAndBuckets(ctx, nil, []string{bucket}, "", ""))
+	exists, err := redisClient.Client.Exists(ctx, rediskv.KVHashKey(wmstore.RedisOTKVName(bucket))).Result()
+	assert.NoError(t, err)
+	assert.Equal(t, int64(0), exists)
+}
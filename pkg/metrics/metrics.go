@@ -38,6 +38,8 @@ const (
 	LabelSDKVersion         = "version"
 	LabelSDKType            = "type" // container type, e.g sourcer, sourcetransformer, sinker, etc. see serverinfo.ContainerType
 	LabelReason             = "reason"
+	LabelFiring             = "firing"
+	LabelCallbackURL        = "url"
 )
 
 var (
@@ -210,6 +212,22 @@ var (
 		Name:      "ud_drop_total",
 		Help:      "Total messages dropped by the user",
 	}, []string{LabelVertex, LabelPipeline, LabelVertexType, LabelVertexReplicaIndex})
+
+	// UDFDeadLetterMessagesCount is used to indicate the number of messages failed by the map UDF, and written to the
+	// dead letter vertex
+	UDFDeadLetterMessagesCount = promauto.NewCounterVec(prometheus.CounterOpts{
+		Subsystem: "forwarder",
+		Name:      "udf_dead_letter_total",
+		Help:      "Total number of messages failed by the map UDF and written to the dead letter vertex",
+	}, []string{LabelVertex, LabelPipeline, LabelVertexType, LabelVertexReplicaIndex, LabelPartitionName})
+
+	// EdgeConditionErrors is used to indicate the number of messages not forwarded to an edge because the expression
+	// condition of the edge failed to evaluate, e.g. the payload is not a valid JSON.
+	EdgeConditionErrors = promauto.NewCounterVec(prometheus.CounterOpts{
+		Subsystem: "forwarder",
+		Name:      "edge_condition_errors_total",
+		Help:      "Total number of messages not forwarded because the expression condition of the edge failed to evaluate",
+	}, []string{LabelVertex, LabelPipeline, LabelVertexType, LabelVertexReplicaIndex})
 )
 
 // Source forwarder specific metrics
@@ -221,6 +239,13 @@ var (
 		Help:      "Total number of source transformer Errors",
 	}, []string{LabelVertex, LabelPipeline, LabelVertexType, LabelVertexReplicaIndex, LabelPartitionName})
 
+	// SourceDedupDroppedCount is used to indicate the number of duplicate messages dropped by the source dedup window
+	SourceDedupDroppedCount = promauto.NewCounterVec(prometheus.CounterOpts{
+		Subsystem: "source_forwarder",
+		Name:      "dedup_dropped_total",
+		Help:      "Total number of duplicate messages dropped by the source dedup window",
+	}, []string{LabelVertex, LabelPipeline, LabelVertexType, LabelVertexReplicaIndex, LabelPartitionName})
+
 	// SourceTransformerProcessingTime is a histogram to Observe Source Transformer Processing times as a whole
 	SourceTransformerProcessingTime = promauto.NewHistogramVec(prometheus.HistogramOpts{
 		Subsystem: "source_forwarder",
@@ -253,6 +278,13 @@ var (
 		Help:      "Total number of Messages Dropped",
 	}, []string{LabelVertex, LabelPipeline, LabelVertexReplicaIndex, LabelReason})
 
+	// ReduceLateDataMessagesCount is used to indicate the number of late messages written to the late data side output
+	ReduceLateDataMessagesCount = promauto.NewCounterVec(prometheus.CounterOpts{
+		Subsystem: "reduce_data_forward",
+		Name:      "late_data_total",
+		Help:      "Total number of late messages written to the late data side output",
+	}, []string{LabelVertex, LabelPipeline, LabelVertexReplicaIndex})
+
 	// PBQWriteErrorCount is used to indicate the number of errors while writing to pbq
 	PBQWriteErrorCount = promauto.NewCounterVec(prometheus.CounterOpts{
 		Subsystem: "reduce_pbq",
@@ -283,6 +315,13 @@ var (
 		Buckets:   prometheus.ExponentialBucketsRange(1, 1200000, 5),
 	}, []string{LabelVertex, LabelPipeline, LabelVertexReplicaIndex})
 
+	// ReduceFiringsCount is used to indicate the number of early, on-time and late firings of aligned windows
+	ReduceFiringsCount = promauto.NewCounterVec(prometheus.CounterOpts{
+		Subsystem: "reduce_pnf",
+		Name:      "firings_total",
+		Help:      "Total number of early, on-time and late firings of aligned windows",
+	}, []string{LabelVertex, LabelPipeline, LabelVertexReplicaIndex, LabelFiring})
+
 	// ReduceForwardTime indicates the time it took to forward the readMessages from ISB to PBQ
 	ReduceForwardTime = promauto.NewHistogramVec(prometheus.HistogramOpts{
 		Subsystem: "reduce_data_forward",
@@ -348,6 +387,52 @@ var (
 	}, []string{LabelVertex, LabelPipeline, LabelVertexType, LabelVertexReplicaIndex, LabelPartitionName})
 )
 
+// Callback metrics
+var (
+	// CallbackRequestsCount is used to indicate the number of callback requests sent to a callback URL
+	CallbackRequestsCount = promauto.NewCounterVec(prometheus.CounterOpts{
+		Subsystem: "callback",
+		Name:      "requests_total",
+		Help:      "Total number of callback requests sent to a callback URL, including the retries",
+	}, []string{LabelVertex, LabelPipeline, LabelCallbackURL})
+
+	// CallbackRequestsError is used to indicate the number of callback requests failed
+	CallbackRequestsError = promauto.NewCounterVec(prometheus.CounterOpts{
+		Subsystem: "callback",
+		Name:      "requests_error_total",
+		Help:      "Total number of callback requests failed to be delivered to a callback URL",
+	}, []string{LabelVertex, LabelPipeline, LabelCallbackURL})
+
+	// CallbackRequestTime is a histogram to observe the latency of the callback requests
+	CallbackRequestTime = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Subsystem: "callback",
+		Name:      "request_time",
+		Help:      "Processing times of callback requests to a callback URL (100 microseconds to 10 minutes)",
+		Buckets:   prometheus.ExponentialBucketsRange(100, 60000000*10, 10),
+	}, []string{LabelVertex, LabelPipeline, LabelCallbackURL})
+
+	// CallbackDroppedCount is used to indicate the number of callbacks dropped after all the delivery attempts failed
+	CallbackDroppedCount = promauto.NewCounterVec(prometheus.CounterOpts{
+		Subsystem: "callback",
+		Name:      "dropped_total",
+		Help:      "Total number of callbacks dropped after all the delivery attempts failed",
+	}, []string{LabelVertex, LabelPipeline})
+
+	// CallbackOutboxFullCount is used to indicate the number of callbacks not kept in the outbox because it's full
+	CallbackOutboxFullCount = promauto.NewCounterVec(prometheus.CounterOpts{
+		Subsystem: "callback",
+		Name:      "outbox_full_total",
+		Help:      "Total number of callbacks not kept in the outbox because it's full, they are delivered without redelivery",
+	}, []string{LabelVertex, LabelPipeline})
+
+	// CallbackOutboxPending is a gauge to indicate the number of callback requests waiting in the outbox for redelivery
+	CallbackOutboxPending = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Subsystem: "callback",
+		Name:      "outbox_pending",
+		Help:      "Number of callback request groups waiting in the outbox for redelivery",
+	}, []string{LabelVertex, LabelPipeline})
+)
+
 // Daemon server metrics
 var (
 	// MonoVertexLookBackSecs is a gauge used to indicate what is the current lookback window value being used
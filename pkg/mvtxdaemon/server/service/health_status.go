@@ -138,7 +138,7 @@ func (hc *HealthChecker) getMonoVertexDataCriticality(_ context.Context, mvtxMet
 	if err != nil {
 		return nil, err
 	}
-	maxReplicas := int(hc.monoVertex.Spec.Scale.GetMaxReplicas())
+	maxReplicas := int(hc.monoVertex.Spec.Scale.GetEffectiveMaxReplicas(time.Now()))
 	// default status is healthy
 	status := v1alpha1.MonoVertexStatusHealthy
 	// If the desired replicas are more than the max replicas,
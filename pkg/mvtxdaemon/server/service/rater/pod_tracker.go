@@ -102,7 +102,7 @@ func (pt *PodTracker) trackActivePods(ctx context.Context) {
 func (pt *PodTracker) updateActivePods() {
 	var wg sync.WaitGroup
 
-	for i := range int(pt.monoVertex.Spec.Scale.GetMaxReplicas()) {
+	for i := range int(pt.monoVertex.Spec.Scale.GetHighestMaxReplicas()) {
 		wg.Add(1)
 		go func(index int) {
 			defer wg.Done()
@@ -91,7 +91,7 @@ func (pt *PodTracker) updateActivePods() {
 	var maxActiveIndex atomic.Int32
 	// Initialize maxActiveIndex to -1 to indicate no active pods.
 	maxActiveIndex.Store(int32(-1))
-	for i := range int(pt.monoVertex.Spec.Scale.GetMaxReplicas()) {
+	for i := range int(pt.monoVertex.Spec.Scale.GetHighestMaxReplicas()) {
 		wg.Add(1)
 		go func(index int) {
 			defer wg.Done()
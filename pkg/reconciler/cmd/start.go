@@ -28,6 +28,7 @@ import (
 	appv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/client-go/kubernetes"
+	metricsclientv1beta1 "k8s.io/metrics/pkg/client/clientset/versioned/typed/metrics/v1beta1"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/cache"
 	"sigs.k8s.io/controller-runtime/pkg/controller"
@@ -207,6 +208,13 @@ func Start(namespaced bool, managedNamespace string) {
 		logger.Fatalw("Unable to watch Pipelines", zap.Error(err))
 	}
 
+	// Watch shadow Pipelines of BlueGreen upgrades
+	if err := pipelineController.Watch(source.Kind(mgr.GetCache(), &dfv1.Pipeline{},
+		handler.TypedEnqueueRequestForOwner[*dfv1.Pipeline](mgr.GetScheme(), mgr.GetRESTMapper(), &dfv1.Pipeline{}, handler.OnlyControllerOwner()),
+		predicate.TypedResourceVersionChangedPredicate[*dfv1.Pipeline]{})); err != nil {
+		logger.Fatalw("Unable to watch shadow Pipelines", zap.Error(err))
+	}
+
 	// Watch Vertices
 	if err := pipelineController.Watch(source.Kind(mgr.GetCache(), &dfv1.Vertex{},
 		handler.TypedEnqueueRequestForOwner[*dfv1.Vertex](mgr.GetScheme(), mgr.GetRESTMapper(), &dfv1.Pipeline{}, handler.OnlyControllerOwner()),
@@ -229,7 +237,7 @@ func Start(namespaced bool, managedNamespace string) {
 	}
 
 	// Vertex controller
-	autoscaler := scaling.NewScaler(mgr.GetClient(), scaling.WithWorkers(20))
+	autoscaler := scaling.NewScaler(mgr.GetClient(), scaling.WithWorkers(20), scaling.WithPodMetricsGetter(metricsclientv1beta1.NewForConfigOrDie(restConfig)))
 	vertexController, err := controller.New(dfv1.ControllerVertex, mgr, controller.Options{
 		Reconciler: vertexctrl.NewReconciler(mgr.GetClient(), mgr.GetScheme(), config, image, autoscaler, logger, mgr.GetEventRecorderFor(dfv1.ControllerVertex)),
 	})
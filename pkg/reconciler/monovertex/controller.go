@@ -169,6 +169,10 @@ func (mr *monoVertexReconciler) reconcile(ctx context.Context, monoVtx *dfv1.Mon
 		return ctrl.Result{}, fmt.Errorf("failed to check mono vertex children resource status, %w", err)
 	}
 
+	// Requeue when a scale schedule starts or ends, so that the replicas are adjusted in time.
+	if next := monoVtx.Spec.Scale.GetNextScheduleTransition(time.Now()); !next.IsZero() && !monoVtx.Spec.Scale.Disabled {
+		return ctrl.Result{RequeueAfter: time.Until(next)}, nil
+	}
 	return ctrl.Result{}, nil
 }
 
@@ -195,13 +199,17 @@ func (mr *monoVertexReconciler) orchestratePods(ctx context.Context, monoVtx *df
 	log := logging.FromContext(ctx)
 	desiredReplicas := monoVtx.CalculateReplicas()
 	monoVtx.Status.DesiredReplicas = uint32(desiredReplicas)
+	monoVtx.Status.ActiveScaleSchedule = ""
+	if as := monoVtx.Spec.Scale.GetActiveSchedule(time.Now()); as != nil && !monoVtx.Spec.Scale.Disabled {
+		monoVtx.Status.ActiveScaleSchedule = as.Name
+	}
 
 	// Set metrics
 	defer func() {
 		reconciler.MonoVertexDesiredReplicas.WithLabelValues(monoVtx.Namespace, monoVtx.Name).Set(float64(desiredReplicas))
 		reconciler.MonoVertexCurrentReplicas.WithLabelValues(monoVtx.Namespace, monoVtx.Name).Set(float64(monoVtx.Status.Replicas))
-		reconciler.MonoVertexMinReplicas.WithLabelValues(monoVtx.Namespace, monoVtx.Name).Set(float64(monoVtx.Spec.Scale.GetMinReplicas()))
-		reconciler.MonoVertexMaxReplicas.WithLabelValues(monoVtx.Namespace, monoVtx.Name).Set(float64(monoVtx.Spec.Scale.GetMaxReplicas()))
+		reconciler.MonoVertexMinReplicas.WithLabelValues(monoVtx.Namespace, monoVtx.Name).Set(float64(monoVtx.Spec.Scale.GetEffectiveMinReplicas(time.Now())))
+		reconciler.MonoVertexMaxReplicas.WithLabelValues(monoVtx.Namespace, monoVtx.Name).Set(float64(monoVtx.Spec.Scale.GetEffectiveMaxReplicas(time.Now())))
 	}()
 
 	podSpec, err := mr.buildPodSpec(monoVtx)
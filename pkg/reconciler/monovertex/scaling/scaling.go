@@ -181,7 +181,8 @@ func (s *Scaler) scaleOneMonoVertex(ctx context.Context, key string, worker int)
 		return nil
 	}
 
-	if monoVtx.Spec.Scale.GetMaxReplicas() == monoVtx.Spec.Scale.GetMinReplicas() {
+	now := time.Now()
+	if monoVtx.Spec.Scale.GetEffectiveMaxReplicas(now) == monoVtx.Spec.Scale.GetEffectiveMinReplicas(now) {
 		log.Infof("MonoVertex %s has same scale.min and scale.max, skip scaling.", monoVtx.Name)
 		return nil
 	}
@@ -238,8 +239,11 @@ func (s *Scaler) scaleOneMonoVertex(ctx context.Context, key string, worker int)
 
 	desired := s.desiredReplicas(ctx, monoVtx, totalRate, totalPending)
 	log.Infof("Calculated desired replica number of MonoVertex %q is: %d.", monoVtx.Name, desired)
-	max := monoVtx.Spec.Scale.GetMaxReplicas()
-	min := monoVtx.Spec.Scale.GetMinReplicas()
+	max := monoVtx.Spec.Scale.GetEffectiveMaxReplicas(now)
+	min := monoVtx.Spec.Scale.GetEffectiveMinReplicas(now)
+	if as := monoVtx.Spec.Scale.GetActiveSchedule(now); as != nil {
+		log.Infof("Scale schedule %q is active, using min %d and max %d.", as.Name, min, max)
+	}
 	if desired > max {
 		desired = max
 		log.Infof("Calculated desired replica number %d of MonoVertex %q is greater than max, using max %d.", desired, monoVtxName, max)
@@ -18,6 +18,7 @@ package pipeline
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"strings"
 	"time"
@@ -191,15 +192,39 @@ func (r *pipelineReconciler) reconcile(ctx context.Context, pl *dfv1.Pipeline) (
 	pl.Status.SetVertexCounts(pl.Spec.Vertices)
 	pl.Status.MarkConfigured()
 
+	// With the BlueGreen upgrade strategy, the spec change is held until the pipeline is drained.
+	held, err := r.reconcileUpgrade(ctx, pl)
+	if err != nil {
+		r.recorder.Eventf(pl, corev1.EventTypeWarning, "ReconcileUpgradeFailed", "Failed to reconcile pipeline upgrade: %s", err.Error())
+		return ctrl.Result{}, err
+	}
+	if held {
+		if err := r.checkChildrenResourceStatus(ctx, pl); err != nil {
+			return ctrl.Result{}, fmt.Errorf("failed to check pipeline children resource status, %w", err)
+		}
+		if pl.Status.Upgrade.InProgress() {
+			return ctrl.Result{RequeueAfter: dfv1.DefaultRequeueAfter}, nil
+		}
+		return ctrl.Result{}, nil
+	}
+
 	// Orchestrate pipeline sub resources.
 	// This should be happening in all cases to ensure a clean initialization regardless of the lifecycle phase.
 	// Eg: even for a pipeline started with desiredPhase = Pause, we should still create the resources for the pipeline.
 	if err := r.reconcileFixedResources(ctx, pl); err != nil {
-		r.recorder.Eventf(pl, corev1.EventTypeWarning, "ReconcileFixedResourcesFailed", "Failed to reconcile pipeline sub resources: %s", err.Error())
-		pl.Status.MarkDeployFailed("ReconcileFixedResourcesFailed", err.Error())
-		return ctrl.Result{}, err
+		if !errors.Is(err, validator.ErrUnsafeTopologyChange) {
+			r.recorder.Eventf(pl, corev1.EventTypeWarning, "ReconcileFixedResourcesFailed", "Failed to reconcile pipeline sub resources: %s", err.Error())
+			pl.Status.MarkDeployFailed("ReconcileFixedResourcesFailed", err.Error())
+			return ctrl.Result{}, err
+		}
+		// keep the pipeline running with the existing topology, so that it can still be paused and drained to get the
+		// change applied
+		log.Warnw("Topology change is held until the pipeline is paused and drained", zap.Error(err))
+		r.recorder.Eventf(pl, corev1.EventTypeWarning, "UnsafeTopologyChange", "Topology change is held: %s", err.Error())
+		pl.Status.MarkFalse(dfv1.PipelineConditionDeployed, "UnsafeTopologyChange", err.Error())
+	} else {
+		pl.Status.MarkDeployed()
 	}
-	pl.Status.MarkDeployed()
 
 	// If the pipeline has a lifecycle change, then do not update the phase as
 	// this should happen only after the required configs for the lifecycle changes
@@ -210,6 +235,11 @@ func (r *pipelineReconciler) reconcile(ctx context.Context, pl *dfv1.Pipeline) (
 	if err := r.checkChildrenResourceStatus(ctx, pl); err != nil {
 		return ctrl.Result{}, fmt.Errorf("failed to check pipeline children resource status, %w", err)
 	}
+	upgrading, err := r.finishUpgrade(ctx, pl)
+	if err != nil {
+		r.recorder.Eventf(pl, corev1.EventTypeWarning, "ReconcileUpgradeFailed", "Failed to reconcile pipeline upgrade: %s", err.Error())
+		return ctrl.Result{}, err
+	}
 
 	// check if any changes related to pause/resume lifecycle for the pipeline
 	oldPhase := pl.Status.Phase
@@ -230,6 +260,9 @@ func (r *pipelineReconciler) reconcile(ctx context.Context, pl *dfv1.Pipeline) (
 		}
 		return ctrl.Result{}, nil
 	}
+	if upgrading {
+		return ctrl.Result{RequeueAfter: dfv1.DefaultRequeueAfter}, nil
+	}
 	return ctrl.Result{}, nil
 }
 
@@ -287,6 +320,20 @@ func (r *pipelineReconciler) reconcileFixedResources(ctx context.Context, pl *df
 	if err != nil {
 		return fmt.Errorf("failed to find existing vertices: %w", err)
 	}
+	// the topology changes which delete buffers or watermark buckets are rejected by the webhook unless the pipeline is
+	// paused and drained, check it again here since the webhook is optional. A pipeline cutting over in a BlueGreen
+	// upgrade is already drained.
+	topologyDiff := validator.DiffVerticesTopology(maps.Values(existingObjs), pl)
+	if upgrade := pl.Status.Upgrade; upgrade == nil || upgrade.Phase != dfv1.PipelineUpgradePhaseCuttingOver {
+		if err := validator.ValidateTopologyChange(topologyDiff, pl); err != nil {
+			return err
+		}
+	}
+	if len(existingObjs) > 0 && !topologyDiff.IsEmpty() {
+		log.Infow("Pipeline topology changed", zap.Strings("addedVertices", topologyDiff.AddedVertices), zap.Strings("removedVertices", topologyDiff.RemovedVertices),
+			zap.Strings("addedEdges", topologyDiff.AddedEdges), zap.Strings("removedEdges", topologyDiff.RemovedEdges), zap.Any("partitionChanges", topologyDiff.PartitionChanges),
+			zap.Any("keyRoutingChanges", topologyDiff.KeyRoutingChanges))
+	}
 	oldBuffers := make(map[string]string)
 	newBuffers := make(map[string]string)
 	oldBuckets := make(map[string]string)
@@ -371,7 +418,8 @@ func (r *pipelineReconciler) reconcileFixedResources(ctx context.Context, pl *df
 			log.Infow("Created vertex successfully", zap.String("vertex", vertexName))
 			r.recorder.Eventf(pl, corev1.EventTypeNormal, "CreateVertexSuccess", "Created vertex %s successfully", vertexName)
 		} else {
-			if oldObj.GetAnnotations()[dfv1.KeyHash] != newObj.GetAnnotations()[dfv1.KeyHash] { // need to update
+			if oldObj.GetAnnotations()[dfv1.KeyHash] != newObj.GetAnnotations()[dfv1.KeyHash] ||
+				oldObj.GetAnnotations()[dfv1.KeyUpgradeHash] != newObj.GetAnnotations()[dfv1.KeyUpgradeHash] { // need to update
 				originalReplicas := int32(0)
 				if x := oldObj.Spec.Replicas; x != nil {
 					originalReplicas = *x
@@ -386,6 +434,7 @@ func (r *pipelineReconciler) reconcileFixedResources(ctx context.Context, pl *df
 					originalReplicas = newObj.Spec.Scale.GetMaxReplicas()
 				}
 				oldObj.Annotations[dfv1.KeyHash] = newObj.GetAnnotations()[dfv1.KeyHash]
+				oldObj.Annotations[dfv1.KeyUpgradeHash] = newObj.GetAnnotations()[dfv1.KeyUpgradeHash]
 				if err := r.client.Update(ctx, &oldObj); err != nil {
 					r.recorder.Eventf(pl, corev1.EventTypeWarning, "UpdateVertexFailed", "Failed to update vertex: %w", err.Error())
 					return fmt.Errorf("failed to update vertex, err: %w", err)
@@ -545,6 +594,12 @@ func (r *pipelineReconciler) createOrUpdateSIMDeployments(ctx context.Context, p
 		pl.Status.MarkDeployFailed("BuildSIMObjsFailed", err.Error())
 		return fmt.Errorf("failed to build Side Inputs Manager Deployments, %w", err)
 	}
+	// mount the secrets referenced by the triggers, e.g. the auth token, to the main container.
+	for i, newObj := range newObjs {
+		vols, volMounts := sharedutil.VolumesFromSecretsAndConfigMaps(pl.Spec.SideInputs[i].Trigger)
+		newObj.Spec.Template.Spec.Volumes = append(newObj.Spec.Template.Spec.Volumes, vols...)
+		newObj.Spec.Template.Spec.Containers[0].VolumeMounts = append(newObj.Spec.Template.Spec.Containers[0].VolumeMounts, volMounts...)
+	}
 	existingObjs, err := r.findExistingSIMDeploys(ctx, pl)
 	if err != nil {
 		pl.Status.MarkDeployFailed("FindExistingSIMFailed", err.Error())
@@ -693,7 +748,8 @@ func buildVertices(pl *dfv1.Pipeline) map[string]dfv1.Vertex {
 				Name:      vertexFullName,
 				Labels:    matchLabels,
 				Annotations: map[string]string{
-					dfv1.KeyHash: hash,
+					dfv1.KeyHash:        hash,
+					dfv1.KeyUpgradeHash: vertexUpgradeHash(spec),
 				},
 				OwnerReferences: []metav1.OwnerReference{
 					*metav1.NewControllerRef(pl.GetObjectMeta(), dfv1.PipelineGroupVersionKind),
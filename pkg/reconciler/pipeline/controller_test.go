@@ -468,6 +468,47 @@ func Test_reconcile(t *testing.T) {
 		events := getEvents(t, r)
 		assert.Contains(t, events, "Warning ValidatePipelineFailed Invalid pipeline: duplicate vertex name \"input\"")
 	})
+
+	t.Run("test reconcile - unsafe topology change", func(t *testing.T) {
+		testIsbSvc := testNativeRedisIsbSvc.DeepCopy()
+		testIsbSvc.Status.MarkConfigured()
+		testIsbSvc.Status.MarkDeployed()
+		cl := fake.NewClientBuilder().Build()
+		err := cl.Create(ctx, testIsbSvc)
+		assert.Nil(t, err)
+		testObj := testPipeline.DeepCopy()
+		testObj.Spec.Vertices = append(testObj.Spec.Vertices, dfv1.AbstractVertex{Name: "output-2", Sink: &dfv1.Sink{}})
+		testObj.Spec.Edges = append(testObj.Spec.Edges, dfv1.Edge{From: "p1", To: "output-2"})
+		r := fakeReconciler(t, cl)
+		_, err = r.reconcile(ctx, testObj)
+		assert.NoError(t, err)
+		countVertices := func() int {
+			vertices := &dfv1.VertexList{}
+			selector, _ := labels.Parse(dfv1.KeyPipelineName + "=" + testObj.Name)
+			err := r.client.List(ctx, vertices, &client.ListOptions{Namespace: testNamespace, LabelSelector: selector})
+			assert.NoError(t, err)
+			return len(vertices.Items)
+		}
+		assert.Equal(t, 4, countVertices())
+
+		// removing a vertex is held while the pipeline is running
+		testObj.Spec.Vertices = testPipeline.Spec.Vertices
+		testObj.Spec.Edges = testPipeline.Spec.Edges
+		_, err = r.reconcile(ctx, testObj)
+		assert.NoError(t, err)
+		assert.Equal(t, 4, countVertices())
+		assert.False(t, testObj.Status.IsReady())
+
+		// and applied once the pipeline is paused and drained
+		testObj.Status.Phase = dfv1.PipelinePhasePaused
+		testObj.Status.DrainedOnPause = true
+		testObj.Spec.Lifecycle.DesiredPhase = dfv1.PipelinePhasePaused
+		_, err = r.reconcile(ctx, testObj)
+		assert.NoError(t, err)
+		assert.Equal(t, 3, countVertices())
+		events := getEvents(t, r)
+		assert.Contains(t, events, `Warning UnsafeTopologyChange Topology change is held: unsafe topology change, the pipeline needs to be paused and drained first: vertex "output-2" is removed; edge "p1->output-2" is removed`)
+	})
 }
 
 func Test_buildVertices(t *testing.T) {
@@ -807,6 +848,12 @@ func Test_createOrUpdateSIMDeployments(t *testing.T) {
 				},
 				Trigger: &dfv1.SideInputTrigger{
 					Schedule: "1 * * * *",
+					Auth: &dfv1.Authorization{
+						Token: &corev1.SecretKeySelector{
+							LocalObjectReference: corev1.LocalObjectReference{Name: "s1-secret"},
+							Key:                  "token",
+						},
+					},
 				},
 			},
 		}
@@ -817,6 +864,13 @@ func Test_createOrUpdateSIMDeployments(t *testing.T) {
 		assert.NoError(t, err)
 		assert.Len(t, deployList.Items, 1)
 		assert.Equal(t, testObj.GetSideInputsManagerDeploymentName("s1"), deployList.Items[0].Name)
+		// the secret of the auth token is mounted to the main container
+		podSpec := deployList.Items[0].Spec.Template.Spec
+		assert.Contains(t, podSpec.Volumes, corev1.Volume{
+			Name:         "secret-s1-secret",
+			VolumeSource: corev1.VolumeSource{Secret: &corev1.SecretVolumeSource{SecretName: "s1-secret"}},
+		})
+		assert.Contains(t, podSpec.Containers[0].VolumeMounts, corev1.VolumeMount{Name: "secret-s1-secret", ReadOnly: true, MountPath: "/var/numaflow/secrets/s1-secret"})
 	})
 
 	t.Run("two side inputs", func(t *testing.T) {
@@ -0,0 +1,319 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package pipeline
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"go.uber.org/zap"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	dfv1 "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1"
+	daemonclient "github.com/numaproj/numaflow/pkg/daemon/client"
+	"github.com/numaproj/numaflow/pkg/reconciler/validator"
+	"github.com/numaproj/numaflow/pkg/shared/logging"
+	sharedutil "github.com/numaproj/numaflow/pkg/shared/util"
+)
+
+// A BlueGreen upgrade of a running pipeline goes through the following phases:
+//  1. StartingShadow: a shadow pipeline with the new spec is started, and takes over the processing once it's healthy.
+//  2. Draining: the sources of the pipeline are paused, until the source pods are terminated and the buffers are empty.
+//  3. CuttingOver: the drained pipeline is updated to the new spec in place.
+//  4. DrainingShadow: the pipeline is resumed, and the shadow pipeline is paused, drained and deleted.
+//
+// The pipeline is updated in place rather than replaced by the shadow pipeline, so that its name and the objects named
+// after it are kept, which is why the shadow pipeline is drained at the end as well.
+//
+// If the shadow pipeline is not healthy, or the pipeline is not drained before the progress deadline, the upgrade
+// goes to RollingBack, which resumes the sources of the pipeline and deletes the shadow pipeline. The pipeline keeps
+// running with the old spec until the spec is changed again.
+
+// vertexUpgradeHash returns the hash of the vertex spec compared by BlueGreen upgrades. The scale settings are left
+// out, since they don't change how the messages are processed, and are applied in place.
+func vertexUpgradeHash(spec dfv1.VertexSpec) string {
+	s := spec.DeepCopyWithoutReplicasAndLifecycle()
+	s.Scale = dfv1.Scale{}
+	return sharedutil.MustHash(s)
+}
+
+// verticesHash returns the hash of the upgrade hash annotations of the vertices, it changes if any vertex is added,
+// removed or updated. The annotations are compared rather than the specs, because the specs of the existing vertices
+// carry the defaults applied by the API server. It returns an empty string if any vertex is not annotated yet.
+func verticesHash(vertices map[string]dfv1.Vertex) string {
+	hashes := make(map[string]string, len(vertices))
+	for name, v := range vertices {
+		h := v.GetAnnotations()[dfv1.KeyUpgradeHash]
+		if h == "" {
+			return ""
+		}
+		hashes[name] = h
+	}
+	return sharedutil.MustHash(hashes)
+}
+
+// reconcileUpgrade drives a BlueGreen upgrade of the pipeline up to the cutover. It returns true if the spec should
+// not be applied to the vertices of the pipeline, either because the pipeline is not drained yet, or the upgrade to
+// the spec was rolled back.
+func (r *pipelineReconciler) reconcileUpgrade(ctx context.Context, pl *dfv1.Pipeline) (bool, error) {
+	log := logging.FromContext(ctx)
+	upgrade := pl.Status.Upgrade
+	if !upgrade.InProgress() {
+		// only a running pipeline is upgraded with a shadow pipeline, a paused one is updated in place
+		if pl.GetUpgradeStrategy().GetType() != dfv1.PipelineUpgradeStrategyBlueGreen || isLifecycleChange(pl) || pl.Status.Phase != dfv1.PipelinePhaseRunning {
+			return false, nil
+		}
+		existingObjs, err := r.findExistingVertices(ctx, pl)
+		if err != nil {
+			return false, fmt.Errorf("failed to find existing vertices: %w", err)
+		}
+		targetHash := verticesHash(buildVertices(pl))
+		currentHash := verticesHash(existingObjs)
+		// the vertices created before the upgrade hash annotation was introduced get it in place first
+		if len(existingObjs) == 0 || currentHash == "" || currentHash == targetHash {
+			return false, nil
+		}
+		if upgrade != nil && upgrade.Phase == dfv1.PipelineUpgradePhaseRolledBack && upgrade.TargetHash == targetHash {
+			// hold the spec failed to be upgraded to, until it's changed again
+			pl.Status.MarkFalse(dfv1.PipelineConditionDeployed, "UpgradeRolledBack", upgrade.Message)
+			return true, nil
+		}
+		upgrade = &dfv1.PipelineUpgradeStatus{
+			TargetHash:     targetHash,
+			ShadowPipeline: pl.GetShadowPipelineName(),
+			StartedAt:      metav1.Now(),
+		}
+		pl.Status.Upgrade = upgrade
+		r.setUpgradePhase(pl, dfv1.PipelineUpgradePhaseStartingShadow, "Starting the shadow pipeline with the new spec")
+	}
+
+	deadline := upgrade.LastTransitionTime.Add(time.Duration(pl.GetUpgradeStrategy().GetProgressDeadlineSeconds()) * time.Second)
+	switch upgrade.Phase {
+	case dfv1.PipelineUpgradePhaseStartingShadow:
+		shadow := buildShadowPipeline(pl)
+		if err := validator.ValidatePipeline(shadow); err != nil {
+			r.setUpgradePhase(pl, dfv1.PipelineUpgradePhaseRollingBack, fmt.Sprintf("Invalid shadow pipeline: %s", err.Error()))
+			return true, nil
+		}
+		// keep the shadow pipeline up to date, in case the spec is changed again before it takes over
+		existing, err := r.createOrUpdateShadowPipeline(ctx, pl, shadow)
+		if err != nil {
+			return true, err
+		}
+		upgrade.TargetHash = verticesHash(buildVertices(pl))
+		if existing.Status.ObservedGeneration == existing.Generation && existing.Status.Phase == dfv1.PipelinePhaseRunning && existing.Status.IsHealthy() {
+			if _, err := r.updateVerticeDesiredPhase(ctx, pl, sourceVertexFilter, dfv1.VertexPhasePaused); err != nil {
+				return true, err
+			}
+			r.setUpgradePhase(pl, dfv1.PipelineUpgradePhaseDraining, "The shadow pipeline is healthy, draining the pipeline")
+		} else if time.Now().After(deadline) {
+			r.setUpgradePhase(pl, dfv1.PipelineUpgradePhaseRollingBack, "The shadow pipeline did not become healthy before the progress deadline")
+		}
+		return true, nil
+	case dfv1.PipelineUpgradePhaseDraining:
+		drained, err := r.isDrained(ctx, pl)
+		if err != nil {
+			// keep waiting until the progress deadline
+			log.Warnw("Failed to check if the pipeline is drained", zap.Error(err))
+		}
+		if drained {
+			r.setUpgradePhase(pl, dfv1.PipelineUpgradePhaseCuttingOver, "The pipeline is drained, updating it to the new spec")
+			return false, nil
+		}
+		if time.Now().After(deadline) {
+			r.setUpgradePhase(pl, dfv1.PipelineUpgradePhaseRollingBack, "The pipeline was not drained before the progress deadline")
+		}
+		return true, nil
+	case dfv1.PipelineUpgradePhaseRollingBack:
+		// the shadow pipeline drains itself before being deleted
+		if _, err := r.updateVerticeDesiredPhase(ctx, pl, sourceVertexFilter, dfv1.VertexPhaseRunning); err != nil {
+			return true, err
+		}
+		deleted, err := r.deleteShadowPipeline(ctx, pl)
+		if err != nil {
+			return true, err
+		}
+		if deleted {
+			r.setUpgradePhase(pl, dfv1.PipelineUpgradePhaseRolledBack, upgrade.Message)
+			pl.Status.MarkFalse(dfv1.PipelineConditionDeployed, "UpgradeRolledBack", upgrade.Message)
+		}
+		return true, nil
+	default:
+		// CuttingOver and DrainingShadow, the pipeline is drained or running with the new spec
+		return false, nil
+	}
+}
+
+// finishUpgrade drives a BlueGreen upgrade after the pipeline is updated to the new spec, it resumes the pipeline,
+// and then drains and deletes the shadow pipeline. It returns true if the upgrade is still in progress.
+func (r *pipelineReconciler) finishUpgrade(ctx context.Context, pl *dfv1.Pipeline) (bool, error) {
+	upgrade := pl.Status.Upgrade
+	if upgrade == nil {
+		return false, nil
+	}
+	switch upgrade.Phase {
+	case dfv1.PipelineUpgradePhaseCuttingOver:
+		// resume the sources paused for draining, the updated vertices are already running
+		if _, err := r.updateVerticeDesiredPhase(ctx, pl, allVertexFilter, dfv1.VertexPhaseRunning); err != nil {
+			return true, err
+		}
+		upgrade.TargetHash = verticesHash(buildVertices(pl))
+		r.setUpgradePhase(pl, dfv1.PipelineUpgradePhaseDrainingShadow, "The pipeline is running with the new spec, draining the shadow pipeline")
+		return true, nil
+	case dfv1.PipelineUpgradePhaseDrainingShadow:
+		// do not take the shadow pipeline down until the pipeline is healthy
+		if !pl.Status.IsHealthy() {
+			return true, nil
+		}
+		shadow := &dfv1.Pipeline{}
+		if err := r.client.Get(ctx, types.NamespacedName{Namespace: pl.Namespace, Name: upgrade.ShadowPipeline}, shadow); err != nil {
+			if !apierrors.IsNotFound(err) {
+				return true, fmt.Errorf("failed to get the shadow pipeline, %w", err)
+			}
+			r.setUpgradePhase(pl, dfv1.PipelineUpgradePhaseSucceeded, "Upgrade completed")
+			return false, nil
+		}
+		if shadow.GetDesiredPhase() != dfv1.PipelinePhasePaused {
+			patchJson := `{"spec":{"lifecycle":{"desiredPhase":"` + string(dfv1.PipelinePhasePaused) + `"}}}`
+			if err := r.client.Patch(ctx, shadow, client.RawPatch(types.MergePatchType, []byte(patchJson))); err != nil && !apierrors.IsNotFound(err) {
+				return true, fmt.Errorf("failed to pause the shadow pipeline, %w", err)
+			}
+			return true, nil
+		}
+		if shadow.Status.Phase != dfv1.PipelinePhasePaused {
+			return true, nil
+		}
+		msg := "Upgrade completed"
+		if !shadow.Status.DrainedOnPause {
+			msg = "Upgrade completed, the shadow pipeline was not fully drained before being deleted"
+			r.recorder.Event(pl, corev1.EventTypeWarning, "ShadowPipelineNotDrained", msg)
+		}
+		if _, err := r.deleteShadowPipeline(ctx, pl); err != nil {
+			return true, err
+		}
+		r.setUpgradePhase(pl, dfv1.PipelineUpgradePhaseSucceeded, msg)
+		return false, nil
+	default:
+		return upgrade.InProgress(), nil
+	}
+}
+
+// setUpgradePhase sets the phase of the upgrade, and records an event for it.
+func (r *pipelineReconciler) setUpgradePhase(pl *dfv1.Pipeline, phase dfv1.PipelineUpgradePhase, msg string) {
+	pl.Status.Upgrade.SetPhase(phase, msg)
+	eventType := corev1.EventTypeNormal
+	if phase == dfv1.PipelineUpgradePhaseRollingBack || phase == dfv1.PipelineUpgradePhaseRolledBack {
+		eventType = corev1.EventTypeWarning
+	}
+	r.logger.Infow("Updated pipeline upgrade phase", zap.String("pipeline", pl.Name), zap.String("phase", string(phase)), zap.String("message", msg))
+	r.recorder.Eventf(pl, eventType, "Upgrade"+string(phase), msg)
+}
+
+// isDrained returns true if the source pods of the pipeline are terminated, and all the buffers are empty.
+func (r *pipelineReconciler) isDrained(ctx context.Context, pl *dfv1.Pipeline) (bool, error) {
+	sourcePodsTerminated, err := r.noSourceVertexPodsRunning(ctx, pl)
+	if err != nil || !sourcePodsTerminated {
+		return false, err
+	}
+	daemonClient, err := daemonclient.NewGRPCDaemonServiceClient(pl.GetDaemonServiceURL())
+	if err != nil {
+		return false, err
+	}
+	defer func() {
+		_ = daemonClient.Close()
+	}()
+	return daemonClient.IsDrained(ctx, pl.Name)
+}
+
+// buildShadowPipeline returns the shadow pipeline of a BlueGreen upgrade, it's a copy of the pipeline upgraded in place.
+func buildShadowPipeline(pl *dfv1.Pipeline) *dfv1.Pipeline {
+	spec := pl.Spec.DeepCopy()
+	spec.UpgradeStrategy = nil
+	spec.Lifecycle.DesiredPhase = dfv1.PipelinePhaseRunning
+	shadow := &dfv1.Pipeline{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: pl.Namespace,
+			Name:      pl.GetShadowPipelineName(),
+			Labels: map[string]string{
+				dfv1.KeyShadowPipelineOf: pl.Name,
+			},
+			Annotations: map[string]string{
+				dfv1.KeyHash: sharedutil.MustHash(spec),
+			},
+			OwnerReferences: []metav1.OwnerReference{
+				*metav1.NewControllerRef(pl.GetObjectMeta(), dfv1.PipelineGroupVersionKind),
+			},
+		},
+		Spec: *spec,
+	}
+	if x := pl.GetAnnotations()[dfv1.KeyInstance]; x != "" {
+		shadow.Annotations[dfv1.KeyInstance] = x
+	}
+	return shadow
+}
+
+// createOrUpdateShadowPipeline creates the shadow pipeline, or updates it if the spec is changed, and returns the
+// existing one.
+func (r *pipelineReconciler) createOrUpdateShadowPipeline(ctx context.Context, pl *dfv1.Pipeline, shadow *dfv1.Pipeline) (*dfv1.Pipeline, error) {
+	existing := &dfv1.Pipeline{}
+	if err := r.client.Get(ctx, types.NamespacedName{Namespace: shadow.Namespace, Name: shadow.Name}, existing); err != nil {
+		if !apierrors.IsNotFound(err) {
+			return nil, fmt.Errorf("failed to find the shadow pipeline, %w", err)
+		}
+		if err := r.client.Create(ctx, shadow); err != nil {
+			r.recorder.Eventf(pl, corev1.EventTypeWarning, "CreateShadowPipelineFailed", "Failed to create the shadow pipeline: %s", err.Error())
+			return nil, fmt.Errorf("failed to create the shadow pipeline, %w", err)
+		}
+		r.recorder.Eventf(pl, corev1.EventTypeNormal, "CreateShadowPipelineSuccess", "Created the shadow pipeline %s successfully", shadow.Name)
+		return shadow, nil
+	}
+	if existing.GetAnnotations()[dfv1.KeyHash] != shadow.GetAnnotations()[dfv1.KeyHash] {
+		if existing.Annotations == nil {
+			existing.Annotations = map[string]string{}
+		}
+		existing.Annotations[dfv1.KeyHash] = shadow.GetAnnotations()[dfv1.KeyHash]
+		existing.Spec = shadow.Spec
+		if err := r.client.Update(ctx, existing); err != nil {
+			return nil, fmt.Errorf("failed to update the shadow pipeline, %w", err)
+		}
+		r.recorder.Eventf(pl, corev1.EventTypeNormal, "UpdateShadowPipelineSuccess", "Updated the shadow pipeline %s successfully", shadow.Name)
+	}
+	return existing, nil
+}
+
+// deleteShadowPipeline deletes the shadow pipeline, it returns true if the shadow pipeline doesn't exist anymore.
+func (r *pipelineReconciler) deleteShadowPipeline(ctx context.Context, pl *dfv1.Pipeline) (bool, error) {
+	shadow := &dfv1.Pipeline{}
+	if err := r.client.Get(ctx, types.NamespacedName{Namespace: pl.Namespace, Name: pl.GetShadowPipelineName()}, shadow); err != nil {
+		if apierrors.IsNotFound(err) {
+			return true, nil
+		}
+		return false, fmt.Errorf("failed to find the shadow pipeline, %w", err)
+	}
+	if shadow.DeletionTimestamp.IsZero() {
+		if err := r.client.Delete(ctx, shadow); err != nil && !apierrors.IsNotFound(err) {
+			return false, fmt.Errorf("failed to delete the shadow pipeline, %w", err)
+		}
+		r.recorder.Eventf(pl, corev1.EventTypeNormal, "DeleteShadowPipelineSuccess", "Deleted the shadow pipeline %s successfully", shadow.Name)
+	}
+	return false, nil
+}
@@ -0,0 +1,332 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package pipeline
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	dfv1 "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1"
+)
+
+// newUpgradingPipeline returns a running pipeline with the BlueGreen upgrade strategy, and a changed spec after the
+// vertices are created.
+func newUpgradingPipeline(t *testing.T, ctx context.Context, r *pipelineReconciler, name string) *dfv1.Pipeline {
+	t.Helper()
+	testIsbSvc := testNativeRedisIsbSvc.DeepCopy()
+	testIsbSvc.Status.MarkConfigured()
+	testIsbSvc.Status.MarkDeployed()
+	require.NoError(t, r.client.Create(ctx, testIsbSvc))
+	testObj := testPipeline.DeepCopy()
+	testObj.Name = name
+	testObj.Spec.UpgradeStrategy = &dfv1.PipelineUpgradeStrategy{Type: dfv1.PipelineUpgradeStrategyBlueGreen}
+	_, err := r.reconcile(ctx, testObj)
+	require.NoError(t, err)
+	require.Equal(t, dfv1.PipelinePhaseRunning, testObj.Status.Phase)
+	testObj.Spec.Limits = &dfv1.PipelineLimits{ReadBatchSize: ptr.To[uint64](20)}
+	return testObj
+}
+
+func markPipelineHealthy(pl *dfv1.Pipeline) {
+	pl.Status.InitConditions()
+	for _, c := range []dfv1.ConditionType{dfv1.PipelineConditionConfigured, dfv1.PipelineConditionDeployed, dfv1.PipelineConditionDaemonServiceHealthy,
+		dfv1.PipelineConditionSideInputsManagersHealthy, dfv1.PipelineConditionVerticesHealthy} {
+		pl.Status.MarkTrue(c)
+	}
+	pl.Status.Phase = dfv1.PipelinePhaseRunning
+}
+
+// applyVertexDefaults updates the vertices of the pipeline with the defaults applied by the API server, which the
+// fake client doesn't apply.
+func applyVertexDefaults(t *testing.T, ctx context.Context, r *pipelineReconciler, pl *dfv1.Pipeline) {
+	t.Helper()
+	vertices, err := r.findExistingVertices(ctx, pl)
+	require.NoError(t, err)
+	for _, v := range vertices {
+		v.Spec.InterStepBuffer = &dfv1.InterStepBuffer{Compression: &dfv1.Compression{Type: dfv1.CompressionTypeNone}}
+		require.NoError(t, r.client.Update(ctx, &v))
+	}
+}
+
+func Test_verticesHash(t *testing.T) {
+	vertices := buildVertices(testPipeline)
+	assert.Equal(t, verticesHash(vertices), verticesHash(buildVertices(testPipeline)))
+	pl := testPipeline.DeepCopy()
+	pl.Spec.Limits = &dfv1.PipelineLimits{ReadBatchSize: ptr.To[uint64](20)}
+	assert.NotEqual(t, verticesHash(vertices), verticesHash(buildVertices(pl)))
+	// lifecycle changes do not count
+	pl = testPipeline.DeepCopy()
+	pl.Spec.Lifecycle.DesiredPhase = dfv1.PipelinePhasePaused
+	assert.Equal(t, verticesHash(vertices), verticesHash(buildVertices(pl)))
+	// neither do scale changes
+	pl = testPipeline.DeepCopy()
+	pl.Spec.Vertices[1].Scale.Max = ptr.To[int32](10)
+	assert.Equal(t, verticesHash(vertices), verticesHash(buildVertices(pl)))
+	// unknown without the upgrade hash annotations
+	v := vertices[testPipeline.Name+"-"+testPipeline.Spec.Vertices[0].Name]
+	delete(v.Annotations, dfv1.KeyUpgradeHash)
+	assert.Empty(t, verticesHash(vertices))
+}
+
+func Test_buildShadowPipeline(t *testing.T) {
+	pl := testPipeline.DeepCopy()
+	pl.Annotations = map[string]string{dfv1.KeyInstance: "1"}
+	pl.Spec.UpgradeStrategy = &dfv1.PipelineUpgradeStrategy{Type: dfv1.PipelineUpgradeStrategyBlueGreen}
+	shadow := buildShadowPipeline(pl)
+	assert.Equal(t, pl.Name+"-shadow", shadow.Name)
+	assert.Equal(t, pl.Namespace, shadow.Namespace)
+	assert.Equal(t, pl.Name, shadow.Labels[dfv1.KeyShadowPipelineOf])
+	assert.Equal(t, "1", shadow.Annotations[dfv1.KeyInstance])
+	assert.NotEmpty(t, shadow.Annotations[dfv1.KeyHash])
+	assert.Nil(t, shadow.Spec.UpgradeStrategy)
+	assert.Equal(t, dfv1.PipelinePhaseRunning, shadow.Spec.Lifecycle.DesiredPhase)
+	assert.Len(t, shadow.OwnerReferences, 1)
+	assert.Equal(t, pl.Name, shadow.OwnerReferences[0].Name)
+	assert.True(t, *shadow.OwnerReferences[0].Controller)
+}
+
+func Test_reconcileUpgrade(t *testing.T) {
+	ctx := context.TODO()
+
+	t.Run("in place", func(t *testing.T) {
+		r := fakeReconciler(t, fake.NewClientBuilder().Build())
+		testObj := newUpgradingPipeline(t, ctx, r, testPipeline.Name)
+		testObj.Spec.UpgradeStrategy = nil
+		held, err := r.reconcileUpgrade(ctx, testObj)
+		assert.NoError(t, err)
+		assert.False(t, held)
+		assert.Nil(t, testObj.Status.Upgrade)
+	})
+
+	t.Run("no change", func(t *testing.T) {
+		r := fakeReconciler(t, fake.NewClientBuilder().Build())
+		testObj := newUpgradingPipeline(t, ctx, r, testPipeline.Name)
+		testObj.Spec.Limits = nil
+		held, err := r.reconcileUpgrade(ctx, testObj)
+		assert.NoError(t, err)
+		assert.False(t, held)
+		assert.Nil(t, testObj.Status.Upgrade)
+	})
+
+	t.Run("no change with defaulted vertices", func(t *testing.T) {
+		r := fakeReconciler(t, fake.NewClientBuilder().Build())
+		testObj := newUpgradingPipeline(t, ctx, r, testPipeline.Name)
+		testObj.Spec.Limits = nil
+		applyVertexDefaults(t, ctx, r, testObj)
+		held, err := r.reconcileUpgrade(ctx, testObj)
+		assert.NoError(t, err)
+		assert.False(t, held)
+		assert.Nil(t, testObj.Status.Upgrade)
+	})
+
+	t.Run("vertices without the upgrade hash", func(t *testing.T) {
+		r := fakeReconciler(t, fake.NewClientBuilder().Build())
+		testObj := newUpgradingPipeline(t, ctx, r, testPipeline.Name)
+		testObj.Spec.Limits = nil
+		vertices, err := r.findExistingVertices(ctx, testObj)
+		require.NoError(t, err)
+		for _, v := range vertices {
+			delete(v.Annotations, dfv1.KeyUpgradeHash)
+			require.NoError(t, r.client.Update(ctx, &v))
+		}
+		held, err := r.reconcileUpgrade(ctx, testObj)
+		assert.NoError(t, err)
+		assert.False(t, held)
+		assert.Nil(t, testObj.Status.Upgrade)
+		// the annotations are added in place
+		_, err = r.reconcile(ctx, testObj)
+		assert.NoError(t, err)
+		vertices, err = r.findExistingVertices(ctx, testObj)
+		assert.NoError(t, err)
+		assert.Equal(t, verticesHash(buildVertices(testObj)), verticesHash(vertices))
+	})
+
+	t.Run("paused pipeline", func(t *testing.T) {
+		r := fakeReconciler(t, fake.NewClientBuilder().Build())
+		testObj := newUpgradingPipeline(t, ctx, r, testPipeline.Name)
+		testObj.Status.Phase = dfv1.PipelinePhasePaused
+		held, err := r.reconcileUpgrade(ctx, testObj)
+		assert.NoError(t, err)
+		assert.False(t, held)
+		assert.Nil(t, testObj.Status.Upgrade)
+	})
+
+	t.Run("start shadow pipeline", func(t *testing.T) {
+		r := fakeReconciler(t, fake.NewClientBuilder().Build())
+		testObj := newUpgradingPipeline(t, ctx, r, testPipeline.Name)
+		oldVertices, err := r.findExistingVertices(ctx, testObj)
+		require.NoError(t, err)
+		_, err = r.reconcile(ctx, testObj)
+		assert.NoError(t, err)
+		assert.True(t, testObj.Status.Upgrade.InProgress())
+		assert.Equal(t, dfv1.PipelineUpgradePhaseStartingShadow, testObj.Status.Upgrade.Phase)
+		assert.Equal(t, testObj.GetShadowPipelineName(), testObj.Status.Upgrade.ShadowPipeline)
+		assert.Equal(t, verticesHash(buildVertices(testObj)), testObj.Status.Upgrade.TargetHash)
+		shadow := &dfv1.Pipeline{}
+		err = r.client.Get(ctx, types.NamespacedName{Namespace: testNamespace, Name: testObj.GetShadowPipelineName()}, shadow)
+		assert.NoError(t, err)
+		assert.Equal(t, testObj.Spec.Limits, shadow.Spec.Limits)
+		// the vertices of the pipeline are not updated yet
+		vertices, err := r.findExistingVertices(ctx, testObj)
+		assert.NoError(t, err)
+		assert.Equal(t, verticesHash(oldVertices), verticesHash(vertices))
+		events := getEvents(t, r)
+		assert.Contains(t, events, "Normal UpgradeStartingShadow Starting the shadow pipeline with the new spec")
+		assert.Contains(t, events, "Normal CreateShadowPipelineSuccess Created the shadow pipeline "+shadow.Name+" successfully")
+	})
+
+	t.Run("shadow pipeline healthy", func(t *testing.T) {
+		r := fakeReconciler(t, fake.NewClientBuilder().Build())
+		testObj := newUpgradingPipeline(t, ctx, r, testPipeline.Name)
+		held, err := r.reconcileUpgrade(ctx, testObj)
+		assert.NoError(t, err)
+		assert.True(t, held)
+		shadow := &dfv1.Pipeline{}
+		require.NoError(t, r.client.Get(ctx, types.NamespacedName{Namespace: testNamespace, Name: testObj.GetShadowPipelineName()}, shadow))
+		markPipelineHealthy(shadow)
+		require.NoError(t, r.client.Update(ctx, shadow))
+		held, err = r.reconcileUpgrade(ctx, testObj)
+		assert.NoError(t, err)
+		assert.True(t, held)
+		assert.Equal(t, dfv1.PipelineUpgradePhaseDraining, testObj.Status.Upgrade.Phase)
+		vertices, err := r.findExistingVertices(ctx, testObj)
+		assert.NoError(t, err)
+		for _, v := range vertices {
+			if v.IsASource() {
+				assert.Equal(t, dfv1.VertexPhasePaused, v.Spec.Lifecycle.GetDesiredPhase())
+			} else {
+				assert.Equal(t, dfv1.VertexPhaseRunning, v.Spec.Lifecycle.GetDesiredPhase())
+			}
+		}
+	})
+
+	t.Run("roll back", func(t *testing.T) {
+		r := fakeReconciler(t, fake.NewClientBuilder().Build())
+		testObj := newUpgradingPipeline(t, ctx, r, testPipeline.Name)
+		held, err := r.reconcileUpgrade(ctx, testObj)
+		assert.NoError(t, err)
+		assert.True(t, held)
+		// the shadow pipeline never becomes healthy
+		testObj.Status.Upgrade.LastTransitionTime = metav1.NewTime(time.Now().Add(-time.Hour))
+		held, err = r.reconcileUpgrade(ctx, testObj)
+		assert.NoError(t, err)
+		assert.True(t, held)
+		assert.Equal(t, dfv1.PipelineUpgradePhaseRollingBack, testObj.Status.Upgrade.Phase)
+		held, err = r.reconcileUpgrade(ctx, testObj)
+		assert.NoError(t, err)
+		assert.True(t, held)
+		err = r.client.Get(ctx, types.NamespacedName{Namespace: testNamespace, Name: testObj.GetShadowPipelineName()}, &dfv1.Pipeline{})
+		assert.True(t, apierrors.IsNotFound(err))
+		held, err = r.reconcileUpgrade(ctx, testObj)
+		assert.NoError(t, err)
+		assert.True(t, held)
+		assert.Equal(t, dfv1.PipelineUpgradePhaseRolledBack, testObj.Status.Upgrade.Phase)
+		assert.Equal(t, "The shadow pipeline did not become healthy before the progress deadline", testObj.Status.Upgrade.Message)
+		assert.False(t, testObj.Status.Upgrade.InProgress())
+
+		// the spec is held until it's changed again
+		_, err = r.reconcile(ctx, testObj)
+		assert.NoError(t, err)
+		assert.Equal(t, dfv1.PipelineUpgradePhaseRolledBack, testObj.Status.Upgrade.Phase)
+		assert.False(t, testObj.Status.IsReady())
+		testObj.Spec.Limits.ReadBatchSize = ptr.To[uint64](30)
+		held, err = r.reconcileUpgrade(ctx, testObj)
+		assert.NoError(t, err)
+		assert.True(t, held)
+		assert.Equal(t, dfv1.PipelineUpgradePhaseStartingShadow, testObj.Status.Upgrade.Phase)
+	})
+
+	t.Run("invalid shadow pipeline", func(t *testing.T) {
+		r := fakeReconciler(t, fake.NewClientBuilder().Build())
+		// the headless service names of the shadow pipeline vertices would be too long
+		testObj := newUpgradingPipeline(t, ctx, r, "a-pipeline-with-a-name-too-long-for-a-shadow")
+		held, err := r.reconcileUpgrade(ctx, testObj)
+		assert.NoError(t, err)
+		assert.True(t, held)
+		assert.Equal(t, dfv1.PipelineUpgradePhaseRollingBack, testObj.Status.Upgrade.Phase)
+		assert.Contains(t, testObj.Status.Upgrade.Message, "Invalid shadow pipeline")
+	})
+}
+
+func Test_finishUpgrade(t *testing.T) {
+	ctx := context.TODO()
+
+	t.Run("no upgrade", func(t *testing.T) {
+		r := fakeReconciler(t, fake.NewClientBuilder().Build())
+		inProgress, err := r.finishUpgrade(ctx, testPipeline.DeepCopy())
+		assert.NoError(t, err)
+		assert.False(t, inProgress)
+	})
+
+	t.Run("cut over and drain the shadow pipeline", func(t *testing.T) {
+		r := fakeReconciler(t, fake.NewClientBuilder().Build())
+		testObj := newUpgradingPipeline(t, ctx, r, testPipeline.Name)
+		held, err := r.reconcileUpgrade(ctx, testObj)
+		require.NoError(t, err)
+		require.True(t, held)
+		_, err = r.updateVerticeDesiredPhase(ctx, testObj, sourceVertexFilter, dfv1.VertexPhasePaused)
+		require.NoError(t, err)
+		testObj.Status.Upgrade.SetPhase(dfv1.PipelineUpgradePhaseCuttingOver, "")
+
+		// the new spec is applied to the vertices, and the sources are resumed
+		_, err = r.reconcile(ctx, testObj)
+		assert.NoError(t, err)
+		assert.Equal(t, dfv1.PipelineUpgradePhaseDrainingShadow, testObj.Status.Upgrade.Phase)
+		vertices, err := r.findExistingVertices(ctx, testObj)
+		assert.NoError(t, err)
+		assert.Equal(t, verticesHash(buildVertices(testObj)), verticesHash(vertices))
+		for _, v := range vertices {
+			assert.Equal(t, dfv1.VertexPhaseRunning, v.Spec.Lifecycle.GetDesiredPhase())
+		}
+
+		// the shadow pipeline is not paused until the pipeline is healthy
+		markPipelineHealthy(testObj)
+		inProgress, err := r.finishUpgrade(ctx, testObj)
+		assert.NoError(t, err)
+		assert.True(t, inProgress)
+		shadow := &dfv1.Pipeline{}
+		require.NoError(t, r.client.Get(ctx, types.NamespacedName{Namespace: testNamespace, Name: testObj.GetShadowPipelineName()}, shadow))
+		assert.Equal(t, dfv1.PipelinePhasePaused, shadow.GetDesiredPhase())
+
+		shadow.Status.Phase = dfv1.PipelinePhasePaused
+		shadow.Status.DrainedOnPause = true
+		require.NoError(t, r.client.Update(ctx, shadow))
+		inProgress, err = r.finishUpgrade(ctx, testObj)
+		assert.NoError(t, err)
+		assert.False(t, inProgress)
+		assert.Equal(t, dfv1.PipelineUpgradePhaseSucceeded, testObj.Status.Upgrade.Phase)
+		assert.Equal(t, "Upgrade completed", testObj.Status.Upgrade.Message)
+		err = r.client.Get(ctx, types.NamespacedName{Namespace: testNamespace, Name: testObj.GetShadowPipelineName()}, &dfv1.Pipeline{})
+		assert.True(t, apierrors.IsNotFound(err))
+
+		// no new upgrade without changes, even with the defaults applied to the vertices
+		applyVertexDefaults(t, ctx, r, testObj)
+		held, err = r.reconcileUpgrade(ctx, testObj)
+		assert.NoError(t, err)
+		assert.False(t, held)
+		assert.Equal(t, dfv1.PipelineUpgradePhaseSucceeded, testObj.Status.Upgrade.Phase)
+	})
+}
@@ -38,6 +38,15 @@ func ValidateMonoVertex(mvtx *dfv1.MonoVertex) error {
 	if mvtx.Spec.Source.Serving != nil {
 		return fmt.Errorf("serving source is not supported with Monovertex yet")
 	}
+	if mvtx.Spec.Source.Dedup != nil {
+		return fmt.Errorf("source dedup is not supported with Monovertex yet")
+	}
+	if mvtx.Spec.Scale.Policy != nil || mvtx.Spec.Scale.DryRun {
+		return fmt.Errorf("scale policy and dry run are not supported with Monovertex yet")
+	}
+	if err := validateScaleSchedules(mvtx.Spec.Scale); err != nil {
+		return fmt.Errorf("invalid scale schedules: %w", err)
+	}
 	if err := validateSource(*mvtx.Spec.Source); err != nil {
 		return fmt.Errorf("invalid source: %w", err)
 	}
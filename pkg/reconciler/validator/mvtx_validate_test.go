@@ -112,6 +112,14 @@ func TestValidateMonoVertex(t *testing.T) {
 		assert.Contains(t, err.Error(), "invalid user-defined source spec")
 	})
 
+	t.Run("test scale dry run", func(t *testing.T) {
+		testObj := testMvtx.DeepCopy()
+		testObj.Spec.Scale.DryRun = true
+		err := ValidateMonoVertex(testObj)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "not supported with Monovertex yet")
+	})
+
 	t.Run("test no sink", func(t *testing.T) {
 		testObj := testMvtx.DeepCopy()
 		testObj.Spec.Sink = nil
@@ -0,0 +1,218 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package validator
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	dfv1 "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1"
+)
+
+// ErrUnsafeTopologyChange is returned when a topology change of a pipeline requires the pipeline to be paused and drained.
+var ErrUnsafeTopologyChange = errors.New("unsafe topology change")
+
+// PartitionCountChange is the change of the partition count of a vertex.
+type PartitionCountChange struct {
+	Vertex string
+	Old    int
+	New    int
+	// Reduce is true if the vertex is a reduce vertex, of which the keys are assigned to the partitions.
+	Reduce bool
+}
+
+// KeyRoutingChange is the change of how the keys are assigned to the partitions of a keyed reduce vertex on an edge,
+// which is decided by the partitioning and the hot key salting of the edge.
+type KeyRoutingChange struct {
+	Edge string
+	Old  string
+	New  string
+}
+
+// TopologyDiff is the difference between the topologies of two versions of a pipeline.
+// The edges are in the format of "<from>-><to>".
+type TopologyDiff struct {
+	AddedVertices     []string
+	RemovedVertices   []string
+	AddedEdges        []string
+	RemovedEdges      []string
+	PartitionChanges  []PartitionCountChange
+	KeyRoutingChanges []KeyRoutingChange
+}
+
+// IsEmpty returns true if the topology is not changed.
+func (d TopologyDiff) IsEmpty() bool {
+	return len(d.AddedVertices) == 0 && len(d.RemovedVertices) == 0 && len(d.AddedEdges) == 0 &&
+		len(d.RemovedEdges) == 0 && len(d.PartitionChanges) == 0 && len(d.KeyRoutingChanges) == 0
+}
+
+// UnsafeChanges returns the descriptions of the changes which delete buffers or watermark buckets, the messages in
+// them would be stranded if the pipeline is not drained. Changing the partition count of a reduce vertex is unsafe as
+// well, since the keys of the open windows would be assigned to other partitions, and so is changing the key routing of
+// an edge to a keyed reduce vertex. Adding vertices, edges, or partitions of other vertices is always safe.
+func (d TopologyDiff) UnsafeChanges() []string {
+	var changes []string
+	for _, v := range d.RemovedVertices {
+		changes = append(changes, fmt.Sprintf("vertex %q is removed", v))
+	}
+	for _, e := range d.RemovedEdges {
+		changes = append(changes, fmt.Sprintf("edge %q is removed", e))
+	}
+	for _, c := range d.PartitionChanges {
+		if c.New < c.Old {
+			changes = append(changes, fmt.Sprintf("partition count of vertex %q is decreased from %d to %d", c.Vertex, c.Old, c.New))
+		} else if c.Reduce {
+			changes = append(changes, fmt.Sprintf("partition count of reduce vertex %q is changed from %d to %d", c.Vertex, c.Old, c.New))
+		}
+	}
+	for _, c := range d.KeyRoutingChanges {
+		changes = append(changes, fmt.Sprintf("key routing of edge %q to a keyed reduce vertex is changed from %s to %s", c.Edge, c.Old, c.New))
+	}
+	return changes
+}
+
+// pipelineTopology is the partition count of each vertex, the reduce vertices, the edges of a pipeline, and the key
+// routing of the edges to the keyed reduce vertices.
+type pipelineTopology struct {
+	partitions map[string]int
+	reduce     map[string]bool
+	edges      map[string]struct{}
+	keyRouting map[string]string
+}
+
+func newPipelineTopology() pipelineTopology {
+	return pipelineTopology{partitions: make(map[string]int), reduce: make(map[string]bool), edges: make(map[string]struct{}), keyRouting: make(map[string]string)}
+}
+
+func edgeName(from, to string) string {
+	return from + "->" + to
+}
+
+func isKeyedReduce(v dfv1.AbstractVertex) bool {
+	return v.IsReduceUDF() && v.UDF.GroupBy.Keyed
+}
+
+// keyRoutingOf describes how the keys are assigned to the partitions of the to vertex of the edge.
+func keyRoutingOf(e dfv1.Edge) string {
+	routing := fmt.Sprintf("%q partitioning", e.GetPartitioningStrategy())
+	if hks := e.HotKeySalting; hks != nil {
+		routing += fmt.Sprintf(" with hot key salting (subPartitions: %d, thresholdPercentage: %d)", hks.GetSubPartitions(), hks.GetThresholdPercentage())
+	}
+	return routing
+}
+
+func topologyOfPipeline(pl *dfv1.Pipeline) pipelineTopology {
+	t := newPipelineTopology()
+	for _, v := range pl.Spec.Vertices {
+		t.partitions[v.Name] = v.GetPartitionCount()
+		t.reduce[v.Name] = v.IsReduceUDF()
+	}
+	vertices := pl.Spec.GetVerticesByName()
+	for _, e := range pl.Spec.Edges {
+		t.edges[edgeName(e.From, e.To)] = struct{}{}
+		if to, ok := vertices[e.To]; ok && isKeyedReduce(*to) {
+			t.keyRouting[edgeName(e.From, e.To)] = keyRoutingOf(e)
+		}
+	}
+	return t
+}
+
+func topologyOfVertices(vertices []dfv1.Vertex) pipelineTopology {
+	t := newPipelineTopology()
+	for _, v := range vertices {
+		t.partitions[v.Spec.Name] = v.GetPartitionCount()
+		t.reduce[v.Spec.Name] = v.IsReduceUDF()
+		// every edge is a from edge of the vertex it goes to
+		for _, e := range v.Spec.FromEdges {
+			t.edges[edgeName(e.From, e.To)] = struct{}{}
+			if isKeyedReduce(v.Spec.AbstractVertex) {
+				t.keyRouting[edgeName(e.From, e.To)] = keyRoutingOf(e.Edge)
+			}
+		}
+	}
+	return t
+}
+
+func diffTopology(old, new pipelineTopology) TopologyDiff {
+	d := TopologyDiff{}
+	for name, n := range new.partitions {
+		o, existing := old.partitions[name]
+		if !existing {
+			d.AddedVertices = append(d.AddedVertices, name)
+		} else if o != n {
+			d.PartitionChanges = append(d.PartitionChanges, PartitionCountChange{Vertex: name, Old: o, New: n, Reduce: old.reduce[name] || new.reduce[name]})
+		}
+	}
+	for name := range old.partitions {
+		if _, existing := new.partitions[name]; !existing {
+			d.RemovedVertices = append(d.RemovedVertices, name)
+		}
+	}
+	for e := range new.edges {
+		if _, existing := old.edges[e]; !existing {
+			d.AddedEdges = append(d.AddedEdges, e)
+		}
+	}
+	for e := range old.edges {
+		if _, existing := new.edges[e]; !existing {
+			d.RemovedEdges = append(d.RemovedEdges, e)
+		}
+	}
+	for e, n := range new.keyRouting {
+		if o, existing := old.keyRouting[e]; existing && o != n {
+			d.KeyRoutingChanges = append(d.KeyRoutingChanges, KeyRoutingChange{Edge: e, Old: o, New: n})
+		}
+	}
+	// sort for deterministic messages
+	slices.Sort(d.AddedVertices)
+	slices.Sort(d.RemovedVertices)
+	slices.Sort(d.AddedEdges)
+	slices.Sort(d.RemovedEdges)
+	slices.SortFunc(d.PartitionChanges, func(a, b PartitionCountChange) int {
+		return strings.Compare(a.Vertex, b.Vertex)
+	})
+	slices.SortFunc(d.KeyRoutingChanges, func(a, b KeyRoutingChange) int {
+		return strings.Compare(a.Edge, b.Edge)
+	})
+	return d
+}
+
+// DiffPipelineTopology returns the topology difference from the old pipeline spec to the new one.
+func DiffPipelineTopology(old, new *dfv1.Pipeline) TopologyDiff {
+	return diffTopology(topologyOfPipeline(old), topologyOfPipeline(new))
+}
+
+// DiffVerticesTopology returns the topology difference from the existing vertex objects of a pipeline to the pipeline spec.
+func DiffVerticesTopology(existing []dfv1.Vertex, pl *dfv1.Pipeline) TopologyDiff {
+	return diffTopology(topologyOfVertices(existing), topologyOfPipeline(pl))
+}
+
+// IsPausedAndDrained returns true if the pipeline is paused and all the messages in it were processed when pausing.
+func IsPausedAndDrained(pl *dfv1.Pipeline) bool {
+	return pl.Status.Phase == dfv1.PipelinePhasePaused && pl.Status.DrainedOnPause
+}
+
+// ValidateTopologyChange validates the topology change of a pipeline, the changes which delete buffers or watermark
+// buckets are only allowed when the pipeline is paused and drained.
+func ValidateTopologyChange(diff TopologyDiff, pl *dfv1.Pipeline) error {
+	if changes := diff.UnsafeChanges(); len(changes) > 0 && !IsPausedAndDrained(pl) {
+		return fmt.Errorf("%w, the pipeline needs to be paused and drained first: %s", ErrUnsafeTopologyChange, strings.Join(changes, "; "))
+	}
+	return nil
+}
@@ -0,0 +1,132 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package validator
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"k8s.io/utils/ptr"
+
+	dfv1 "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1"
+)
+
+func TestDiffPipelineTopology(t *testing.T) {
+	t.Run("no change", func(t *testing.T) {
+		diff := DiffPipelineTopology(testPipeline, testPipeline.DeepCopy())
+		assert.True(t, diff.IsEmpty())
+		assert.Empty(t, diff.UnsafeChanges())
+	})
+
+	t.Run("added vertex and edge", func(t *testing.T) {
+		newPl := testPipeline.DeepCopy()
+		newPl.Spec.Vertices = append(newPl.Spec.Vertices, dfv1.AbstractVertex{Name: "output-2", Sink: &dfv1.Sink{}})
+		newPl.Spec.Edges = append(newPl.Spec.Edges, dfv1.Edge{From: "p1", To: "output-2"})
+		diff := DiffPipelineTopology(testPipeline, newPl)
+		assert.False(t, diff.IsEmpty())
+		assert.Equal(t, []string{"output-2"}, diff.AddedVertices)
+		assert.Equal(t, []string{"p1->output-2"}, diff.AddedEdges)
+		assert.Empty(t, diff.UnsafeChanges())
+
+		diff = DiffPipelineTopology(newPl, testPipeline)
+		assert.Equal(t, []string{"output-2"}, diff.RemovedVertices)
+		assert.Equal(t, []string{"p1->output-2"}, diff.RemovedEdges)
+		assert.Equal(t, []string{`vertex "output-2" is removed`, `edge "p1->output-2" is removed`}, diff.UnsafeChanges())
+	})
+
+	t.Run("partition count change", func(t *testing.T) {
+		oldPl := testPipeline.DeepCopy()
+		oldPl.Spec.Vertices[1].Partitions = ptr.To[int32](2)
+		newPl := testPipeline.DeepCopy()
+		newPl.Spec.Vertices[1].Partitions = ptr.To[int32](3)
+		diff := DiffPipelineTopology(oldPl, newPl)
+		assert.Equal(t, []PartitionCountChange{{Vertex: "p1", Old: 2, New: 3}}, diff.PartitionChanges)
+		assert.Empty(t, diff.UnsafeChanges())
+
+		diff = DiffPipelineTopology(newPl, oldPl)
+		assert.Equal(t, []string{`partition count of vertex "p1" is decreased from 3 to 2`}, diff.UnsafeChanges())
+	})
+
+	t.Run("partition count change of reduce vertex", func(t *testing.T) {
+		newPl := testReducePipeline.DeepCopy()
+		newPl.Spec.Vertices[2].Partitions = ptr.To[int32](3)
+		diff := DiffPipelineTopology(testReducePipeline, newPl)
+		assert.Equal(t, []PartitionCountChange{{Vertex: "p2", Old: 2, New: 3, Reduce: true}}, diff.PartitionChanges)
+		assert.Equal(t, []string{`partition count of reduce vertex "p2" is changed from 2 to 3`}, diff.UnsafeChanges())
+	})
+
+	t.Run("key routing change of keyed reduce vertex", func(t *testing.T) {
+		newPl := testReducePipeline.DeepCopy()
+		newPl.Spec.Edges[1].Partitioning = ptr.To(dfv1.PartitioningStrategyJumpHash)
+		diff := DiffPipelineTopology(testReducePipeline, newPl)
+		assert.False(t, diff.IsEmpty())
+		assert.Equal(t, []KeyRoutingChange{{Edge: "p1->p2", Old: `"modulo" partitioning`, New: `"jumpHash" partitioning`}}, diff.KeyRoutingChanges)
+		assert.Equal(t, []string{`key routing of edge "p1->p2" to a keyed reduce vertex is changed from "modulo" partitioning to "jumpHash" partitioning`}, diff.UnsafeChanges())
+
+		oldPl := newPl.DeepCopy()
+		newPl.Spec.Edges[1].HotKeySalting = &dfv1.HotKeySalting{SubPartitions: ptr.To[int32](2)}
+		diff = DiffPipelineTopology(oldPl, newPl)
+		assert.Equal(t, []KeyRoutingChange{{Edge: "p1->p2", Old: `"jumpHash" partitioning`, New: `"jumpHash" partitioning with hot key salting (subPartitions: 2, thresholdPercentage: 20)`}}, diff.KeyRoutingChanges)
+
+		// the edges to the vertices which are not keyed reduce vertices are not affected
+		newPl = testReducePipeline.DeepCopy()
+		newPl.Spec.Edges[0].Partitioning = ptr.To(dfv1.PartitioningStrategyJumpHash)
+		assert.True(t, DiffPipelineTopology(testReducePipeline, newPl).IsEmpty())
+	})
+}
+
+func TestDiffVerticesTopology(t *testing.T) {
+	oldPl := testPipeline.DeepCopy()
+	oldPl.Spec.Vertices[1].Partitions = ptr.To[int32](2)
+	vertices := []dfv1.Vertex{
+		{Spec: dfv1.VertexSpec{AbstractVertex: oldPl.Spec.Vertices[0], ToEdges: []dfv1.CombinedEdge{{Edge: dfv1.Edge{From: "input", To: "p1"}}}}},
+		{Spec: dfv1.VertexSpec{AbstractVertex: oldPl.Spec.Vertices[1], FromEdges: []dfv1.CombinedEdge{{Edge: dfv1.Edge{From: "input", To: "p1"}}}}},
+	}
+	diff := DiffVerticesTopology(vertices, testPipeline)
+	assert.Equal(t, []string{"output"}, diff.AddedVertices)
+	assert.Equal(t, []string{"p1->output"}, diff.AddedEdges)
+	assert.Equal(t, []PartitionCountChange{{Vertex: "p1", Old: 2, New: 1}}, diff.PartitionChanges)
+}
+
+func TestDiffVerticesTopology_KeyRouting(t *testing.T) {
+	p1, p2 := testReducePipeline.Spec.Vertices[1], testReducePipeline.Spec.Vertices[2]
+	vertices := []dfv1.Vertex{
+		{Spec: dfv1.VertexSpec{AbstractVertex: p1, ToEdges: []dfv1.CombinedEdge{{Edge: dfv1.Edge{From: "p1", To: "p2"}}}}},
+		{Spec: dfv1.VertexSpec{AbstractVertex: p2, FromEdges: []dfv1.CombinedEdge{{Edge: dfv1.Edge{From: "p1", To: "p2", HotKeySalting: &dfv1.HotKeySalting{}}}}}},
+	}
+	pl := testReducePipeline.DeepCopy()
+	pl.Spec.Vertices = []dfv1.AbstractVertex{p1, p2}
+	pl.Spec.Edges = []dfv1.Edge{{From: "p1", To: "p2"}}
+	diff := DiffVerticesTopology(vertices, pl)
+	assert.Equal(t, []KeyRoutingChange{{Edge: "p1->p2", Old: `"modulo" partitioning with hot key salting (subPartitions: 2, thresholdPercentage: 20)`, New: `"modulo" partitioning`}}, diff.KeyRoutingChanges)
+}
+
+func TestValidateTopologyChange(t *testing.T) {
+	diff := TopologyDiff{RemovedVertices: []string{"p1"}}
+	pl := testPipeline.DeepCopy()
+	err := ValidateTopologyChange(diff, pl)
+	assert.ErrorIs(t, err, ErrUnsafeTopologyChange)
+	assert.Contains(t, err.Error(), `vertex "p1" is removed`)
+
+	// paused without being drained
+	pl.Status.Phase = dfv1.PipelinePhasePaused
+	assert.Error(t, ValidateTopologyChange(diff, pl))
+
+	pl.Status.DrainedOnPause = true
+	assert.NoError(t, ValidateTopologyChange(diff, pl))
+	assert.NoError(t, ValidateTopologyChange(TopologyDiff{AddedVertices: []string{"p2"}}, testPipeline))
+}
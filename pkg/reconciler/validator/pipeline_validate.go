@@ -18,12 +18,16 @@ package validator
 
 import (
 	"fmt"
+	"slices"
+	"time"
 
+	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/util/intstr"
 	k8svalidation "k8s.io/apimachinery/pkg/util/validation"
 
 	"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1"
 	dfv1 "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1"
+	"github.com/numaproj/numaflow/pkg/shared/expr"
 )
 
 func ValidatePipeline(pl *dfv1.Pipeline) error {
@@ -140,6 +144,22 @@ func ValidatePipeline(pl *dfv1.Pipeline) error {
 					return fmt.Errorf("invalid edge: conditional forwarding requires at least one tag value")
 				}
 			}
+			if e.Conditions.Expression != "" {
+				if _, err := expr.CompileBool(e.Conditions.Expression); err != nil {
+					return fmt.Errorf("invalid edge: invalid expression condition of the edge from %q to %q, %w", e.From, e.To, err)
+				}
+			}
+		}
+		if e.HotKeySalting != nil {
+			if toVertex := pl.GetVertex(e.To); !toVertex.IsReduceUDF() || toVertex.GetPartitionCount() < 2 {
+				return fmt.Errorf("invalid edge: hot key salting is only supported when the to vertex %q is a keyed reduce vertex with more than one partition", e.To)
+			}
+			// each partition produces a partial result for a salted key, which needs to be combined downstream
+			for _, next := range pl.GetToEdges(e.To) {
+				if combiner := pl.GetVertex(next.To); combiner == nil || !combiner.IsReduceUDF() || !combiner.UDF.GroupBy.Keyed || next.HotKeySalting != nil {
+					return fmt.Errorf("invalid edge: hot key salting requires the partial results of %q to be combined by a downstream keyed reduce vertex without hot key salting, %q is not", e.To, next.To)
+				}
+			}
 		}
 	}
 
@@ -176,6 +196,222 @@ func ValidatePipeline(pl *dfv1.Pipeline) error {
 		return err
 	}
 
+	if err := validateLateData(*pl); err != nil {
+		return err
+	}
+
+	if err := validateDeadLetter(*pl); err != nil {
+		return err
+	}
+
+	if err := validateUpgradeStrategy(*pl); err != nil {
+		return err
+	}
+
+	if err := validateGoRuntimeFeatures(pl.Spec, func(v dfv1.AbstractVertex) bool {
+		return isRustRuntime(pl.Spec, v)
+	}); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// isRustRuntime returns true if the vertex runs on the Rust runtime, which is selected by the NUMAFLOW_RUNTIME
+// environment variable of the vertex, or of the vertex template of the pipeline.
+func isRustRuntime(spec dfv1.PipelineSpec, v dfv1.AbstractVertex) bool {
+	runtime := ""
+	if t := spec.Templates; t != nil && t.VertexTemplate != nil && t.VertexTemplate.ContainerTemplate != nil {
+		for _, env := range t.VertexTemplate.ContainerTemplate.Env {
+			if env.Name == dfv1.EnvNumaflowRuntime {
+				runtime = env.Value
+			}
+		}
+	}
+	if v.ContainerTemplate != nil {
+		for _, env := range v.ContainerTemplate.Env {
+			if env.Name == dfv1.EnvNumaflowRuntime {
+				runtime = env.Value
+			}
+		}
+	}
+	return runtime == "rust"
+}
+
+// hasEnv returns true if the environment variable is set for the vertex, or in the vertex template of the pipeline.
+func hasEnv(spec dfv1.PipelineSpec, v dfv1.AbstractVertex, name string) bool {
+	var envs []corev1.EnvVar
+	if t := spec.Templates; t != nil && t.VertexTemplate != nil && t.VertexTemplate.ContainerTemplate != nil {
+		envs = append(envs, t.VertexTemplate.ContainerTemplate.Env...)
+	}
+	if v.ContainerTemplate != nil {
+		envs = append(envs, v.ContainerTemplate.Env...)
+	}
+	for _, env := range envs {
+		if env.Name == name {
+			return true
+		}
+	}
+	return false
+}
+
+// validateGoRuntimeFeatures validates that the features which are only implemented by the Go runtime are not used by
+// the vertices running on the Rust runtime.
+func validateGoRuntimeFeatures(spec dfv1.PipelineSpec, isRust func(dfv1.AbstractVertex) bool) error {
+	for _, v := range spec.Vertices {
+		if v.Source != nil && v.Source.HTTP != nil && v.Source.HTTP.DurableAck && isRust(v) {
+			return fmt.Errorf("invalid vertex %q, \"http.durableAck\" is not supported by the Rust runtime", v.Name)
+		}
+		if v.Source != nil && v.Source.Dedup != nil && isRust(v) {
+			return fmt.Errorf("invalid vertex %q, \"source.dedup\" is not supported by the Rust runtime", v.Name)
+		}
+		if v.UDF != nil && v.UDF.Builtin != nil && isRust(v) {
+			return fmt.Errorf("invalid vertex %q, built-in functions are not supported by the Rust runtime", v.Name)
+		}
+		if v.UDF != nil && (v.UDF.RetryStrategy != nil || v.UDF.DeadLetter != nil) && isRust(v) {
+			return fmt.Errorf("invalid vertex %q, \"retryStrategy\" and \"deadLetter\" of the map UDF are not supported by the Rust runtime", v.Name)
+		}
+		if v.IsReduceUDF() && (v.UDF.GroupBy.Window.Count != nil || v.UDF.GroupBy.Window.Global != nil) && isRust(v) {
+			return fmt.Errorf("invalid vertex %q, count and global windows are not supported by the Rust runtime", v.Name)
+		}
+		if v.IsReduceUDF() && (v.UDF.GroupBy.EarlyFiring != nil || v.UDF.GroupBy.LateFiring) && isRust(v) {
+			return fmt.Errorf("invalid vertex %q, \"earlyFiring\" and \"lateFiring\" are not supported by the Rust runtime", v.Name)
+		}
+		if v.IsReduceUDF() && v.UDF.GroupBy.LateData != nil && isRust(v) {
+			return fmt.Errorf("invalid vertex %q, \"groupBy.lateData\" is not supported by the Rust runtime", v.Name)
+		}
+		if v.IsReduceUDF() && v.UDF.GroupBy.Storage != nil && v.UDF.GroupBy.Storage.ObjectStore != nil && isRust(v) {
+			return fmt.Errorf("invalid vertex %q, object store storage is not supported by the Rust runtime", v.Name)
+		}
+		for _, name := range []string{dfv1.EnvCallbackSigningKey, dfv1.EnvCallbackOutboxDir, dfv1.EnvCallbackOutboxMaxBytes} {
+			if hasEnv(spec, v, name) && isRust(v) {
+				return fmt.Errorf("invalid vertex %q, environment variable %q is not supported by the Rust runtime", v.Name, name)
+			}
+		}
+	}
+	vertices := spec.GetVerticesByName()
+	for _, e := range spec.Edges {
+		// the conditions are evaluated and the messages are shuffled by the vertex which forwards the messages
+		if from, ok := vertices[e.From]; !ok || !isRust(*from) {
+			continue
+		}
+		if e.Conditions != nil && e.Conditions.Expression != "" {
+			return fmt.Errorf("invalid edge: expression condition of the edge from %q to %q is not supported by the Rust runtime", e.From, e.To)
+		}
+		if e.HotKeySalting != nil {
+			return fmt.Errorf("invalid edge: hot key salting of the edge from %q to %q is not supported by the Rust runtime", e.From, e.To)
+		}
+		if e.GetPartitioningStrategy() != dfv1.PartitioningStrategyModulo {
+			return fmt.Errorf("invalid edge: %q partitioning of the edge from %q to %q is not supported by the Rust runtime", e.GetPartitioningStrategy(), e.From, e.To)
+		}
+	}
+	return nil
+}
+
+// validateUpgradeStrategy validates that the sources can be shared by the pipeline and the shadow pipeline of a
+// BlueGreen upgrade. The HTTP and serving sources are exposed by a Service per pipeline, the shadow pipeline doesn't
+// receive the requests. The generator source and the JetStream source, of which the consumer is named after the
+// pipeline, would feed both the pipelines with the same messages.
+func validateUpgradeStrategy(pl dfv1.Pipeline) error {
+	if pl.GetUpgradeStrategy().GetType() != dfv1.PipelineUpgradeStrategyBlueGreen {
+		return nil
+	}
+	for _, v := range pl.Spec.Vertices {
+		if v.Source == nil {
+			continue
+		}
+		var source string
+		switch {
+		case v.Source.HTTP != nil:
+			source = "http"
+		case v.Source.Serving != nil:
+			source = "serving"
+		case v.Source.Generator != nil:
+			source = "generator"
+		case v.Source.JetStream != nil:
+			source = "jetstream"
+		default:
+			continue
+		}
+		return fmt.Errorf("invalid vertex %q, %q source can not be shared with the shadow pipeline of the BlueGreen upgrade strategy", v.Name, source)
+	}
+	return nil
+}
+
+// validateLateData validates the late data side outputs of the reduce vertices, the late data vertex has to be a
+// downstream vertex of the reduce vertex.
+func validateLateData(pl dfv1.Pipeline) error {
+	for _, v := range pl.Spec.Vertices {
+		if !v.IsReduceUDF() || v.UDF.GroupBy.LateData == nil {
+			continue
+		}
+		lateData := v.UDF.GroupBy.LateData
+		if lateData.ToVertex == "" {
+			return fmt.Errorf("invalid vertex %q, \"groupBy.lateData.toVertex\" is required", v.Name)
+		}
+		connected := false
+		for _, e := range pl.GetToEdges(v.Name) {
+			if e.To == lateData.ToVertex {
+				connected = true
+				break
+			}
+		}
+		if !connected {
+			return fmt.Errorf("invalid vertex %q, late data vertex %q is not connected to it with an edge", v.Name, lateData.ToVertex)
+		}
+		if lateData.Fallback {
+			if toVertex := pl.GetVertex(lateData.ToVertex); toVertex.Sink == nil || toVertex.Sink.Fallback == nil {
+				return fmt.Errorf("invalid vertex %q, late data can only be written to the fallback sink of a sink vertex with a fallback sink", v.Name)
+			}
+		}
+	}
+	return nil
+}
+
+// validateDeadLetter validates the retry strategies and the dead letter side outputs of the map vertices, the dead
+// letter vertex has to be a downstream vertex of the map vertex.
+func validateDeadLetter(pl dfv1.Pipeline) error {
+	for _, v := range pl.Spec.Vertices {
+		if v.UDF == nil || (v.UDF.RetryStrategy == nil && v.UDF.DeadLetter == nil) {
+			continue
+		}
+		if v.IsReduceUDF() {
+			return fmt.Errorf("invalid vertex %q, \"retryStrategy\" and \"deadLetter\" are not supported by reduce vertices", v.Name)
+		}
+		onFailure := dfv1.DefaultOnFailureRetryStrategy
+		if x := v.UDF.RetryStrategy; x != nil {
+			if err := validateBackoff(x.BackOff); err != nil {
+				return fmt.Errorf("invalid vertex %q, %w", v.Name, err)
+			}
+			onFailure = x.GetOnFailureRetryStrategy()
+			if onFailure != dfv1.OnFailureRetry && (x.BackOff == nil || x.BackOff.Steps == nil) {
+				return fmt.Errorf("invalid vertex %q, steps in backoff strategy are required by the %q OnFailure strategy", v.Name, onFailure)
+			}
+		}
+		deadLetter := v.UDF.DeadLetter
+		if onFailure == dfv1.OnFailureFallback && deadLetter == nil {
+			return fmt.Errorf("invalid vertex %q, given OnFailure strategy is fallback but \"deadLetter\" is not provided", v.Name)
+		}
+		if deadLetter == nil {
+			continue
+		}
+		if onFailure != dfv1.OnFailureFallback {
+			return fmt.Errorf("invalid vertex %q, \"deadLetter\" requires the fallback OnFailure strategy", v.Name)
+		}
+		if deadLetter.ToVertex == "" {
+			return fmt.Errorf("invalid vertex %q, \"deadLetter.toVertex\" is required", v.Name)
+		}
+		connected := false
+		for _, e := range pl.GetToEdges(v.Name) {
+			if e.To == deadLetter.ToVertex {
+				connected = true
+				break
+			}
+		}
+		if !connected {
+			return fmt.Errorf("invalid vertex %q, dead letter vertex %q is not connected to it with an edge", v.Name, deadLetter.ToVertex)
+		}
+	}
 	return nil
 }
 
@@ -198,6 +434,80 @@ func validateIdleSource(pl dfv1.Pipeline) error {
 	return nil
 }
 
+func validateScalePolicy(p *dfv1.ScalePolicy) error {
+	if p == nil {
+		return nil
+	}
+	count := 0
+	if p.TargetLag != nil {
+		count++
+		if p.TargetLag.TargetLagSeconds == 0 {
+			return fmt.Errorf("targetLag.targetLagSeconds should be greater than 0")
+		}
+	}
+	if p.CPU != nil {
+		count++
+		if p.CPU.TargetUtilization == 0 {
+			return fmt.Errorf("cpu.targetUtilization should be greater than 0")
+		}
+	}
+	if p.Predictive != nil {
+		count++
+		if p.Predictive.GetPeriodSeconds() == 0 {
+			return fmt.Errorf("predictive.periodSeconds should be greater than 0")
+		}
+		if p.Predictive.GetLookaheadSeconds() >= p.Predictive.GetPeriodSeconds() {
+			return fmt.Errorf("predictive.lookaheadSeconds should be less than predictive.periodSeconds")
+		}
+	}
+	if count != 1 {
+		return fmt.Errorf("exactly one of targetLag, cpu or predictive should be specified")
+	}
+	return nil
+}
+
+func validateScaleSchedules(scale dfv1.Scale) error {
+	if len(scale.Schedules) == 0 {
+		return nil
+	}
+	if scale.Disabled {
+		return fmt.Errorf("schedules are not supported when autoscaling is disabled")
+	}
+	names := make(map[string]struct{})
+	for _, ss := range scale.Schedules {
+		if ss.Name == "" {
+			return fmt.Errorf("schedule name is required")
+		}
+		if _, existing := names[ss.Name]; existing {
+			return fmt.Errorf("duplicate schedule name %q", ss.Name)
+		}
+		names[ss.Name] = struct{}{}
+		if _, _, err := ss.Evaluate(time.Now()); err != nil {
+			return fmt.Errorf("schedule %q: %w", ss.Name, err)
+		}
+		min, max := int32(0), int32(dfv1.DefaultMaxReplicas)
+		if scale.Min != nil {
+			min = *scale.Min
+		}
+		if scale.Max != nil {
+			max = *scale.Max
+		}
+		if ss.Min != nil {
+			min = *ss.Min
+		}
+		if ss.Max != nil {
+			max = *ss.Max
+		}
+		if min < 0 {
+			return fmt.Errorf("schedule %q: min number of replicas should not be smaller than 0", ss.Name)
+		}
+		if min > max {
+			return fmt.Errorf("schedule %q: max number of replicas should be greater than or equal to min", ss.Name)
+		}
+	}
+	return nil
+}
+
 func validateVertex(v dfv1.AbstractVertex) error {
 	if errs := k8svalidation.IsDNS1035Label(v.Name); len(errs) > 0 {
 		return fmt.Errorf("invalid vertex name %q, %v", v.Name, errs)
@@ -215,6 +525,12 @@ func validateVertex(v dfv1.AbstractVertex) error {
 	if min > max {
 		return fmt.Errorf("vertex %q: max number of replicas should be greater than or equal to min", v.Name)
 	}
+	if err := validateScalePolicy(v.Scale.Policy); err != nil {
+		return fmt.Errorf("vertex %q: invalid scale policy: %w", v.Name, err)
+	}
+	if err := validateScaleSchedules(v.Scale); err != nil {
+		return fmt.Errorf("vertex %q: invalid scale schedules: %w", v.Name, err)
+	}
 	if v.Partitions != nil {
 		if *v.Partitions < 0 {
 			return fmt.Errorf("vertex %q: number of partitions should not be smaller than 0", v.Name)
@@ -278,13 +594,67 @@ func validateUDF(udf dfv1.UDF) error {
 }
 
 func validateMapUDF(udf dfv1.UDF) error {
+	if udf.Builtin != nil {
+		if udf.Container != nil {
+			return fmt.Errorf("invalid udf spec, only one of 'container' and 'builtin' can be specified")
+		}
+		return validateBuiltinFunction(*udf.Builtin)
+	}
 	if udf.Container == nil || udf.Container.Image == "" {
 		return fmt.Errorf("invalid udf spec, a customized image is required")
 	}
 	return nil
 }
 
+// validateBuiltinFunction validates the keyword arguments of a built-in map function, the expressions are compiled to
+// make sure they are valid.
+func validateBuiltinFunction(fn dfv1.Function) error {
+	var boolExprs, strExprs, others, required []string
+	switch fn.Name {
+	case dfv1.BuiltinFunctionCat:
+	case dfv1.BuiltinFunctionFilter:
+		boolExprs, required = []string{"expression"}, []string{"expression"}
+	case dfv1.BuiltinFunctionProject:
+		strExprs = []string{"key", "value"}
+		if fn.KWArgs["key"] == "" && fn.KWArgs["value"] == "" {
+			return fmt.Errorf("invalid built-in function %q, at least one of the kwargs \"key\" and \"value\" is required", fn.Name)
+		}
+	case dfv1.BuiltinFunctionEventTime:
+		strExprs, others, required = []string{"expression"}, []string{"format"}, []string{"expression"}
+	default:
+		return fmt.Errorf("unrecognized built-in function %q", fn.Name)
+	}
+	for k := range fn.KWArgs {
+		if !slices.Contains(boolExprs, k) && !slices.Contains(strExprs, k) && !slices.Contains(others, k) {
+			return fmt.Errorf("invalid built-in function %q, unknown kwarg %q", fn.Name, k)
+		}
+	}
+	for _, k := range required {
+		if fn.KWArgs[k] == "" {
+			return fmt.Errorf("invalid built-in function %q, kwarg %q is required", fn.Name, k)
+		}
+	}
+	for _, k := range boolExprs {
+		if v := fn.KWArgs[k]; v != "" {
+			if _, err := expr.CompileBool(v); err != nil {
+				return fmt.Errorf("invalid built-in function %q, invalid kwarg %q, %w", fn.Name, k, err)
+			}
+		}
+	}
+	for _, k := range strExprs {
+		if v := fn.KWArgs[k]; v != "" {
+			if _, err := expr.CompileStr(v); err != nil {
+				return fmt.Errorf("invalid built-in function %q, invalid kwarg %q, %w", fn.Name, k, err)
+			}
+		}
+	}
+	return nil
+}
+
 func validateReduceUDF(udf dfv1.UDF) error {
+	if udf.Builtin != nil {
+		return fmt.Errorf("invalid udf spec, built-in functions are not supported by reduce vertices")
+	}
 	if udf.Container != nil {
 		if udf.Container.Image == "" {
 			return fmt.Errorf("invalid udf spec, a customized image is required")
@@ -295,11 +665,19 @@ func validateReduceUDF(udf dfv1.UDF) error {
 	s := udf.GroupBy.Window.Sliding
 	ss := udf.GroupBy.Window.Session
 	accum := udf.GroupBy.Window.Accumulator
+	c := udf.GroupBy.Window.Count
+	g := udf.GroupBy.Window.Global
 
 	storage := udf.GroupBy.Storage
-	if f == nil && s == nil && ss == nil && accum == nil {
+	if f == nil && s == nil && ss == nil && accum == nil && c == nil && g == nil {
 		return fmt.Errorf(`invalid "groupBy.window", no windowing strategy specified`)
 	}
+	if (c != nil || g != nil) && (f != nil || s != nil || ss != nil || accum != nil) {
+		return fmt.Errorf(`invalid "groupBy.window", count or global can not be combined with any other windowing strategy`)
+	}
+	if c != nil && g != nil {
+		return fmt.Errorf(`invalid "groupBy.window", either count or global is allowed, not both`)
+	}
 	if f != nil && s != nil {
 		return fmt.Errorf(`invalid "groupBy.window", either fixed or sliding is allowed, not both`)
 	}
@@ -325,10 +703,40 @@ func validateReduceUDF(udf dfv1.UDF) error {
 	if accum != nil && accum.Timeout == nil {
 		return fmt.Errorf(`invalid "groupBy.window.accumulator", "timeout" is missing`)
 	}
+	if c != nil && c.Count <= 0 {
+		return fmt.Errorf(`invalid "groupBy.window.count", "count" should be greater than 0`)
+	}
+	if g != nil {
+		if g.Trigger == nil || (g.Trigger.Count == nil && g.Trigger.Interval == nil) {
+			return fmt.Errorf(`invalid "groupBy.window.global", "trigger" with "count" or "interval" is required`)
+		}
+		if g.Trigger.Count != nil && *g.Trigger.Count <= 0 {
+			return fmt.Errorf(`invalid "groupBy.window.global.trigger", "count" should be greater than 0`)
+		}
+		if g.Trigger.Interval != nil && g.Trigger.Interval.Duration <= 0 {
+			return fmt.Errorf(`invalid "groupBy.window.global.trigger", "interval" should be greater than 0`)
+		}
+	}
+	if ef := udf.GroupBy.EarlyFiring; ef != nil {
+		if f == nil && s == nil {
+			return fmt.Errorf(`invalid "groupBy.earlyFiring", only fixed and sliding windows support early firing`)
+		}
+		if (ef.Interval == nil || ef.Interval.Duration <= 0) && (ef.Count == nil || *ef.Count <= 0) {
+			return fmt.Errorf(`invalid "groupBy.earlyFiring", a positive "interval" or "count" is required`)
+		}
+	}
+	if udf.GroupBy.LateFiring {
+		if f == nil && s == nil {
+			return fmt.Errorf(`invalid "groupBy.lateFiring", only fixed and sliding windows support late firing`)
+		}
+		if udf.GroupBy.AllowedLateness == nil || udf.GroupBy.AllowedLateness.Duration <= 0 {
+			return fmt.Errorf(`invalid "groupBy.lateFiring", "allowedLateness" is required`)
+		}
+	}
 	if storage == nil {
 		return fmt.Errorf(`invalid "groupBy", "storage" is missing`)
 	}
-	if storage.PersistentVolumeClaim == nil && storage.EmptyDir == nil && storage.NoStore == nil {
+	if storage.PersistentVolumeClaim == nil && storage.EmptyDir == nil && storage.NoStore == nil && storage.ObjectStore == nil {
 		return fmt.Errorf(`invalid "groupBy.storage", type of storage to use is missing`)
 	}
 	if storage.PersistentVolumeClaim != nil && storage.EmptyDir != nil {
@@ -340,6 +748,17 @@ func validateReduceUDF(udf dfv1.UDF) error {
 	if storage.EmptyDir != nil && storage.NoStore != nil {
 		return fmt.Errorf(`invalid "groupBy.storage", either none or emptyDir is allowed, not both`)
 	}
+	if objStore := storage.ObjectStore; objStore != nil {
+		if storage.PersistentVolumeClaim != nil || storage.EmptyDir != nil || storage.NoStore != nil {
+			return fmt.Errorf(`invalid "groupBy.storage", objectStore can not be used with other types of storage`)
+		}
+		if f == nil && s == nil {
+			return fmt.Errorf(`invalid "groupBy.storage.objectStore", only fixed and sliding windows support object store`)
+		}
+		if objStore.Bucket == "" {
+			return fmt.Errorf(`invalid "groupBy.storage.objectStore", "bucket" is required`)
+		}
+	}
 
 	return nil
 }
@@ -363,8 +782,21 @@ func validateSideInputs(pl dfv1.Pipeline) error {
 		if si.Trigger == nil {
 			return fmt.Errorf("side input %q: trigger is missing", si.Name)
 		}
-		if len(si.Trigger.Schedule) == 0 {
-			return fmt.Errorf("side input %q: schedule is required", si.Name)
+		if len(si.Trigger.Schedule) == 0 && !si.Trigger.HasWatch() {
+			return fmt.Errorf("side input %q: either schedule or watch is required", si.Name)
+		}
+		if si.RetainedVersions != nil && *si.RetainedVersions < 1 {
+			return fmt.Errorf("side input %q: retainedVersions must be greater than 0", si.Name)
+		}
+		if si.PinnedVersion != nil && *si.PinnedVersion < 1 {
+			return fmt.Errorf("side input %q: pinnedVersion must be greater than 0", si.Name)
+		}
+		if w := si.Trigger.Watch; w != nil {
+			for _, n := range append(append([]string{}, w.ConfigMaps...), w.Secrets...) {
+				if n == "" {
+					return fmt.Errorf("side input %q: watched ConfigMap or Secret name is empty", si.Name)
+				}
+			}
 		}
 	}
 	for _, v := range pl.Spec.Vertices {
@@ -588,6 +1020,17 @@ func validateSource(source dfv1.Source) error {
 			return fmt.Errorf("invalid user-defined source spec, only one of 'http', 'kafka', 'nats', 'generator' and 'udSource' can be specified")
 		}
 	}
+	if x := source.Dedup; x != nil {
+		if source.HTTP == nil && source.JetStream == nil {
+			return fmt.Errorf("invalid source spec, dedup is only supported by 'http' and 'jetstream' sources")
+		}
+		if x.Duration.Duration <= 0 {
+			return fmt.Errorf("invalid dedup window, duration must be greater than 0")
+		}
+		if x.MaxEntries != nil && *x.MaxEntries <= 0 {
+			return fmt.Errorf("invalid dedup window, maxEntries must be greater than 0")
+		}
+	}
 	return nil
 }
 
@@ -610,38 +1053,43 @@ func hasValidSinkRetryStrategy(s dfv1.Sink) error {
 		return fmt.Errorf("given OnFailure strategy is fallback but fallback sink is not provided")
 	}
 
-	if s.RetryStrategy.BackOff != nil {
-		// If steps are provided in the strategy they cannot be 0, as we do not allow no tries for writing
-		if s.RetryStrategy.BackOff.Steps != nil && *s.RetryStrategy.BackOff.Steps == 0 {
-			return fmt.Errorf("steps in backoff strategy cannot be 0")
-		}
-		// If factor is provided in the strategy it should be greater than or equal to 1
-		if s.RetryStrategy.BackOff.Factor != nil && *s.RetryStrategy.BackOff.Factor < 1 {
-			return fmt.Errorf("factor in backoff strategy cannot be less than 1")
-		}
+	return validateBackoff(s.RetryStrategy.BackOff)
+}
 
-		// If cap and interval are provided, cap must be greater than or equal to interval
-		if s.RetryStrategy.BackOff.Cap != nil && s.RetryStrategy.BackOff.Interval != nil {
-			if s.RetryStrategy.BackOff.Cap.Duration < s.RetryStrategy.BackOff.Interval.Duration {
-				return fmt.Errorf("cap in backoff strategy cannot be less than interval")
-			}
-		}
+// validateBackoff validates the backoff of a retry strategy.
+func validateBackoff(b *dfv1.Backoff) error {
+	if b == nil {
+		return nil
+	}
+	// If steps are provided in the strategy they cannot be 0, as we do not allow no tries for writing
+	if b.Steps != nil && *b.Steps == 0 {
+		return fmt.Errorf("steps in backoff strategy cannot be 0")
+	}
+	// If factor is provided in the strategy it should be greater than or equal to 1
+	if b.Factor != nil && *b.Factor < 1 {
+		return fmt.Errorf("factor in backoff strategy cannot be less than 1")
+	}
 
-		// If cap is provided but interval isn't, cap must be greater than or equal to default interval value
-		if s.RetryStrategy.BackOff.Cap != nil && s.RetryStrategy.BackOff.Interval == nil {
-			if s.RetryStrategy.BackOff.Cap.Duration < v1alpha1.DefaultRetryInterval {
-				return fmt.Errorf("cap in backoff strategy cannot be less than default interval value, if interval is not provided")
-			}
+	// If cap and interval are provided, cap must be greater than or equal to interval
+	if b.Cap != nil && b.Interval != nil {
+		if b.Cap.Duration < b.Interval.Duration {
+			return fmt.Errorf("cap in backoff strategy cannot be less than interval")
 		}
+	}
 
-		// If jitter is provided, it should be greater than or equal to 0 and less than 1
-		// Jitter is typically used to introduce small random variations to avoid synchronized retries
-		// A jitter value less than 1 ensures that the delay remains within a reasonable range around the base delay.
-		if s.RetryStrategy.BackOff.Jitter != nil && (*s.RetryStrategy.BackOff.Jitter < 0 || *s.RetryStrategy.BackOff.Jitter >= 1) {
-			return fmt.Errorf("jitter in backoff strategy should be between 0 and 1")
+	// If cap is provided but interval isn't, cap must be greater than or equal to default interval value
+	if b.Cap != nil && b.Interval == nil {
+		if b.Cap.Duration < v1alpha1.DefaultRetryInterval {
+			return fmt.Errorf("cap in backoff strategy cannot be less than default interval value, if interval is not provided")
 		}
 	}
-	// If no errors are found, the function returns nil indicating the validation passed.
+
+	// If jitter is provided, it should be greater than or equal to 0 and less than 1
+	// Jitter is typically used to introduce small random variations to avoid synchronized retries
+	// A jitter value less than 1 ensures that the delay remains within a reasonable range around the base delay.
+	if b.Jitter != nil && (*b.Jitter < 0 || *b.Jitter >= 1) {
+		return fmt.Errorf("jitter in backoff strategy should be between 0 and 1")
+	}
 	return nil
 }
 
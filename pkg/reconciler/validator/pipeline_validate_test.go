@@ -520,6 +520,52 @@ func TestValidatePipeline(t *testing.T) {
 		assert.Contains(t, err.Error(), "invalid edge: conditional forwarding requires at least one tag value")
 	})
 
+	t.Run("expression conditional forwarding", func(t *testing.T) {
+		testObj := testPipeline.DeepCopy()
+		testObj.Spec.Edges[1].Conditions = &dfv1.ForwardConditions{Expression: `json(payload).amount > 100`}
+		assert.NoError(t, ValidatePipeline(testObj))
+		testObj.Spec.Edges[1].Conditions.Expression = `json(payload).amount >`
+		err := ValidatePipeline(testObj)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "invalid edge: invalid expression condition")
+	})
+
+	t.Run("expression conditional forwarding on rust runtime", func(t *testing.T) {
+		testObj := testPipeline.DeepCopy()
+		testObj.Spec.Edges[1].Conditions = &dfv1.ForwardConditions{Expression: `json(payload).amount > 100`}
+		testObj.Spec.Templates = &dfv1.Templates{VertexTemplate: &dfv1.VertexTemplate{
+			ContainerTemplate: &dfv1.ContainerTemplate{Env: []corev1.EnvVar{{Name: dfv1.EnvNumaflowRuntime, Value: "rust"}}},
+		}}
+		err := ValidatePipeline(testObj)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "is not supported by the Rust runtime")
+		// the vertex overrides the runtime of the template
+		for i := range testObj.Spec.Vertices {
+			testObj.Spec.Vertices[i].ContainerTemplate = &dfv1.ContainerTemplate{Env: []corev1.EnvVar{{Name: dfv1.EnvNumaflowRuntime, Value: "golang"}}}
+		}
+		assert.NoError(t, ValidatePipeline(testObj))
+	})
+
+	t.Run("http durable ack on rust runtime", func(t *testing.T) {
+		testObj := testPipeline.DeepCopy()
+		testObj.Spec.Vertices[0].Source = &dfv1.Source{HTTP: &dfv1.HTTPSource{DurableAck: true}}
+		assert.NoError(t, ValidatePipeline(testObj))
+		testObj.Spec.Vertices[0].ContainerTemplate = &dfv1.ContainerTemplate{Env: []corev1.EnvVar{{Name: dfv1.EnvNumaflowRuntime, Value: "rust"}}}
+		err := ValidatePipeline(testObj)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), `"http.durableAck" is not supported by the Rust runtime`)
+	})
+
+	t.Run("source dedup on rust runtime", func(t *testing.T) {
+		testObj := testPipeline.DeepCopy()
+		testObj.Spec.Vertices[0].Source = &dfv1.Source{HTTP: &dfv1.HTTPSource{}, Dedup: &dfv1.DedupWindow{Duration: metav1.Duration{Duration: time.Minute}}}
+		assert.NoError(t, ValidatePipeline(testObj))
+		testObj.Spec.Vertices[0].ContainerTemplate = &dfv1.ContainerTemplate{Env: []corev1.EnvVar{{Name: dfv1.EnvNumaflowRuntime, Value: "rust"}}}
+		err := ValidatePipeline(testObj)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), `"source.dedup" is not supported by the Rust runtime`)
+	})
+
 	t.Run("allow conditional forwarding from source vertex or udf vertex", func(t *testing.T) {
 		testObj := testPipeline.DeepCopy()
 		operatorOr := dfv1.LogicOperatorOr
@@ -590,6 +636,136 @@ func TestValidateReducePipeline(t *testing.T) {
 		assert.Contains(t, err.Error(), "a customized image is required")
 	})
 
+	t.Run("test hot key salting", func(t *testing.T) {
+		testObj := testReducePipeline.DeepCopy()
+		testObj.Spec.Edges[1].HotKeySalting = &dfv1.HotKeySalting{}
+		// the partial results of p2 are not combined by a keyed reduce vertex
+		err := ValidatePipeline(testObj)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), `hot key salting requires the partial results of "p2" to be combined by a downstream keyed reduce vertex without hot key salting, "p3" is not`)
+		testObj.Spec.Vertices[3].UDF.GroupBy.Keyed = true
+		assert.NoError(t, ValidatePipeline(testObj))
+		// the messages are salted by the vertex which forwards them
+		testObj.Spec.Vertices[1].ContainerTemplate = &dfv1.ContainerTemplate{Env: []corev1.EnvVar{{Name: dfv1.EnvNumaflowRuntime, Value: "rust"}}}
+		err = ValidatePipeline(testObj)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), `hot key salting of the edge from "p1" to "p2" is not supported by the Rust runtime`)
+		testObj.Spec.Vertices[1].ContainerTemplate = nil
+		testObj.Spec.Edges[1].HotKeySalting = nil
+		testObj.Spec.Edges[2].HotKeySalting = &dfv1.HotKeySalting{}
+		err = ValidatePipeline(testObj)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), `hot key salting is only supported when the to vertex "p3" is a keyed reduce vertex with more than one partition`)
+	})
+
+	t.Run("test count and global windows on rust runtime", func(t *testing.T) {
+		for _, window := range []dfv1.Window{
+			{Count: &dfv1.CountWindow{Count: 10}},
+			{Global: &dfv1.GlobalWindow{Trigger: &dfv1.WindowTrigger{Count: ptr.To[int32](10)}}},
+		} {
+			testObj := testReducePipeline.DeepCopy()
+			testObj.Spec.Vertices[2].UDF.GroupBy.Window = window
+			assert.NoError(t, ValidatePipeline(testObj))
+			testObj.Spec.Vertices[2].ContainerTemplate = &dfv1.ContainerTemplate{Env: []corev1.EnvVar{{Name: dfv1.EnvNumaflowRuntime, Value: "rust"}}}
+			err := ValidatePipeline(testObj)
+			assert.Error(t, err)
+			assert.Contains(t, err.Error(), `invalid vertex "p2", count and global windows are not supported by the Rust runtime`)
+		}
+	})
+
+	t.Run("test late data on rust runtime", func(t *testing.T) {
+		testObj := testReducePipeline.DeepCopy()
+		testObj.Spec.Vertices[4].UDF.GroupBy.LateData = &dfv1.LateDataOutput{ToVertex: "output"}
+		assert.NoError(t, ValidatePipeline(testObj))
+		testObj.Spec.Vertices[4].ContainerTemplate = &dfv1.ContainerTemplate{Env: []corev1.EnvVar{{Name: dfv1.EnvNumaflowRuntime, Value: "rust"}}}
+		err := ValidatePipeline(testObj)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), `"groupBy.lateData" is not supported by the Rust runtime`)
+	})
+
+	t.Run("test firing triggers on rust runtime", func(t *testing.T) {
+		testObj := testReducePipeline.DeepCopy()
+		testObj.Spec.Vertices[2].UDF.GroupBy.AllowedLateness = &metav1.Duration{Duration: time.Minute}
+		testObj.Spec.Vertices[2].UDF.GroupBy.LateFiring = true
+		assert.NoError(t, ValidatePipeline(testObj))
+		testObj.Spec.Vertices[2].ContainerTemplate = &dfv1.ContainerTemplate{Env: []corev1.EnvVar{{Name: dfv1.EnvNumaflowRuntime, Value: "rust"}}}
+		err := ValidatePipeline(testObj)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), `invalid vertex "p2", "earlyFiring" and "lateFiring" are not supported by the Rust runtime`)
+		testObj.Spec.Vertices[2].UDF.GroupBy.LateFiring = false
+		testObj.Spec.Vertices[2].UDF.GroupBy.EarlyFiring = &dfv1.EarlyFiring{Count: ptr.To[int32](10)}
+		err = ValidatePipeline(testObj)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), `"earlyFiring" and "lateFiring" are not supported by the Rust runtime`)
+	})
+
+	t.Run("test partitioning on rust runtime", func(t *testing.T) {
+		testObj := testReducePipeline.DeepCopy()
+		testObj.Spec.Edges[1].Partitioning = ptr.To(dfv1.PartitioningStrategyJumpHash)
+		assert.NoError(t, ValidatePipeline(testObj))
+		testObj.Spec.Vertices[1].ContainerTemplate = &dfv1.ContainerTemplate{Env: []corev1.EnvVar{{Name: dfv1.EnvNumaflowRuntime, Value: "rust"}}}
+		err := ValidatePipeline(testObj)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), `"jumpHash" partitioning of the edge from "p1" to "p2" is not supported by the Rust runtime`)
+	})
+
+	t.Run("test late data", func(t *testing.T) {
+		testObj := testReducePipeline.DeepCopy()
+		testObj.Spec.Vertices[4].UDF.GroupBy.LateData = &dfv1.LateDataOutput{ToVertex: "output"}
+		assert.NoError(t, ValidatePipeline(testObj))
+		testObj.Spec.Vertices[4].UDF.GroupBy.LateData.Fallback = true
+		err := ValidatePipeline(testObj)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), `late data can only be written to the fallback sink of a sink vertex with a fallback sink`)
+		testObj.Spec.Vertices[5].Sink.Fallback = &dfv1.AbstractSink{Blackhole: &dfv1.Blackhole{}}
+		assert.NoError(t, ValidatePipeline(testObj))
+		testObj.Spec.Vertices[4].UDF.GroupBy.LateData.ToVertex = "p1"
+		err = ValidatePipeline(testObj)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), `late data vertex "p1" is not connected to it with an edge`)
+	})
+
+	t.Run("test dead letter", func(t *testing.T) {
+		testObj := testPipeline.DeepCopy()
+		fallback := dfv1.OnFailureFallback
+		testObj.Spec.Vertices[1].UDF.RetryStrategy = &dfv1.RetryStrategy{OnFailure: &fallback}
+		err := ValidatePipeline(testObj)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), `steps in backoff strategy are required by the "fallback" OnFailure strategy`)
+		testObj.Spec.Vertices[1].UDF.RetryStrategy.BackOff = &dfv1.Backoff{Steps: ptr.To[uint32](3)}
+		err = ValidatePipeline(testObj)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), `given OnFailure strategy is fallback but "deadLetter" is not provided`)
+		testObj.Spec.Vertices[1].UDF.DeadLetter = &dfv1.DeadLetterOutput{ToVertex: "output"}
+		assert.NoError(t, ValidatePipeline(testObj))
+		testObj.Spec.Vertices[1].UDF.DeadLetter.ToVertex = "input"
+		err = ValidatePipeline(testObj)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), `dead letter vertex "input" is not connected to it with an edge`)
+		drop := dfv1.OnFailureDrop
+		testObj.Spec.Vertices[1].UDF.RetryStrategy.OnFailure = &drop
+		err = ValidatePipeline(testObj)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), `"deadLetter" requires the fallback OnFailure strategy`)
+		testObj.Spec.Vertices[1].UDF.DeadLetter = nil
+		assert.NoError(t, ValidatePipeline(testObj))
+		testObj.Spec.Vertices[1].ContainerTemplate = &dfv1.ContainerTemplate{Env: []corev1.EnvVar{{Name: dfv1.EnvNumaflowRuntime, Value: "rust"}}}
+		err = ValidatePipeline(testObj)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), `"retryStrategy" and "deadLetter" of the map UDF are not supported by the Rust runtime`)
+		testObj.Spec.Vertices[1].ContainerTemplate = nil
+		testObj.Spec.Vertices[1].UDF.RetryStrategy.BackOff.Factor = ptr.To[float64](0.5)
+		err = ValidatePipeline(testObj)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), `factor in backoff strategy cannot be less than 1`)
+
+		testObj = testReducePipeline.DeepCopy()
+		testObj.Spec.Vertices[4].UDF.RetryStrategy = &dfv1.RetryStrategy{}
+		err = ValidatePipeline(testObj)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), `"retryStrategy" and "deadLetter" are not supported by reduce vertices`)
+	})
+
 	t.Run("test partitions", func(t *testing.T) {
 		testObj := testReducePipeline.DeepCopy()
 		testObj.Spec.Vertices[0].Partitions = ptr.To[int32](2)
@@ -630,6 +806,54 @@ func TestValidateReducePipeline(t *testing.T) {
 		assert.Contains(t, err.Error(), `either emptyDir or persistentVolumeClaim is allowed, not both`)
 	})
 
+	t.Run("object store", func(t *testing.T) {
+		testObj := testReducePipeline.DeepCopy()
+		testObj.Spec.Vertices[1].UDF.GroupBy.Storage = &dfv1.PBQStorage{ObjectStore: &dfv1.ObjectStoreStorage{Bucket: "my-bucket"}}
+		assert.NoError(t, ValidatePipeline(testObj))
+		testObj.Spec.Vertices[1].ContainerTemplate = &dfv1.ContainerTemplate{Env: []corev1.EnvVar{{Name: dfv1.EnvNumaflowRuntime, Value: "rust"}}}
+		err := ValidatePipeline(testObj)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), `object store storage is not supported by the Rust runtime`)
+		testObj.Spec.Vertices[1].ContainerTemplate = nil
+		testObj.Spec.Vertices[1].UDF.GroupBy.Storage.EmptyDir = &corev1.EmptyDirVolumeSource{}
+		err = ValidatePipeline(testObj)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), `objectStore can not be used with other types of storage`)
+		testObj.Spec.Vertices[1].UDF.GroupBy.Storage = &dfv1.PBQStorage{ObjectStore: &dfv1.ObjectStoreStorage{}}
+		err = ValidatePipeline(testObj)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), `"bucket" is required`)
+		testObj.Spec.Vertices[4].UDF.GroupBy.Storage = &dfv1.PBQStorage{ObjectStore: &dfv1.ObjectStoreStorage{Bucket: "my-bucket"}}
+		testObj.Spec.Vertices[1].UDF.GroupBy.Storage.ObjectStore.Bucket = "my-bucket"
+		err = ValidatePipeline(testObj)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), `only fixed and sliding windows support object store`)
+	})
+
+	t.Run("blue green upgrade with unshared sources", func(t *testing.T) {
+		testObj := testPipeline.DeepCopy()
+		testObj.Spec.UpgradeStrategy = &dfv1.PipelineUpgradeStrategy{Type: dfv1.PipelineUpgradeStrategyBlueGreen}
+		testObj.Spec.Vertices[0].Source.Kafka = &dfv1.KafkaSource{Topic: "t", ConsumerGroupName: "g"}
+		assert.NoError(t, ValidatePipeline(testObj))
+		testObj.Spec.Vertices[0].Source.Kafka = nil
+		testObj.Spec.Vertices[0].Source.HTTP = &dfv1.HTTPSource{}
+		err := ValidatePipeline(testObj)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), `"http" source can not be shared with the shadow pipeline of the BlueGreen upgrade strategy`)
+		testObj.Spec.UpgradeStrategy = nil
+		assert.NoError(t, ValidatePipeline(testObj))
+	})
+
+	t.Run("callback delivery", func(t *testing.T) {
+		testObj := testPipeline.DeepCopy()
+		testObj.Spec.Vertices[1].ContainerTemplate = &dfv1.ContainerTemplate{Env: []corev1.EnvVar{{Name: dfv1.EnvCallbackOutboxDir, Value: "/var/numaflow/callback-outbox"}}}
+		assert.NoError(t, ValidatePipeline(testObj))
+		testObj.Spec.Templates = &dfv1.Templates{VertexTemplate: &dfv1.VertexTemplate{ContainerTemplate: &dfv1.ContainerTemplate{Env: []corev1.EnvVar{{Name: dfv1.EnvNumaflowRuntime, Value: "rust"}}}}}
+		err := ValidatePipeline(testObj)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), `environment variable "NUMAFLOW_CALLBACK_OUTBOX_DIR" is not supported by the Rust runtime`)
+	})
+
 }
 
 func TestValidateVertex(t *testing.T) {
@@ -671,6 +895,70 @@ func TestValidateVertex(t *testing.T) {
 		assert.Contains(t, err.Error(), "or equal to")
 	})
 
+	t.Run("scale policy", func(t *testing.T) {
+		v := dfv1.AbstractVertex{
+			Name: "my-vertex",
+			Scale: dfv1.Scale{
+				Policy: &dfv1.ScalePolicy{TargetLag: &dfv1.TargetLagScalePolicy{TargetLagSeconds: 10}},
+			},
+		}
+		assert.NoError(t, validateVertex(v))
+		v.Scale.Policy.CPU = &dfv1.CPUScalePolicy{TargetUtilization: 70}
+		err := validateVertex(v)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "exactly one of targetLag, cpu or predictive")
+		v.Scale.Policy = &dfv1.ScalePolicy{CPU: &dfv1.CPUScalePolicy{}}
+		err = validateVertex(v)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "cpu.targetUtilization should be greater than 0")
+		v.Scale.Policy = &dfv1.ScalePolicy{}
+		err = validateVertex(v)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "exactly one of targetLag, cpu or predictive")
+		v.Scale.Policy = &dfv1.ScalePolicy{Predictive: &dfv1.PredictiveScalePolicy{}}
+		assert.NoError(t, validateVertex(v))
+		v.Scale.Policy.Predictive.PeriodSeconds = ptr.To[uint32](300)
+		err = validateVertex(v)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "predictive.lookaheadSeconds should be less than predictive.periodSeconds")
+		v.Scale.Policy.Predictive.PeriodSeconds = ptr.To[uint32](0)
+		err = validateVertex(v)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "predictive.periodSeconds should be greater than 0")
+	})
+
+	t.Run("scale schedules", func(t *testing.T) {
+		v := dfv1.AbstractVertex{
+			Name: "my-vertex",
+			Scale: dfv1.Scale{
+				Max: ptr.To[int32](5),
+				Schedules: []dfv1.ScaleSchedule{
+					{Name: "peak", Start: "0 8 * * *", Duration: metav1.Duration{Duration: 10 * time.Hour}, TimeZone: "Europe/Berlin", Min: ptr.To[int32](10), Max: ptr.To[int32](20)},
+				},
+			},
+		}
+		assert.NoError(t, validateVertex(v))
+		v.Scale.Schedules[0].Max = nil
+		err := validateVertex(v)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), `schedule "peak": max number of replicas should be greater than or equal to min`)
+		v.Scale.Schedules[0].Max = ptr.To[int32](20)
+		v.Scale.Schedules[0].TimeZone = "Nowhere/Land"
+		err = validateVertex(v)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "invalid time zone")
+		v.Scale.Schedules[0].TimeZone = ""
+		v.Scale.Schedules = append(v.Scale.Schedules, v.Scale.Schedules[0])
+		err = validateVertex(v)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), `duplicate schedule name "peak"`)
+		v.Scale.Schedules = v.Scale.Schedules[:1]
+		v.Scale.Disabled = true
+		err = validateVertex(v)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "not supported when autoscaling is disabled")
+	})
+
 	t.Run("rollingUpdateStrategy - invalid maxUnavailable", func(t *testing.T) {
 		v := dfv1.AbstractVertex{
 			Name: "my-vertex",
@@ -788,6 +1076,123 @@ func TestValidateUDF(t *testing.T) {
 		assert.Error(t, err)
 		assert.Contains(t, err.Error(), `"timeout" is missing`)
 	})
+
+	t.Run("bad early and late firing", func(t *testing.T) {
+		udf := dfv1.UDF{
+			GroupBy: &dfv1.GroupBy{
+				Window: dfv1.Window{
+					Session: &dfv1.SessionWindow{Timeout: &metav1.Duration{Duration: time.Minute}},
+				},
+				EarlyFiring: &dfv1.EarlyFiring{Count: ptr.To[int32](100)},
+			},
+		}
+		err := validateUDF(udf)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), `only fixed and sliding windows support early firing`)
+
+		udf.GroupBy.Window = dfv1.Window{Fixed: &dfv1.FixedWindow{Length: &metav1.Duration{Duration: time.Minute}}}
+		udf.GroupBy.EarlyFiring.Count = nil
+		err = validateUDF(udf)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), `a positive "interval" or "count" is required`)
+
+		udf.GroupBy.EarlyFiring.Interval = &metav1.Duration{Duration: 10 * time.Second}
+		udf.GroupBy.LateFiring = true
+		err = validateUDF(udf)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), `"allowedLateness" is required`)
+	})
+
+	t.Run("bad count window", func(t *testing.T) {
+		udf := dfv1.UDF{
+			GroupBy: &dfv1.GroupBy{
+				Window: dfv1.Window{
+					Count: &dfv1.CountWindow{},
+				},
+			},
+		}
+		err := validateUDF(udf)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), `"count" should be greater than 0`)
+
+		udf.GroupBy.Window.Fixed = &dfv1.FixedWindow{Length: &metav1.Duration{Duration: time.Minute}}
+		udf.GroupBy.Window.Count.Count = 10
+		err = validateUDF(udf)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), `count or global can not be combined with any other windowing strategy`)
+	})
+
+	t.Run("bad global window trigger", func(t *testing.T) {
+		udf := dfv1.UDF{
+			GroupBy: &dfv1.GroupBy{
+				Window: dfv1.Window{
+					Global: &dfv1.GlobalWindow{},
+				},
+			},
+		}
+		err := validateUDF(udf)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), `"trigger" with "count" or "interval" is required`)
+
+		udf.GroupBy.Window.Global.Trigger = &dfv1.WindowTrigger{Count: ptr.To[int32](0)}
+		err = validateUDF(udf)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), `"count" should be greater than 0`)
+	})
+
+	t.Run("builtin function", func(t *testing.T) {
+		udf := dfv1.UDF{
+			Builtin: &dfv1.Function{
+				Name:   dfv1.BuiltinFunctionFilter,
+				KWArgs: map[string]string{"expression": `json(payload).amount > 100`},
+			},
+		}
+		assert.NoError(t, validateUDF(udf))
+
+		udf.Container = &dfv1.Container{Image: "my-image"}
+		err := validateUDF(udf)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "only one of 'container' and 'builtin' can be specified")
+		udf.Container = nil
+
+		udf.Builtin.KWArgs["expression"] = `payload ==`
+		err = validateUDF(udf)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), `invalid kwarg "expression"`)
+
+		udf.Builtin.KWArgs = map[string]string{"expr": `true`}
+		err = validateUDF(udf)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), `unknown kwarg "expr"`)
+
+		udf.Builtin.KWArgs = nil
+		err = validateUDF(udf)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), `kwarg "expression" is required`)
+
+		udf.Builtin = &dfv1.Function{Name: dfv1.BuiltinFunctionProject}
+		err = validateUDF(udf)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), `at least one of the kwargs "key" and "value" is required`)
+		udf.Builtin.KWArgs = map[string]string{"key": `json(payload).customer`}
+		assert.NoError(t, validateUDF(udf))
+
+		udf.Builtin = &dfv1.Function{Name: dfv1.BuiltinFunctionEventTime, KWArgs: map[string]string{"expression": `json(payload).time`, "format": "2006-01-02"}}
+		assert.NoError(t, validateUDF(udf))
+
+		udf.Builtin = &dfv1.Function{Name: dfv1.BuiltinFunctionCat}
+		assert.NoError(t, validateUDF(udf))
+
+		udf.Builtin = &dfv1.Function{Name: "dog"}
+		err = validateUDF(udf)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), `unrecognized built-in function "dog"`)
+
+		udf.GroupBy = &dfv1.GroupBy{Window: dfv1.Window{Fixed: &dfv1.FixedWindow{Length: &metav1.Duration{Duration: time.Minute}}}}
+		err = validateUDF(udf)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "built-in functions are not supported by reduce vertices")
+	})
 }
 
 func Test_validateSideInputs(t *testing.T) {
@@ -821,8 +1226,33 @@ func Test_validateSideInputs(t *testing.T) {
 	testObj.Spec.SideInputs[0].Trigger = &dfv1.SideInputTrigger{}
 	err = validateSideInputs(*testObj)
 	assert.Error(t, err)
-	assert.Contains(t, err.Error(), `schedule is required`)
+	assert.Contains(t, err.Error(), `either schedule or watch is required`)
 
+	testObj.Spec.SideInputs[0].Trigger.Watch = &dfv1.SideInputWatch{ConfigMaps: []string{""}}
+	err = validateSideInputs(*testObj)
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), `watched ConfigMap or Secret name is empty`)
+
+	testObj.Spec.SideInputs[0].Trigger.Watch.ConfigMaps = []string{"my-config"}
+	err = validateSideInputs(*testObj)
+	assert.NoError(t, err)
+
+	testObj.Spec.SideInputs[0].RetainedVersions = ptr.To[int32](0)
+	err = validateSideInputs(*testObj)
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), `retainedVersions must be greater than 0`)
+
+	testObj.Spec.SideInputs[0].RetainedVersions = ptr.To[int32](3)
+	testObj.Spec.SideInputs[0].PinnedVersion = ptr.To[int64](0)
+	err = validateSideInputs(*testObj)
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), `pinnedVersion must be greater than 0`)
+
+	testObj.Spec.SideInputs[0].PinnedVersion = ptr.To[int64](2)
+	err = validateSideInputs(*testObj)
+	assert.NoError(t, err)
+
+	testObj.Spec.SideInputs[0].Trigger.Watch = nil
 	testObj.Spec.SideInputs[0].Trigger.Schedule = "@every 200s"
 	testObj.Spec.SideInputs = append(testObj.Spec.SideInputs, dfv1.SideInput{
 		Name: "s1",
@@ -1127,6 +1557,31 @@ func Test_validateIdleSource(t *testing.T) {
 }
 
 // TestValidateSink tests the validateSink function with different sink configurations.
+func TestValidateSourceDedup(t *testing.T) {
+	window := &dfv1.DedupWindow{Duration: metav1.Duration{Duration: time.Minute}}
+	t.Run("http source", func(t *testing.T) {
+		assert.NoError(t, validateSource(dfv1.Source{HTTP: &dfv1.HTTPSource{}, Dedup: window}))
+	})
+	t.Run("jetstream source", func(t *testing.T) {
+		assert.NoError(t, validateSource(dfv1.Source{JetStream: &dfv1.JetStreamSource{}, Dedup: window}))
+	})
+	t.Run("unsupported source", func(t *testing.T) {
+		err := validateSource(dfv1.Source{Generator: &dfv1.GeneratorSource{}, Dedup: window})
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "only supported by 'http' and 'jetstream'")
+	})
+	t.Run("invalid duration", func(t *testing.T) {
+		err := validateSource(dfv1.Source{HTTP: &dfv1.HTTPSource{}, Dedup: &dfv1.DedupWindow{}})
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "duration must be greater than 0")
+	})
+	t.Run("invalid max entries", func(t *testing.T) {
+		err := validateSource(dfv1.Source{HTTP: &dfv1.HTTPSource{}, Dedup: &dfv1.DedupWindow{Duration: window.Duration, MaxEntries: ptr.To[int32](0)}})
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "maxEntries must be greater than 0")
+	})
+}
+
 func TestValidateSink(t *testing.T) {
 	onFailFallback := dfv1.OnFailureFallback
 	tests := []struct {
@@ -21,5 +21,9 @@ import (
 )
 
 func ValidateServingPipeline(spl *dfv1.ServingPipeline) error {
+	// the vertices of a serving pipeline always run on the Rust runtime
+	if err := validateGoRuntimeFeatures(spl.Spec.Pipeline, func(dfv1.AbstractVertex) bool { return true }); err != nil {
+		return err
+	}
 	return nil
 }
@@ -15,3 +15,45 @@ limitations under the License.
 */
 
 package validator
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+
+	dfv1 "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1"
+)
+
+func TestValidateServingPipeline(t *testing.T) {
+	spl := &dfv1.ServingPipeline{Spec: dfv1.ServingPipelineSpec{Pipeline: testPipeline.Spec}}
+	assert.NoError(t, ValidateServingPipeline(spl.DeepCopy()))
+
+	t.Run("expression conditional forwarding", func(t *testing.T) {
+		testObj := spl.DeepCopy()
+		testObj.Spec.Pipeline.Edges[1].Conditions = &dfv1.ForwardConditions{Expression: `json(payload).amount > 100`}
+		err := ValidateServingPipeline(testObj)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "is not supported by the Rust runtime")
+	})
+
+	t.Run("builtin function", func(t *testing.T) {
+		testObj := spl.DeepCopy()
+		for i, v := range testObj.Spec.Pipeline.Vertices {
+			if v.UDF != nil {
+				testObj.Spec.Pipeline.Vertices[i].UDF = &dfv1.UDF{Builtin: &dfv1.Function{Name: dfv1.BuiltinFunctionCat}}
+			}
+		}
+		err := ValidateServingPipeline(testObj)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "built-in functions are not supported by the Rust runtime")
+	})
+
+	t.Run("callback signing key", func(t *testing.T) {
+		testObj := spl.DeepCopy()
+		testObj.Spec.Pipeline.Vertices[0].ContainerTemplate = &dfv1.ContainerTemplate{Env: []corev1.EnvVar{{Name: dfv1.EnvCallbackSigningKey, Value: "key"}}}
+		err := ValidateServingPipeline(testObj)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), `environment variable "NUMAFLOW_CALLBACK_SIGNING_KEY" is not supported by the Rust runtime`)
+	})
+}
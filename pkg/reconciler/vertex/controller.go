@@ -199,6 +199,10 @@ func (r *vertexReconciler) reconcile(ctx context.Context, vertex *dfv1.Vertex) (
 		vertex.Status.MarkPodNotHealthy(reason, msg)
 	}
 
+	// Requeue when a scale schedule starts or ends, so that the replicas are adjusted in time.
+	if next := vertex.Spec.Scale.GetNextScheduleTransition(time.Now()); !next.IsZero() && !vertex.Spec.Scale.Disabled {
+		return ctrl.Result{RequeueAfter: time.Until(next)}, nil
+	}
 	return ctrl.Result{}, nil
 }
 
@@ -206,13 +210,20 @@ func (r *vertexReconciler) orchestratePods(ctx context.Context, vertex *dfv1.Ver
 	log := logging.FromContext(ctx)
 	desiredReplicas := vertex.CalculateReplicas()
 	vertex.Status.DesiredReplicas = uint32(desiredReplicas)
+	vertex.Status.ActiveScaleSchedule = ""
+	if as := vertex.Spec.Scale.GetActiveSchedule(time.Now()); as != nil && !vertex.Spec.Scale.Disabled {
+		vertex.Status.ActiveScaleSchedule = as.Name
+	}
+	if vertex.Spec.Scale.Disabled || vertex.Spec.Scale.GetPolicyType() != dfv1.ScalePolicyTypePredictive {
+		vertex.Status.ForecastUnavailableReason = ""
+	}
 
 	// Set metrics
 	defer func() {
 		reconciler.VertexDesiredReplicas.WithLabelValues(vertex.Namespace, vertex.Spec.PipelineName, vertex.Spec.Name).Set(float64(desiredReplicas))
 		reconciler.VertexCurrentReplicas.WithLabelValues(vertex.Namespace, vertex.Spec.PipelineName, vertex.Spec.Name).Set(float64(vertex.Status.Replicas))
-		reconciler.VertexMinReplicas.WithLabelValues(vertex.Namespace, vertex.Spec.PipelineName, vertex.Spec.Name).Set(float64(vertex.Spec.Scale.GetMinReplicas()))
-		reconciler.VertexMaxReplicas.WithLabelValues(vertex.Namespace, vertex.Spec.PipelineName, vertex.Spec.Name).Set(float64(vertex.Spec.Scale.GetMaxReplicas()))
+		reconciler.VertexMinReplicas.WithLabelValues(vertex.Namespace, vertex.Spec.PipelineName, vertex.Spec.Name).Set(float64(vertex.Spec.Scale.GetEffectiveMinReplicas(time.Now())))
+		reconciler.VertexMaxReplicas.WithLabelValues(vertex.Namespace, vertex.Spec.PipelineName, vertex.Spec.Name).Set(float64(vertex.Spec.Scale.GetEffectiveMaxReplicas(time.Now())))
 	}()
 
 	// Build pod spec of the 1st replica to calculate the hash, which is used to determine whether the pod spec is changed
@@ -367,7 +378,7 @@ func (r *vertexReconciler) orchestratePodsFromTo(ctx context.Context, vertex *df
 			labels[dfv1.KeyVertexName] = vertex.Spec.Name
 			annotations[dfv1.KeyHash] = newHash
 			annotations[dfv1.KeyReplica] = strconv.Itoa(replica)
-			if vertex.IsMapUDF() || vertex.IsReduceUDF() {
+			if (vertex.IsMapUDF() || vertex.IsReduceUDF()) && vertex.Spec.UDF.Builtin == nil {
 				annotations[dfv1.KeyDefaultContainer] = dfv1.CtrUdf
 			} else if vertex.IsUDSink() {
 				annotations[dfv1.KeyDefaultContainer] = dfv1.CtrUdsink
@@ -552,7 +563,7 @@ func (r *vertexReconciler) buildPodSpec(vertex *dfv1.Vertex, pl *dfv1.Pipeline,
 	podSpec.Volumes = append(podSpec.Volumes, vols...)
 	podSpec.Containers[0].VolumeMounts = append(podSpec.Containers[0].VolumeMounts, volMounts...)
 
-	if vertex.IsReduceUDF() && vertex.Spec.UDF.GroupBy.Storage.NoStore == nil {
+	if vertex.IsReduceUDF() && vertex.Spec.UDF.GroupBy.Storage.NoStore == nil && vertex.Spec.UDF.GroupBy.Storage.ObjectStore == nil {
 		// Add pvc for reduce vertex pods
 		storage := vertex.Spec.UDF.GroupBy.Storage
 		volName := "pbq-vol"
@@ -578,6 +578,36 @@ func Test_reconcile(t *testing.T) {
 		assert.Equal(t, 3, len(pods.Items[0].Spec.InitContainers))
 	})
 
+	t.Run("test reconcile udf with scale schedules", func(t *testing.T) {
+		cl := fake.NewClientBuilder().Build()
+		ctx := context.TODO()
+		testIsbSvc := testNativeRedisIsbSvc.DeepCopy()
+		testIsbSvc.Status.MarkConfigured()
+		testIsbSvc.Status.MarkDeployed()
+		err := cl.Create(ctx, testIsbSvc)
+		assert.Nil(t, err)
+		testPl := testPipeline.DeepCopy()
+		err = cl.Create(ctx, testPl)
+		assert.Nil(t, err)
+		r := fakeReconciler(t, cl)
+		testObj := testVertex.DeepCopy()
+		testObj.Spec.UDF = &dfv1.UDF{
+			Container: &dfv1.Container{
+				Image: "my-image",
+			},
+		}
+		testObj.Spec.Scale.Schedules = []dfv1.ScaleSchedule{
+			{Name: "always", Start: "* * * * *", Duration: metav1.Duration{Duration: 2 * time.Minute}, Min: ptr.To[int32](3)},
+		}
+		testObj.Status.ForecastUnavailableReason = "stale"
+		result, err := r.reconcile(ctx, testObj)
+		assert.NoError(t, err)
+		assert.Equal(t, "always", testObj.Status.ActiveScaleSchedule)
+		assert.Empty(t, testObj.Status.ForecastUnavailableReason)
+		assert.Equal(t, uint32(3), testObj.Status.DesiredReplicas)
+		assert.True(t, result.RequeueAfter > 0 && result.RequeueAfter <= time.Minute)
+	})
+
 	t.Run("test reconcile reduce udf", func(t *testing.T) {
 		cl := fake.NewClientBuilder().Build()
 		ctx := context.TODO()
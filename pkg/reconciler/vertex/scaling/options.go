@@ -16,6 +16,10 @@ limitations under the License.
 
 package scaling
 
+import (
+	metricsclientv1beta1 "k8s.io/metrics/pkg/client/clientset/versioned/typed/metrics/v1beta1"
+)
+
 type options struct {
 	// Number of workers working on autoscaling.
 	workers int
@@ -25,6 +29,8 @@ type options struct {
 	backPressureThreshold float64
 	// size of the daemon clients cache.
 	clientsCacheSize int
+	// Client to get the pod metrics, used by the CPU scale policy.
+	podMetricsGetter metricsclientv1beta1.PodMetricsesGetter
 }
 
 type Option func(*options)
@@ -65,3 +71,10 @@ func WithClientsCacheSize(n int) Option {
 		o.clientsCacheSize = n
 	}
 }
+
+// WithPodMetricsGetter sets the client to get the pod metrics, which is required by the CPU scale policy.
+func WithPodMetricsGetter(g metricsclientv1beta1.PodMetricsesGetter) Option {
+	return func(o *options) {
+		o.podMetricsGetter = g
+	}
+}
@@ -0,0 +1,424 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package scaling
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math"
+	"sync"
+	"time"
+
+	lru "github.com/hashicorp/golang-lru/v2"
+	"go.uber.org/zap"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/types"
+	metricsclientv1beta1 "k8s.io/metrics/pkg/client/clientset/versioned/typed/metrics/v1beta1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	dfv1 "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1"
+	"github.com/numaproj/numaflow/pkg/shared/logging"
+)
+
+// cpuTolerance is the ratio of the CPU utilization to the target within which no scaling is needed.
+const cpuTolerance = 0.1
+
+// vertexMetrics contains the partition level metrics of a vertex used to calculate the desired replicas.
+type vertexMetrics struct {
+	// Processing rates with the lookback seconds defined in the scale spec.
+	partitionRates   []float64
+	partitionPending []int64
+	// Buffer lengths and target available buffer lengths, only available for UDF and Sink vertices.
+	partitionBufferLengths          []int64
+	partitionAvailableBufferLengths []int64
+}
+
+// scalingPolicy calculates the desired replicas of a vertex.
+type scalingPolicy interface {
+	desiredReplicas(ctx context.Context, vertex *dfv1.Vertex, m *vertexMetrics) (int32, error)
+}
+
+// policyOf returns the scaling policy defined in the scale spec of the vertex.
+func (s *Scaler) policyOf(vertex *dfv1.Vertex) scalingPolicy {
+	p := vertex.Spec.Scale.Policy
+	switch p.GetType() {
+	case dfv1.ScalePolicyTypeTargetLag:
+		return &targetLagPolicy{targetLagSeconds: float64(p.TargetLag.TargetLagSeconds)}
+	case dfv1.ScalePolicyTypeCPU:
+		return &cpuPolicy{client: s.client, podMetrics: s.options.podMetricsGetter, targetUtilization: p.CPU.GetTargetUtilization()}
+	case dfv1.ScalePolicyTypePredictive:
+		return &predictivePolicy{
+			base:             &defaultPolicy{scaler: s},
+			client:           s.client,
+			histories:        s.rateHistories,
+			lookaheadSeconds: float64(p.Predictive.GetLookaheadSeconds()),
+			periodSeconds:    float64(p.Predictive.GetPeriodSeconds()),
+			now:              time.Now,
+		}
+	default:
+		return &defaultPolicy{scaler: s}
+	}
+}
+
+// defaultPolicy uses the pending messages and processing rate for source vertices,
+// and the buffer availability for UDF and Sink vertices.
+type defaultPolicy struct {
+	scaler *Scaler
+}
+
+func (p *defaultPolicy) desiredReplicas(ctx context.Context, vertex *dfv1.Vertex, m *vertexMetrics) (int32, error) {
+	return p.scaler.desiredReplicas(ctx, vertex, m.partitionRates, m.partitionPending, m.partitionBufferLengths, m.partitionAvailableBufferLengths), nil
+}
+
+// targetLagPolicy calculates the desired replicas to get the pending messages processed within the target lag seconds,
+// for any kind of vertices.
+//
+//	desiredReplicas = readyReplicas * (pending / rate) / targetLagSeconds
+type targetLagPolicy struct {
+	targetLagSeconds float64
+}
+
+func (p *targetLagPolicy) desiredReplicas(_ context.Context, vertex *dfv1.Vertex, m *vertexMetrics) (int32, error) {
+	if p.targetLagSeconds <= 0 {
+		return 0, fmt.Errorf("invalid target lag seconds %v", p.targetLagSeconds)
+	}
+	maxDesired := int32(1)
+	for i := 0; i < len(m.partitionPending); i++ {
+		rate := m.partitionRates[i]
+		pending := m.partitionPending[i]
+		if pending == 0 || rate == 0 {
+			continue
+		}
+		desired := int32(math.Round((float64(pending) / rate) / p.targetLagSeconds * float64(vertex.Status.ReadyReplicas)))
+		if desired > int32(pending) { // Do not scale up to more than pending.
+			desired = int32(pending)
+		}
+		maxDesired = max(maxDesired, desired)
+	}
+	return maxDesired, nil
+}
+
+// cpuPolicy calculates the desired replicas to keep the average CPU utilization of the pods at the target level.
+//
+//	desiredReplicas = ceil(podsWithMetrics * utilization / targetUtilization)
+type cpuPolicy struct {
+	client            client.Client
+	podMetrics        metricsclientv1beta1.PodMetricsesGetter
+	targetUtilization float64
+}
+
+func (p *cpuPolicy) desiredReplicas(ctx context.Context, vertex *dfv1.Vertex, _ *vertexMetrics) (int32, error) {
+	if p.podMetrics == nil {
+		return 0, fmt.Errorf("pod metrics client is not configured")
+	}
+	if p.targetUtilization <= 0 {
+		return 0, fmt.Errorf("invalid target CPU utilization %v", p.targetUtilization)
+	}
+	selector := labels.SelectorFromSet(map[string]string{
+		dfv1.KeyComponent:    dfv1.ComponentVertex,
+		dfv1.KeyPipelineName: vertex.Spec.PipelineName,
+		dfv1.KeyVertexName:   vertex.Spec.Name,
+	})
+	pods := &corev1.PodList{}
+	if err := p.client.List(ctx, pods, &client.ListOptions{Namespace: vertex.Namespace, LabelSelector: selector}); err != nil {
+		return 0, fmt.Errorf("failed to list pods of vertex %q, %w", vertex.Name, err)
+	}
+	podMetricsList, err := p.podMetrics.PodMetricses(vertex.Namespace).List(ctx, metav1.ListOptions{LabelSelector: selector.String()})
+	if err != nil {
+		return 0, fmt.Errorf("failed to list pod metrics of vertex %q, %w", vertex.Name, err)
+	}
+	usages := make(map[string]map[string]int64, len(podMetricsList.Items))
+	for _, pm := range podMetricsList.Items {
+		usages[pm.Name] = make(map[string]int64, len(pm.Containers))
+		for _, c := range pm.Containers {
+			usages[pm.Name][c.Name] = c.Usage.Cpu().MilliValue()
+		}
+	}
+	var totalUsage, totalRequests int64
+	var podsWithMetrics int
+	for _, pod := range pods.Items {
+		containerUsages, ok := usages[pod.Name]
+		if !ok || pod.Status.Phase != corev1.PodRunning {
+			continue
+		}
+		// the usage and the requests are summed over the same containers, including the sidecars, which are the
+		// init containers restarted always
+		var usage, requests int64
+		containers := append([]corev1.Container(nil), pod.Spec.Containers...)
+		for _, c := range pod.Spec.InitContainers {
+			if c.RestartPolicy != nil && *c.RestartPolicy == corev1.ContainerRestartPolicyAlways {
+				containers = append(containers, c)
+			}
+		}
+		for _, c := range containers {
+			usage += containerUsages[c.Name]
+			requests += c.Resources.Requests.Cpu().MilliValue()
+		}
+		if requests == 0 {
+			return 0, fmt.Errorf("cpu requests are not set for the containers of pod %q", pod.Name)
+		}
+		totalUsage += usage
+		totalRequests += requests
+		podsWithMetrics++
+	}
+	if podsWithMetrics == 0 {
+		return 0, fmt.Errorf("no pod metrics available for vertex %q", vertex.Name)
+	}
+	ratio := float64(totalUsage) / float64(totalRequests) / p.targetUtilization
+	if math.Abs(ratio-1) <= cpuTolerance {
+		return int32(vertex.Status.Replicas), nil
+	}
+	return max(int32(1), int32(math.Ceil(float64(podsWithMetrics)*ratio))), nil
+}
+
+// predictivePolicy scales the vertex up ahead of the periodic load.
+//
+// The processing rates of the vertex are recorded in one-minute slots for a period. The rate in the lookahead seconds
+// is forecast with the max rate observed in the same time range of the previous period. When the forecast rate is
+// higher than the current one, the desired replicas calculated by the base policy are increased proportionally,
+// otherwise the base policy decides. The base policy also decides until the rates of a full period have been recorded,
+// the reason is reported in the vertex status.
+//
+// The history is cached in memory, and persisted to a ConfigMap owned by the vertex every time a new slot starts,
+// so that it survives the restarts of the controller.
+type predictivePolicy struct {
+	base             scalingPolicy
+	client           client.Client
+	histories        *lru.Cache[string, *rateHistory]
+	lookaheadSeconds float64
+	periodSeconds    float64
+	now              func() time.Time
+}
+
+func (p *predictivePolicy) desiredReplicas(ctx context.Context, vertex *dfv1.Vertex, m *vertexMetrics) (int32, error) {
+	log := logging.FromContext(ctx)
+	desired, err := p.base.desiredReplicas(ctx, vertex, m)
+	if err != nil {
+		return 0, err
+	}
+	history, err := p.historyOf(ctx, vertex)
+	if err != nil {
+		return 0, err
+	}
+	now := p.now()
+	period := time.Duration(p.periodSeconds) * time.Second
+	if history.record(now, m.partitionRates, period) {
+		// At most the rates of the last slot are lost when the controller restarts.
+		if err := p.saveHistory(ctx, vertex, history); err != nil {
+			log.Warnw("Failed to persist the rate history of the vertex", zap.String("vertex", vertex.Name), zap.Error(err))
+		}
+	}
+	from := now.Add(-period)
+	forecast, ok := history.maxRates(from, from.Add(time.Duration(p.lookaheadSeconds)*time.Second))
+	var reason string
+	if !ok {
+		if since := history.since(); since.After(from) {
+			reason = fmt.Sprintf("The processing rates are recorded since %s, a full period of %s is needed to forecast.", since.UTC().Format(time.RFC3339), period)
+		} else {
+			reason = "No processing rates are recorded in the lookahead seconds of the previous period."
+		}
+	}
+	if err := p.reportForecast(ctx, vertex, reason); err != nil {
+		log.Warnw("Failed to report the forecast availability of the vertex", zap.String("vertex", vertex.Name), zap.Error(err))
+	}
+	if !ok {
+		return desired, nil
+	}
+	maxFactor := float64(1)
+	for i := 0; i < len(m.partitionRates) && i < len(forecast); i++ {
+		if m.partitionRates[i] <= 0 {
+			continue
+		}
+		maxFactor = max(maxFactor, forecast[i]/m.partitionRates[i])
+	}
+	return int32(math.Ceil(float64(desired) * maxFactor)), nil
+}
+
+// historyOf returns the rate history of the vertex, it's loaded from the ConfigMap it's persisted to when not cached.
+func (p *predictivePolicy) historyOf(ctx context.Context, vertex *dfv1.Vertex) (*rateHistory, error) {
+	key := KeyOfVertex(*vertex)
+	if history, ok := p.histories.Get(key); ok {
+		return history, nil
+	}
+	history := newRateHistory()
+	cm := &corev1.ConfigMap{}
+	if err := p.client.Get(ctx, client.ObjectKey{Namespace: vertex.Namespace, Name: rateHistoryConfigMapName(vertex)}, cm); err != nil {
+		if !apierrors.IsNotFound(err) {
+			return nil, fmt.Errorf("failed to get the rate history of vertex %q, %w", vertex.Name, err)
+		}
+	} else if err := json.Unmarshal([]byte(cm.Data[rateHistoryConfigMapKey]), history); err != nil {
+		logging.FromContext(ctx).Warnw("Invalid rate history of the vertex, starting over", zap.String("vertex", vertex.Name), zap.Error(err))
+		history = newRateHistory()
+	}
+	p.histories.Add(key, history)
+	return history, nil
+}
+
+// saveHistory persists the rate history of the vertex to a ConfigMap owned by the vertex.
+func (p *predictivePolicy) saveHistory(ctx context.Context, vertex *dfv1.Vertex, history *rateHistory) error {
+	data, err := json.Marshal(history)
+	if err != nil {
+		return fmt.Errorf("failed to marshal the rate history, %w", err)
+	}
+	cm := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: vertex.Namespace,
+			Name:      rateHistoryConfigMapName(vertex),
+			Labels: map[string]string{
+				dfv1.KeyManagedBy:    dfv1.ControllerVertex,
+				dfv1.KeyPipelineName: vertex.Spec.PipelineName,
+				dfv1.KeyVertexName:   vertex.Spec.Name,
+			},
+			OwnerReferences: []metav1.OwnerReference{*metav1.NewControllerRef(vertex.GetObjectMeta(), dfv1.VertexGroupVersionKind)},
+		},
+		Data: map[string]string{rateHistoryConfigMapKey: string(data)},
+	}
+	if err := p.client.Update(ctx, cm); err != nil {
+		if !apierrors.IsNotFound(err) {
+			return fmt.Errorf("failed to update the rate history ConfigMap, %w", err)
+		}
+		if err := p.client.Create(ctx, cm); err != nil {
+			return fmt.Errorf("failed to create the rate history ConfigMap, %w", err)
+		}
+	}
+	return nil
+}
+
+// reportForecast records the reason the forecast is not available in the vertex status, an empty reason clears it.
+func (p *predictivePolicy) reportForecast(ctx context.Context, vertex *dfv1.Vertex, reason string) error {
+	if vertex.Status.ForecastUnavailableReason == reason {
+		return nil
+	}
+	patchJson, err := json.Marshal(map[string]any{"status": map[string]any{"forecastUnavailableReason": reason}})
+	if err != nil {
+		return fmt.Errorf("failed to marshal the forecast status, %w", err)
+	}
+	if err := p.client.Status().Patch(ctx, vertex, client.RawPatch(types.MergePatchType, patchJson)); err != nil && !apierrors.IsNotFound(err) {
+		return fmt.Errorf("failed to patch vertex status with the forecast status, %w", err)
+	}
+	return nil
+}
+
+// rateHistoryConfigMapKey is the key of the rate history in the ConfigMap it's persisted to.
+const rateHistoryConfigMapKey = "rates"
+
+// rateHistoryConfigMapName returns the name of the ConfigMap the rate history of the vertex is persisted to.
+func rateHistoryConfigMapName(vertex *dfv1.Vertex) string {
+	return vertex.Name + "-rate-history"
+}
+
+// rateHistory keeps the processing rates of the partitions of a vertex in one-minute slots,
+// the max rate of each partition is kept in a slot.
+type rateHistory struct {
+	lock  sync.Mutex
+	slots map[int64][]float64
+	// oldest is the time the history started to be recorded.
+	oldest time.Time
+}
+
+// rateHistorySnapshot is the persisted form of a rateHistory.
+type rateHistorySnapshot struct {
+	Oldest time.Time           `json:"oldest"`
+	Slots  map[int64][]float64 `json:"slots"`
+}
+
+func newRateHistory() *rateHistory {
+	return &rateHistory{slots: make(map[int64][]float64)}
+}
+
+func (h *rateHistory) MarshalJSON() ([]byte, error) {
+	h.lock.Lock()
+	defer h.lock.Unlock()
+	return json.Marshal(rateHistorySnapshot{Oldest: h.oldest, Slots: h.slots})
+}
+
+func (h *rateHistory) UnmarshalJSON(data []byte) error {
+	snapshot := rateHistorySnapshot{}
+	if err := json.Unmarshal(data, &snapshot); err != nil {
+		return err
+	}
+	h.lock.Lock()
+	defer h.lock.Unlock()
+	h.oldest = snapshot.Oldest
+	h.slots = snapshot.Slots
+	if h.slots == nil {
+		h.slots = make(map[int64][]float64)
+	}
+	return nil
+}
+
+// record records the rates at the given time, the slots older than the retention are removed.
+// It returns true if the rates are recorded in a new slot.
+func (h *rateHistory) record(now time.Time, rates []float64, retention time.Duration) bool {
+	h.lock.Lock()
+	defer h.lock.Unlock()
+	if h.oldest.IsZero() {
+		h.oldest = now
+	}
+	slot := now.Unix() / 60
+	recorded, existing := h.slots[slot]
+	for i, rate := range rates {
+		if i < len(recorded) {
+			recorded[i] = max(recorded[i], rate)
+		} else {
+			recorded = append(recorded, rate)
+		}
+	}
+	h.slots[slot] = recorded
+	oldestSlot := now.Add(-retention).Unix()/60 - 1
+	for s := range h.slots {
+		if s < oldestSlot {
+			delete(h.slots, s)
+		}
+	}
+	if retentionStart := now.Add(-retention); h.oldest.Before(retentionStart) {
+		h.oldest = retentionStart
+	}
+	return !existing
+}
+
+// since returns the time the history started to be recorded, or the start of the retention if it's been longer.
+func (h *rateHistory) since() time.Time {
+	h.lock.Lock()
+	defer h.lock.Unlock()
+	return h.oldest
+}
+
+// maxRates returns the max rate of each partition recorded between from and to. It returns false if the history
+// doesn't cover the time range, or nothing is recorded in it.
+func (h *rateHistory) maxRates(from, to time.Time) ([]float64, bool) {
+	h.lock.Lock()
+	defer h.lock.Unlock()
+	if h.oldest.IsZero() || h.oldest.After(from) {
+		return nil, false
+	}
+	var result []float64
+	for s := from.Unix() / 60; s <= to.Unix()/60; s++ {
+		for i, rate := range h.slots[s] {
+			if i < len(result) {
+				result[i] = max(result[i], rate)
+			} else {
+				result = append(result, rate)
+			}
+		}
+	}
+	return result, len(result) > 0
+}
@@ -0,0 +1,274 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package scaling
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	k8stesting "k8s.io/client-go/testing"
+	metricsv1beta1 "k8s.io/metrics/pkg/apis/metrics/v1beta1"
+	metricsfake "k8s.io/metrics/pkg/client/clientset/versioned/fake"
+	metricsclientv1beta1 "k8s.io/metrics/pkg/client/clientset/versioned/typed/metrics/v1beta1"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	dfv1 "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1"
+)
+
+func Test_policyOf(t *testing.T) {
+	s := NewScaler(fake.NewClientBuilder().Build())
+	v := fakeVertex.DeepCopy()
+	assert.IsType(t, &defaultPolicy{}, s.policyOf(v))
+	v.Spec.Scale.Policy = &dfv1.ScalePolicy{TargetLag: &dfv1.TargetLagScalePolicy{TargetLagSeconds: 10}}
+	assert.IsType(t, &targetLagPolicy{}, s.policyOf(v))
+	v.Spec.Scale.Policy = &dfv1.ScalePolicy{CPU: &dfv1.CPUScalePolicy{TargetUtilization: 70}}
+	assert.IsType(t, &cpuPolicy{}, s.policyOf(v))
+	v.Spec.Scale.Policy = &dfv1.ScalePolicy{Predictive: &dfv1.PredictiveScalePolicy{}}
+	assert.IsType(t, &predictivePolicy{}, s.policyOf(v))
+}
+
+func Test_targetLagPolicy(t *testing.T) {
+	p := &targetLagPolicy{targetLagSeconds: 2}
+	udf := fakeVertex.DeepCopy()
+	udf.Spec.UDF = &dfv1.UDF{}
+	// lag is 4 seconds, 2 ready replicas
+	desired, err := p.desiredReplicas(context.TODO(), udf, &vertexMetrics{partitionRates: []float64{2500}, partitionPending: []int64{10000}})
+	assert.NoError(t, err)
+	assert.Equal(t, int32(4), desired)
+	// max of the partitions
+	desired, err = p.desiredReplicas(context.TODO(), udf, &vertexMetrics{partitionRates: []float64{2500, 1000}, partitionPending: []int64{10000, 10000}})
+	assert.NoError(t, err)
+	assert.Equal(t, int32(10), desired)
+	// no more than pending
+	desired, err = p.desiredReplicas(context.TODO(), udf, &vertexMetrics{partitionRates: []float64{0.1}, partitionPending: []int64{3}})
+	assert.NoError(t, err)
+	assert.Equal(t, int32(3), desired)
+	desired, err = p.desiredReplicas(context.TODO(), udf, &vertexMetrics{partitionRates: []float64{0}, partitionPending: []int64{0}})
+	assert.NoError(t, err)
+	assert.Equal(t, int32(1), desired)
+}
+
+func Test_cpuPolicy(t *testing.T) {
+	vertex := fakeVertex.DeepCopy()
+	vertex.Namespace = "default"
+	vertex.Spec.PipelineName = "pl"
+	vertex.Spec.Name = "v"
+	labels := map[string]string{
+		dfv1.KeyComponent:    dfv1.ComponentVertex,
+		dfv1.KeyPipelineName: "pl",
+		dfv1.KeyVertexName:   "v",
+	}
+	newPod := func(name string) *corev1.Pod {
+		return &corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "default", Labels: labels},
+			Spec: corev1.PodSpec{
+				Containers: []corev1.Container{{
+					Name:      dfv1.CtrMain,
+					Resources: corev1.ResourceRequirements{Requests: corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("500m")}},
+				}},
+			},
+			Status: corev1.PodStatus{Phase: corev1.PodRunning},
+		}
+	}
+	newPodMetrics := func(name, usage string) metricsv1beta1.PodMetrics {
+		return metricsv1beta1.PodMetrics{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "default", Labels: labels},
+			Containers: []metricsv1beta1.ContainerMetrics{{
+				Name:  dfv1.CtrMain,
+				Usage: corev1.ResourceList{corev1.ResourceCPU: resource.MustParse(usage)},
+			}},
+		}
+	}
+	newMetricsClient := func(podMetrics ...metricsv1beta1.PodMetrics) metricsclientv1beta1.PodMetricsesGetter {
+		c := metricsfake.NewSimpleClientset()
+		c.Fake.PrependReactor("list", "pods", func(action k8stesting.Action) (bool, runtime.Object, error) {
+			return true, &metricsv1beta1.PodMetricsList{Items: podMetrics}, nil
+		})
+		return c.MetricsV1beta1()
+	}
+	cl := fake.NewClientBuilder().WithObjects(newPod("p0"), newPod("p1"), newPod("p2")).Build()
+
+	t.Run("no metrics client", func(t *testing.T) {
+		p := &cpuPolicy{client: cl, targetUtilization: 0.5}
+		_, err := p.desiredReplicas(context.TODO(), vertex, nil)
+		assert.ErrorContains(t, err, "pod metrics client is not configured")
+	})
+
+	t.Run("scale up", func(t *testing.T) {
+		// average utilization is 80%, pod p2 has no metrics yet
+		metricsClient := newMetricsClient(newPodMetrics("p0", "400m"), newPodMetrics("p1", "400m"))
+		p := &cpuPolicy{client: cl, podMetrics: metricsClient, targetUtilization: 0.5}
+		desired, err := p.desiredReplicas(context.TODO(), vertex, nil)
+		assert.NoError(t, err)
+		assert.Equal(t, int32(4), desired)
+	})
+
+	t.Run("within tolerance", func(t *testing.T) {
+		metricsClient := newMetricsClient(newPodMetrics("p0", "260m"), newPodMetrics("p1", "260m"), newPodMetrics("p2", "260m"))
+		p := &cpuPolicy{client: cl, podMetrics: metricsClient, targetUtilization: 0.5}
+		desired, err := p.desiredReplicas(context.TODO(), vertex, nil)
+		assert.NoError(t, err)
+		assert.Equal(t, int32(vertex.Status.Replicas), desired)
+	})
+
+	t.Run("scale down", func(t *testing.T) {
+		metricsClient := newMetricsClient(newPodMetrics("p0", "50m"), newPodMetrics("p1", "50m"), newPodMetrics("p2", "50m"))
+		p := &cpuPolicy{client: cl, podMetrics: metricsClient, targetUtilization: 0.5}
+		desired, err := p.desiredReplicas(context.TODO(), vertex, nil)
+		assert.NoError(t, err)
+		assert.Equal(t, int32(1), desired)
+	})
+
+	t.Run("sidecars", func(t *testing.T) {
+		pod := newPod("p0")
+		pod.Spec.InitContainers = []corev1.Container{{
+			Name:          dfv1.CtrUdf,
+			RestartPolicy: ptr.To(corev1.ContainerRestartPolicyAlways),
+			Resources:     corev1.ResourceRequirements{Requests: corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("500m")}},
+		}, {
+			Name:      dfv1.CtrInit,
+			Resources: corev1.ResourceRequirements{Requests: corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("500m")}},
+		}}
+		podMetrics := newPodMetrics("p0", "400m")
+		podMetrics.Containers = append(podMetrics.Containers, metricsv1beta1.ContainerMetrics{
+			Name:  dfv1.CtrUdf,
+			Usage: corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("400m")},
+		})
+		// the utilization of the main and the udf containers is 80%, the init container doesn't count
+		p := &cpuPolicy{client: fake.NewClientBuilder().WithObjects(pod).Build(), podMetrics: newMetricsClient(podMetrics), targetUtilization: 0.5}
+		desired, err := p.desiredReplicas(context.TODO(), vertex, nil)
+		assert.NoError(t, err)
+		assert.Equal(t, int32(2), desired)
+	})
+
+	t.Run("no metrics", func(t *testing.T) {
+		p := &cpuPolicy{client: cl, podMetrics: newMetricsClient(), targetUtilization: 0.5}
+		_, err := p.desiredReplicas(context.TODO(), vertex, nil)
+		assert.ErrorContains(t, err, "no pod metrics available")
+	})
+}
+
+func Test_rateHistory(t *testing.T) {
+	h := newRateHistory()
+	start := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	for i := 0; i < 120; i++ {
+		h.record(start.Add(time.Duration(i)*time.Minute), []float64{float64(i), 1}, time.Hour)
+	}
+	// the slots older than the retention are removed
+	assert.LessOrEqual(t, len(h.slots), 62)
+	_, ok := h.maxRates(start.Add(30*time.Minute), start.Add(35*time.Minute))
+	assert.False(t, ok)
+
+	rates, ok := h.maxRates(start.Add(90*time.Minute), start.Add(95*time.Minute))
+	assert.True(t, ok)
+	assert.Equal(t, []float64{95, 1}, rates)
+
+	// nothing recorded in the range
+	_, ok = h.maxRates(start.Add(3*time.Hour), start.Add(4*time.Hour))
+	assert.False(t, ok)
+}
+
+func Test_predictivePolicy(t *testing.T) {
+	src := fakeVertex.DeepCopy()
+	src.Name = "pl-src"
+	src.Namespace = "default"
+	src.Spec.Source = &dfv1.Source{Kafka: &dfv1.KafkaSource{}}
+	src.Spec.Scale.TargetProcessingSeconds = ptr.To[uint32](10)
+	sch := runtime.NewScheme()
+	assert.NoError(t, dfv1.AddToScheme(sch))
+	assert.NoError(t, corev1.AddToScheme(sch))
+	cl := fake.NewClientBuilder().WithScheme(sch).WithObjects(src).WithStatusSubresource(src).Build()
+	now := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	newPolicy := func() *predictivePolicy {
+		s := NewScaler(cl)
+		return &predictivePolicy{
+			base:             &defaultPolicy{scaler: s},
+			client:           cl,
+			histories:        s.rateHistories,
+			lookaheadSeconds: 300,
+			periodSeconds:    3600,
+			now:              func() time.Time { return now },
+		}
+	}
+	p := newPolicy()
+	desiredAt := func(at time.Time, rate float64) int32 {
+		now = at
+		assert.NoError(t, cl.Get(context.TODO(), client.ObjectKeyFromObject(src), src))
+		desired, err := p.desiredReplicas(context.TODO(), src, &vertexMetrics{
+			partitionRates:   []float64{rate},
+			partitionPending: []int64{int64(rate * 10)},
+		})
+		assert.NoError(t, err)
+		return desired
+	}
+	forecastUnavailableReason := func() string {
+		v := &dfv1.Vertex{}
+		assert.NoError(t, cl.Get(context.TODO(), client.ObjectKeyFromObject(src), v))
+		return v.Status.ForecastUnavailableReason
+	}
+
+	// the base policy decides until a full period is observed, the load peaks in minutes 20 to 25.
+	start := now
+	for i := 0; i < 60; i++ {
+		rate := float64(900)
+		if i >= 20 && i < 25 {
+			rate = 1800
+		}
+		assert.Equal(t, int32(2), desiredAt(start.Add(time.Duration(i)*time.Minute), rate))
+	}
+	assert.Contains(t, forecastUnavailableReason(), "recorded since 2026-01-01T00:00:00Z")
+
+	// the history is persisted, it's loaded after the controller restarts.
+	cm := &corev1.ConfigMap{}
+	assert.NoError(t, cl.Get(context.TODO(), client.ObjectKey{Namespace: src.Namespace, Name: rateHistoryConfigMapName(src)}, cm))
+	assert.Equal(t, src.Name, cm.OwnerReferences[0].Name)
+	p = newPolicy()
+
+	// the peak of the previous period is ahead, the vertex is scaled up proportionally.
+	assert.Equal(t, int32(4), desiredAt(start.Add(78*time.Minute), 900))
+	assert.Empty(t, forecastUnavailableReason())
+	// no higher load is expected, the base policy decides.
+	assert.Equal(t, int32(2), desiredAt(start.Add(100*time.Minute), 900))
+}
+
+func Test_recordScalingDecision(t *testing.T) {
+	vertex := fakeVertex.DeepCopy()
+	vertex.Name = "pl-v"
+	vertex.Namespace = "default"
+	vertex.Spec.Scale.DryRun = true
+	vertex.Spec.Scale.Policy = &dfv1.ScalePolicy{TargetLag: &dfv1.TargetLagScalePolicy{TargetLagSeconds: 10}}
+	sch := runtime.NewScheme()
+	assert.NoError(t, dfv1.AddToScheme(sch))
+	cl := fake.NewClientBuilder().WithScheme(sch).WithObjects(vertex).WithStatusSubresource(vertex).Build()
+	s := NewScaler(cl)
+	assert.NoError(t, s.patchVertexReplicas(context.TODO(), vertex, 5))
+	v := &dfv1.Vertex{}
+	assert.NoError(t, cl.Get(context.TODO(), client.ObjectKeyFromObject(vertex), v))
+	assert.Equal(t, ptr.To[int32](3), v.Spec.Replicas)
+	assert.NotNil(t, v.Status.LastScalingDecision)
+	assert.Equal(t, dfv1.ScalePolicyTypeTargetLag, v.Status.LastScalingDecision.Policy)
+	assert.Equal(t, uint32(3), v.Status.LastScalingDecision.Replicas)
+	assert.Equal(t, uint32(5), v.Status.LastScalingDecision.DesiredReplicas)
+}
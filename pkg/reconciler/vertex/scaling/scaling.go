@@ -19,6 +19,7 @@ package scaling
 import (
 	"container/list"
 	"context"
+	"encoding/json"
 	"fmt"
 	"math"
 	"strings"
@@ -28,6 +29,7 @@ import (
 	lru "github.com/hashicorp/golang-lru/v2"
 	"go.uber.org/zap"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
@@ -47,6 +49,8 @@ type Scaler struct {
 	// Cache to store the vertex metrics such as pending message number
 	vertexMetricsCache *lru.Cache[string, int64]
 	daemonClientsCache *lru.Cache[string, daemonclient.DaemonClient]
+	// Cache to store the processing rate histories of the vertices with the predictive scale policy
+	rateHistories *lru.Cache[string, *rateHistory]
 }
 
 // NewScaler returns a Scaler instance.
@@ -70,6 +74,7 @@ func NewScaler(client client.Client, opts ...Option) *Scaler {
 	})
 	vertexMetricsCache, _ := lru.New[string, int64](10000)
 	s.vertexMetricsCache = vertexMetricsCache
+	s.rateHistories, _ = lru.New[string, *rateHistory](10000)
 	return s
 }
 
@@ -127,6 +132,9 @@ func (s *Scaler) scale(ctx context.Context, id int, keyCh <-chan string) {
 
 // scaleOneVertex implements the detailed logic of scaling up/down a vertex.
 //
+// The desired replicas are calculated with the scale policy of the vertex, see "policy.go",
+// following is the default policy.
+//
 // For source vertices which have both rate and pending message information,
 // if there are multiple partitions, we consider the max desired replicas among all partitions.
 //
@@ -210,7 +218,8 @@ func (s *Scaler) scaleOneVertex(ctx context.Context, key string, worker int) err
 		return nil
 	}
 
-	if vertex.Spec.Scale.GetMaxReplicas() == vertex.Spec.Scale.GetMinReplicas() {
+	now := time.Now()
+	if vertex.Spec.Scale.GetEffectiveMaxReplicas(now) == vertex.Spec.Scale.GetEffectiveMinReplicas(now) {
 		log.Infof("Vertex %s has same scale.min and scale.max, skip scaling.", vertex.Name)
 		return nil
 	}
@@ -311,11 +320,22 @@ func (s *Scaler) scaleOneVertex(ctx context.Context, key string, worker int) err
 	if totalPending == 0 && totalRate == 0 {
 		desired = 0
 	} else {
-		desired = s.desiredReplicas(ctx, vertex, partitionRates, partitionPending, partitionBufferLengths, partitionAvailableBufferLengths)
+		desired, err = s.policyOf(vertex).desiredReplicas(ctx, vertex, &vertexMetrics{
+			partitionRates:                  partitionRates,
+			partitionPending:                partitionPending,
+			partitionBufferLengths:          partitionBufferLengths,
+			partitionAvailableBufferLengths: partitionAvailableBufferLengths,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to calculate desired replicas of vertex key %q with %q policy, %w", key, vertex.Spec.Scale.GetPolicyType(), err)
+		}
+	}
+	log.Infof("Calculated desired replica number of vertex %q with %q policy is: %d.", vertex.Name, vertex.Spec.Scale.GetPolicyType(), desired)
+	max := vertex.Spec.Scale.GetEffectiveMaxReplicas(now)
+	min := vertex.Spec.Scale.GetEffectiveMinReplicas(now)
+	if as := vertex.Spec.Scale.GetActiveSchedule(now); as != nil {
+		log.Infof("Scale schedule %q is active, using min %d and max %d.", as.Name, min, max)
 	}
-	log.Infof("Calculated desired replica number of vertex %q is: %d.", vertex.Name, desired)
-	max := vertex.Spec.Scale.GetMaxReplicas()
-	min := vertex.Spec.Scale.GetMinReplicas()
 	if desired > max {
 		log.Infof("Calculated desired replica number %d of vertex %q is greater than max, using max %d.", desired, vertex.Name, max)
 		desired = max
@@ -504,6 +524,9 @@ loop:
 
 func (s *Scaler) patchVertexReplicas(ctx context.Context, vertex *dfv1.Vertex, desiredReplicas int32) error {
 	log := logging.FromContext(ctx)
+	if vertex.Spec.Scale.DryRun {
+		return s.recordScalingDecision(ctx, vertex, desiredReplicas)
+	}
 	origin := vertex.Spec.Replicas
 	patchJson := fmt.Sprintf(`{"spec":{"replicas":%d}}`, desiredReplicas)
 	if err := s.client.Patch(ctx, vertex, client.RawPatch(types.MergePatchType, []byte(patchJson))); err != nil && !apierrors.IsNotFound(err) {
@@ -513,6 +536,26 @@ func (s *Scaler) patchVertexReplicas(ctx context.Context, vertex *dfv1.Vertex, d
 	return nil
 }
 
+// recordScalingDecision records the scaling decision in the vertex status without changing the replicas.
+func (s *Scaler) recordScalingDecision(ctx context.Context, vertex *dfv1.Vertex, desiredReplicas int32) error {
+	log := logging.FromContext(ctx)
+	decision := dfv1.ScalingDecision{
+		Policy:          vertex.Spec.Scale.GetPolicyType(),
+		Replicas:        vertex.Status.Replicas,
+		DesiredReplicas: uint32(desiredReplicas),
+		DecidedAt:       metav1.Now(),
+	}
+	patchJson, err := json.Marshal(map[string]any{"status": map[string]any{"lastScalingDecision": decision}})
+	if err != nil {
+		return fmt.Errorf("failed to marshal scaling decision, %w", err)
+	}
+	if err := s.client.Status().Patch(ctx, vertex, client.RawPatch(types.MergePatchType, patchJson)); err != nil && !apierrors.IsNotFound(err) {
+		return fmt.Errorf("failed to patch vertex status with the scaling decision, %w", err)
+	}
+	log.Infow("Auto scaling dry run - vertex replicas not changed.", zap.Uint32("from", vertex.Status.Replicas), zap.Int32("to", desiredReplicas), zap.String("policy", string(decision.Policy)), zap.String("namespace", vertex.Namespace), zap.String("pipeline", vertex.Spec.PipelineName), zap.String("vertex", vertex.Spec.Name))
+	return nil
+}
+
 // KeyOfVertex returns the unique key of a vertex
 func KeyOfVertex(vertex dfv1.Vertex) string {
 	return fmt.Sprintf("%s/%s", vertex.Namespace, vertex.Name)
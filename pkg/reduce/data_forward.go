@@ -29,6 +29,7 @@ package reduce
 
 import (
 	"context"
+	"errors"
 	"math"
 	"strconv"
 	"sync"
@@ -48,6 +49,7 @@ import (
 	"github.com/numaproj/numaflow/pkg/reduce/pnf"
 	"github.com/numaproj/numaflow/pkg/shared/idlehandler"
 	"github.com/numaproj/numaflow/pkg/shared/logging"
+	"github.com/numaproj/numaflow/pkg/shared/tracing"
 	"github.com/numaproj/numaflow/pkg/watermark/fetch"
 	"github.com/numaproj/numaflow/pkg/watermark/publish"
 	"github.com/numaproj/numaflow/pkg/watermark/wmb"
@@ -73,7 +75,10 @@ type DataForward struct {
 	storeManager        wal.Manager
 	of                  *pnf.ProcessAndForward
 	opts                *Options
-	currentWatermark    time.Time // if watermark is -1, then make sure event-time is < watermark
+	currentWatermark    time.Time           // if watermark is -1, then make sure event-time is < watermark
+	onTimeFired         map[string]struct{} // aligned partitions for which the on-time firing has been requested
+	pendingAcks         []*isb.ReadMessage  // messages written to the PBQs, acked once the PBQs are flushed
+	pendingSince        time.Time           // time the oldest of the pendingAcks was written
 	log                 *zap.SugaredLogger
 }
 
@@ -118,6 +123,7 @@ func NewDataForward(ctx context.Context,
 		of:                  of,
 		wmbChecker:          wmb.NewWMBChecker(2), // TODO: make configurable
 		currentWatermark:    time.UnixMilli(-1),
+		onTimeFired:         make(map[string]struct{}),
 		log:                 logging.FromContext(ctx),
 		opts:                options}
 
@@ -300,6 +306,7 @@ func (df *DataForward) forwardAChunk(ctx context.Context) {
 	start := time.Now()
 	readStart := time.Now()
 	readMessages, err := df.fromBufferPartition.Read(ctx, df.opts.readBatchSize)
+	readEnd := time.Now()
 	if err != nil {
 		df.log.Errorw("Failed to read from isb", zap.Error(err))
 		metrics.ReadMessagesError.With(metricLabelsWithPartition).Inc()
@@ -307,6 +314,10 @@ func (df *DataForward) forwardAChunk(ctx context.Context) {
 
 	// idle watermark
 	if len(readMessages) == 0 {
+		// ack the messages written before, if they are due to be flushed
+		if err = df.flushAndAck(ctx, false); err != nil {
+			df.log.Errorw("Failed to flush the PBQs, the messages will not be acked", zap.Error(err))
+		}
 		// we get the Head idle wmb for the partition which we read the messages from and
 		// use it as the idle watermark
 		var processorWMB = df.wmFetcher.ComputeHeadIdleWMB(df.fromBufferPartition.GetPartitionIdx())
@@ -372,6 +383,7 @@ func (df *DataForward) forwardAChunk(ctx context.Context) {
 
 	totalBytes := 0
 	dataBytes := 0
+	dataMessages := make([]*isb.ReadMessage, 0, len(readMessages))
 	for _, m := range readMessages {
 		if !df.keyed {
 			m.Keys = []string{dfv1.DefaultKeyForNonKeyedData}
@@ -381,9 +393,16 @@ func (df *DataForward) forwardAChunk(ctx context.Context) {
 		totalBytes += len(m.Payload)
 		if m.Kind == isb.Data {
 			dataBytes += len(m.Payload)
+			dataMessages = append(dataMessages, m)
 		}
 	}
 
+	// trace the batch from the start of the read, the headers of the data messages will carry the context of the batch span.
+	ctx, span := tracing.StartBatchSpan(ctx, "reduce.forward", readStart, dataMessages,
+		append(tracing.VertexAttributes(df.pipelineName, df.vertexName, df.vertexReplica), tracing.AttributePartition.String(df.fromBufferPartition.GetName()))...)
+	defer span.End()
+	tracing.RecordSpan(ctx, "isb.read", readStart, readEnd)
+
 	metrics.ReadProcessingTime.With(metricLabelsWithPartition).Observe(float64(time.Since(readStart).Microseconds()))
 	metrics.ReadBytesCount.With(metricLabelsWithPartition).Add(float64(totalBytes))
 	metrics.ReadDataBytesCount.With(metricLabelsWithPartition).Add(float64(dataBytes))
@@ -470,7 +489,9 @@ func (df *DataForward) process(ctx context.Context, messages []*isb.ReadMessage)
 	metrics.ReadMessagesCount.With(metricLabelsWithPartition).Add(float64(len(messages)))
 
 	// write messages to windows based by PBQs.
+	_, pbqSpan := tracing.StartSpan(ctx, "pbq.write")
 	successfullyWrittenMessages, failedMessages, err := df.writeMessagesToWindows(ctx, dataMessages)
+	tracing.EndSpan(pbqSpan, err)
 	if err != nil {
 		df.log.Errorw("Failed to write messages", zap.Int("totalMessages", len(messages)), zap.Int("writtenMessage", len(successfullyWrittenMessages)))
 	}
@@ -493,8 +514,15 @@ func (df *DataForward) process(ctx context.Context, messages []*isb.ReadMessage)
 		return
 	}
 
-	// ack successful messages
-	df.ackMessages(ctx, successfullyWrittenMessages)
+	// ack successful messages, once they are persisted by the stores
+	if len(df.pendingAcks) == 0 {
+		df.pendingSince = time.Now()
+	}
+	df.pendingAcks = append(df.pendingAcks, successfullyWrittenMessages...)
+	if err = df.flushAndAck(ctx, false); err != nil {
+		df.log.Errorw("Failed to flush the PBQs, the messages will not be acked", zap.Error(err))
+		return
+	}
 	metrics.AckProcessingTime.With(metricLabelsWithPartition).Observe(float64(time.Since(ackStart).Microseconds()))
 
 	// close any windows that need to be closed.
@@ -502,7 +530,8 @@ func (df *DataForward) process(ctx context.Context, messages []*isb.ReadMessage)
 	// we can invoke remove windows only once per batch
 	wm := wmb.Watermark(successfullyWrittenMessages[0].Watermark)
 
-	closedWindowOps := df.windower.CloseWindows(time.Time(wm).Add(-1 * df.opts.allowedLateness))
+	closeTime := time.Time(wm).Add(-1 * df.opts.allowedLateness)
+	closedWindowOps := df.windower.CloseWindows(closeTime)
 
 	df.log.Debugw("Windows eligible for closing", zap.Int("length", len(closedWindowOps)), zap.Time("watermark", time.Time(wm)))
 
@@ -514,6 +543,11 @@ func (df *DataForward) process(ctx context.Context, messages []*isb.ReadMessage)
 		}
 	}
 
+	// the aligned windows are kept open for the late data, emit their on-time results
+	if df.opts.lateFiring && df.windower.Type() == window.Aligned {
+		df.fireOnTime(ctx, time.Time(wm), closeTime)
+	}
+
 	// solve Reduce withholding of watermark where we do not send WM until the window is closed.
 	oldestWindowEndTime := df.windower.OldestWindowEndTime()
 	if oldestWindowEndTime != time.UnixMilli(-1) {
@@ -534,11 +568,43 @@ func (df *DataForward) process(ctx context.Context, messages []*isb.ReadMessage)
 	}
 }
 
+// fireOnTime requests the on-time firing of the aligned partitions whose end time has been passed by the watermark, but
+// are still open because of the allowed lateness.
+func (df *DataForward) fireOnTime(ctx context.Context, wm time.Time, closeTime time.Time) {
+	for _, q := range df.pbqManager.ListPartitions() {
+		pid := q.PartitionID
+		if !pid.End.After(closeTime) {
+			// the partition is closed, it will not be fired anymore
+			delete(df.onTimeFired, pid.String())
+			continue
+		}
+		if pid.End.After(wm) {
+			continue
+		}
+		if _, ok := df.onTimeFired[pid.String()]; ok {
+			continue
+		}
+
+		fireOp := &window.TimedWindowRequest{
+			Operation: window.Fire,
+			Windows:   []window.TimedWindow{window.NewAlignedTimedWindow(pid.Start, pid.End, pid.Slot)},
+			ID:        &pid,
+			Firing:    window.OnTime,
+		}
+		if err := df.writeToPBQ(ctx, fireOp, false); err != nil {
+			df.log.Errorw("Failed to write fire signal to PBQ", zap.String("partitionID", pid.String()), zap.Error(err))
+			continue
+		}
+		df.onTimeFired[pid.String()] = struct{}{}
+	}
+}
+
 // writeMessagesToWindows write the messages to each window that message belongs to. Each window is backed by a PBQ.
 func (df *DataForward) writeMessagesToWindows(ctx context.Context, messages []*isb.ReadMessage) ([]*isb.ReadMessage, []*isb.ReadMessage, error) {
 	var err error
 	var writtenMessages = make([]*isb.ReadMessage, 0, len(messages))
 	var failedMessages = make([]*isb.ReadMessage, 0)
+	var lateMessages = make([]*isb.ReadMessage, 0)
 
 	for _, message := range messages {
 		var windowOperations []*window.TimedWindowRequest
@@ -548,6 +614,12 @@ func (df *DataForward) writeMessagesToWindows(ctx context.Context, messages []*i
 			windowOperations = df.handleOnTimeMessage(message)
 		}
 
+		// the message is dropped, write it to the late data side output if configured
+		if len(windowOperations) == 0 && df.opts.lateDataDecider != nil {
+			lateMessages = append(lateMessages, message)
+			continue
+		}
+
 		var failed bool
 		// for each window we will have a PBQ. A message could belong to multiple windows (e.g., sliding).
 		// We need to write the messages to these PBQs
@@ -567,9 +639,100 @@ func (df *DataForward) writeMessagesToWindows(ctx context.Context, messages []*i
 		}
 		writtenMessages = append(writtenMessages, message)
 	}
+
+	if len(lateMessages) > 0 {
+		if lErr := df.writeLateData(ctx, lateMessages); lErr != nil {
+			df.log.Errorw("Failed to write late messages, asked to stop trying", zap.Int("count", len(lateMessages)), zap.Error(lErr))
+			failedMessages = append(failedMessages, lateMessages...)
+			err = lErr
+		} else {
+			writtenMessages = append(writtenMessages, lateMessages...)
+		}
+	}
 	return writtenMessages, failedMessages, err
 }
 
+// writeLateData writes the late messages, which are dropped because their windows have already been closed, to the
+// late data side output. The messages keep their original headers, and carry the watermark at the time they were
+// dropped. It will return error only if we are in a continuous error loop, and we have received ctx.Done().
+func (df *DataForward) writeLateData(ctx context.Context, messages []*isb.ReadMessage) error {
+	lateMessages := make(map[string]map[int32][]isb.Message)
+	for _, message := range messages {
+		lateMessage := message.Message
+		headers := make(map[string]string, len(message.Headers)+2)
+		for k, v := range message.Headers {
+			headers[k] = v
+		}
+		headers[dfv1.KeyMetaLateDataWatermark] = strconv.FormatInt(message.Watermark.UnixMilli(), 10)
+		// the marker may have been set by the clients of the source, only the reduce vertex decides it.
+		delete(headers, dfv1.KeyMetaLateDataFallback)
+		if df.opts.lateDataFallback {
+			headers[dfv1.KeyMetaLateDataFallback] = "true"
+		}
+		lateMessage.Headers = headers
+
+		to, err := df.opts.lateDataDecider.WhereTo(lateMessage.Keys, nil, lateMessage.ID.String(), &lateMessage)
+		if err != nil {
+			return err
+		}
+		for _, t := range to {
+			if _, ok := lateMessages[t.ToVertexName]; !ok {
+				lateMessages[t.ToVertexName] = make(map[int32][]isb.Message)
+			}
+			lateMessages[t.ToVertexName][t.ToVertexPartitionIdx] = append(lateMessages[t.ToVertexName][t.ToVertexPartitionIdx], lateMessage)
+		}
+	}
+
+	for toVertexName, partitions := range lateMessages {
+		for partitionIdx, partitionMessages := range partitions {
+			if err := df.writeToBuffer(ctx, toVertexName, partitionIdx, partitionMessages); err != nil {
+				return err
+			}
+		}
+	}
+
+	metrics.ReduceLateDataMessagesCount.With(map[string]string{
+		metrics.LabelVertex:             df.vertexName,
+		metrics.LabelPipeline:           df.pipelineName,
+		metrics.LabelVertexReplicaIndex: strconv.Itoa(int(df.vertexReplica)),
+	}).Add(float64(len(messages)))
+	return nil
+}
+
+// writeToBuffer writes the messages to the ISB partition with infinite backoff, it will return error only if
+// ctx.Done() has been invoked.
+func (df *DataForward) writeToBuffer(ctx context.Context, toVertexName string, partitionIdx int32, messages []isb.Message) error {
+	var isbWriteBackoff = wait.Backoff{
+		Steps:    math.MaxInt,
+		Duration: 100 * time.Millisecond,
+		Factor:   1,
+		Jitter:   0.1,
+	}
+
+	writeMessages := messages
+	return wait.ExponentialBackoff(isbWriteBackoff, func() (done bool, err error) {
+		var failedMessages []isb.Message
+		_, writeErrs := df.toBuffers[toVertexName][partitionIdx].Write(ctx, writeMessages)
+		for i, message := range writeMessages {
+			// non retryable errors are returned when the buffer is full with DiscardLatest strategy, or the message
+			// is a duplicate, we drop the message in both cases.
+			if writeErrs[i] != nil && !errors.As(writeErrs[i], &isb.NonRetryableBufferWriteErr{}) {
+				failedMessages = append(failedMessages, message)
+			}
+		}
+		if len(failedMessages) > 0 {
+			df.log.Warnw("Failed to write late messages to isb", zap.String("toVertex", toVertexName), zap.Errors("errors", writeErrs))
+			writeMessages = failedMessages
+			if ctx.Err() != nil {
+				// no need to retry if the context is closed
+				return false, ctx.Err()
+			}
+			return false, nil
+		}
+		return true, nil
+	})
+}
+
 // handleLateMessage handles the late message and returns the timed window requests to be written to PBQ.
 // if the message is dropped, it returns an empty slice.
 func (df *DataForward) handleLateMessage(message *isb.ReadMessage) []*window.TimedWindowRequest {
@@ -681,6 +844,53 @@ func (df *DataForward) writeToPBQ(ctx context.Context, winOp *window.TimedWindow
 	return err
 }
 
+// flushPBQs persists the messages buffered by the stores of the PBQs. Retries until it can succeed or ctx.Done()
+// happens.
+func (df *DataForward) flushPBQs(ctx context.Context) error {
+	var flushBackoff = wait.Backoff{
+		Steps:    math.MaxInt,
+		Duration: 1 * time.Second,
+		Factor:   1.5,
+		Jitter:   0.1,
+	}
+
+	for _, q := range df.pbqManager.ListPartitions() {
+		err := wait.ExponentialBackoff(flushBackoff, func() (done bool, err error) {
+			if fErr := q.Flush(); fErr != nil {
+				df.log.Errorw("Failed to flush pbq", zap.String("partitionID", q.PartitionID.String()), zap.Error(fErr))
+				select {
+				case <-ctx.Done():
+					// no point in retrying after we have been asked to stop.
+					return false, ctx.Err()
+				default:
+					// keep retrying
+					return false, nil
+				}
+			}
+			return true, nil
+		})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// flushAndAck flushes the PBQs and acks the pending messages, once the flush interval has elapsed since the oldest
+// of them was written, or if force is set. The stores which buffer the written messages are flushed on the interval
+// rather than for each read batch, the messages are not acked if the flush fails.
+func (df *DataForward) flushAndAck(ctx context.Context, force bool) error {
+	if len(df.pendingAcks) == 0 || (!force && time.Since(df.pendingSince) < df.opts.flushInterval) {
+		return nil
+	}
+	if err := df.flushPBQs(ctx); err != nil {
+		return err
+	}
+	df.ackMessages(ctx, df.pendingAcks)
+	df.pendingAcks = nil
+	return nil
+}
+
 // ackMessages acks messages. Retries until it can succeed or ctx.Done() happens.
 func (df *DataForward) ackMessages(ctx context.Context, messages []*isb.ReadMessage) {
 	var ackBackoff = wait.Backoff{
@@ -751,6 +961,11 @@ func (df *DataForward) ShutDown(ctx context.Context) {
 
 	df.log.Infow("Stopping reduce data forwarder...")
 
+	// ack the messages written to the PBQs, before the reader is closed
+	if err := df.flushAndAck(ctx, true); err != nil {
+		df.log.Errorw("Failed to flush the PBQs, the messages will be redelivered", zap.Error(err))
+	}
+
 	if err := df.fromBufferPartition.Close(); err != nil {
 		df.log.Errorw("Failed to close buffer reader, shutdown anyways...", zap.Error(err))
 	} else {
@@ -127,7 +127,7 @@ type myForwardTestRoundRobin struct {
 	count atomic.Int32
 }
 
-func (f *myForwardTestRoundRobin) WhereTo(_ []string, _ []string, s string) ([]forwarder.VertexBuffer, error) {
+func (f *myForwardTestRoundRobin) WhereTo(_ []string, _ []string, s string, _ *isb.Message) ([]forwarder.VertexBuffer, error) {
 	var output = []forwarder.VertexBuffer{{
 		ToVertexName:         "reduce-to-vertex",
 		ToVertexPartitionIdx: f.count.Load() % 2,
@@ -183,7 +183,7 @@ func (f CounterReduceTest) CloseConn(_ context.Context) error {
 	return nil
 }
 
-func (f CounterReduceTest) WhereTo(_ []string, _ []string, s string) ([]forwarder.VertexBuffer, error) {
+func (f CounterReduceTest) WhereTo(_ []string, _ []string, s string, _ *isb.Message) ([]forwarder.VertexBuffer, error) {
 	return []forwarder.VertexBuffer{{
 		ToVertexName:         "reduce-to-vertex",
 		ToVertexPartitionIdx: 0,
@@ -247,7 +247,7 @@ func (s SessionSumReduceTest) CloseConn(ctx context.Context) error {
 	return nil
 }
 
-func (s SessionSumReduceTest) WhereTo(_ []string, _ []string, s2 string) ([]forwarder.VertexBuffer, error) {
+func (s SessionSumReduceTest) WhereTo(_ []string, _ []string, s2 string, _ *isb.Message) ([]forwarder.VertexBuffer, error) {
 	return []forwarder.VertexBuffer{{
 		ToVertexName:         "reduce-to-vertex",
 		ToVertexPartitionIdx: 0,
@@ -1901,3 +1901,103 @@ func buildIsbMessageAllowedLatency(messageValue int, eventTime time.Time) isb.Me
 		Body: isb.Body{Payload: result},
 	}
 }
+
+func TestDataForward_WriteLateData(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	lateDataVertexName := "late-data-vertex"
+	buffer := simplebuffer.NewInMemoryBuffer(lateDataVertexName, 10, 0)
+	toBuffer := map[string][]isb.BufferWriter{
+		"reduce-to-vertex": {simplebuffer.NewInMemoryBuffer("reduce-to-vertex", 10, 0)},
+		lateDataVertexName: {buffer},
+	}
+	lateDataDecider := forwarder.GoWhere(func(_ []string, _ []string, _ string, _ *isb.Message) ([]forwarder.VertexBuffer, error) {
+		return []forwarder.VertexBuffer{{ToVertexName: lateDataVertexName, ToVertexPartitionIdx: 0}}, nil
+	})
+
+	// there are no open windows, so the late message will be dropped
+	windower := fixed.NewWindower(5*time.Second, keyedVertex)
+	df, err := NewDataForward(ctx, keyedVertex, nil, toBuffer, nil, nil, CounterReduceTest{}, nil, nil,
+		windower, nil, nil, WithLateData(lateDataDecider, true))
+	assert.NoError(t, err)
+
+	message := buildIsbMessageAllowedLatency(1, time.UnixMilli(60000))
+	message.Headers = map[string]string{"x-custom": "value"}
+	readMessage := &isb.ReadMessage{Message: message, ReadOffset: isb.SimpleIntOffset(func() int64 { return 0 }), Watermark: time.UnixMilli(90000)}
+
+	written, failed, err := df.writeMessagesToWindows(ctx, []*isb.ReadMessage{readMessage})
+	assert.NoError(t, err)
+	assert.Len(t, written, 1)
+	assert.Len(t, failed, 0)
+
+	msgs, err := buffer.Read(ctx, 1)
+	assert.NoError(t, err)
+	assert.Len(t, msgs, 1)
+	assert.Equal(t, message.Payload, msgs[0].Payload)
+	assert.Equal(t, "value", msgs[0].Headers["x-custom"])
+	assert.Equal(t, "90000", msgs[0].Headers[dfv1.KeyMetaLateDataWatermark])
+	assert.Equal(t, "true", msgs[0].Headers[dfv1.KeyMetaLateDataFallback])
+	// the headers of the original message should not be modified
+	assert.NotContains(t, message.Headers, dfv1.KeyMetaLateDataWatermark)
+
+	// the marker set upstream is dropped without the fallback
+	df, err = NewDataForward(ctx, keyedVertex, nil, toBuffer, nil, nil, CounterReduceTest{}, nil, nil,
+		windower, nil, nil, WithLateData(lateDataDecider, false))
+	assert.NoError(t, err)
+	message.Headers = map[string]string{dfv1.KeyMetaLateDataFallback: "true"}
+	readMessage = &isb.ReadMessage{Message: message, ReadOffset: isb.SimpleIntOffset(func() int64 { return 1 }), Watermark: time.UnixMilli(90000)}
+	_, _, err = df.writeMessagesToWindows(ctx, []*isb.ReadMessage{readMessage})
+	assert.NoError(t, err)
+	msgs, err = buffer.Read(ctx, 1)
+	assert.NoError(t, err)
+	assert.Len(t, msgs, 1)
+	assert.NotContains(t, msgs[0].Headers, dfv1.KeyMetaLateDataFallback)
+}
+
+// ackCountingOffset counts the acks of the offset.
+type ackCountingOffset struct {
+	isb.Offset
+	acks *atomic.Int32
+}
+
+func (o ackCountingOffset) AckIt() error {
+	o.acks.Inc()
+	return nil
+}
+
+func TestDataForward_FlushAndAck(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	fromBuffer := simplebuffer.NewInMemoryBuffer("from", 10, 0)
+	pbqManager, err := pbq.NewManager(ctx, "reduce", pipelineName, 0, memory.NewMemManager(), window.Aligned)
+	assert.NoError(t, err)
+	df, err := NewDataForward(ctx, keyedVertex, fromBuffer, nil, pbqManager, nil, CounterReduceTest{}, nil, nil,
+		fixed.NewWindower(5*time.Second, keyedVertex), nil, nil, WithFlushInterval(time.Hour))
+	assert.NoError(t, err)
+
+	acks := atomic.NewInt32(0)
+	messages := make([]*isb.ReadMessage, 3)
+	for i := range messages {
+		messages[i] = &isb.ReadMessage{ReadOffset: ackCountingOffset{Offset: isb.SimpleIntOffset(func() int64 { return int64(i) }), acks: acks}}
+	}
+	df.pendingAcks = messages
+	df.pendingSince = time.Now()
+
+	// the messages are held until the flush interval elapses
+	assert.NoError(t, df.flushAndAck(ctx, false))
+	assert.Equal(t, int32(0), acks.Load())
+	assert.Len(t, df.pendingAcks, 3)
+
+	df.pendingSince = time.Now().Add(-time.Hour)
+	assert.NoError(t, df.flushAndAck(ctx, false))
+	assert.Equal(t, int32(3), acks.Load())
+	assert.Empty(t, df.pendingAcks)
+
+	// and acked on shutdown regardless of the interval
+	df.pendingAcks = messages[:1]
+	df.pendingSince = time.Now()
+	assert.NoError(t, df.flushAndAck(ctx, true))
+	assert.Equal(t, int32(4), acks.Load())
+}
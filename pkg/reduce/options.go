@@ -20,6 +20,7 @@ import (
 	"time"
 
 	dfv1 "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1"
+	"github.com/numaproj/numaflow/pkg/forwarder"
 )
 
 // Options for forwarding the message
@@ -28,6 +29,15 @@ type Options struct {
 	readBatchSize int64
 	// allowedLateness is the time.Duration it waits after the watermark has progressed for late-date to be included
 	allowedLateness time.Duration
+	// lateFiring emits the on-time results of the aligned windows which are kept open for the late data
+	lateFiring bool
+	// lateDataDecider decides where the dropped late messages are written to, they are discarded if it is not set
+	lateDataDecider forwarder.ToWhichStepDecider
+	// lateDataFallback asks the sink vertex to write the late messages to its fallback sink
+	lateDataFallback bool
+	// flushInterval is the interval to flush the PBQs and ack the messages written to them, the messages are acked
+	// after each read batch if it is zero
+	flushInterval time.Duration
 }
 
 type Option func(*Options) error
@@ -54,3 +64,30 @@ func WithAllowedLateness(t time.Duration) Option {
 		return nil
 	}
 }
+
+// WithLateFiring enables the on-time firing of the aligned windows, once the watermark passes the end of the window
+func WithLateFiring(lateFiring bool) Option {
+	return func(o *Options) error {
+		o.lateFiring = lateFiring
+		return nil
+	}
+}
+
+// WithLateData routes the late messages, which are dropped because their windows have already been closed, to the
+// buffers decided by the toWhichStepDecider. If fallback is set, the sink vertex writes them to its fallback sink.
+func WithLateData(toWhichStepDecider forwarder.ToWhichStepDecider, fallback bool) Option {
+	return func(o *Options) error {
+		o.lateDataDecider = toWhichStepDecider
+		o.lateDataFallback = fallback
+		return nil
+	}
+}
+
+// WithFlushInterval sets the interval to flush the PBQs, for the stores which buffer the written messages. The messages
+// written to the PBQs are acked together once they are flushed, instead of after each read batch.
+func WithFlushInterval(interval time.Duration) Option {
+	return func(o *Options) error {
+		o.flushInterval = interval
+		return nil
+	}
+}
@@ -81,9 +81,9 @@ func (p *PBQ) Write(ctx context.Context, request *window.TimedWindowRequest, per
 	case window.Open, window.Append, window.Expand:
 		// during replay we do not have to persist
 		if persist {
-			writeErr = p.store.Write(request.ReadMessage)
+			writeErr = p.persist(request)
 		}
-	case window.Close, window.Merge:
+	case window.Close, window.Merge, window.Fire:
 	// these do not have request.ReadMessage, only metadata fields are used
 	default:
 		return fmt.Errorf("unknown request.Operation, %v", request.Operation)
@@ -98,6 +98,33 @@ func (p *PBQ) Write(ctx context.Context, request *window.TimedWindowRequest, per
 	return writeErr
 }
 
+// persist writes the message of the request to the store. A message which is assigned to windows starting after its
+// event time (e.g. a late message which opens the next pane of a global window) is persisted at the start of the
+// windows, so that it is not compacted along with the windows which were closed before.
+func (p *PBQ) persist(request *window.TimedWindowRequest) error {
+	if len(request.Windows) == 0 {
+		return p.store.Write(request.ReadMessage)
+	}
+	startTime := request.Windows[0].StartTime()
+	for _, w := range request.Windows[1:] {
+		if w.StartTime().Before(startTime) {
+			startTime = w.StartTime()
+		}
+	}
+	if tw, ok := p.store.(wal.TimedWriter); ok && startTime.After(request.ReadMessage.EventTime) {
+		return tw.WriteAt(request.ReadMessage, startTime)
+	}
+	return p.store.Write(request.ReadMessage)
+}
+
+// Flush persists the messages buffered by the store, if any.
+func (p *PBQ) Flush() error {
+	if f, ok := p.store.(wal.Flusher); ok {
+		return f.Flush()
+	}
+	return nil
+}
+
 // CloseOfBook closes output channel
 func (p *PBQ) CloseOfBook() {
 	close(p.output)
@@ -0,0 +1,81 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fs
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+
+	"github.com/numaproj/numaflow/pkg/reduce/pbq/wal"
+)
+
+// ScanSegment decodes the aligned WAL segment file and calls fn for each of its entries. It stops at the first entry
+// which can not be decoded, e.g. a torn write or a checksum mismatch, and reports it in the returned SegmentInfo so
+// that the segment can be truncated to its valid prefix. An error is returned only if the file can not be read or fn
+// returns an error. It is used to inspect the WALs offline, and must not be called on the WALs of a running vertex.
+func ScanSegment(filePath string, fn func(*wal.EntryInfo) error) (*wal.SegmentInfo, error) {
+	data, err := os.ReadFile(filePath)
+	if err != nil {
+		return nil, err
+	}
+	info := &wal.SegmentInfo{Path: filePath, Size: int64(len(data))}
+
+	reader := bytes.NewReader(data)
+	id, err := decodeWALHeader(reader)
+	if err != nil {
+		info.Err = fmt.Errorf("failed to decode the segment header, %w", err)
+		return info, nil
+	}
+	info.PartitionID = id
+	info.ValidSize = info.Size - int64(reader.Len())
+
+	for reader.Len() > 0 {
+		position := info.ValidSize
+		// check the lengths in the entry header before decoding, so that a corrupted header does not lead to a huge
+		// allocation.
+		entryHeader, err := decodeWALMessageHeader(bytes.NewReader(data[position:]))
+		if err == nil && (entryHeader.MessageLen < 0 || entryHeader.MessageLen > int64(reader.Len())-EntryHeaderSize) {
+			err = fmt.Errorf("message length %d exceeds the remaining %d bytes", entryHeader.MessageLen, reader.Len())
+		}
+		if err != nil {
+			info.Err = fmt.Errorf("failed to decode the entry at position %d, %w", position, err)
+			return info, nil
+		}
+		message, size, err := decodeReadMessage(reader)
+		if err != nil {
+			info.Err = fmt.Errorf("failed to decode the entry at position %d, %w", position, err)
+			return info, nil
+		}
+		info.Entries++
+		info.ValidSize += size
+		if fn == nil {
+			continue
+		}
+		if err = fn(&wal.EntryInfo{
+			Position:  position,
+			Size:      size,
+			Offset:    entryHeader.Offset,
+			EventTime: message.EventTime,
+			Watermark: message.Watermark,
+			Keys:      message.Keys,
+		}); err != nil {
+			return info, err
+		}
+	}
+	return info, nil
+}
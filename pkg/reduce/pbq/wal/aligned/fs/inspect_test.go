@@ -0,0 +1,96 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fs
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/numaproj/numaflow/pkg/isb/testutils"
+	"github.com/numaproj/numaflow/pkg/reduce/pbq/partition"
+	"github.com/numaproj/numaflow/pkg/reduce/pbq/wal"
+)
+
+func TestScanSegment(t *testing.T) {
+	tmp := t.TempDir()
+	id := partition.ID{Start: time.UnixMilli(60000).In(location), End: time.UnixMilli(120000).In(location), Slot: "slot-0"}
+	manager := NewFSManager(vi, WithStorePath(tmp))
+	w, err := manager.CreateWAL(context.Background(), id)
+	assert.NoError(t, err)
+	messages := testutils.BuildTestReadMessagesIntOffset(10, id.Start, nil)
+	for _, msg := range messages {
+		assert.NoError(t, w.Write(&msg))
+	}
+	assert.NoError(t, w.Close())
+	filePath := getSegmentFilePath(&id, tmp)
+
+	var entries []*wal.EntryInfo
+	info, err := ScanSegment(filePath, func(entry *wal.EntryInfo) error {
+		entries = append(entries, entry)
+		return nil
+	})
+	assert.NoError(t, err)
+	assert.False(t, info.Corrupted())
+	assert.Equal(t, id, *info.PartitionID)
+	assert.Equal(t, 10, info.Entries)
+	assert.Equal(t, info.Size, info.ValidSize)
+	assert.Len(t, entries, 10)
+	for i, entry := range entries {
+		assert.Equal(t, int64(i), entry.Offset)
+		assert.Equal(t, messages[i].EventTime.UnixMilli(), entry.EventTime.UnixMilli())
+		assert.Equal(t, messages[i].Keys, entry.Keys)
+	}
+	validSize := info.Size
+
+	// a torn write at the tail
+	fp, err := os.OpenFile(filePath, os.O_APPEND|os.O_WRONLY, 0644)
+	assert.NoError(t, err)
+	_, err = fp.Write([]byte{1, 2, 3, 4, 5})
+	assert.NoError(t, err)
+	assert.NoError(t, fp.Close())
+	info, err = ScanSegment(filePath, nil)
+	assert.NoError(t, err)
+	assert.True(t, info.Corrupted())
+	assert.Equal(t, 10, info.Entries)
+	assert.Equal(t, validSize, info.ValidSize)
+
+	// a checksum mismatch in the last entry
+	assert.NoError(t, os.Truncate(filePath, validSize))
+	data, err := os.ReadFile(filePath)
+	assert.NoError(t, err)
+	data[len(data)-1] ^= 0xff
+	assert.NoError(t, os.WriteFile(filePath, data, 0644))
+	info, err = ScanSegment(filePath, nil)
+	assert.NoError(t, err)
+	assert.ErrorIs(t, info.Err, errChecksumMismatch)
+	assert.Equal(t, 9, info.Entries)
+	assert.Equal(t, entries[9].Position, info.ValidSize)
+
+	// a corrupted header
+	corrupted := filepath.Join(tmp, "corrupted")
+	assert.NoError(t, os.WriteFile(corrupted, []byte{1, 2}, 0644))
+	info, err = ScanSegment(corrupted, nil)
+	assert.NoError(t, err)
+	assert.True(t, info.Corrupted())
+	assert.Nil(t, info.PartitionID)
+	assert.Equal(t, int64(0), info.ValidSize)
+}
@@ -0,0 +1,141 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package objectstore
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"hash/crc32"
+	"io"
+	"time"
+
+	"github.com/numaproj/numaflow/pkg/isb"
+	"github.com/numaproj/numaflow/pkg/reduce/pbq/partition"
+)
+
+var errChecksumMismatch = fmt.Errorf("data checksum not match")
+
+var crc32q = crc32.MakeTable(crc32.IEEE)
+
+// segmentHeaderPreamble is the header preamble of a segment (excludes variadic slot)
+type segmentHeaderPreamble struct {
+	S    int64
+	E    int64
+	SLen int16
+}
+
+// entryHeaderPreamble is the header for each entry of a segment
+type entryHeaderPreamble struct {
+	WaterMark  int64
+	Offset     int64
+	MessageLen int64
+	Checksum   uint32
+}
+
+// encodeSegmentHeader builds the segment header. Every segment starts with the header, so that a segment can be
+// replayed without the other segments of the WAL. The header is of the following format.
+//
+//	+--------------------+------------------+------------------+-------------+
+//	| start time (int64) | end time (int64) | slot-len (int16) | slot []rune |
+//	+--------------------+------------------+------------------+-------------+
+func encodeSegmentHeader(id *partition.ID) (*bytes.Buffer, error) {
+	buf := new(bytes.Buffer)
+	hp := segmentHeaderPreamble{
+		S:    id.Start.UnixMilli(),
+		E:    id.End.UnixMilli(),
+		SLen: int16(len([]rune(id.Slot))),
+	}
+	if err := binary.Write(buf, binary.LittleEndian, hp); err != nil {
+		return nil, err
+	}
+	if err := binary.Write(buf, binary.LittleEndian, []rune(id.Slot)); err != nil {
+		return nil, err
+	}
+	return buf, nil
+}
+
+// decodeSegmentHeader decodes the header which is encoded by encodeSegmentHeader.
+func decodeSegmentHeader(buf io.Reader) (*partition.ID, error) {
+	var hp = new(segmentHeaderPreamble)
+	if err := binary.Read(buf, binary.LittleEndian, hp); err != nil {
+		return nil, err
+	}
+	var slot = make([]rune, hp.SLen)
+	if err := binary.Read(buf, binary.LittleEndian, slot); err != nil {
+		return nil, err
+	}
+	return &partition.ID{
+		Start: time.UnixMilli(hp.S).UTC(),
+		End:   time.UnixMilli(hp.E).UTC(),
+		Slot:  string(slot),
+	}, nil
+}
+
+// encodeEntry builds an entry of the segment. The format as follow is
+//
+//	+-------------------+----------------+-----------------+--------------+----------------+
+//	| watermark (int64) | offset (int64) | msg-len (int64) | CRC (unit32) | message []byte |
+//	+-------------------+----------------+-----------------+--------------+----------------+
+//
+// CRC will be used for detecting ReadMessage corruptions.
+func encodeEntry(message *isb.ReadMessage) ([]byte, error) {
+	body, err := message.Message.MarshalBinary()
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode the message, %w", err)
+	}
+	offset, err := message.ReadOffset.Sequence()
+	if err != nil {
+		return nil, err
+	}
+	buf := new(bytes.Buffer)
+	header := entryHeaderPreamble{
+		WaterMark:  message.Watermark.UnixMilli(),
+		Offset:     offset,
+		MessageLen: int64(len(body)),
+		Checksum:   crc32.Checksum(body, crc32q),
+	}
+	if err = binary.Write(buf, binary.LittleEndian, header); err != nil {
+		return nil, err
+	}
+	buf.Write(body)
+	return buf.Bytes(), nil
+}
+
+// decodeEntry decodes the entry which is encoded by encodeEntry. Returns errChecksumMismatch if the entry is corrupted.
+func decodeEntry(buf io.Reader) (*isb.ReadMessage, error) {
+	var header = new(entryHeaderPreamble)
+	if err := binary.Read(buf, binary.LittleEndian, header); err != nil {
+		return nil, err
+	}
+	body := make([]byte, header.MessageLen)
+	if _, err := io.ReadFull(buf, body); err != nil {
+		return nil, err
+	}
+	if crc32.Checksum(body, crc32q) != header.Checksum {
+		return nil, errChecksumMismatch
+	}
+	var message = new(isb.Message)
+	if err := message.UnmarshalBinary(body); err != nil {
+		return nil, err
+	}
+	return &isb.ReadMessage{
+		Message:    *message,
+		Watermark:  time.UnixMilli(header.WaterMark).UTC(),
+		ReadOffset: isb.SimpleIntOffset(func() int64 { return header.Offset }),
+	}, nil
+}
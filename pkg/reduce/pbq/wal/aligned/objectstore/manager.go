@@ -0,0 +1,235 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package objectstore
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"net/url"
+	"path"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	dfv1 "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1"
+	"github.com/numaproj/numaflow/pkg/metrics"
+	"github.com/numaproj/numaflow/pkg/reduce/pbq/partition"
+	"github.com/numaproj/numaflow/pkg/reduce/pbq/wal"
+)
+
+const (
+	SegmentPrefix = "segment"
+	// DefaultKeyPrefix is the default key prefix of the WAL segments in the bucket.
+	DefaultKeyPrefix = "numaflow"
+	// defaultRequestTimeout is the default timeout of the object store requests.
+	defaultRequestTimeout = 30 * time.Second
+)
+
+type objectStoreManager struct {
+	store ObjectStore
+	// prefix is the key prefix of the WAL segments in the bucket
+	prefix string
+	// keyPrefix is the key prefix of the WALs of this replica
+	keyPrefix string
+	// maxBatchSize max size of the buffered entries before they are uploaded
+	maxBatchSize int64
+	// syncDuration timeout to upload the buffered entries
+	syncDuration time.Duration
+	// requestTimeout timeout of the object store requests
+	requestTimeout time.Duration
+	pipelineName   string
+	vertexName     string
+	replicaIndex   int32
+	activeWals     map[string]*objectWAL
+	mu             sync.RWMutex
+}
+
+// NewObjectStoreManager is an object store WAL Manager. The segments of the WALs are written under
+// "{prefix}/{namespace}/{pipeline}/{vertex}/{replica}/" in the object store.
+func NewObjectStoreManager(vertexInstance *dfv1.VertexInstance, store ObjectStore, opts ...Option) wal.Manager {
+	m := &objectStoreManager{
+		store:          store,
+		prefix:         DefaultKeyPrefix,
+		maxBatchSize:   dfv1.DefaultWALMaxSyncSize,
+		syncDuration:   dfv1.DefaultWALSyncDuration,
+		requestTimeout: defaultRequestTimeout,
+		pipelineName:   vertexInstance.Vertex.Spec.PipelineName,
+		vertexName:     vertexInstance.Vertex.Spec.AbstractVertex.Name,
+		replicaIndex:   vertexInstance.Replica,
+		activeWals:     make(map[string]*objectWAL),
+	}
+	for _, o := range opts {
+		o(m)
+	}
+	m.keyPrefix = path.Join(m.prefix, vertexInstance.Vertex.Namespace, m.pipelineName, m.vertexName, strconv.Itoa(int(m.replicaIndex))) + "/"
+	return m
+}
+
+// CreateWAL creates the object store WAL. No object is created till the first segment is uploaded.
+func (m *objectStoreManager) CreateWAL(_ context.Context, partitionID partition.ID) (wal.WAL, error) {
+	// check if the WAL is already present
+	// during crash recovery, we might have already created the WAL while replaying
+	m.mu.RLock()
+	w, ok := m.activeWals[partitionID.String()]
+	m.mu.RUnlock()
+	if ok {
+		return w, nil
+	}
+
+	w = newObjectWAL(m.store, m.walPrefix(&partitionID), &partitionID, make([]string, 0), 0, m)
+	m.mu.Lock()
+	m.activeWals[partitionID.String()] = w
+	m.mu.Unlock()
+	activeWALsCount.With(map[string]string{
+		metrics.LabelPipeline:           m.pipelineName,
+		metrics.LabelVertex:             m.vertexName,
+		metrics.LabelVertexReplicaIndex: strconv.Itoa(int(m.replicaIndex)),
+	}).Inc()
+	return w, nil
+}
+
+// DiscoverWALs returns all the WALs of this replica, which are found by listing the segments in the object store.
+func (m *objectStoreManager) DiscoverWALs(ctx context.Context) ([]wal.WAL, error) {
+	keys, err := m.store.ListObjects(ctx, m.keyPrefix)
+	if err != nil {
+		return nil, err
+	}
+
+	// group the segments by the WAL they belong to, the keys are listed in lexicographical order and the segment
+	// sequence numbers are zero padded, so the segments of a WAL are in the order of their creation.
+	segmentsByWAL := make(map[string][]string)
+	walPrefixes := make([]string, 0)
+	for _, key := range keys {
+		walPrefix, segment := path.Split(key)
+		if !strings.HasPrefix(segment, SegmentPrefix) || path.Dir(strings.TrimSuffix(walPrefix, "/"))+"/" != m.keyPrefix {
+			continue
+		}
+		if _, ok := segmentsByWAL[walPrefix]; !ok {
+			walPrefixes = append(walPrefixes, walPrefix)
+		}
+		segmentsByWAL[walPrefix] = append(segmentsByWAL[walPrefix], key)
+	}
+
+	wals := make([]wal.WAL, 0, len(walPrefixes))
+	for _, walPrefix := range walPrefixes {
+		segments := segmentsByWAL[walPrefix]
+		id, err := m.readPartitionID(ctx, segments[0])
+		if err != nil {
+			return nil, err
+		}
+		lastSegment := segments[len(segments)-1]
+		seq, err := strconv.ParseInt(strings.TrimPrefix(path.Base(lastSegment), SegmentPrefix+"-"), 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid segment key %q, %w", lastSegment, err)
+		}
+		w := newObjectWAL(m.store, walPrefix, id, segments, seq+1, m)
+		m.mu.Lock()
+		m.activeWals[id.String()] = w
+		m.mu.Unlock()
+		wals = append(wals, w)
+	}
+	// replay the oldest partitions first
+	sort.Slice(wals, func(i, j int) bool {
+		return wals[i].PartitionID().Start.Before(wals[j].PartitionID().Start)
+	})
+	activeWALsCount.With(map[string]string{
+		metrics.LabelPipeline:           m.pipelineName,
+		metrics.LabelVertex:             m.vertexName,
+		metrics.LabelVertexReplicaIndex: strconv.Itoa(int(m.replicaIndex)),
+	}).Add(float64(len(wals)))
+	return wals, nil
+}
+
+// readPartitionID reads the partition ID from the header of the segment.
+func (m *objectStoreManager) readPartitionID(ctx context.Context, key string) (*partition.ID, error) {
+	ctx, cancel := context.WithTimeout(ctx, m.requestTimeout)
+	defer cancel()
+	data, err := m.store.GetObject(ctx, key)
+	if err != nil {
+		return nil, err
+	}
+	id, err := decodeSegmentHeader(bytes.NewReader(data))
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode the header of segment %q, %w", key, err)
+	}
+	return id, nil
+}
+
+// DeleteWAL deletes all the segments of the WAL for the given partitionID.
+func (m *objectStoreManager) DeleteWAL(partitionID partition.ID) error {
+	var err error
+	defer func() {
+		if err != nil {
+			walErrors.With(map[string]string{
+				metrics.LabelPipeline:           m.pipelineName,
+				metrics.LabelVertex:             m.vertexName,
+				metrics.LabelVertexReplicaIndex: strconv.Itoa(int(m.replicaIndex)),
+				labelErrorKind:                  "gc",
+			}).Inc()
+		}
+	}()
+
+	m.mu.RLock()
+	w, ok := m.activeWals[partitionID.String()]
+	m.mu.RUnlock()
+	if ok {
+		// the buffered entries need not be uploaded anymore
+		w.discard()
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), m.requestTimeout)
+	defer cancel()
+	start := time.Now()
+	// list the segments instead of relying on the in-memory state, so that no segment is left behind
+	keys, err := m.store.ListObjects(ctx, m.walPrefix(&partitionID))
+	if err != nil {
+		return err
+	}
+	if err = m.store.DeleteObjects(ctx, keys); err != nil {
+		return err
+	}
+	garbageCollectingTime.With(map[string]string{
+		metrics.LabelPipeline:           m.pipelineName,
+		metrics.LabelVertex:             m.vertexName,
+		metrics.LabelVertexReplicaIndex: strconv.Itoa(int(m.replicaIndex)),
+	}).Observe(float64(time.Since(start).Milliseconds()))
+
+	m.mu.Lock()
+	if _, ok = m.activeWals[partitionID.String()]; ok {
+		delete(m.activeWals, partitionID.String())
+		activeWALsCount.With(map[string]string{
+			metrics.LabelPipeline:           m.pipelineName,
+			metrics.LabelVertex:             m.vertexName,
+			metrics.LabelVertexReplicaIndex: strconv.Itoa(int(m.replicaIndex)),
+		}).Dec()
+	}
+	m.mu.Unlock()
+	return nil
+}
+
+// walPrefix returns the key prefix of the segments of the WAL for the given partitionID.
+func (m *objectStoreManager) walPrefix(id *partition.ID) string {
+	return fmt.Sprintf("%s%d.%d.%s/", m.keyPrefix, id.Start.UnixMilli(), id.End.UnixMilli(), url.PathEscape(id.Slot))
+}
+
+// segmentKey returns the key of the segment with the given sequence number.
+func segmentKey(walPrefix string, seq int64) string {
+	return fmt.Sprintf("%s%s-%020d", walPrefix, SegmentPrefix, seq)
+}
@@ -0,0 +1,241 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package objectstore
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	dfv1 "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1"
+	"github.com/numaproj/numaflow/pkg/isb"
+	"github.com/numaproj/numaflow/pkg/isb/testutils"
+	"github.com/numaproj/numaflow/pkg/reduce/pbq/partition"
+	"github.com/numaproj/numaflow/pkg/reduce/pbq/wal"
+)
+
+var vi = &dfv1.VertexInstance{
+	Vertex: &dfv1.Vertex{Spec: dfv1.VertexSpec{
+		PipelineName: "testPipeline",
+		AbstractVertex: dfv1.AbstractVertex{
+			Name: "testVertex",
+		},
+	}},
+	Hostname: "test-host",
+	Replica:  0,
+}
+
+// memStore is an in-memory ObjectStore.
+type memStore struct {
+	objects map[string][]byte
+	// failPuts is the number of the next PutObject calls which fail.
+	failPuts int
+	mu       sync.Mutex
+}
+
+func newMemStore() *memStore {
+	return &memStore{objects: make(map[string][]byte)}
+}
+
+func (s *memStore) PutObject(_ context.Context, key string, data []byte) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.failPuts > 0 {
+		s.failPuts--
+		return fmt.Errorf("failed to put object %q", key)
+	}
+	s.objects[key] = append([]byte(nil), data...)
+	return nil
+}
+
+func (s *memStore) GetObject(_ context.Context, key string) ([]byte, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	data, ok := s.objects[key]
+	if !ok {
+		return nil, fmt.Errorf("object %q not found", key)
+	}
+	return data, nil
+}
+
+func (s *memStore) ListObjects(_ context.Context, prefix string) ([]string, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	keys := make([]string, 0)
+	for key := range s.objects {
+		if strings.HasPrefix(key, prefix) {
+			keys = append(keys, key)
+		}
+	}
+	sort.Strings(keys)
+	return keys, nil
+}
+
+func (s *memStore) DeleteObjects(_ context.Context, keys []string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for _, key := range keys {
+		delete(s.objects, key)
+	}
+	return nil
+}
+
+func replayAll(t *testing.T, w wal.WAL) []*isb.ReadMessage {
+	readCh, errCh := w.Replay()
+	var messages []*isb.ReadMessage
+	for {
+		select {
+		case err := <-errCh:
+			assert.NoError(t, err)
+			return messages
+		case msg, ok := <-readCh:
+			if !ok {
+				return messages
+			}
+			messages = append(messages, msg)
+		}
+	}
+}
+
+func TestObjectStoreManager(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	partitionIds := []partition.ID{
+		{Start: time.Unix(60, 0), End: time.Unix(120, 0), Slot: "test-1"},
+		{Start: time.Unix(120, 0), End: time.Unix(180, 0), Slot: "test/2"},
+	}
+	store := newMemStore()
+	// a small batch size, so that every write uploads a segment
+	manager := NewObjectStoreManager(vi, store, WithPrefix("wal"), WithMaxBatchSize(1))
+
+	msgCount := 5
+	for _, partitionID := range partitionIds {
+		w, err := manager.CreateWAL(ctx, partitionID)
+		assert.NoError(t, err)
+		messages := testutils.BuildTestReadMessagesIntOffset(int64(msgCount), partitionID.Start, nil)
+		for _, msg := range messages {
+			assert.NoError(t, w.Write(&msg))
+		}
+		assert.NoError(t, w.Close())
+	}
+	keys, _ := store.ListObjects(ctx, "wal/testPipeline/testVertex/0/")
+	assert.Len(t, keys, len(partitionIds)*msgCount)
+
+	// discover the WALs after a restart
+	manager = NewObjectStoreManager(vi, store, WithPrefix("wal"))
+	discovered, err := manager.DiscoverWALs(ctx)
+	assert.NoError(t, err)
+	assert.Len(t, discovered, len(partitionIds))
+	for i, w := range discovered {
+		assert.Equal(t, partitionIds[i].String(), w.PartitionID().String())
+		messages := replayAll(t, w)
+		assert.Len(t, messages, msgCount)
+		for j, msg := range messages {
+			offset, _ := msg.ReadOffset.Sequence()
+			assert.Equal(t, int64(j), offset)
+		}
+
+		// the new segments are appended after the discovered ones
+		extra := testutils.BuildTestReadMessagesIntOffset(1, partitionIds[i].Start, nil)
+		assert.NoError(t, w.Write(&extra[0]))
+		assert.NoError(t, w.Close())
+		assert.Len(t, replayAll(t, w), msgCount+1)
+	}
+
+	for _, partitionID := range partitionIds {
+		assert.NoError(t, manager.DeleteWAL(partitionID))
+	}
+	discovered, err = manager.DiscoverWALs(ctx)
+	assert.NoError(t, err)
+	assert.Len(t, discovered, 0)
+}
+
+func TestObjectWAL_WriteFailure(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	store := newMemStore()
+	manager := NewObjectStoreManager(vi, store, WithMaxBatchSize(1))
+	partitionID := partition.ID{Start: time.Unix(60, 0), End: time.Unix(120, 0), Slot: "test-1"}
+	w, err := manager.CreateWAL(ctx, partitionID)
+	assert.NoError(t, err)
+
+	messages := testutils.BuildTestReadMessagesIntOffset(2, partitionID.Start, nil)
+	assert.NoError(t, w.Write(&messages[0]))
+
+	// the failed write should not leave the message in the buffer, since it will be retried
+	store.failPuts = 1
+	assert.Error(t, w.Write(&messages[1]))
+	assert.NoError(t, w.Write(&messages[1]))
+	assert.NoError(t, w.Close())
+
+	assert.Len(t, replayAll(t, w), 2)
+}
+
+func TestObjectWAL_Flush(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	store := newMemStore()
+	manager := NewObjectStoreManager(vi, store)
+	partitionID := partition.ID{Start: time.Unix(60, 0), End: time.Unix(120, 0), Slot: "test-1"}
+	w, err := manager.CreateWAL(ctx, partitionID)
+	assert.NoError(t, err)
+
+	messages := testutils.BuildTestReadMessagesIntOffset(2, partitionID.Start, nil)
+	for _, msg := range messages {
+		assert.NoError(t, w.Write(&msg))
+	}
+	// the messages are buffered till the WAL is flushed
+	assert.Len(t, replayAll(t, w), 0)
+
+	// a failed flush keeps the messages in the buffer, so that the flush can be retried
+	store.failPuts = 1
+	assert.Error(t, w.(wal.Flusher).Flush())
+	assert.NoError(t, w.(wal.Flusher).Flush())
+	assert.Len(t, replayAll(t, w), 2)
+
+	// nothing is uploaded if there are no buffered messages
+	assert.NoError(t, w.(wal.Flusher).Flush())
+	keys, _ := store.ListObjects(ctx, "")
+	assert.Len(t, keys, 1)
+	assert.NoError(t, w.Close())
+}
+
+func TestCodec(t *testing.T) {
+	id := &partition.ID{Start: time.UnixMilli(60000), End: time.UnixMilli(120000), Slot: "slot-ü"}
+	header, err := encodeSegmentHeader(id)
+	assert.NoError(t, err)
+	decoded, err := decodeSegmentHeader(header)
+	assert.NoError(t, err)
+	assert.Equal(t, id.String(), decoded.String())
+
+	messages := testutils.BuildTestReadMessagesIntOffset(1, time.UnixMilli(60000), nil)
+	entry, err := encodeEntry(&messages[0])
+	assert.NoError(t, err)
+	// corrupt the payload
+	entry[len(entry)-1] ^= 0xff
+	_, err = decodeEntry(strings.NewReader(string(entry)))
+	assert.ErrorIs(t, err, errChecksumMismatch)
+}
@@ -0,0 +1,58 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package objectstore
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+
+	"github.com/numaproj/numaflow/pkg/metrics"
+)
+
+const (
+	labelErrorKind = "kind"
+)
+
+var segmentsCount = promauto.NewCounterVec(prometheus.CounterOpts{
+	Subsystem: "pbq",
+	Name:      "aligned_objectstore_wal_segments_total",
+	Help:      "Total number of segments uploaded to the object store",
+}, []string{metrics.LabelPipeline, metrics.LabelVertex, metrics.LabelVertexReplicaIndex})
+
+var activeWALsCount = promauto.NewGaugeVec(prometheus.GaugeOpts{
+	Subsystem: "pbq",
+	Name:      "aligned_objectstore_active_wals",
+	Help:      "Total number of active object store WALs/partitions",
+}, []string{metrics.LabelPipeline, metrics.LabelVertex, metrics.LabelVertexReplicaIndex})
+
+var segmentUploadLatency = promauto.NewSummaryVec(prometheus.SummaryOpts{
+	Subsystem: "pbq",
+	Name:      "aligned_objectstore_wal_segment_upload_latency",
+	Help:      "Time taken to upload a segment to the object store (in milliseconds)",
+}, []string{metrics.LabelPipeline, metrics.LabelVertex, metrics.LabelVertexReplicaIndex})
+
+var garbageCollectingTime = promauto.NewSummaryVec(prometheus.SummaryOpts{
+	Subsystem: "pbq",
+	Name:      "aligned_objectstore_wal_garbage_collecting_time",
+	Help:      "Time taken to delete the segments of a WAL from the object store (in milliseconds)",
+}, []string{metrics.LabelPipeline, metrics.LabelVertex, metrics.LabelVertexReplicaIndex})
+
+var walErrors = promauto.NewCounterVec(prometheus.CounterOpts{
+	Subsystem: "pbq",
+	Name:      "aligned_objectstore_wal_errors",
+	Help:      "Errors encountered",
+}, []string{metrics.LabelPipeline, metrics.LabelVertex, metrics.LabelVertexReplicaIndex, labelErrorKind})
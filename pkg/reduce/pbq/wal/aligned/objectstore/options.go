@@ -0,0 +1,51 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package objectstore
+
+import (
+	"time"
+)
+
+type Option func(*objectStoreManager)
+
+// WithPrefix sets the key prefix of the WAL segments in the bucket
+func WithPrefix(prefix string) Option {
+	return func(m *objectStoreManager) {
+		m.prefix = prefix
+	}
+}
+
+// WithMaxBatchSize sets the max size of the buffered entries before they are uploaded as a segment
+func WithMaxBatchSize(size int64) Option {
+	return func(m *objectStoreManager) {
+		m.maxBatchSize = size
+	}
+}
+
+// WithSyncDuration sets the duration after which the buffered entries are uploaded as a segment
+func WithSyncDuration(maxDuration time.Duration) Option {
+	return func(m *objectStoreManager) {
+		m.syncDuration = maxDuration
+	}
+}
+
+// WithRequestTimeout sets the timeout of the object store requests
+func WithRequestTimeout(timeout time.Duration) Option {
+	return func(m *objectStoreManager) {
+		m.requestTimeout = timeout
+	}
+}
@@ -0,0 +1,120 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package objectstore
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/aws/aws-sdk-go-v2/service/s3/types"
+)
+
+// maxDeleteObjects is the max number of keys which can be deleted by one DeleteObjects request.
+const maxDeleteObjects = 1000
+
+// ObjectStore is the set of object store operations used by the WAL.
+type ObjectStore interface {
+	// PutObject uploads the object with the given key.
+	PutObject(ctx context.Context, key string, data []byte) error
+	// GetObject downloads the object with the given key.
+	GetObject(ctx context.Context, key string) ([]byte, error)
+	// ListObjects returns the keys of all the objects with the given prefix in lexicographical order.
+	ListObjects(ctx context.Context, prefix string) ([]string, error)
+	// DeleteObjects deletes the objects with the given keys.
+	DeleteObjects(ctx context.Context, keys []string) error
+}
+
+// s3Store is an ObjectStore backed by an S3 bucket.
+type s3Store struct {
+	client *s3.Client
+	bucket string
+}
+
+// NewS3Store returns an ObjectStore backed by the given S3 bucket.
+func NewS3Store(client *s3.Client, bucket string) ObjectStore {
+	return &s3Store{
+		client: client,
+		bucket: bucket,
+	}
+}
+
+func (s *s3Store) PutObject(ctx context.Context, key string, data []byte) error {
+	_, err := s.client.PutObject(ctx, &s3.PutObjectInput{
+		Bucket: aws.String(s.bucket),
+		Key:    aws.String(key),
+		Body:   bytes.NewReader(data),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to put object %q, %w", key, err)
+	}
+	return nil
+}
+
+func (s *s3Store) GetObject(ctx context.Context, key string) ([]byte, error) {
+	out, err := s.client.GetObject(ctx, &s3.GetObjectInput{
+		Bucket: aws.String(s.bucket),
+		Key:    aws.String(key),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get object %q, %w", key, err)
+	}
+	defer func() { _ = out.Body.Close() }()
+	return io.ReadAll(out.Body)
+}
+
+func (s *s3Store) ListObjects(ctx context.Context, prefix string) ([]string, error) {
+	keys := make([]string, 0)
+	paginator := s3.NewListObjectsV2Paginator(s.client, &s3.ListObjectsV2Input{
+		Bucket: aws.String(s.bucket),
+		Prefix: aws.String(prefix),
+	})
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list objects with prefix %q, %w", prefix, err)
+		}
+		for _, obj := range page.Contents {
+			keys = append(keys, aws.ToString(obj.Key))
+		}
+	}
+	return keys, nil
+}
+
+func (s *s3Store) DeleteObjects(ctx context.Context, keys []string) error {
+	for start := 0; start < len(keys); start += maxDeleteObjects {
+		end := min(start+maxDeleteObjects, len(keys))
+		objects := make([]types.ObjectIdentifier, 0, end-start)
+		for _, key := range keys[start:end] {
+			objects = append(objects, types.ObjectIdentifier{Key: aws.String(key)})
+		}
+		out, err := s.client.DeleteObjects(ctx, &s3.DeleteObjectsInput{
+			Bucket: aws.String(s.bucket),
+			Delete: &types.Delete{Objects: objects, Quiet: aws.Bool(true)},
+		})
+		if err != nil {
+			return fmt.Errorf("failed to delete objects, %w", err)
+		}
+		if len(out.Errors) > 0 {
+			return fmt.Errorf("failed to delete object %q, %s", aws.ToString(out.Errors[0].Key), aws.ToString(out.Errors[0].Message))
+		}
+	}
+	return nil
+}
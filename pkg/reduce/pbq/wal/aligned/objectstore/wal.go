@@ -0,0 +1,257 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package objectstore
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/numaproj/numaflow/pkg/isb"
+	"github.com/numaproj/numaflow/pkg/metrics"
+	"github.com/numaproj/numaflow/pkg/reduce/pbq/partition"
+	"github.com/numaproj/numaflow/pkg/reduce/pbq/wal"
+	"github.com/numaproj/numaflow/pkg/reduce/pbq/wal/aligned"
+)
+
+// objectWAL implements a write-ahead-log on an object store. Since objects are immutable, the entries are buffered
+// in memory and uploaded as a new segment object once the buffer exceeds maxBatchSize or syncDuration has elapsed
+// since the last upload, when the WAL is flushed, and when the WAL is closed. The reader flushes the WALs before the
+// messages are acked, so that the messages which are not uploaded yet are redelivered if the pod crashes.
+type objectWAL struct {
+	store          ObjectStore
+	prefix         string        // prefix is the key prefix of the segments of the WAL
+	partitionID    *partition.ID // partitionID is the partition ID of the WAL
+	maxBatchSize   int64         // maxBatchSize is the maximum size of the buffered entries before they are uploaded.
+	syncDuration   time.Duration // syncDuration is the duration after which the buffered entries are uploaded.
+	requestTimeout time.Duration // requestTimeout is the timeout of the object store requests.
+	buf            *bytes.Buffer // buf holds the entries which are not uploaded yet.
+	segments       []string      // segments are the keys of the uploaded segments, in the order of their creation.
+	nextSegment    int64         // nextSegment is the sequence number of the next segment.
+	prevSyncedTime time.Time     // prevSyncedTime is the time when the last segment was uploaded.
+	closed         bool
+	pipelineName   string
+	vertexName     string
+	replicaIndex   int32
+	mu             sync.Mutex
+}
+
+func newObjectWAL(store ObjectStore, prefix string, id *partition.ID, segments []string, nextSegment int64, m *objectStoreManager) *objectWAL {
+	return &objectWAL{
+		store:          store,
+		prefix:         prefix,
+		partitionID:    id,
+		maxBatchSize:   m.maxBatchSize,
+		syncDuration:   m.syncDuration,
+		requestTimeout: m.requestTimeout,
+		buf:            new(bytes.Buffer),
+		segments:       segments,
+		nextSegment:    nextSegment,
+		prevSyncedTime: time.Now(),
+		pipelineName:   m.pipelineName,
+		vertexName:     m.vertexName,
+		replicaIndex:   m.replicaIndex,
+	}
+}
+
+// PartitionID returns the partition ID of the WAL.
+func (w *objectWAL) PartitionID() *partition.ID {
+	return w.partitionID
+}
+
+// Write appends the message to the buffered entries, and uploads them as a new segment if the buffer is full or the
+// sync duration has elapsed. If the upload fails, the message is removed from the buffer so that it can be retried.
+func (w *objectWAL) Write(message *isb.ReadMessage) (err error) {
+	defer func() {
+		if err != nil {
+			walErrors.With(map[string]string{
+				metrics.LabelPipeline:           w.pipelineName,
+				metrics.LabelVertex:             w.vertexName,
+				metrics.LabelVertexReplicaIndex: strconv.Itoa(int(w.replicaIndex)),
+				labelErrorKind:                  "write",
+			}).Inc()
+		}
+	}()
+
+	entry, err := encodeEntry(message)
+	if err != nil {
+		return err
+	}
+
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	if w.closed {
+		return aligned.ErrWriteStoreClosed
+	}
+
+	prevLen := w.buf.Len()
+	w.buf.Write(entry)
+	if int64(w.buf.Len()) > w.maxBatchSize || time.Since(w.prevSyncedTime) > w.syncDuration {
+		if err = w.flush(); err != nil {
+			w.buf.Truncate(prevLen)
+			return err
+		}
+	}
+	return nil
+}
+
+// flush uploads the buffered entries as a new segment.
+func (w *objectWAL) flush() error {
+	if w.buf.Len() == 0 {
+		return nil
+	}
+
+	header, err := encodeSegmentHeader(w.partitionID)
+	if err != nil {
+		return err
+	}
+	data := make([]byte, 0, header.Len()+w.buf.Len())
+	data = append(data, header.Bytes()...)
+	data = append(data, w.buf.Bytes()...)
+
+	key := segmentKey(w.prefix, w.nextSegment)
+	ctx, cancel := context.WithTimeout(context.Background(), w.requestTimeout)
+	defer cancel()
+	start := time.Now()
+	if err = w.store.PutObject(ctx, key, data); err != nil {
+		return err
+	}
+	segmentUploadLatency.With(map[string]string{
+		metrics.LabelPipeline:           w.pipelineName,
+		metrics.LabelVertex:             w.vertexName,
+		metrics.LabelVertexReplicaIndex: strconv.Itoa(int(w.replicaIndex)),
+	}).Observe(float64(time.Since(start).Milliseconds()))
+	segmentsCount.With(map[string]string{
+		metrics.LabelPipeline:           w.pipelineName,
+		metrics.LabelVertex:             w.vertexName,
+		metrics.LabelVertexReplicaIndex: strconv.Itoa(int(w.replicaIndex)),
+	}).Inc()
+
+	w.segments = append(w.segments, key)
+	w.nextSegment++
+	w.prevSyncedTime = time.Now()
+	w.buf.Reset()
+	return nil
+}
+
+// Flush uploads the buffered entries as a new segment.
+func (w *objectWAL) Flush() error {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	if w.closed {
+		return nil
+	}
+	if err := w.flush(); err != nil {
+		walErrors.With(map[string]string{
+			metrics.LabelPipeline:           w.pipelineName,
+			metrics.LabelVertex:             w.vertexName,
+			metrics.LabelVertexReplicaIndex: strconv.Itoa(int(w.replicaIndex)),
+			labelErrorKind:                  "flush",
+		}).Inc()
+		return err
+	}
+	return nil
+}
+
+// Replay replays the messages of the uploaded segments, returns a channel to read messages and a channel to read
+// errors. The messages channel will be closed after all the messages are read from the segments.
+func (w *objectWAL) Replay() (<-chan *isb.ReadMessage, <-chan error) {
+	messages := make(chan *isb.ReadMessage)
+	errs := make(chan error)
+
+	w.mu.Lock()
+	segments := make([]string, len(w.segments))
+	copy(segments, w.segments)
+	w.mu.Unlock()
+
+	go func() {
+		defer close(messages)
+		for _, key := range segments {
+			if err := w.replaySegment(key, messages); err != nil {
+				errs <- err
+				return
+			}
+		}
+	}()
+	return messages, errs
+}
+
+// replaySegment downloads the segment and sends its messages to the channel.
+func (w *objectWAL) replaySegment(key string, messages chan<- *isb.ReadMessage) error {
+	ctx, cancel := context.WithTimeout(context.Background(), w.requestTimeout)
+	data, err := w.store.GetObject(ctx, key)
+	cancel()
+	if err != nil {
+		return err
+	}
+
+	reader := bytes.NewReader(data)
+	id, err := decodeSegmentHeader(reader)
+	if err != nil {
+		return fmt.Errorf("failed to decode the header of segment %q, %w", key, err)
+	}
+	if id.String() != w.partitionID.String() {
+		return fmt.Errorf("segment %q belongs to partition %q, expected %q", key, id.String(), w.partitionID.String())
+	}
+	for reader.Len() > 0 {
+		message, err := decodeEntry(reader)
+		if err != nil {
+			if errors.Is(err, io.ErrUnexpectedEOF) {
+				return fmt.Errorf("segment %q is truncated, %w", key, err)
+			}
+			return err
+		}
+		messages <- message
+	}
+	return nil
+}
+
+// Close uploads the buffered entries and closes the WAL.
+func (w *objectWAL) Close() error {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	if w.closed {
+		return nil
+	}
+	if err := w.flush(); err != nil {
+		walErrors.With(map[string]string{
+			metrics.LabelPipeline:           w.pipelineName,
+			metrics.LabelVertex:             w.vertexName,
+			metrics.LabelVertexReplicaIndex: strconv.Itoa(int(w.replicaIndex)),
+			labelErrorKind:                  "close",
+		}).Inc()
+		return err
+	}
+	w.closed = true
+	return nil
+}
+
+// discard closes the WAL without uploading the buffered entries, it is used when the WAL is deleted.
+func (w *objectWAL) discard() {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	w.buf.Reset()
+	w.closed = true
+}
+
+var _ wal.WAL = (*objectWAL)(nil)
+var _ wal.Flusher = (*objectWAL)(nil)
@@ -0,0 +1,68 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package wal
+
+import (
+	"time"
+
+	"github.com/numaproj/numaflow/pkg/reduce/pbq/partition"
+)
+
+// EntryInfo describes a message entry of a WAL segment file, it is used to inspect the WALs offline.
+type EntryInfo struct {
+	// Position is the byte position of the entry in the segment file.
+	Position int64
+	// Size is the size of the entry in bytes.
+	Size      int64
+	Offset    int64
+	EventTime time.Time
+	Watermark time.Time
+	Keys      []string
+}
+
+// GCEventInfo describes a GC event of a GC events file, it is used to inspect the WALs offline.
+type GCEventInfo struct {
+	// Position is the byte position of the event in the GC events file.
+	Position int64
+	// Size is the size of the event in bytes.
+	Size  int64
+	Start time.Time
+	End   time.Time
+	Slot  string
+	Key   string
+}
+
+// SegmentInfo is the result of scanning a WAL segment file or a GC events file.
+type SegmentInfo struct {
+	Path string
+	// Size is the size of the file in bytes.
+	Size int64
+	// PartitionID is the partition ID in the header of the segment, it is nil for the GC events files or if the
+	// header can not be decoded.
+	PartitionID *partition.ID
+	// Entries is the number of the valid entries in the file.
+	Entries int
+	// ValidSize is the size of the valid prefix of the file, the bytes after it can not be decoded.
+	ValidSize int64
+	// Err is the error which stopped the scan at ValidSize, it is nil if the whole file is valid.
+	Err error
+}
+
+// Corrupted returns true if the file has bytes which can not be decoded, e.g. a torn write or a checksum mismatch.
+func (s *SegmentInfo) Corrupted() bool {
+	return s.Err != nil
+}
@@ -18,6 +18,7 @@ package wal
 
 import (
 	"context"
+	"time"
 
 	"github.com/numaproj/numaflow/pkg/isb"
 	"github.com/numaproj/numaflow/pkg/reduce/pbq/partition"
@@ -36,6 +37,19 @@ type WAL interface {
 	Close() error
 }
 
+// TimedWriter is implemented by the WALs which compact the persisted messages based on their event time.
+type TimedWriter interface {
+	// WriteAt writes the message to the WAL to be compacted as if it was at the given event time, the message is
+	// replayed with its own event time.
+	WriteAt(msg *isb.ReadMessage, eventTime time.Time) error
+}
+
+// Flusher is implemented by the WALs which buffer the written messages in memory.
+type Flusher interface {
+	// Flush persists the buffered messages, the messages should not be acked before they are flushed.
+	Flush() error
+}
+
 // Manager defines the interface to manage the WALs.
 type Manager interface {
 	// CreateWAL returns a new WAL instance.
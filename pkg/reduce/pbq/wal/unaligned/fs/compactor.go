@@ -67,6 +67,7 @@ type compactor struct {
 	stopSignal          chan struct{}
 	doneCh              chan struct{}
 	latestWatermark     int64
+	keepUnclosedKeys    bool
 	log                 *zap.SugaredLogger
 }
 
@@ -500,12 +501,13 @@ func (c *compactor) shouldKeepMessage(eventTime int64, key string) bool {
 	// check if the key is present in the compaction key map
 	ce, ok := c.compactKeyMap[key]
 
-	// we should not discard the messages which are not older than the max end time
-	if ok && eventTime >= ce {
-		return true
+	// none of the windows of the key has been closed yet, the messages are kept only if the key could have open panes
+	if !ok {
+		return c.keepUnclosedKeys
 	}
 
-	return false
+	// we should not discard the messages which are not older than the max end time
+	return eventTime >= ce
 }
 
 // writeToFile writes the message to the compacted file and rotates the file if the max file size is reached
@@ -450,6 +450,24 @@ func Test_buildCompactionKeyMap(t *testing.T) {
 	assert.Equal(t, int64(60100), c.compactKeyMap["::"])
 }
 
+func TestCompactor_ShouldKeepMessage(t *testing.T) {
+	c := &compactor{
+		compactKeyMap: map[string]int64{"key-1": 60010},
+	}
+
+	// messages of the closed windows are discarded
+	assert.False(t, c.shouldKeepMessage(60000, "key-1"))
+	// messages of the windows which are still open are kept
+	assert.True(t, c.shouldKeepMessage(60010, "key-1"))
+	// none of the windows of the key have been closed
+	assert.False(t, c.shouldKeepMessage(60000, "key-2"))
+
+	// the panes of the global windows are open until the trigger fires
+	WithKeepUnclosedKeys()(c)
+	assert.False(t, c.shouldKeepMessage(60000, "key-1"))
+	assert.True(t, c.shouldKeepMessage(60000, "key-2"))
+}
+
 // cleanup_dir removes all the files in the directory
 func cleanupDir(dir string) {
 	_ = os.RemoveAll(dir)
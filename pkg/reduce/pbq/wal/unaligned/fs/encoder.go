@@ -23,6 +23,7 @@ import (
 	"hash/crc32"
 	"log"
 	"strings"
+	"time"
 
 	dfv1 "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1"
 	"github.com/numaproj/numaflow/pkg/isb"
@@ -94,8 +95,9 @@ func (e *encoder) encodeHeader(id *partition.ID) ([]byte, error) {
 	return buf.Bytes(), nil
 }
 
-// encodeMessage encodes the given isb.ReadMessage to a binary format.
-func (e *encoder) encodeMessage(message *isb.ReadMessage) ([]byte, error) {
+// encodeMessage encodes the given isb.ReadMessage to a binary format. The event time in the header is only used for
+// compaction, the message itself carries its own event time.
+func (e *encoder) encodeMessage(message *isb.ReadMessage, eventTime time.Time) ([]byte, error) {
 	buf := new(bytes.Buffer)
 
 	combinedKey := strings.Join(message.Keys, dfv1.KeysDelimitter)
@@ -110,7 +112,7 @@ func (e *encoder) encodeMessage(message *isb.ReadMessage) ([]byte, error) {
 	checksum := calculateChecksum(body)
 
 	// Prepare and encode the message header
-	headerBuf, err := e.encodeWALMessageHeader(message, int64(len(body)), checksum, int32(len(combinedKey)))
+	headerBuf, err := e.encodeWALMessageHeader(message, eventTime, int64(len(body)), checksum, int32(len(combinedKey)))
 	if err != nil {
 		return nil, err
 	}
@@ -170,7 +172,7 @@ func calculateChecksum(data []byte) uint32 {
 }
 
 // encodeWALMessageHeader encodes the WALMessage header.
-func (e *encoder) encodeWALMessageHeader(message *isb.ReadMessage, bodyLen int64, checksum uint32, keyLen int32) (*bytes.Buffer, error) {
+func (e *encoder) encodeWALMessageHeader(message *isb.ReadMessage, eventTime time.Time, bodyLen int64, checksum uint32, keyLen int32) (*bytes.Buffer, error) {
 	buf := new(bytes.Buffer)
 
 	offset, err := message.ReadOffset.Sequence()
@@ -183,7 +185,7 @@ func (e *encoder) encodeWALMessageHeader(message *isb.ReadMessage, bodyLen int64
 	hp := &readMessageHeaderPreamble{
 		Offset:     offset,
 		WaterMark:  message.Watermark.UnixMilli(),
-		EventTime:  message.EventTime.UnixMilli(),
+		EventTime:  eventTime.UnixMilli(),
 		MessageLen: bodyLen,
 		Checksum:   checksum,
 		KeyLen:     keyLen,
@@ -17,6 +17,7 @@ limitations under the License.
 package fs
 
 import (
+	"bytes"
 	"os"
 	"testing"
 	"time"
@@ -59,7 +60,7 @@ func TestEncodingAndDecoding(t *testing.T) {
 	// build test read messages
 	readMessages := testutils.BuildTestReadMessages(100, time.UnixMilli(60000), []string{"key1:key2"})
 	for _, msg := range readMessages {
-		bytes, err = ec.encodeMessage(&msg)
+		bytes, err = ec.encodeMessage(&msg, msg.EventTime)
 		assert.NoError(t, err)
 		_, err = fp.Write(bytes)
 		assert.NoError(t, err)
@@ -102,3 +103,21 @@ func TestEncodingAndDecoding(t *testing.T) {
 	assert.Equal(t, dMsg, dm)
 
 }
+
+// TestEncodingAtEventTime tests that the event time used for compaction does not change the event time of the message
+func TestEncodingAtEventTime(t *testing.T) {
+	ec := newEncoder()
+	dc := newDecoder()
+
+	msg := testutils.BuildTestReadMessages(1, time.UnixMilli(60000), []string{"key1:key2"})[0]
+	data, err := ec.encodeMessage(&msg, time.UnixMilli(70000))
+	assert.NoError(t, err)
+
+	header, err := dc.decodeWALMessageHeader(bytes.NewReader(data))
+	assert.NoError(t, err)
+	assert.Equal(t, int64(70000), header.EventTime)
+
+	decoded, _, err := dc.decodeMessage(bytes.NewReader(data))
+	assert.NoError(t, err)
+	assert.Equal(t, msg.EventTime.UnixMilli(), decoded.EventTime.UnixMilli())
+}
@@ -0,0 +1,144 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fs
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/numaproj/numaflow/pkg/reduce/pbq/wal"
+)
+
+// runeSize is the encoded size of a rune, the keys and slots are encoded as []rune.
+const runeSize = 4
+
+// ScanSegment decodes the unaligned WAL segment file, either a data segment or a compacted segment, and calls fn for
+// each of its entries. It stops at the first entry which can not be decoded, e.g. a torn write or a checksum mismatch,
+// and reports it in the returned SegmentInfo so that the segment can be truncated to its valid prefix. An error is
+// returned only if the file can not be read or fn returns an error. It is used to inspect the WALs offline, and must
+// not be called on the WALs of a running vertex.
+func ScanSegment(filePath string, fn func(*wal.EntryInfo) error) (*wal.SegmentInfo, error) {
+	data, err := os.ReadFile(filePath)
+	if err != nil {
+		return nil, err
+	}
+	info := &wal.SegmentInfo{Path: filePath, Size: int64(len(data))}
+
+	d := newDecoder()
+	reader := bytes.NewReader(data)
+	id, err := d.decodeHeader(reader)
+	if err != nil {
+		info.Err = fmt.Errorf("failed to decode the segment header, %w", err)
+		return info, nil
+	}
+	info.PartitionID = id
+	info.ValidSize = info.Size - int64(reader.Len())
+
+	headerSize := int64(binary.Size(readMessageHeaderPreamble{}))
+	for reader.Len() > 0 {
+		position := info.ValidSize
+		// check the lengths in the entry header before decoding, so that a corrupted header does not lead to a huge
+		// allocation.
+		entryHeader, err := d.decodeWALMessageHeader(bytes.NewReader(data[position:]))
+		if err == nil {
+			err = checkLength(headerSize, int64(entryHeader.KeyLen)*runeSize, entryHeader.MessageLen, int64(reader.Len()))
+		}
+		if err != nil {
+			info.Err = fmt.Errorf("failed to decode the entry at position %d, %w", position, err)
+			return info, nil
+		}
+		message, _, err := d.decodeMessage(reader)
+		if err != nil {
+			info.Err = fmt.Errorf("failed to decode the entry at position %d, %w", position, err)
+			return info, nil
+		}
+		info.Entries++
+		info.ValidSize = info.Size - int64(reader.Len())
+		if fn == nil {
+			continue
+		}
+		if err = fn(&wal.EntryInfo{
+			Position:  position,
+			Size:      info.ValidSize - position,
+			Offset:    entryHeader.Offset,
+			EventTime: message.EventTime,
+			Watermark: message.Watermark,
+			Keys:      message.Keys,
+		}); err != nil {
+			return info, err
+		}
+	}
+	return info, nil
+}
+
+// ScanGCEvents decodes the GC events file and calls fn for each of its events. Like ScanSegment, it stops at the first
+// event which can not be decoded and reports it in the returned SegmentInfo.
+func ScanGCEvents(filePath string, fn func(*wal.GCEventInfo) error) (*wal.SegmentInfo, error) {
+	data, err := os.ReadFile(filePath)
+	if err != nil {
+		return nil, err
+	}
+	info := &wal.SegmentInfo{Path: filePath, Size: int64(len(data))}
+
+	d := newDecoder()
+	reader := bytes.NewReader(data)
+	headerSize := int64(binary.Size(deletionMessageHeaderPreamble{}))
+	for reader.Len() > 0 {
+		position := info.ValidSize
+		var eventHeader deletionMessageHeaderPreamble
+		err := binary.Read(bytes.NewReader(data[position:]), binary.LittleEndian, &eventHeader)
+		if err == nil {
+			err = checkLength(headerSize, int64(eventHeader.SLen)*runeSize, int64(eventHeader.KLen)*runeSize, int64(reader.Len()))
+		}
+		if err != nil {
+			info.Err = fmt.Errorf("failed to decode the event at position %d, %w", position, err)
+			return info, nil
+		}
+		event, _, err := d.decodeDeletionMessage(reader)
+		if err != nil {
+			info.Err = fmt.Errorf("failed to decode the event at position %d, %w", position, err)
+			return info, nil
+		}
+		info.Entries++
+		info.ValidSize = info.Size - int64(reader.Len())
+		if fn == nil {
+			continue
+		}
+		if err = fn(&wal.GCEventInfo{
+			Position: position,
+			Size:     info.ValidSize - position,
+			Start:    time.UnixMilli(event.St).In(location),
+			End:      time.UnixMilli(event.Et).In(location),
+			Slot:     event.Slot,
+			Key:      event.Key,
+		}); err != nil {
+			return info, err
+		}
+	}
+	return info, nil
+}
+
+// checkLength checks that the variadic lengths decoded from a header are valid and fit in the remaining bytes.
+func checkLength(headerSize int64, firstLen int64, secondLen int64, remaining int64) error {
+	if firstLen < 0 || secondLen < 0 || headerSize+firstLen+secondLen > remaining {
+		return fmt.Errorf("entry length %d exceeds the remaining %d bytes", headerSize+firstLen+secondLen, remaining)
+	}
+	return nil
+}
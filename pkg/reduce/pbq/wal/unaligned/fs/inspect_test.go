@@ -0,0 +1,119 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fs
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/numaproj/numaflow/pkg/isb/testutils"
+	"github.com/numaproj/numaflow/pkg/reduce/pbq/wal"
+	"github.com/numaproj/numaflow/pkg/window"
+)
+
+func TestScanSegment(t *testing.T) {
+	ctx := context.Background()
+	segmentDir := t.TempDir()
+	compactDir := t.TempDir()
+
+	partitionId := window.SharedUnalignedPartition
+	s, err := NewUnalignedWriteOnlyWAL(ctx, "test-pl", "test-vtx", 0, &partitionId, WithStoreOptions(segmentDir, compactDir))
+	assert.NoError(t, err)
+	readMessages := testutils.BuildTestReadMessagesIntOffset(20, time.UnixMilli(60000), []string{"key-1", "key-2"})
+	for _, readMessage := range readMessages {
+		assert.NoError(t, s.Write(&readMessage))
+	}
+	assert.NoError(t, s.Close())
+
+	files, err := os.ReadDir(segmentDir)
+	assert.NoError(t, err)
+	assert.Len(t, files, 1)
+	filePath := filepath.Join(segmentDir, files[0].Name())
+
+	var entries []*wal.EntryInfo
+	info, err := ScanSegment(filePath, func(entry *wal.EntryInfo) error {
+		entries = append(entries, entry)
+		return nil
+	})
+	assert.NoError(t, err)
+	assert.False(t, info.Corrupted())
+	assert.Equal(t, partitionId.String(), info.PartitionID.String())
+	assert.Equal(t, 20, info.Entries)
+	assert.Len(t, entries, 20)
+	for i, entry := range entries {
+		assert.Equal(t, int64(i), entry.Offset)
+		assert.Equal(t, []string{"key-1", "key-2"}, entry.Keys)
+	}
+	assert.Equal(t, info.Size, entries[19].Position+entries[19].Size)
+
+	// a torn write at the tail
+	assert.NoError(t, os.Truncate(filePath, info.Size-3))
+	info, err = ScanSegment(filePath, nil)
+	assert.NoError(t, err)
+	assert.True(t, info.Corrupted())
+	assert.Equal(t, 19, info.Entries)
+	assert.Equal(t, entries[19].Position, info.ValidSize)
+}
+
+func TestScanGCEvents(t *testing.T) {
+	ctx := context.Background()
+	eventsDir := t.TempDir()
+
+	tracker, err := NewGCEventsWAL(ctx, "test-pl", "test-vtx", 0, WithEventsPath(eventsDir))
+	assert.NoError(t, err)
+	windows := buildTestWindows(time.UnixMilli(60000), 10, time.Second, []string{"key-1", "key-2"})
+	for _, timedWindow := range windows {
+		assert.NoError(t, tracker.PersistGCEvent(timedWindow))
+	}
+	assert.NoError(t, tracker.Close())
+
+	files, err := os.ReadDir(eventsDir)
+	assert.NoError(t, err)
+	assert.Len(t, files, 1)
+	filePath := filepath.Join(eventsDir, files[0].Name())
+
+	var events []*wal.GCEventInfo
+	info, err := ScanGCEvents(filePath, func(event *wal.GCEventInfo) error {
+		events = append(events, event)
+		return nil
+	})
+	assert.NoError(t, err)
+	assert.False(t, info.Corrupted())
+	assert.Equal(t, 10, info.Entries)
+	assert.Len(t, events, 10)
+	for i, event := range events {
+		assert.Equal(t, windows[i].StartTime().UnixMilli(), event.Start.UnixMilli())
+		assert.Equal(t, windows[i].EndTime().UnixMilli(), event.End.UnixMilli())
+		assert.Equal(t, "key-1:key-2", event.Key)
+	}
+
+	// a corrupted length in the header of the last event must not be decoded
+	data, err := os.ReadFile(filePath)
+	assert.NoError(t, err)
+	data[events[9].Position+18] = 0xff
+	assert.NoError(t, os.WriteFile(filePath, data, 0644))
+	info, err = ScanGCEvents(filePath, nil)
+	assert.NoError(t, err)
+	assert.True(t, info.Corrupted())
+	assert.Equal(t, 9, info.Entries)
+	assert.Equal(t, events[9].Position, info.ValidSize)
+}
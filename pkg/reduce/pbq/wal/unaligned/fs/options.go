@@ -116,3 +116,11 @@ func WithCompactionDuration(maxDuration time.Duration) CompactorOption {
 		c.compactionDuration = maxDuration
 	}
 }
+
+// WithKeepUnclosedKeys keeps the messages of the keys for which none of the windows have been closed yet. It is used
+// by the global windows, where a pane of the key is open until the trigger fires.
+func WithKeepUnclosedKeys() CompactorOption {
+	return func(c *compactor) {
+		c.keepUnclosedKeys = true
+	}
+}
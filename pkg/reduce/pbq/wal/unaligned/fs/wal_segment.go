@@ -155,9 +155,18 @@ func NewUnalignedReadWriteWAL(ctx context.Context, pipelineName string, vertexNa
 //
 // CRC will be used for detecting ReadMessage corruptions.
 func (s *unalignedWAL) Write(message *isb.ReadMessage) error {
+	return s.write(message, message.EventTime)
+}
+
+// WriteAt writes the message to the unalignedWAL to be compacted as if it was at the given event time, the message is
+// replayed with its own event time.
+func (s *unalignedWAL) WriteAt(message *isb.ReadMessage, eventTime time.Time) error {
+	return s.write(message, eventTime)
+}
 
+func (s *unalignedWAL) write(message *isb.ReadMessage, eventTime time.Time) error {
 	// encode the message
-	entry, err := s.encoder.encodeMessage(message)
+	entry, err := s.encoder.encodeMessage(message, eventTime)
 	if err != nil {
 		segmentWALErrors.WithLabelValues(s.pipelineName, s.vertexName, strconv.Itoa(int(s.replicaIndex)), "encode").Inc()
 		return err
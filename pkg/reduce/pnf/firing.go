@@ -0,0 +1,352 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package pnf
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+
+	dfv1 "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1"
+	"github.com/numaproj/numaflow/pkg/isb"
+	"github.com/numaproj/numaflow/pkg/metrics"
+	"github.com/numaproj/numaflow/pkg/reduce/pbq/partition"
+	"github.com/numaproj/numaflow/pkg/window"
+)
+
+// firer emits the results of an aligned window before the window is closed. The aligned reduce UDF only emits the
+// result once the window is closed, so a firing reduces the messages seen so far for the window in a separate reduce
+// stream. firer keeps up to maxFiringMessages messages of the window in memory till the window is closed, the firings
+// of a window which has more messages are stopped and its result is only emitted when the window is closed.
+// The firings run in the background so that they do not hold up the requests of the window, at most one firing is
+// queued up while another one is running.
+type firer struct {
+	pf  *ProcessAndForward
+	pid *partition.ID
+	// retained are the requests of the window seen so far, they are sent to the UDF on every firing.
+	retained []*window.TimedWindowRequest
+	// seen is the number of messages of the window so far.
+	seen int
+	// firedSeen is the number of messages of the window covered by the last firing.
+	firedSeen int
+	// overflowed is set once the window has more messages than can be retained, there are no more firings after that.
+	overflowed bool
+	// onTimeFired is set once the watermark has passed the end of the window and the on-time firing is requested,
+	// every message after that is late data.
+	onTimeFired bool
+	// onTimeEmitted is set once the on-time result has been emitted by a firing.
+	onTimeEmitted bool
+	// queued is the firing to run once the running firing is done.
+	queued *queuedFiring
+	// signal wakes up the firing loop when a firing is queued.
+	signal chan struct{}
+	// firings is the number of firings so far, used to generate unique message IDs for the results.
+	firings int
+	mu      sync.Mutex
+}
+
+// queuedFiring is a firing which covers the first count retained requests of the window.
+type queuedFiring struct {
+	firing window.Firing
+	count  int
+	seen   int
+}
+
+func newFirer(pf *ProcessAndForward, pid *partition.ID) *firer {
+	return &firer{
+		pf:       pf,
+		pid:      pid,
+		retained: make([]*window.TimedWindowRequest, 0),
+		signal:   make(chan struct{}, 1),
+	}
+}
+
+// intercept reads the requests of the window, forwards them to the reduce stream of the window and fires the early,
+// on-time and late results based on the triggers. The returned channel is closed once the requests channel is closed
+// and the queued firings are done.
+func (f *firer) intercept(ctx context.Context, requests <-chan *window.TimedWindowRequest) <-chan *window.TimedWindowRequest {
+	out := make(chan *window.TimedWindowRequest)
+	firingDone := make(chan struct{})
+
+	go func() {
+		defer close(firingDone)
+		f.runFirings(ctx)
+	}()
+
+	go func() {
+		defer close(out)
+		// the results of the firings are emitted before the result of the window
+		defer func() {
+			close(f.signal)
+			<-firingDone
+		}()
+
+		var tickerCh <-chan time.Time
+		if f.pf.opts.earlyFiringInterval > 0 {
+			ticker := time.NewTicker(f.pf.opts.earlyFiringInterval)
+			defer ticker.Stop()
+			tickerCh = ticker.C
+		}
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-firingDone:
+				// the firing loop stops only on errors
+				return
+			case <-tickerCh:
+				if f.shouldFireEarly(false) {
+					f.queue(window.Early)
+				}
+			case request, ok := <-requests:
+				if !ok {
+					return
+				}
+
+				// fire requests are not sent to the UDF
+				if request.Operation == window.Fire {
+					if request.Firing == window.OnTime && f.pf.opts.lateFiring && !f.isOnTimeFired() {
+						f.queue(window.OnTime)
+					}
+					break
+				}
+
+				select {
+				case out <- request:
+				case <-ctx.Done():
+					return
+				}
+				if request.ReadMessage == nil {
+					break
+				}
+				f.retain(request)
+
+				if f.isOnTimeFired() {
+					// late data, re-emit the result once there are no more requests queued up for the window
+					if len(requests) == 0 {
+						f.queue(window.Late)
+					}
+				} else if f.shouldFireEarly(true) {
+					f.queue(window.Early)
+				}
+			}
+		}
+	}()
+
+	return out
+}
+
+// runFirings runs the queued firings till the signal channel is closed.
+func (f *firer) runFirings(ctx context.Context) {
+	for range f.signal {
+		for {
+			qf := f.dequeue()
+			if qf == nil {
+				break
+			}
+			if err := f.fire(ctx, qf); err != nil {
+				if ctx.Err() != nil {
+					return
+				}
+				f.pf.log.Errorw("Got an error while firing the window", zap.String("partitionID", f.pid.String()), zap.Error(err))
+				f.pf.shutdownOnce.Do(func() {
+					close(f.pf.shutdownCh)
+				})
+				return
+			}
+		}
+	}
+}
+
+// retain keeps the request to be sent to the UDF on the next firings.
+func (f *firer) retain(request *window.TimedWindowRequest) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.seen++
+	if f.overflowed {
+		return
+	}
+	if len(f.retained) >= f.pf.opts.maxFiringMessages {
+		f.pf.log.Warnw("Too many messages in the window, the firings are stopped till the window is closed",
+			zap.String("partitionID", f.pid.String()), zap.Int("maxFiringMessages", f.pf.opts.maxFiringMessages))
+		f.overflowed = true
+		f.retained = nil
+		f.queued = nil
+		return
+	}
+	f.retained = append(f.retained, request)
+}
+
+func (f *firer) isOnTimeFired() bool {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return f.onTimeFired
+}
+
+// shouldFireEarly returns true if an early firing is due, byCount decides whether the count or the interval trigger
+// is checked.
+func (f *firer) shouldFireEarly(byCount bool) bool {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	pending := f.seen - f.firedSeen
+	if f.queued != nil {
+		pending = f.seen - f.queued.seen
+	}
+	if f.onTimeFired || pending == 0 {
+		return false
+	}
+	if byCount {
+		return f.pf.opts.earlyFiringCount > 0 && pending >= f.pf.opts.earlyFiringCount
+	}
+	return true
+}
+
+// queue queues up the firing of the messages seen so far, it replaces the firing which is already queued up. The
+// on-time firing is never replaced, since it covers the late data seen so far as well.
+func (f *firer) queue(firing window.Firing) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if firing == window.OnTime {
+		f.onTimeFired = true
+	}
+	if f.overflowed {
+		return
+	}
+	if f.queued != nil && f.queued.firing == window.OnTime {
+		firing = window.OnTime
+	}
+	f.queued = &queuedFiring{firing: firing, count: len(f.retained), seen: f.seen}
+
+	select {
+	case f.signal <- struct{}{}:
+	default:
+	}
+}
+
+// dequeue returns the queued firing, nil if there is none.
+func (f *firer) dequeue() *queuedFiring {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	qf := f.queued
+	f.queued = nil
+	if qf != nil {
+		f.firings++
+	}
+	return qf
+}
+
+// fire reduces the messages covered by the firing in a separate reduce stream and forwards the results.
+func (f *firer) fire(ctx context.Context, qf *queuedFiring) error {
+	f.mu.Lock()
+	retained := f.retained
+	firingIdx := f.firings
+	f.mu.Unlock()
+	if len(retained) < qf.count {
+		// the window has overflowed since the firing was queued
+		return nil
+	}
+	retained = retained[:qf.count]
+
+	requests := make(chan *window.TimedWindowRequest)
+	responseCh, errCh := f.pf.reduceApplier.ApplyReduce(ctx, f.pid, requests)
+
+	go func() {
+		defer close(requests)
+		for _, request := range retained {
+			select {
+			case requests <- request:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case err := <-errCh:
+			if err != nil {
+				return err
+			}
+		case response, ok := <-responseCh:
+			if !ok {
+				f.mu.Lock()
+				if qf.seen > f.firedSeen {
+					f.firedSeen = qf.seen
+				}
+				if qf.firing == window.OnTime {
+					f.onTimeEmitted = true
+				}
+				f.mu.Unlock()
+
+				metrics.ReduceFiringsCount.With(map[string]string{
+					metrics.LabelVertex:             f.pf.vertexName,
+					metrics.LabelPipeline:           f.pf.pipelineName,
+					metrics.LabelVertexReplicaIndex: strconv.Itoa(int(f.pf.vertexReplica)),
+					metrics.LabelFiring:             qf.firing.String(),
+				}).Inc()
+				return nil
+			}
+
+			// EOF of the firing stream is not the end of the window
+			if response.EOF {
+				continue
+			}
+
+			// the results of every firing should have unique IDs, otherwise they will be deduplicated by the ISB
+			response.WriteMessage.ID.Offset = fmt.Sprintf("%s-%s-%d", response.WriteMessage.ID.Offset, qf.firing, firingIdx)
+			tagFiring(response.WriteMessage, qf.firing)
+
+			select {
+			case f.pf.responseCh <- response:
+			case <-ctx.Done():
+				return ctx.Err()
+			}
+		}
+	}
+}
+
+// finalResult tags the result of the window emitted when the window is closed. It returns false if the result need
+// not be forwarded, because it has already been emitted by the last firing.
+func (f *firer) finalResult(response *window.TimedWindowResponse) bool {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	firing := window.OnTime
+	if f.onTimeEmitted {
+		if f.seen == f.firedSeen {
+			return false
+		}
+		firing = window.Late
+	}
+	tagFiring(response.WriteMessage, firing)
+	return true
+}
+
+// tagFiring sets the firing header of the message.
+func tagFiring(message *isb.WriteMessage, firing window.Firing) {
+	if message.Headers == nil {
+		message.Headers = make(map[string]string)
+	}
+	message.Headers[dfv1.KeyMetaFiring] = firing.String()
+}
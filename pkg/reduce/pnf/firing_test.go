@@ -0,0 +1,223 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package pnf
+
+import (
+	"context"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	dfv1 "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1"
+	"github.com/numaproj/numaflow/pkg/isb"
+	"github.com/numaproj/numaflow/pkg/reduce/pbq/partition"
+	"github.com/numaproj/numaflow/pkg/shared/logging"
+	"github.com/numaproj/numaflow/pkg/window"
+)
+
+// countReducer emits the number of messages of the window once the request stream is closed.
+type countReducer struct{}
+
+func (c countReducer) ApplyReduce(_ context.Context, partitionID *partition.ID, requestStream <-chan *window.TimedWindowRequest) (<-chan *window.TimedWindowResponse, <-chan error) {
+	errCh := make(chan error)
+	responseCh := make(chan *window.TimedWindowResponse, 2)
+
+	go func() {
+		defer close(responseCh)
+		count := 0
+		for range requestStream {
+			count++
+		}
+		win := window.NewAlignedTimedWindow(partitionID.Start, partitionID.End, partitionID.Slot)
+		responseCh <- &window.TimedWindowResponse{
+			WriteMessage: &isb.WriteMessage{
+				Message: isb.Message{
+					Header: isb.Header{
+						ID: isb.MessageID{
+							VertexName: "testVertex",
+							Offset:     partitionID.String(),
+						},
+						Keys: []string{"count"},
+					},
+					Body: isb.Body{Payload: []byte(strconv.Itoa(count))},
+				},
+			},
+			Window: win,
+		}
+		responseCh <- &window.TimedWindowResponse{Window: win, EOF: true}
+	}()
+
+	return responseCh, errCh
+}
+
+func (c countReducer) WaitUntilReady(_ context.Context) error {
+	return nil
+}
+
+func (c countReducer) CloseConn(_ context.Context) error {
+	return nil
+}
+
+func newTestFirer(ctx context.Context, opts *options) *firer {
+	if opts.maxFiringMessages == 0 {
+		opts.maxFiringMessages = dfv1.DefaultMaxFiringMessages
+	}
+	pf := &ProcessAndForward{
+		vertexName:    "testVertex",
+		pipelineName:  testPipelineName,
+		reduceApplier: countReducer{},
+		responseCh:    make(chan *window.TimedWindowResponse, 10),
+		opts:          opts,
+		log:           logging.FromContext(ctx),
+		shutdownCh:    make(chan struct{}),
+	}
+	pid := &partition.ID{Start: time.UnixMilli(60000), End: time.UnixMilli(120000), Slot: "slot-0"}
+	return newFirer(pf, pid)
+}
+
+func dataRequest(idx int) *window.TimedWindowRequest {
+	return &window.TimedWindowRequest{
+		ReadMessage: &isb.ReadMessage{
+			Message: isb.Message{
+				Header: isb.Header{
+					MessageInfo: isb.MessageInfo{EventTime: time.UnixMilli(60000 + int64(idx))},
+					Keys:        []string{"count"},
+				},
+			},
+		},
+		Operation: window.Append,
+	}
+}
+
+// drain reads the requests forwarded to the reduce stream of the window.
+func drain(ch <-chan *window.TimedWindowRequest) {
+	go func() {
+		for range ch {
+		}
+	}()
+}
+
+func TestFirer_EarlyFiringByCount(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	f := newTestFirer(ctx, &options{earlyFiringCount: 2})
+	requests := make(chan *window.TimedWindowRequest)
+	drain(f.intercept(ctx, requests))
+
+	// the firings run in the background, wait for the result of every firing before sending more messages
+	for i, expected := range []string{"2", "4"} {
+		requests <- dataRequest(2 * i)
+		requests <- dataRequest(2*i + 1)
+		select {
+		case response := <-f.pf.responseCh:
+			assert.Equal(t, expected, string(response.WriteMessage.Payload))
+			assert.Equal(t, window.Early.String(), response.WriteMessage.Headers[dfv1.KeyMetaFiring])
+		case <-ctx.Done():
+			t.Fatal("timed out waiting for the early result")
+		}
+	}
+	requests <- dataRequest(4)
+	close(requests)
+
+	// the final result of the window should be emitted as on-time
+	final := &window.TimedWindowResponse{WriteMessage: &isb.WriteMessage{}}
+	assert.True(t, f.finalResult(final))
+	assert.Equal(t, window.OnTime.String(), final.WriteMessage.Headers[dfv1.KeyMetaFiring])
+}
+
+func TestFirer_LateFiring(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	f := newTestFirer(ctx, &options{lateFiring: true})
+	requests := make(chan *window.TimedWindowRequest)
+	drain(f.intercept(ctx, requests))
+
+	requests <- dataRequest(0)
+	requests <- dataRequest(1)
+	requests <- &window.TimedWindowRequest{Operation: window.Fire, Firing: window.OnTime}
+
+	var offsets []string
+	readResult := func(expectedPayload string, expectedFiring window.Firing) {
+		select {
+		case response := <-f.pf.responseCh:
+			assert.Equal(t, expectedPayload, string(response.WriteMessage.Payload))
+			assert.Equal(t, expectedFiring.String(), response.WriteMessage.Headers[dfv1.KeyMetaFiring])
+			offsets = append(offsets, response.WriteMessage.ID.Offset)
+		case <-ctx.Done():
+			t.Fatalf("timed out waiting for the %s result", expectedFiring)
+		}
+	}
+
+	readResult("2", window.OnTime)
+	assert.Eventually(t, f.isOnTimeFired, time.Second, 10*time.Millisecond)
+
+	// a late message re-emits the result of the window
+	requests <- dataRequest(2)
+	readResult("3", window.Late)
+	assert.NotEqual(t, offsets[0], offsets[1])
+	close(requests)
+
+	// the final result is suppressed since the late firing already emitted it
+	assert.Eventually(t, func() bool {
+		return !f.finalResult(&window.TimedWindowResponse{WriteMessage: &isb.WriteMessage{}})
+	}, time.Second, 10*time.Millisecond)
+}
+
+func TestFirer_MaxFiringMessages(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	f := newTestFirer(ctx, &options{earlyFiringCount: 2, maxFiringMessages: 3})
+	requests := make(chan *window.TimedWindowRequest)
+	out := f.intercept(ctx, requests)
+	forwarded := 0
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		for range out {
+			forwarded++
+		}
+	}()
+
+	requests <- dataRequest(0)
+	requests <- dataRequest(1)
+	select {
+	case response := <-f.pf.responseCh:
+		assert.Equal(t, "2", string(response.WriteMessage.Payload))
+	case <-ctx.Done():
+		t.Fatal("timed out waiting for the early result")
+	}
+
+	for i := 2; i < 6; i++ {
+		requests <- dataRequest(i)
+	}
+	close(requests)
+	<-done
+
+	// every message is forwarded to the reduce stream of the window, but the firings stop once the window overflows
+	assert.Equal(t, 6, forwarded)
+	assert.Empty(t, f.pf.responseCh)
+
+	// the final result of the window should be emitted as on-time
+	final := &window.TimedWindowResponse{WriteMessage: &isb.WriteMessage{}}
+	assert.True(t, f.finalResult(final))
+	assert.Equal(t, window.OnTime.String(), final.WriteMessage.Headers[dfv1.KeyMetaFiring])
+}
@@ -28,6 +28,19 @@ type options struct {
 	windowType      window.Type
 	batchSize       int
 	flushDuration   time.Duration
+	// earlyFiringInterval is the processing time interval at which partial results of aligned windows are emitted.
+	earlyFiringInterval time.Duration
+	// earlyFiringCount is the number of messages of an aligned window after which a partial result is emitted.
+	earlyFiringCount int
+	// lateFiring emits the on-time result of aligned windows, and re-emits it when late data arrives.
+	lateFiring bool
+	// maxFiringMessages is the max number of messages of an aligned window kept in memory for the firings.
+	maxFiringMessages int
+}
+
+// firingEnabled returns true if the results of aligned windows are emitted before the window is closed.
+func (o *options) firingEnabled() bool {
+	return o.earlyFiringInterval > 0 || o.earlyFiringCount > 0 || o.lateFiring
 }
 
 type Option func(options *options) error
@@ -63,3 +76,29 @@ func WithFlushDuration(flushDuration time.Duration) Option {
 		return nil
 	}
 }
+
+// WithEarlyFiring sets the processing time interval and the message count at which partial results of aligned
+// windows are emitted, zero disables the corresponding trigger.
+func WithEarlyFiring(interval time.Duration, count int) Option {
+	return func(o *options) error {
+		o.earlyFiringInterval = interval
+		o.earlyFiringCount = count
+		return nil
+	}
+}
+
+// WithLateFiring enables the on-time and late firings of aligned windows.
+func WithLateFiring(lateFiring bool) Option {
+	return func(o *options) error {
+		o.lateFiring = lateFiring
+		return nil
+	}
+}
+
+// WithMaxFiringMessages sets the max number of messages of an aligned window kept in memory for the firings.
+func WithMaxFiringMessages(maxFiringMessages int) Option {
+	return func(o *options) error {
+		o.maxFiringMessages = maxFiringMessages
+		return nil
+	}
+}
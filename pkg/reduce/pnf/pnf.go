@@ -38,6 +38,7 @@ import (
 	"github.com/numaproj/numaflow/pkg/reduce/pbq/partition"
 	"github.com/numaproj/numaflow/pkg/shared/idlehandler"
 	"github.com/numaproj/numaflow/pkg/shared/logging"
+	"github.com/numaproj/numaflow/pkg/shared/tracing"
 	"github.com/numaproj/numaflow/pkg/watermark/publish"
 	"github.com/numaproj/numaflow/pkg/watermark/wmb"
 	"github.com/numaproj/numaflow/pkg/window"
@@ -84,8 +85,9 @@ func NewProcessAndForward(ctx context.Context,
 
 	// apply the options
 	dOpts := &options{
-		batchSize:     dfv1.DefaultReadBatchSize,
-		flushDuration: dfv1.DefaultReadTimeout,
+		batchSize:         dfv1.DefaultReadBatchSize,
+		flushDuration:     dfv1.DefaultReadTimeout,
+		maxFiringMessages: dfv1.DefaultMaxFiringMessages,
 	}
 	for _, opt := range opts {
 		if err := opt(dOpts); err != nil {
@@ -156,7 +158,15 @@ func (pf *ProcessAndForward) invokeUDF(ctx context.Context, done chan struct{},
 	}()
 
 	defer close(done)
-	udfResponseCh, errCh := pf.reduceApplier.ApplyReduce(ctx, pid, pbqReader.ReadCh())
+
+	requests := pbqReader.ReadCh()
+	// results of the aligned windows can be emitted before the window is closed
+	var f *firer
+	if pf.opts.firingEnabled() && pf.windower.Type() == window.Aligned {
+		f = newFirer(pf, pid)
+		requests = f.intercept(ctx, requests)
+	}
+	udfResponseCh, errCh := pf.reduceApplier.ApplyReduce(ctx, pid, requests)
 
 outerLoop:
 	for {
@@ -178,6 +188,9 @@ outerLoop:
 				break outerLoop
 			}
 
+			if f != nil && !response.EOF && !f.finalResult(response) {
+				continue
+			}
 			pf.responseCh <- response
 		}
 	}
@@ -345,6 +358,14 @@ func (pf *ProcessAndForward) forwardToBuffers(ctx context.Context, writeMessages
 		return nil
 	}
 
+	// the results do not carry the headers of the messages they are reduced from, so they start the trace of the write span.
+	ctx, span := tracing.StartSpan(ctx, "reduce.write", tracing.VertexAttributes(pf.pipelineName, pf.vertexName, pf.vertexReplica)...)
+	if span.IsRecording() {
+		for _, m := range *writeMessages {
+			m.Headers = tracing.Inject(ctx, m.Headers)
+		}
+	}
+
 	messagesToStep := pf.whereToStep(*writeMessages)
 	// parallel writes to each ISB
 	var mu sync.Mutex
@@ -373,7 +394,9 @@ func (pf *ProcessAndForward) forwardToBuffers(ctx context.Context, writeMessages
 	}
 
 	// wait until all the writer go routines return
-	if err := eg.Wait(); err != nil {
+	err := eg.Wait()
+	tracing.EndSpan(span, err)
+	if err != nil {
 		return err
 	}
 
@@ -390,7 +413,7 @@ func (pf *ProcessAndForward) whereToStep(writeMessages []*isb.WriteMessage) map[
 	var to []forwarder.VertexBuffer
 	var err error
 	for _, msg := range writeMessages {
-		to, err = pf.whereToDecider.WhereTo(msg.Keys, msg.Tags, msg.ID.String())
+		to, err = pf.whereToDecider.WhereTo(msg.Keys, msg.Tags, msg.ID.String(), &msg.Message)
 		if err != nil {
 			metrics.PlatformError.With(map[string]string{
 				metrics.LabelVertex:             pf.vertexName,
@@ -19,29 +19,47 @@ package callback
 import (
 	"bytes"
 	"context"
+	"crypto/hmac"
+	"crypto/sha256"
 	"crypto/tls"
+	"encoding/hex"
 	"encoding/json"
 	"errors"
 	"fmt"
 	"net/http"
+	"sync"
 	"time"
 
+	"github.com/google/uuid"
 	lru "github.com/hashicorp/golang-lru/v2"
+	"github.com/prometheus/client_golang/prometheus"
 	"go.uber.org/zap"
+	"golang.org/x/sync/errgroup"
+	"k8s.io/apimachinery/pkg/util/wait"
 
 	dfv1 "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1"
 	"github.com/numaproj/numaflow/pkg/isb"
+	"github.com/numaproj/numaflow/pkg/metrics"
 )
 
+// SignatureHeader is the header carrying the HMAC-SHA256 signature of the request body, in the format of
+// "sha256=<hex encoded signature>".
+const SignatureHeader = "X-Numaflow-Signature"
+
 // Uploader uploads the callback messages to the callback endpoint.
 type Uploader struct {
 	vertexName   string
 	pipelineName string
 	clientsCache *lru.Cache[string, *http.Client]
-	opts         *Options
+	// inFlight keeps the IDs of the outbox entries being delivered, so that they are not redelivered concurrently.
+	inFlight sync.Map
+	cancel   context.CancelFunc
+	wg       sync.WaitGroup
+	opts     *Options
 }
 
-// NewUploader creates a new callback Uploader.
+// NewUploader creates a new callback Uploader. If an outbox is configured, the callback requests left in the outbox,
+// including the ones from the previous runs, are redelivered periodically until the context is canceled.
 func NewUploader(ctx context.Context, vertexName string, pipelineName string, opts ...OptionFunc) *Uploader {
 	dOpts := DefaultOptions(ctx)
 	for _, opt := range opts {
@@ -51,14 +69,26 @@ func NewUploader(ctx context.Context, vertexName string, pipelineName string, op
 	clientCache, _ := lru.NewWithEvict[string, *http.Client](dOpts.cacheSize, func(key string, value *http.Client) {
 		// Close the client when it's removed from the cache
 		value.CloseIdleConnections()
+		// the URLs come from the message headers, drop the metrics of the URLs not in use to bound the cardinality
+		urlLabels := prometheus.Labels{metrics.LabelVertex: vertexName, metrics.LabelPipeline: pipelineName, metrics.LabelCallbackURL: key}
+		metrics.CallbackRequestsCount.DeletePartialMatch(urlLabels)
+		metrics.CallbackRequestsError.DeletePartialMatch(urlLabels)
+		metrics.CallbackRequestTime.DeletePartialMatch(urlLabels)
 	})
 
-	return &Uploader{
+	u := &Uploader{
 		vertexName:   vertexName,
 		pipelineName: pipelineName,
 		clientsCache: clientCache,
 		opts:         dOpts,
 	}
+
+	ctx, u.cancel = context.WithCancel(ctx)
+	if dOpts.outbox != nil {
+		u.wg.Add(1)
+		go u.redeliver(ctx)
+	}
+	return u
 }
 
 // Request is the struct that holds the data to be sent in the POST request
@@ -193,32 +223,169 @@ func (u *Uploader) SinkVertexCallback(ctx context.Context, messages []isb.Messag
 }
 
 // executeCallback sends POST requests to the provided callback URLs with the corresponding request bodies.
-// It returns an error if any of the requests fail.
+// The failed requests are kept in the outbox for redelivery if it's configured, otherwise they are dropped.
 func (u *Uploader) executeCallback(ctx context.Context, callbackUrlMap map[string][]Request) error {
-	var failedRequests []Request
-
 	for url, requests := range callbackUrlMap {
-		err := u.sendRequest(ctx, url, requests)
-		if err != nil {
-			u.opts.logger.Errorw("Failed to send request, will try writing to the callback URL",
-				zap.String("url", url),
+		entry := &OutboxEntry{
+			// prefix with the timestamp so that the entries are redelivered in the order they are created
+			ID:       fmt.Sprintf("%d-%s", time.Now().UnixNano(), uuid.NewString()),
+			URL:      url,
+			Requests: requests,
+		}
+		u.deliver(ctx, entry)
+	}
+
+	return nil
+}
+
+// deliver persists the entry to the outbox if it's configured, sends the requests, and removes the entry from the
+// outbox once they are delivered. A persisted entry is only attempted once, the retries are left to the redelivery so
+// that an unavailable callback endpoint doesn't hold up the forwarder.
+func (u *Uploader) deliver(ctx context.Context, entry *OutboxEntry) {
+	outbox := u.opts.outbox
+	backoff := u.opts.retryBackoff
+	if outbox != nil {
+		u.inFlight.Store(entry.ID, struct{}{})
+		defer u.inFlight.Delete(entry.ID)
+		if err := outbox.Put(ctx, entry); err != nil {
+			u.opts.logger.Errorw("Failed to persist the callback requests to the outbox, delivering without it",
+				zap.String("url", entry.URL),
 				zap.Error(err),
 			)
-			failedRequests = append(failedRequests, requests...)
+			if errors.Is(err, ErrOutboxFull) {
+				metrics.CallbackOutboxFullCount.With(map[string]string{metrics.LabelVertex: u.vertexName, metrics.LabelPipeline: u.pipelineName}).Add(float64(len(entry.Requests)))
+			}
+			outbox = nil
+		} else {
+			backoff = wait.Backoff{Steps: 1}
 		}
 	}
 
-	if len(failedRequests) > 0 && u.opts.callbackURL != "" {
-		err := u.sendRequest(ctx, u.opts.callbackURL, failedRequests)
-		if err != nil {
-			u.opts.logger.Errorw("Failed to send request to callback URL, skipping the callback requests",
-				zap.String("url", u.opts.callbackURL),
+	if err := u.send(ctx, entry, backoff); err != nil {
+		if outbox != nil {
+			u.opts.logger.Errorw("Failed to send the callback requests, will be redelivered from the outbox",
+				zap.String("url", entry.URL),
 				zap.Error(err),
 			)
+			return
 		}
+		u.opts.logger.Errorw("Failed to send the callback requests, skipping the callback requests",
+			zap.String("url", entry.URL),
+			zap.Error(err),
+		)
+		metrics.CallbackDroppedCount.With(map[string]string{metrics.LabelVertex: u.vertexName, metrics.LabelPipeline: u.pipelineName}).Add(float64(len(entry.Requests)))
+		return
 	}
 
-	return nil
+	if outbox != nil {
+		if err := outbox.Delete(ctx, entry.ID); err != nil {
+			// it will be delivered again by the redelivery, which is fine since the callbacks are at-least-once
+			u.opts.logger.Errorw("Failed to delete the delivered callback requests from the outbox", zap.String("id", entry.ID), zap.Error(err))
+		}
+	}
+}
+
+// send sends the requests of the entry to its URL with retries. In case of failure, it writes the requests to the
+// callbackURL if it's set and different from the URL of the entry.
+func (u *Uploader) send(ctx context.Context, entry *OutboxEntry, backoff wait.Backoff) error {
+	err := u.sendWithRetry(ctx, entry.URL, entry.Requests, backoff)
+	if err == nil || u.opts.callbackURL == "" || u.opts.callbackURL == entry.URL {
+		return err
+	}
+	u.opts.logger.Errorw("Failed to send request, will try writing to the callback URL",
+		zap.String("url", entry.URL),
+		zap.Error(err),
+	)
+	return u.sendWithRetry(ctx, u.opts.callbackURL, entry.Requests, backoff)
+}
+
+// sendWithRetry sends the requests to the URL, and retries with the backoff if it fails.
+func (u *Uploader) sendWithRetry(ctx context.Context, url string, requests []Request, backoff wait.Backoff) error {
+	var lastErr error
+	err := wait.ExponentialBackoffWithContext(ctx, backoff, func(ctx context.Context) (bool, error) {
+		if lastErr = u.sendRequest(ctx, url, requests); lastErr != nil {
+			u.opts.logger.Warnw("Failed to send the callback request", zap.String("url", url), zap.Error(lastErr))
+			return false, nil
+		}
+		return true, nil
+	})
+	if err != nil && lastErr != nil {
+		return lastErr
+	}
+	return err
+}
+
+// redeliver periodically redelivers the callback requests left in the outbox until the context is canceled.
+func (u *Uploader) redeliver(ctx context.Context) {
+	defer u.wg.Done()
+	ticker := time.NewTicker(u.opts.redeliveryInterval)
+	defer ticker.Stop()
+	for {
+		// start with a redelivery to pick up the requests left by the previous run
+		u.redeliverOutbox(ctx)
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// redeliverOutbox sends the callback requests in the outbox which are not being delivered. The entries of a callback
+// URL are sent in batches, in the order they are created, and the callback URLs are redelivered concurrently.
+func (u *Uploader) redeliverOutbox(ctx context.Context) {
+	entries, err := u.opts.outbox.List(ctx)
+	if err != nil {
+		u.opts.logger.Errorw("Failed to list the callback outbox", zap.Error(err))
+		return
+	}
+	metrics.CallbackOutboxPending.With(map[string]string{metrics.LabelVertex: u.vertexName, metrics.LabelPipeline: u.pipelineName}).Set(float64(len(entries)))
+	var urls []string
+	entriesByURL := make(map[string][]*OutboxEntry)
+	for _, entry := range entries {
+		if _, ok := u.inFlight.Load(entry.ID); ok {
+			continue
+		}
+		if _, ok := entriesByURL[entry.URL]; !ok {
+			urls = append(urls, entry.URL)
+		}
+		entriesByURL[entry.URL] = append(entriesByURL[entry.URL], entry)
+	}
+	g := errgroup.Group{}
+	g.SetLimit(u.opts.redeliveryConcurrency)
+	for _, url := range urls {
+		g.Go(func() error {
+			u.redeliverURL(ctx, url, entriesByURL[url])
+			return nil
+		})
+	}
+	_ = g.Wait()
+}
+
+// redeliverURL sends the entries of a callback URL in batches. The remaining entries are left for the next
+// redelivery once a batch fails, so that an unavailable callback endpoint isn't tried once for each entry.
+func (u *Uploader) redeliverURL(ctx context.Context, url string, entries []*OutboxEntry) {
+	for len(entries) > 0 {
+		if ctx.Err() != nil {
+			return
+		}
+		// a batch takes at least one entry, and as many as fit in the batch size
+		batch := &OutboxEntry{URL: url, Requests: append([]Request(nil), entries[0].Requests...)}
+		n := 1
+		for ; n < len(entries) && len(batch.Requests)+len(entries[n].Requests) <= u.opts.redeliveryBatchSize; n++ {
+			batch.Requests = append(batch.Requests, entries[n].Requests...)
+		}
+		if err := u.send(ctx, batch, u.opts.retryBackoff); err != nil {
+			u.opts.logger.Errorw("Failed to redeliver the callback requests", zap.String("url", url), zap.Int("pending", len(entries)), zap.Error(err))
+			return
+		}
+		for _, entry := range entries[:n] {
+			if err := u.opts.outbox.Delete(ctx, entry.ID); err != nil {
+				u.opts.logger.Errorw("Failed to delete the redelivered callback requests from the outbox", zap.String("id", entry.ID), zap.Error(err))
+			}
+		}
+		entries = entries[n:]
+	}
 }
 
 // sendRequest sends a POST request to the provided URL with the provided requests.
@@ -238,21 +405,38 @@ func (u *Uploader) sendRequest(ctx context.Context, url string, requests []Reque
 	}
 
 	req.Header.Set("Content-Type", "application/json")
+	if len(u.opts.signingKey) > 0 {
+		req.Header.Set(SignatureHeader, Sign(u.opts.signingKey, body))
+	}
 
+	urlLabels := map[string]string{metrics.LabelVertex: u.vertexName, metrics.LabelPipeline: u.pipelineName, metrics.LabelCallbackURL: url}
+	metrics.CallbackRequestsCount.With(urlLabels).Inc()
+	start := time.Now()
 	resp, err := client.Do(req)
+	metrics.CallbackRequestTime.With(urlLabels).Observe(float64(time.Since(start).Microseconds()))
 	if err != nil {
+		metrics.CallbackRequestsError.With(urlLabels).Inc()
 		return fmt.Errorf("failed to send request: %w", err)
 	}
 
 	defer resp.Body.Close()
 
 	if resp.StatusCode > 299 {
+		metrics.CallbackRequestsError.With(urlLabels).Inc()
 		return fmt.Errorf("received non-OK response status: %s", resp.Status)
 	}
 
 	return nil
 }
 
+// Sign returns the HMAC-SHA256 signature of the body with the key, in the format of "sha256=<hex encoded signature>".
+// The receiver of the callbacks can verify the requests by comparing it with the value of the SignatureHeader.
+func Sign(key []byte, body []byte) string {
+	mac := hmac.New(sha256.New, key)
+	mac.Write(body)
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
+
 // GetClient returns a client for the given URL from the cache
 // If the client is not in the cache, a new one is created.
 func (u *Uploader) GetClient(url string) *http.Client {
@@ -278,8 +462,10 @@ func (u *Uploader) GetClient(url string) *http.Client {
 	return client
 }
 
-// Close closes all clients in the cache
+// Close stops the redelivery and closes all clients in the cache
 func (u *Uploader) Close() {
+	u.cancel()
+	u.wg.Wait()
 	// clear the cache, which will call the onEvicted method for each client
 	u.clientsCache.Purge()
 }
@@ -19,12 +19,16 @@ package callback
 import (
 	"context"
 	"encoding/json"
+	"fmt"
 	"io"
 	"net/http"
 	"net/http/httptest"
+	"sync/atomic"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/util/wait"
 
 	dfv1 "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1"
 	"github.com/numaproj/numaflow/pkg/isb"
@@ -315,3 +319,213 @@ func TestSinkVertexCallback_NoCallbackURL(t *testing.T) {
 	assert.NotNil(t, err)
 	assert.Contains(t, err.Error(), "callback URL not found in headers and default callback URL is not set")
 }
+
+func sinkMessages(url string) []isb.Message {
+	return []isb.Message{
+		{
+			Header: isb.Header{
+				Headers: map[string]string{
+					dfv1.KeyMetaCallbackURL: url,
+					dfv1.KeyMetaID:          "XXXX",
+				},
+				ID: isb.MessageID{
+					VertexName: "from-vertex",
+				},
+			},
+		},
+	}
+}
+
+func TestSinkVertexCallback_Signed(t *testing.T) {
+	key := []byte("secret")
+	var signed atomic.Bool
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		bodyBytes, _ := io.ReadAll(req.Body)
+		signed.Store(req.Header.Get(SignatureHeader) == Sign(key, bodyBytes))
+		rw.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	ctx := context.Background()
+	cp := NewUploader(ctx, "testVertex", "testPipeline", WithSigningKey(key))
+	defer cp.Close()
+
+	err := cp.SinkVertexCallback(ctx, sinkMessages(server.URL))
+	assert.NoError(t, err)
+	assert.True(t, signed.Load())
+}
+
+func TestSign(t *testing.T) {
+	// echo -n '[]' | openssl dgst -sha256 -hmac secret
+	assert.Equal(t, "sha256=53364a07fcc563e712f42cfc9de1e28e1e2d39f236cee430f112203e557aea3f", Sign([]byte("secret"), []byte("[]")))
+}
+
+func TestSinkVertexCallback_Retry(t *testing.T) {
+	var count atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		// fail the first two attempts
+		if count.Add(1) <= 2 {
+			rw.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		rw.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	ctx := context.Background()
+	cp := NewUploader(ctx, "testVertex", "testPipeline", WithRetryBackoff(wait.Backoff{Duration: time.Millisecond, Factor: 1, Steps: 3}))
+	defer cp.Close()
+
+	err := cp.SinkVertexCallback(ctx, sinkMessages(server.URL))
+	assert.NoError(t, err)
+	assert.Equal(t, int32(3), count.Load())
+}
+
+func TestSinkVertexCallback_FallbackToCallbackURL(t *testing.T) {
+	failing := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		rw.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer failing.Close()
+	var received atomic.Int32
+	fallback := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		received.Add(1)
+		rw.WriteHeader(http.StatusNoContent)
+	}))
+	defer fallback.Close()
+
+	ctx := context.Background()
+	cp := NewUploader(ctx, "testVertex", "testPipeline", WithCallbackURL(fallback.URL), WithRetryBackoff(wait.Backoff{Duration: time.Millisecond, Steps: 2}))
+	defer cp.Close()
+
+	err := cp.SinkVertexCallback(ctx, sinkMessages(failing.URL))
+	assert.NoError(t, err)
+	assert.Equal(t, int32(1), received.Load())
+}
+
+func TestSinkVertexCallback_Outbox(t *testing.T) {
+	var healthy atomic.Bool
+	var received atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		if !healthy.Load() {
+			rw.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		received.Add(1)
+		rw.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	outbox, err := NewFileOutbox(t.TempDir(), 0)
+	assert.NoError(t, err)
+	cp := NewUploader(ctx, "testVertex", "testPipeline",
+		WithOutbox(outbox),
+		WithRetryBackoff(wait.Backoff{Duration: time.Millisecond, Steps: 1}),
+		WithRedeliveryInterval(10*time.Millisecond))
+	defer cp.Close()
+
+	// the failed requests are kept in the outbox
+	err = cp.SinkVertexCallback(ctx, sinkMessages(server.URL))
+	assert.NoError(t, err)
+	entries, err := outbox.List(ctx)
+	assert.NoError(t, err)
+	assert.Len(t, entries, 1)
+	assert.Equal(t, server.URL, entries[0].URL)
+
+	// and redelivered once the callback endpoint is back
+	healthy.Store(true)
+	assert.Eventually(t, func() bool {
+		entries, err := outbox.List(ctx)
+		return err == nil && len(entries) == 0
+	}, 5*time.Second, 10*time.Millisecond)
+	assert.Equal(t, int32(1), received.Load())
+}
+
+// listedOutbox signals when the entries are listed by the redelivery.
+type listedOutbox struct {
+	Outbox
+	listed chan struct{}
+}
+
+func (o *listedOutbox) List(ctx context.Context) ([]*OutboxEntry, error) {
+	select {
+	case o.listed <- struct{}{}:
+	default:
+	}
+	return o.Outbox.List(ctx)
+}
+
+func TestSinkVertexCallback_OutboxSingleAttempt(t *testing.T) {
+	var attempts atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		attempts.Add(1)
+		rw.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer server.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	fileOutbox, err := NewFileOutbox(t.TempDir(), 0)
+	assert.NoError(t, err)
+	outbox := &listedOutbox{Outbox: fileOutbox, listed: make(chan struct{}, 1)}
+	cp := NewUploader(ctx, "testVertex", "testPipeline",
+		WithOutbox(outbox),
+		WithRetryBackoff(wait.Backoff{Duration: time.Millisecond, Steps: 3}),
+		WithRedeliveryInterval(time.Hour))
+	defer cp.Close()
+	// wait for the redelivery at the start to pass
+	<-outbox.listed
+
+	// the persisted requests are not retried inline, the redelivery takes care of them
+	err = cp.SinkVertexCallback(ctx, sinkMessages(server.URL))
+	assert.NoError(t, err)
+	assert.Equal(t, int32(1), attempts.Load())
+	entries, err := outbox.List(ctx)
+	assert.NoError(t, err)
+	assert.Len(t, entries, 1)
+}
+
+func TestSinkVertexCallback_OutboxBatchRedelivery(t *testing.T) {
+	var posts, received atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		var requests []Request
+		_ = json.NewDecoder(req.Body).Decode(&requests)
+		posts.Add(1)
+		received.Add(int32(len(requests)))
+		rw.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+	var attempts atomic.Int32
+	failing := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		attempts.Add(1)
+		rw.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer failing.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	outbox, err := NewFileOutbox(t.TempDir(), 0)
+	assert.NoError(t, err)
+	// the entries left by a previous run
+	for i := 0; i < 5; i++ {
+		assert.NoError(t, outbox.Put(ctx, &OutboxEntry{ID: fmt.Sprintf("%d-a", i), URL: server.URL, Requests: []Request{{ID: fmt.Sprint(i)}}}))
+		assert.NoError(t, outbox.Put(ctx, &OutboxEntry{ID: fmt.Sprintf("%d-b", i), URL: failing.URL, Requests: []Request{{ID: fmt.Sprint(i)}}}))
+	}
+	cp := NewUploader(ctx, "testVertex", "testPipeline",
+		WithOutbox(outbox),
+		WithRetryBackoff(wait.Backoff{Duration: time.Millisecond, Steps: 1}),
+		WithRedeliveryInterval(time.Hour),
+		WithRedeliveryBatchSize(2))
+	defer cp.Close()
+
+	assert.Eventually(t, func() bool {
+		entries, err := outbox.List(ctx)
+		return err == nil && len(entries) == 5 && attempts.Load() > 0
+	}, 5*time.Second, 10*time.Millisecond)
+	// the requests are delivered in batches
+	assert.Equal(t, int32(3), posts.Load())
+	assert.Equal(t, int32(5), received.Load())
+	// the entries of an unavailable endpoint are left for the next redelivery after the first failed batch
+	assert.Equal(t, int32(1), attempts.Load())
+}
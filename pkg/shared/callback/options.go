@@ -18,9 +18,13 @@ package callback
 
 import (
 	"context"
+	"fmt"
+	"os"
 	"time"
 
 	"go.uber.org/zap"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/apimachinery/pkg/util/wait"
 
 	dfv1 "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1"
 	"github.com/numaproj/numaflow/pkg/shared/logging"
@@ -37,6 +41,19 @@ type Options struct {
 	callbackHeaderKey string
 	// callbackURL specifies the URL to which the callback is sent.
 	callbackURL string
+	// signingKey is the key to sign the request bodies with HMAC-SHA256, the requests are not signed if it's empty.
+	signingKey []byte
+	// retryBackoff is the backoff to retry a failed request to a callback URL.
+	retryBackoff wait.Backoff
+	// outbox keeps the callback requests until they are delivered, the undelivered requests are dropped if it's nil.
+	outbox Outbox
+	// redeliveryInterval is the interval to redeliver the callback requests left in the outbox.
+	redeliveryInterval time.Duration
+	// redeliveryConcurrency is the number of callback URLs the requests left in the outbox are redelivered to
+	// concurrently.
+	redeliveryConcurrency int
+	// redeliveryBatchSize is the maximum number of the requests left in the outbox sent to a callback URL at once.
+	redeliveryBatchSize int
 	// logger is the logger for the publisher.
 	logger *zap.SugaredLogger
 }
@@ -48,6 +65,15 @@ func DefaultOptions(ctx context.Context) *Options {
 		cacheSize:         50,
 		logger:            logging.FromContext(ctx),
 		callbackHeaderKey: dfv1.KeyMetaCallbackURL,
+		retryBackoff: wait.Backoff{
+			Duration: 100 * time.Millisecond,
+			Factor:   2,
+			Jitter:   0.1,
+			Steps:    3,
+		},
+		redeliveryInterval:    30 * time.Second,
+		redeliveryConcurrency: 5,
+		redeliveryBatchSize:   500,
 	}
 }
 
@@ -81,3 +107,74 @@ func WithLogger(logger *zap.SugaredLogger) OptionFunc {
 		o.logger = logger
 	}
 }
+
+// WithSigningKey sets the key to sign the request bodies with HMAC-SHA256.
+func WithSigningKey(key []byte) OptionFunc {
+	return func(o *Options) {
+		o.signingKey = key
+	}
+}
+
+// WithRetryBackoff sets the backoff to retry a failed request to a callback URL.
+func WithRetryBackoff(backoff wait.Backoff) OptionFunc {
+	return func(o *Options) {
+		o.retryBackoff = backoff
+	}
+}
+
+// WithOutbox sets the outbox to keep the callback requests until they are delivered.
+func WithOutbox(outbox Outbox) OptionFunc {
+	return func(o *Options) {
+		o.outbox = outbox
+	}
+}
+
+// WithRedeliveryInterval sets the interval to redeliver the callback requests left in the outbox.
+func WithRedeliveryInterval(interval time.Duration) OptionFunc {
+	return func(o *Options) {
+		o.redeliveryInterval = interval
+	}
+}
+
+// WithRedeliveryConcurrency sets the number of callback URLs the requests left in the outbox are redelivered to
+// concurrently.
+func WithRedeliveryConcurrency(concurrency int) OptionFunc {
+	return func(o *Options) {
+		o.redeliveryConcurrency = concurrency
+	}
+}
+
+// WithRedeliveryBatchSize sets the maximum number of the requests left in the outbox sent to a callback URL at once.
+func WithRedeliveryBatchSize(size int) OptionFunc {
+	return func(o *Options) {
+		o.redeliveryBatchSize = size
+	}
+}
+
+// EnvOptions returns the options configured with the environment variables of the container, the signing key is
+// expected to be provided by a Secret. The variables are only supported by the Go runtime.
+func EnvOptions() ([]OptionFunc, error) {
+	var opts []OptionFunc
+	if url := os.Getenv(dfv1.EnvCallbackURL); url != "" {
+		opts = append(opts, WithCallbackURL(url))
+	}
+	if key := os.Getenv(dfv1.EnvCallbackSigningKey); key != "" {
+		opts = append(opts, WithSigningKey([]byte(key)))
+	}
+	if dir := os.Getenv(dfv1.EnvCallbackOutboxDir); dir != "" {
+		maxBytes := int64(dfv1.DefaultCallbackOutboxMaxBytes)
+		if x := os.Getenv(dfv1.EnvCallbackOutboxMaxBytes); x != "" {
+			q, err := resource.ParseQuantity(x)
+			if err != nil {
+				return nil, fmt.Errorf("invalid %s %q, %w", dfv1.EnvCallbackOutboxMaxBytes, x, err)
+			}
+			maxBytes = q.Value()
+		}
+		outbox, err := NewFileOutbox(dir, maxBytes)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create the callback outbox, %w", err)
+		}
+		opts = append(opts, WithOutbox(outbox))
+	}
+	return opts, nil
+}
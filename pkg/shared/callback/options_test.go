@@ -23,6 +23,9 @@ import (
 
 	"github.com/stretchr/testify/assert"
 	"go.uber.org/zap"
+	"k8s.io/apimachinery/pkg/util/wait"
+
+	dfv1 "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1"
 )
 
 func TestOptions(t *testing.T) {
@@ -35,16 +38,61 @@ func TestOptions(t *testing.T) {
 	assert.Equal(t, 10*time.Second, opts.httpTimeout)
 	assert.Equal(t, 50, opts.cacheSize)
 	assert.NotNil(t, opts.logger)
+	assert.Equal(t, 3, opts.retryBackoff.Steps)
+	assert.Equal(t, 30*time.Second, opts.redeliveryInterval)
+	assert.Equal(t, 5, opts.redeliveryConcurrency)
+	assert.Equal(t, 500, opts.redeliveryBatchSize)
+	assert.Nil(t, opts.signingKey)
+	assert.Nil(t, opts.outbox)
 
 	// Modify options
 	WithHTTPTimeout(20 * time.Second)(opts)
 	WithLRUCacheSize(100)(opts)
 	WithCallbackURL("http://example.com")(opts)
 	WithLogger(zap.NewNop().Sugar())(opts)
+	WithSigningKey([]byte("key"))(opts)
+	WithRetryBackoff(wait.Backoff{Duration: time.Second, Steps: 5})(opts)
+	WithRedeliveryInterval(time.Minute)(opts)
+	WithRedeliveryConcurrency(10)(opts)
+	WithRedeliveryBatchSize(100)(opts)
 
 	// Check modified values
 	assert.Equal(t, 20*time.Second, opts.httpTimeout)
 	assert.Equal(t, 100, opts.cacheSize)
 	assert.Equal(t, "http://example.com", opts.callbackURL)
 	assert.IsType(t, &zap.SugaredLogger{}, opts.logger)
+	assert.Equal(t, []byte("key"), opts.signingKey)
+	assert.Equal(t, 5, opts.retryBackoff.Steps)
+	assert.Equal(t, time.Minute, opts.redeliveryInterval)
+	assert.Equal(t, 10, opts.redeliveryConcurrency)
+	assert.Equal(t, 100, opts.redeliveryBatchSize)
+}
+
+func TestEnvOptions(t *testing.T) {
+	dir := t.TempDir()
+	t.Setenv(dfv1.EnvCallbackURL, "http://example.com")
+	t.Setenv(dfv1.EnvCallbackSigningKey, "secret")
+	t.Setenv(dfv1.EnvCallbackOutboxDir, dir)
+
+	envOpts, err := EnvOptions()
+	assert.NoError(t, err)
+	opts := DefaultOptions(context.Background())
+	for _, opt := range envOpts {
+		opt(opts)
+	}
+	assert.Equal(t, "http://example.com", opts.callbackURL)
+	assert.Equal(t, []byte("secret"), opts.signingKey)
+	assert.Equal(t, &fileOutbox{dir: dir, maxBytes: dfv1.DefaultCallbackOutboxMaxBytes}, opts.outbox)
+
+	t.Setenv(dfv1.EnvCallbackOutboxMaxBytes, "1Mi")
+	envOpts, err = EnvOptions()
+	assert.NoError(t, err)
+	for _, opt := range envOpts {
+		opt(opts)
+	}
+	assert.Equal(t, &fileOutbox{dir: dir, maxBytes: 1024 * 1024}, opts.outbox)
+
+	t.Setenv(dfv1.EnvCallbackOutboxMaxBytes, "abc")
+	_, err = EnvOptions()
+	assert.Error(t, err)
 }
@@ -0,0 +1,161 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package callback
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+)
+
+// OutboxEntry is a group of callback requests to a callback URL waiting to be delivered.
+type OutboxEntry struct {
+	// ID is the unique identifier of the entry, the IDs of the entries sort in the order they are created.
+	ID string `json:"id"`
+	// URL is the callback URL the requests are sent to
+	URL string `json:"url"`
+	// Requests is the list of the callback requests
+	Requests []Request `json:"requests"`
+}
+
+// Outbox keeps the callback requests until they are delivered, so that they survive the restarts.
+type Outbox interface {
+	// Put persists an entry.
+	Put(ctx context.Context, entry *OutboxEntry) error
+	// Delete removes the entry with the given ID, it's a no-op if the entry doesn't exist.
+	Delete(ctx context.Context, id string) error
+	// List returns all the entries, sorted by the ID.
+	List(ctx context.Context) ([]*OutboxEntry, error)
+}
+
+const outboxFileSuffix = ".json"
+
+// ErrOutboxFull is returned by Put if the entry doesn't fit in the outbox.
+var ErrOutboxFull = errors.New("the callback outbox is full")
+
+// fileOutbox keeps each entry as a JSON file in a directory.
+type fileOutbox struct {
+	dir string
+	// maxBytes is the maximum total size of the entries, unlimited if it's not positive.
+	maxBytes int64
+	mu       sync.Mutex
+	// size is the total size of the entries in the directory.
+	size int64
+}
+
+var _ Outbox = (*fileOutbox)(nil)
+
+// NewFileOutbox returns an Outbox keeping the entries in the given directory, which is created if it doesn't exist.
+// The entries survive the restarts as long as the directory does, so it should be on a volume, and not be shared
+// with other pods. The total size of the entries is limited to maxBytes if it's positive, so that the outbox doesn't
+// fill up the volume while a callback endpoint is unavailable.
+func NewFileOutbox(dir string, maxBytes int64) (Outbox, error) {
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return nil, fmt.Errorf("failed to create the outbox directory %q, %w", dir, err)
+	}
+	files, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read the outbox directory, %w", err)
+	}
+	f := &fileOutbox{dir: dir, maxBytes: maxBytes}
+	for _, file := range files {
+		if file.IsDir() {
+			continue
+		}
+		if !strings.HasSuffix(file.Name(), outboxFileSuffix) {
+			// left by a crash in the middle of a Put
+			_ = os.Remove(filepath.Join(dir, file.Name()))
+			continue
+		}
+		if info, err := file.Info(); err == nil {
+			f.size += info.Size()
+		}
+	}
+	return f, nil
+}
+
+func (f *fileOutbox) Put(_ context.Context, entry *OutboxEntry) error {
+	data, err := json.Marshal(entry)
+	if err != nil {
+		return fmt.Errorf("failed to marshal the outbox entry, %w", err)
+	}
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if f.maxBytes > 0 && f.size+int64(len(data)) > f.maxBytes {
+		return ErrOutboxFull
+	}
+	// write to a temporary file first, so that a crash doesn't leave a partially written entry behind
+	path := filepath.Join(f.dir, entry.ID+outboxFileSuffix)
+	if err = os.WriteFile(path+".tmp", data, 0o644); err != nil {
+		return fmt.Errorf("failed to write the outbox entry, %w", err)
+	}
+	if err = os.Rename(path+".tmp", path); err != nil {
+		return fmt.Errorf("failed to write the outbox entry, %w", err)
+	}
+	f.size += int64(len(data))
+	return nil
+}
+
+func (f *fileOutbox) Delete(_ context.Context, id string) error {
+	path := filepath.Join(f.dir, id+outboxFileSuffix)
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	info, err := os.Stat(path)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return nil
+		}
+		return fmt.Errorf("failed to delete the outbox entry %q, %w", id, err)
+	}
+	if err = os.Remove(path); err != nil && !errors.Is(err, os.ErrNotExist) {
+		return fmt.Errorf("failed to delete the outbox entry %q, %w", id, err)
+	}
+	f.size -= info.Size()
+	return nil
+}
+
+func (f *fileOutbox) List(_ context.Context) ([]*OutboxEntry, error) {
+	files, err := os.ReadDir(f.dir)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read the outbox directory, %w", err)
+	}
+	var entries []*OutboxEntry
+	// os.ReadDir returns the files sorted by the name
+	for _, file := range files {
+		if file.IsDir() || !strings.HasSuffix(file.Name(), outboxFileSuffix) {
+			continue
+		}
+		data, err := os.ReadFile(filepath.Join(f.dir, file.Name()))
+		if err != nil {
+			if errors.Is(err, os.ErrNotExist) {
+				continue
+			}
+			return nil, fmt.Errorf("failed to read the outbox entry %q, %w", file.Name(), err)
+		}
+		entry := &OutboxEntry{}
+		if err = json.Unmarshal(data, entry); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal the outbox entry %q, %w", file.Name(), err)
+		}
+		entries = append(entries, entry)
+	}
+	return entries, nil
+}
@@ -0,0 +1,82 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package callback
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func testOutbox(t *testing.T, outbox Outbox) {
+	ctx := context.Background()
+	entries, err := outbox.List(ctx)
+	assert.NoError(t, err)
+	assert.Empty(t, entries)
+
+	second := &OutboxEntry{ID: "2-b", URL: "http://b", Requests: []Request{{ID: "y", Vertex: "v"}}}
+	first := &OutboxEntry{ID: "1-a", URL: "http://a", Requests: []Request{{ID: "x", Vertex: "v", Tags: []string{"t"}}}}
+	assert.NoError(t, outbox.Put(ctx, second))
+	assert.NoError(t, outbox.Put(ctx, first))
+
+	entries, err = outbox.List(ctx)
+	assert.NoError(t, err)
+	assert.Equal(t, []*OutboxEntry{first, second}, entries)
+
+	assert.NoError(t, outbox.Delete(ctx, first.ID))
+	// deleting a nonexistent entry is a no-op
+	assert.NoError(t, outbox.Delete(ctx, first.ID))
+	entries, err = outbox.List(ctx)
+	assert.NoError(t, err)
+	assert.Equal(t, []*OutboxEntry{second}, entries)
+}
+
+func TestFileOutbox(t *testing.T) {
+	dir := t.TempDir()
+	outbox, err := NewFileOutbox(dir, 0)
+	assert.NoError(t, err)
+	testOutbox(t, outbox)
+
+	// the entries survive a new outbox on the same directory
+	reopened, err := NewFileOutbox(dir, 0)
+	assert.NoError(t, err)
+	entries, err := reopened.List(context.Background())
+	assert.NoError(t, err)
+	assert.Len(t, entries, 1)
+}
+
+func TestFileOutbox_MaxBytes(t *testing.T) {
+	ctx := context.Background()
+	dir := t.TempDir()
+	entry := &OutboxEntry{ID: "1-a", URL: "http://a", Requests: []Request{{ID: "x", Vertex: "v"}}}
+	data, err := json.Marshal(entry)
+	assert.NoError(t, err)
+	outbox, err := NewFileOutbox(dir, int64(2*len(data)))
+	assert.NoError(t, err)
+	assert.NoError(t, outbox.Put(ctx, entry))
+	assert.NoError(t, outbox.Put(ctx, &OutboxEntry{ID: "2-a", URL: entry.URL, Requests: entry.Requests}))
+	assert.ErrorIs(t, outbox.Put(ctx, &OutboxEntry{ID: "3-a", URL: entry.URL, Requests: entry.Requests}), ErrOutboxFull)
+
+	// the size of the entries left by the previous run counts
+	reopened, err := NewFileOutbox(dir, int64(2*len(data)))
+	assert.NoError(t, err)
+	assert.ErrorIs(t, reopened.Put(ctx, &OutboxEntry{ID: "3-a", URL: entry.URL, Requests: entry.Requests}), ErrOutboxFull)
+	assert.NoError(t, reopened.Delete(ctx, entry.ID))
+	assert.NoError(t, reopened.Put(ctx, &OutboxEntry{ID: "3-a", URL: entry.URL, Requests: entry.Requests}))
+}
@@ -42,6 +42,8 @@ type Options struct {
 	RefreshBufferWriteInfo bool
 	// BufferFullWritingStrategy is the writing strategy when buffer is full
 	BufferFullWritingStrategy dfv1.BufferFullWritingStrategy
+	// CompressionType is the compression type used to compress the message payload
+	CompressionType dfv1.CompressionType
 }
 
 // Option to apply different options
@@ -156,3 +158,15 @@ func (s bufferFullWritingStrategy) Apply(o *Options) {
 func WithBufferFullWritingStrategy(s dfv1.BufferFullWritingStrategy) Option {
 	return bufferFullWritingStrategy(s)
 }
+
+// compressionType option
+type compressionType dfv1.CompressionType
+
+func (c compressionType) Apply(o *Options) {
+	o.CompressionType = dfv1.CompressionType(c)
+}
+
+// WithCompressionType sets the CompressionType
+func WithCompressionType(c dfv1.CompressionType) Option {
+	return compressionType(c)
+}
@@ -0,0 +1,53 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package s3 provides the helpers to create an AWS S3 client, which can also be used with the S3-compatible object
+// stores such as MinIO.
+//
+// Credentials are resolved using the default AWS credential chain, e.g. environment variables,
+// the shared credentials file or the IAM role of the service account (IRSA).
+package s3
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/config"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+)
+
+// NewClient returns a new S3 client for the given region. The endpointURL is optional, usePathStyle addresses the
+// buckets with path style URLs, which is required by most of the S3-compatible object stores.
+func NewClient(ctx context.Context, region string, endpointURL *string, usePathStyle bool) (*s3.Client, error) {
+	var opts []func(*config.LoadOptions) error
+	if region != "" {
+		opts = append(opts, config.WithRegion(region))
+	}
+	cfg, err := config.LoadDefaultConfig(ctx, opts...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load aws config, %w", err)
+	}
+	if cfg.Region == "" {
+		return nil, fmt.Errorf("aws region is required for s3")
+	}
+	return s3.NewFromConfig(cfg, func(o *s3.Options) {
+		if endpointURL != nil && *endpointURL != "" {
+			o.BaseEndpoint = aws.String(*endpointURL)
+		}
+		o.UsePathStyle = usePathStyle
+	}), nil
+}
@@ -0,0 +1,69 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package sqs provides the helpers to create an AWS SQS client, which is shared by the SQS source and sink.
+//
+// Credentials are resolved using the default AWS credential chain, e.g. environment variables,
+// the shared credentials file or the IAM role of the service account (IRSA). The endpoint can be
+// overridden by the spec, or by the AWS_ENDPOINT_URL_SQS environment variable, which is useful to
+// point to an SQS-compatible endpoint such as localstack.
+package sqs
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/config"
+	"github.com/aws/aws-sdk-go-v2/service/sqs"
+)
+
+// HeadersAttributeName is the message attribute the message headers are packed into as a JSON object by the SQS sink,
+// when they can not be sent as individual message attributes. The SQS source unpacks it back into the headers.
+const HeadersAttributeName = "numaflow-headers"
+
+// NewClient returns a new SQS client for the given region. The endpointURL is optional.
+func NewClient(ctx context.Context, region string, endpointURL *string) (*sqs.Client, error) {
+	if region == "" {
+		return nil, fmt.Errorf("aws region is required for sqs")
+	}
+	cfg, err := config.LoadDefaultConfig(ctx, config.WithRegion(region))
+	if err != nil {
+		return nil, fmt.Errorf("failed to load aws config, %w", err)
+	}
+	return sqs.NewFromConfig(cfg, func(o *sqs.Options) {
+		if endpointURL != nil && *endpointURL != "" {
+			o.BaseEndpoint = endpointURL
+		}
+	}), nil
+}
+
+// GetQueueURL returns the URL of the queue with the given name. The queueOwnerAWSAccountID is
+// only required when the queue belongs to a different account.
+func GetQueueURL(ctx context.Context, client *sqs.Client, queueName string, queueOwnerAWSAccountID string) (string, error) {
+	if queueName == "" {
+		return "", fmt.Errorf("sqs queue name is required")
+	}
+	input := &sqs.GetQueueUrlInput{QueueName: aws.String(queueName)}
+	if queueOwnerAWSAccountID != "" {
+		input.QueueOwnerAWSAccountId = aws.String(queueOwnerAWSAccountID)
+	}
+	out, err := client.GetQueueUrl(ctx, input)
+	if err != nil {
+		return "", fmt.Errorf("failed to get the url of sqs queue %q, %w", queueName, err)
+	}
+	return aws.ToString(out.QueueUrl), nil
+}
@@ -0,0 +1,50 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sqs
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"k8s.io/utils/ptr"
+
+	sqstest "github.com/numaproj/numaflow/pkg/shared/clients/sqs/test"
+)
+
+func TestNewClient(t *testing.T) {
+	_, err := NewClient(context.Background(), "", nil)
+	assert.EqualError(t, err, "aws region is required for sqs")
+}
+
+func TestGetQueueURL(t *testing.T) {
+	t.Setenv("AWS_ACCESS_KEY_ID", "test")
+	t.Setenv("AWS_SECRET_ACCESS_KEY", "test")
+	server := sqstest.RunSQSServer(t)
+	queueURL := server.CreateQueue("test-queue")
+	client, err := NewClient(context.Background(), "us-west-2", ptr.To(server.URL))
+	assert.NoError(t, err)
+
+	u, err := GetQueueURL(context.Background(), client, "test-queue", "")
+	assert.NoError(t, err)
+	assert.Equal(t, queueURL, u)
+
+	_, err = GetQueueURL(context.Background(), client, "", "")
+	assert.EqualError(t, err, "sqs queue name is required")
+	_, err = GetQueueURL(context.Background(), client, "not-exist", "123456789012")
+	assert.Error(t, err)
+}
@@ -0,0 +1,331 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package test provides an in-memory SQS-compatible server, which implements the subset of the
+// AWS JSON protocol used by the SQS source and sink. It is only used for testing.
+package test
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const accountID = "000000000000"
+
+// MessageAttribute is a string message attribute of an SQS message.
+type MessageAttribute struct {
+	DataType    string `json:"DataType"`
+	StringValue string `json:"StringValue,omitempty"`
+}
+
+// Message is a message stored in the server.
+type Message struct {
+	MessageID         string
+	Body              string
+	MessageAttributes map[string]MessageAttribute
+	SentTimestamp     time.Time
+	receiptHandle     string
+	invisibleUntil    time.Time
+}
+
+type queue struct {
+	name     string
+	messages []*Message
+}
+
+// Server is an in-memory SQS-compatible server.
+type Server struct {
+	sync.Mutex
+	*httptest.Server
+	queues map[string]*queue
+	// FailSend is used to fail the SendMessageBatch entries whose body it returns true for.
+	FailSend func(body string) bool
+	// FailReceive is used to fail the ReceiveMessage requests it returns true for.
+	FailReceive func() bool
+}
+
+// RunSQSServer starts an in-memory SQS-compatible server, which is closed when the test finishes.
+func RunSQSServer(t *testing.T) *Server {
+	t.Helper()
+	s := &Server{queues: make(map[string]*queue)}
+	s.Server = httptest.NewServer(http.HandlerFunc(s.handle))
+	t.Cleanup(s.Close)
+	return s
+}
+
+// CreateQueue creates a queue and returns its URL.
+func (s *Server) CreateQueue(name string) string {
+	s.Lock()
+	defer s.Unlock()
+	s.queues[name] = &queue{name: name}
+	return s.queueURL(name)
+}
+
+// SendMessage adds a message to the queue.
+func (s *Server) SendMessage(queueName string, body string, attributes map[string]string) {
+	s.Lock()
+	defer s.Unlock()
+	q := s.queues[queueName]
+	q.messages = append(q.messages, newMessage(body, toMessageAttributes(attributes)))
+}
+
+// Messages returns all the messages in the queue, including the in-flight ones.
+func (s *Server) Messages(queueName string) []Message {
+	s.Lock()
+	defer s.Unlock()
+	var result []Message
+	for _, m := range s.queues[queueName].messages {
+		result = append(result, *m)
+	}
+	return result
+}
+
+func (s *Server) queueURL(name string) string {
+	return s.URL + "/" + accountID + "/" + name
+}
+
+func (s *Server) getQueue(queueURL string) (*queue, error) {
+	q, ok := s.queues[queueURL[strings.LastIndex(queueURL, "/")+1:]]
+	if !ok {
+		return nil, fmt.Errorf("queue %q does not exist", queueURL)
+	}
+	return q, nil
+}
+
+func newMessage(body string, attributes map[string]MessageAttribute) *Message {
+	return &Message{
+		MessageID:         uuid.New().String(),
+		Body:              body,
+		MessageAttributes: attributes,
+		SentTimestamp:     time.Now(),
+	}
+}
+
+func toMessageAttributes(attributes map[string]string) map[string]MessageAttribute {
+	if len(attributes) == 0 {
+		return nil
+	}
+	result := make(map[string]MessageAttribute, len(attributes))
+	for k, v := range attributes {
+		result[k] = MessageAttribute{DataType: "String", StringValue: v}
+	}
+	return result
+}
+
+type batchResultErrorEntry struct {
+	Id          string `json:"Id"`
+	Code        string `json:"Code"`
+	Message     string `json:"Message"`
+	SenderFault bool   `json:"SenderFault"`
+}
+
+func (s *Server) handle(w http.ResponseWriter, r *http.Request) {
+	s.Lock()
+	defer s.Unlock()
+	var (
+		resp any
+		err  error
+	)
+	switch action := strings.TrimPrefix(r.Header.Get("X-Amz-Target"), "AmazonSQS."); action {
+	case "GetQueueUrl":
+		resp, err = s.getQueueURL(r)
+	case "ReceiveMessage":
+		resp, err = s.receiveMessage(r)
+	case "DeleteMessageBatch":
+		resp, err = s.deleteMessageBatch(r)
+	case "GetQueueAttributes":
+		resp, err = s.getQueueAttributes(r)
+	case "SendMessageBatch":
+		resp, err = s.sendMessageBatch(r)
+	default:
+		err = fmt.Errorf("unsupported action %q", action)
+	}
+	w.Header().Set("Content-Type", "application/x-amz-json-1.0")
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		_ = json.NewEncoder(w).Encode(map[string]string{"__type": "com.amazonaws.sqs#InvalidParameterValue", "message": err.Error()})
+		return
+	}
+	_ = json.NewEncoder(w).Encode(resp)
+}
+
+func (s *Server) getQueueURL(r *http.Request) (any, error) {
+	var req struct {
+		QueueName string `json:"QueueName"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		return nil, err
+	}
+	if _, ok := s.queues[req.QueueName]; !ok {
+		return nil, fmt.Errorf("queue %q does not exist", req.QueueName)
+	}
+	return map[string]string{"QueueUrl": s.queueURL(req.QueueName)}, nil
+}
+
+func (s *Server) receiveMessage(r *http.Request) (any, error) {
+	var req struct {
+		QueueUrl            string `json:"QueueUrl"`
+		MaxNumberOfMessages int    `json:"MaxNumberOfMessages"`
+		VisibilityTimeout   *int   `json:"VisibilityTimeout"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		return nil, err
+	}
+	q, err := s.getQueue(req.QueueUrl)
+	if err != nil {
+		return nil, err
+	}
+	if s.FailReceive != nil && s.FailReceive() {
+		return nil, fmt.Errorf("failed to receive messages")
+	}
+	if req.MaxNumberOfMessages == 0 {
+		req.MaxNumberOfMessages = 1
+	}
+	visibilityTimeout := 30 * time.Second
+	if req.VisibilityTimeout != nil {
+		visibilityTimeout = time.Duration(*req.VisibilityTimeout) * time.Second
+	}
+	type message struct {
+		MessageId         string                      `json:"MessageId"`
+		ReceiptHandle     string                      `json:"ReceiptHandle"`
+		Body              string                      `json:"Body"`
+		Attributes        map[string]string           `json:"Attributes"`
+		MessageAttributes map[string]MessageAttribute `json:"MessageAttributes,omitempty"`
+	}
+	now := time.Now()
+	var messages []message
+	for _, m := range q.messages {
+		if len(messages) == req.MaxNumberOfMessages {
+			break
+		}
+		if m.invisibleUntil.After(now) {
+			continue
+		}
+		m.receiptHandle = uuid.New().String()
+		m.invisibleUntil = now.Add(visibilityTimeout)
+		messages = append(messages, message{
+			MessageId:         m.MessageID,
+			ReceiptHandle:     m.receiptHandle,
+			Body:              m.Body,
+			Attributes:        map[string]string{"SentTimestamp": strconv.FormatInt(m.SentTimestamp.UnixMilli(), 10)},
+			MessageAttributes: m.MessageAttributes,
+		})
+	}
+	return map[string]any{"Messages": messages}, nil
+}
+
+func (s *Server) deleteMessageBatch(r *http.Request) (any, error) {
+	var req struct {
+		QueueUrl string `json:"QueueUrl"`
+		Entries  []struct {
+			Id            string `json:"Id"`
+			ReceiptHandle string `json:"ReceiptHandle"`
+		} `json:"Entries"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		return nil, err
+	}
+	q, err := s.getQueue(req.QueueUrl)
+	if err != nil {
+		return nil, err
+	}
+	successful := make([]map[string]string, 0)
+	failed := make([]batchResultErrorEntry, 0)
+	for _, e := range req.Entries {
+		deleted := false
+		for i, m := range q.messages {
+			if m.receiptHandle == e.ReceiptHandle {
+				q.messages = append(q.messages[:i], q.messages[i+1:]...)
+				deleted = true
+				break
+			}
+		}
+		if deleted {
+			successful = append(successful, map[string]string{"Id": e.Id})
+		} else {
+			failed = append(failed, batchResultErrorEntry{Id: e.Id, Code: "ReceiptHandleIsInvalid", Message: "receipt handle is invalid", SenderFault: true})
+		}
+	}
+	return map[string]any{"Successful": successful, "Failed": failed}, nil
+}
+
+func (s *Server) getQueueAttributes(r *http.Request) (any, error) {
+	var req struct {
+		QueueUrl string `json:"QueueUrl"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		return nil, err
+	}
+	q, err := s.getQueue(req.QueueUrl)
+	if err != nil {
+		return nil, err
+	}
+	now := time.Now()
+	visible, notVisible := 0, 0
+	for _, m := range q.messages {
+		if m.invisibleUntil.After(now) {
+			notVisible++
+		} else {
+			visible++
+		}
+	}
+	return map[string]any{"Attributes": map[string]string{
+		"ApproximateNumberOfMessages":           strconv.Itoa(visible),
+		"ApproximateNumberOfMessagesNotVisible": strconv.Itoa(notVisible),
+	}}, nil
+}
+
+func (s *Server) sendMessageBatch(r *http.Request) (any, error) {
+	var req struct {
+		QueueUrl string `json:"QueueUrl"`
+		Entries  []struct {
+			Id                string                      `json:"Id"`
+			MessageBody       string                      `json:"MessageBody"`
+			MessageAttributes map[string]MessageAttribute `json:"MessageAttributes"`
+		} `json:"Entries"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		return nil, err
+	}
+	q, err := s.getQueue(req.QueueUrl)
+	if err != nil {
+		return nil, err
+	}
+	if len(req.Entries) > 10 {
+		return nil, fmt.Errorf("too many entries in the batch, %d", len(req.Entries))
+	}
+	successful := make([]map[string]string, 0)
+	failed := make([]batchResultErrorEntry, 0)
+	for _, e := range req.Entries {
+		if s.FailSend != nil && s.FailSend(e.MessageBody) {
+			failed = append(failed, batchResultErrorEntry{Id: e.Id, Code: "InternalError", Message: "failed to send " + e.MessageBody})
+			continue
+		}
+		m := newMessage(e.MessageBody, e.MessageAttributes)
+		q.messages = append(q.messages, m)
+		successful = append(successful, map[string]string{"Id": e.Id, "MessageId": m.MessageID})
+	}
+	return map[string]any{"Successful": successful, "Failed": failed}, nil
+}
@@ -0,0 +1,111 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package compression compresses and decompresses the message payloads written to the InterStepBuffer.
+package compression
+
+import (
+	"bytes"
+	"compress/gzip"
+	"fmt"
+	"io"
+
+	"github.com/klauspost/compress/zstd"
+	"github.com/pierrec/lz4/v4"
+
+	dfv1 "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1"
+)
+
+// HeaderKey is the header used to mark which codec has been used to compress a message payload.
+// Readers use it to decide how to decompress, so that buffers holding messages written with
+// different settings (or by writers without compression support) can still be read.
+const HeaderKey = "X-Numaflow-Compression"
+
+var (
+	// zstd encoder and decoder are safe for concurrent use when using EncodeAll and DecodeAll.
+	zstdEncoder, _ = zstd.NewWriter(nil)
+	zstdDecoder, _ = zstd.NewReader(nil)
+)
+
+// IsEnabled returns true if the payload needs to be compressed with the given compression type.
+func IsEnabled(t dfv1.CompressionType) bool {
+	return t != "" && t != dfv1.CompressionTypeNone
+}
+
+// Compress compresses the data with the given compression type.
+func Compress(t dfv1.CompressionType, data []byte) ([]byte, error) {
+	switch t {
+	case "", dfv1.CompressionTypeNone:
+		return data, nil
+	case dfv1.CompressionTypeGZIP:
+		var buf bytes.Buffer
+		w := gzip.NewWriter(&buf)
+		if _, err := w.Write(data); err != nil {
+			return nil, fmt.Errorf("failed to gzip compress, %w", err)
+		}
+		if err := w.Close(); err != nil {
+			return nil, fmt.Errorf("failed to gzip compress, %w", err)
+		}
+		return buf.Bytes(), nil
+	case dfv1.CompressionTypeZSTD:
+		return zstdEncoder.EncodeAll(data, make([]byte, 0, len(data))), nil
+	case dfv1.CompressionTypeLZ4:
+		var buf bytes.Buffer
+		w := lz4.NewWriter(&buf)
+		if _, err := w.Write(data); err != nil {
+			return nil, fmt.Errorf("failed to lz4 compress, %w", err)
+		}
+		if err := w.Close(); err != nil {
+			return nil, fmt.Errorf("failed to lz4 compress, %w", err)
+		}
+		return buf.Bytes(), nil
+	default:
+		return nil, fmt.Errorf("unsupported compression type %q", t)
+	}
+}
+
+// Decompress decompresses the data which was compressed with the given compression type.
+func Decompress(t dfv1.CompressionType, data []byte) ([]byte, error) {
+	switch t {
+	case "", dfv1.CompressionTypeNone:
+		return data, nil
+	case dfv1.CompressionTypeGZIP:
+		r, err := gzip.NewReader(bytes.NewReader(data))
+		if err != nil {
+			return nil, fmt.Errorf("failed to create gzip reader, %w", err)
+		}
+		defer func() { _ = r.Close() }()
+		result, err := io.ReadAll(r)
+		if err != nil {
+			return nil, fmt.Errorf("failed to gzip decompress, %w", err)
+		}
+		return result, nil
+	case dfv1.CompressionTypeZSTD:
+		result, err := zstdDecoder.DecodeAll(data, nil)
+		if err != nil {
+			return nil, fmt.Errorf("failed to zstd decompress, %w", err)
+		}
+		return result, nil
+	case dfv1.CompressionTypeLZ4:
+		result, err := io.ReadAll(lz4.NewReader(bytes.NewReader(data)))
+		if err != nil {
+			return nil, fmt.Errorf("failed to lz4 decompress, %w", err)
+		}
+		return result, nil
+	default:
+		return nil, fmt.Errorf("unsupported compression type %q", t)
+	}
+}
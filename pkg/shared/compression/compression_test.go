@@ -0,0 +1,68 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package compression
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	dfv1 "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1"
+)
+
+func TestCompressAndDecompress(t *testing.T) {
+	data := bytes.Repeat([]byte("numaflow compression test payload "), 100)
+	for _, ct := range []dfv1.CompressionType{"", dfv1.CompressionTypeNone, dfv1.CompressionTypeGZIP, dfv1.CompressionTypeZSTD, dfv1.CompressionTypeLZ4} {
+		t.Run(string(ct), func(t *testing.T) {
+			compressed, err := Compress(ct, data)
+			assert.NoError(t, err)
+			if IsEnabled(ct) {
+				assert.Less(t, len(compressed), len(data))
+			} else {
+				assert.Equal(t, data, compressed)
+			}
+			decompressed, err := Decompress(ct, compressed)
+			assert.NoError(t, err)
+			assert.Equal(t, data, decompressed)
+		})
+	}
+}
+
+func TestCompressEmptyPayload(t *testing.T) {
+	for _, ct := range []dfv1.CompressionType{dfv1.CompressionTypeGZIP, dfv1.CompressionTypeZSTD, dfv1.CompressionTypeLZ4} {
+		compressed, err := Compress(ct, nil)
+		assert.NoError(t, err)
+		decompressed, err := Decompress(ct, compressed)
+		assert.NoError(t, err)
+		assert.Empty(t, decompressed)
+	}
+}
+
+func TestUnsupportedType(t *testing.T) {
+	_, err := Compress("snappy", []byte("a"))
+	assert.Error(t, err)
+	_, err = Decompress("snappy", []byte("a"))
+	assert.Error(t, err)
+}
+
+func TestDecompressInvalidData(t *testing.T) {
+	for _, ct := range []dfv1.CompressionType{dfv1.CompressionTypeGZIP, dfv1.CompressionTypeZSTD, dfv1.CompressionTypeLZ4} {
+		_, err := Decompress(ct, []byte("not compressed"))
+		assert.Error(t, err)
+	}
+}
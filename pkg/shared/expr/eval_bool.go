@@ -23,11 +23,15 @@ import (
 
 	"github.com/Masterminds/sprig/v3"
 	"github.com/antonmedv/expr"
+	"github.com/antonmedv/expr/vm"
 )
 
 var sprigFuncMap = sprig.GenericFuncMap()
 
-const root = "payload"
+const (
+	root        = "payload"
+	headersRoot = "headers"
+)
 
 func EvalBool(expression string, msg []byte) (bool, error) {
 	msgMap := map[string]interface{}{
@@ -45,6 +49,47 @@ func EvalBool(expression string, msg []byte) (bool, error) {
 	return resultBool, nil
 }
 
+// BoolExpression is a compiled boolean expression, which is evaluated against the payload and the headers of the
+// messages, e.g. the expression condition of an edge. Compiling it once avoids parsing the expression for every message.
+type BoolExpression struct {
+	expression string
+	program    *vm.Program
+}
+
+// CompileBool compiles the boolean expression. The payload of the message is available as "payload", and the headers
+// as "headers", e.g. `json(payload).amount > 100 && headers["type"] == "order"`.
+func CompileBool(expression string) (*BoolExpression, error) {
+	program, err := expr.Compile(expression, expr.Env(messageEnv(nil, nil)), expr.AsBool())
+	if err != nil {
+		return nil, fmt.Errorf("unable to compile expression '%s': %s", expression, err)
+	}
+	return &BoolExpression{expression: expression, program: program}, nil
+}
+
+// Eval evaluates the expression against the payload and the headers of a message.
+func (e *BoolExpression) Eval(payload []byte, headers map[string]string) (bool, error) {
+	result, err := expr.Run(e.program, messageEnv(payload, headers))
+	if err != nil {
+		return false, fmt.Errorf("unable to evaluate expression '%s': %s", e.expression, err)
+	}
+	resultBool, ok := result.(bool)
+	if !ok {
+		return false, fmt.Errorf("unable to cast expression result '%s' to bool", result)
+	}
+	return resultBool, nil
+}
+
+func messageEnv(payload []byte, headers map[string]string) map[string]interface{} {
+	if headers == nil {
+		headers = map[string]string{}
+	}
+	env := getFuncMap(map[string]interface{}{
+		root: string(payload),
+	})
+	env[headersRoot] = headers
+	return env
+}
+
 func getFuncMap(m map[string]interface{}) map[string]interface{} {
 	env := Expand(m)
 	env["sprig"] = sprigFuncMap
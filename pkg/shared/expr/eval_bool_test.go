@@ -140,3 +140,33 @@ func Test_eval_EvalBool(t *testing.T) {
 		assert.Contains(t, err.Error(), "unable to evaluate expression")
 	})
 }
+
+func TestBoolExpression(t *testing.T) {
+	t.Run("test payload and headers", func(t *testing.T) {
+		e, err := CompileBool(`json(payload).amount > 100 && headers["type"] == "order"`)
+		assert.NoError(t, err)
+		result, err := e.Eval([]byte(`{"amount": 120}`), map[string]string{"type": "order"})
+		assert.NoError(t, err)
+		assert.True(t, result)
+		result, err = e.Eval([]byte(`{"amount": 120}`), map[string]string{"type": "refund"})
+		assert.NoError(t, err)
+		assert.False(t, result)
+		result, err = e.Eval([]byte(`{"amount": 120}`), nil)
+		assert.NoError(t, err)
+		assert.False(t, result)
+	})
+
+	t.Run("test invalid payload", func(t *testing.T) {
+		e, err := CompileBool(`json(payload).amount > 100`)
+		assert.NoError(t, err)
+		_, err = e.Eval([]byte(`abc`), nil)
+		assert.Error(t, err)
+	})
+
+	t.Run("test compile errors", func(t *testing.T) {
+		_, err := CompileBool(`payload ==`)
+		assert.Error(t, err)
+		_, err = CompileBool(`string(payload)`)
+		assert.Error(t, err)
+	})
+}
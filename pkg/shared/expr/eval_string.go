@@ -20,6 +20,7 @@ import (
 	"fmt"
 
 	"github.com/antonmedv/expr"
+	"github.com/antonmedv/expr/vm"
 )
 
 // EvalStr uses the given input expression to evaluate input message and compile it to a string.
@@ -40,3 +41,29 @@ func EvalStr(expression string, msg []byte) (string, error) {
 	}
 	return fmt.Sprintf("%v", result), nil
 }
+
+// StrExpression is a compiled expression whose result is converted to a string, e.g. the key a built-in map function
+// extracts from the messages.
+type StrExpression struct {
+	expression string
+	program    *vm.Program
+}
+
+// CompileStr compiles the expression, the payload and the headers of the message are available the same way as
+// CompileBool.
+func CompileStr(expression string) (*StrExpression, error) {
+	program, err := expr.Compile(expression, expr.Env(messageEnv(nil, nil)))
+	if err != nil {
+		return nil, fmt.Errorf("unable to compile expression '%s': %s", expression, err)
+	}
+	return &StrExpression{expression: expression, program: program}, nil
+}
+
+// Eval evaluates the expression against the payload and the headers of a message.
+func (e *StrExpression) Eval(payload []byte, headers map[string]string) (string, error) {
+	result, err := expr.Run(e.program, messageEnv(payload, headers))
+	if err != nil {
+		return "", fmt.Errorf("unable to evaluate expression '%s': %s", e.expression, err)
+	}
+	return fmt.Sprintf("%v", result), nil
+}
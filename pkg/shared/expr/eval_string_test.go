@@ -47,3 +47,30 @@ func Test_eval_expression(t *testing.T) {
 		assert.Contains(t, err.Error(), "unable to compile expression")
 	})
 }
+
+func TestStrExpression(t *testing.T) {
+	t.Run("test payload and headers", func(t *testing.T) {
+		e, err := CompileStr(`json(payload).customer.id + "-" + headers["region"]`)
+		assert.NoError(t, err)
+		result, err := e.Eval([]byte(`{"customer": {"id": "c1"}}`), map[string]string{"region": "us"})
+		assert.NoError(t, err)
+		assert.Equal(t, "c1-us", result)
+	})
+
+	t.Run("test non-string result", func(t *testing.T) {
+		e, err := CompileStr(`json(payload).amount`)
+		assert.NoError(t, err)
+		result, err := e.Eval([]byte(`{"amount": 120}`), nil)
+		assert.NoError(t, err)
+		assert.Equal(t, "120", result)
+	})
+
+	t.Run("test invalid expression", func(t *testing.T) {
+		_, err := CompileStr(`ab\na`)
+		assert.Error(t, err)
+		e, err := CompileStr(`json(payload).a`)
+		assert.NoError(t, err)
+		_, err = e.Eval([]byte(`abc`), nil)
+		assert.Error(t, err)
+	})
+}
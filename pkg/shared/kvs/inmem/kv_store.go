@@ -20,6 +20,7 @@ Package inmem package implements the watermark progression using in mem store as
 package inmem
 
 import (
+	"bytes"
 	"context"
 	"fmt"
 	"sort"
@@ -72,6 +73,7 @@ type inMemStore struct {
 }
 
 var _ kvs.KVStorer = (*inMemStore)(nil)
+var _ kvs.CompareAndPutter = (*inMemStore)(nil)
 
 // NewKVInMemKVStore returns inMemStore.
 func NewKVInMemKVStore(ctx context.Context, bucketName string) (kvs.KVStorer, error) {
@@ -107,7 +109,7 @@ func (kv *inMemStore) GetValue(_ context.Context, k string) ([]byte, error) {
 	if val, ok := kv.kv[k]; ok {
 		return val, nil
 	} else {
-		return []byte(""), fmt.Errorf("key %s not found", k)
+		return []byte(""), fmt.Errorf("%w: %s", kvs.ErrKeyNotFound, k)
 	}
 }
 
@@ -140,7 +142,7 @@ func (kv *inMemStore) DeleteKey(ctx context.Context, k string) error {
 		kv.kvHistory = append(kv.kvHistory, entry)
 		return nil
 	} else {
-		return fmt.Errorf("key %s not found", k)
+		return fmt.Errorf("%w: %s", kvs.ErrKeyNotFound, k)
 	}
 }
 
@@ -148,6 +150,22 @@ func (kv *inMemStore) DeleteKey(ctx context.Context, k string) error {
 func (kv *inMemStore) PutKV(ctx context.Context, k string, v []byte) error {
 	kv.lock.Lock()
 	defer kv.lock.Unlock()
+	return kv.put(ctx, k, v)
+}
+
+// CompareAndPut puts an element to the in mem key-value store if the current value is old.
+func (kv *inMemStore) CompareAndPut(ctx context.Context, k string, old, v []byte) error {
+	kv.lock.Lock()
+	defer kv.lock.Unlock()
+	current, ok := kv.kv[k]
+	if ok != (old != nil) || !bytes.Equal(current, old) {
+		return fmt.Errorf("%w: %s", kvs.ErrKeyConflict, k)
+	}
+	return kv.put(ctx, k, v)
+}
+
+// put puts an element and notifies the watchers, the lock must be held by the caller.
+func (kv *inMemStore) put(ctx context.Context, k string, v []byte) error {
 	if kv.isClosed {
 		return fmt.Errorf("kv store is closed")
 	}
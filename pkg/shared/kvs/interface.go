@@ -18,8 +18,15 @@ package kvs
 
 import (
 	"context"
+	"errors"
 )
 
+// ErrKeyNotFound is returned by GetValue if the key doesn't exist.
+var ErrKeyNotFound = errors.New("key not found")
+
+// ErrKeyConflict is returned by CompareAndPut if the value of the key has been changed.
+var ErrKeyConflict = errors.New("key conflict")
+
 // KVStorer defines the storage for publishing the watermark and sideinput
 type KVStorer interface {
 	// GetAllKeys the keys from KV store.
@@ -28,7 +35,7 @@ type KVStorer interface {
 	DeleteKey(context.Context, string) error
 	// PutKV inserts a key-value pair into the KV store.
 	PutKV(context.Context, string, []byte) error
-	// GetValue gets the value of the given key.
+	// GetValue gets the value of the given key, it returns ErrKeyNotFound if the key doesn't exist.
 	GetValue(context.Context, string) ([]byte, error)
 	// GetStoreName returns the bucket name of the KV store.
 	GetStoreName() string
@@ -39,6 +46,13 @@ type KVStorer interface {
 	Close()
 }
 
+// CompareAndPutter is implemented by the KV stores which support optimistic concurrency control.
+type CompareAndPutter interface {
+	// CompareAndPut puts the key-value pair only if the current value of the key is old, a nil old means
+	// the key doesn't exist. It returns ErrKeyConflict if the value has been changed.
+	CompareAndPut(ctx context.Context, k string, old, v []byte) error
+}
+
 // KVWatchOp is the operation as detected by the KV watcher.
 type KVWatchOp int64
 
@@ -20,6 +20,7 @@ Package jetstream package implements the kv store and watcher using Jetstream.
 package jetstream
 
 import (
+	"bytes"
 	"context"
 	"errors"
 	"fmt"
@@ -47,6 +48,7 @@ type jetStreamStore struct {
 }
 
 var _ kvs.KVStorer = (*jetStreamStore)(nil)
+var _ kvs.CompareAndPutter = (*jetStreamStore)(nil)
 
 // NewKVJetStreamKVStore returns KVJetStreamStore.
 func NewKVJetStreamKVStore(ctx context.Context, kvName string, client *jsclient.Client, opts ...Option) (kvs.KVStorer, error) {
@@ -120,6 +122,9 @@ func (jss *jetStreamStore) GetAllKeys(_ context.Context) ([]string, error) {
 func (jss *jetStreamStore) GetValue(_ context.Context, k string) ([]byte, error) {
 	keyValueEntry, err := jss.kv.Get(k)
 	if err != nil {
+		if errors.Is(err, nats.ErrKeyNotFound) {
+			return []byte(""), fmt.Errorf("%w: %s", kvs.ErrKeyNotFound, k)
+		}
 		return []byte(""), err
 	}
 
@@ -145,6 +150,36 @@ func (jss *jetStreamStore) PutKV(_ context.Context, k string, v []byte) error {
 	return err
 }
 
+// CompareAndPut puts an element to the JS key-value store if the current value is old, the revision
+// of the current value is used for the update, so the value can't be changed in between.
+func (jss *jetStreamStore) CompareAndPut(_ context.Context, k string, old, v []byte) error {
+	var revision uint64
+	entry, err := jss.kv.Get(k)
+	switch {
+	case err == nil:
+		if old == nil || !bytes.Equal(entry.Value(), old) {
+			return fmt.Errorf("%w: %s", kvs.ErrKeyConflict, k)
+		}
+		revision = entry.Revision()
+	case errors.Is(err, nats.ErrKeyNotFound):
+		if old != nil {
+			return fmt.Errorf("%w: %s", kvs.ErrKeyConflict, k)
+		}
+	default:
+		return err
+	}
+	if revision == 0 {
+		_, err = jss.kv.Create(k, v)
+	} else {
+		_, err = jss.kv.Update(k, v, revision)
+	}
+	var apiErr *nats.APIError
+	if errors.As(err, &apiErr) && apiErr.ErrorCode == nats.JSErrCodeStreamWrongLastSequence {
+		return fmt.Errorf("%w: %s", kvs.ErrKeyConflict, k)
+	}
+	return err
+}
+
 func (jss *jetStreamStore) GetKVName() string {
 	return jss.kvName
 }
@@ -90,6 +90,21 @@ func TestJetStreamKVStoreOperations(t *testing.T) {
 	keys, err = kvStore.GetAllKeys(ctx)
 	assert.NoError(t, err)
 	assert.Equal(t, []string{"key2"}, keys)
+
+	// Test compare and put
+	cas := kvStore.(kvs.CompareAndPutter)
+	assert.ErrorIs(t, cas.CompareAndPut(ctx, "key2", []byte("stale"), []byte("value3")), kvs.ErrKeyConflict)
+	assert.ErrorIs(t, cas.CompareAndPut(ctx, "key2", nil, []byte("value3")), kvs.ErrKeyConflict)
+	assert.NoError(t, cas.CompareAndPut(ctx, "key2", []byte("value2"), []byte("value3")))
+	assert.ErrorIs(t, cas.CompareAndPut(ctx, "key3", []byte("value2"), []byte("value3")), kvs.ErrKeyConflict)
+	// the deleted key can be created again
+	assert.NoError(t, cas.CompareAndPut(ctx, "key1", nil, []byte("value1")))
+	value, err = kvStore.GetValue(ctx, "key2")
+	assert.NoError(t, err)
+	assert.Equal(t, []byte("value3"), value)
+	value, err = kvStore.GetValue(ctx, "key1")
+	assert.NoError(t, err)
+	assert.Equal(t, []byte("value1"), value)
 }
 
 func TestJetStreamKVStoreWatch(t *testing.T) {
@@ -293,7 +308,7 @@ func TestJetStreamKVStoreGetValueError(t *testing.T) {
 
 	// Attempt to get a non-existent key to simulate error
 	_, err = kvStore.GetValue(ctx, "non-existent-key")
-	assert.Error(t, err)
+	assert.ErrorIs(t, err, kvs.ErrKeyNotFound)
 }
 
 func TestJetStreamKVStoreListKeysError(t *testing.T) {
@@ -0,0 +1,434 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package redis implements the KVStorer backed by Redis. The key-value pairs of a store are kept in a Redis hash,
+// and every change is also appended to a Redis stream, which is what the watchers read from.
+package redis
+
+import (
+	"bytes"
+	"cmp"
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+	"go.uber.org/zap"
+
+	redisclient "github.com/numaproj/numaflow/pkg/shared/clients/redis"
+	"github.com/numaproj/numaflow/pkg/shared/kvs"
+	"github.com/numaproj/numaflow/pkg/shared/logging"
+)
+
+const (
+	fieldOp    = "op"
+	fieldKey   = "key"
+	fieldValue = "value"
+	opPut      = "put"
+	opDelete   = "delete"
+)
+
+// redisStore implements the KVStorer backed by Redis.
+type redisStore struct {
+	kvName     string
+	hashKey    string
+	updatesKey string
+	client     *redisclient.RedisClient
+	doneCh     chan struct{}
+	log        *zap.SugaredLogger
+	opts       *options
+}
+
+var _ kvs.KVStorer = (*redisStore)(nil)
+var _ kvs.CompareAndPutter = (*redisStore)(nil)
+
+// NewKVRedisKVStore returns a Redis KVStorer. There is nothing to be created on the Redis side,
+// the hash and the updates stream are created by the first write.
+func NewKVRedisKVStore(ctx context.Context, kvName string, client *redisclient.RedisClient, opts ...Option) (kvs.KVStorer, error) {
+	kvOpts := defaultOptions()
+	for _, o := range opts {
+		o(kvOpts)
+	}
+	return &redisStore{
+		kvName:     kvName,
+		hashKey:    KVHashKey(kvName),
+		updatesKey: KVUpdatesKey(kvName),
+		client:     client,
+		doneCh:     make(chan struct{}),
+		log:        logging.FromContext(ctx).With("kvName", kvName),
+		opts:       kvOpts,
+	}, nil
+}
+
+// KVHashKey returns the Redis key of the hash which holds the key-value pairs of the store.
+// The hash tag makes sure the hash and the updates stream are in the same slot in cluster mode.
+func KVHashKey(kvName string) string {
+	return fmt.Sprintf("{%s}:kv", kvName)
+}
+
+// KVUpdatesKey returns the Redis key of the stream which holds the updates of the store.
+func KVUpdatesKey(kvName string) string {
+	return fmt.Sprintf("{%s}:updates", kvName)
+}
+
+// kvEntry is each key-value entry in the store and the operation associated with the kv pair.
+type kvEntry struct {
+	key   string
+	value []byte
+	op    kvs.KVWatchOp
+}
+
+// Key returns the key
+func (k kvEntry) Key() string {
+	return k.key
+}
+
+// Value returns the value.
+func (k kvEntry) Value() []byte {
+	return k.value
+}
+
+// Operation returns the operation on that key-value pair.
+func (k kvEntry) Operation() kvs.KVWatchOp {
+	return k.op
+}
+
+// GetAllKeys returns all the keys in the key-value store.
+func (rs *redisStore) GetAllKeys(ctx context.Context) ([]string, error) {
+	return rs.client.Client.HKeys(ctx, rs.hashKey).Result()
+}
+
+// GetValue returns the value for a given key.
+func (rs *redisStore) GetValue(ctx context.Context, k string) ([]byte, error) {
+	val, err := rs.client.Client.HGet(ctx, rs.hashKey, k).Bytes()
+	if err != nil {
+		if errors.Is(err, redis.Nil) {
+			return []byte(""), fmt.Errorf("%w: %s", kvs.ErrKeyNotFound, k)
+		}
+		return []byte(""), err
+	}
+	return val, nil
+}
+
+// GetStoreName returns the store name.
+func (rs *redisStore) GetStoreName() string {
+	return rs.kvName
+}
+
+// DeleteKey deletes the key from the store and notifies the watchers.
+func (rs *redisStore) DeleteKey(ctx context.Context, k string) error {
+	_, err := rs.client.Client.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		pipe.HDel(ctx, rs.hashKey, k)
+		pipe.XAdd(ctx, rs.updateArgs(opDelete, k, nil))
+		return nil
+	})
+	return err
+}
+
+// PutKV puts an element to the store and notifies the watchers.
+func (rs *redisStore) PutKV(ctx context.Context, k string, v []byte) error {
+	_, err := rs.client.Client.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		pipe.HSet(ctx, rs.hashKey, k, v)
+		pipe.XAdd(ctx, rs.updateArgs(opPut, k, v))
+		return nil
+	})
+	return err
+}
+
+// CompareAndPut puts an element to the store if the current value is old, and notifies the watchers.
+// The hash is watched, so the transaction fails if it's changed in between.
+func (rs *redisStore) CompareAndPut(ctx context.Context, k string, old, v []byte) error {
+	err := rs.client.Client.Watch(ctx, func(tx *redis.Tx) error {
+		current, err := tx.HGet(ctx, rs.hashKey, k).Bytes()
+		exists := true
+		if errors.Is(err, redis.Nil) {
+			exists = false
+		} else if err != nil {
+			return err
+		}
+		if exists != (old != nil) || !bytes.Equal(current, old) {
+			return fmt.Errorf("%w: %s", kvs.ErrKeyConflict, k)
+		}
+		_, err = tx.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+			pipe.HSet(ctx, rs.hashKey, k, v)
+			pipe.XAdd(ctx, rs.updateArgs(opPut, k, v))
+			return nil
+		})
+		return err
+	}, rs.hashKey)
+	if errors.Is(err, redis.TxFailedErr) {
+		return fmt.Errorf("%w: %s", kvs.ErrKeyConflict, k)
+	}
+	return err
+}
+
+func (rs *redisStore) updateArgs(op string, k string, v []byte) *redis.XAddArgs {
+	return &redis.XAddArgs{
+		Stream: rs.updatesKey,
+		MaxLen: rs.opts.maxUpdates,
+		Approx: true,
+		Values: map[string]interface{}{fieldOp: op, fieldKey: k, fieldValue: v},
+	}
+}
+
+// Watch watches the store and returns the updates channel. Like the JetStream KV watchers, the current
+// key-value pairs are delivered first as puts, followed by the updates.
+//
+// The updates stream is trimmed to about maxUpdates entries, so a watcher which falls behind could miss some of the
+// updates. The gap is detected by comparing the last update read with the latest trimmed one, in which case the
+// key-value pairs are snapshotted again, the changed ones are delivered as puts and the removed ones as deletes.
+func (rs *redisStore) Watch(ctx context.Context) <-chan kvs.KVEntry {
+	var updates = make(chan kvs.KVEntry)
+	go func() {
+		defer close(updates)
+		send := func(entry kvs.KVEntry) bool {
+			select {
+			case <-ctx.Done():
+				return false
+			case <-rs.doneCh:
+				return false
+			case updates <- entry:
+				return true
+			}
+		}
+
+		// known holds the keys which have been delivered as puts, and not deleted since, it is needed to deliver
+		// the deletes missed in a gap.
+		known := make(map[string]struct{})
+		// resync snapshots the key-value pairs and delivers them, it returns the position of the updates stream to
+		// continue from.
+		resync := func() (string, bool) {
+			// the position of the updates stream is taken before the snapshot, so nothing is missed in between.
+			// the updates after the position may have been applied to the snapshot already, replaying them
+			// still ends with the latest values.
+			lastID, ok := rs.lastUpdateID(ctx)
+			if !ok {
+				return "", false
+			}
+			snapshot, ok := rs.snapshot(ctx)
+			if !ok {
+				return "", false
+			}
+			for k := range known {
+				if _, ok := snapshot[k]; ok {
+					continue
+				}
+				if !send(kvEntry{key: k, op: kvs.KVDelete}) {
+					return "", false
+				}
+				delete(known, k)
+			}
+			for k, v := range snapshot {
+				if !send(kvEntry{key: k, value: []byte(v), op: kvs.KVPut}) {
+					return "", false
+				}
+				known[k] = struct{}{}
+			}
+			return lastID, true
+		}
+
+		lastID, ok := resync()
+		if !ok {
+			return
+		}
+		for {
+			select {
+			case <-ctx.Done():
+				rs.log.Infow("Stopping WatchAll", zap.String("watcher", rs.kvName))
+				return
+			case <-rs.doneCh:
+				rs.log.Infow("Stopping WatchAll", zap.String("watcher", rs.kvName))
+				return
+			default:
+			}
+			msgs, gap, err := rs.readUpdates(ctx, lastID)
+			if err != nil {
+				if ctx.Err() == nil {
+					rs.log.Errorw("Failed to read the updates", zap.String("watcher", rs.kvName), zap.Error(err))
+					time.Sleep(100 * time.Millisecond)
+				}
+				continue
+			}
+			if gap {
+				rs.log.Warnw("Some of the updates were trimmed before being read, resyncing the key-value pairs",
+					zap.String("watcher", rs.kvName), zap.String("lastID", lastID))
+				if lastID, ok = resync(); !ok {
+					return
+				}
+				continue
+			}
+			if len(msgs) == 0 {
+				// wait for the next update, it is read along with the gap check in the next iteration.
+				_, err := rs.client.Client.XRead(ctx, &redis.XReadArgs{
+					Streams: []string{rs.updatesKey, lastID},
+					Count:   1,
+					Block:   rs.opts.readBlock,
+				}).Result()
+				if err != nil && !errors.Is(err, redis.Nil) && ctx.Err() == nil {
+					rs.log.Errorw("Failed to wait for the updates", zap.String("watcher", rs.kvName), zap.Error(err))
+					time.Sleep(100 * time.Millisecond)
+				}
+				continue
+			}
+			for _, msg := range msgs {
+				lastID = msg.ID
+				entry, err := toKVEntry(msg)
+				if err != nil {
+					rs.log.Warnw("Invalid update", zap.String("watcher", rs.kvName), zap.String("id", msg.ID), zap.Error(err))
+					continue
+				}
+				rs.log.Debugw("Received an update", zap.String("watcher", rs.kvName), zap.String("key", entry.key), zap.String("op", entry.op.String()))
+				if !send(entry) {
+					return
+				}
+				if entry.op == kvs.KVDelete {
+					delete(known, entry.key)
+				} else {
+					known[entry.key] = struct{}{}
+				}
+			}
+		}
+	}()
+	return updates
+}
+
+// readUpdates returns the updates after the given ID, and whether any of the updates after it have been trimmed.
+// Both are read in a transaction, so an update can't be trimmed in between.
+func (rs *redisStore) readUpdates(ctx context.Context, lastID string) ([]redis.XMessage, bool, error) {
+	var (
+		infoCmd  *redis.XInfoStreamCmd
+		rangeCmd *redis.XMessageSliceCmd
+	)
+	_, err := rs.client.Client.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		infoCmd = pipe.XInfoStream(ctx, rs.updatesKey)
+		rangeCmd = pipe.XRangeN(ctx, rs.updatesKey, "("+lastID, "+", 100)
+		return nil
+	})
+	if err != nil {
+		// the updates stream is created by the first write
+		if isNoSuchKey(infoCmd.Err()) && rangeCmd.Err() == nil {
+			return nil, false, nil
+		}
+		return nil, false, err
+	}
+	trimmed, err := compareIDs(infoCmd.Val().MaxDeletedEntryID, lastID)
+	if err != nil {
+		return nil, false, err
+	}
+	return rangeCmd.Val(), trimmed > 0, nil
+}
+
+// lastUpdateID returns the ID of the latest update, it keeps retrying until the context is done.
+func (rs *redisStore) lastUpdateID(ctx context.Context) (string, bool) {
+	for {
+		msgs, err := rs.client.Client.XRevRangeN(ctx, rs.updatesKey, "+", "-", 1).Result()
+		if err == nil {
+			if len(msgs) == 0 {
+				return redisclient.ReadFromEarliest, true
+			}
+			return msgs[0].ID, true
+		}
+		rs.log.Errorw("Failed to get the latest update", zap.String("watcher", rs.kvName), zap.Error(err))
+		select {
+		case <-ctx.Done():
+			return "", false
+		case <-rs.doneCh:
+			return "", false
+		case <-time.After(100 * time.Millisecond):
+		}
+	}
+}
+
+// snapshot returns all the key-value pairs, it keeps retrying until the context is done.
+func (rs *redisStore) snapshot(ctx context.Context) (map[string]string, bool) {
+	for {
+		kvPairs, err := rs.client.Client.HGetAll(ctx, rs.hashKey).Result()
+		if err == nil {
+			return kvPairs, true
+		}
+		rs.log.Errorw("Failed to get the key-value pairs", zap.String("watcher", rs.kvName), zap.Error(err))
+		select {
+		case <-ctx.Done():
+			return nil, false
+		case <-rs.doneCh:
+			return nil, false
+		case <-time.After(100 * time.Millisecond):
+		}
+	}
+}
+
+// isNoSuchKey returns true if the error is returned for a stream command on a key which doesn't exist.
+func isNoSuchKey(err error) bool {
+	return err != nil && strings.Contains(err.Error(), "no such key")
+}
+
+// compareIDs compares two stream entry IDs, it returns -1, 0 or 1 if a is smaller than, equal to or greater than b.
+func compareIDs(a, b string) (int, error) {
+	aMillis, aSeq, err := parseID(a)
+	if err != nil {
+		return 0, err
+	}
+	bMillis, bSeq, err := parseID(b)
+	if err != nil {
+		return 0, err
+	}
+	if aMillis != bMillis {
+		return cmp.Compare(aMillis, bMillis), nil
+	}
+	return cmp.Compare(aSeq, bSeq), nil
+}
+
+// parseID parses a stream entry ID in the format of <millisecondsTime>-<sequenceNumber>.
+func parseID(id string) (uint64, uint64, error) {
+	millis, seq, _ := strings.Cut(id, "-")
+	m, err := strconv.ParseUint(millis, 10, 64)
+	if err != nil {
+		return 0, 0, fmt.Errorf("invalid stream entry id %q, %w", id, err)
+	}
+	if seq == "" {
+		return m, 0, nil
+	}
+	n, err := strconv.ParseUint(seq, 10, 64)
+	if err != nil {
+		return 0, 0, fmt.Errorf("invalid stream entry id %q, %w", id, err)
+	}
+	return m, n, nil
+}
+
+func toKVEntry(msg redis.XMessage) (kvEntry, error) {
+	key, ok := msg.Values[fieldKey].(string)
+	if !ok {
+		return kvEntry{}, fmt.Errorf("missing %q", fieldKey)
+	}
+	value, _ := msg.Values[fieldValue].(string)
+	switch msg.Values[fieldOp] {
+	case opPut:
+		return kvEntry{key: key, value: []byte(value), op: kvs.KVPut}, nil
+	case opDelete:
+		return kvEntry{key: key, value: []byte(value), op: kvs.KVDelete}, nil
+	default:
+		return kvEntry{}, fmt.Errorf("unknown operation %v", msg.Values[fieldOp])
+	}
+}
+
+// Close doesn't close the Redis client, it will be closed by the caller.
+// give the signal to watchers to stop watching
+func (rs *redisStore) Close() {
+	close(rs.doneCh)
+}
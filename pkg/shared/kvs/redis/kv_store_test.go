@@ -0,0 +1,178 @@
+//go:build isb_redis
+
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package redis
+
+import (
+	"context"
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	redisclient "github.com/numaproj/numaflow/pkg/shared/clients/redis"
+	"github.com/numaproj/numaflow/pkg/shared/kvs"
+)
+
+var redisOptions = &redis.UniversalOptions{
+	Addrs: []string{":6379"},
+}
+
+func newTestStore(t *testing.T, ctx context.Context, kvName string) (*redisclient.RedisClient, kvs.KVStorer) {
+	t.Helper()
+	client := redisclient.NewRedisClient(redisOptions)
+	_ = client.DeleteKeys(ctx, KVHashKey(kvName), KVUpdatesKey(kvName))
+	t.Cleanup(func() {
+		_ = client.DeleteKeys(context.Background(), KVHashKey(kvName), KVUpdatesKey(kvName))
+		client.Close()
+	})
+	store, err := NewKVRedisKVStore(ctx, kvName, client, WithReadBlock(100*time.Millisecond))
+	require.NoError(t, err)
+	return client, store
+}
+
+func TestRedisKVStoreOperations(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	_, store := newTestStore(t, ctx, "testRedisKVStore")
+	defer store.Close()
+
+	assert.Equal(t, "testRedisKVStore", store.GetStoreName())
+
+	assert.NoError(t, store.PutKV(ctx, "key1", []byte("value1")))
+	assert.NoError(t, store.PutKV(ctx, "key2", []byte("value2")))
+
+	value, err := store.GetValue(ctx, "key1")
+	assert.NoError(t, err)
+	assert.Equal(t, []byte("value1"), value)
+
+	keys, err := store.GetAllKeys(ctx)
+	assert.NoError(t, err)
+	sort.Strings(keys)
+	assert.Equal(t, []string{"key1", "key2"}, keys)
+
+	assert.NoError(t, store.DeleteKey(ctx, "key1"))
+	_, err = store.GetValue(ctx, "key1")
+	assert.ErrorIs(t, err, kvs.ErrKeyNotFound)
+
+	cas := store.(kvs.CompareAndPutter)
+	assert.ErrorIs(t, cas.CompareAndPut(ctx, "key2", []byte("stale"), []byte("value3")), kvs.ErrKeyConflict)
+	assert.ErrorIs(t, cas.CompareAndPut(ctx, "key2", nil, []byte("value3")), kvs.ErrKeyConflict)
+	assert.NoError(t, cas.CompareAndPut(ctx, "key2", []byte("value2"), []byte("value3")))
+	assert.NoError(t, cas.CompareAndPut(ctx, "key1", nil, []byte("value1")))
+	value, err = store.GetValue(ctx, "key2")
+	assert.NoError(t, err)
+	assert.Equal(t, []byte("value3"), value)
+}
+
+func TestRedisKVStoreWatch(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	_, store := newTestStore(t, ctx, "testRedisKVStoreWatch")
+
+	// the existing key-value pairs are delivered first
+	assert.NoError(t, store.PutKV(ctx, "key1", []byte("value1")))
+	watchCh := store.Watch(ctx)
+	entry := <-watchCh
+	assert.Equal(t, "key1", entry.Key())
+	assert.Equal(t, []byte("value1"), entry.Value())
+	assert.Equal(t, kvs.KVPut, entry.Operation())
+
+	// the updates after the snapshot
+	var entries []kvs.KVEntry
+	go func() {
+		_ = store.PutKV(ctx, "key2", []byte("value2"))
+		_ = store.DeleteKey(ctx, "key1")
+	}()
+	for entry := range watchCh {
+		// the put of key1 before the snapshot might be replayed
+		if entry.Key() == "key1" && entry.Operation() == kvs.KVPut {
+			continue
+		}
+		entries = append(entries, entry)
+		if len(entries) == 2 {
+			break
+		}
+	}
+	assert.Equal(t, "key2", entries[0].Key())
+	assert.Equal(t, []byte("value2"), entries[0].Value())
+	assert.Equal(t, kvs.KVPut, entries[0].Operation())
+	assert.Equal(t, "key1", entries[1].Key())
+	assert.Equal(t, kvs.KVDelete, entries[1].Operation())
+
+	// the channel is closed once the store is closed
+	store.Close()
+	for range watchCh {
+	}
+}
+
+func TestRedisKVStoreWatch_Gap(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	client, store := newTestStore(t, ctx, "testRedisKVStoreWatchGap")
+	defer store.Close()
+
+	assert.NoError(t, store.PutKV(ctx, "key1", []byte("value1")))
+	watchCh := store.Watch(ctx)
+	entry := <-watchCh
+	assert.Equal(t, "key1", entry.Key())
+
+	// the updates are trimmed before the watcher gets to read them
+	assert.NoError(t, store.PutKV(ctx, "key2", []byte("value2")))
+	assert.NoError(t, store.DeleteKey(ctx, "key1"))
+	assert.NoError(t, client.Client.XTrimMaxLen(ctx, KVUpdatesKey("testRedisKVStoreWatchGap"), 0).Err())
+
+	var key1Deleted, key2Put bool
+	for entry := range watchCh {
+		switch {
+		case entry.Key() == "key1" && entry.Operation() == kvs.KVDelete:
+			key1Deleted = true
+		case entry.Key() == "key2" && entry.Operation() == kvs.KVPut:
+			assert.Equal(t, []byte("value2"), entry.Value())
+			key2Put = true
+		}
+		if key1Deleted && key2Put {
+			break
+		}
+	}
+	assert.True(t, key1Deleted)
+	assert.True(t, key2Put)
+}
+
+func TestCompareIDs(t *testing.T) {
+	tests := []struct {
+		a, b string
+		want int
+	}{
+		{"0-0", "0-0", 0},
+		{"1-0", "0-0", 1},
+		{"1700000000000-1", "1700000000000-2", -1},
+		{"1700000000001-0", "1700000000000-5", 1},
+		{"1700000000000", "1700000000000-0", 0},
+	}
+	for _, tt := range tests {
+		got, err := compareIDs(tt.a, tt.b)
+		assert.NoError(t, err)
+		assert.Equal(t, tt.want, got, "%s vs %s", tt.a, tt.b)
+	}
+	_, err := compareIDs("abc", "0-0")
+	assert.Error(t, err)
+}
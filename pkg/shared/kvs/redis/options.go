@@ -0,0 +1,51 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package redis
+
+import "time"
+
+// options for the Redis KV store.
+type options struct {
+	// readBlock is the max duration a watcher blocks on reading the updates stream.
+	readBlock time.Duration
+	// maxUpdates is the approximate max length of the updates stream, the older updates are trimmed.
+	maxUpdates int64
+}
+
+func defaultOptions() *options {
+	return &options{
+		readBlock:  time.Second,
+		maxUpdates: 10000,
+	}
+}
+
+// Option is a function on the options of the Redis KV store
+type Option func(*options)
+
+// WithReadBlock sets the max duration a watcher blocks on reading the updates stream
+func WithReadBlock(d time.Duration) Option {
+	return func(o *options) {
+		o.readBlock = d
+	}
+}
+
+// WithMaxUpdates sets the approximate max length of the updates stream
+func WithMaxUpdates(n int64) Option {
+	return func(o *options) {
+		o.maxUpdates = n
+	}
+}
@@ -0,0 +1,27 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package tracing propagates W3C trace context across the inter-step buffers and records spans for the batches a
+// vertex processes.
+//
+// The trace context of a message is carried in its isb.Header.Headers. Each forwarder starts a span per read batch,
+// which is parented to the upstream context when the whole batch shares one, or linked to every upstream context
+// otherwise. The headers of the batch are then rewritten to carry the context of the new span, so that user-defined
+// functions, sinks and the downstream vertices continue the same trace.
+//
+// Spans are only exported when an OTLP endpoint is configured through the standard OTEL_EXPORTER_OTLP_* environment
+// variables, otherwise the no-op tracer provider is used and the headers are forwarded untouched.
+package tracing
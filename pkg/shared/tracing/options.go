@@ -0,0 +1,47 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tracing
+
+import (
+	"go.opentelemetry.io/otel/attribute"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+)
+
+type options struct {
+	// exporter overrides the OTLP exporter configured by the environment variables.
+	exporter sdktrace.SpanExporter
+	// attributes are the additional resource attributes.
+	attributes []attribute.KeyValue
+}
+
+// Option is a function that applies an option to the tracer provider.
+type Option func(*options)
+
+// WithSpanExporter exports the spans synchronously with the given exporter instead of the OTLP exporter, e.g. an
+// in-memory exporter in tests.
+func WithSpanExporter(exporter sdktrace.SpanExporter) Option {
+	return func(o *options) {
+		o.exporter = exporter
+	}
+}
+
+// WithResourceAttributes adds attributes to the resource describing the traced process.
+func WithResourceAttributes(attrs ...attribute.KeyValue) Option {
+	return func(o *options) {
+		o.attributes = append(o.attributes, attrs...)
+	}
+}
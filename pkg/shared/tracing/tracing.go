@@ -0,0 +1,270 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tracing
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracegrpc"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.26.0"
+	"go.opentelemetry.io/otel/trace"
+	"go.opentelemetry.io/otel/trace/noop"
+
+	"github.com/numaproj/numaflow/pkg/isb"
+)
+
+const (
+	instrumentationName = "github.com/numaproj/numaflow"
+
+	// EnvOTLPEndpoint is the standard environment variable of the OTLP endpoint for all signals.
+	EnvOTLPEndpoint = "OTEL_EXPORTER_OTLP_ENDPOINT"
+	// EnvOTLPTracesEndpoint is the standard environment variable of the OTLP endpoint for traces.
+	EnvOTLPTracesEndpoint = "OTEL_EXPORTER_OTLP_TRACES_ENDPOINT"
+	// EnvSDKDisabled is the standard environment variable to disable the OpenTelemetry SDK.
+	EnvSDKDisabled = "OTEL_SDK_DISABLED"
+)
+
+// Span attribute keys used by the forwarders.
+const (
+	AttributePipeline      = attribute.Key("numaflow.pipeline")
+	AttributeVertex        = attribute.Key("numaflow.vertex")
+	AttributeVertexReplica = attribute.Key("numaflow.vertex.replica")
+	AttributePartition     = attribute.Key("numaflow.partition")
+	AttributeBatchSize     = attribute.Key("messaging.batch.message_count")
+)
+
+var (
+	// propagator is the W3C trace context propagator used for the message headers.
+	propagator = propagation.TraceContext{}
+	// enabled is set when a tracer provider exporting spans is installed.
+	enabled atomic.Bool
+)
+
+// ShutdownFunc flushes the pending spans and shuts down the tracer provider.
+type ShutdownFunc func(context.Context) error
+
+// Init installs the global tracer provider and the W3C trace context propagator. Spans are exported to the OTLP
+// endpoint configured by the OTEL_EXPORTER_OTLP_* environment variables over gRPC. When no endpoint is configured,
+// and no exporter is given through the options, tracing stays disabled and the returned ShutdownFunc is a no-op.
+func Init(ctx context.Context, serviceName string, opts ...Option) (ShutdownFunc, error) {
+	otel.SetTextMapPropagator(propagator)
+	o := &options{}
+	for _, opt := range opts {
+		opt(o)
+	}
+	var processor sdktrace.SpanProcessor
+	if o.exporter != nil {
+		processor = sdktrace.NewSimpleSpanProcessor(o.exporter)
+	} else {
+		if !exporterConfigured() {
+			return func(context.Context) error { return nil }, nil
+		}
+		exporter, err := otlptracegrpc.New(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create the OTLP trace exporter, %w", err)
+		}
+		processor = sdktrace.NewBatchSpanProcessor(exporter)
+	}
+	// attributes from OTEL_RESOURCE_ATTRIBUTES and OTEL_SERVICE_NAME take precedence
+	res, err := resource.New(ctx,
+		resource.WithTelemetrySDK(),
+		resource.WithAttributes(append([]attribute.KeyValue{semconv.ServiceName(serviceName)}, o.attributes...)...),
+		resource.WithFromEnv(),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create the tracing resource, %w", err)
+	}
+	tp := sdktrace.NewTracerProvider(sdktrace.WithResource(res), sdktrace.WithSpanProcessor(processor))
+	otel.SetTracerProvider(tp)
+	enabled.Store(true)
+	return func(ctx context.Context) error {
+		enabled.Store(false)
+		otel.SetTracerProvider(noop.NewTracerProvider())
+		return tp.Shutdown(ctx)
+	}, nil
+}
+
+// exporterConfigured returns true if an OTLP endpoint is configured, and the SDK is not disabled.
+func exporterConfigured() bool {
+	if disabled, _ := strconv.ParseBool(os.Getenv(EnvSDKDisabled)); disabled {
+		return false
+	}
+	return os.Getenv(EnvOTLPEndpoint) != "" || os.Getenv(EnvOTLPTracesEndpoint) != ""
+}
+
+// Enabled returns true if spans are being recorded.
+func Enabled() bool {
+	return enabled.Load()
+}
+
+// Tracer returns the numaflow tracer of the global tracer provider.
+func Tracer() trace.Tracer {
+	return otel.Tracer(instrumentationName)
+}
+
+// VertexAttributes returns the span attributes identifying a vertex replica.
+func VertexAttributes(pipelineName, vertexName string, replica int32) []attribute.KeyValue {
+	return []attribute.KeyValue{
+		AttributePipeline.String(pipelineName),
+		AttributeVertex.String(vertexName),
+		AttributeVertexReplica.Int(int(replica)),
+	}
+}
+
+// StartBatchSpan starts a span, beginning at start, for a batch of messages read from a buffer or a source.
+// The span is a child of the upstream trace context when all the messages carry the same one, otherwise it links to
+// each of the upstream contexts. The headers of the messages are replaced by copies carrying the new span context.
+// It is a no-op when tracing is disabled, or the batch is empty.
+func StartBatchSpan(ctx context.Context, name string, start time.Time, messages []*isb.ReadMessage, attrs ...attribute.KeyValue) (context.Context, trace.Span) {
+	if !Enabled() || len(messages) == 0 {
+		return ctx, noop.Span{}
+	}
+	type spanKey struct {
+		traceID trace.TraceID
+		spanID  trace.SpanID
+	}
+	var (
+		seen  = make(map[spanKey]struct{})
+		links []trace.Link
+	)
+	for _, m := range messages {
+		sc := trace.SpanContextFromContext(Extract(context.Background(), m.Headers))
+		if !sc.IsValid() {
+			continue
+		}
+		key := spanKey{traceID: sc.TraceID(), spanID: sc.SpanID()}
+		if _, ok := seen[key]; ok {
+			continue
+		}
+		seen[key] = struct{}{}
+		links = append(links, trace.Link{SpanContext: sc})
+	}
+	spanOpts := []trace.SpanStartOption{
+		trace.WithTimestamp(start),
+		trace.WithSpanKind(trace.SpanKindConsumer),
+		trace.WithAttributes(attrs...),
+		trace.WithAttributes(AttributeBatchSize.Int(len(messages))),
+	}
+	if len(links) == 1 {
+		ctx = trace.ContextWithRemoteSpanContext(ctx, links[0].SpanContext)
+	} else if len(links) > 1 {
+		spanOpts = append(spanOpts, trace.WithLinks(links...))
+	}
+	ctx, span := Tracer().Start(ctx, name, spanOpts...)
+	for _, m := range messages {
+		m.Headers = Inject(ctx, m.Headers)
+	}
+	return ctx, span
+}
+
+// StartSpan starts a child span of the span in the context.
+func StartSpan(ctx context.Context, name string, attrs ...attribute.KeyValue) (context.Context, trace.Span) {
+	if !Enabled() {
+		return ctx, noop.Span{}
+	}
+	return Tracer().Start(ctx, name, trace.WithAttributes(attrs...))
+}
+
+// RecordSpan records a child span of the span in the context for an operation which has already completed, e.g. the
+// read of a batch, which is only known to be worth tracing after it returns messages.
+func RecordSpan(ctx context.Context, name string, start, end time.Time, attrs ...attribute.KeyValue) {
+	if !Enabled() {
+		return
+	}
+	_, span := Tracer().Start(ctx, name, trace.WithTimestamp(start), trace.WithAttributes(attrs...))
+	span.End(trace.WithTimestamp(end))
+}
+
+// EndSpan marks the span as failed if err is not nil, and ends it.
+func EndSpan(span trace.Span, err error) {
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+	span.End()
+}
+
+// Inject returns a copy of the headers carrying the trace context of the span in the context. The headers are
+// returned untouched if the context has no valid span, the given map is never modified since it is usually shared
+// with the message it was derived from.
+func Inject(ctx context.Context, headers map[string]string) map[string]string {
+	if !trace.SpanContextFromContext(ctx).IsValid() {
+		return headers
+	}
+	injected := make(headersCarrier, len(headers)+2)
+	for k, v := range headers {
+		injected[k] = v
+	}
+	propagator.Inject(ctx, injected)
+	return injected
+}
+
+// Extract returns a copy of the context carrying the remote trace context found in the headers.
+func Extract(ctx context.Context, headers map[string]string) context.Context {
+	if len(headers) == 0 {
+		return ctx
+	}
+	return propagator.Extract(ctx, headersCarrier(headers))
+}
+
+// headersCarrier adapts the message headers to a propagation.TextMapCarrier. The lookups are case-insensitive since
+// the HTTP source keeps the canonical form of the request header keys, e.g. "Traceparent".
+type headersCarrier map[string]string
+
+// Get returns the value of the key.
+func (c headersCarrier) Get(key string) string {
+	if v, ok := c[key]; ok {
+		return v
+	}
+	for k, v := range c {
+		if strings.EqualFold(k, key) {
+			return v
+		}
+	}
+	return ""
+}
+
+// Set sets the value of the key, replacing the value stored under any other case of the key.
+func (c headersCarrier) Set(key, value string) {
+	for k := range c {
+		if k != key && strings.EqualFold(k, key) {
+			delete(c, k)
+		}
+	}
+	c[key] = value
+}
+
+// Keys returns the keys of the carrier.
+func (c headersCarrier) Keys() []string {
+	keys := make([]string, 0, len(c))
+	for k := range c {
+		keys = append(keys, k)
+	}
+	return keys
+}
@@ -0,0 +1,144 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tracing
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/numaproj/numaflow/pkg/isb"
+)
+
+const (
+	upstreamTraceparent      = "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01"
+	otherUpstreamTraceparent = "00-0af7651916cd43dd8448eb211c80319c-b7ad6b7169203331-01"
+)
+
+func initTesting(t *testing.T) *tracetest.InMemoryExporter {
+	t.Helper()
+	exporter := tracetest.NewInMemoryExporter()
+	shutdown, err := Init(context.Background(), "test", WithSpanExporter(exporter))
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		_ = shutdown(context.Background())
+	})
+	return exporter
+}
+
+func testMessages(headers ...map[string]string) []*isb.ReadMessage {
+	messages := make([]*isb.ReadMessage, len(headers))
+	for i, h := range headers {
+		messages[i] = &isb.ReadMessage{Message: isb.Message{Header: isb.Header{Headers: h}}}
+	}
+	return messages
+}
+
+func TestInit_Disabled(t *testing.T) {
+	t.Setenv(EnvOTLPEndpoint, "")
+	t.Setenv(EnvOTLPTracesEndpoint, "")
+	shutdown, err := Init(context.Background(), "test")
+	require.NoError(t, err)
+	assert.False(t, Enabled())
+	assert.NoError(t, shutdown(context.Background()))
+
+	messages := testMessages(map[string]string{"a": "b"})
+	ctx, span := StartBatchSpan(context.Background(), "batch", time.Now(), messages)
+	EndSpan(span, nil)
+	assert.False(t, span.IsRecording())
+	assert.False(t, trace.SpanContextFromContext(ctx).IsValid())
+	assert.Equal(t, map[string]string{"a": "b"}, messages[0].Headers)
+}
+
+func TestExporterConfigured(t *testing.T) {
+	t.Setenv(EnvOTLPEndpoint, "")
+	t.Setenv(EnvOTLPTracesEndpoint, "http://collector:4317")
+	assert.True(t, exporterConfigured())
+	t.Setenv(EnvSDKDisabled, "true")
+	assert.False(t, exporterConfigured())
+}
+
+func TestStartBatchSpan_SingleUpstream(t *testing.T) {
+	exporter := initTesting(t)
+	// the HTTP source keeps the canonical form of the header keys
+	shared := map[string]string{"Traceparent": upstreamTraceparent, "x": "y"}
+	messages := testMessages(shared, shared)
+	start := time.Now().Add(-time.Second)
+	ctx, span := StartBatchSpan(context.Background(), "batch", start, messages, VertexAttributes("p", "v", 1)...)
+	RecordSpan(ctx, "read", start, time.Now())
+	EndSpan(span, nil)
+
+	spans := exporter.GetSpans()
+	require.Len(t, spans, 2)
+	assert.Equal(t, "read", spans[0].Name)
+	batch := spans[1]
+	assert.Equal(t, "batch", batch.Name)
+	assert.Equal(t, start.UnixNano(), batch.StartTime.UnixNano())
+	assert.Equal(t, "4bf92f3577b34da6a3ce929d0e0e4736", batch.SpanContext.TraceID().String())
+	assert.Equal(t, "00f067aa0ba902b7", batch.Parent.SpanID().String())
+	assert.Empty(t, batch.Links)
+	assert.Equal(t, batch.SpanContext.SpanID(), spans[0].Parent.SpanID())
+	assert.Contains(t, batch.Attributes, AttributeVertex.String("v"))
+	assert.Contains(t, batch.Attributes, AttributeBatchSize.Int(2))
+
+	// the headers are copied with the context of the batch span, and the shared map is left untouched
+	for _, m := range messages {
+		assert.Equal(t, "y", m.Headers["x"])
+		assert.NotContains(t, m.Headers, "Traceparent")
+		sc := trace.SpanContextFromContext(Extract(context.Background(), m.Headers))
+		assert.Equal(t, batch.SpanContext.SpanID(), sc.SpanID())
+	}
+	assert.Equal(t, upstreamTraceparent, shared["Traceparent"])
+}
+
+func TestStartBatchSpan_MultipleUpstreams(t *testing.T) {
+	exporter := initTesting(t)
+	messages := testMessages(
+		map[string]string{"traceparent": upstreamTraceparent},
+		map[string]string{"traceparent": otherUpstreamTraceparent},
+		map[string]string{"traceparent": upstreamTraceparent},
+		nil,
+	)
+	_, span := StartBatchSpan(context.Background(), "batch", time.Now(), messages)
+	EndSpan(span, assert.AnError)
+
+	spans := exporter.GetSpans()
+	require.Len(t, spans, 1)
+	assert.False(t, spans[0].Parent.IsValid())
+	require.Len(t, spans[0].Links, 2)
+	assert.Equal(t, "4bf92f3577b34da6a3ce929d0e0e4736", spans[0].Links[0].SpanContext.TraceID().String())
+	assert.Equal(t, "0af7651916cd43dd8448eb211c80319c", spans[0].Links[1].SpanContext.TraceID().String())
+	assert.Equal(t, "Error", spans[0].Status.Code.String())
+	// the messages without a trace context start carrying the one of the batch
+	sc := trace.SpanContextFromContext(Extract(context.Background(), messages[3].Headers))
+	assert.Equal(t, spans[0].SpanContext.SpanID(), sc.SpanID())
+}
+
+func TestInject(t *testing.T) {
+	headers := map[string]string{"a": "b"}
+	assert.Equal(t, headers, Inject(context.Background(), headers))
+
+	ctx := Extract(context.Background(), map[string]string{"TRACEPARENT": upstreamTraceparent})
+	injected := Inject(ctx, headers)
+	assert.Equal(t, map[string]string{"a": "b", "traceparent": upstreamTraceparent}, injected)
+	assert.Equal(t, map[string]string{"a": "b"}, headers)
+}
@@ -0,0 +1,208 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package shuffle
+
+import (
+	"cmp"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+
+	dfv1 "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1"
+)
+
+const (
+	// sketchWidth and sketchDepth are the dimensions of the count-min sketch, the estimated ratio of a key
+	// is higher than the actual ratio by at most 0.1% with a probability of 93.75%.
+	sketchWidth = 2048
+	sketchDepth = 4
+	// topN is the number of hot keys tracked and exposed as metrics.
+	topN = 10
+	// decayThreshold is the number of messages after which all the counts are halved, so that the
+	// frequencies reflect the recent messages.
+	decayThreshold = 100_000
+	// reportInterval is the number of messages after which the hot key metrics are updated.
+	reportInterval = 1000
+	// minSamples is the minimum number of messages before a key can be considered hot.
+	minSamples = 1000
+)
+
+// hotKeyTracker tracks the frequency of the keys using a count-min sketch, and keeps the top N hot keys.
+// The sketch and the counters are updated atomically, the top N keys, the metrics and the decay are only updated
+// when the lock is free, so that the concurrent writers are never blocked by each other.
+type hotKeyTracker struct {
+	sync.Mutex
+	// labels are the label values of the edge in the hot key metrics
+	labels []string
+	sketch *countMinSketch
+	// total is the number of messages since the last decay, halved on decay as well
+	total atomic.Uint64
+	// sinceReport is the number of messages since the metrics were last updated
+	sinceReport atomic.Uint64
+	// topMin is the lowest count of the top N keys, or 0 if there are less than N keys, a key with a lower
+	// estimate can't be a top key, so the lock is not needed for it
+	topMin atomic.Uint64
+	// top is the top N keys with their estimated counts, guarded by the lock
+	top map[string]*topKey
+	// reported is the key hash label of each rank which has the hot key metric, guarded by the lock
+	reported []string
+}
+
+// topKey is a top N key, the count is the estimate when it was last updated, the hash value is kept to get
+// the current estimate from the sketch.
+type topKey struct {
+	hashValue uint64
+	count     uint64
+}
+
+func newHotKeyTracker(labels ...string) *hotKeyTracker {
+	return &hotKeyTracker{
+		labels: labels,
+		sketch: newCountMinSketch(sketchWidth, sketchDepth),
+		top:    make(map[string]*topKey, topN),
+	}
+}
+
+// observe records a message with the given keys and hash value, and returns the estimated ratio of the recent
+// messages with the same keys. It returns 0 until there are enough messages to estimate the ratio.
+func (t *hotKeyTracker) observe(keys []string, hashValue uint64) float64 {
+	estimate := t.sketch.add(hashValue)
+	total := t.total.Add(1)
+	sinceReport := t.sinceReport.Add(1)
+	if estimate > t.topMin.Load() && t.TryLock() {
+		t.updateTop(strings.Join(keys, dfv1.KeysDelimitter), hashValue, estimate)
+		t.Unlock()
+	}
+	// if the lock is busy, the decay and the report are left to one of the next writers
+	if (total >= decayThreshold || sinceReport >= reportInterval) && t.TryLock() {
+		if t.total.Load() >= decayThreshold {
+			t.decay()
+		}
+		if t.sinceReport.Load() >= reportInterval {
+			t.sinceReport.Store(0)
+			t.report()
+		}
+		t.Unlock()
+	}
+	if total < minSamples {
+		return 0
+	}
+	return float64(estimate) / float64(total)
+}
+
+// decay halves all the counts, the lock must be held.
+func (t *hotKeyTracker) decay() {
+	t.sketch.decay()
+	t.total.Store(t.total.Load() >> 1)
+	for _, k := range t.top {
+		k.count >>= 1
+	}
+	t.updateTopMin()
+}
+
+// updateTop updates the top N keys with the estimated count of the key, the lock must be held.
+func (t *hotKeyTracker) updateTop(key string, hashValue uint64, estimate uint64) {
+	if _, ok := t.top[key]; ok || len(t.top) < topN {
+		t.top[key] = &topKey{hashValue: hashValue, count: estimate}
+		t.updateTopMin()
+		return
+	}
+	minKey, minCount := "", ^uint64(0)
+	for k, c := range t.top {
+		if c.count < minCount {
+			minKey, minCount = k, c.count
+		}
+	}
+	if estimate > minCount {
+		delete(t.top, minKey)
+		t.top[key] = &topKey{hashValue: hashValue, count: estimate}
+		t.updateTopMin()
+	}
+}
+
+// updateTopMin updates the lowest count of the top N keys, the lock must be held.
+func (t *hotKeyTracker) updateTopMin() {
+	if len(t.top) < topN {
+		t.topMin.Store(0)
+		return
+	}
+	minCount := ^uint64(0)
+	for _, c := range t.top {
+		minCount = min(minCount, c.count)
+	}
+	t.topMin.Store(minCount)
+}
+
+// hotKeys returns the top N keys with their estimated ratio of the recent messages.
+func (t *hotKeyTracker) hotKeys() map[string]float64 {
+	t.Lock()
+	defer t.Unlock()
+	total := t.total.Load()
+	result := make(map[string]float64, len(t.top))
+	for k, c := range t.top {
+		result[k] = float64(t.sketch.estimate(c.hashValue)) / float64(total)
+	}
+	return result
+}
+
+// report updates the hot key metrics, the lock must be held. The keys are user data with an unbounded cardinality,
+// so only the top N keys are reported, labeled with their rank and the hash of the keys instead of the keys
+// themselves, and the series of a rank is deleted once its key changes, which keeps at most N series per edge.
+func (t *hotKeyTracker) report() {
+	type rankedKey struct {
+		keyHash string
+		count   uint64
+	}
+	ranked := make([]rankedKey, 0, len(t.top))
+	for _, c := range t.top {
+		ranked = append(ranked, rankedKey{keyHash: formatKeyHash(c.hashValue), count: t.sketch.estimate(c.hashValue)})
+	}
+	slices.SortFunc(ranked, func(a, b rankedKey) int {
+		if a.count != b.count {
+			return cmp.Compare(b.count, a.count)
+		}
+		return strings.Compare(a.keyHash, b.keyHash)
+	})
+	total := float64(t.total.Load())
+	for i, r := range ranked {
+		rank := strconv.Itoa(i + 1)
+		if i < len(t.reported) && t.reported[i] != r.keyHash {
+			hotKeyRatio.DeleteLabelValues(t.labelValues(rank, t.reported[i])...)
+		}
+		hotKeyRatio.WithLabelValues(t.labelValues(rank, r.keyHash)...).Set(float64(r.count) / total)
+	}
+	for i := len(ranked); i < len(t.reported); i++ {
+		hotKeyRatio.DeleteLabelValues(t.labelValues(strconv.Itoa(i+1), t.reported[i])...)
+	}
+	t.reported = t.reported[:0]
+	for _, r := range ranked {
+		t.reported = append(t.reported, r.keyHash)
+	}
+}
+
+// labelValues returns the label values of the hot key metric of the given rank and key hash.
+func (t *hotKeyTracker) labelValues(rank, keyHash string) []string {
+	return append(slices.Clip(t.labels), rank, keyHash)
+}
+
+// formatKeyHash formats the hash value of the keys as the key hash label of the hot key metric.
+func formatKeyHash(hashValue uint64) string {
+	return fmt.Sprintf("%016x", hashValue)
+}
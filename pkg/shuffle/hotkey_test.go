@@ -0,0 +1,171 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package shuffle
+
+import (
+	"fmt"
+	"sync"
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCountMinSketch(t *testing.T) {
+	cms := newCountMinSketch(sketchWidth, sketchDepth)
+	s := NewShuffle("v1", 1)
+	counts := map[string]uint64{}
+	for i := 0; i < 10000; i++ {
+		key := fmt.Sprintf("key_%d", i%500)
+		if i%2 == 0 {
+			key = "hot"
+		}
+		counts[key]++
+		estimate := cms.add(s.generateHash([]string{key}))
+		// the estimate is never lower than the actual count
+		assert.GreaterOrEqual(t, estimate, counts[key])
+	}
+	assert.InDelta(t, 5000, cms.add(s.generateHash([]string{"hot"})), 50)
+
+	cms.decay()
+	assert.InDelta(t, 2500, cms.add(s.generateHash([]string{"hot"})), 50)
+}
+
+func TestShuffle_HotKeys(t *testing.T) {
+	// the keys are tracked with and without salting
+	for _, opts := range [][]Option{nil, {WithHotKeySalting(2, 90)}} {
+		s := NewShuffle("v1", 4, opts...)
+		assert.Empty(t, s.HotKeys())
+		for i := 0; i < 5000; i++ {
+			if i%5 < 3 {
+				s.ShuffleOnKeys([]string{"hot", "key"})
+			} else {
+				s.ShuffleOnKeys([]string{fmt.Sprintf("key_%d", i)})
+			}
+		}
+		hotKeys := s.HotKeys()
+		assert.LessOrEqual(t, len(hotKeys), topN)
+		require.Contains(t, hotKeys, "hot:key")
+		assert.InDelta(t, 0.6, hotKeys["hot:key"], 0.05)
+	}
+}
+
+func TestShuffle_HotKeySalting(t *testing.T) {
+	tests := []struct {
+		name               string
+		opts               []Option
+		expectedPartitions int
+	}{
+		{name: "no salting", expectedPartitions: 1},
+		{name: "salting", opts: []Option{WithHotKeySalting(3, 20)}, expectedPartitions: 3},
+		{name: "sub-partitions capped by partition count", opts: []Option{WithHotKeySalting(20, 20)}, expectedPartitions: 10},
+		{name: "below threshold", opts: []Option{WithHotKeySalting(3, 60)}, expectedPartitions: 1},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			s := NewShuffle("v1", 10, test.opts...)
+			hotPartitions := map[int32]bool{}
+			coldPartitions := map[string]map[int32]bool{}
+			for i := 0; i < 5000; i++ {
+				if i%2 == 0 {
+					hotPartitions[s.ShuffleOnKeys([]string{"hot"})] = true
+				} else {
+					key := fmt.Sprintf("key_%d", i%100)
+					if coldPartitions[key] == nil {
+						coldPartitions[key] = map[int32]bool{}
+					}
+					coldPartitions[key][s.ShuffleOnKeys([]string{key})] = true
+				}
+			}
+			assert.Len(t, hotPartitions, test.expectedPartitions)
+			// the cold keys always go to the same partition.
+			for _, partitions := range coldPartitions {
+				assert.Len(t, partitions, 1)
+			}
+		})
+	}
+}
+
+func TestShuffle_HotKeysConcurrent(t *testing.T) {
+	s := NewShuffle("v1", 4, WithHotKeySalting(2, 90))
+	var wg sync.WaitGroup
+	for w := 0; w < 8; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := 0; i < 2000; i++ {
+				if i%2 == 0 {
+					s.ShuffleOnKeys([]string{"hot"})
+				} else {
+					s.ShuffleOnKeys([]string{fmt.Sprintf("key_%d", i)})
+				}
+			}
+		}()
+	}
+	wg.Wait()
+	assert.Equal(t, uint64(16000), s.hotKeys.total.Load())
+	hotKeys := s.HotKeys()
+	require.Contains(t, hotKeys, "hot")
+	assert.InDelta(t, 0.5, hotKeys["hot"], 0.05)
+}
+
+func TestHotKeyTracker_Report(t *testing.T) {
+	tracker := newHotKeyTracker("v0", "report-test", "v1")
+	s := NewShuffle("v1", 1)
+	hot, hotter := formatKeyHash(s.generateHash([]string{"hot"})), formatKeyHash(s.generateHash([]string{"hotter"}))
+	for i := 0; i < reportInterval; i++ {
+		key := fmt.Sprintf("key_%d", i%3)
+		if i%2 == 0 {
+			key = "hot"
+		}
+		tracker.observe([]string{key}, s.generateHash([]string{key}))
+	}
+	assert.Len(t, tracker.reported, 4)
+	assert.Equal(t, hot, tracker.reported[0])
+	assert.InDelta(t, 0.5, testutil.ToFloat64(hotKeyRatio.WithLabelValues("v0", "report-test", "v1", "1", hot)), 0.01)
+	// only the ranks of the tracked keys are reported
+	assert.False(t, hotKeyRatio.DeleteLabelValues("v0", "report-test", "v1", "5", hot))
+
+	// the series of a rank is replaced once its key changes
+	for i := 0; i < 2*reportInterval; i++ {
+		tracker.observe([]string{"hotter"}, s.generateHash([]string{"hotter"}))
+	}
+	assert.Equal(t, hotter, tracker.reported[0])
+	assert.Equal(t, hot, tracker.reported[1])
+	assert.False(t, hotKeyRatio.DeleteLabelValues("v0", "report-test", "v1", "1", hot))
+	assert.True(t, hotKeyRatio.DeleteLabelValues("v0", "report-test", "v1", "2", hot))
+}
+
+func TestHotKeyTracker_LockBusy(t *testing.T) {
+	tracker := newHotKeyTracker("v0", "lock-test", "v1")
+	hashValue := NewShuffle("v1", 1).generateHash([]string{"hot"})
+	// the writers are not blocked while the lock is held, the report and the decay are deferred
+	tracker.Lock()
+	for i := 0; i < decayThreshold; i++ {
+		tracker.observe([]string{"hot"}, hashValue)
+	}
+	assert.Empty(t, tracker.reported)
+	assert.Equal(t, uint64(decayThreshold), tracker.total.Load())
+	tracker.Unlock()
+
+	// the next writer catches up once the lock is free
+	tracker.observe([]string{"hot"}, hashValue)
+	assert.Equal(t, []string{formatKeyHash(hashValue)}, tracker.reported)
+	assert.Equal(t, uint64(decayThreshold+1)>>1, tracker.total.Load())
+	assert.Zero(t, tracker.sinceReport.Load())
+}
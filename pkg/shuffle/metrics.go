@@ -0,0 +1,45 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package shuffle
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+
+	"github.com/numaproj/numaflow/pkg/metrics"
+)
+
+const (
+	labelToVertex = "to_vertex"
+	labelRank     = "rank"
+	labelKeyHash  = "key_hash"
+)
+
+// hotKeyRatio is used to indicate the estimated ratio of the recent messages of the top hot keys, with their rank
+// and the hash of the keys
+var hotKeyRatio = promauto.NewGaugeVec(prometheus.GaugeOpts{
+	Subsystem: "shuffle",
+	Name:      "hot_key_ratio",
+	Help:      "Estimated ratio of the recent messages shuffled with the hot key, for the top hot keys",
+}, []string{metrics.LabelVertex, metrics.LabelPipeline, labelToVertex, labelRank, labelKeyHash})
+
+// hotKeySaltedCount is used to indicate the number of messages of hot keys which are split across partitions
+var hotKeySaltedCount = promauto.NewCounterVec(prometheus.CounterOpts{
+	Subsystem: "shuffle",
+	Name:      "hot_key_salted_total",
+	Help:      "Total number of messages of hot keys which are split across partitions",
+}, []string{metrics.LabelVertex, metrics.LabelPipeline, labelToVertex})
@@ -19,7 +19,9 @@ package shuffle
 import (
 	"hash"
 	"sync"
+	"sync/atomic"
 
+	dfv1 "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1"
 	"github.com/numaproj/numaflow/pkg/isb"
 
 	"github.com/spaolacci/murmur3"
@@ -28,9 +30,22 @@ import (
 // Shuffle shuffles messages among ISB
 type Shuffle struct {
 	vertexName string
+	// pipelineName and fromVertexName identify the vertex writing to the edge in the metrics
+	pipelineName   string
+	fromVertexName string
 	// partitionCount is the number of partitions of the buffer owned by the vertex
 	partitionCount int
-	hash           hash.Hash64
+	// partitioning is the strategy to map the hash value to a partition
+	partitioning dfv1.PartitioningStrategy
+	hash         hash.Hash64
+	// hotKeys tracks the frequency of the keys shuffled with ShuffleOnKeys
+	hotKeys *hotKeyTracker
+	// saltSubPartitions is the number of partitions a hot key is split across, salting is disabled if it's less than 2
+	saltSubPartitions int
+	// saltThreshold is the minimum ratio of the recent messages for a key to be split
+	saltThreshold float64
+	// saltCounter is used to split the messages of hot keys across the sub-partitions in a round-robin manner
+	saltCounter atomic.Uint64
 	// we need to hold a lock because concurrent PnFs writes to buffer which internally invokes shuffle.
 	// we need the lock to protect the hash.
 	mu sync.Mutex
@@ -41,37 +56,98 @@ type Shuffle struct {
 // Shuffling before the Vnth vertex creates a key to edge-buffer-index affinity,
 // which will not change from Vn to Vn+1 vertices if there is no re-keying between these vertices causing
 // idle partitions.
-func NewShuffle(vertexName string, partitionCount int) *Shuffle {
+func NewShuffle(vertexName string, partitionCount int, opts ...Option) *Shuffle {
 	// We use vertex name as seed.
 	vertexHash := murmur3.New64()
 	_, _ = vertexHash.Write([]byte(vertexName))
 
-	return &Shuffle{
+	s := &Shuffle{
 		vertexName:     vertexName,
 		partitionCount: partitionCount,
+		partitioning:   dfv1.PartitioningStrategyModulo,
 		// we use murmur3, we are open for suggestions. fnv did not work for us because of lack of re-keying in
 		// some cases causing idle partitions in edges. We need to revisit the below link
 		// https://softwareengineering.stackexchange.com/questions/49550/which-hashing-algorithm-is-best-for-uniqueness-and-speed
 		hash: murmur3.New64WithSeed(uint32(vertexHash.Sum64())),
 	}
+	for _, opt := range opts {
+		opt(s)
+	}
+	// the keys are always tracked, so that the hot keys of a keyed vertex are visible before salting is enabled
+	s.hotKeys = newHotKeyTracker(s.fromVertexName, s.pipelineName, vertexName)
+	return s
+}
+
+type Option func(*Shuffle)
+
+// WithPartitioning sets the strategy to map the hash value of the keys to a partition.
+func WithPartitioning(p dfv1.PartitioningStrategy) Option {
+	return func(s *Shuffle) {
+		s.partitioning = p
+	}
+}
+
+// WithMetricsLabels sets the pipeline and the vertex writing to the edge, which label the metrics of the shuffle.
+func WithMetricsLabels(pipelineName, vertexName string) Option {
+	return func(s *Shuffle) {
+		s.pipelineName = pipelineName
+		s.fromVertexName = vertexName
+	}
+}
+
+// WithHotKeySalting splits the messages of a hot key, which accounts for at least thresholdPercentage of
+// the recent messages, across subPartitions partitions in a round-robin manner.
+func WithHotKeySalting(subPartitions int, thresholdPercentage int) Option {
+	return func(s *Shuffle) {
+		s.saltSubPartitions = subPartitions
+		s.saltThreshold = float64(thresholdPercentage) / 100
+	}
+}
+
+// EdgeOptions returns the shuffle options configured on the edge.
+func EdgeOptions(edge dfv1.Edge) []Option {
+	opts := []Option{WithPartitioning(edge.GetPartitioningStrategy())}
+	if x := edge.HotKeySalting; x != nil {
+		opts = append(opts, WithHotKeySalting(x.GetSubPartitions(), x.GetThresholdPercentage()))
+	}
+	return opts
 }
 
 // ShuffleOnKeys accepts array of keys and returns a shuffled identifier.
 // We do not need message-id here because there is no multi-partitioning in Reduce streams.
+// If hot key salting is enabled, the messages of a hot key are split across the partitions next to
+// the partition the key is assigned to, and the partial results need to be combined downstream.
 func (s *Shuffle) ShuffleOnKeys(keys []string) int32 {
 	// hash of the message keys returns a unique hashValue
-	// mod of hashValue will decide which isb it will belong
+	// the partitioning strategy maps the hashValue to the isb it will belong
 	hashValue := s.generateHash(keys)
-	hashValue = hashValue % uint64(s.partitionCount)
-	return int32(hashValue)
+	partitionIdx := s.partition(hashValue)
+	ratio := s.hotKeys.observe(keys, hashValue)
+	if !s.saltingEnabled() {
+		return partitionIdx
+	}
+	if ratio >= s.saltThreshold {
+		salt := s.saltCounter.Add(1) % uint64(min(s.saltSubPartitions, s.partitionCount))
+		partitionIdx = int32((uint64(partitionIdx) + salt) % uint64(s.partitionCount))
+		hotKeySaltedCount.WithLabelValues(s.fromVertexName, s.pipelineName, s.vertexName).Inc()
+	}
+	return partitionIdx
+}
+
+// saltingEnabled returns true if the messages of hot keys can be split across more than one partition.
+func (s *Shuffle) saltingEnabled() bool {
+	return min(s.saltSubPartitions, s.partitionCount) > 1
+}
+
+// HotKeys returns the tracked hot keys, joined by the keys delimiter, with their estimated ratio of the recent messages.
+func (s *Shuffle) HotKeys() map[string]float64 {
+	return s.hotKeys.hotKeys()
 }
 
 // ShuffleOnId shuffle based on the message-id. This is used to make sure we
 // always have consistent hashing for a given message-id. This is used for non-reduce vertex.
 func (s *Shuffle) ShuffleOnId(msgId string) int32 {
-	hashValue := s.generateHash([]string{msgId})
-	hashValue = hashValue % uint64(s.partitionCount)
-	return int32(hashValue)
+	return s.partition(s.generateHash([]string{msgId}))
 }
 
 // ShuffleMessages accepts list of isb messages and returns the mapping of isb to messages
@@ -84,6 +160,26 @@ func (s *Shuffle) ShuffleMessages(messages []*isb.Message) map[int32][]*isb.Mess
 	return hashMap
 }
 
+// partition maps the hash value to a partition based on the partitioning strategy.
+func (s *Shuffle) partition(hashValue uint64) int32 {
+	if s.partitioning == dfv1.PartitioningStrategyJumpHash {
+		return jumpHash(hashValue, s.partitionCount)
+	}
+	return int32(hashValue % uint64(s.partitionCount))
+}
+
+// jumpHash is the jump consistent hash (https://arxiv.org/abs/1406.2294). When the number of buckets changes
+// from n to m, only |m-n|/max(m,n) of the keys move to a different bucket.
+func jumpHash(key uint64, numBuckets int) int32 {
+	var b, j int64 = -1, 0
+	for j < int64(numBuckets) {
+		b = j
+		key = key*2862933555777941757 + 1
+		j = int64(float64(b+1) * (float64(int64(1)<<31) / float64((key>>33)+1)))
+	}
+	return int32(b)
+}
+
 func (s *Shuffle) generateHash(keys []string) uint64 {
 	s.mu.Lock()
 	defer s.mu.Unlock()
@@ -93,3 +189,26 @@ func (s *Shuffle) generateHash(keys []string) uint64 {
 	}
 	return s.hash.Sum64()
 }
+
+// KeyMove describes a key which is assigned to a different partition after the partition count changes.
+type KeyMove struct {
+	Keys []string
+	From int32
+	To   int32
+}
+
+// MovedKeys reports which of the given keys are assigned to a different partition when the partition count of
+// the vertex changes from oldPartitionCount to newPartitionCount. It can be used to plan the resizing of a keyed vertex.
+// Hot key salting is not taken into account.
+func MovedKeys(vertexName string, keys [][]string, oldPartitionCount, newPartitionCount int, opts ...Option) []KeyMove {
+	oldShuffle := NewShuffle(vertexName, oldPartitionCount, opts...)
+	newShuffle := NewShuffle(vertexName, newPartitionCount, opts...)
+	var moves []KeyMove
+	for _, k := range keys {
+		from, to := oldShuffle.partition(oldShuffle.generateHash(k)), newShuffle.partition(newShuffle.generateHash(k))
+		if from != to {
+			moves = append(moves, KeyMove{Keys: k, From: from, To: to})
+		}
+	}
+	return moves
+}
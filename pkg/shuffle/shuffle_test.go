@@ -23,6 +23,7 @@ import (
 
 	"github.com/stretchr/testify/assert"
 
+	dfv1 "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1"
 	"github.com/numaproj/numaflow/pkg/isb"
 	"github.com/numaproj/numaflow/pkg/isb/testutils"
 )
@@ -104,6 +105,74 @@ func TestShuffler_UseVertexNameAsSeed(t *testing.T) {
 	}
 }
 
+func TestShuffle_JumpHash(t *testing.T) {
+	messages := buildTestMessagesWithDistinctKeys(1000)
+	shuffler := NewShuffle("v1", 10, WithPartitioning(dfv1.PartitioningStrategyJumpHash))
+	bufferIdMessageMap := shuffler.ShuffleMessages(messages)
+	assert.Len(t, bufferIdMessageMap, 10)
+	for partition, msgs := range bufferIdMessageMap {
+		assert.GreaterOrEqual(t, partition, int32(0))
+		assert.Less(t, partition, int32(10))
+		assert.NotEmpty(t, msgs)
+	}
+	// the same keys always go to the same partition.
+	for _, m := range messages {
+		assert.Equal(t, shuffler.ShuffleOnKeys(m.Keys), shuffler.ShuffleOnKeys(m.Keys))
+	}
+}
+
+// TestShuffle_PartitionStability pins the partitions of a few keys, the messages already buffered in the partitions of a
+// keyed vertex would be processed with the wrong state if an upgrade of the hash library assigned the keys differently.
+func TestShuffle_PartitionStability(t *testing.T) {
+	keys := [][]string{{"key-1"}, {"key-2"}, {"key-3"}, {"user", "42"}, {"日本"}, {""}}
+	tests := []struct {
+		partitioning dfv1.PartitioningStrategy
+		expected     []int32
+		expectedId   int32
+	}{
+		{partitioning: dfv1.PartitioningStrategyModulo, expected: []int32{4, 4, 1, 4, 3, 0}, expectedId: 3},
+		{partitioning: dfv1.PartitioningStrategyJumpHash, expected: []int32{2, 2, 0, 4, 0, 2}, expectedId: 2},
+	}
+	for _, test := range tests {
+		t.Run(string(test.partitioning), func(t *testing.T) {
+			shuffler := NewShuffle("keyed-reduce", 5, WithPartitioning(test.partitioning))
+			assert.Equal(t, uint64(0x3d4eca8ac2d970b1), shuffler.generateHash([]string{"key-1"}))
+			partitions := make([]int32, 0, len(keys))
+			for _, k := range keys {
+				partitions = append(partitions, shuffler.ShuffleOnKeys(k))
+			}
+			assert.Equal(t, test.expected, partitions)
+			assert.Equal(t, test.expectedId, shuffler.ShuffleOnId("msg-id-1"))
+		})
+	}
+}
+
+func TestMovedKeys(t *testing.T) {
+	keys := make([][]string, 0, 1000)
+	for i := 0; i < 1000; i++ {
+		keys = append(keys, []string{fmt.Sprintf("key_%d", i)})
+	}
+
+	// with jump hash, only ~1/N of the keys move, and they all move to the new partition.
+	moves := MovedKeys("v1", keys, 4, 5, WithPartitioning(dfv1.PartitioningStrategyJumpHash))
+	assert.InDelta(t, 200, len(moves), 60)
+	for _, m := range moves {
+		assert.Equal(t, int32(4), m.To)
+	}
+	// when scaling down, only the keys of the removed partition move.
+	moves = MovedKeys("v1", keys, 5, 4, WithPartitioning(dfv1.PartitioningStrategyJumpHash))
+	assert.InDelta(t, 200, len(moves), 60)
+	for _, m := range moves {
+		assert.Equal(t, int32(4), m.From)
+	}
+
+	// with modulo, most of the keys move.
+	moves = MovedKeys("v1", keys, 4, 5)
+	assert.Greater(t, len(moves), 700)
+
+	assert.Empty(t, MovedKeys("v1", keys, 4, 4, WithPartitioning(dfv1.PartitioningStrategyJumpHash)))
+}
+
 // isSameShuffleDistribution performs a simple count check to ensure that the two input maps have the same distribution of elements.
 // For a more strict verification, one could compare the contents of the two distributions, which would require sorting the elements.
 func isSameShuffleDistribution(a, b map[int32][]*isb.Message) bool {
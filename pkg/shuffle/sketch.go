@@ -0,0 +1,71 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package shuffle
+
+import "sync/atomic"
+
+// countMinSketch is a probabilistic data structure to estimate the frequency of the keys with a fixed memory.
+// The estimate is never lower than the actual count, and it is higher by at most 2/width of the total count
+// with a probability of 1 - (1/2)^depth. The counts are updated atomically, so that it can be shared by the
+// concurrent writers without a lock.
+type countMinSketch struct {
+	width  uint64
+	depth  int
+	counts [][]atomic.Uint64
+}
+
+func newCountMinSketch(width uint64, depth int) *countMinSketch {
+	counts := make([][]atomic.Uint64, depth)
+	for i := range counts {
+		counts[i] = make([]atomic.Uint64, width)
+	}
+	return &countMinSketch{width: width, depth: depth, counts: counts}
+}
+
+// add increments the count of the key with the given hash value, and returns the estimated count of the key.
+// The row indexes are derived from the hash value using double hashing, so that the key doesn't need to be hashed
+// again for each row.
+func (cms *countMinSketch) add(hashValue uint64) uint64 {
+	h1, h2 := hashValue, (hashValue>>32)|(hashValue<<32)
+	estimate := ^uint64(0)
+	for i := 0; i < cms.depth; i++ {
+		idx := (h1 + uint64(i)*h2) % cms.width
+		estimate = min(estimate, cms.counts[i][idx].Add(1))
+	}
+	return estimate
+}
+
+// estimate returns the estimated count of the key with the given hash value.
+func (cms *countMinSketch) estimate(hashValue uint64) uint64 {
+	h1, h2 := hashValue, (hashValue>>32)|(hashValue<<32)
+	estimate := ^uint64(0)
+	for i := 0; i < cms.depth; i++ {
+		estimate = min(estimate, cms.counts[i][(h1+uint64(i)*h2)%cms.width].Load())
+	}
+	return estimate
+}
+
+// decay halves all the counts, so that the sketch reflects the recent frequencies. The increments which happen
+// concurrently with the decay may be lost, which is fine for an estimate.
+func (cms *countMinSketch) decay() {
+	for i := range cms.counts {
+		for j := range cms.counts[i] {
+			c := &cms.counts[i][j]
+			c.Store(c.Load() >> 1)
+		}
+	}
+}
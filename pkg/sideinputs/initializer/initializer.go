@@ -26,10 +26,13 @@ import (
 	dfv1 "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1"
 	"github.com/numaproj/numaflow/pkg/isbsvc"
 	jsclient "github.com/numaproj/numaflow/pkg/shared/clients/nats"
+	redisclient "github.com/numaproj/numaflow/pkg/shared/clients/redis"
 	"github.com/numaproj/numaflow/pkg/shared/kvs"
 	"github.com/numaproj/numaflow/pkg/shared/kvs/jetstream"
+	rediskv "github.com/numaproj/numaflow/pkg/shared/kvs/redis"
 	"github.com/numaproj/numaflow/pkg/shared/logging"
 	"github.com/numaproj/numaflow/pkg/sideinputs/utils"
+	"github.com/numaproj/numaflow/pkg/sideinputs/versions"
 )
 
 // sideInputsInitializer contains the pipeline
@@ -40,15 +43,18 @@ type sideInputsInitializer struct {
 	pipelineName    string
 	sideInputsStore string
 	sideInputs      []string
+	// pinnedVersions are the versions the side inputs are pinned to
+	pinnedVersions map[string]int64
 }
 
 // NewSideInputsInitializer creates a new initializer with given values
-func NewSideInputsInitializer(isbSvcType dfv1.ISBSvcType, pipelineName, sideInputsStore string, sideInputs []string) *sideInputsInitializer {
+func NewSideInputsInitializer(isbSvcType dfv1.ISBSvcType, pipelineName, sideInputsStore string, sideInputs []string, pinnedVersions map[string]int64) *sideInputsInitializer {
 	return &sideInputsInitializer{
 		isbSvcType:      isbSvcType,
 		sideInputsStore: sideInputsStore,
 		pipelineName:    pipelineName,
 		sideInputs:      sideInputs,
+		pinnedVersions:  pinnedVersions,
 	}
 }
 
@@ -68,7 +74,13 @@ func (sii *sideInputsInitializer) Run(ctx context.Context) error {
 	defer cancel()
 	switch sii.isbSvcType {
 	case dfv1.ISBSvcTypeRedis:
-		return fmt.Errorf("unsupported isbsvc type %q", sii.isbSvcType)
+		redisClient := redisclient.NewInClusterRedisClient()
+		defer redisClient.Close()
+		kvName := isbsvc.RedisSideInputsStoreKVName(sii.sideInputsStore)
+		sideInputStore, err = rediskv.NewKVRedisKVStore(ctx, kvName, redisClient)
+		if err != nil {
+			return fmt.Errorf("failed to create a new KVStore: %w", err)
+		}
 	case dfv1.ISBSvcTypeJetStream:
 		natsClient, err = jsclient.NewNATSClient(ctx)
 		if err != nil {
@@ -85,14 +97,16 @@ func (sii *sideInputsInitializer) Run(ctx context.Context) error {
 	default:
 		return fmt.Errorf("unrecognized isbsvc type %q", sii.isbSvcType)
 	}
-	return startSideInputInitializer(ctx, sideInputStore, dfv1.PathSideInputsMount, sii.sideInputs)
+	return startSideInputInitializer(ctx, sideInputStore, dfv1.PathSideInputsMount, sii.sideInputs, sii.pinnedVersions)
 }
 
 // startSideInputInitializer watches the side inputs KV store to get side inputs
 // and writes to disk once the initial value of all the side-inputs is ready.
+// The pinned side inputs are read from the keys of the pinned versions.
 // This is a blocking call.
-func startSideInputInitializer(ctx context.Context, store kvs.KVStorer, mountPath string, sideInputs []string) error {
+func startSideInputInitializer(ctx context.Context, store kvs.KVStorer, mountPath string, sideInputs []string, pinnedVersions map[string]int64) error {
 	log := logging.FromContext(ctx)
+	keys := versions.WatchKeys(sideInputs, pinnedVersions)
 	m := make(map[string][]byte)
 	watchCh := store.Watch(ctx)
 	for {
@@ -108,7 +122,9 @@ func startSideInputInitializer(ctx context.Context, store kvs.KVStorer, mountPat
 			}
 			log.Debug("Side Input value received ",
 				zap.String("key", value.Key()), zap.String("value", string(value.Value())))
-			m[value.Key()] = value.Value()
+			if sideInput, ok := keys[value.Key()]; ok {
+				m[sideInput] = value.Value()
+			}
 			// Wait for the data to be ready in the side input store, and then copy it to the disk
 			if gotAllSideInputVals(sideInputs, m) {
 				for _, sideInput := range sideInputs {
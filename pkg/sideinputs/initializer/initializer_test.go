@@ -89,7 +89,7 @@ func TestSideInputsInitializer_Success(t *testing.T) {
 			fmt.Println("Error in writing to bucket ", err)
 		}
 	}
-	err = startSideInputInitializer(ctx, sideInputStore, mountPath, sideInputs)
+	err = startSideInputInitializer(ctx, sideInputStore, mountPath, sideInputs, nil)
 	assert.NoError(t, err)
 
 	for x, sideInput := range sideInputs {
@@ -153,6 +153,6 @@ func TestSideInputsTimeout(t *testing.T) {
 	bucketName := keyspace
 	sideInputStore, _ := jetstream.NewKVJetStreamKVStore(ctx, bucketName, nc)
 
-	_ = startSideInputInitializer(ctx, sideInputStore, mountPath, sideInputs)
+	_ = startSideInputInitializer(ctx, sideInputStore, mountPath, sideInputs, nil)
 	assert.Equal(t, context.DeadlineExceeded, ctx.Err())
 }
@@ -19,11 +19,14 @@ package manager
 import (
 	"context"
 	"fmt"
+	"os"
+	"sync"
 	"time"
 
 	cronlib "github.com/robfig/cron/v3"
 	"go.uber.org/zap"
 	"google.golang.org/protobuf/types/known/emptypb"
+	"k8s.io/client-go/kubernetes"
 
 	dfv1 "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1"
 	"github.com/numaproj/numaflow/pkg/isbsvc"
@@ -32,9 +35,13 @@ import (
 	"github.com/numaproj/numaflow/pkg/sdkclient/serverinfo"
 	"github.com/numaproj/numaflow/pkg/sdkclient/sideinput"
 	jsclient "github.com/numaproj/numaflow/pkg/shared/clients/nats"
+	redisclient "github.com/numaproj/numaflow/pkg/shared/clients/redis"
 	"github.com/numaproj/numaflow/pkg/shared/kvs"
 	"github.com/numaproj/numaflow/pkg/shared/kvs/jetstream"
+	rediskv "github.com/numaproj/numaflow/pkg/shared/kvs/redis"
 	"github.com/numaproj/numaflow/pkg/shared/logging"
+	sharedutil "github.com/numaproj/numaflow/pkg/shared/util"
+	"github.com/numaproj/numaflow/pkg/sideinputs/versions"
 )
 
 type sideInputsManager struct {
@@ -42,6 +49,7 @@ type sideInputsManager struct {
 	pipelineName    string
 	sideInputsStore string
 	sideInput       *dfv1.SideInput
+	lock            sync.Mutex
 }
 
 func NewSideInputsManager(isbSvcType dfv1.ISBSvcType, pipelineName, sideInputsStore string, sideInput *dfv1.SideInput) *sideInputsManager {
@@ -64,7 +72,13 @@ func (sim *sideInputsManager) Start(ctx context.Context) error {
 	var siStore kvs.KVStorer
 	switch sim.isbSvcType {
 	case dfv1.ISBSvcTypeRedis:
-		return fmt.Errorf("unsupported isbsvc type %q", sim.isbSvcType)
+		redisClient := redisclient.NewInClusterRedisClient()
+		defer redisClient.Close()
+		kvName := isbsvc.RedisSideInputsStoreKVName(sim.sideInputsStore)
+		siStore, err = rediskv.NewKVRedisKVStore(ctx, kvName, redisClient)
+		if err != nil {
+			return fmt.Errorf("failed to create a new KVStore: %w", err)
+		}
 	case dfv1.ISBSvcTypeJetStream:
 		natsClient, err = jsclient.NewNATSClient(ctx)
 		if err != nil {
@@ -109,22 +123,64 @@ func (sim *sideInputsManager) Start(ctx context.Context) error {
 		return fmt.Errorf("failed on SideInput readiness check, %w", err)
 	}
 
+	// Refreshing could be triggered by the cron, the watched objects or the HTTPS endpoint,
+	// make sure there's only one call to fetch the side input at a time.
+	refresh := func(ctx context.Context) error {
+		sim.lock.Lock()
+		defer sim.lock.Unlock()
+		return sim.execute(ctx, sideInputClient, siStore)
+	}
 	f := func() {
-		if err := sim.execute(ctx, sideInputClient, siStore); err != nil {
+		if err := refresh(ctx); err != nil {
 			log.Errorw("Failed to execute the call to fetch Side Inputs.", zap.Error(err))
 		}
 	}
 
-	cron, err := resolveCron(sim.sideInput.Trigger, f)
-	if err != nil {
-		return fmt.Errorf("failed to resolve cron: %w", err)
+	trigger := sim.sideInput.Trigger
+	var cron *cronlib.Cron
+	if trigger.Schedule != "" {
+		if cron, err = resolveCron(trigger, f); err != nil {
+			return fmt.Errorf("failed to resolve cron: %w", err)
+		}
 	}
 
 	// Run the first time immediately.
 	f()
 
-	cron.Start()
-	defer func() { _ = cron.Stop() }()
+	if cron != nil {
+		cron.Start()
+		defer func() { _ = cron.Stop() }()
+	}
+
+	if trigger.HasWatch() {
+		restConfig, err := sharedutil.K8sRestConfig()
+		if err != nil {
+			return fmt.Errorf("failed to get kubeRestConfig, %w", err)
+		}
+		kubeClient, err := kubernetes.NewForConfig(restConfig)
+		if err != nil {
+			return fmt.Errorf("failed to get kubeclient, %w", err)
+		}
+		if err := watchObjects(ctx, kubeClient, os.Getenv(dfv1.EnvNamespace), trigger.Watch, f); err != nil {
+			return fmt.Errorf("failed to watch ConfigMaps and Secrets: %w", err)
+		}
+	}
+
+	var token string
+	if x := trigger.Auth; x != nil && x.Token != nil {
+		if token, err = sharedutil.GetSecretFromVolume(x.Token); err != nil {
+			return fmt.Errorf("failed to get the auth token of the trigger, %w", err)
+		}
+	}
+	shutdown, err := startTriggerServer(ctx, token, refresh)
+	if err != nil {
+		return fmt.Errorf("failed to start the side inputs manager server: %w", err)
+	}
+	defer func() {
+		if err := shutdown(context.Background()); err != nil {
+			log.Warnw("Failed to shutdown the side inputs manager server", zap.Error(err))
+		}
+	}()
 
 	<-ctx.Done()
 	return nil
@@ -132,7 +188,7 @@ func (sim *sideInputsManager) Start(ctx context.Context) error {
 
 func (sim *sideInputsManager) execute(ctx context.Context, sideInputClient sideinput.Client, siStore kvs.KVStorer) error {
 	log := logging.FromContext(ctx)
-	log.Info("Executing Side Inputs manager cron ...")
+	log.Info("Executing Side Inputs manager refresh ...")
 	resp, err := sideInputClient.RetrieveSideInput(ctx, &emptypb.Empty{})
 	if err != nil {
 		return fmt.Errorf("failed to retrieve side input: %w", err)
@@ -142,11 +198,16 @@ func (sim *sideInputsManager) execute(ctx context.Context, sideInputClient sidei
 		log.Info("Side input is not broadcasted, skipping ...")
 		return nil
 	}
-	// Write the side input value to the store.
-	err = siStore.PutKV(ctx, sim.sideInput.Name, resp.Value)
+	// Write the side input value to the store as a new version.
+	v, err := versions.Broadcast(ctx, siStore, sim.sideInput.Name, resp.Value, sim.sideInput.GetRetainedVersions(), sim.sideInput.PinnedVersion)
 	if err != nil {
-		return fmt.Errorf("failed to write side input %q to store: %w", sim.sideInput.Name, err)
+		return err
+	}
+	if v == nil {
+		log.Info("Side input value is not changed since rolled back, skipping ...")
+		return nil
 	}
+	log.Infow("Side input broadcasted", zap.Int64("version", v.Version), zap.String("checksum", v.Checksum))
 	return nil
 }
 
@@ -17,10 +17,18 @@ limitations under the License.
 package manager
 
 import (
+	"context"
 	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
 
 	dfv1 "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1"
 )
@@ -57,3 +65,89 @@ func Test_resolveCron(t *testing.T) {
 		assert.Len(t, c.Entries(), 1)
 	})
 }
+
+func Test_newTriggerHandler(t *testing.T) {
+	var count atomic.Int32
+	var failure error
+	refresh := func(ctx context.Context) error {
+		count.Add(1)
+		return failure
+	}
+	newRequest := func(token string) *http.Request {
+		r := httptest.NewRequest(http.MethodPost, "/trigger", nil)
+		if token != "" {
+			r.Header.Set("Authorization", "Bearer "+token)
+		}
+		return r
+	}
+
+	// the endpoint is disabled without a token
+	w := httptest.NewRecorder()
+	newTriggerHandler("", refresh).ServeHTTP(w, newRequest(""))
+	assert.Equal(t, http.StatusForbidden, w.Code)
+	assert.Equal(t, int32(0), count.Load())
+
+	h := newTriggerHandler("test-token", refresh)
+	for _, token := range []string{"", "wrong-token"} {
+		w = httptest.NewRecorder()
+		h.ServeHTTP(w, newRequest(token))
+		assert.Equal(t, http.StatusForbidden, w.Code)
+		assert.Equal(t, int32(0), count.Load())
+	}
+
+	w = httptest.NewRecorder()
+	h.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/trigger", nil))
+	assert.Equal(t, http.StatusMethodNotAllowed, w.Code)
+	assert.Equal(t, int32(0), count.Load())
+
+	w = httptest.NewRecorder()
+	h.ServeHTTP(w, newRequest("test-token"))
+	assert.Equal(t, http.StatusNoContent, w.Code)
+	assert.Equal(t, int32(1), count.Load())
+
+	failure = fmt.Errorf("udf unavailable")
+	w = httptest.NewRecorder()
+	h.ServeHTTP(w, newRequest("test-token"))
+	assert.Equal(t, http.StatusInternalServerError, w.Code)
+	assert.Contains(t, w.Body.String(), "udf unavailable")
+}
+
+func Test_watchObjects(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	ns := "test-ns"
+	cm := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{Namespace: ns, Name: "my-config"},
+		Data:       map[string]string{"a": "1"},
+	}
+	kubeClient := fake.NewSimpleClientset(cm)
+	var count atomic.Int32
+	err := watchObjects(ctx, kubeClient, ns, &dfv1.SideInputWatch{ConfigMaps: []string{"my-config"}, Secrets: []string{"my-secret"}}, func() { count.Add(1) })
+	assert.NoError(t, err)
+	// Objects in the initial list do not trigger a refresh.
+	assert.Never(t, func() bool { return count.Load() > 0 }, 200*time.Millisecond, 20*time.Millisecond)
+
+	cm.Data["a"] = "2"
+	_, err = kubeClient.CoreV1().ConfigMaps(ns).Update(ctx, cm, metav1.UpdateOptions{})
+	assert.NoError(t, err)
+	assert.Eventually(t, func() bool { return count.Load() == 1 }, 3*time.Second, 20*time.Millisecond)
+
+	cm.Labels = map[string]string{"x": "y"}
+	_, err = kubeClient.CoreV1().ConfigMaps(ns).Update(ctx, cm, metav1.UpdateOptions{})
+	assert.NoError(t, err)
+	assert.Never(t, func() bool { return count.Load() > 1 }, 200*time.Millisecond, 20*time.Millisecond)
+
+	_, err = kubeClient.CoreV1().Secrets(ns).Create(ctx, &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Namespace: ns, Name: "my-secret"},
+		Data:       map[string][]byte{"b": []byte("1")},
+	}, metav1.CreateOptions{})
+	assert.NoError(t, err)
+	assert.Eventually(t, func() bool { return count.Load() == 2 }, 3*time.Second, 20*time.Millisecond)
+}
+
+func Test_dataChanged(t *testing.T) {
+	assert.False(t, dataChanged(&corev1.ConfigMap{Data: map[string]string{"a": "1"}}, &corev1.ConfigMap{Data: map[string]string{"a": "1"}}))
+	assert.True(t, dataChanged(&corev1.ConfigMap{Data: map[string]string{"a": "1"}}, &corev1.ConfigMap{Data: map[string]string{"a": "2"}}))
+	assert.False(t, dataChanged(&corev1.Secret{}, &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Labels: map[string]string{"a": "b"}}}))
+	assert.True(t, dataChanged(&corev1.Secret{}, &corev1.Secret{Data: map[string][]byte{"a": []byte("b")}}))
+}
@@ -0,0 +1,85 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package manager
+
+import (
+	"context"
+	"crypto/subtle"
+	"crypto/tls"
+	"fmt"
+	"net/http"
+
+	"go.uber.org/zap"
+
+	dfv1 "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1"
+	"github.com/numaproj/numaflow/pkg/shared/logging"
+	sharedtls "github.com/numaproj/numaflow/pkg/shared/tls"
+)
+
+// newTriggerHandler returns the HTTP handler of the side inputs manager, which
+// exposes an endpoint "/trigger" to refresh the side input on demand. The requests need to
+// have the bearer token, the endpoint is disabled if the token is empty.
+func newTriggerHandler(token string, refresh func(ctx context.Context) error) http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/trigger", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			w.WriteHeader(http.StatusMethodNotAllowed)
+			return
+		}
+		if token == "" {
+			http.Error(w, "on-demand trigger is disabled, trigger.auth of the side input is not configured", http.StatusForbidden)
+			return
+		}
+		if subtle.ConstantTimeCompare([]byte(r.Header.Get("Authorization")), []byte("Bearer "+token)) != 1 {
+			http.Error(w, "request not authorized", http.StatusForbidden)
+			return
+		}
+		if err := refresh(r.Context()); err != nil {
+			w.WriteHeader(http.StatusInternalServerError)
+			_, _ = w.Write([]byte(err.Error()))
+			return
+		}
+		w.WriteHeader(http.StatusNoContent)
+	})
+	mux.HandleFunc("/livez", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNoContent)
+	})
+	return mux
+}
+
+// startTriggerServer starts the HTTPS server for on-demand side input refreshing,
+// it returns a shutdown function and an error if any.
+func startTriggerServer(ctx context.Context, token string, refresh func(ctx context.Context) error) (func(ctx context.Context) error, error) {
+	log := logging.FromContext(ctx)
+	cer, err := sharedtls.GenerateX509KeyPair()
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate cert: %w", err)
+	}
+	httpServer := &http.Server{
+		Addr:      fmt.Sprintf(":%d", dfv1.SideInputsManagerPort),
+		Handler:   newTriggerHandler(token, refresh),
+		TLSConfig: &tls.Config{Certificates: []tls.Certificate{*cer}, MinVersion: tls.VersionTLS12},
+	}
+	go func() {
+		log.Info("Starting side inputs manager HTTPS server")
+		if err := httpServer.ListenAndServeTLS("", ""); err != nil && err != http.ErrServerClosed {
+			log.Fatalw("Failed to listen-and-server on HTTPS", zap.Error(err))
+		}
+		log.Info("Side inputs manager HTTPS server shutdown")
+	}()
+	return httpServer.Shutdown, nil
+}
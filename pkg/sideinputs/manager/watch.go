@@ -0,0 +1,137 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package manager
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/watch"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/tools/cache"
+
+	dfv1 "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1"
+	"github.com/numaproj/numaflow/pkg/shared/logging"
+)
+
+// watchObjects watches the ConfigMaps and Secrets defined in the side input trigger,
+// and calls onChange whenever the data of any of them is changed. Changes happening
+// while onChange is running are coalesced into one more call.
+func watchObjects(ctx context.Context, kubeClient kubernetes.Interface, namespace string, w *dfv1.SideInputWatch, onChange func()) error {
+	log := logging.FromContext(ctx)
+	changes := make(chan struct{}, 1)
+	notify := func() {
+		select {
+		case changes <- struct{}{}:
+		default:
+		}
+	}
+	handler := cache.ResourceEventHandlerDetailedFuncs{
+		AddFunc: func(obj interface{}, isInInitialList bool) {
+			if !isInInitialList {
+				notify()
+			}
+		},
+		UpdateFunc: func(oldObj, newObj interface{}) {
+			if dataChanged(oldObj, newObj) {
+				notify()
+			}
+		},
+		DeleteFunc: func(obj interface{}) {
+			notify()
+		},
+	}
+
+	var informers []cache.SharedIndexInformer
+	for _, name := range w.ConfigMaps {
+		selector := fields.OneTermEqualSelector("metadata.name", name).String()
+		lw := &cache.ListWatch{
+			ListFunc: func(options metav1.ListOptions) (runtime.Object, error) {
+				options.FieldSelector = selector
+				return kubeClient.CoreV1().ConfigMaps(namespace).List(ctx, options)
+			},
+			WatchFunc: func(options metav1.ListOptions) (watch.Interface, error) {
+				options.FieldSelector = selector
+				return kubeClient.CoreV1().ConfigMaps(namespace).Watch(ctx, options)
+			},
+		}
+		informers = append(informers, cache.NewSharedIndexInformer(lw, &corev1.ConfigMap{}, 0, cache.Indexers{}))
+	}
+	for _, name := range w.Secrets {
+		selector := fields.OneTermEqualSelector("metadata.name", name).String()
+		lw := &cache.ListWatch{
+			ListFunc: func(options metav1.ListOptions) (runtime.Object, error) {
+				options.FieldSelector = selector
+				return kubeClient.CoreV1().Secrets(namespace).List(ctx, options)
+			},
+			WatchFunc: func(options metav1.ListOptions) (watch.Interface, error) {
+				options.FieldSelector = selector
+				return kubeClient.CoreV1().Secrets(namespace).Watch(ctx, options)
+			},
+		}
+		informers = append(informers, cache.NewSharedIndexInformer(lw, &corev1.Secret{}, 0, cache.Indexers{}))
+	}
+
+	var synced []cache.InformerSynced
+	for _, informer := range informers {
+		if _, err := informer.AddEventHandler(handler); err != nil {
+			return fmt.Errorf("failed to add event handler, %w", err)
+		}
+		go informer.Run(ctx.Done())
+		synced = append(synced, informer.HasSynced)
+	}
+	syncCtx, cancel := context.WithTimeout(ctx, time.Minute)
+	defer cancel()
+	if !cache.WaitForCacheSync(syncCtx.Done(), synced...) {
+		return fmt.Errorf("timed out waiting for the watched ConfigMaps and Secrets to be synced")
+	}
+	log.Infow("Watching ConfigMaps and Secrets for side input changes", "configMaps", w.ConfigMaps, "secrets", w.Secrets)
+
+	go func() {
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-changes:
+				log.Info("Watched ConfigMap or Secret changed, refreshing side input ...")
+				onChange()
+			}
+		}
+	}()
+	return nil
+}
+
+// dataChanged returns true if the data of a ConfigMap or a Secret is changed,
+// updates on the metadata only are ignored.
+func dataChanged(oldObj, newObj interface{}) bool {
+	switch o := oldObj.(type) {
+	case *corev1.ConfigMap:
+		n, ok := newObj.(*corev1.ConfigMap)
+		return !ok || !equality.Semantic.DeepEqual(o.Data, n.Data) || !equality.Semantic.DeepEqual(o.BinaryData, n.BinaryData)
+	case *corev1.Secret:
+		n, ok := newObj.(*corev1.Secret)
+		return !ok || !equality.Semantic.DeepEqual(o.Data, n.Data)
+	default:
+		return true
+	}
+}
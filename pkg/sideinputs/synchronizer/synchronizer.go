@@ -20,17 +20,19 @@ import (
 	"context"
 	"fmt"
 	"path"
-	"slices"
 
 	"go.uber.org/zap"
 
 	dfv1 "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1"
 	"github.com/numaproj/numaflow/pkg/isbsvc"
 	jsclient "github.com/numaproj/numaflow/pkg/shared/clients/nats"
+	redisclient "github.com/numaproj/numaflow/pkg/shared/clients/redis"
 	"github.com/numaproj/numaflow/pkg/shared/kvs"
 	"github.com/numaproj/numaflow/pkg/shared/kvs/jetstream"
+	rediskv "github.com/numaproj/numaflow/pkg/shared/kvs/redis"
 	"github.com/numaproj/numaflow/pkg/shared/logging"
 	"github.com/numaproj/numaflow/pkg/sideinputs/utils"
+	"github.com/numaproj/numaflow/pkg/sideinputs/versions"
 )
 
 // sideInputsSynchronizer contains the pipeline and
@@ -41,15 +43,18 @@ type sideInputsSynchronizer struct {
 	pipelineName    string
 	sideInputsStore string
 	sideInputs      []string
+	// pinnedVersions are the versions the side inputs are pinned to
+	pinnedVersions map[string]int64
 }
 
 // NewSideInputsSynchronizer creates a new synchronizer with given values
-func NewSideInputsSynchronizer(isbSvcType dfv1.ISBSvcType, pipelineName, sideInputsStore string, sideInputs []string) *sideInputsSynchronizer {
+func NewSideInputsSynchronizer(isbSvcType dfv1.ISBSvcType, pipelineName, sideInputsStore string, sideInputs []string, pinnedVersions map[string]int64) *sideInputsSynchronizer {
 	return &sideInputsSynchronizer{
 		isbSvcType:      isbSvcType,
 		sideInputsStore: sideInputsStore,
 		pipelineName:    pipelineName,
 		sideInputs:      sideInputs,
+		pinnedVersions:  pinnedVersions,
 	}
 }
 
@@ -63,13 +68,19 @@ func (sis *sideInputsSynchronizer) Start(ctx context.Context) error {
 	)
 
 	log := logging.FromContext(ctx)
-	log.Infow("Starting Side Inputs Synchronizer", zap.Strings("sideInputs", sis.sideInputs))
+	log.Infow("Starting Side Inputs Synchronizer", zap.Strings("sideInputs", sis.sideInputs), zap.Any("pinnedVersions", sis.pinnedVersions))
 	ctx, cancel := context.WithCancel(ctx)
 	defer cancel()
 
 	switch sis.isbSvcType {
 	case dfv1.ISBSvcTypeRedis:
-		return fmt.Errorf("unsupported isbsvc type %q", sis.isbSvcType)
+		redisClient := redisclient.NewInClusterRedisClient()
+		defer redisClient.Close()
+		kvName := isbsvc.RedisSideInputsStoreKVName(sis.sideInputsStore)
+		sideInputStore, err = rediskv.NewKVRedisKVStore(ctx, kvName, redisClient)
+		if err != nil {
+			return fmt.Errorf("failed to create a new KVStore: %w", err)
+		}
 	case dfv1.ISBSvcTypeJetStream:
 		natsClient, err = jsclient.NewNATSClient(ctx)
 		defer natsClient.Close()
@@ -86,15 +97,17 @@ func (sis *sideInputsSynchronizer) Start(ctx context.Context) error {
 	default:
 		return fmt.Errorf("unrecognized isbsvc type %q", sis.isbSvcType)
 	}
-	go startSideInputSynchronizer(ctx, sideInputStore, sis.sideInputs, dfv1.PathSideInputsMount)
+	go startSideInputSynchronizer(ctx, sideInputStore, sis.sideInputs, dfv1.PathSideInputsMount, sis.pinnedVersions)
 	<-ctx.Done()
 	return nil
 }
 
 // startSideInputSynchronizer watches the Side Input KV store for any changes
-// and writes the updated value to the mount volume.
-func startSideInputSynchronizer(ctx context.Context, watch kvs.KVStorer, sideInputs []string, mountPath string) {
+// and writes the updated value to the mount volume. The pinned side inputs
+// are read from the keys of the pinned versions.
+func startSideInputSynchronizer(ctx context.Context, watch kvs.KVStorer, sideInputs []string, mountPath string, pinnedVersions map[string]int64) {
 	log := logging.FromContext(ctx)
+	keys := versions.WatchKeys(sideInputs, pinnedVersions)
 	watchCh := watch.Watch(ctx)
 	for {
 		select {
@@ -107,12 +120,13 @@ func startSideInputSynchronizer(ctx context.Context, watch kvs.KVStorer, sideInp
 				log.Warnw("nil value received from Side Input watcher")
 				continue
 			}
-			if !slices.Contains(sideInputs, value.Key()) {
+			sideInput, ok := keys[value.Key()]
+			if !ok {
 				continue
 			}
 			log.Infow("Side Input value received ",
 				zap.String("key", value.Key()), zap.String("value", string(value.Value())))
-			p := path.Join(mountPath, value.Key())
+			p := path.Join(mountPath, sideInput)
 			// Write changes to disk
 			err := utils.UpdateSideInputFile(ctx, p, value.Value())
 			if err != nil {
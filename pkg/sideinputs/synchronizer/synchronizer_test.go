@@ -29,8 +29,10 @@ import (
 
 	natsclient "github.com/numaproj/numaflow/pkg/shared/clients/nats"
 	natstest "github.com/numaproj/numaflow/pkg/shared/clients/nats/test"
+	"github.com/numaproj/numaflow/pkg/shared/kvs/inmem"
 	"github.com/numaproj/numaflow/pkg/shared/kvs/jetstream"
 	"github.com/numaproj/numaflow/pkg/sideinputs/utils"
+	"github.com/numaproj/numaflow/pkg/sideinputs/versions"
 )
 
 // Delete mountPath directory if it exists
@@ -94,7 +96,7 @@ func TestSideInputsValueUpdates(t *testing.T) {
 
 	bucketName := keyspace
 	sideInputStore, _ := jetstream.NewKVJetStreamKVStore(ctx, bucketName, nc)
-	go startSideInputSynchronizer(ctx, sideInputStore, sideInputs, mountPath)
+	go startSideInputSynchronizer(ctx, sideInputStore, sideInputs, mountPath, nil)
 	for x := range sideInputs {
 		_, err = kv.Put(sideInputs[x], []byte(dataTest[x]))
 		if err != nil {
@@ -117,3 +119,29 @@ func TestSideInputsValueUpdates(t *testing.T) {
 		assert.Equal(t, dataTest[x], string(fileData))
 	}
 }
+
+// TestSideInputsPinnedVersion tests that the synchronizer reads the pinned version
+// of a side input instead of the latest broadcast one.
+func TestSideInputsPinnedVersion(t *testing.T) {
+	mountPath, err := os.MkdirTemp("/tmp", "side-input")
+	assert.NoError(t, err)
+	defer cleanup(mountPath)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	store, err := inmem.NewKVInMemKVStore(ctx, "sideInputTestPinned")
+	assert.NoError(t, err)
+	defer store.Close()
+	_, err = versions.Broadcast(ctx, store, "TEST", []byte("v1"), 5, nil)
+	assert.NoError(t, err)
+	_, err = versions.Broadcast(ctx, store, "TEST", []byte("v2"), 5, nil)
+	assert.NoError(t, err)
+
+	go startSideInputSynchronizer(ctx, store, []string{"TEST"}, mountPath, map[string]int64{"TEST": 1})
+	p := path.Join(mountPath, "TEST")
+	assert.Eventually(t, func() bool {
+		fileData, err := utils.FetchSideInputFileValue(p)
+		return err == nil && string(fileData) == "v1"
+	}, 5*time.Second, 10*time.Millisecond)
+}
@@ -0,0 +1,22 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package versions manages the version history of the side inputs in the side inputs store.
+//
+// The value currently broadcast to the vertices is stored under the side input name, which is
+// what the side inputs initializers and synchronizers watch. Each broadcast value is also stored
+// as a version under "<name>.v<version>", and the retained versions are tracked under "<name>.history".
+package versions
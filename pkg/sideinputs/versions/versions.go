@@ -0,0 +1,291 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package versions
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/numaproj/numaflow/pkg/shared/kvs"
+)
+
+// ErrVersionNotFound is returned if the requested version of a side input is not retained.
+var ErrVersionNotFound = errors.New("side input version not found")
+
+// maxConflictRetries is the max number of retries on the conflicting updates of a version history.
+const maxConflictRetries = 10
+
+// Version is the information of a version of a side input.
+type Version struct {
+	Version int64 `json:"version"`
+	// Checksum is the SHA-256 checksum of the value, in hex.
+	Checksum string `json:"checksum"`
+	// CreatedAt is the creation time in unix milliseconds.
+	CreatedAt int64 `json:"createdAt"`
+}
+
+// History is the version history of a side input.
+type History struct {
+	// Current is the version currently broadcast to the vertices.
+	Current int64 `json:"current"`
+	// Versions are the retained versions, the oldest first.
+	Versions []Version `json:"versions"`
+}
+
+// Latest returns the latest version, or nil if there's no version yet.
+func (h *History) Latest() *Version {
+	if len(h.Versions) == 0 {
+		return nil
+	}
+	return &h.Versions[len(h.Versions)-1]
+}
+
+// Get returns the given version, or nil if it's not retained.
+func (h *History) Get(version int64) *Version {
+	for i := range h.Versions {
+		if h.Versions[i].Version == version {
+			return &h.Versions[i]
+		}
+	}
+	return nil
+}
+
+// HistoryKey returns the key of the version history of a side input.
+func HistoryKey(sideInput string) string {
+	return sideInput + ".history"
+}
+
+// VersionKey returns the key of a version of a side input.
+func VersionKey(sideInput string, version int64) string {
+	return fmt.Sprintf("%s.v%d", sideInput, version)
+}
+
+// Checksum returns the SHA-256 checksum of a side input value, in hex.
+func Checksum(value []byte) string {
+	sum := sha256.Sum256(value)
+	return hex.EncodeToString(sum[:])
+}
+
+// GetHistory returns the version history of a side input, an empty history is returned
+// if the side input has never been broadcast.
+func GetHistory(ctx context.Context, store kvs.KVStorer, sideInput string) (*History, error) {
+	h, _, err := getHistory(ctx, store, sideInput)
+	return h, err
+}
+
+// getHistory returns the version history of a side input, and the raw value it's decoded from,
+// which is nil if the side input has never been broadcast.
+func getHistory(ctx context.Context, store kvs.KVStorer, sideInput string) (*History, []byte, error) {
+	b, err := store.GetValue(ctx, HistoryKey(sideInput))
+	if err != nil {
+		if errors.Is(err, kvs.ErrKeyNotFound) {
+			return &History{}, nil, nil
+		}
+		return nil, nil, fmt.Errorf("failed to get the version history of side input %q, %w", sideInput, err)
+	}
+	h := &History{}
+	if err := json.Unmarshal(b, h); err != nil {
+		return nil, nil, fmt.Errorf("failed to unmarshal the version history of side input %q, %w", sideInput, err)
+	}
+	return h, b, nil
+}
+
+// updateHistory reads the version history of a side input, applies the update to it, and writes it back if the
+// update returns true. The history is updated by both the side inputs manager and the daemon server, so it's
+// written with a compare-and-put if the store supports it, and the update is retried on conflicts.
+func updateHistory(ctx context.Context, store kvs.KVStorer, sideInput string, update func(h *History) (bool, error)) error {
+	for i := 0; ; i++ {
+		h, old, err := getHistory(ctx, store, sideInput)
+		if err != nil {
+			return err
+		}
+		changed, err := update(h)
+		if err != nil || !changed {
+			return err
+		}
+		b, err := json.Marshal(h)
+		if err != nil {
+			return fmt.Errorf("failed to marshal the version history of side input %q, %w", sideInput, err)
+		}
+		if cas, ok := store.(kvs.CompareAndPutter); ok {
+			err = cas.CompareAndPut(ctx, HistoryKey(sideInput), old, b)
+		} else {
+			err = store.PutKV(ctx, HistoryKey(sideInput), b)
+		}
+		if err == nil {
+			return nil
+		}
+		if !errors.Is(err, kvs.ErrKeyConflict) || i >= maxConflictRetries {
+			return fmt.Errorf("failed to write the version history of side input %q, %w", sideInput, err)
+		}
+	}
+}
+
+// Broadcast stores the value as a new version of the side input, and broadcasts it to the vertices.
+// At most "retained" versions are kept, besides the pinned one if it's not nil.
+//
+// If the value is the same as the latest version, no new version is created. In that case, the value
+// is not broadcast if an older version has been rolled back to, and a nil version is returned, so that
+// a rollback holds until the side input produces a new value.
+func Broadcast(ctx context.Context, store kvs.KVStorer, sideInput string, value []byte, retained int, pinned *int64) (*Version, error) {
+	if retained < 1 {
+		retained = 1
+	}
+	checksum := Checksum(value)
+	var (
+		result  *Version
+		evicted []Version
+		written = make(map[int64]bool)
+	)
+	err := updateHistory(ctx, store, sideInput, func(h *History) (bool, error) {
+		result, evicted = nil, nil
+		if latest := h.Latest(); latest != nil && latest.Checksum == checksum {
+			if h.Current == latest.Version {
+				result = latest
+			}
+			return false, nil
+		}
+
+		v := Version{Version: 1, Checksum: checksum, CreatedAt: time.Now().UnixMilli()}
+		if latest := h.Latest(); latest != nil {
+			v.Version = latest.Version + 1
+		}
+		// the version is written before the history refers to it.
+		if !written[v.Version] {
+			if err := store.PutKV(ctx, VersionKey(sideInput, v.Version), value); err != nil {
+				return false, fmt.Errorf("failed to write version %d of side input %q to store: %w", v.Version, sideInput, err)
+			}
+			written[v.Version] = true
+		}
+		h.Versions = append(h.Versions, v)
+		h.Current = v.Version
+		result = &v
+
+		// Evict the oldest versions, the pinned version is always kept.
+		var kept []Version
+		for i := len(h.Versions) - 1; i >= 0; i-- {
+			x := h.Versions[i]
+			if len(h.Versions)-i <= retained || (pinned != nil && x.Version == *pinned) {
+				kept = append([]Version{x}, kept...)
+			} else {
+				evicted = append(evicted, x)
+			}
+		}
+		h.Versions = kept
+		return true, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	if result == nil {
+		return nil, nil
+	}
+	if err := putCurrent(ctx, store, sideInput, result.Version, value); err != nil {
+		return nil, err
+	}
+	for _, x := range evicted {
+		if err := store.DeleteKey(ctx, VersionKey(sideInput, x.Version)); err != nil && !errors.Is(err, kvs.ErrKeyNotFound) {
+			return nil, fmt.Errorf("failed to delete version %d of side input %q from store: %w", x.Version, sideInput, err)
+		}
+	}
+	return result, nil
+}
+
+// Rollback broadcasts a retained version of the side input to the vertices.
+func Rollback(ctx context.Context, store kvs.KVStorer, sideInput string, version int64) (*Version, error) {
+	var (
+		result *Version
+		value  []byte
+	)
+	err := updateHistory(ctx, store, sideInput, func(h *History) (bool, error) {
+		v := h.Get(version)
+		if v == nil {
+			return false, fmt.Errorf("%w: side input %q version %d", ErrVersionNotFound, sideInput, version)
+		}
+		b, err := store.GetValue(ctx, VersionKey(sideInput, version))
+		if err != nil {
+			if errors.Is(err, kvs.ErrKeyNotFound) {
+				return false, fmt.Errorf("%w: side input %q version %d", ErrVersionNotFound, sideInput, version)
+			}
+			return false, fmt.Errorf("failed to get version %d of side input %q, %w", version, sideInput, err)
+		}
+		if Checksum(b) != v.Checksum {
+			return false, fmt.Errorf("checksum mismatch for version %d of side input %q", version, sideInput)
+		}
+		result, value = v, b
+		h.Current = version
+		return true, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	if err := putCurrent(ctx, store, sideInput, version, value); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// putCurrent writes the value of a version to the key of the side input watched by the vertices. The value and the
+// version history are different keys, so the history is read again after the write, and if a concurrent update has
+// made another version current in the meantime, the value of that version is written instead, until they agree.
+func putCurrent(ctx context.Context, store kvs.KVStorer, sideInput string, version int64, value []byte) error {
+	for i := 0; ; i++ {
+		if value != nil {
+			if err := store.PutKV(ctx, sideInput, value); err != nil {
+				return fmt.Errorf("failed to write side input %q to store: %w", sideInput, err)
+			}
+		}
+		h, _, err := getHistory(ctx, store, sideInput)
+		if err != nil {
+			return err
+		}
+		if value != nil && h.Current == version {
+			return nil
+		}
+		if i >= maxConflictRetries {
+			return fmt.Errorf("failed to write side input %q to store, the current version keeps changing", sideInput)
+		}
+		version = h.Current
+		value, err = store.GetValue(ctx, VersionKey(sideInput, version))
+		if err != nil {
+			if !errors.Is(err, kvs.ErrKeyNotFound) {
+				return fmt.Errorf("failed to get version %d of side input %q, %w", version, sideInput, err)
+			}
+			// the version has been evicted by a concurrent update, read the history again.
+			value = nil
+		}
+	}
+}
+
+// WatchKeys returns the keys to watch in the side inputs store, mapped to the side input names.
+// The key of a side input is its name, or the key of the pinned version if it's pinned.
+func WatchKeys(sideInputs []string, pinned map[string]int64) map[string]string {
+	keys := make(map[string]string, len(sideInputs))
+	for _, sideInput := range sideInputs {
+		if version, ok := pinned[sideInput]; ok {
+			keys[VersionKey(sideInput, version)] = sideInput
+		} else {
+			keys[sideInput] = sideInput
+		}
+	}
+	return keys
+}
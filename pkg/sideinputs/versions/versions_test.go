@@ -0,0 +1,204 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package versions
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"k8s.io/utils/ptr"
+
+	"github.com/numaproj/numaflow/pkg/shared/kvs"
+	"github.com/numaproj/numaflow/pkg/shared/kvs/inmem"
+)
+
+func newTestStore(t *testing.T) kvs.KVStorer {
+	store, err := inmem.NewKVInMemKVStore(context.Background(), "test-side-inputs")
+	assert.NoError(t, err)
+	t.Cleanup(store.Close)
+	return store
+}
+
+func TestBroadcast(t *testing.T) {
+	ctx := context.Background()
+	store := newTestStore(t)
+
+	v, err := Broadcast(ctx, store, "s1", []byte("a"), 2, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(1), v.Version)
+	assert.Equal(t, Checksum([]byte("a")), v.Checksum)
+
+	// Same value does not create a new version.
+	v, err = Broadcast(ctx, store, "s1", []byte("a"), 2, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(1), v.Version)
+
+	_, err = Broadcast(ctx, store, "s1", []byte("b"), 2, nil)
+	assert.NoError(t, err)
+	v, err = Broadcast(ctx, store, "s1", []byte("c"), 2, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(3), v.Version)
+
+	h, err := GetHistory(ctx, store, "s1")
+	assert.NoError(t, err)
+	assert.Equal(t, int64(3), h.Current)
+	assert.Len(t, h.Versions, 2)
+	assert.Equal(t, int64(2), h.Versions[0].Version)
+
+	current, err := store.GetValue(ctx, "s1")
+	assert.NoError(t, err)
+	assert.Equal(t, []byte("c"), current)
+	_, err = store.GetValue(ctx, VersionKey("s1", 1))
+	assert.ErrorIs(t, err, kvs.ErrKeyNotFound)
+}
+
+func TestBroadcast_Pinned(t *testing.T) {
+	ctx := context.Background()
+	store := newTestStore(t)
+	for _, value := range []string{"a", "b", "c", "d"} {
+		_, err := Broadcast(ctx, store, "s1", []byte(value), 2, ptr.To[int64](1))
+		assert.NoError(t, err)
+	}
+	h, err := GetHistory(ctx, store, "s1")
+	assert.NoError(t, err)
+	assert.Len(t, h.Versions, 3)
+	assert.Equal(t, int64(1), h.Versions[0].Version)
+	assert.Equal(t, int64(3), h.Versions[1].Version)
+	pinned, err := store.GetValue(ctx, VersionKey("s1", 1))
+	assert.NoError(t, err)
+	assert.Equal(t, []byte("a"), pinned)
+}
+
+func TestRollback(t *testing.T) {
+	ctx := context.Background()
+	store := newTestStore(t)
+	_, err := Broadcast(ctx, store, "s1", []byte("good"), 5, nil)
+	assert.NoError(t, err)
+	_, err = Broadcast(ctx, store, "s1", []byte("bad"), 5, nil)
+	assert.NoError(t, err)
+
+	_, err = Rollback(ctx, store, "s1", 10)
+	assert.ErrorIs(t, err, ErrVersionNotFound)
+
+	v, err := Rollback(ctx, store, "s1", 1)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(1), v.Version)
+	current, err := store.GetValue(ctx, "s1")
+	assert.NoError(t, err)
+	assert.Equal(t, []byte("good"), current)
+
+	// The rollback holds while the side input keeps producing the same bad value.
+	v, err = Broadcast(ctx, store, "s1", []byte("bad"), 5, nil)
+	assert.NoError(t, err)
+	assert.Nil(t, v)
+	current, err = store.GetValue(ctx, "s1")
+	assert.NoError(t, err)
+	assert.Equal(t, []byte("good"), current)
+
+	// A new value is broadcast again.
+	v, err = Broadcast(ctx, store, "s1", []byte("fixed"), 5, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(3), v.Version)
+	h, err := GetHistory(ctx, store, "s1")
+	assert.NoError(t, err)
+	assert.Equal(t, int64(3), h.Current)
+}
+
+// racingStore runs the given update once right before the first compare-and-put, as if it's done concurrently.
+type racingStore struct {
+	kvs.KVStorer
+	race func()
+}
+
+func (s *racingStore) CompareAndPut(ctx context.Context, k string, old, v []byte) error {
+	if s.race != nil {
+		race := s.race
+		s.race = nil
+		race()
+	}
+	return s.KVStorer.(kvs.CompareAndPutter).CompareAndPut(ctx, k, old, v)
+}
+
+func TestRollback_Conflict(t *testing.T) {
+	ctx := context.Background()
+	inner := newTestStore(t)
+	store := &racingStore{KVStorer: inner}
+	_, err := Broadcast(ctx, store, "s1", []byte("good"), 5, nil)
+	assert.NoError(t, err)
+	_, err = Broadcast(ctx, store, "s1", []byte("bad"), 5, nil)
+	assert.NoError(t, err)
+
+	// a new version is broadcast while rolling back, neither of the updates is lost.
+	store.race = func() {
+		_, err := Broadcast(ctx, inner, "s1", []byte("new"), 5, nil)
+		assert.NoError(t, err)
+	}
+	_, err = Rollback(ctx, store, "s1", 1)
+	assert.NoError(t, err)
+
+	h, err := GetHistory(ctx, store, "s1")
+	assert.NoError(t, err)
+	assert.Equal(t, int64(1), h.Current)
+	assert.Len(t, h.Versions, 3)
+	assert.Equal(t, int64(3), h.Latest().Version)
+	current, err := store.GetValue(ctx, "s1")
+	assert.NoError(t, err)
+	assert.Equal(t, []byte("good"), current)
+}
+
+// racingPutStore runs the given update once right before the first write of the given key, as if it's done concurrently.
+type racingPutStore struct {
+	kvs.KVStorer
+	key  string
+	race func()
+}
+
+func (s *racingPutStore) PutKV(ctx context.Context, k string, v []byte) error {
+	if k == s.key && s.race != nil {
+		race := s.race
+		s.race = nil
+		race()
+	}
+	return s.KVStorer.PutKV(ctx, k, v)
+}
+
+func TestRollback_ConcurrentBroadcast(t *testing.T) {
+	ctx := context.Background()
+	inner := newTestStore(t)
+	store := &racingPutStore{KVStorer: inner, key: "s1"}
+	_, err := Broadcast(ctx, inner, "s1", []byte("good"), 5, nil)
+	assert.NoError(t, err)
+	_, err = Broadcast(ctx, inner, "s1", []byte("bad"), 5, nil)
+	assert.NoError(t, err)
+
+	// a new version is broadcast after the history is rolled back, but before the rolled back value is written,
+	// the value of the current version is served in the end.
+	store.race = func() {
+		_, err := Broadcast(ctx, inner, "s1", []byte("new"), 5, nil)
+		assert.NoError(t, err)
+	}
+	_, err = Rollback(ctx, store, "s1", 1)
+	assert.NoError(t, err)
+
+	h, err := GetHistory(ctx, store, "s1")
+	assert.NoError(t, err)
+	assert.Equal(t, int64(3), h.Current)
+	current, err := store.GetValue(ctx, "s1")
+	assert.NoError(t, err)
+	assert.Equal(t, []byte("new"), current)
+}
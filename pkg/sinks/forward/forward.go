@@ -33,6 +33,7 @@ import (
 	"github.com/numaproj/numaflow/pkg/metrics"
 	"github.com/numaproj/numaflow/pkg/shared/idlehandler"
 	"github.com/numaproj/numaflow/pkg/shared/logging"
+	"github.com/numaproj/numaflow/pkg/shared/tracing"
 	"github.com/numaproj/numaflow/pkg/sinks/sinker"
 	"github.com/numaproj/numaflow/pkg/sinks/udsink"
 	"github.com/numaproj/numaflow/pkg/watermark/fetch"
@@ -204,6 +205,7 @@ func (df *DataForward) forwardAChunk(ctx context.Context) error {
 	// at-least-once semantics for reading, during restart we will have to reprocess all unacknowledged messages. It is the
 	// responsibility of the Read function to do that.
 	readMessages, err := df.fromBufferPartition.Read(ctx, df.opts.readBatchSize)
+	readEnd := time.Now()
 	df.opts.logger.Debugw("Read from buffer", zap.String("bufferFrom", df.fromBufferPartition.GetName()), zap.Int64("length", int64(len(readMessages))))
 	if err != nil {
 		df.opts.logger.Warnw("failed to read fromBufferPartition", zap.Error(err))
@@ -250,6 +252,12 @@ func (df *DataForward) forwardAChunk(ctx context.Context) error {
 		}
 	}
 
+	// trace the batch from the start of the read, the headers of the data messages will carry the context of the batch span.
+	ctx, span := tracing.StartBatchSpan(ctx, "sink.forward", readStart, dataMessages,
+		append(tracing.VertexAttributes(df.pipelineName, df.vertexName, df.vertexReplica), tracing.AttributePartition.String(df.fromBufferPartition.GetName()))...)
+	defer span.End()
+	tracing.RecordSpan(ctx, "isb.read", readStart, readEnd)
+
 	metrics.ReadDataMessagesCount.With(metricLabelsWithPartition).Add(float64(len(dataMessages)))
 	metrics.ReadMessagesCount.With(metricLabelsWithPartition).Add(float64(len(readMessages)))
 
@@ -265,26 +273,37 @@ func (df *DataForward) forwardAChunk(ctx context.Context) error {
 	processorWM := df.wmFetcher.ComputeWatermark(readMessages[0].ReadOffset, df.fromBufferPartition.GetPartitionIdx())
 
 	writeMessages := make([]isb.Message, 0, len(dataMessages))
+	// late messages of the reduce vertices could ask to be written to the fallback sink directly
+	lateFallbackMessages := make([]isb.Message, 0)
 	for _, m := range dataMessages {
 		m.Watermark = time.Time(processorWM)
+		if df.opts.fbSinkWriter != nil && m.Headers[dfv1.KeyMetaLateDataFallback] == "true" {
+			lateFallbackMessages = append(lateFallbackMessages, m.Message)
+			continue
+		}
 		writeMessages = append(writeMessages, m.Message)
 	}
 
 	// write the messages to the sink
+	_, writeSpan := tracing.StartSpan(ctx, "sink.write")
 	_, fallbackMessages, err := df.writeToSink(ctx, df.sinkWriter, writeMessages, false)
+	tracing.EndSpan(writeSpan, err)
 	// error will not be nil only when we get ctx.Done()
 	if err != nil {
 		df.opts.logger.Errorw("failed to write to sink", zap.Error(err))
 		df.fromBufferPartition.NoAck(ctx, readOffsets)
 		return err
 	}
+	fallbackMessages = append(fallbackMessages, lateFallbackMessages...)
 
 	// Only when fallback is configured, it is possible to return fallbackMessages. If there's any, write to the fallback sink.
 	if len(fallbackMessages) > 0 {
 		df.opts.logger.Infow("Writing messages to fallback sink", zap.Int("count", len(fallbackMessages)))
 		// write to sink is an infinite loop; it will return only if writes are successful or
 		// ctx.Done happens due to shutdown.
+		_, fallbackSpan := tracing.StartSpan(ctx, "sink.fallback")
 		_, _, err = df.writeToSink(ctx, df.opts.fbSinkWriter, fallbackMessages, true)
+		tracing.EndSpan(fallbackSpan, err)
 		if err != nil {
 			df.opts.logger.Errorw("Failed to write to fallback sink", zap.Error(err))
 			df.fromBufferPartition.NoAck(ctx, readOffsets)
@@ -0,0 +1,188 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package pulsar
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/apache/pulsar-client-go/pulsar"
+	"go.uber.org/zap"
+
+	dfv1 "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1"
+	"github.com/numaproj/numaflow/pkg/isb"
+	"github.com/numaproj/numaflow/pkg/metrics"
+	"github.com/numaproj/numaflow/pkg/shared/logging"
+	"github.com/numaproj/numaflow/pkg/shared/util"
+)
+
+const (
+	// batchingMaxPublishDelay is the max time the producer waits to group messages into a single batch.
+	batchingMaxPublishDelay = 10 * time.Millisecond
+	// sendTimeout is the time after which a message that hasn't been acknowledged by the broker is failed.
+	sendTimeout = 30 * time.Second
+)
+
+// producer is the subset of the pulsar.Producer methods used by the sink.
+type producer interface {
+	SendAsync(context.Context, *pulsar.ProducerMessage, func(pulsar.MessageID, *pulsar.ProducerMessage, error))
+	FlushWithCtx(ctx context.Context) error
+	Close()
+}
+
+// ToPulsar produces the output to a pulsar topic.
+type ToPulsar struct {
+	name         string
+	pipelineName string
+	client       pulsar.Client
+	producer     producer
+	log          *zap.SugaredLogger
+}
+
+// NewToPulsar returns ToPulsar type. The pulsar sink spec is passed in explicitly, so that the
+// same writer can be used for both the main sink and the fallback sink.
+func NewToPulsar(ctx context.Context, vertexInstance *dfv1.VertexInstance, pulsarSink *dfv1.PulsarSink) (*ToPulsar, error) {
+	toPulsar := &ToPulsar{
+		name:         vertexInstance.Vertex.Spec.Name,
+		pipelineName: vertexInstance.Vertex.Spec.PipelineName,
+		log:          logging.FromContext(ctx).With("sinkType", "pulsar").With("topic", pulsarSink.Topic),
+	}
+
+	clientOpts := pulsar.ClientOptions{
+		URL: pulsarSink.ServerAddr,
+	}
+	auth, err := getAuthentication(pulsarSink.Auth)
+	if err != nil {
+		return nil, err
+	}
+	clientOpts.Authentication = auth
+
+	client, err := pulsar.NewClient(clientOpts)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create pulsar client, %w", err)
+	}
+	p, err := client.CreateProducer(pulsar.ProducerOptions{
+		Topic:                   pulsarSink.Topic,
+		Name:                    pulsarSink.ProducerName,
+		SendTimeout:             sendTimeout,
+		BatchingMaxPublishDelay: batchingMaxPublishDelay,
+	})
+	if err != nil {
+		client.Close()
+		return nil, fmt.Errorf("failed to create pulsar producer, %w", err)
+	}
+	toPulsar.client = client
+	toPulsar.producer = p
+	return toPulsar, nil
+}
+
+// getAuthentication returns the pulsar authentication based on the auth spec, credentials are read from the mounted secrets.
+func getAuthentication(auth *dfv1.PulsarAuth) (pulsar.Authentication, error) {
+	if auth == nil {
+		return nil, nil
+	}
+	if auth.Token != nil {
+		token, err := util.GetSecretFromVolume(auth.Token)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get pulsar auth token, %w", err)
+		}
+		return pulsar.NewAuthenticationToken(token), nil
+	}
+	if auth.BasicAuth != nil {
+		if auth.BasicAuth.Username == nil || auth.BasicAuth.Password == nil {
+			return nil, fmt.Errorf("username and password are required for pulsar basic auth")
+		}
+		username, err := util.GetSecretFromVolume(auth.BasicAuth.Username)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get pulsar basic auth username, %w", err)
+		}
+		password, err := util.GetSecretFromVolume(auth.BasicAuth.Password)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get pulsar basic auth password, %w", err)
+		}
+		return pulsar.NewAuthenticationBasic(username, password)
+	}
+	return nil, fmt.Errorf("pulsar auth is specified, but no credentials are provided")
+}
+
+// GetName returns the name.
+func (tp *ToPulsar) GetName() string {
+	return tp.name
+}
+
+// GetPartitionIdx returns the partition index.
+// for sink it is always 0.
+func (tp *ToPulsar) GetPartitionIdx() int32 {
+	return 0
+}
+
+// Write writes the messages to the pulsar topic. Messages are sent asynchronously so that the producer
+// can batch them, and the send result of each message is reported at the same index of the returned errors.
+func (tp *ToPulsar) Write(ctx context.Context, messages []isb.Message) ([]isb.Offset, []error) {
+	errs := make([]error, len(messages))
+	labels := map[string]string{metrics.LabelVertex: tp.name, metrics.LabelPipeline: tp.pipelineName}
+	wg := new(sync.WaitGroup)
+	for index, msg := range messages {
+		wg.Add(1)
+		tp.producer.SendAsync(ctx, toProducerMessage(msg), func(idx int) func(pulsar.MessageID, *pulsar.ProducerMessage, error) {
+			return func(_ pulsar.MessageID, _ *pulsar.ProducerMessage, err error) {
+				defer wg.Done()
+				if err != nil {
+					pulsarSinkWriteErrors.With(labels).Inc()
+				}
+				errs[idx] = err
+			}
+		}(index))
+	}
+	// flush to send out the last batch without waiting for the batching delay.
+	if err := tp.producer.FlushWithCtx(ctx); err != nil {
+		tp.log.Warnw("Failed to flush pulsar producer", zap.Error(err))
+	}
+	wg.Wait()
+	return nil, errs
+}
+
+// toProducerMessage converts the isb.Message to a pulsar message. Similar to the kafka sink,
+// the keys are added to the message properties.
+func toProducerMessage(msg isb.Message) *pulsar.ProducerMessage {
+	properties := make(map[string]string, len(msg.Headers)+len(msg.Keys)+1)
+	for k, v := range msg.Headers {
+		properties[k] = v
+	}
+	properties["__key_len"] = strconv.Itoa(len(msg.Keys))
+	for idx, key := range msg.Keys {
+		properties[fmt.Sprintf("__key_%d", idx)] = key
+	}
+	return &pulsar.ProducerMessage{
+		Payload:    msg.Payload,
+		Properties: properties,
+		EventTime:  msg.EventTime,
+	}
+}
+
+// Close closes the producer and the client.
+func (tp *ToPulsar) Close() error {
+	tp.log.Info("Closing pulsar producer...")
+	tp.producer.Close()
+	if tp.client != nil {
+		tp.client.Close()
+	}
+	return nil
+}
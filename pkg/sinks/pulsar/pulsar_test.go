@@ -0,0 +1,142 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package pulsar
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/apache/pulsar-client-go/pulsar"
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+
+	dfv1 "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1"
+	"github.com/numaproj/numaflow/pkg/isb"
+	"github.com/numaproj/numaflow/pkg/shared/logging"
+)
+
+// fakeProducer records the sent messages and fails the ones whose payload is in failPayloads.
+type fakeProducer struct {
+	sync.Mutex
+	sent         []*pulsar.ProducerMessage
+	failPayloads map[string]bool
+	flushed      int
+	closed       bool
+}
+
+func (f *fakeProducer) SendAsync(_ context.Context, m *pulsar.ProducerMessage, cb func(pulsar.MessageID, *pulsar.ProducerMessage, error)) {
+	f.Lock()
+	f.sent = append(f.sent, m)
+	f.Unlock()
+	// callbacks are invoked from a different goroutine in the real producer.
+	go func() {
+		if f.failPayloads[string(m.Payload)] {
+			cb(nil, m, fmt.Errorf("failed to send %s", m.Payload))
+			return
+		}
+		cb(nil, m, nil)
+	}()
+}
+
+func (f *fakeProducer) FlushWithCtx(_ context.Context) error {
+	f.flushed++
+	return nil
+}
+
+func (f *fakeProducer) Close() {
+	f.closed = true
+}
+
+func newTestToPulsar(p producer) *ToPulsar {
+	return &ToPulsar{
+		name:         "testVertex",
+		pipelineName: "testPipeline",
+		producer:     p,
+		log:          logging.NewLogger(),
+	}
+}
+
+func TestWriteToPulsar(t *testing.T) {
+	fp := &fakeProducer{}
+	toPulsar := newTestToPulsar(fp)
+	eventTime := time.Unix(1636470000, 0)
+	msgs := []isb.Message{
+		{
+			Header: isb.Header{
+				MessageInfo: isb.MessageInfo{EventTime: eventTime},
+				Keys:        []string{"key1", "key2"},
+				Headers:     map[string]string{"foo": "bar"},
+			},
+			Body: isb.Body{Payload: []byte("welcome1")},
+		},
+		{
+			Header: isb.Header{
+				MessageInfo: isb.MessageInfo{EventTime: eventTime},
+			},
+			Body: isb.Body{Payload: []byte("welcome2")},
+		},
+	}
+	_, errs := toPulsar.Write(context.Background(), msgs)
+	assert.Len(t, errs, 2)
+	for _, err := range errs {
+		assert.NoError(t, err)
+	}
+	assert.Equal(t, 1, fp.flushed)
+	assert.Len(t, fp.sent, 2)
+	assert.Equal(t, map[string]string{"foo": "bar", "__key_len": "2", "__key_0": "key1", "__key_1": "key2"}, fp.sent[0].Properties)
+	assert.Equal(t, eventTime, fp.sent[0].EventTime)
+	assert.Equal(t, map[string]string{"__key_len": "0"}, fp.sent[1].Properties)
+
+	assert.NoError(t, toPulsar.Close())
+	assert.True(t, fp.closed)
+}
+
+func TestWriteToPulsarPartialFailure(t *testing.T) {
+	fp := &fakeProducer{failPayloads: map[string]bool{"bad": true}}
+	toPulsar := newTestToPulsar(fp)
+	msgs := []isb.Message{
+		{Body: isb.Body{Payload: []byte("good")}},
+		{Body: isb.Body{Payload: []byte("bad")}},
+		{Body: isb.Body{Payload: []byte("good")}},
+	}
+	_, errs := toPulsar.Write(context.Background(), msgs)
+	assert.Len(t, errs, 3)
+	assert.NoError(t, errs[0])
+	assert.EqualError(t, errs[1], "failed to send bad")
+	assert.NoError(t, errs[2])
+}
+
+func TestGetAuthentication(t *testing.T) {
+	auth, err := getAuthentication(nil)
+	assert.NoError(t, err)
+	assert.Nil(t, auth)
+
+	_, err = getAuthentication(&dfv1.PulsarAuth{})
+	assert.Error(t, err)
+
+	_, err = getAuthentication(&dfv1.PulsarAuth{BasicAuth: &dfv1.PulsarBasicAuth{
+		Username: &corev1.SecretKeySelector{LocalObjectReference: corev1.LocalObjectReference{Name: "user"}, Key: "user"},
+	}})
+	assert.EqualError(t, err, "username and password are required for pulsar basic auth")
+
+	// secret volume doesn't exist in the test
+	_, err = getAuthentication(&dfv1.PulsarAuth{Token: &corev1.SecretKeySelector{LocalObjectReference: corev1.LocalObjectReference{Name: "token"}, Key: "token"}})
+	assert.Error(t, err)
+}
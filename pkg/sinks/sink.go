@@ -19,7 +19,6 @@ package sinks
 import (
 	"context"
 	"fmt"
-	"os"
 	"sync"
 
 	"go.uber.org/zap"
@@ -43,11 +42,14 @@ import (
 	sinkforward "github.com/numaproj/numaflow/pkg/sinks/forward"
 	kafkasink "github.com/numaproj/numaflow/pkg/sinks/kafka"
 	logsink "github.com/numaproj/numaflow/pkg/sinks/logger"
+	pulsarsink "github.com/numaproj/numaflow/pkg/sinks/pulsar"
 	"github.com/numaproj/numaflow/pkg/sinks/sinker"
+	sqssink "github.com/numaproj/numaflow/pkg/sinks/sqs"
 	"github.com/numaproj/numaflow/pkg/sinks/udsink"
 	"github.com/numaproj/numaflow/pkg/watermark/fetch"
 	"github.com/numaproj/numaflow/pkg/watermark/generic"
 	"github.com/numaproj/numaflow/pkg/watermark/generic/jetstream"
+	rediswm "github.com/numaproj/numaflow/pkg/watermark/generic/redis"
 	"github.com/numaproj/numaflow/pkg/watermark/store"
 	"github.com/numaproj/numaflow/pkg/watermark/wmb"
 )
@@ -96,6 +98,30 @@ func (u *SinkProcessor) Start(ctx context.Context) error {
 			reader := redisisb.NewBufferRead(ctx, redisClient, bufferPartition, fromGroup, consumer, int32(index), readOptions...)
 			readers = append(readers, reader)
 		}
+
+		if u.VertexInstance.Vertex.Spec.Watermark.Disabled {
+			// use default no op fetcher, publisher, idleManager
+		} else {
+			// build from vertex watermark stores
+			fromVertexWmStores, err = rediswm.BuildFromVertexWatermarkStores(ctx, u.VertexInstance, redisClient)
+			if err != nil {
+				return fmt.Errorf("failed to from vertex watermark stores: %w", err)
+			}
+
+			// create watermark fetcher using watermark stores
+			fetchWatermark = fetch.NewEdgeFetcherSet(ctx, u.VertexInstance, fromVertexWmStores)
+
+			// create watermark stores
+			sinkWmStores, err = rediswm.BuildToVertexWatermarkStores(ctx, u.VertexInstance, redisClient)
+			if err != nil {
+				return fmt.Errorf("failed to to vertex watermark stores: %w", err)
+			}
+
+			// create watermark publisher using watermark stores
+			publishWatermark = rediswm.BuildPublishersFromStores(ctx, u.VertexInstance, sinkWmStores)
+			// sink vertex has only one toBuffer, so the length is 1
+			idleManager, _ = wmb.NewIdleManager(len(readers), 1)
+		}
 	case dfv1.ISBSvcTypeJetStream:
 
 		natsClientPool, err = jsclient.NewClientPool(ctx, jsclient.WithClientPoolSize(2))
@@ -104,7 +130,9 @@ func (u *SinkProcessor) Start(ctx context.Context) error {
 		}
 		defer natsClientPool.CloseAll()
 
-		var readOptions []jetstreamisb.ReadOption
+		readOptions := []jetstreamisb.ReadOption{
+			jetstreamisb.WithDefaultCompressionType(u.VertexInstance.Vertex.Spec.GetCompressionType()),
+		}
 		if x := u.VertexInstance.Vertex.Spec.Limits; x != nil && x.ReadTimeout != nil {
 			readOptions = append(readOptions, jetstreamisb.WithReadTimeOut(x.ReadTimeout.Duration))
 		}
@@ -216,6 +244,18 @@ func (u *SinkProcessor) Start(ctx context.Context) error {
 		}()
 	}
 
+	// if the callback is enabled, create a callback publisher, it's shared by all the partitions so that
+	// the callback outbox is redelivered by a single publisher
+	var cbPublisher *callback.Uploader
+	if sharedutil.LookupEnvBoolOr(dfv1.EnvCallbackEnabled, false) {
+		cbOpts, err := callback.EnvOptions()
+		if err != nil {
+			return fmt.Errorf("failed to create the callback publisher, error: %w", err)
+		}
+		cbPublisher = callback.NewUploader(ctx, vertexName, pipelineName, cbOpts...)
+		defer cbPublisher.Close()
+	}
+
 	var finalWg sync.WaitGroup
 	for index := range u.VertexInstance.Vertex.OwnedBuffers() {
 		finalWg.Add(1)
@@ -251,15 +291,7 @@ func (u *SinkProcessor) Start(ctx context.Context) error {
 			forwardOpts = append(forwardOpts, sinkforward.WithFbSinkWriter(fbSinkWriter))
 		}
 
-		// if the callback is enabled, create a callback publisher
-		cbEnabled := sharedutil.LookupEnvBoolOr(dfv1.EnvCallbackEnabled, false)
-		if cbEnabled {
-			cbOpts := make([]callback.OptionFunc, 0)
-			cbUrl := os.Getenv(dfv1.EnvCallbackURL)
-			if cbUrl != "" {
-				cbOpts = append(cbOpts, callback.WithCallbackURL(cbUrl))
-			}
-			cbPublisher := callback.NewUploader(ctx, vertexName, pipelineName, cbOpts...)
+		if cbPublisher != nil {
 			forwardOpts = append(forwardOpts, sinkforward.WithCallbackUploader(cbPublisher))
 		}
 
@@ -332,6 +364,10 @@ func (u *SinkProcessor) createSinkWriter(ctx context.Context, abstractSink *dfv1
 		return kafkasink.NewToKafka(ctx, u.VertexInstance)
 	} else if x := abstractSink.Blackhole; x != nil {
 		return blackhole.NewBlackhole(ctx, u.VertexInstance)
+	} else if x := abstractSink.Pulsar; x != nil {
+		return pulsarsink.NewToPulsar(ctx, u.VertexInstance, x)
+	} else if x := abstractSink.Sqs; x != nil {
+		return sqssink.NewToSQS(ctx, u.VertexInstance, x)
 	} else if x := abstractSink.UDSink; x != nil {
 		// if the sink is a user-defined sink, then we need to pass the sinkHandler to it which will be used to invoke the user-defined sink
 		return udsink.NewUserDefinedSink(ctx, u.VertexInstance, sinkHandler)
@@ -0,0 +1,194 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sqs
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/sqs"
+	"github.com/aws/aws-sdk-go-v2/service/sqs/types"
+	"go.uber.org/zap"
+
+	dfv1 "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1"
+	"github.com/numaproj/numaflow/pkg/isb"
+	"github.com/numaproj/numaflow/pkg/metrics"
+	sqsclient "github.com/numaproj/numaflow/pkg/shared/clients/sqs"
+	"github.com/numaproj/numaflow/pkg/shared/logging"
+)
+
+const (
+	// maxBatchSize is the max number of messages SQS allows in a single send request.
+	maxBatchSize = 10
+	// maxMessageAttributes is the max number of message attributes SQS allows on a single message.
+	maxMessageAttributes = 10
+	// maxAttributeNameLength is the max length of a message attribute name.
+	maxAttributeNameLength = 256
+)
+
+// ToSQS produces the output to an SQS queue.
+type ToSQS struct {
+	name         string
+	pipelineName string
+	queueName    string
+	queueURL     string
+	client       *sqs.Client
+	log          *zap.SugaredLogger
+}
+
+// NewToSQS returns ToSQS type. The sqs sink spec is passed in explicitly, so that the
+// same writer can be used for both the main sink and the fallback sink.
+func NewToSQS(ctx context.Context, vertexInstance *dfv1.VertexInstance, sqsSink *dfv1.SqsSink) (*ToSQS, error) {
+	client, err := sqsclient.NewClient(ctx, sqsSink.AWSRegion, sqsSink.EndpointURL)
+	if err != nil {
+		return nil, err
+	}
+	queueURL, err := sqsclient.GetQueueURL(ctx, client, sqsSink.QueueName, sqsSink.QueueOwnerAWSAccountID)
+	if err != nil {
+		return nil, err
+	}
+	return &ToSQS{
+		name:         vertexInstance.Vertex.Spec.Name,
+		pipelineName: vertexInstance.Vertex.Spec.PipelineName,
+		queueName:    sqsSink.QueueName,
+		queueURL:     queueURL,
+		client:       client,
+		log:          logging.FromContext(ctx).With("sinkType", "sqs").With("queue", sqsSink.QueueName),
+	}, nil
+}
+
+// GetName returns the name.
+func (ts *ToSQS) GetName() string {
+	return ts.name
+}
+
+// GetPartitionIdx returns the partition index.
+// for sink it is always 0.
+func (ts *ToSQS) GetPartitionIdx() int32 {
+	return 0
+}
+
+// Write sends the messages to the SQS queue in batches, and the send result of each message is
+// reported at the same index of the returned errors.
+func (ts *ToSQS) Write(ctx context.Context, messages []isb.Message) ([]isb.Offset, []error) {
+	errs := make([]error, len(messages))
+	for start := 0; start < len(messages); start += maxBatchSize {
+		end := min(start+maxBatchSize, len(messages))
+		entries := make([]types.SendMessageBatchRequestEntry, 0, end-start)
+		for i := start; i < end; i++ {
+			entries = append(entries, toBatchRequestEntry(strconv.Itoa(i), messages[i]))
+		}
+		out, err := ts.client.SendMessageBatch(ctx, &sqs.SendMessageBatchInput{
+			QueueUrl: aws.String(ts.queueURL),
+			Entries:  entries,
+		})
+		if err != nil {
+			for i := start; i < end; i++ {
+				errs[i] = fmt.Errorf("failed to send messages to sqs queue %q, %w", ts.queueName, err)
+			}
+			continue
+		}
+		for _, f := range out.Failed {
+			idx, _ := strconv.Atoi(aws.ToString(f.Id))
+			errs[idx] = fmt.Errorf("failed to send message to sqs queue %q, %s: %s", ts.queueName, aws.ToString(f.Code), aws.ToString(f.Message))
+		}
+	}
+	for _, err := range errs {
+		if err != nil {
+			sqsSinkWriteErrors.With(map[string]string{metrics.LabelVertex: ts.name, metrics.LabelPipeline: ts.pipelineName}).Inc()
+		}
+	}
+	return nil, errs
+}
+
+// toBatchRequestEntry converts the isb.Message to an SQS batch entry, the headers are added as string message attributes.
+// The headers SQS would reject as attributes, i.e. the ones with an empty value or an invalid name, are packed into a
+// single attribute as a JSON object. SQS also rejects a message with more than 10 attributes, so if there are more
+// headers than that, all of them are packed.
+func toBatchRequestEntry(id string, msg isb.Message) types.SendMessageBatchRequestEntry {
+	entry := types.SendMessageBatchRequestEntry{
+		Id:          aws.String(id),
+		MessageBody: aws.String(string(msg.Payload)),
+	}
+	if len(msg.Headers) == 0 {
+		return entry
+	}
+	attributes := make(map[string]string, len(msg.Headers))
+	packed := make(map[string]string)
+	for k, v := range msg.Headers {
+		if v != "" && isValidAttributeName(k) {
+			attributes[k] = v
+		} else {
+			packed[k] = v
+		}
+	}
+	count := len(attributes)
+	if len(packed) > 0 {
+		count++
+	}
+	if count > maxMessageAttributes {
+		attributes, packed = map[string]string{}, msg.Headers
+	}
+	entry.MessageAttributes = make(map[string]types.MessageAttributeValue, len(attributes)+1)
+	for k, v := range attributes {
+		entry.MessageAttributes[k] = types.MessageAttributeValue{
+			DataType:    aws.String("String"),
+			StringValue: aws.String(v),
+		}
+	}
+	if len(packed) > 0 {
+		// marshalling a map of strings never fails
+		data, _ := json.Marshal(packed)
+		entry.MessageAttributes[sqsclient.HeadersAttributeName] = types.MessageAttributeValue{
+			DataType:    aws.String("String"),
+			StringValue: aws.String(string(data)),
+		}
+	}
+	return entry
+}
+
+// isValidAttributeName returns whether SQS accepts the name as a message attribute name. The name can contain
+// alphanumeric characters, hyphens, underscores and periods, it must not start with "AWS." or "Amazon.", start or end
+// with a period, or have consecutive periods. The name of the attribute the headers are packed into is reserved.
+func isValidAttributeName(name string) bool {
+	if name == "" || len(name) > maxAttributeNameLength || name == sqsclient.HeadersAttributeName {
+		return false
+	}
+	lower := strings.ToLower(name)
+	if strings.HasPrefix(lower, "aws.") || strings.HasPrefix(lower, "amazon.") {
+		return false
+	}
+	if strings.HasPrefix(name, ".") || strings.HasSuffix(name, ".") || strings.Contains(name, "..") {
+		return false
+	}
+	for _, c := range name {
+		if !(c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z' || c >= '0' && c <= '9' || c == '-' || c == '_' || c == '.') {
+			return false
+		}
+	}
+	return true
+}
+
+// Close is a no-op, the sqs client doesn't hold any resources to be released.
+func (ts *ToSQS) Close() error {
+	ts.log.Info("Sqs sink closed")
+	return nil
+}
@@ -0,0 +1,180 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sqs
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	dfv1 "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1"
+	"github.com/numaproj/numaflow/pkg/isb"
+	sqsclient "github.com/numaproj/numaflow/pkg/shared/clients/sqs"
+	sqstest "github.com/numaproj/numaflow/pkg/shared/clients/sqs/test"
+)
+
+func newTestToSQS(t *testing.T, endpoint string) (*ToSQS, error) {
+	t.Helper()
+	t.Setenv("AWS_ACCESS_KEY_ID", "test")
+	t.Setenv("AWS_SECRET_ACCESS_KEY", "test")
+	t.Setenv("AWS_ENDPOINT_URL_SQS", endpoint)
+	vi := &dfv1.VertexInstance{
+		Vertex: &dfv1.Vertex{
+			Spec: dfv1.VertexSpec{
+				PipelineName:   "testPipeline",
+				AbstractVertex: dfv1.AbstractVertex{Name: "testVertex"},
+			},
+		},
+	}
+	return NewToSQS(context.Background(), vi, &dfv1.SqsSink{AWSRegion: "us-west-2", QueueName: "test-queue"})
+}
+
+func TestWriteToSQS(t *testing.T) {
+	server := sqstest.RunSQSServer(t)
+	server.CreateQueue("test-queue")
+	toSQS, err := newTestToSQS(t, server.URL)
+	assert.NoError(t, err)
+	assert.Equal(t, "testVertex", toSQS.GetName())
+	defer func() { _ = toSQS.Close() }()
+
+	// more than the max batch size of a single send request.
+	msgs := make([]isb.Message, 0, 25)
+	for i := 0; i < 25; i++ {
+		msgs = append(msgs, isb.Message{
+			Header: isb.Header{Headers: map[string]string{"index": fmt.Sprint(i)}},
+			Body:   isb.Body{Payload: []byte(fmt.Sprintf("message-%d", i))},
+		})
+	}
+	_, errs := toSQS.Write(context.Background(), msgs)
+	assert.Len(t, errs, 25)
+	for _, e := range errs {
+		assert.NoError(t, e)
+	}
+	sent := server.Messages("test-queue")
+	assert.Len(t, sent, 25)
+	for i, m := range sent {
+		assert.Equal(t, fmt.Sprintf("message-%d", i), m.Body)
+		assert.Equal(t, sqstest.MessageAttribute{DataType: "String", StringValue: fmt.Sprint(i)}, m.MessageAttributes["index"])
+	}
+}
+
+func TestWriteToSQSPartialFailure(t *testing.T) {
+	server := sqstest.RunSQSServer(t)
+	server.CreateQueue("test-queue")
+	server.FailSend = func(body string) bool { return strings.HasPrefix(body, "bad") }
+	toSQS, err := newTestToSQS(t, server.URL)
+	assert.NoError(t, err)
+
+	msgs := []isb.Message{
+		{Body: isb.Body{Payload: []byte("good")}},
+		{Body: isb.Body{Payload: []byte("bad")}},
+		{Body: isb.Body{Payload: []byte("good")}},
+	}
+	_, errs := toSQS.Write(context.Background(), msgs)
+	assert.Len(t, errs, 3)
+	assert.NoError(t, errs[0])
+	assert.ErrorContains(t, errs[1], "failed to send bad")
+	assert.NoError(t, errs[2])
+	assert.Len(t, server.Messages("test-queue"), 2)
+}
+
+func TestNewToSQS_QueueNotExist(t *testing.T) {
+	server := sqstest.RunSQSServer(t)
+	_, err := newTestToSQS(t, server.URL)
+	assert.Error(t, err)
+}
+
+func TestWriteToSQSTooManyHeaders(t *testing.T) {
+	server := sqstest.RunSQSServer(t)
+	server.CreateQueue("test-queue")
+	toSQS, err := newTestToSQS(t, server.URL)
+	assert.NoError(t, err)
+
+	headers := make(map[string]string)
+	for i := 0; i < maxMessageAttributes+1; i++ {
+		headers[fmt.Sprintf("h%d", i)] = fmt.Sprint(i)
+	}
+	msgs := []isb.Message{
+		{Header: isb.Header{Headers: headers}, Body: isb.Body{Payload: []byte("many-headers")}},
+		{Header: isb.Header{Headers: map[string]string{"h": "v"}}, Body: isb.Body{Payload: []byte("few-headers")}},
+	}
+	_, errs := toSQS.Write(context.Background(), msgs)
+	for _, e := range errs {
+		assert.NoError(t, e)
+	}
+	sent := server.Messages("test-queue")
+	assert.Len(t, sent, 2)
+	assert.Len(t, sent[0].MessageAttributes, 1)
+	packed := map[string]string{}
+	assert.NoError(t, json.Unmarshal([]byte(sent[0].MessageAttributes[sqsclient.HeadersAttributeName].StringValue), &packed))
+	assert.Equal(t, headers, packed)
+	assert.Equal(t, sqstest.MessageAttribute{DataType: "String", StringValue: "v"}, sent[1].MessageAttributes["h"])
+}
+
+func TestToBatchRequestEntry_InvalidHeaders(t *testing.T) {
+	headers := map[string]string{
+		"valid":                        "v",
+		"empty":                        "",
+		"AWS.TraceHeader":              "t",
+		"amazon.header":                "a",
+		"with space":                   "s",
+		".dot":                         "d",
+		sqsclient.HeadersAttributeName: "reserved",
+	}
+	entry := toBatchRequestEntry("0", isb.Message{Header: isb.Header{Headers: headers}})
+	assert.Len(t, entry.MessageAttributes, 2)
+	assert.Equal(t, "v", *entry.MessageAttributes["valid"].StringValue)
+	packed := map[string]string{}
+	assert.NoError(t, json.Unmarshal([]byte(*entry.MessageAttributes[sqsclient.HeadersAttributeName].StringValue), &packed))
+	delete(headers, "valid")
+	assert.Equal(t, headers, packed)
+
+	// all the headers are packed if the valid ones and the packed attribute exceed the limit
+	headers = map[string]string{"empty": ""}
+	for i := 0; i < maxMessageAttributes; i++ {
+		headers[fmt.Sprintf("h%d", i)] = fmt.Sprint(i)
+	}
+	entry = toBatchRequestEntry("0", isb.Message{Header: isb.Header{Headers: headers}})
+	assert.Len(t, entry.MessageAttributes, 1)
+	packed = map[string]string{}
+	assert.NoError(t, json.Unmarshal([]byte(*entry.MessageAttributes[sqsclient.HeadersAttributeName].StringValue), &packed))
+	assert.Equal(t, headers, packed)
+}
+
+func TestNewToSQS_EndpointURL(t *testing.T) {
+	server := sqstest.RunSQSServer(t)
+	server.CreateQueue("test-queue")
+	t.Setenv("AWS_ACCESS_KEY_ID", "test")
+	t.Setenv("AWS_SECRET_ACCESS_KEY", "test")
+	vi := &dfv1.VertexInstance{
+		Vertex: &dfv1.Vertex{
+			Spec: dfv1.VertexSpec{
+				PipelineName:   "testPipeline",
+				AbstractVertex: dfv1.AbstractVertex{Name: "testVertex"},
+			},
+		},
+	}
+	toSQS, err := NewToSQS(context.Background(), vi, &dfv1.SqsSink{AWSRegion: "us-west-2", QueueName: "test-queue", EndpointURL: &server.URL})
+	assert.NoError(t, err)
+	_, errs := toSQS.Write(context.Background(), []isb.Message{{Body: isb.Body{Payload: []byte("hello")}}})
+	assert.NoError(t, errs[0])
+	assert.Len(t, server.Messages("test-queue"), 1)
+}
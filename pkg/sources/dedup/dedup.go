@@ -0,0 +1,182 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package dedup drops source messages which have already been forwarded within a configurable window.
+//
+// Messages are keyed on their isb.MessageID.Offset. Forwarded IDs are kept in a bounded in-memory LRU cache
+// for the hot path, and in a kvs.KVStorer so that the window survives pod restarts and is shared across replicas.
+package dedup
+
+import (
+	"context"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	lru "github.com/hashicorp/golang-lru/v2"
+	"go.uber.org/zap"
+	"golang.org/x/sync/errgroup"
+
+	"github.com/numaproj/numaflow/pkg/isb"
+	"github.com/numaproj/numaflow/pkg/shared/kvs"
+	"github.com/numaproj/numaflow/pkg/shared/logging"
+)
+
+// maxConcurrentKVOps is the max number of KV store operations of a batch in flight.
+const maxConcurrentKVOps = 32
+
+// Deduplicator filters out messages whose ID has already been recorded within the window.
+type Deduplicator struct {
+	store  kvs.KVStorer
+	window time.Duration
+	// cache holds the IDs recorded by this replica, mapped to the time they were recorded.
+	cache *lru.Cache[string, time.Time]
+	log   *zap.SugaredLogger
+}
+
+// NewDeduplicator returns a Deduplicator backed by the given KV store. If the store is nil,
+// only the in-memory cache is used, so the window is neither shared across replicas nor kept across restarts.
+func NewDeduplicator(ctx context.Context, store kvs.KVStorer, window time.Duration, maxEntries int) (*Deduplicator, error) {
+	if window <= 0 {
+		return nil, fmt.Errorf("invalid dedup window %v, it must be greater than 0", window)
+	}
+	cache, err := lru.New[string, time.Time](maxEntries)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create dedup cache, %w", err)
+	}
+	return &Deduplicator{
+		store:  store,
+		window: window,
+		cache:  cache,
+		log:    logging.FromContext(ctx),
+	}, nil
+}
+
+// Filter returns the messages which have not been seen within the window, preserving their order.
+// Repeated IDs within the same batch are also dropped, only the first occurrence is kept.
+// The IDs are looked up in the in-memory cache first, the ones missing from the cache are looked up in the KV store
+// concurrently. Errors from the KV store are logged and the message is treated as not seen, because dropping
+// data is worse than forwarding a duplicate.
+func (d *Deduplicator) Filter(ctx context.Context, messages []*isb.ReadMessage) []*isb.ReadMessage {
+	var (
+		inBatch = make(map[string]struct{}, len(messages))
+		unique  = make([]*isb.ReadMessage, 0, len(messages))
+		seen    = make([]bool, 0, len(messages))
+		misses  []int
+	)
+	for _, m := range messages {
+		id := m.ID.Offset
+		if _, ok := inBatch[id]; ok {
+			continue
+		}
+		inBatch[id] = struct{}{}
+		cached := d.cached(id)
+		if !cached && d.store != nil {
+			misses = append(misses, len(unique))
+		}
+		unique = append(unique, m)
+		seen = append(seen, cached)
+	}
+
+	if len(misses) > 0 {
+		var g errgroup.Group
+		g.SetLimit(maxConcurrentKVOps)
+		for _, i := range misses {
+			g.Go(func() error {
+				seen[i] = d.seenInStore(ctx, unique[i].ID.Offset)
+				return nil
+			})
+		}
+		_ = g.Wait()
+	}
+
+	result := make([]*isb.ReadMessage, 0, len(unique))
+	for i, m := range unique {
+		if !seen[i] {
+			result = append(result, m)
+		}
+	}
+	return result
+}
+
+// Record marks the IDs of the given messages as seen, it should be invoked after the messages are written to the ISB.
+// The IDs are written to the KV store concurrently.
+func (d *Deduplicator) Record(ctx context.Context, messages []*isb.ReadMessage) error {
+	now := time.Now()
+	value := []byte(strconv.FormatInt(now.UnixMilli(), 10))
+	for _, m := range messages {
+		d.cache.Add(m.ID.Offset, now)
+	}
+	if d.store == nil {
+		return nil
+	}
+
+	var g errgroup.Group
+	g.SetLimit(maxConcurrentKVOps)
+	for _, m := range messages {
+		id := m.ID.Offset
+		g.Go(func() error {
+			if err := d.store.PutKV(ctx, encodeKey(id), value); err != nil {
+				return fmt.Errorf("failed to record message id %q, %w", id, err)
+			}
+			return nil
+		})
+	}
+	return g.Wait()
+}
+
+// Close closes the backing KV store.
+func (d *Deduplicator) Close() {
+	if d.store != nil {
+		d.store.Close()
+	}
+}
+
+// cached returns true if the id was recorded within the window according to the in-memory cache.
+func (d *Deduplicator) cached(id string) bool {
+	recordedAt, ok := d.cache.Get(id)
+	return ok && time.Since(recordedAt) < d.window
+}
+
+// seenInStore returns true if the id was recorded within the window according to the KV store.
+func (d *Deduplicator) seenInStore(ctx context.Context, id string) bool {
+	value, err := d.store.GetValue(ctx, encodeKey(id))
+	if err != nil {
+		if !errors.Is(err, kvs.ErrKeyNotFound) {
+			d.log.Warnw("Failed to look up message id in dedup store", zap.String("id", id), zap.Error(err))
+		}
+		return false
+	}
+	millis, err := strconv.ParseInt(string(value), 10, 64)
+	if err != nil {
+		d.log.Warnw("Invalid value in dedup store", zap.String("id", id), zap.ByteString("value", value))
+		return false
+	}
+	recordedAt := time.UnixMilli(millis)
+	if time.Since(recordedAt) >= d.window {
+		return false
+	}
+	d.cache.Add(id, recordedAt)
+	return true
+}
+
+// encodeKey encodes the message id to a key which is valid for all the KV store implementations,
+// message ids are supplied by the clients and can contain any character.
+func encodeKey(id string) string {
+	return base64.RawURLEncoding.EncodeToString([]byte(id))
+}
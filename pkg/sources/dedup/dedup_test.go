@@ -0,0 +1,116 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package dedup
+
+import (
+	"context"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/numaproj/numaflow/pkg/isb"
+	"github.com/numaproj/numaflow/pkg/shared/kvs/inmem"
+)
+
+func testMessages(ids ...string) []*isb.ReadMessage {
+	var result []*isb.ReadMessage
+	for _, id := range ids {
+		result = append(result, &isb.ReadMessage{
+			Message: isb.Message{
+				Header: isb.Header{ID: isb.MessageID{VertexName: "in", Offset: id}},
+			},
+		})
+	}
+	return result
+}
+
+func offsets(messages []*isb.ReadMessage) []string {
+	var result []string
+	for _, m := range messages {
+		result = append(result, m.ID.Offset)
+	}
+	return result
+}
+
+func TestDeduplicator_Filter(t *testing.T) {
+	ctx := context.Background()
+	store, _ := inmem.NewKVInMemKVStore(ctx, "test")
+	d, err := NewDeduplicator(ctx, store, time.Minute, 10)
+	require.NoError(t, err)
+	defer d.Close()
+
+	t.Run("duplicates in a batch", func(t *testing.T) {
+		assert.Equal(t, []string{"a", "b", "c"}, offsets(d.Filter(ctx, testMessages("a", "b", "a", "c", "b"))))
+	})
+
+	t.Run("recorded ids", func(t *testing.T) {
+		require.NoError(t, d.Record(ctx, testMessages("a", "b")))
+		assert.Equal(t, []string{"c"}, offsets(d.Filter(ctx, testMessages("a", "c", "b"))))
+	})
+
+	t.Run("ids with special characters", func(t *testing.T) {
+		require.NoError(t, d.Record(ctx, testMessages("x/y z.*>")))
+		assert.Empty(t, d.Filter(ctx, testMessages("x/y z.*>")))
+	})
+}
+
+func TestDeduplicator_SharedStore(t *testing.T) {
+	ctx := context.Background()
+	store, _ := inmem.NewKVInMemKVStore(ctx, "test")
+	d1, err := NewDeduplicator(ctx, store, time.Minute, 10)
+	require.NoError(t, err)
+	d2, err := NewDeduplicator(ctx, store, time.Minute, 10)
+	require.NoError(t, err)
+
+	require.NoError(t, d1.Record(ctx, testMessages("a")))
+	// d2 has never seen "a" in its own cache, it should be found in the store.
+	assert.Equal(t, []string{"b"}, offsets(d2.Filter(ctx, testMessages("a", "b"))))
+}
+
+func TestDeduplicator_Window(t *testing.T) {
+	ctx := context.Background()
+	store, _ := inmem.NewKVInMemKVStore(ctx, "test")
+	d, err := NewDeduplicator(ctx, store, time.Minute, 10)
+	require.NoError(t, err)
+
+	// recorded by another replica out of the window
+	require.NoError(t, store.PutKV(ctx, encodeKey("a"), []byte(strconv.FormatInt(time.Now().Add(-2*time.Minute).UnixMilli(), 10))))
+	// recorded by another replica within the window
+	require.NoError(t, store.PutKV(ctx, encodeKey("b"), []byte(strconv.FormatInt(time.Now().Add(-30*time.Second).UnixMilli(), 10))))
+	assert.Equal(t, []string{"a", "c"}, offsets(d.Filter(ctx, testMessages("a", "b", "c"))))
+}
+
+func TestDeduplicator_NoStore(t *testing.T) {
+	ctx := context.Background()
+	d, err := NewDeduplicator(ctx, nil, time.Minute, 2)
+	require.NoError(t, err)
+	defer d.Close()
+
+	require.NoError(t, d.Record(ctx, testMessages("a", "b", "c")))
+	// "a" has been evicted from the cache
+	assert.Equal(t, []string{"a", "d"}, offsets(d.Filter(ctx, testMessages("a", "b", "c", "d"))))
+}
+
+func TestNewDeduplicator(t *testing.T) {
+	_, err := NewDeduplicator(context.Background(), nil, 0, 10)
+	assert.Error(t, err)
+	_, err = NewDeduplicator(context.Background(), nil, time.Minute, 0)
+	assert.Error(t, err)
+}
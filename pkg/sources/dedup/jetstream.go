@@ -0,0 +1,112 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package dedup
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/nats-io/nats.go"
+	"go.uber.org/zap"
+
+	"github.com/numaproj/numaflow/pkg/isbsvc"
+	jsclient "github.com/numaproj/numaflow/pkg/shared/clients/nats"
+	"github.com/numaproj/numaflow/pkg/shared/kvs"
+	jetstreamkv "github.com/numaproj/numaflow/pkg/shared/kvs/jetstream"
+	"github.com/numaproj/numaflow/pkg/shared/logging"
+	wmstore "github.com/numaproj/numaflow/pkg/watermark/store"
+)
+
+// NewJetStreamStore returns the JetStream KV store backing the dedup window of a source vertex.
+// The KV is created by the source vertex if it doesn't exist, entries expire after the window, at most maxEntries
+// entries are kept, and it uses the same number of replicas as the watermark KVs of the source bucket. The limits of
+// an existing KV are updated if the window or maxEntries have changed.
+func NewJetStreamStore(ctx context.Context, client *jsclient.Client, sourceBucket string, window time.Duration, maxEntries int) (kvs.KVStorer, error) {
+	log := logging.FromContext(ctx)
+	js, err := client.JetStreamContext()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get a JetStream context from nats connection, %w", err)
+	}
+	kvName := isbsvc.JetStreamDedupKVName(sourceBucket)
+	kv, err := js.KeyValue(kvName)
+	if err != nil {
+		if !errors.Is(err, nats.ErrBucketNotFound) && !errors.Is(err, nats.ErrStreamNotFound) {
+			return nil, fmt.Errorf("failed to query information of KV %q, %w", kvName, err)
+		}
+		replicas := 1
+		if otKV, err := js.KeyValue(wmstore.JetStreamOTKVName(sourceBucket)); err == nil {
+			if status, err := otKV.Status(); err == nil {
+				if s, ok := status.(*nats.KeyValueBucketStatus); ok {
+					replicas = s.StreamInfo().Config.Replicas
+				}
+			}
+		}
+		// multiple replicas could race to create the KV, creating it with an identical config is idempotent, unless
+		// another replica has already updated its limits.
+		kv, err = js.CreateKeyValue(&nats.KeyValueConfig{
+			Bucket:   kvName,
+			History:  1,
+			TTL:      window,
+			Storage:  nats.FileStorage,
+			Replicas: replicas,
+		})
+		if errors.Is(err, nats.ErrStreamNameAlreadyInUse) {
+			kv, err = js.KeyValue(kvName)
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to create dedup KV %q, %w", kvName, err)
+		}
+		log.Infow("Succeeded to create a dedup KV", zap.String("kvName", kvName))
+	}
+	if err = updateLimits(log, js, kv, window, maxEntries); err != nil {
+		return nil, fmt.Errorf("failed to update the limits of dedup KV %q, %w", kvName, err)
+	}
+	return jetstreamkv.NewKVJetStreamKVStore(ctx, kvName, client)
+}
+
+// updateLimits updates the TTL of the KV to the window, and its max number of entries to maxEntries, if they are
+// different. A full KV rejects the new entries by default, it discards the oldest ones instead, so that the recently
+// forwarded IDs are always recorded.
+func updateLimits(log *zap.SugaredLogger, js nats.JetStreamContext, kv nats.KeyValue, window time.Duration, maxEntries int) error {
+	// the KV is backed by a stream, the TTL is the max age of the stream
+	streamName := "KV_" + kv.Bucket()
+	info, err := js.StreamInfo(streamName)
+	if err != nil {
+		return err
+	}
+	cfg := info.Config
+	if cfg.MaxAge == window && cfg.MaxMsgs == int64(maxEntries) && cfg.Discard == nats.DiscardOld {
+		return nil
+	}
+	from := cfg.MaxAge
+	cfg.MaxAge = window
+	cfg.MaxMsgs = int64(maxEntries)
+	cfg.Discard = nats.DiscardOld
+	// the duplicates window can't be larger than the max age, it is capped the same way as when the KV is created
+	cfg.Duplicates = 2 * time.Minute
+	if window < cfg.Duplicates {
+		cfg.Duplicates = window
+	}
+	if _, err = js.UpdateStream(&cfg); err != nil {
+		return err
+	}
+	log.Infow("Updated the limits of the dedup KV", zap.String("kvName", kv.Bucket()),
+		zap.Duration("fromTTL", from), zap.Duration("toTTL", window), zap.Int("maxEntries", maxEntries))
+	return nil
+}
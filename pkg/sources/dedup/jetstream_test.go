@@ -0,0 +1,80 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package dedup
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/nats-io/nats.go"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/numaproj/numaflow/pkg/isbsvc"
+	natsclient "github.com/numaproj/numaflow/pkg/shared/clients/nats"
+	natstest "github.com/numaproj/numaflow/pkg/shared/clients/nats/test"
+	"github.com/numaproj/numaflow/pkg/shared/kvs"
+)
+
+func TestNewJetStreamStore_UpdateLimits(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	s := natstest.RunJetStreamServer(t)
+	defer natstest.ShutdownJetStreamServer(t, s)
+
+	testClient := natsclient.NewTestClientWithServer(t, s)
+	defer testClient.Close()
+	js, err := testClient.JetStreamContext()
+	assert.NoError(t, err)
+
+	kvName := isbsvc.JetStreamDedupKVName("test-bucket")
+	config := func() nats.StreamConfig {
+		info, err := js.StreamInfo("KV_" + kvName)
+		assert.NoError(t, err)
+		return info.Config
+	}
+
+	store, err := NewJetStreamStore(ctx, testClient, "test-bucket", time.Minute, 100)
+	assert.NoError(t, err)
+	store.Close()
+	assert.Equal(t, time.Minute, config().MaxAge)
+	assert.Equal(t, int64(100), config().MaxMsgs)
+	assert.Equal(t, nats.DiscardOld, config().Discard)
+
+	// the limits follow the dedup window of the source
+	store, err = NewJetStreamStore(ctx, testClient, "test-bucket", time.Hour, 100)
+	assert.NoError(t, err)
+	store.Close()
+	assert.Equal(t, time.Hour, config().MaxAge)
+
+	store, err = NewJetStreamStore(ctx, testClient, "test-bucket", 30*time.Second, 3)
+	assert.NoError(t, err)
+	defer store.Close()
+	assert.Equal(t, 30*time.Second, config().MaxAge)
+	assert.Equal(t, int64(3), config().MaxMsgs)
+
+	// the oldest entries are discarded once the KV is full
+	for _, key := range []string{"a", "b", "c", "d"} {
+		assert.NoError(t, store.PutKV(ctx, key, []byte("1")))
+	}
+	_, err = store.GetValue(ctx, "a")
+	assert.ErrorIs(t, err, kvs.ErrKeyNotFound)
+	value, err := store.GetValue(ctx, "d")
+	assert.NoError(t, err)
+	assert.Equal(t, []byte("1"), value)
+}
@@ -0,0 +1,98 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package dedup
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+
+	"github.com/numaproj/numaflow/pkg/isbsvc"
+	redisclient "github.com/numaproj/numaflow/pkg/shared/clients/redis"
+	"github.com/numaproj/numaflow/pkg/shared/kvs"
+)
+
+// redisStore is the Redis store backing the dedup window of a source vertex. Unlike the Redis KV store for
+// watermarks and side inputs, each ID is kept in its own Redis key which expires after the window, so that
+// the store doesn't grow without bound. It can't be listed or watched, which the dedup window doesn't need.
+type redisStore struct {
+	kvName string
+	window time.Duration
+	client *redisclient.RedisClient
+}
+
+var _ kvs.KVStorer = (*redisStore)(nil)
+
+// NewRedisStore returns the Redis store backing the dedup window of a source vertex, the entries expire after the window.
+func NewRedisStore(client *redisclient.RedisClient, sourceBucket string, window time.Duration) kvs.KVStorer {
+	return &redisStore{
+		kvName: isbsvc.RedisDedupKVName(sourceBucket),
+		window: window,
+		client: client,
+	}
+}
+
+func (rs *redisStore) redisKey(k string) string {
+	return fmt.Sprintf("%s:%s", rs.kvName, k)
+}
+
+// GetAllKeys is not supported by the dedup store.
+func (rs *redisStore) GetAllKeys(context.Context) ([]string, error) {
+	return nil, fmt.Errorf("listing the keys is not supported by the Redis dedup store")
+}
+
+// DeleteKey deletes the key from the store.
+func (rs *redisStore) DeleteKey(ctx context.Context, k string) error {
+	return rs.client.Client.Del(ctx, rs.redisKey(k)).Err()
+}
+
+// PutKV puts an element to the store, it expires after the window.
+func (rs *redisStore) PutKV(ctx context.Context, k string, v []byte) error {
+	return rs.client.Client.Set(ctx, rs.redisKey(k), v, rs.window).Err()
+}
+
+// GetValue returns the value for a given key.
+func (rs *redisStore) GetValue(ctx context.Context, k string) ([]byte, error) {
+	val, err := rs.client.Client.Get(ctx, rs.redisKey(k)).Bytes()
+	if err != nil {
+		if errors.Is(err, redis.Nil) {
+			return []byte(""), fmt.Errorf("%w: %s", kvs.ErrKeyNotFound, k)
+		}
+		return []byte(""), err
+	}
+	return val, nil
+}
+
+// GetStoreName returns the store name.
+func (rs *redisStore) GetStoreName() string {
+	return rs.kvName
+}
+
+// Watch is not supported by the dedup store, the returned channel is closed immediately.
+func (rs *redisStore) Watch(context.Context) <-chan kvs.KVEntry {
+	updates := make(chan kvs.KVEntry)
+	close(updates)
+	return updates
+}
+
+// Close closes the Redis client, which is created for the dedup store.
+func (rs *redisStore) Close() {
+	rs.client.Close()
+}
@@ -0,0 +1,57 @@
+//go:build isb_redis
+
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package dedup
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+	"github.com/stretchr/testify/assert"
+
+	redisclient "github.com/numaproj/numaflow/pkg/shared/clients/redis"
+	"github.com/numaproj/numaflow/pkg/shared/kvs"
+)
+
+func TestRedisStore(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	client := redisclient.NewRedisClient(&redis.UniversalOptions{Addrs: []string{":6379"}})
+	store := NewRedisStore(client, "test-bucket", 500*time.Millisecond)
+	defer store.Close()
+
+	assert.NoError(t, store.PutKV(ctx, "id1", []byte("1")))
+	value, err := store.GetValue(ctx, "id1")
+	assert.NoError(t, err)
+	assert.Equal(t, []byte("1"), value)
+
+	// the entries expire after the window
+	assert.Eventually(t, func() bool {
+		_, err := store.GetValue(ctx, "id1")
+		return errors.Is(err, kvs.ErrKeyNotFound)
+	}, 5*time.Second, 100*time.Millisecond)
+
+	d, err := NewDeduplicator(ctx, store, time.Minute, 10)
+	assert.NoError(t, err)
+	assert.NoError(t, store.PutKV(ctx, encodeKey("id2"), []byte(strconv.FormatInt(time.Now().UnixMilli(), 10))))
+	assert.True(t, d.seenInStore(ctx, "id2"))
+}
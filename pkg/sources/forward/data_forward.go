@@ -32,6 +32,7 @@ import (
 	"github.com/numaproj/numaflow/pkg/metrics"
 	"github.com/numaproj/numaflow/pkg/shared/idlehandler"
 	"github.com/numaproj/numaflow/pkg/shared/logging"
+	"github.com/numaproj/numaflow/pkg/shared/tracing"
 	errors2 "github.com/numaproj/numaflow/pkg/sources/errors"
 	"github.com/numaproj/numaflow/pkg/sources/sourcer"
 	"github.com/numaproj/numaflow/pkg/watermark/entity"
@@ -217,6 +218,7 @@ func (df *DataForward) forwardAChunk(ctx context.Context) error {
 	// at-least-once semantics for reading, during the restart we will have to reprocess all unacknowledged messages. It is the
 	// responsibility of the Read function to do that.
 	readMessages, err := df.reader.Read(ctx, df.opts.readBatchSize)
+	readEnd := time.Now()
 	if err != nil {
 		df.opts.logger.Warnw("failed to read from source", zap.Error(err))
 		metrics.ReadMessagesError.With(metricLabelsWithPartition).Inc()
@@ -280,10 +282,43 @@ func (df *DataForward) forwardAChunk(ctx context.Context) error {
 	for idx, m := range readMessages {
 		totalBytes += len(m.Payload)
 		readOffsets[idx] = m.ReadOffset
+		// the late data fallback marker is only set by the reduce vertices, it can't be set by the clients of the source.
+		delete(m.Headers, dfv1.KeyMetaLateDataFallback)
 	}
 	metrics.ReadBytesCount.With(metricLabelsWithPartition).Add(float64(totalBytes))
 	metrics.ReadDataBytesCount.With(metricLabelsWithPartition).Add(float64(totalBytes))
 
+	// drop the messages which have already been forwarded within the dedup window,
+	// they are still acknowledged together with the rest of the batch.
+	if df.opts.deduplicator != nil {
+		deduped := df.opts.deduplicator.Filter(ctx, readMessages)
+		if dropped := len(readMessages) - len(deduped); dropped > 0 {
+			metrics.SourceDedupDroppedCount.With(metricLabelsWithPartition).Add(float64(dropped))
+		}
+		readMessages = deduped
+		// the whole batch is duplicate, nothing to forward.
+		if len(readMessages) == 0 {
+			if err = df.ackFromSource(ctx, readOffsets); err != nil {
+				df.opts.logger.Errorw("failed to ack duplicate messages from source", zap.Error(err))
+				metrics.AckMessageError.With(metricLabelsWithPartition).Add(float64(len(readOffsets)))
+				var ackErr = new(errors2.SourceAckErr)
+				if errors.As(err, &ackErr) && !ackErr.IsRetryable() {
+					return err
+				}
+				return nil
+			}
+			metrics.AckMessagesCount.With(metricLabelsWithPartition).Add(float64(len(readOffsets)))
+			return nil
+		}
+	}
+
+	// trace the batch from the start of the read, the trace context found in the source headers (e.g., the HTTP request
+	// headers, or the Kafka record headers) is continued, and the messages will carry the context of the batch span.
+	ctx, span := tracing.StartBatchSpan(ctx, "source.forward", readStart, readMessages,
+		append(tracing.VertexAttributes(df.pipelineName, df.vertexName, df.vertexReplica), tracing.AttributePartition.String(df.reader.GetName()))...)
+	defer span.End()
+	tracing.RecordSpan(ctx, "source.read", readStart, readEnd)
+
 	// source data transformer applies filtering and assigns event time to source data, which doesn't require watermarks.
 	// hence we assign time.UnixMilli(-1) to processorWM.
 	processorWM := wmb.Watermark(time.UnixMilli(-1))
@@ -307,7 +342,9 @@ func (df *DataForward) forwardAChunk(ctx context.Context) error {
 
 		metrics.SourceTransformerReadMessagesCount.With(metricLabelsWithPartition).Add(float64(len(readMessages)))
 		transformerProcessingStart := time.Now()
+		_, transformerSpan := tracing.StartSpan(ctx, "source.transform")
 		readWriteMessagePairs, err = df.applyTransformer(ctx, readMessages)
+		tracing.EndSpan(transformerSpan, err)
 		if err != nil {
 			metrics.SourceTransformerError.With(metricLabelsWithPartition).Inc()
 			df.opts.logger.Errorw("failed to apply source transformer", zap.Error(err))
@@ -384,12 +421,21 @@ func (df *DataForward) forwardAChunk(ctx context.Context) error {
 	}
 
 	// forward the messages to the edge buffer (could be multiple edges)
+	_, writeSpan := tracing.StartSpan(ctx, "isb.write")
 	writeOffsets, err = df.writeToBuffers(ctx, messageToStep)
+	tracing.EndSpan(writeSpan, err)
 	if err != nil {
 		df.opts.logger.Errorw("failed to write to toBuffers", zap.Error(err))
 		return err
 	}
 
+	// record the forwarded messages before acknowledging, so that a redelivery after this point is dropped.
+	if df.opts.deduplicator != nil {
+		if err = df.opts.deduplicator.Record(ctx, readMessages); err != nil {
+			df.opts.logger.Warnw("failed to record the forwarded messages for dedup", zap.Error(err))
+		}
+	}
+
 	// activeWatermarkBuffers records the buffers that the publisher has published
 	// a watermark in this batch processing cycle.
 	// It's used to determine which buffers should receive an idle watermark.
@@ -623,7 +669,7 @@ func (df *DataForward) applyTransformer(ctx context.Context, messages []*isb.Rea
 // whereToStep executes the WhereTo interfaces and then updates the to step's writeToBuffers buffer.
 func (df *DataForward) whereToStep(writeMessage *isb.WriteMessage, messageToStep map[string][][]isb.Message) error {
 	// call WhereTo and drop it on errors
-	to, err := df.toWhichStepDecider.WhereTo(writeMessage.Keys, writeMessage.Tags, writeMessage.ID.String())
+	to, err := df.toWhichStepDecider.WhereTo(writeMessage.Keys, writeMessage.Tags, writeMessage.ID.String(), &writeMessage.Message)
 	if err != nil {
 		df.opts.logger.Errorw("failed in whereToStep", zap.Error(isb.MessageWriteErr{
 			Name:    df.reader.GetName(),
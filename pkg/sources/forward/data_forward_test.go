@@ -36,6 +36,7 @@ import (
 	"github.com/numaproj/numaflow/pkg/isb/testutils"
 	"github.com/numaproj/numaflow/pkg/metrics"
 	"github.com/numaproj/numaflow/pkg/shared/logging"
+	"github.com/numaproj/numaflow/pkg/sources/dedup"
 	udfapplier "github.com/numaproj/numaflow/pkg/udf/rpc"
 	"github.com/numaproj/numaflow/pkg/watermark/generic"
 	wmstore "github.com/numaproj/numaflow/pkg/watermark/store"
@@ -114,7 +115,7 @@ func (t *testForwardFetcher) ComputeHeadWatermark(int32) wmb.Watermark {
 type myForwardTest struct {
 }
 
-func (f myForwardTest) WhereTo(_ []string, _ []string, s string) ([]forwarder.VertexBuffer, error) {
+func (f myForwardTest) WhereTo(_ []string, _ []string, s string, _ *isb.Message) ([]forwarder.VertexBuffer, error) {
 	return []forwarder.VertexBuffer{{
 		ToVertexName:         "to1",
 		ToVertexPartitionIdx: 0,
@@ -844,7 +845,7 @@ func TestNewDataForward(t *testing.T) {
 type mySourceForwardTest struct {
 }
 
-func (f mySourceForwardTest) WhereTo(_ []string, _ []string, s string) ([]forwarder.VertexBuffer, error) {
+func (f mySourceForwardTest) WhereTo(_ []string, _ []string, s string, _ *isb.Message) ([]forwarder.VertexBuffer, error) {
 	return []forwarder.VertexBuffer{{
 		ToVertexName:         "to1",
 		ToVertexPartitionIdx: 0,
@@ -855,7 +856,7 @@ type mySourceForwardTestRoundRobin struct {
 	count int
 }
 
-func (f *mySourceForwardTestRoundRobin) WhereTo(_ []string, _ []string, s string) ([]forwarder.VertexBuffer, error) {
+func (f *mySourceForwardTestRoundRobin) WhereTo(_ []string, _ []string, s string, _ *isb.Message) ([]forwarder.VertexBuffer, error) {
 	var output = []forwarder.VertexBuffer{{
 		ToVertexName:         "to1",
 		ToVertexPartitionIdx: int32(f.count % 2),
@@ -961,6 +962,100 @@ func TestDataForwardSinglePartition(t *testing.T) {
 	<-stopped
 }
 
+func TestDataForwardDedup(t *testing.T) {
+	fromStep := NewSimpleSource(simplebuffer.NewInMemoryBuffer("from", 25, 0))
+	to1 := simplebuffer.NewInMemoryBuffer("to1", 10, 0, simplebuffer.WithReadTimeOut(time.Second*10))
+	toSteps := map[string][]isb.BufferWriter{
+		"to1": {to1},
+	}
+	vertex := &dfv1.Vertex{Spec: dfv1.VertexSpec{
+		PipelineName: "testPipeline",
+		AbstractVertex: dfv1.AbstractVertex{
+			Name: "receivingVertex",
+		},
+	}}
+	vertexInstance := &dfv1.VertexInstance{
+		Vertex:  vertex,
+		Replica: 0,
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*10)
+	defer cancel()
+
+	writeMessages := testutils.BuildTestWriteMessages(int64(20), testStartTime, nil, "testVertex")
+	for i := range writeMessages {
+		writeMessages[i].ID.Offset = fmt.Sprintf("id-%d", i)
+	}
+	fetchWatermark := &testForwardFetcher{}
+	toVertexStores := buildNoOpToVertexStores(toSteps)
+
+	deduplicator, err := dedup.NewDeduplicator(ctx, nil, time.Minute, 10)
+	assert.NoError(t, err)
+	idleManager, _ := wmb.NewIdleManager(1, len(toSteps))
+	f, err := NewDataForward(vertexInstance, fromStep, toSteps, mySourceForwardTest{}, fetchWatermark, TestSourceWatermarkPublisher{}, toVertexStores, idleManager, WithReadBatchSize(5), WithDeduplicator(deduplicator))
+	assert.NoError(t, err)
+
+	dropped := metrics.SourceDedupDroppedCount.WithLabelValues("receivingVertex", "testPipeline", "Source", "0", "from")
+	droppedBefore := testutil.ToFloat64(dropped)
+
+	stopped := f.Start()
+	_, errs := fromStep.Write(ctx, writeMessages[0:2])
+	assert.Equal(t, make([]error, 2), errs)
+	readMessages, err := to1.Read(ctx, 2)
+	assert.NoError(t, err)
+	assert.Len(t, readMessages, 2)
+
+	// retry the first two messages together with a new one, only the new one is forwarded.
+	_, errs = fromStep.Write(ctx, writeMessages[0:3])
+	assert.Equal(t, make([]error, 3), errs)
+	readMessages, err = to1.Read(ctx, 1)
+	assert.NoError(t, err)
+	assert.Len(t, readMessages, 1)
+	assert.Equal(t, writeMessages[2].Header.ID, readMessages[0].Header.ID)
+	assert.True(t, to1.IsEmpty())
+	assert.Eventually(t, func() bool {
+		return testutil.ToFloat64(dropped)-droppedBefore == 2
+	}, time.Second*5, time.Millisecond*10)
+
+	f.Stop()
+	<-stopped
+}
+
+func TestDataForwardStripsLateDataFallback(t *testing.T) {
+	fromStep := NewSimpleSource(simplebuffer.NewInMemoryBuffer("from", 25, 0))
+	to1 := simplebuffer.NewInMemoryBuffer("to1", 10, 0, simplebuffer.WithReadTimeOut(time.Second*10))
+	toSteps := map[string][]isb.BufferWriter{
+		"to1": {to1},
+	}
+	vertexInstance := &dfv1.VertexInstance{
+		Vertex: &dfv1.Vertex{Spec: dfv1.VertexSpec{
+			PipelineName:   "testPipeline",
+			AbstractVertex: dfv1.AbstractVertex{Name: "receivingVertex"},
+		}},
+		Replica: 0,
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*10)
+	defer cancel()
+
+	writeMessages := testutils.BuildTestWriteMessages(int64(1), testStartTime, nil, "testVertex")
+	writeMessages[0].Headers = map[string]string{dfv1.KeyMetaLateDataFallback: "true", "x-custom": "value"}
+	toVertexStores := buildNoOpToVertexStores(toSteps)
+	idleManager, _ := wmb.NewIdleManager(1, len(toSteps))
+	f, err := NewDataForward(vertexInstance, fromStep, toSteps, mySourceForwardTest{}, &testForwardFetcher{}, TestSourceWatermarkPublisher{}, toVertexStores, idleManager, WithReadBatchSize(5))
+	assert.NoError(t, err)
+
+	stopped := f.Start()
+	_, errs := fromStep.Write(ctx, writeMessages)
+	assert.Equal(t, make([]error, 1), errs)
+	readMessages, err := to1.Read(ctx, 1)
+	assert.NoError(t, err)
+	assert.Len(t, readMessages, 1)
+	assert.Equal(t, "value", readMessages[0].Headers["x-custom"])
+	assert.NotContains(t, readMessages[0].Headers, dfv1.KeyMetaLateDataFallback)
+
+	f.Stop()
+	<-stopped
+}
+
 func TestDataForwardMultiplePartition(t *testing.T) {
 	fromStep := NewSimpleSource(simplebuffer.NewInMemoryBuffer("from", 25, 0))
 	to11 := simplebuffer.NewInMemoryBuffer("to1-0", 10, 0, simplebuffer.WithReadTimeOut(time.Second*10))
@@ -1156,7 +1251,7 @@ func TestWriteToBuffer(t *testing.T) {
 type myForwardDropTest struct {
 }
 
-func (f myForwardDropTest) WhereTo(_ []string, _ []string, s string) ([]forwarder.VertexBuffer, error) {
+func (f myForwardDropTest) WhereTo(_ []string, _ []string, s string, _ *isb.Message) ([]forwarder.VertexBuffer, error) {
 	return []forwarder.VertexBuffer{}, nil
 }
 
@@ -1168,7 +1263,7 @@ type myForwardToAllTest struct {
 	count int
 }
 
-func (f *myForwardToAllTest) WhereTo(_ []string, _ []string, s string) ([]forwarder.VertexBuffer, error) {
+func (f *myForwardToAllTest) WhereTo(_ []string, _ []string, s string, _ *isb.Message) ([]forwarder.VertexBuffer, error) {
 	var output = []forwarder.VertexBuffer{{
 		ToVertexName:         "to1",
 		ToVertexPartitionIdx: int32(f.count % 2),
@@ -1188,7 +1283,7 @@ func (f *myForwardToAllTest) ApplyTransform(ctx context.Context, messages []*isb
 type myForwardInternalErrTest struct {
 }
 
-func (f myForwardInternalErrTest) WhereTo(_ []string, _ []string, s string) ([]forwarder.VertexBuffer, error) {
+func (f myForwardInternalErrTest) WhereTo(_ []string, _ []string, s string, _ *isb.Message) ([]forwarder.VertexBuffer, error) {
 	return []forwarder.VertexBuffer{{
 		ToVertexName:         "to1",
 		ToVertexPartitionIdx: 0,
@@ -1209,7 +1304,7 @@ func (f myForwardInternalErrTest) ApplyTransform(ctx context.Context, _ []*isb.R
 type myForwardApplyWhereToErrTest struct {
 }
 
-func (f myForwardApplyWhereToErrTest) WhereTo(_ []string, _ []string, s string) ([]forwarder.VertexBuffer, error) {
+func (f myForwardApplyWhereToErrTest) WhereTo(_ []string, _ []string, s string, _ *isb.Message) ([]forwarder.VertexBuffer, error) {
 	return []forwarder.VertexBuffer{{
 		ToVertexName:         "to1",
 		ToVertexPartitionIdx: 0,
@@ -1223,7 +1318,7 @@ func (f myForwardApplyWhereToErrTest) ApplyTransform(ctx context.Context, messag
 type myForwardApplyTransformerErrTest struct {
 }
 
-func (f myForwardApplyTransformerErrTest) WhereTo(_ []string, _ []string, s string) ([]forwarder.VertexBuffer, error) {
+func (f myForwardApplyTransformerErrTest) WhereTo(_ []string, _ []string, s string, _ *isb.Message) ([]forwarder.VertexBuffer, error) {
 	return []forwarder.VertexBuffer{{
 		ToVertexName:         "to1",
 		ToVertexPartitionIdx: 0,
@@ -24,6 +24,7 @@ import (
 	dfv1 "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1"
 	"github.com/numaproj/numaflow/pkg/shared/callback"
 	"github.com/numaproj/numaflow/pkg/shared/logging"
+	"github.com/numaproj/numaflow/pkg/sources/dedup"
 	"github.com/numaproj/numaflow/pkg/sources/forward/applier"
 )
 
@@ -41,6 +42,8 @@ type options struct {
 	logger *zap.SugaredLogger
 	// cbPublisher is the callback publisher for the vertex.
 	cbPublisher *callback.Uploader
+	// deduplicator drops the messages already forwarded within the dedup window.
+	deduplicator *dedup.Deduplicator
 }
 
 type Option func(*options) error
@@ -102,3 +105,11 @@ func WithCallbackUploader(cp *callback.Uploader) Option {
 		return nil
 	}
 }
+
+// WithDeduplicator sets the deduplicator to drop the duplicate messages read from the source
+func WithDeduplicator(d *dedup.Deduplicator) Option {
+	return func(o *options) error {
+		o.deduplicator = d
+		return nil
+	}
+}
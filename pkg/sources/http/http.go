@@ -17,14 +17,17 @@ limitations under the License.
 package http
 
 import (
+	"bytes"
 	"context"
 	"crypto/tls"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"io"
 	"net/http"
 	"strconv"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/google/uuid"
@@ -39,6 +42,9 @@ import (
 	"github.com/numaproj/numaflow/pkg/sources/sourcer"
 )
 
+// retryAfterSeconds is the Retry-After header of the requests rejected with DurableAck.
+const retryAfterSeconds = "1"
+
 type httpSource struct {
 	vertexName    string
 	pipelineName  string
@@ -46,9 +52,26 @@ type httpSource struct {
 	ready         atomic.Bool
 	readTimeout   time.Duration
 	bufferSize    int
-	messages      chan *isb.ReadMessage
-	logger        *zap.SugaredLogger
-	shutdown      func(context.Context) error
+	auth          string
+	// durableAck makes the requests wait until their messages are acknowledged, i.e. written to the ISB.
+	durableAck bool
+	ackTimeout time.Duration
+	messages   chan *isb.ReadMessage
+	// enqueueLock makes checking the free space of the messages channel and sending a request's messages to it atomic.
+	enqueueLock sync.Mutex
+	// pendingLock protects pending.
+	pendingLock sync.Mutex
+	// pending tracks the requests waiting for their messages to be acknowledged, keyed by the offsets of the messages.
+	pending  map[string][]*pendingRequest
+	logger   *zap.SugaredLogger
+	shutdown func(context.Context) error
+}
+
+// pendingRequest is a request waiting for its messages to be acknowledged.
+type pendingRequest struct {
+	// remaining is the number of messages not acknowledged yet, protected by httpSource.pendingLock.
+	remaining int
+	done      chan struct{}
 }
 
 type Option func(*httpSource) error
@@ -70,6 +93,7 @@ func WithBufferSize(s int) Option {
 
 // NewHttpSource creates a new http source reader.
 func NewHttpSource(ctx context.Context, vertexInstance *dfv1.VertexInstance, opts ...Option) (sourcer.SourceReader, error) {
+	httpSpec := vertexInstance.Vertex.Spec.Source.HTTP
 	h := &httpSource{
 		vertexName:    vertexInstance.Vertex.Spec.Name,
 		pipelineName:  vertexInstance.Vertex.Spec.PipelineName,
@@ -77,6 +101,9 @@ func NewHttpSource(ctx context.Context, vertexInstance *dfv1.VertexInstance, opt
 		ready:         atomic.Bool{},
 		bufferSize:    1000,            // default size
 		readTimeout:   1 * time.Second, // default timeout
+		durableAck:    httpSpec.DurableAck,
+		ackTimeout:    httpSpec.GetAckTimeout(),
+		pending:       make(map[string][]*pendingRequest),
 		logger:        logging.FromContext(ctx),
 	}
 
@@ -88,12 +115,11 @@ func NewHttpSource(ctx context.Context, vertexInstance *dfv1.VertexInstance, opt
 
 	h.messages = make(chan *isb.ReadMessage, h.bufferSize)
 
-	auth := ""
-	if x := vertexInstance.Vertex.Spec.Source.HTTP.Auth; x != nil && x.Token != nil {
+	if x := httpSpec.Auth; x != nil && x.Token != nil {
 		if s, err := sharedutil.GetSecretFromVolume(x.Token); err != nil {
 			return nil, fmt.Errorf("failed to get auth token, %w", err)
 		} else {
-			auth = s
+			h.auth = s
 		}
 	}
 	mux := http.NewServeMux()
@@ -112,8 +138,34 @@ func NewHttpSource(ctx context.Context, vertexInstance *dfv1.VertexInstance, opt
 		}
 		w.WriteHeader(http.StatusNoContent)
 	})
-	mux.HandleFunc("/vertices/"+vertexInstance.Vertex.Spec.Name, func(w http.ResponseWriter, r *http.Request) {
-		if auth != "" && r.Header.Get("Authorization") != "Bearer "+auth {
+	mux.HandleFunc("/vertices/"+vertexInstance.Vertex.Spec.Name, h.handler(false))
+	// the batch endpoint accepts multiple messages in one request, either NDJSON or a JSON array
+	mux.HandleFunc("/vertices/"+vertexInstance.Vertex.Spec.Name+"/batch", h.handler(true))
+	cer, err := sharedtls.GenerateX509KeyPair()
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate cert: %w", err)
+	}
+	server := &http.Server{
+		Addr:      fmt.Sprintf(":%d", dfv1.VertexHTTPSPort),
+		Handler:   mux,
+		TLSConfig: &tls.Config{Certificates: []tls.Certificate{*cer}, MinVersion: tls.VersionTLS12},
+	}
+	go func() {
+		h.logger.Info("Starting http source server")
+		if err := server.ListenAndServeTLS("", ""); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			h.logger.Fatalw("Failed to listen-and-server on http source server", zap.Error(err))
+		}
+		h.logger.Info("Shutdown http source server")
+	}()
+	h.shutdown = server.Shutdown
+	h.ready.Store(true)
+	return h, nil
+}
+
+// handler returns the handler of the single message endpoint, or the batch endpoint.
+func (h *httpSource) handler(batch bool) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if h.auth != "" && r.Header.Get("Authorization") != "Bearer "+h.auth {
 			http.Error(w, "request not authorized", http.StatusForbidden)
 			return
 		}
@@ -121,76 +173,183 @@ func NewHttpSource(ctx context.Context, vertexInstance *dfv1.VertexInstance, opt
 			http.Error(w, "http source not ready", http.StatusServiceUnavailable)
 			return
 		}
-		msg, err := io.ReadAll(r.Body)
+		body, err := io.ReadAll(r.Body)
 		_ = r.Body.Close()
 		if err != nil {
 			http.Error(w, err.Error(), http.StatusBadRequest)
 			return
 		}
-
-		id := r.Header.Get(dfv1.KeyMetaID)
-		if id == "" {
-			id = uuid.New().String()
-		}
-		eventTime := time.Now()
-		if x := r.Header.Get(dfv1.KeyMetaEventTime); x != "" {
-			i, err := strconv.ParseInt(x, 10, 64)
-			if err != nil {
+		payloads := [][]byte{body}
+		if batch {
+			if payloads, err = splitBatch(body); err != nil {
 				http.Error(w, err.Error(), http.StatusBadRequest)
 				return
 			}
-			eventTime = time.UnixMilli(i)
+			if len(payloads) == 0 {
+				http.Error(w, "no messages in the batch", http.StatusBadRequest)
+				return
+			}
 		}
+		msgs, err := h.buildMessages(r.Header, payloads, batch)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		if !h.durableAck {
+			for _, m := range msgs {
+				h.messages <- m
+			}
+			w.WriteHeader(http.StatusNoContent)
+			return
+		}
+		h.enqueueAndWait(w, r, msgs)
+	}
+}
 
-		// we don't need to consider event time in the header
-		r.Header.Del(dfv1.KeyMetaEventTime)
-		// TODO(security): Auth headers will be passed
-		// https://github.com/numaproj/numaflow/issues/1583
-		headers := make(map[string]string, len(r.Header))
-		for k, v := range r.Header {
-			// multi-value headers are joined with ","
-			headers[k] = strings.Join(v, ",")
+// buildMessages builds the messages of a request. The ID in the header is used as the ID of the message, or suffixed
+// with the index of the message for a batch.
+func (h *httpSource) buildMessages(header http.Header, payloads [][]byte, batch bool) ([]*isb.ReadMessage, error) {
+	id := header.Get(dfv1.KeyMetaID)
+	eventTime := time.Now()
+	if x := header.Get(dfv1.KeyMetaEventTime); x != "" {
+		i, err := strconv.ParseInt(x, 10, 64)
+		if err != nil {
+			return nil, err
 		}
+		eventTime = time.UnixMilli(i)
+	}
 
-		m := &isb.ReadMessage{
+	// we don't need to consider event time in the header
+	header.Del(dfv1.KeyMetaEventTime)
+	// TODO(security): Auth headers will be passed
+	// https://github.com/numaproj/numaflow/issues/1583
+	headers := make(map[string]string, len(header))
+	for k, v := range header {
+		// multi-value headers are joined with ","
+		headers[k] = strings.Join(v, ",")
+	}
+
+	msgs := make([]*isb.ReadMessage, 0, len(payloads))
+	for i, payload := range payloads {
+		msgID := id
+		if msgID == "" {
+			msgID = uuid.New().String()
+		} else if batch {
+			msgID = fmt.Sprintf("%s-%d", id, i)
+		}
+		msgs = append(msgs, &isb.ReadMessage{
 			Message: isb.Message{
 				Header: isb.Header{
 					MessageInfo: isb.MessageInfo{EventTime: eventTime},
 					ID: isb.MessageID{
 						VertexName: h.vertexName,
-						Offset:     id,
+						Offset:     msgID,
 						Index:      h.vertexReplica,
 					},
 					Headers: headers,
 				},
 				Body: isb.Body{
-					Payload: msg,
+					Payload: payload,
 				},
 			},
-			ReadOffset: isb.NewSimpleStringPartitionOffset(id, vertexInstance.Replica),
-		}
+			ReadOffset: isb.NewSimpleStringPartitionOffset(msgID, h.vertexReplica),
+		})
+	}
+	return msgs, nil
+}
+
+// enqueueAndWait sends the messages of a request to the messages channel, and waits until all of them are acknowledged.
+// The request is rejected right away if the channel doesn't have enough space for the messages.
+func (h *httpSource) enqueueAndWait(w http.ResponseWriter, r *http.Request, msgs []*isb.ReadMessage) {
+	if len(msgs) > h.bufferSize {
+		http.Error(w, fmt.Sprintf("too many messages in the batch, the max is %d", h.bufferSize), http.StatusRequestEntityTooLarge)
+		return
+	}
+	h.enqueueLock.Lock()
+	if cap(h.messages)-len(h.messages) < len(msgs) {
+		h.enqueueLock.Unlock()
+		w.Header().Set("Retry-After", retryAfterSeconds)
+		http.Error(w, "http source is busy", http.StatusTooManyRequests)
+		return
+	}
+	// track the request before sending the messages, so that the acks can't come before the tracking
+	req := h.track(msgs)
+	for _, m := range msgs {
 		h.messages <- m
+	}
+	h.enqueueLock.Unlock()
+
+	timer := time.NewTimer(h.ackTimeout)
+	defer timer.Stop()
+	select {
+	case <-req.done:
 		w.WriteHeader(http.StatusNoContent)
-	})
-	cer, err := sharedtls.GenerateX509KeyPair()
-	if err != nil {
-		return nil, fmt.Errorf("failed to generate cert: %w", err)
+	case <-timer.C:
+		w.Header().Set("Retry-After", retryAfterSeconds)
+		http.Error(w, "timed out waiting for the messages to be written", http.StatusServiceUnavailable)
+	case <-r.Context().Done():
+		h.logger.Debugw("Request cancelled before the messages were written", zap.Error(r.Context().Err()))
 	}
-	server := &http.Server{
-		Addr:      fmt.Sprintf(":%d", dfv1.VertexHTTPSPort),
-		Handler:   mux,
-		TLSConfig: &tls.Config{Certificates: []tls.Certificate{*cer}, MinVersion: tls.VersionTLS12},
+}
+
+// track starts tracking a request waiting for the messages to be acknowledged.
+func (h *httpSource) track(msgs []*isb.ReadMessage) *pendingRequest {
+	req := &pendingRequest{remaining: len(msgs), done: make(chan struct{})}
+	h.pendingLock.Lock()
+	defer h.pendingLock.Unlock()
+	for _, m := range msgs {
+		key := m.ReadOffset.String()
+		h.pending[key] = append(h.pending[key], req)
 	}
-	go func() {
-		h.logger.Info("Starting http source server")
-		if err := server.ListenAndServeTLS("", ""); err != nil && !errors.Is(err, http.ErrServerClosed) {
-			h.logger.Fatalw("Failed to listen-and-server on http source server", zap.Error(err))
+	return req
+}
+
+// release marks the messages as acknowledged, and releases the requests whose messages are all acknowledged. The
+// messages with the same offset, e.g. sent with the same ID, are released in the order they were sent.
+func (h *httpSource) release(offsets []isb.Offset) {
+	h.pendingLock.Lock()
+	defer h.pendingLock.Unlock()
+	for _, o := range offsets {
+		key := o.String()
+		reqs := h.pending[key]
+		if len(reqs) == 0 {
+			continue
 		}
-		h.logger.Info("Shutdown http source server")
-	}()
-	h.shutdown = server.Shutdown
-	h.ready.Store(true)
-	return h, nil
+		if len(reqs) == 1 {
+			delete(h.pending, key)
+		} else {
+			h.pending[key] = reqs[1:]
+		}
+		req := reqs[0]
+		req.remaining--
+		if req.remaining == 0 {
+			close(req.done)
+		}
+	}
+}
+
+// splitBatch splits the body of a batch request into the payloads of the messages, the body is either a JSON array,
+// whose elements are the payloads, or NDJSON, whose non-empty lines are the payloads.
+func splitBatch(body []byte) ([][]byte, error) {
+	trimmed := bytes.TrimSpace(body)
+	if len(trimmed) > 0 && trimmed[0] == '[' {
+		var elements []json.RawMessage
+		if err := json.Unmarshal(trimmed, &elements); err != nil {
+			return nil, fmt.Errorf("invalid JSON array, %w", err)
+		}
+		payloads := make([][]byte, 0, len(elements))
+		for _, e := range elements {
+			payloads = append(payloads, []byte(e))
+		}
+		return payloads, nil
+	}
+	var payloads [][]byte
+	for _, line := range bytes.Split(trimmed, []byte("\n")) {
+		if line = bytes.TrimSpace(line); len(line) > 0 {
+			payloads = append(payloads, line)
+		}
+	}
+	return payloads, nil
 }
 
 // GetName returns the name of the source.
@@ -224,7 +383,12 @@ func (h *httpSource) Pending(_ context.Context) (int64, error) {
 	return isb.PendingNotAvailable, nil
 }
 
+// Ack releases the requests waiting for the messages with DurableAck, the messages are acknowledged once they are
+// written to the ISB.
 func (h *httpSource) Ack(_ context.Context, offsets []isb.Offset) []error {
+	if h.durableAck {
+		h.release(offsets)
+	}
 	return make([]error, len(offsets))
 }
 
@@ -18,12 +18,17 @@ package http
 
 import (
 	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
 	"testing"
 	"time"
 
 	"github.com/stretchr/testify/assert"
+	"go.uber.org/zap"
 
 	dfv1 "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1"
+	"github.com/numaproj/numaflow/pkg/isb"
 )
 
 func TestWithBufferSize(t *testing.T) {
@@ -67,3 +72,106 @@ func Test_NewHTTP(t *testing.T) {
 	assert.NotNil(t, h.(*httpSource).shutdown)
 	assert.True(t, h.(*httpSource).ready.Load())
 }
+
+func newTestHTTPSource(durableAck bool, bufferSize int, ackTimeout time.Duration) *httpSource {
+	h := &httpSource{
+		vertexName:  "test-v",
+		readTimeout: 100 * time.Millisecond,
+		bufferSize:  bufferSize,
+		durableAck:  durableAck,
+		ackTimeout:  ackTimeout,
+		messages:    make(chan *isb.ReadMessage, bufferSize),
+		pending:     make(map[string][]*pendingRequest),
+		logger:      zap.NewNop().Sugar(),
+	}
+	h.ready.Store(true)
+	return h
+}
+
+func Test_splitBatch(t *testing.T) {
+	payloads, err := splitBatch([]byte(` [{"a": 1}, {"b": [2, 3]}, "c"] `))
+	assert.NoError(t, err)
+	assert.Equal(t, [][]byte{[]byte(`{"a": 1}`), []byte(`{"b": [2, 3]}`), []byte(`"c"`)}, payloads)
+
+	payloads, err = splitBatch([]byte("{\"a\": 1}\n\n{\"b\": 2}\r\n"))
+	assert.NoError(t, err)
+	assert.Equal(t, [][]byte{[]byte(`{"a": 1}`), []byte(`{"b": 2}`)}, payloads)
+
+	_, err = splitBatch([]byte(`[{"a": 1}`))
+	assert.Error(t, err)
+}
+
+func Test_handler_Batch(t *testing.T) {
+	h := newTestHTTPSource(false, 10, time.Second)
+	req := httptest.NewRequest(http.MethodPost, "/vertices/test-v/batch", strings.NewReader("a\nb\nc"))
+	req.Header.Set(dfv1.KeyMetaID, "my-id")
+	req.Header.Set(dfv1.KeyMetaEventTime, "1661169600000")
+	w := httptest.NewRecorder()
+	h.handler(true)(w, req)
+	assert.Equal(t, http.StatusNoContent, w.Code)
+
+	msgs, err := h.Read(context.Background(), 10)
+	assert.NoError(t, err)
+	assert.Len(t, msgs, 3)
+	for i, id := range []string{"my-id-0", "my-id-1", "my-id-2"} {
+		assert.Equal(t, id, msgs[i].ID.Offset)
+		assert.Equal(t, time.UnixMilli(1661169600000), msgs[i].EventTime)
+	}
+	assert.Equal(t, []byte("b"), msgs[1].Payload)
+
+	w = httptest.NewRecorder()
+	h.handler(true)(w, httptest.NewRequest(http.MethodPost, "/vertices/test-v/batch", strings.NewReader(" ")))
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+}
+
+func Test_handler_DurableAck(t *testing.T) {
+	t.Run("acknowledged", func(t *testing.T) {
+		h := newTestHTTPSource(true, 10, 5*time.Second)
+		w := httptest.NewRecorder()
+		done := make(chan struct{})
+		go func() {
+			defer close(done)
+			h.handler(true)(w, httptest.NewRequest(http.MethodPost, "/vertices/test-v/batch", strings.NewReader(`[1, 2]`)))
+		}()
+
+		var msgs []*isb.ReadMessage
+		for len(msgs) < 2 {
+			m, err := h.Read(context.Background(), 2)
+			assert.NoError(t, err)
+			msgs = append(msgs, m...)
+		}
+		errs := h.Ack(context.Background(), []isb.Offset{msgs[0].ReadOffset})
+		assert.Equal(t, []error{nil}, errs)
+		select {
+		case <-done:
+			t.Fatal("request returned before all the messages were acknowledged")
+		case <-time.After(50 * time.Millisecond):
+		}
+		h.Ack(context.Background(), []isb.Offset{msgs[1].ReadOffset})
+		<-done
+		assert.Equal(t, http.StatusNoContent, w.Code)
+		assert.Empty(t, h.pending)
+	})
+
+	t.Run("busy", func(t *testing.T) {
+		h := newTestHTTPSource(true, 2, 5*time.Second)
+		h.messages <- &isb.ReadMessage{}
+		w := httptest.NewRecorder()
+		h.handler(true)(w, httptest.NewRequest(http.MethodPost, "/vertices/test-v/batch", strings.NewReader("a\nb")))
+		assert.Equal(t, http.StatusTooManyRequests, w.Code)
+		assert.Equal(t, retryAfterSeconds, w.Header().Get("Retry-After"))
+		assert.Len(t, h.messages, 1)
+
+		w = httptest.NewRecorder()
+		h.handler(true)(w, httptest.NewRequest(http.MethodPost, "/vertices/test-v/batch", strings.NewReader("a\nb\nc")))
+		assert.Equal(t, http.StatusRequestEntityTooLarge, w.Code)
+	})
+
+	t.Run("timed out", func(t *testing.T) {
+		h := newTestHTTPSource(true, 2, 50*time.Millisecond)
+		w := httptest.NewRecorder()
+		h.handler(false)(w, httptest.NewRequest(http.MethodPost, "/vertices/test-v", strings.NewReader("a")))
+		assert.Equal(t, http.StatusServiceUnavailable, w.Code)
+		assert.Equal(t, retryAfterSeconds, w.Header().Get("Retry-After"))
+	})
+}
@@ -19,7 +19,6 @@ package sources
 import (
 	"context"
 	"fmt"
-	"os"
 	"slices"
 	"strconv"
 	"time"
@@ -40,9 +39,11 @@ import (
 	"github.com/numaproj/numaflow/pkg/shared/callback"
 	jsclient "github.com/numaproj/numaflow/pkg/shared/clients/nats"
 	redisclient "github.com/numaproj/numaflow/pkg/shared/clients/redis"
+	"github.com/numaproj/numaflow/pkg/shared/kvs"
 	"github.com/numaproj/numaflow/pkg/shared/logging"
 	sharedutil "github.com/numaproj/numaflow/pkg/shared/util"
 	"github.com/numaproj/numaflow/pkg/shuffle"
+	"github.com/numaproj/numaflow/pkg/sources/dedup"
 	sourceforward "github.com/numaproj/numaflow/pkg/sources/forward"
 	"github.com/numaproj/numaflow/pkg/sources/generator"
 	"github.com/numaproj/numaflow/pkg/sources/http"
@@ -50,11 +51,13 @@ import (
 	"github.com/numaproj/numaflow/pkg/sources/kafka"
 	"github.com/numaproj/numaflow/pkg/sources/nats"
 	"github.com/numaproj/numaflow/pkg/sources/sourcer"
+	sqssrc "github.com/numaproj/numaflow/pkg/sources/sqs"
 	"github.com/numaproj/numaflow/pkg/sources/transformer"
 	"github.com/numaproj/numaflow/pkg/sources/udsource"
 	"github.com/numaproj/numaflow/pkg/watermark/fetch"
 	"github.com/numaproj/numaflow/pkg/watermark/generic"
 	"github.com/numaproj/numaflow/pkg/watermark/generic/jetstream"
+	rediswm "github.com/numaproj/numaflow/pkg/watermark/generic/redis"
 	"github.com/numaproj/numaflow/pkg/watermark/publish"
 	"github.com/numaproj/numaflow/pkg/watermark/store"
 	"github.com/numaproj/numaflow/pkg/watermark/wmb"
@@ -76,6 +79,7 @@ func (sp *SourceProcessor) Start(ctx context.Context) error {
 		sourceReader             sourcer.SourceReader
 		healthCheckers           []metrics.HealthChecker
 		idleManager              wmb.IdleManager
+		dedupStore               kvs.KVStorer
 		pipelineName             = sp.VertexInstance.Vertex.Spec.PipelineName
 		vertexName               = sp.VertexInstance.Vertex.Spec.Name
 	)
@@ -97,6 +101,7 @@ func (sp *SourceProcessor) Start(ctx context.Context) error {
 		for _, e := range sp.VertexInstance.Vertex.Spec.ToEdges {
 			writeOpts := []redisclient.Option{
 				redisclient.WithBufferFullWritingStrategy(e.BufferFullWritingStrategy()),
+				redisclient.WithCompressionType(sp.VertexInstance.Vertex.Spec.GetCompressionType()),
 			}
 			if x := e.ToVertexLimits; x != nil && x.BufferMaxLength != nil {
 				writeOpts = append(writeOpts, redisclient.WithMaxLength(int64(*x.BufferMaxLength)))
@@ -115,6 +120,40 @@ func (sp *SourceProcessor) Start(ctx context.Context) error {
 			}
 			writersMap[e.To] = bufferWriters
 		}
+		if x := sp.VertexInstance.Vertex.Spec.Source.Dedup; x != nil {
+			sourceBucket := dfv1.GenerateSourceBucketName(sp.VertexInstance.Vertex.Namespace, pipelineName, vertexName)
+			dedupStore = dedup.NewRedisStore(redisclient.NewInClusterRedisClient(), sourceBucket, x.Duration.Duration)
+		}
+
+		// created watermark related components only if watermark is enabled
+		// otherwise no op will be used
+		if !sp.VertexInstance.Vertex.Spec.Watermark.Disabled {
+			var err error
+			redisClient := redisclient.NewInClusterRedisClient()
+			defer redisClient.Close()
+
+			// build watermark stores for from vertex
+			sourceWmStores, err = rediswm.BuildFromVertexWatermarkStores(ctx, sp.VertexInstance, redisClient)
+			if err != nil {
+				return fmt.Errorf("failed to build watermark stores: %w", err)
+			}
+
+			// create watermark fetcher using watermark stores of from vertex
+			fetchWatermark = fetch.NewSourceFetcher(ctx, sourceWmStores[sp.VertexInstance.Vertex.Name], fetch.WithIsSource(true))
+
+			// build watermark stores for to-vertex
+			toVertexWatermarkStores, err = rediswm.BuildToVertexWatermarkStores(ctx, sp.VertexInstance, redisClient)
+			if err != nil {
+				return err
+			}
+
+			// build watermark stores for sourceReader (we publish twice for sourceReader)
+			sourcePublisherStores, err = rediswm.BuildSourcePublisherStores(ctx, sp.VertexInstance, redisClient)
+			if err != nil {
+				return err
+			}
+			idleManager, _ = wmb.NewIdleManager(1, len(writersMap))
+		}
 	case dfv1.ISBSvcTypeJetStream:
 
 		// create a new NATS client pool
@@ -127,6 +166,7 @@ func (sp *SourceProcessor) Start(ctx context.Context) error {
 		for _, e := range sp.VertexInstance.Vertex.Spec.ToEdges {
 			writeOpts := []jetstreamisb.WriteOption{
 				jetstreamisb.WithBufferFullWritingStrategy(e.BufferFullWritingStrategy()),
+				jetstreamisb.WithCompressionType(sp.VertexInstance.Vertex.Spec.GetCompressionType()),
 			}
 			if x := e.ToVertexLimits; x != nil && x.BufferMaxLength != nil {
 				writeOpts = append(writeOpts, jetstreamisb.WithMaxLength(int64(*x.BufferMaxLength)))
@@ -149,6 +189,14 @@ func (sp *SourceProcessor) Start(ctx context.Context) error {
 			writersMap[e.To] = bufferWriters
 		}
 
+		if x := sp.VertexInstance.Vertex.Spec.Source.Dedup; x != nil {
+			sourceBucket := dfv1.GenerateSourceBucketName(sp.VertexInstance.Vertex.Namespace, pipelineName, vertexName)
+			dedupStore, err = dedup.NewJetStreamStore(ctx, natsClientPool.NextAvailableClient(), sourceBucket, x.Duration.Duration, x.GetMaxEntries())
+			if err != nil {
+				return fmt.Errorf("failed to create dedup store: %w", err)
+			}
+		}
+
 		// created watermark related components only if watermark is enabled
 		// otherwise no op will be used
 		if !sp.VertexInstance.Vertex.Spec.Watermark.Disabled {
@@ -184,11 +232,15 @@ func (sp *SourceProcessor) Start(ctx context.Context) error {
 	shuffleFuncMap := make(map[string]*shuffle.Shuffle)
 	for _, edge := range sp.VertexInstance.Vertex.Spec.ToEdges {
 		if edge.GetToVertexPartitionCount() > 1 {
-			s := shuffle.NewShuffle(edge.To, edge.GetToVertexPartitionCount())
+			s := shuffle.NewShuffle(edge.To, edge.GetToVertexPartitionCount(), append(shuffle.EdgeOptions(edge.Edge), shuffle.WithMetricsLabels(sp.VertexInstance.Vertex.Spec.PipelineName, edge.From))...)
 			shuffleFuncMap[fmt.Sprintf("%s:%s", edge.From, edge.To)] = s
 		}
 		toVertexPartitionMap[edge.To] = edge.GetToVertexPartitionCount()
 	}
+	edgeConditions, err := forwarder.NewEdgeConditions(sp.VertexInstance)
+	if err != nil {
+		return err
+	}
 
 	maxMessageSize := sharedutil.LookupEnvIntOr(dfv1.EnvGRPCMaxMessageSize, sdkclient.DefaultGRPCMaxMessageSize)
 
@@ -234,6 +286,15 @@ func (sp *SourceProcessor) Start(ctx context.Context) error {
 		}
 	}
 
+	if x := sp.VertexInstance.Vertex.Spec.Source.Dedup; x != nil {
+		deduplicator, err := dedup.NewDeduplicator(ctx, dedupStore, x.Duration.Duration, x.GetMaxEntries())
+		if err != nil {
+			return err
+		}
+		defer deduplicator.Close()
+		forwardOpts = append(forwardOpts, sourceforward.WithDeduplicator(deduplicator))
+	}
+
 	if sp.VertexInstance.Vertex.HasUDTransformer() {
 		// Wait for server info to be ready
 		serverInfo, err := serverinfo.SDKServerInfo(serverinfo.WithServerInfoFilePath(sdkclient.SourceTransformerServerInfoFile))
@@ -266,7 +327,7 @@ func (sp *SourceProcessor) Start(ctx context.Context) error {
 		forwardOpts = append(forwardOpts, sourceforward.WithTransformer(srcTransformerGRPCClient))
 	}
 
-	sourceReader, err := sp.createSourceReader(ctx, udsGRPCClient)
+	sourceReader, err = sp.createSourceReader(ctx, udsGRPCClient)
 	if err != nil {
 		return fmt.Errorf("failed to create source, error: %w", err)
 	}
@@ -275,23 +336,22 @@ func (sp *SourceProcessor) Start(ctx context.Context) error {
 	sourceWmPublisher := publish.NewSourcePublish(ctx, pipelineName, vertexName, sourcePublisherStores, publish.WithDelay(sp.VertexInstance.Vertex.Spec.Watermark.GetMaxDelay()))
 
 	// if the callback is enabled, create a callback publisher
-	cbEnabled := sharedutil.LookupEnvBoolOr(dfv1.EnvCallbackEnabled, false)
-	if cbEnabled {
-		cbOpts := make([]callback.OptionFunc, 0)
-		cbUrl := os.Getenv(dfv1.EnvCallbackURL)
-		if cbUrl != "" {
-			cbOpts = append(cbOpts, callback.WithCallbackURL(cbUrl))
+	if sharedutil.LookupEnvBoolOr(dfv1.EnvCallbackEnabled, false) {
+		cbOpts, err := callback.EnvOptions()
+		if err != nil {
+			return fmt.Errorf("failed to create the callback publisher, error: %w", err)
 		}
 		cbPublisher := callback.NewUploader(ctx, vertexName, pipelineName, cbOpts...)
+		defer cbPublisher.Close()
 		forwardOpts = append(forwardOpts, sourceforward.WithCallbackUploader(cbPublisher))
 	}
 
 	// create source data forwarder
 	var sourceForwarder *sourceforward.DataForward
 	if sp.VertexInstance.Vertex.HasUDTransformer() {
-		sourceForwarder, err = sourceforward.NewDataForward(sp.VertexInstance, sourceReader, writersMap, sp.getTransformerGoWhereDecider(shuffleFuncMap), fetchWatermark, sourceWmPublisher, toVertexWatermarkStores, idleManager, forwardOpts...)
+		sourceForwarder, err = sourceforward.NewDataForward(sp.VertexInstance, sourceReader, writersMap, sp.getTransformerGoWhereDecider(shuffleFuncMap, edgeConditions), fetchWatermark, sourceWmPublisher, toVertexWatermarkStores, idleManager, forwardOpts...)
 	} else {
-		sourceForwarder, err = sourceforward.NewDataForward(sp.VertexInstance, sourceReader, writersMap, sp.getSourceGoWhereDecider(shuffleFuncMap), fetchWatermark, sourceWmPublisher, toVertexWatermarkStores, idleManager, forwardOpts...)
+		sourceForwarder, err = sourceforward.NewDataForward(sp.VertexInstance, sourceReader, writersMap, sp.getSourceGoWhereDecider(shuffleFuncMap, edgeConditions), fetchWatermark, sourceWmPublisher, toVertexWatermarkStores, idleManager, forwardOpts...)
 	}
 	if err != nil {
 		return fmt.Errorf("failed to create source forwarder, error: %w", err)
@@ -361,17 +421,24 @@ func (sp *SourceProcessor) createSourceReader(ctx context.Context, udsGRPCClient
 		return jetstreamsrc.New(ctx, sp.VertexInstance, jetstreamsrc.WithReadTimeout(readTimeout))
 	} else if x := src.Serving; x != nil {
 		return jetstreamsrc.New(ctx, sp.VertexInstance, jetstreamsrc.WithReadTimeout(readTimeout), jetstreamsrc.WithServingEnabled())
+	} else if x := src.Sqs; x != nil {
+		return sqssrc.New(ctx, sp.VertexInstance, sqssrc.WithReadTimeout(readTimeout))
 	}
 	return nil, fmt.Errorf("invalid source spec")
 }
 
-func (sp *SourceProcessor) getSourceGoWhereDecider(shuffleFuncMap map[string]*shuffle.Shuffle) forwarder.GoWhere {
+func (sp *SourceProcessor) getSourceGoWhereDecider(shuffleFuncMap map[string]*shuffle.Shuffle, edgeConditions *forwarder.EdgeConditions) forwarder.GoWhere {
 	// create the conditional forwarder
-	conditionalForwarder := forwarder.GoWhere(func(keys []string, tags []string, msgId string) ([]forwarder.VertexBuffer, error) {
+	conditionalForwarder := forwarder.GoWhere(func(keys []string, tags []string, msgId string, msg *isb.Message) ([]forwarder.VertexBuffer, error) {
 		var result []forwarder.VertexBuffer
 
 		// Iterate through the edges
 		for _, edge := range sp.VertexInstance.Vertex.Spec.ToEdges {
+			// messages are not tagged without a transformer, only the expression condition is applied
+			if !edgeConditions.MatchExpression(edge, msg) {
+				continue
+			}
+
 			// if the edge has more than one partition, shuffle the message
 			// else forward the message to the default partition
 			partitionIdx := isb.DefaultPartitionIdx
@@ -395,9 +462,9 @@ func (sp *SourceProcessor) getSourceGoWhereDecider(shuffleFuncMap map[string]*sh
 	return conditionalForwarder
 }
 
-func (sp *SourceProcessor) getTransformerGoWhereDecider(shuffleFuncMap map[string]*shuffle.Shuffle) forwarder.GoWhere {
+func (sp *SourceProcessor) getTransformerGoWhereDecider(shuffleFuncMap map[string]*shuffle.Shuffle, edgeConditions *forwarder.EdgeConditions) forwarder.GoWhere {
 	// create the conditional forwarder
-	conditionalForwarder := forwarder.GoWhere(func(keys []string, tags []string, msgId string) ([]forwarder.VertexBuffer, error) {
+	conditionalForwarder := forwarder.GoWhere(func(keys []string, tags []string, msgId string, msg *isb.Message) ([]forwarder.VertexBuffer, error) {
 		var result []forwarder.VertexBuffer
 
 		// Drop message if it contains the special tag
@@ -414,8 +481,8 @@ func (sp *SourceProcessor) getTransformerGoWhereDecider(shuffleFuncMap map[strin
 
 		// Iterate through the edges
 		for _, edge := range sp.VertexInstance.Vertex.Spec.ToEdges {
-			// Condition to proceed for forwarding message: No conditions on edge, or message tags match edge conditions
-			proceed := edge.Conditions == nil || edge.Conditions.Tags == nil || len(edge.Conditions.Tags.Values) == 0 || sharedutil.CompareSlice(edge.Conditions.Tags.GetOperator(), tags, edge.Conditions.Tags.Values)
+			// Condition to proceed for forwarding message: No conditions on edge, or message tags and the message match edge conditions
+			proceed := edgeConditions.Match(edge, tags, msg)
 
 			if proceed {
 				// if the edge has more than one partition, shuffle the message
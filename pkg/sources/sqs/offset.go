@@ -0,0 +1,53 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sqs
+
+import (
+	"fmt"
+	"strconv"
+)
+
+// sqsOffset is the offset of an SQS message. The receipt handle is used to delete the message from
+// the queue when it's acknowledged, while the message id is used to identify the message.
+type sqsOffset struct {
+	messageID     string
+	receiptHandle string
+	partitionIdx  int32
+}
+
+func (s *sqsOffset) String() string {
+	return s.messageID + "-" + strconv.Itoa(int(s.partitionIdx))
+}
+
+// Sequence is not supported, SQS messages are not ordered.
+func (s *sqsOffset) Sequence() (int64, error) {
+	return -1, fmt.Errorf("sequence is not supported by sqs offset %q", s.messageID)
+}
+
+// AckIt acking is taken care by the source reader, which deletes the message from the queue.
+func (s *sqsOffset) AckIt() error {
+	return nil
+}
+
+// NoAck is a no-op, the message will be visible again once the visibility timeout expires.
+func (s *sqsOffset) NoAck() error {
+	return nil
+}
+
+func (s *sqsOffset) PartitionIdx() int32 {
+	return s.partitionIdx
+}
@@ -0,0 +1,274 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sqs
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/sqs"
+	"github.com/aws/aws-sdk-go-v2/service/sqs/types"
+	"go.uber.org/zap"
+
+	dfv1 "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1"
+	"github.com/numaproj/numaflow/pkg/isb"
+	"github.com/numaproj/numaflow/pkg/metrics"
+	sqsclient "github.com/numaproj/numaflow/pkg/shared/clients/sqs"
+	"github.com/numaproj/numaflow/pkg/shared/logging"
+	"github.com/numaproj/numaflow/pkg/sources/sourcer"
+)
+
+// maxBatchSize is the max number of messages SQS allows in a single receive or delete request.
+const maxBatchSize = 10
+
+type sqsSource struct {
+	vertexName    string             // name of the source vertex
+	pipelineName  string             // name of the pipeline
+	vertexReplica int32              // replica index of the source vertex
+	spec          *dfv1.SqsSource    // sqs source spec
+	client        *sqs.Client        // sqs client
+	queueURL      string             // url of the queue to read from
+	readTimeout   time.Duration      // read timeout
+	logger        *zap.SugaredLogger // logger
+}
+
+// New returns an SQS source reader. Read messages are hidden from the other consumers for the
+// visibility timeout, and they are deleted from the queue when they are acknowledged. Messages that
+// are not acknowledged become visible again after the visibility timeout, and will be redelivered.
+func New(ctx context.Context, vertexInstance *dfv1.VertexInstance, opts ...Option) (sourcer.SourceReader, error) {
+	spec := vertexInstance.Vertex.Spec.Source.Sqs
+	s := &sqsSource{
+		vertexName:    vertexInstance.Vertex.Spec.Name,
+		pipelineName:  vertexInstance.Vertex.Spec.PipelineName,
+		vertexReplica: vertexInstance.Replica,
+		spec:          spec,
+		readTimeout:   1 * time.Second, // default timeout
+		logger:        logging.FromContext(ctx).With("sourceType", "sqs").With("queue", spec.QueueName),
+	}
+	for _, o := range opts {
+		if err := o(s); err != nil {
+			return nil, err
+		}
+	}
+
+	client, err := sqsclient.NewClient(ctx, spec.AWSRegion, spec.EndpointURL)
+	if err != nil {
+		return nil, err
+	}
+	queueURL, err := sqsclient.GetQueueURL(ctx, client, spec.QueueName, spec.QueueOwnerAWSAccountID)
+	if err != nil {
+		return nil, err
+	}
+	s.client = client
+	s.queueURL = queueURL
+	return s, nil
+}
+
+type Option func(*sqsSource) error
+
+// WithReadTimeout sets the read timeout
+func WithReadTimeout(t time.Duration) Option {
+	return func(o *sqsSource) error {
+		o.readTimeout = t
+		return nil
+	}
+}
+
+func (s *sqsSource) GetName() string {
+	return s.vertexName
+}
+
+// Partitions returns the partitions associated with this source.
+func (s *sqsSource) Partitions(context.Context) []int32 {
+	return []int32{s.vertexReplica}
+}
+
+// Read receives up to count messages from the queue. It keeps polling until count messages are read, the
+// read timeout is reached, or the queue has no visible messages. If a receive request fails after some messages
+// have been read, the error is logged and the messages read so far are returned, since they are already hidden
+// from the other consumers.
+func (s *sqsSource) Read(ctx context.Context, count int64) ([]*isb.ReadMessage, error) {
+	msgs := make([]*isb.ReadMessage, 0, count)
+	deadline := time.Now().Add(s.readTimeout)
+	for int64(len(msgs)) < count && time.Now().Before(deadline) {
+		out, err := s.client.ReceiveMessage(ctx, s.receiveMessageInput(count-int64(len(msgs))))
+		if err != nil {
+			sqsSourceReadErrors.With(map[string]string{metrics.LabelVertex: s.vertexName, metrics.LabelPipeline: s.pipelineName}).Inc()
+			err = fmt.Errorf("failed to receive messages from sqs queue %q, %w", s.spec.QueueName, err)
+			if len(msgs) == 0 {
+				return nil, err
+			}
+			s.logger.Warnw("Failed to receive more messages, returning the ones read so far", zap.Int("count", len(msgs)), zap.Error(err))
+			break
+		}
+		for _, m := range out.Messages {
+			msgs = append(msgs, s.toReadMessage(m))
+		}
+		sqsSourceReadCount.With(map[string]string{metrics.LabelVertex: s.vertexName, metrics.LabelPipeline: s.pipelineName}).Add(float64(len(out.Messages)))
+		if len(out.Messages) == 0 {
+			break
+		}
+	}
+	s.logger.Debugf("Read %d messages.", len(msgs))
+	return msgs, nil
+}
+
+func (s *sqsSource) receiveMessageInput(remaining int64) *sqs.ReceiveMessageInput {
+	input := &sqs.ReceiveMessageInput{
+		QueueUrl:                    aws.String(s.queueURL),
+		MaxNumberOfMessages:         1,
+		MessageAttributeNames:       s.spec.MessageAttributeNames,
+		MessageSystemAttributeNames: []types.MessageSystemAttributeName{types.MessageSystemAttributeNameSentTimestamp},
+	}
+	if x := s.spec.MaxNumberOfMessages; x != nil && *x > 0 {
+		input.MaxNumberOfMessages = min(*x, maxBatchSize)
+	}
+	if int64(input.MaxNumberOfMessages) > remaining {
+		input.MaxNumberOfMessages = int32(remaining)
+	}
+	if x := s.spec.VisibilityTimeout; x != nil {
+		input.VisibilityTimeout = *x
+	}
+	if x := s.spec.WaitTimeSeconds; x != nil {
+		input.WaitTimeSeconds = *x
+	}
+	for _, a := range s.spec.AttributeNames {
+		input.AttributeNames = append(input.AttributeNames, types.QueueAttributeName(a))
+	}
+	return input
+}
+
+// toReadMessage converts the SQS message to an isb.ReadMessage. The message attributes of string type and the
+// system attributes are added to the headers, and the event time is the time the message was sent to the queue.
+// The headers packed into a single attribute by the SQS sink are unpacked.
+func (s *sqsSource) toReadMessage(m types.Message) *isb.ReadMessage {
+	readOffset := &sqsOffset{
+		messageID:     aws.ToString(m.MessageId),
+		receiptHandle: aws.ToString(m.ReceiptHandle),
+		partitionIdx:  s.vertexReplica,
+	}
+	headers := make(map[string]string, len(m.Attributes)+len(m.MessageAttributes))
+	for k, v := range m.Attributes {
+		headers[k] = v
+	}
+	for k, v := range m.MessageAttributes {
+		if v.StringValue == nil {
+			continue
+		}
+		if k == sqsclient.HeadersAttributeName {
+			packed := map[string]string{}
+			if err := json.Unmarshal([]byte(*v.StringValue), &packed); err == nil {
+				for pk, pv := range packed {
+					headers[pk] = pv
+				}
+				continue
+			}
+			s.logger.Warnw("Failed to unpack the headers attribute, keeping it as is", zap.String("messageID", aws.ToString(m.MessageId)))
+		}
+		headers[k] = *v.StringValue
+	}
+	eventTime := time.Now()
+	if ts, err := strconv.ParseInt(m.Attributes[string(types.MessageSystemAttributeNameSentTimestamp)], 10, 64); err == nil {
+		eventTime = time.UnixMilli(ts)
+	}
+	return &isb.ReadMessage{
+		Message: isb.Message{
+			Header: isb.Header{
+				MessageInfo: isb.MessageInfo{EventTime: eventTime},
+				ID: isb.MessageID{
+					VertexName: s.vertexName,
+					Offset:     readOffset.String(),
+					Index:      readOffset.PartitionIdx(),
+				},
+				Headers: headers,
+			},
+			Body: isb.Body{
+				Payload: []byte(aws.ToString(m.Body)),
+			},
+		},
+		ReadOffset: readOffset,
+	}
+}
+
+// Ack deletes the messages from the queue in batches, the error of each message is returned at the same index.
+func (s *sqsSource) Ack(ctx context.Context, offsets []isb.Offset) []error {
+	errs := make([]error, len(offsets))
+	for start := 0; start < len(offsets); start += maxBatchSize {
+		end := min(start+maxBatchSize, len(offsets))
+		entries := make([]types.DeleteMessageBatchRequestEntry, 0, end-start)
+		for i := start; i < end; i++ {
+			o, ok := offsets[i].(*sqsOffset)
+			if !ok {
+				errs[i] = fmt.Errorf("invalid offset type %T for sqs source", offsets[i])
+				continue
+			}
+			entries = append(entries, types.DeleteMessageBatchRequestEntry{
+				Id:            aws.String(strconv.Itoa(i)),
+				ReceiptHandle: aws.String(o.receiptHandle),
+			})
+		}
+		if len(entries) == 0 {
+			continue
+		}
+		out, err := s.client.DeleteMessageBatch(ctx, &sqs.DeleteMessageBatchInput{
+			QueueUrl: aws.String(s.queueURL),
+			Entries:  entries,
+		})
+		if err != nil {
+			for _, e := range entries {
+				idx, _ := strconv.Atoi(aws.ToString(e.Id))
+				errs[idx] = fmt.Errorf("failed to delete messages from sqs queue %q, %w", s.spec.QueueName, err)
+			}
+			continue
+		}
+		for _, f := range out.Failed {
+			idx, _ := strconv.Atoi(aws.ToString(f.Id))
+			errs[idx] = fmt.Errorf("failed to delete message from sqs queue %q, %s: %s", s.spec.QueueName, aws.ToString(f.Code), aws.ToString(f.Message))
+		}
+	}
+	for _, err := range errs {
+		if err != nil {
+			sqsSourceAckErrors.With(map[string]string{metrics.LabelVertex: s.vertexName, metrics.LabelPipeline: s.pipelineName}).Inc()
+		}
+	}
+	return errs
+}
+
+// Pending returns the approximate number of messages available for retrieval from the queue.
+func (s *sqsSource) Pending(ctx context.Context) (int64, error) {
+	out, err := s.client.GetQueueAttributes(ctx, &sqs.GetQueueAttributesInput{
+		QueueUrl:       aws.String(s.queueURL),
+		AttributeNames: []types.QueueAttributeName{types.QueueAttributeNameApproximateNumberOfMessages},
+	})
+	if err != nil {
+		return isb.PendingNotAvailable, fmt.Errorf("failed to get attributes of sqs queue %q, %w", s.spec.QueueName, err)
+	}
+	pending, err := strconv.ParseInt(out.Attributes[string(types.QueueAttributeNameApproximateNumberOfMessages)], 10, 64)
+	if err != nil {
+		return isb.PendingNotAvailable, fmt.Errorf("failed to parse the approximate number of messages, %w", err)
+	}
+	return pending, nil
+}
+
+func (s *sqsSource) Close() error {
+	s.logger.Info("Sqs source closed")
+	return nil
+}
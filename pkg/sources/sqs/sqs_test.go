@@ -0,0 +1,201 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sqs
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"k8s.io/utils/ptr"
+
+	dfv1 "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1"
+	"github.com/numaproj/numaflow/pkg/isb"
+	sqsclient "github.com/numaproj/numaflow/pkg/shared/clients/sqs"
+	sqstest "github.com/numaproj/numaflow/pkg/shared/clients/sqs/test"
+)
+
+func testVertex(t *testing.T, endpoint, queueName string) *dfv1.VertexInstance {
+	t.Helper()
+	t.Setenv("AWS_ACCESS_KEY_ID", "test")
+	t.Setenv("AWS_SECRET_ACCESS_KEY", "test")
+	return &dfv1.VertexInstance{
+		Vertex: &dfv1.Vertex{
+			Spec: dfv1.VertexSpec{
+				PipelineName: "test-p",
+				AbstractVertex: dfv1.AbstractVertex{
+					Name: "test-v",
+					Source: &dfv1.Source{
+						Sqs: &dfv1.SqsSource{
+							AWSRegion:             "us-west-2",
+							QueueName:             queueName,
+							EndpointURL:           ptr.To(endpoint),
+							MaxNumberOfMessages:   ptr.To[int32](10),
+							VisibilityTimeout:     ptr.To[int32](30),
+							MessageAttributeNames: []string{"All"},
+						},
+					},
+				},
+			},
+		},
+		Replica: 0,
+	}
+}
+
+func TestSqsSource_ReadAndAck(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+	server := sqstest.RunSQSServer(t)
+	server.CreateQueue("test-queue")
+	for i := 0; i < 15; i++ {
+		server.SendMessage("test-queue", fmt.Sprintf("message-%d", i), map[string]string{"index": fmt.Sprint(i)})
+	}
+
+	src, err := New(ctx, testVertex(t, server.URL, "test-queue"), WithReadTimeout(time.Second))
+	assert.NoError(t, err)
+	assert.Equal(t, "test-v", src.GetName())
+	assert.Equal(t, []int32{0}, src.Partitions(ctx))
+	defer func() { _ = src.Close() }()
+
+	pending, err := src.Pending(ctx)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(15), pending)
+
+	// more than the max batch size of a single receive request.
+	msgs, err := src.Read(ctx, 12)
+	assert.NoError(t, err)
+	assert.Len(t, msgs, 12)
+	for i, m := range msgs {
+		assert.Equal(t, fmt.Sprintf("message-%d", i), string(m.Payload))
+		assert.Equal(t, fmt.Sprint(i), m.Headers["index"])
+		assert.Contains(t, m.Headers, "SentTimestamp")
+		assert.Equal(t, m.ReadOffset.String(), m.ID.Offset)
+	}
+	assert.WithinDuration(t, time.Now(), msgs[0].EventTime, 10*time.Second)
+
+	// in-flight messages are not pending.
+	pending, err = src.Pending(ctx)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(3), pending)
+
+	offsets := make([]isb.Offset, 0, len(msgs))
+	for _, m := range msgs {
+		offsets = append(offsets, m.ReadOffset)
+	}
+	errs := src.Ack(ctx, offsets)
+	assert.Len(t, errs, 12)
+	for _, e := range errs {
+		assert.NoError(t, e)
+	}
+	assert.Len(t, server.Messages("test-queue"), 3)
+
+	// acking the same messages again fails, since they have been deleted.
+	errs = src.Ack(ctx, offsets[:2])
+	assert.Error(t, errs[0])
+	assert.Error(t, errs[1])
+
+	// returns what's available without waiting for the read timeout.
+	msgs, err = src.Read(ctx, 5)
+	assert.NoError(t, err)
+	assert.Len(t, msgs, 3)
+}
+
+func TestSqsSource_PackedHeaders(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+	server := sqstest.RunSQSServer(t)
+	server.CreateQueue("test-queue")
+	server.SendMessage("test-queue", "packed", map[string]string{"h": "v", sqsclient.HeadersAttributeName: `{"empty":"","AWS.h":"a"}`})
+	server.SendMessage("test-queue", "not-packed", map[string]string{sqsclient.HeadersAttributeName: "not-json"})
+
+	src, err := New(ctx, testVertex(t, server.URL, "test-queue"), WithReadTimeout(time.Second))
+	assert.NoError(t, err)
+	defer func() { _ = src.Close() }()
+
+	msgs, err := src.Read(ctx, 2)
+	assert.NoError(t, err)
+	assert.Len(t, msgs, 2)
+	assert.Equal(t, "v", msgs[0].Headers["h"])
+	assert.Equal(t, "", msgs[0].Headers["empty"])
+	assert.Equal(t, "a", msgs[0].Headers["AWS.h"])
+	assert.NotContains(t, msgs[0].Headers, sqsclient.HeadersAttributeName)
+	assert.Equal(t, "not-json", msgs[1].Headers[sqsclient.HeadersAttributeName])
+}
+
+func TestSqsSource_Redelivery(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+	server := sqstest.RunSQSServer(t)
+	server.CreateQueue("test-queue")
+	server.SendMessage("test-queue", "message", nil)
+
+	vi := testVertex(t, server.URL, "test-queue")
+	vi.Vertex.Spec.Source.Sqs.VisibilityTimeout = ptr.To[int32](1)
+	src, err := New(ctx, vi)
+	assert.NoError(t, err)
+
+	msgs, err := src.Read(ctx, 1)
+	assert.NoError(t, err)
+	assert.Len(t, msgs, 1)
+	msgs, err = src.Read(ctx, 1)
+	assert.NoError(t, err)
+	assert.Empty(t, msgs)
+
+	// the message is visible again after the visibility timeout if it's not acknowledged.
+	time.Sleep(1100 * time.Millisecond)
+	msgs, err = src.Read(ctx, 1)
+	assert.NoError(t, err)
+	assert.Len(t, msgs, 1)
+	assert.Equal(t, "message", string(msgs[0].Payload))
+}
+
+func TestSqsSource_ReadError(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+	server := sqstest.RunSQSServer(t)
+	server.CreateQueue("test-queue")
+	for i := 0; i < 15; i++ {
+		server.SendMessage("test-queue", fmt.Sprintf("message-%d", i), nil)
+	}
+
+	src, err := New(ctx, testVertex(t, server.URL, "test-queue"), WithReadTimeout(time.Second))
+	assert.NoError(t, err)
+	defer func() { _ = src.Close() }()
+
+	// the messages received before the failure are returned without an error
+	receives := 0
+	server.FailReceive = func() bool {
+		receives++
+		return receives > 1
+	}
+	msgs, err := src.Read(ctx, 12)
+	assert.NoError(t, err)
+	assert.Len(t, msgs, 10)
+
+	// the error is returned if no message is received
+	msgs, err = src.Read(ctx, 5)
+	assert.Error(t, err)
+	assert.Empty(t, msgs)
+}
+
+func TestNew_QueueNotExist(t *testing.T) {
+	server := sqstest.RunSQSServer(t)
+	_, err := New(context.Background(), testVertex(t, server.URL, "not-exist"))
+	assert.Error(t, err)
+}
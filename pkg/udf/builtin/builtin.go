@@ -0,0 +1,127 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package builtin implements the built-in map functions, which run in the numa container so that trivial map vertices,
+// e.g. filtering messages or extracting the keys, don't need a UDF container.
+package builtin
+
+import (
+	"context"
+	"fmt"
+
+	"go.uber.org/zap"
+
+	dfv1 "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1"
+	"github.com/numaproj/numaflow/pkg/isb"
+	"github.com/numaproj/numaflow/pkg/shared/logging"
+	"github.com/numaproj/numaflow/pkg/udf/rpc"
+)
+
+// mapFunc applies a built-in function to the message in place, it returns false if the message should be dropped.
+type mapFunc func(msg *isb.WriteMessage) (bool, error)
+
+// Map is a map applier that runs a built-in function. It implements the applier.MapApplier interface.
+type Map struct {
+	vertexName string
+	fn         mapFunc
+	// dropOnError drops the messages which fail the function instead of failing the batch.
+	dropOnError bool
+	log         *zap.SugaredLogger
+}
+
+// NewMap returns a Map which runs the given built-in function.
+func NewMap(ctx context.Context, fn dfv1.Function, vertexName string) (*Map, error) {
+	var (
+		f   mapFunc
+		err error
+	)
+	switch fn.Name {
+	case dfv1.BuiltinFunctionCat:
+		f = cat
+	case dfv1.BuiltinFunctionFilter:
+		f, err = newFilter(fn.KWArgs)
+	case dfv1.BuiltinFunctionProject:
+		f, err = newProject(fn.KWArgs)
+	case dfv1.BuiltinFunctionEventTime:
+		f, err = newEventTime(fn.KWArgs)
+	default:
+		return nil, fmt.Errorf("unrecognized built-in function %q", fn.Name)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to create built-in function %q, %w", fn.Name, err)
+	}
+	return &Map{
+		vertexName:  vertexName,
+		fn:          f,
+		dropOnError: fn.Name == dfv1.BuiltinFunctionFilter,
+		log:         logging.FromContext(ctx).With("builtin", fn.Name),
+	}, nil
+}
+
+// ApplyMap applies the built-in function to the messages. A message which fails the filter function is dropped, a
+// message which fails any other function fails the batch with a UDF error, so that it goes through the retry strategy
+// of the vertex, e.g. to be sent to the dead letter vertex.
+func (m *Map) ApplyMap(_ context.Context, readMessages []*isb.ReadMessage) ([]isb.ReadWriteMessagePair, error) {
+	results := make([]isb.ReadWriteMessagePair, len(readMessages))
+	for i, readMessage := range readMessages {
+		writeMessage := &isb.WriteMessage{
+			Message: isb.Message{
+				Header: isb.Header{
+					MessageInfo: readMessage.MessageInfo,
+					ID: isb.MessageID{
+						VertexName: m.vertexName,
+						Offset:     readMessage.ReadOffset.String(),
+						Index:      0,
+					},
+					Keys:    readMessage.Keys,
+					Headers: readMessage.Headers,
+				},
+				Body: isb.Body{
+					Payload: readMessage.Body.Payload,
+				},
+			},
+		}
+		keep, err := m.fn(writeMessage)
+		if err != nil {
+			if !m.dropOnError {
+				return nil, &rpc.ApplyUDFErr{
+					UserUDFErr: true,
+					Message:    fmt.Sprintf("built-in function failed on the message %s, %s", readMessage.ReadOffset.String(), err),
+				}
+			}
+			m.log.Warnw("Failed to apply the built-in function", zap.String("offset", readMessage.ReadOffset.String()), zap.Error(err))
+		}
+		results[i] = isb.ReadWriteMessagePair{ReadMessage: readMessage}
+		if keep {
+			results[i].WriteMessages = []*isb.WriteMessage{writeMessage}
+		}
+	}
+	return results, nil
+}
+
+// cat forwards the messages unchanged, it is useful for debugging and testing.
+func cat(*isb.WriteMessage) (bool, error) {
+	return true, nil
+}
+
+// kwarg returns the keyword argument, or an error if it is required but missing.
+func kwarg(kwargs map[string]string, key string, required bool) (string, error) {
+	v := kwargs[key]
+	if v == "" && required {
+		return "", fmt.Errorf("missing keyword argument %q", key)
+	}
+	return v, nil
+}
@@ -0,0 +1,146 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package builtin
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	dfv1 "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1"
+	"github.com/numaproj/numaflow/pkg/isb"
+	"github.com/numaproj/numaflow/pkg/udf/rpc"
+)
+
+func testReadMessages(payloads ...string) []*isb.ReadMessage {
+	var messages []*isb.ReadMessage
+	for i, p := range payloads {
+		offset := fmt.Sprintf("%d", i)
+		messages = append(messages, &isb.ReadMessage{
+			Message: isb.Message{
+				Header: isb.Header{
+					MessageInfo: isb.MessageInfo{
+						EventTime: time.Unix(1661169600, 0),
+					},
+					ID: isb.MessageID{
+						VertexName: "in",
+						Offset:     offset,
+					},
+					Keys:    []string{"k"},
+					Headers: map[string]string{"type": "order"},
+				},
+				Body: isb.Body{
+					Payload: []byte(p),
+				},
+			},
+			ReadOffset: isb.SimpleStringOffset(func() string { return offset }),
+		})
+	}
+	return messages
+}
+
+func TestNewMap(t *testing.T) {
+	ctx := context.Background()
+	_, err := NewMap(ctx, dfv1.Function{Name: "dog"}, "test-vertex")
+	assert.ErrorContains(t, err, `unrecognized built-in function "dog"`)
+	_, err = NewMap(ctx, dfv1.Function{Name: dfv1.BuiltinFunctionFilter}, "test-vertex")
+	assert.ErrorContains(t, err, `missing keyword argument "expression"`)
+	_, err = NewMap(ctx, dfv1.Function{Name: dfv1.BuiltinFunctionFilter, KWArgs: map[string]string{"expression": "payload =="}}, "test-vertex")
+	assert.Error(t, err)
+	_, err = NewMap(ctx, dfv1.Function{Name: dfv1.BuiltinFunctionProject}, "test-vertex")
+	assert.Error(t, err)
+	_, err = NewMap(ctx, dfv1.Function{Name: dfv1.BuiltinFunctionEventTime}, "test-vertex")
+	assert.Error(t, err)
+}
+
+func TestMap_Cat(t *testing.T) {
+	m, err := NewMap(context.Background(), dfv1.Function{Name: dfv1.BuiltinFunctionCat}, "test-vertex")
+	assert.NoError(t, err)
+	results, err := m.ApplyMap(context.Background(), testReadMessages(`a`, `b`))
+	assert.NoError(t, err)
+	assert.Len(t, results, 2)
+	assert.Equal(t, []byte(`b`), results[1].WriteMessages[0].Payload)
+	assert.Equal(t, []string{"k"}, results[1].WriteMessages[0].Keys)
+	assert.Equal(t, time.Unix(1661169600, 0), results[1].WriteMessages[0].EventTime)
+}
+
+func TestMap_Filter(t *testing.T) {
+	m, err := NewMap(context.Background(), dfv1.Function{
+		Name:   dfv1.BuiltinFunctionFilter,
+		KWArgs: map[string]string{"expression": `json(payload).amount > 100 && headers["type"] == "order"`},
+	}, "test-vertex")
+	assert.NoError(t, err)
+	results, err := m.ApplyMap(context.Background(), testReadMessages(`{"amount": 120}`, `{"amount": 80}`, `abc`))
+	assert.NoError(t, err)
+	assert.Len(t, results, 3)
+	assert.Len(t, results[0].WriteMessages, 1)
+	assert.Equal(t, []byte(`{"amount": 120}`), results[0].WriteMessages[0].Payload)
+	assert.Equal(t, []string{"k"}, results[0].WriteMessages[0].Keys)
+	assert.Equal(t, "test-vertex", results[0].WriteMessages[0].ID.VertexName)
+	assert.Equal(t, "0", results[0].WriteMessages[0].ID.Offset)
+	assert.Equal(t, map[string]string{"type": "order"}, results[0].WriteMessages[0].Headers)
+	assert.Empty(t, results[1].WriteMessages)
+	assert.Empty(t, results[2].WriteMessages)
+}
+
+func TestMap_Project(t *testing.T) {
+	m, err := NewMap(context.Background(), dfv1.Function{
+		Name: dfv1.BuiltinFunctionProject,
+		KWArgs: map[string]string{
+			"key":   `json(payload).customer`,
+			"value": `json(payload).amount`,
+		},
+	}, "test-vertex")
+	assert.NoError(t, err)
+	results, err := m.ApplyMap(context.Background(), testReadMessages(`{"customer": "c1", "amount": 120}`))
+	assert.NoError(t, err)
+	assert.Len(t, results, 1)
+	assert.Equal(t, []string{"c1"}, results[0].WriteMessages[0].Keys)
+	assert.Equal(t, []byte(`120`), results[0].WriteMessages[0].Payload)
+
+	// a message which fails the evaluation fails the batch
+	_, err = m.ApplyMap(context.Background(), testReadMessages(`{"customer": "c1", "amount": 120}`, `abc`))
+	var udfErr *rpc.ApplyUDFErr
+	assert.ErrorAs(t, err, &udfErr)
+	assert.True(t, udfErr.IsUserUDFErr())
+}
+
+func TestMap_EventTime(t *testing.T) {
+	m, err := NewMap(context.Background(), dfv1.Function{
+		Name:   dfv1.BuiltinFunctionEventTime,
+		KWArgs: map[string]string{"expression": `json(payload).time`},
+	}, "test-vertex")
+	assert.NoError(t, err)
+	results, err := m.ApplyMap(context.Background(), testReadMessages(`{"time": "2024-01-02T03:04:05Z"}`))
+	assert.NoError(t, err)
+	assert.Equal(t, time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC), results[0].WriteMessages[0].EventTime)
+	// a message which fails the parsing fails the batch
+	_, err = m.ApplyMap(context.Background(), testReadMessages(`{"time": "yesterday"}`))
+	assert.ErrorContains(t, err, "failed to parse the event time")
+
+	m, err = NewMap(context.Background(), dfv1.Function{
+		Name:   dfv1.BuiltinFunctionEventTime,
+		KWArgs: map[string]string{"expression": `json(payload).time`, "format": "2006-01-02 15:04:05"},
+	}, "test-vertex")
+	assert.NoError(t, err)
+	results, err = m.ApplyMap(context.Background(), testReadMessages(`{"time": "2024-01-02 03:04:05"}`))
+	assert.NoError(t, err)
+	assert.Equal(t, time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC), results[0].WriteMessages[0].EventTime)
+}
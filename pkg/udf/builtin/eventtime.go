@@ -0,0 +1,54 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package builtin
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/numaproj/numaflow/pkg/isb"
+	"github.com/numaproj/numaflow/pkg/shared/expr"
+)
+
+// newEventTime returns a function which sets the event time of the messages to the result of the "expression", parsed
+// with the Go time layout "format" (RFC3339 by default).
+func newEventTime(kwargs map[string]string) (mapFunc, error) {
+	expression, err := kwarg(kwargs, "expression", true)
+	if err != nil {
+		return nil, err
+	}
+	format, _ := kwarg(kwargs, "format", false)
+	if format == "" {
+		format = time.RFC3339
+	}
+	e, err := expr.CompileStr(expression)
+	if err != nil {
+		return nil, err
+	}
+	return func(msg *isb.WriteMessage) (bool, error) {
+		v, err := e.Eval(msg.Payload, msg.Headers)
+		if err != nil {
+			return true, err
+		}
+		eventTime, err := time.Parse(format, v)
+		if err != nil {
+			return true, fmt.Errorf("failed to parse the event time %q, %w", v, err)
+		}
+		msg.EventTime = eventTime
+		return true, nil
+	}, nil
+}
@@ -0,0 +1,38 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package builtin
+
+import (
+	"github.com/numaproj/numaflow/pkg/isb"
+	"github.com/numaproj/numaflow/pkg/shared/expr"
+)
+
+// newFilter returns a function which forwards the messages only if the "expression" evaluates to true, the messages
+// which fail the evaluation are dropped.
+func newFilter(kwargs map[string]string) (mapFunc, error) {
+	expression, err := kwarg(kwargs, "expression", true)
+	if err != nil {
+		return nil, err
+	}
+	e, err := expr.CompileBool(expression)
+	if err != nil {
+		return nil, err
+	}
+	return func(msg *isb.WriteMessage) (bool, error) {
+		return e.Eval(msg.Payload, msg.Headers)
+	}, nil
+}
@@ -0,0 +1,69 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package builtin
+
+import (
+	"fmt"
+
+	"github.com/numaproj/numaflow/pkg/isb"
+	"github.com/numaproj/numaflow/pkg/shared/expr"
+)
+
+// newProject returns a function which sets the key of the messages to the result of the "key" expression, and the
+// payload to the result of the "value" expression. At least one of them is required.
+func newProject(kwargs map[string]string) (mapFunc, error) {
+	var (
+		keyExpr, valueExpr *expr.StrExpression
+		err                error
+	)
+	if k := kwargs["key"]; k != "" {
+		if keyExpr, err = expr.CompileStr(k); err != nil {
+			return nil, err
+		}
+	}
+	if v := kwargs["value"]; v != "" {
+		if valueExpr, err = expr.CompileStr(v); err != nil {
+			return nil, err
+		}
+	}
+	if keyExpr == nil && valueExpr == nil {
+		return nil, fmt.Errorf("at least one of the keyword arguments %q and %q is required", "key", "value")
+	}
+	return func(msg *isb.WriteMessage) (bool, error) {
+		var (
+			key, value string
+			err        error
+		)
+		if keyExpr != nil {
+			if key, err = keyExpr.Eval(msg.Payload, msg.Headers); err != nil {
+				return true, err
+			}
+		}
+		if valueExpr != nil {
+			if value, err = valueExpr.Eval(msg.Payload, msg.Headers); err != nil {
+				return true, err
+			}
+		}
+		if keyExpr != nil {
+			msg.Keys = []string{key}
+		}
+		if valueExpr != nil {
+			msg.Payload = []byte(value)
+		}
+		return true, nil
+	}, nil
+}
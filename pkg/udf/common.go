@@ -74,6 +74,7 @@ func buildRedisBufferIO(ctx context.Context, vertexInstance *dfv1.VertexInstance
 
 		writeOpts := []redisclient.Option{
 			redisclient.WithBufferFullWritingStrategy(e.BufferFullWritingStrategy()),
+			redisclient.WithCompressionType(vertexInstance.Vertex.Spec.GetCompressionType()),
 		}
 		if x := e.ToVertexLimits; x != nil && x.BufferMaxLength != nil {
 			writeOpts = append(writeOpts, redisclient.WithMaxLength(int64(*x.BufferMaxLength)))
@@ -97,7 +98,9 @@ func buildJetStreamBufferIO(ctx context.Context, vertexInstance *dfv1.VertexInst
 
 	// create readers for owned buffer partitions.
 	var readers []isb.BufferReader
-	var readOptions []jetstreamisb.ReadOption
+	readOptions := []jetstreamisb.ReadOption{
+		jetstreamisb.WithDefaultCompressionType(vertexInstance.Vertex.Spec.GetCompressionType()),
+	}
 	if x := vertexInstance.Vertex.Spec.Limits; x != nil && x.ReadTimeout != nil {
 		readOptions = append(readOptions, jetstreamisb.WithReadTimeOut(x.ReadTimeout.Duration))
 	}
@@ -146,6 +149,7 @@ func buildJetStreamBufferIO(ctx context.Context, vertexInstance *dfv1.VertexInst
 	for _, e := range vertexInstance.Vertex.Spec.ToEdges {
 		writeOpts := []jetstreamisb.WriteOption{
 			jetstreamisb.WithBufferFullWritingStrategy(e.BufferFullWritingStrategy()),
+			jetstreamisb.WithCompressionType(vertexInstance.Vertex.Spec.GetCompressionType()),
 		}
 		if x := e.ToVertexLimits; x != nil && x.BufferMaxLength != nil {
 			writeOpts = append(writeOpts, jetstreamisb.WithMaxLength(int64(*x.BufferMaxLength)))
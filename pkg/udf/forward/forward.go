@@ -38,6 +38,7 @@ import (
 	"github.com/numaproj/numaflow/pkg/metrics"
 	"github.com/numaproj/numaflow/pkg/shared/idlehandler"
 	"github.com/numaproj/numaflow/pkg/shared/logging"
+	"github.com/numaproj/numaflow/pkg/shared/tracing"
 	"github.com/numaproj/numaflow/pkg/watermark/fetch"
 	"github.com/numaproj/numaflow/pkg/watermark/publish"
 	"github.com/numaproj/numaflow/pkg/watermark/wmb"
@@ -197,6 +198,7 @@ func (isdf *InterStepDataForward) forwardAChunk(ctx context.Context) error {
 	// responsibility of the Read function to do that.
 	readStart := time.Now()
 	readMessages, err := isdf.fromBufferPartition.Read(ctx, isdf.opts.readBatchSize)
+	readEnd := time.Now()
 	isdf.opts.logger.Debugw("Read from buffer", zap.String("bufferFrom", isdf.fromBufferPartition.GetName()), zap.Int64("length", int64(len(readMessages))))
 	if err != nil {
 		isdf.opts.logger.Warnw("failed to read fromBufferPartition", zap.Error(err))
@@ -257,6 +259,12 @@ func (isdf *InterStepDataForward) forwardAChunk(ctx context.Context) error {
 		return nil
 	}
 
+	// trace the batch from the start of the read, the headers of the data messages will carry the context of the batch span.
+	ctx, span := tracing.StartBatchSpan(ctx, "map.forward", readStart, dataMessages,
+		append(tracing.VertexAttributes(isdf.pipelineName, isdf.vertexName, isdf.vertexReplica), tracing.AttributePartition.String(isdf.fromBufferPartition.GetName()))...)
+	defer span.End()
+	tracing.RecordSpan(ctx, "isb.read", readStart, readEnd)
+
 	metrics.ReadProcessingTime.With(metricLabelsWithPartition).Observe(float64(time.Since(readStart).Microseconds()))
 	metrics.ReadDataMessagesCount.With(metricLabelsWithPartition).Add(float64(len(dataMessages)))
 	metrics.ReadMessagesCount.With(metricLabelsWithPartition).Add(float64(len(readMessages)))
@@ -280,7 +288,9 @@ func (isdf *InterStepDataForward) forwardAChunk(ctx context.Context) error {
 	var writeOffsets map[string][][]isb.Offset
 	// Check if map streaming mode is enabled, if the applier is not nil that means we have enabled the required mode
 	if isdf.opts.streamMapUdfApplier != nil {
+		_, udfSpan := tracing.StartSpan(ctx, "map.udf")
 		writeOffsets, err = isdf.streamMessage(ctx, dataMessages)
+		tracing.EndSpan(udfSpan, err)
 		if err != nil {
 			isdf.opts.logger.Errorw("failed to streamMessage", zap.Error(err))
 			// As there's no partial failure, non-ack all the readOffsets
@@ -298,7 +308,14 @@ func (isdf *InterStepDataForward) forwardAChunk(ctx context.Context) error {
 		// ie Batch Map or unary map
 		// This will be a blocking call until the all the UDF results for the batch are received.
 		udfStart := time.Now()
-		udfResults, err = isdf.applyUDF(ctx, dataMessages)
+		_, udfSpan := tracing.StartSpan(ctx, "map.udf")
+		var deadLetters []deadLetter
+		if isdf.opts.udfRetryStrategy != nil {
+			udfResults, deadLetters, err = isdf.applyUDFWithRetry(ctx, dataMessages)
+		} else {
+			udfResults, err = isdf.applyUDF(ctx, dataMessages)
+		}
+		tracing.EndSpan(udfSpan, err)
 		if err != nil {
 			isdf.opts.logger.Errorw("failed to applyUDF", zap.Error(err))
 			// As there's no partial failure, non-ack all the readOffsets
@@ -319,15 +336,28 @@ func (isdf *InterStepDataForward) forwardAChunk(ctx context.Context) error {
 				}
 			}
 		}
+		// route the messages failed by the UDF to the dead letter vertex, bypassing the edge conditions.
+		for _, d := range deadLetters {
+			if err := isdf.deadLetterToStep(d, messageToStep); err != nil {
+				isdf.opts.logger.Errorw("failed in deadLetterToStep", zap.Error(err))
+				isdf.fromBufferPartition.NoAck(ctx, readOffsets)
+				return err
+			}
+		}
 
 		// forward the message to the edge buffer (could be multiple edges)
+		_, writeSpan := tracing.StartSpan(ctx, "isb.write")
 		writeOffsets, err = isdf.writeToBuffers(ctx, messageToStep)
+		tracing.EndSpan(writeSpan, err)
 		if err != nil {
 			isdf.opts.logger.Errorw("failed to write to toBuffers", zap.Error(err))
 			isdf.fromBufferPartition.NoAck(ctx, readOffsets)
 			return err
 		}
 		isdf.opts.logger.Debugw("writeToBuffers completed")
+		if len(deadLetters) > 0 {
+			metrics.UDFDeadLetterMessagesCount.With(metricLabelsWithPartition).Add(float64(len(deadLetters)))
+		}
 	}
 
 	// activeWatermarkBuffers records the buffers that the publisher has published
@@ -657,10 +687,114 @@ func (isdf *InterStepDataForward) applyUDF(ctx context.Context, readMessages []*
 	return writeMessages, nil
 }
 
+// deadLetter is a message failed by the map UDF, with the error of its last attempt.
+type deadLetter struct {
+	message *isb.ReadMessage
+	err     error
+}
+
+// applyUDFWithRetry applies the map UDF to the batch following the retry strategy. The batch is retried with the
+// backoff of the strategy, once the retries are exhausted, it is retried again with the "retry" on failure strategy.
+// Otherwise, the UDF is applied to each of the messages on its own, so that a poison message does not fail the rest
+// of the batch, and the messages still failing are dropped, or returned as dead letters with the "fallback" strategy.
+func (isdf *InterStepDataForward) applyUDFWithRetry(ctx context.Context, readMessages []*isb.ReadMessage) ([]isb.ReadWriteMessagePair, []deadLetter, error) {
+	backoff := isdf.opts.udfRetryStrategy.GetBackoff()
+	onFailure := isdf.opts.udfRetryStrategy.GetOnFailureRetryStrategy()
+	for {
+		var (
+			results []isb.ReadWriteMessagePair
+			udfErr  error
+		)
+		err := wait.ExponentialBackoffWithContext(ctx, backoff, func(_ context.Context) (bool, error) {
+			results, udfErr = isdf.applyUDF(ctx, readMessages)
+			return udfErr == nil, nil
+		})
+		if err == nil {
+			return results, nil, nil
+		}
+		if ctx.Err() != nil {
+			// the context may be canceled before the first attempt
+			if udfErr == nil {
+				udfErr = err
+			}
+			return nil, nil, fmt.Errorf("failed to applyUDF, %w", udfErr)
+		}
+		isdf.opts.logger.Warnw("Retries exhausted in the map UDF", zap.Int("messages", len(readMessages)),
+			zap.String("onFailure", string(onFailure)), zap.Error(udfErr))
+		if onFailure == dfv1.OnFailureRetry {
+			continue
+		}
+		break
+	}
+
+	// isolate the failing messages
+	var (
+		results = make([]isb.ReadWriteMessagePair, 0, len(readMessages))
+		failed  []deadLetter
+	)
+	for _, m := range readMessages {
+		result, err := isdf.applyUDF(ctx, []*isb.ReadMessage{m})
+		if err != nil {
+			if ctx.Err() != nil {
+				return nil, nil, fmt.Errorf("failed to applyUDF, %w", err)
+			}
+			failed = append(failed, deadLetter{message: m, err: err})
+			continue
+		}
+		results = append(results, result...)
+	}
+	if len(failed) > 0 && onFailure == dfv1.OnFailureDrop {
+		isdf.opts.logger.Infow("Dropping the messages failed by the map UDF", zap.Int("count", len(failed)))
+		metrics.DropMessagesCount.With(map[string]string{
+			metrics.LabelVertex:             isdf.vertexName,
+			metrics.LabelPipeline:           isdf.pipelineName,
+			metrics.LabelVertexType:         string(dfv1.VertexTypeMapUDF),
+			metrics.LabelVertexReplicaIndex: strconv.Itoa(int(isdf.vertexReplica)),
+			metrics.LabelPartitionName:      isdf.fromBufferPartition.GetName(),
+			metrics.LabelReason:             "retries exhausted in the map UDF",
+		}).Add(float64(len(failed)))
+		return results, nil, nil
+	}
+	return results, failed, nil
+}
+
+// deadLetterToStep assigns the message failed by the map UDF to the buffers of the dead letter vertex. The dead letter
+// is the message read by the vertex, with the error of the UDF in the headers.
+func (isdf *InterStepDataForward) deadLetterToStep(d deadLetter, messageToStep map[string][][]isb.Message) error {
+	if isdf.opts.deadLetterDecider == nil {
+		return fmt.Errorf("no dead letter vertex is configured for the failed message %s", d.message.ID.String())
+	}
+	message := d.message.Message
+	headers := make(map[string]string, len(message.Headers)+2)
+	for k, v := range message.Headers {
+		headers[k] = v
+	}
+	headers[dfv1.KeyMetaDeadLetterError] = d.err.Error()
+	headers[dfv1.KeyMetaDeadLetterVertex] = isdf.vertexName
+	message.Headers = headers
+	message.ID = isb.MessageID{
+		VertexName: isdf.vertexName,
+		Offset:     d.message.ReadOffset.String(),
+		Index:      0,
+	}
+
+	to, err := isdf.opts.deadLetterDecider.WhereTo(message.Keys, nil, message.ID.String(), &message)
+	if err != nil {
+		return err
+	}
+	for _, t := range to {
+		if _, ok := messageToStep[t.ToVertexName]; !ok {
+			return fmt.Errorf("no such dead letter destination (%s)", t.ToVertexName)
+		}
+		messageToStep[t.ToVertexName][t.ToVertexPartitionIdx] = append(messageToStep[t.ToVertexName][t.ToVertexPartitionIdx], message)
+	}
+	return nil
+}
+
 // whereToStep executes the WhereTo interfaces and then updates the to step's writeToBuffers buffer.
 func (isdf *InterStepDataForward) whereToStep(writeMessage *isb.WriteMessage, messageToStep map[string][][]isb.Message, readMessage *isb.ReadMessage) error {
 	// call WhereTo and drop it on errors
-	to, err := isdf.fsd.WhereTo(writeMessage.Keys, writeMessage.Tags, writeMessage.ID.String())
+	to, err := isdf.fsd.WhereTo(writeMessage.Keys, writeMessage.Tags, writeMessage.ID.String(), &writeMessage.Message)
 	if err != nil {
 		isdf.opts.logger.Errorw("failed in whereToStep", zap.Error(isb.MessageWriteErr{Name: isdf.fromBufferPartition.GetName(), Header: readMessage.Header, Body: readMessage.Body, Message: fmt.Sprintf("WhereTo failed, %s", err)}))
 		// a shutdown can break the blocking loop caused due to InternalErr
@@ -27,7 +27,11 @@ import (
 
 	"github.com/prometheus/client_golang/prometheus/testutil"
 	"github.com/stretchr/testify/assert"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
 	"go.uber.org/goleak"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
 
 	dfv1 "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1"
 	"github.com/numaproj/numaflow/pkg/forwarder"
@@ -37,6 +41,7 @@ import (
 	"github.com/numaproj/numaflow/pkg/metrics"
 	"github.com/numaproj/numaflow/pkg/shared/kvs"
 	"github.com/numaproj/numaflow/pkg/shared/logging"
+	"github.com/numaproj/numaflow/pkg/shared/tracing"
 	udfapplier "github.com/numaproj/numaflow/pkg/udf/rpc"
 	"github.com/numaproj/numaflow/pkg/watermark/entity"
 	"github.com/numaproj/numaflow/pkg/watermark/generic"
@@ -87,7 +92,7 @@ func (f myForwardTest) ApplyBatchMap(ctx context.Context, messages []*isb.ReadMe
 	return testutils.CopyUDFTestApplyBatchMap(ctx, "test-vertex", messages)
 }
 
-func (f myForwardTest) WhereTo(_ []string, _ []string, _ string) ([]forwarder.VertexBuffer, error) {
+func (f myForwardTest) WhereTo(_ []string, _ []string, _ string, _ *isb.Message) ([]forwarder.VertexBuffer, error) {
 	return []forwarder.VertexBuffer{{
 		ToVertexName:         "to1",
 		ToVertexPartitionIdx: 0,
@@ -907,6 +912,118 @@ func TestNewInterStepDataForward(t *testing.T) {
 	}
 }
 
+// myForwardPoisonTest fails the batches containing the poison message
+type myForwardPoisonTest struct {
+	poison string
+}
+
+func (f myForwardPoisonTest) WhereTo(_ []string, _ []string, _ string, _ *isb.Message) ([]forwarder.VertexBuffer, error) {
+	return []forwarder.VertexBuffer{{
+		ToVertexName:         "to1",
+		ToVertexPartitionIdx: 0,
+	}}, nil
+}
+
+func (f myForwardPoisonTest) ApplyMap(ctx context.Context, messages []*isb.ReadMessage) ([]isb.ReadWriteMessagePair, error) {
+	for _, m := range messages {
+		if strings.Contains(string(m.Payload), f.poison) {
+			return nil, fmt.Errorf("poison message %s", m.ID.String())
+		}
+	}
+	return mySourceForwardTest{}.ApplyMap(ctx, messages)
+}
+
+func TestInterStepDataForwardDeadLetter(t *testing.T) {
+	tests := []struct {
+		name      string
+		onFailure dfv1.OnFailureRetryStrategy
+	}{
+		{name: "fallback", onFailure: dfv1.OnFailureFallback},
+		{name: "drop", onFailure: dfv1.OnFailureDrop},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fromStep := simplebuffer.NewInMemoryBuffer("from", 25, 0)
+			to1 := simplebuffer.NewInMemoryBuffer("to1", 10, 0, simplebuffer.WithReadTimeOut(time.Second*10))
+			dlq := simplebuffer.NewInMemoryBuffer("dlq", 10, 0, simplebuffer.WithReadTimeOut(time.Second*10))
+			toSteps := map[string][]isb.BufferWriter{
+				"to1": {to1},
+				"dlq": {dlq},
+			}
+			vertexInstance := &dfv1.VertexInstance{
+				Vertex: &dfv1.Vertex{Spec: dfv1.VertexSpec{
+					PipelineName: "testPipeline",
+					AbstractVertex: dfv1.AbstractVertex{
+						Name: "test-vertex",
+					},
+				}},
+				Replica: 0,
+			}
+			ctx, cancel := context.WithTimeout(context.Background(), time.Second*10)
+			defer cancel()
+
+			writeMessages := testutils.BuildTestWriteMessages(int64(5), testStartTime, nil, "test-vertex")
+			fetchWatermark := &testForwardFetcher{}
+			_, publishWatermark := generic.BuildNoOpWatermarkProgressorsFromBufferMap(toSteps)
+			idleManager, _ := wmb.NewIdleManager(1, len(toSteps))
+
+			onFailure := tt.onFailure
+			applier := myForwardPoisonTest{poison: "paydload_1"}
+			deadLetterDecider := forwarder.GoWhere(func(_ []string, _ []string, _ string, _ *isb.Message) ([]forwarder.VertexBuffer, error) {
+				return []forwarder.VertexBuffer{{ToVertexName: "dlq", ToVertexPartitionIdx: 0}}, nil
+			})
+			f, err := NewInterStepDataForward(vertexInstance, fromStep, toSteps, applier, fetchWatermark, publishWatermark, idleManager,
+				WithReadBatchSize(5), WithUDFMap(applier), WithDeadLetter(deadLetterDecider),
+				WithUDFRetryStrategy(dfv1.RetryStrategy{
+					BackOff:   &dfv1.Backoff{Steps: ptr.To[uint32](3), Interval: &metav1.Duration{Duration: time.Millisecond}},
+					OnFailure: &onFailure,
+				}))
+			assert.NoError(t, err)
+			stopped := f.Start()
+
+			_, errs := fromStep.Write(ctx, writeMessages)
+			assert.Equal(t, make([]error, 5), errs)
+
+			// the rest of the batch is forwarded
+			readMessages, err := to1.Read(ctx, 4)
+			assert.NoError(t, err)
+			assert.Len(t, readMessages, 4)
+			for _, m := range readMessages {
+				assert.NotContains(t, string(m.Payload), "paydload_1")
+			}
+
+			if tt.onFailure == dfv1.OnFailureFallback {
+				deadLetters, err := dlq.Read(ctx, 1)
+				assert.NoError(t, err)
+				assert.Len(t, deadLetters, 1)
+				assert.Equal(t, writeMessages[1].Payload, deadLetters[0].Payload)
+				assert.Equal(t, "value1", deadLetters[0].Headers["key1"])
+				assert.Equal(t, "test-vertex", deadLetters[0].Headers[dfv1.KeyMetaDeadLetterVertex])
+				assert.Contains(t, deadLetters[0].Headers[dfv1.KeyMetaDeadLetterError], "poison message")
+				// the headers of the read message are left untouched
+				assert.NotContains(t, writeMessages[1].Headers, dfv1.KeyMetaDeadLetterError)
+			} else {
+				// only the idle watermarks of the unused edge end up in the dead letter buffer
+				readCtx, readCancel := context.WithTimeout(ctx, 100*time.Millisecond)
+				defer readCancel()
+				deadLetters, err := dlq.Read(readCtx, 10)
+				assert.NoError(t, err)
+				for _, m := range deadLetters {
+					assert.NotEqual(t, isb.Data, m.Kind)
+				}
+			}
+
+			// the whole batch is acked
+			assert.Eventually(t, func() bool {
+				return fromStep.IsEmpty()
+			}, 5*time.Second, 10*time.Millisecond)
+
+			f.Stop()
+			<-stopped
+		})
+	}
+}
+
 type testWMBFetcher struct {
 	// for forward_test.go only
 	WMBTestSameHeadWMB bool // for testing same head wmb, if set true then WMBTestDiffHeadWMB must be false
@@ -1325,7 +1442,7 @@ func TestNewInterStepDataForwardIdleWatermark_Reset(t *testing.T) {
 type mySourceForwardTest struct {
 }
 
-func (f mySourceForwardTest) WhereTo(_ []string, _ []string, _ string) ([]forwarder.VertexBuffer, error) {
+func (f mySourceForwardTest) WhereTo(_ []string, _ []string, _ string, _ *isb.Message) ([]forwarder.VertexBuffer, error) {
 	return []forwarder.VertexBuffer{{
 		ToVertexName:         "to1",
 		ToVertexPartitionIdx: 0,
@@ -1336,7 +1453,7 @@ type mySourceForwardTestRoundRobin struct {
 	count int
 }
 
-func (f *mySourceForwardTestRoundRobin) WhereTo(_ []string, _ []string, _ string) ([]forwarder.VertexBuffer, error) {
+func (f *mySourceForwardTestRoundRobin) WhereTo(_ []string, _ []string, _ string, _ *isb.Message) ([]forwarder.VertexBuffer, error) {
 	var output = []forwarder.VertexBuffer{{
 		ToVertexName:         "to1",
 		ToVertexPartitionIdx: int32(f.count % 2),
@@ -1483,6 +1600,74 @@ func TestInterStepDataForwardSinglePartition(t *testing.T) {
 	<-stopped
 }
 
+func TestInterStepDataForwardTracing(t *testing.T) {
+	exporter := tracetest.NewInMemoryExporter()
+	shutdown, err := tracing.Init(context.Background(), "test", tracing.WithSpanExporter(exporter))
+	assert.NoError(t, err)
+	defer func() { _ = shutdown(context.Background()) }()
+
+	fromStep := simplebuffer.NewInMemoryBuffer("from", 25, 0)
+	to1 := simplebuffer.NewInMemoryBuffer("to1", 10, 0, simplebuffer.WithReadTimeOut(time.Second*10))
+	toSteps := map[string][]isb.BufferWriter{
+		"to1": {to1},
+	}
+	vertexInstance := &dfv1.VertexInstance{
+		Vertex: &dfv1.Vertex{Spec: dfv1.VertexSpec{
+			PipelineName: "testPipeline",
+			AbstractVertex: dfv1.AbstractVertex{
+				Name: "test-vertex",
+			},
+		}},
+		Replica: 0,
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*10)
+	defer cancel()
+
+	// both messages are from the same upstream span
+	writeMessages := testutils.BuildTestWriteMessages(int64(2), testStartTime, nil, "test-vertex")
+	for _, m := range writeMessages {
+		m.Headers["traceparent"] = "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01"
+	}
+	fetchWatermark := &testForwardFetcher{}
+	_, publishWatermark := generic.BuildNoOpWatermarkProgressorsFromBufferMap(toSteps)
+	idleManager, _ := wmb.NewIdleManager(1, len(toSteps))
+
+	f, err := NewInterStepDataForward(vertexInstance, fromStep, toSteps, mySourceForwardTest{}, fetchWatermark, publishWatermark, idleManager, WithReadBatchSize(5), WithUDFMap(mySourceForwardTest{}))
+	assert.NoError(t, err)
+	stopped := f.Start()
+
+	_, errs := fromStep.Write(ctx, writeMessages)
+	assert.Equal(t, make([]error, 2), errs)
+	readMessages, err := to1.Read(ctx, 2)
+	assert.NoError(t, err)
+	assert.Len(t, readMessages, 2)
+
+	var spans tracetest.SpanStubs
+	assert.Eventually(t, func() bool {
+		spans = exporter.GetSpans()
+		return len(spans) == 4
+	}, 5*time.Second, 10*time.Millisecond)
+	names := make([]string, 0, len(spans))
+	for _, span := range spans {
+		names = append(names, span.Name)
+	}
+	assert.ElementsMatch(t, []string{"isb.read", "map.udf", "isb.write", "map.forward"}, names)
+	batch := spans[len(spans)-1]
+	assert.Equal(t, "map.forward", batch.Name)
+	assert.Equal(t, "4bf92f3577b34da6a3ce929d0e0e4736", batch.SpanContext.TraceID().String())
+	assert.Equal(t, "00f067aa0ba902b7", batch.Parent.SpanID().String())
+
+	// the downstream messages carry the context of the batch span, along with the other headers
+	for _, m := range readMessages {
+		assert.Equal(t, "value1", m.Headers["key1"])
+		sc := trace.SpanContextFromContext(tracing.Extract(context.Background(), m.Headers))
+		assert.Equal(t, batch.SpanContext.SpanID(), sc.SpanID())
+	}
+
+	f.Stop()
+	<-stopped
+}
+
 func TestInterStepDataForwardMultiplePartition(t *testing.T) {
 	fromStep := simplebuffer.NewInMemoryBuffer("from", 25, 0)
 	to11 := simplebuffer.NewInMemoryBuffer("to1-0", 10, 0, simplebuffer.WithReadTimeOut(time.Second*10))
@@ -1703,7 +1888,7 @@ func TestWriteToBuffer(t *testing.T) {
 type myForwardDropTest struct {
 }
 
-func (f myForwardDropTest) WhereTo(_ []string, _ []string, _ string) ([]forwarder.VertexBuffer, error) {
+func (f myForwardDropTest) WhereTo(_ []string, _ []string, _ string, _ *isb.Message) ([]forwarder.VertexBuffer, error) {
 	return []forwarder.VertexBuffer{}, nil
 }
 
@@ -1723,7 +1908,7 @@ type myForwardToAllTest struct {
 	count int
 }
 
-func (f *myForwardToAllTest) WhereTo(_ []string, _ []string, _ string) ([]forwarder.VertexBuffer, error) {
+func (f *myForwardToAllTest) WhereTo(_ []string, _ []string, _ string, _ *isb.Message) ([]forwarder.VertexBuffer, error) {
 	var output = []forwarder.VertexBuffer{{
 		ToVertexName:         "to1",
 		ToVertexPartitionIdx: int32(f.count % 2),
@@ -1751,7 +1936,7 @@ func (f *myForwardToAllTest) ApplyBatchMap(ctx context.Context, messages []*isb.
 type myForwardInternalErrTest struct {
 }
 
-func (f myForwardInternalErrTest) WhereTo(_ []string, _ []string, _ string) ([]forwarder.VertexBuffer, error) {
+func (f myForwardInternalErrTest) WhereTo(_ []string, _ []string, _ string, _ *isb.Message) ([]forwarder.VertexBuffer, error) {
 	return []forwarder.VertexBuffer{{
 		ToVertexName:         "to1",
 		ToVertexPartitionIdx: 0,
@@ -1795,7 +1980,7 @@ func (f myForwardInternalErrTest) ApplyMapStream(_ context.Context, _ *isb.ReadM
 type myForwardApplyWhereToErrTest struct {
 }
 
-func (f myForwardApplyWhereToErrTest) WhereTo(_ []string, _ []string, _ string) ([]forwarder.VertexBuffer, error) {
+func (f myForwardApplyWhereToErrTest) WhereTo(_ []string, _ []string, _ string, _ *isb.Message) ([]forwarder.VertexBuffer, error) {
 	return []forwarder.VertexBuffer{{
 		ToVertexName:         "to1",
 		ToVertexPartitionIdx: 0,
@@ -1817,7 +2002,7 @@ func (f myForwardApplyWhereToErrTest) ApplyBatchMap(ctx context.Context, message
 type myForwardApplyUDFErrTest struct {
 }
 
-func (f myForwardApplyUDFErrTest) WhereTo(_ []string, _ []string, _ string) ([]forwarder.VertexBuffer, error) {
+func (f myForwardApplyUDFErrTest) WhereTo(_ []string, _ []string, _ string, _ *isb.Message) ([]forwarder.VertexBuffer, error) {
 	return []forwarder.VertexBuffer{{
 		ToVertexName:         "to1",
 		ToVertexPartitionIdx: 0,
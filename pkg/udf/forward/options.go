@@ -23,6 +23,7 @@ import (
 	"go.uber.org/zap"
 
 	dfv1 "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1"
+	"github.com/numaproj/numaflow/pkg/forwarder"
 	"github.com/numaproj/numaflow/pkg/shared/callback"
 	"github.com/numaproj/numaflow/pkg/shared/logging"
 	"github.com/numaproj/numaflow/pkg/udf/forward/applier"
@@ -44,6 +45,10 @@ type options struct {
 	unaryMapUdfApplier applier.MapApplier
 	// streamMapUdfApplier is the UDF applier for a server streaming map mode
 	streamMapUdfApplier applier.MapStreamApplier
+	// udfRetryStrategy is the retry strategy of the map UDF failures, a failure stops the forwarder if it is not set
+	udfRetryStrategy *dfv1.RetryStrategy
+	// deadLetterDecider decides where the messages failed by the map UDF are written to
+	deadLetterDecider forwarder.ToWhichStepDecider
 }
 
 type Option func(*options) error
@@ -99,6 +104,23 @@ func WithCallbackUploader(cp *callback.Uploader) Option {
 	}
 }
 
+// WithUDFRetryStrategy sets the retry strategy of the map UDF failures
+func WithUDFRetryStrategy(strategy dfv1.RetryStrategy) Option {
+	return func(o *options) error {
+		o.udfRetryStrategy = &strategy
+		return nil
+	}
+}
+
+// WithDeadLetter routes the messages failed by the map UDF, once the retry strategy is exhausted with the fallback
+// on failure strategy, to the buffers decided by the toWhichStepDecider.
+func WithDeadLetter(toWhichStepDecider forwarder.ToWhichStepDecider) Option {
+	return func(o *options) error {
+		o.deadLetterDecider = toWhichStepDecider
+		return nil
+	}
+}
+
 // Options to set the map mode to be used, as all of them are mutually exclusive, at one point of time
 // one of them can be enabled, and others are set to nil
 // NOTE: if multiple of these are passed with valid appliers, we consider that as an invalid scenario
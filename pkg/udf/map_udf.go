@@ -20,7 +20,6 @@ import (
 	"context"
 	"fmt"
 	"io"
-	"os"
 	"slices"
 	"strconv"
 	"sync"
@@ -36,14 +35,17 @@ import (
 	"github.com/numaproj/numaflow/pkg/sdkclient/serverinfo"
 	"github.com/numaproj/numaflow/pkg/shared/callback"
 	jsclient "github.com/numaproj/numaflow/pkg/shared/clients/nats"
+	redisclient "github.com/numaproj/numaflow/pkg/shared/clients/redis"
 	"github.com/numaproj/numaflow/pkg/shared/logging"
 	sharedutil "github.com/numaproj/numaflow/pkg/shared/util"
 	"github.com/numaproj/numaflow/pkg/shuffle"
+	"github.com/numaproj/numaflow/pkg/udf/builtin"
 	"github.com/numaproj/numaflow/pkg/udf/forward"
 	"github.com/numaproj/numaflow/pkg/udf/rpc"
 	"github.com/numaproj/numaflow/pkg/watermark/fetch"
 	"github.com/numaproj/numaflow/pkg/watermark/generic"
 	"github.com/numaproj/numaflow/pkg/watermark/generic/jetstream"
+	rediswm "github.com/numaproj/numaflow/pkg/watermark/generic/redis"
 	"github.com/numaproj/numaflow/pkg/watermark/store"
 	"github.com/numaproj/numaflow/pkg/watermark/wmb"
 )
@@ -88,6 +90,34 @@ func (u *MapUDFProcessor) Start(ctx context.Context) error {
 		if err != nil {
 			return err
 		}
+
+		// created watermark related components only if watermark is enabled
+		// otherwise no op will be used
+		if !u.VertexInstance.Vertex.Spec.Watermark.Disabled {
+			redisClient := redisclient.NewInClusterRedisClient()
+			defer redisClient.Close()
+
+			// create from vertex watermark stores
+			fromVertexWmStores, err = rediswm.BuildFromVertexWatermarkStores(ctx, u.VertexInstance, redisClient)
+			if err != nil {
+				return fmt.Errorf("failed to build watermark stores: %w", err)
+			}
+
+			// create watermark fetcher using watermark stores
+			fetchWatermark = fetch.NewEdgeFetcherSet(ctx, u.VertexInstance, fromVertexWmStores, fetch.WithVertexReplica(u.VertexInstance.Replica),
+				fetch.WithIsReduce(u.VertexInstance.Vertex.IsReduceUDF()), fetch.WithIsSource(u.VertexInstance.Vertex.IsASource()))
+
+			// create to vertex watermark stores
+			toVertexWmStores, err = rediswm.BuildToVertexWatermarkStores(ctx, u.VertexInstance, redisClient)
+			if err != nil {
+				return err
+			}
+
+			// create watermark publisher using watermark stores
+			publishWatermark = rediswm.BuildPublishersFromStores(ctx, u.VertexInstance, toVertexWmStores)
+
+			idleManager, _ = wmb.NewIdleManager(len(writers), len(writers))
+		}
 	case dfv1.ISBSvcTypeJetStream:
 
 		natsClientPool, err := jsclient.NewClientPool(ctx)
@@ -135,16 +165,37 @@ func (u *MapUDFProcessor) Start(ctx context.Context) error {
 	enableMapUdfStream := false
 	maxMessageSize := sharedutil.LookupEnvIntOr(dfv1.EnvGRPCMaxMessageSize, sdkclient.DefaultGRPCMaxMessageSize)
 
-	// Wait for map server info to be ready, we use the same info file for all the map modes
-	serverInfo, err := serverinfo.SDKServerInfo(serverinfo.WithServerInfoFilePath(sdkclient.MapServerInfoFile))
-	if err != nil {
-		return err
+	// a built-in function runs in the numa container, there is no map server to wait for
+	builtinFunc := u.VertexInstance.Vertex.Spec.UDF.Builtin
+	var (
+		serverInfo *serverinfo.ServerInfo
+		mapMode    string
+		ok         bool
+	)
+	if builtinFunc == nil {
+		// Wait for map server info to be ready, we use the same info file for all the map modes
+		serverInfo, err = serverinfo.SDKServerInfo(serverinfo.WithServerInfoFilePath(sdkclient.MapServerInfoFile))
+		if err != nil {
+			return err
+		}
+		metrics.SDKInfo.WithLabelValues(dfv1.ComponentVertex, fmt.Sprintf("%s-%s", pipelineName, vertexName), string(serverinfo.ContainerTypeMapper), serverInfo.Version, string(serverInfo.Language)).Set(1)
+		mapMode, ok = serverInfo.Metadata[serverinfo.MapModeKey]
 	}
-	metrics.SDKInfo.WithLabelValues(dfv1.ComponentVertex, fmt.Sprintf("%s-%s", pipelineName, vertexName), string(serverinfo.ContainerTypeMapper), serverInfo.Version, string(serverInfo.Language)).Set(1)
 
 	// track all the resources that need to be closed
 	var resourcesToClose []io.Closer
-	mapMode, ok := serverInfo.Metadata[serverinfo.MapModeKey]
+
+	// if the callback is enabled, create a callback publisher, it's shared by all the partitions so that
+	// the callback outbox is redelivered by a single publisher
+	if sharedutil.LookupEnvBoolOr(dfv1.EnvCallbackEnabled, false) {
+		cbOpts, err := callback.EnvOptions()
+		if err != nil {
+			return fmt.Errorf("failed to create the callback publisher, error: %w", err)
+		}
+		cbPublisher := callback.NewUploader(ctx, vertexName, pipelineName, cbOpts...)
+		defer cbPublisher.Close()
+		opts = append(opts, forward.WithCallbackUploader(cbPublisher))
+	}
 
 	for index, bufferPartition := range fromBuffer {
 		// Read the server info file to read which map mode is enabled
@@ -153,7 +204,15 @@ func (u *MapUDFProcessor) Start(ctx context.Context) error {
 		// the client is not thread safe since we use one common gRPC Bidirectional stream
 		// to communicate with the server
 
-		if ok && (serverinfo.MapMode(mapMode) == serverinfo.StreamMap) {
+		if builtinFunc != nil {
+			log.Infow("Map mode enabled: Builtin", zap.String("function", builtinFunc.Name))
+			builtinHandler, err := builtin.NewMap(ctx, *builtinFunc, vertexName)
+			if err != nil {
+				return err
+			}
+			opts = append(opts, forward.WithUDFMap(builtinHandler))
+
+		} else if ok && (serverinfo.MapMode(mapMode) == serverinfo.StreamMap) {
 			log.Info("Map mode enabled: Stream Map")
 			// Map Stream mode
 			enableMapUdfStream = true
@@ -215,13 +274,17 @@ func (u *MapUDFProcessor) Start(ctx context.Context) error {
 		shuffleFuncMap := make(map[string]*shuffle.Shuffle)
 		for _, edge := range u.VertexInstance.Vertex.Spec.ToEdges {
 			if edge.GetToVertexPartitionCount() > 1 {
-				s := shuffle.NewShuffle(edge.To, edge.GetToVertexPartitionCount())
+				s := shuffle.NewShuffle(edge.To, edge.GetToVertexPartitionCount(), append(shuffle.EdgeOptions(edge.Edge), shuffle.WithMetricsLabels(u.VertexInstance.Vertex.Spec.PipelineName, edge.From))...)
 				shuffleFuncMap[edge.From+":"+edge.To] = s
 			}
 		}
+		edgeConditions, err := forwarder.NewEdgeConditions(u.VertexInstance)
+		if err != nil {
+			return err
+		}
 
 		// create a conditional forwarder for each partition
-		conditionalForwarder := forwarder.GoWhere(func(keys []string, tags []string, msgId string) ([]forwarder.VertexBuffer, error) {
+		conditionalForwarder := forwarder.GoWhere(func(keys []string, tags []string, msgId string, msg *isb.Message) ([]forwarder.VertexBuffer, error) {
 			var result []forwarder.VertexBuffer
 
 			// Drop message if it contains the special tag
@@ -238,8 +301,8 @@ func (u *MapUDFProcessor) Start(ctx context.Context) error {
 
 			// Iterate through the edges
 			for _, edge := range u.VertexInstance.Vertex.Spec.ToEdges {
-				// Condition to proceed for forwarding message: No conditions on edge, or message tags match edge conditions
-				proceed := edge.Conditions == nil || edge.Conditions.Tags == nil || len(edge.Conditions.Tags.Values) == 0 || sharedutil.CompareSlice(edge.Conditions.Tags.GetOperator(), tags, edge.Conditions.Tags.Values)
+				// Condition to proceed for forwarding message: No conditions on edge, or message tags and the message match edge conditions
+				proceed := edgeConditions.Match(edge, tags, msg)
 
 				if proceed {
 					// if the edge has more than one partition, shuffle the message
@@ -263,6 +326,40 @@ func (u *MapUDFProcessor) Start(ctx context.Context) error {
 
 			return result, nil
 		})
+		// retry the map UDF failures, and route the messages still failing to the dead letter vertex, bypassing the
+		// edge conditions
+		if retryStrategy := u.VertexInstance.Vertex.Spec.UDF.RetryStrategy; retryStrategy != nil {
+			if enableMapUdfStream {
+				log.Warn("Retry strategy is not supported by the map streaming mode, ignoring it")
+			} else {
+				opts = append(opts, forward.WithUDFRetryStrategy(*retryStrategy))
+			}
+		}
+		if deadLetter := u.VertexInstance.Vertex.Spec.UDF.DeadLetter; deadLetter != nil {
+			var deadLetterEdge *dfv1.CombinedEdge
+			for _, edge := range u.VertexInstance.Vertex.Spec.ToEdges {
+				if edge.To == deadLetter.ToVertex {
+					deadLetterEdge = &edge
+					break
+				}
+			}
+			if deadLetterEdge == nil {
+				return fmt.Errorf("dead letter vertex %q is not connected to vertex %q", deadLetter.ToVertex, vertexName)
+			}
+			deadLetterDecider := forwarder.GoWhere(func(keys []string, _ []string, msgId string, _ *isb.Message) ([]forwarder.VertexBuffer, error) {
+				partitionIdx := isb.DefaultPartitionIdx
+				if deadLetterEdge.GetToVertexPartitionCount() > 1 {
+					s := shuffleFuncMap[deadLetterEdge.From+":"+deadLetterEdge.To]
+					if deadLetterEdge.ToVertexType == dfv1.VertexTypeReduceUDF {
+						partitionIdx = s.ShuffleOnKeys(keys)
+					} else {
+						partitionIdx = s.ShuffleOnId(msgId)
+					}
+				}
+				return []forwarder.VertexBuffer{{ToVertexName: deadLetterEdge.To, ToVertexPartitionIdx: partitionIdx}}, nil
+			})
+			opts = append(opts, forward.WithDeadLetter(deadLetterDecider))
+		}
 		if x := u.VertexInstance.Vertex.Spec.Limits; x != nil {
 			if x.ReadBatchSize != nil {
 				opts = append(opts, forward.WithReadBatchSize(int64(*x.ReadBatchSize)))
@@ -270,18 +367,6 @@ func (u *MapUDFProcessor) Start(ctx context.Context) error {
 			}
 		}
 
-		// if the callback is enabled, create a callback publisher
-		cbEnabled := sharedutil.LookupEnvBoolOr(dfv1.EnvCallbackEnabled, false)
-		if cbEnabled {
-			cbOpts := make([]callback.OptionFunc, 0)
-			cbUrl := os.Getenv(dfv1.EnvCallbackURL)
-			if cbUrl != "" {
-				cbOpts = append(cbOpts, callback.WithCallbackURL(cbUrl))
-			}
-			cbPublisher := callback.NewUploader(ctx, vertexName, pipelineName, cbOpts...)
-			opts = append(opts, forward.WithCallbackUploader(cbPublisher))
-		}
-
 		// create a forwarder for each partition
 		df, err := forward.NewInterStepDataForward(u.VertexInstance, readers[index], writers, conditionalForwarder, fetchWatermark, publishWatermark, idleManager, opts...)
 		if err != nil {
@@ -316,13 +401,15 @@ func (u *MapUDFProcessor) Start(ctx context.Context) error {
 		lagReaders = append(lagReaders, reader)
 	}
 
-	var metricsOpts []metrics.Option
 	// Add the correct client handler for the metrics server, based on the mode being used.
+	// A built-in function doesn't have a UDF container to check.
+	var healthCheckers []metrics.HealthChecker
 	if enableMapUdfStream {
-		metricsOpts = metrics.NewMetricsOptions(ctx, u.VertexInstance.Vertex, []metrics.HealthChecker{mapStreamHandler}, lagReaders)
-	} else {
-		metricsOpts = metrics.NewMetricsOptions(ctx, u.VertexInstance.Vertex, []metrics.HealthChecker{mapHandler}, lagReaders)
+		healthCheckers = append(healthCheckers, mapStreamHandler)
+	} else if mapHandler != nil {
+		healthCheckers = append(healthCheckers, mapHandler)
 	}
+	metricsOpts := metrics.NewMetricsOptions(ctx, u.VertexInstance.Vertex, healthCheckers, lagReaders)
 	ms := metrics.NewMetricsServer(u.VertexInstance.Vertex, metricsOpts...)
 	if shutdown, err := ms.Start(ctx); err != nil {
 		return fmt.Errorf("failed to start metrics server, error: %w", err)
@@ -23,6 +23,7 @@ import (
 	"strconv"
 	"strings"
 	"sync"
+	"time"
 
 	"go.uber.org/zap"
 
@@ -34,6 +35,7 @@ import (
 	"github.com/numaproj/numaflow/pkg/reduce/applier"
 	"github.com/numaproj/numaflow/pkg/reduce/pbq"
 	alignedfs "github.com/numaproj/numaflow/pkg/reduce/pbq/wal/aligned/fs"
+	alignedobjectstore "github.com/numaproj/numaflow/pkg/reduce/pbq/wal/aligned/objectstore"
 	noopwal "github.com/numaproj/numaflow/pkg/reduce/pbq/wal/noop"
 	"github.com/numaproj/numaflow/pkg/reduce/pbq/wal/unaligned"
 	unalignedfs "github.com/numaproj/numaflow/pkg/reduce/pbq/wal/unaligned/fs"
@@ -44,6 +46,8 @@ import (
 	"github.com/numaproj/numaflow/pkg/sdkclient/serverinfo"
 	"github.com/numaproj/numaflow/pkg/sdkclient/sessionreducer"
 	jsclient "github.com/numaproj/numaflow/pkg/shared/clients/nats"
+	redisclient "github.com/numaproj/numaflow/pkg/shared/clients/redis"
+	s3client "github.com/numaproj/numaflow/pkg/shared/clients/s3"
 	"github.com/numaproj/numaflow/pkg/shared/logging"
 	sharedutil "github.com/numaproj/numaflow/pkg/shared/util"
 	"github.com/numaproj/numaflow/pkg/shuffle"
@@ -51,11 +55,14 @@ import (
 	"github.com/numaproj/numaflow/pkg/watermark/fetch"
 	"github.com/numaproj/numaflow/pkg/watermark/generic"
 	"github.com/numaproj/numaflow/pkg/watermark/generic/jetstream"
+	rediswm "github.com/numaproj/numaflow/pkg/watermark/generic/redis"
 	"github.com/numaproj/numaflow/pkg/watermark/store"
 	"github.com/numaproj/numaflow/pkg/watermark/wmb"
 	"github.com/numaproj/numaflow/pkg/window"
 	"github.com/numaproj/numaflow/pkg/window/strategy/accumulate"
+	"github.com/numaproj/numaflow/pkg/window/strategy/count"
 	"github.com/numaproj/numaflow/pkg/window/strategy/fixed"
+	"github.com/numaproj/numaflow/pkg/window/strategy/global"
 	"github.com/numaproj/numaflow/pkg/window/strategy/session"
 	"github.com/numaproj/numaflow/pkg/window/strategy/sliding"
 )
@@ -134,7 +141,8 @@ func (u *ReduceUDFProcessor) Start(ctx context.Context) error {
 
 		udfApplier = reduceHandler
 		healthChecker = reduceHandler
-	} else if windowType.Session != nil {
+	} else if windowType.Session != nil || windowType.Count != nil || windowType.Global != nil {
+		// count and global windows are keyed unaligned windows as well, their panes are reduced by the session reducer.
 		// Wait for server info to be ready
 		serverInfo, err := serverinfo.SDKServerInfo(serverinfo.WithServerInfoFilePath(sdkclient.SessionReduceServerInfoFile))
 		if err != nil {
@@ -191,6 +199,21 @@ func (u *ReduceUDFProcessor) Start(ctx context.Context) error {
 		windower = session.NewWindower(windowType.Session.Timeout.Duration, u.VertexInstance)
 	} else if windowType.Accumulator != nil {
 		windower = accumulate.NewWindower(u.VertexInstance, windowType.Accumulator.Timeout.Duration)
+	} else if windowType.Count != nil {
+		var timeout time.Duration
+		if windowType.Count.Timeout != nil {
+			timeout = windowType.Count.Timeout.Duration
+		}
+		windower = count.NewWindower(u.VertexInstance, int(windowType.Count.Count), timeout)
+	} else if windowType.Global != nil {
+		var trigger global.Trigger
+		if windowType.Global.Trigger.Count != nil {
+			trigger.Count = int(*windowType.Global.Trigger.Count)
+		}
+		if windowType.Global.Trigger.Interval != nil {
+			trigger.Interval = windowType.Global.Trigger.Interval.Duration
+		}
+		windower = global.NewWindower(u.VertexInstance, trigger)
 	} else {
 		return fmt.Errorf("invalid window spec")
 	}
@@ -216,6 +239,34 @@ func (u *ReduceUDFProcessor) Start(ctx context.Context) error {
 		if err != nil {
 			return err
 		}
+
+		// created watermark related components only if watermark is enabled
+		// otherwise noop will used
+		if !u.VertexInstance.Vertex.Spec.Watermark.Disabled {
+			redisClient := redisclient.NewInClusterRedisClient()
+			defer redisClient.Close()
+
+			// create from vertex watermark stores
+			fromVertexWmStores, err = rediswm.BuildFromVertexWatermarkStores(ctx, u.VertexInstance, redisClient)
+			if err != nil {
+				return fmt.Errorf("failed to build watermark stores: %w", err)
+			}
+
+			// create watermark fetcher using watermark stores
+			fetchWatermark = fetch.NewEdgeFetcherSet(ctx, u.VertexInstance, fromVertexWmStores, fetch.WithVertexReplica(u.VertexInstance.Replica),
+				fetch.WithIsReduce(u.VertexInstance.Vertex.IsReduceUDF()), fetch.WithIsSource(u.VertexInstance.Vertex.IsASource()))
+
+			// create to vertex watermark stores
+			toVertexWmStores, err = rediswm.BuildToVertexWatermarkStores(ctx, u.VertexInstance, redisClient)
+			if err != nil {
+				return err
+			}
+
+			// create watermark publisher using watermark stores
+			publishWatermark = rediswm.BuildPublishersFromStores(ctx, u.VertexInstance, toVertexWmStores)
+
+			idleManager, _ = wmb.NewIdleManager(1, len(writers))
+		}
 	case dfv1.ISBSvcTypeJetStream:
 
 		natsClientPool, err = jsclient.NewClientPool(ctx)
@@ -261,13 +312,17 @@ func (u *ReduceUDFProcessor) Start(ctx context.Context) error {
 	shuffleFuncMap := make(map[string]*shuffle.Shuffle)
 	for _, edge := range u.VertexInstance.Vertex.Spec.ToEdges {
 		if edge.GetToVertexPartitionCount() > 1 {
-			s := shuffle.NewShuffle(edge.To, edge.GetToVertexPartitionCount())
+			s := shuffle.NewShuffle(edge.To, edge.GetToVertexPartitionCount(), append(shuffle.EdgeOptions(edge.Edge), shuffle.WithMetricsLabels(u.VertexInstance.Vertex.Spec.PipelineName, edge.From))...)
 			shuffleFuncMap[edge.From+":"+edge.To] = s
 		}
 	}
+	edgeConditions, err := forwarder.NewEdgeConditions(u.VertexInstance)
+	if err != nil {
+		return err
+	}
 
 	// create the conditional forwarder
-	conditionalForwarder := forwarder.GoWhere(func(keys []string, tags []string, msgId string) ([]forwarder.VertexBuffer, error) {
+	conditionalForwarder := forwarder.GoWhere(func(keys []string, tags []string, msgId string, msg *isb.Message) ([]forwarder.VertexBuffer, error) {
 		var result []forwarder.VertexBuffer
 
 		// Drop message if it contains the special tag
@@ -285,8 +340,8 @@ func (u *ReduceUDFProcessor) Start(ctx context.Context) error {
 		for _, edge := range u.VertexInstance.Vertex.Spec.ToEdges {
 			edgeKey := edge.From + ":" + edge.To
 
-			// Condition to proceed for forwarding message: No conditions on edge, or message tags match edge conditions
-			proceed := edge.Conditions == nil || edge.Conditions.Tags == nil || len(edge.Conditions.Tags.Values) == 0 || sharedutil.CompareSlice(edge.Conditions.Tags.GetOperator(), tags, edge.Conditions.Tags.Values)
+			// Condition to proceed for forwarding message: No conditions on edge, or message tags and the message match edge conditions
+			proceed := edgeConditions.Match(edge, tags, msg)
 
 			if proceed {
 				// if the edge has more than one partition, shuffle the message
@@ -338,6 +393,23 @@ func (u *ReduceUDFProcessor) Start(ctx context.Context) error {
 			walManager = unalignedfs.NewFSManager(ctx, dfv1.DefaultSegmentWALPath, dfv1.DefaultCompactWALPath, u.VertexInstance)
 		}
 	}
+	// if the vertex has an object store, create an object store based wal manager, only aligned windows support it
+	if objStore := u.VertexInstance.Vertex.Spec.UDF.GroupBy.Storage.ObjectStore; objStore != nil {
+		if windower.Type() != window.Aligned {
+			return fmt.Errorf("object store storage is only supported by fixed and sliding windows")
+		}
+		s3Client, err := s3client.NewClient(ctx, objStore.Region, objStore.EndpointURL, objStore.UsePathStyle)
+		if err != nil {
+			return fmt.Errorf("failed to create s3 client, %w", err)
+		}
+		// the messages are acked once their WAL entries are uploaded, so upload more often than the other stores sync
+		walOpts := []alignedobjectstore.Option{alignedobjectstore.WithSyncDuration(dfv1.DefaultObjectStoreWALSyncDuration)}
+		opts = append(opts, reduce.WithFlushInterval(dfv1.DefaultObjectStoreWALSyncDuration))
+		if objStore.Prefix != "" {
+			walOpts = append(walOpts, alignedobjectstore.WithPrefix(objStore.Prefix))
+		}
+		walManager = alignedobjectstore.NewObjectStoreManager(u.VertexInstance, alignedobjectstore.NewS3Store(s3Client, objStore.Bucket), walOpts...)
+	}
 
 	pbqManager, err := pbq.NewManager(ctx, vertexName, pipelineName, vertexReplica, walManager, windower.Type())
 	if err != nil {
@@ -359,11 +431,57 @@ func (u *ReduceUDFProcessor) Start(ctx context.Context) error {
 		opts = append(opts, reduce.WithAllowedLateness(allowedLateness.Duration))
 	}
 
+	// early and late firings are only applicable to the aligned windows
+	if groupBy := u.VertexInstance.Vertex.Spec.UDF.GroupBy; windower.Type() == window.Aligned {
+		if ef := groupBy.EarlyFiring; ef != nil {
+			var interval time.Duration
+			if ef.Interval != nil {
+				interval = ef.Interval.Duration
+			}
+			var count int
+			if ef.Count != nil {
+				count = int(*ef.Count)
+			}
+			pnfOpts = append(pnfOpts, pnf.WithEarlyFiring(interval, count))
+		}
+		if groupBy.LateFiring {
+			opts = append(opts, reduce.WithLateFiring(true))
+			pnfOpts = append(pnfOpts, pnf.WithLateFiring(true))
+		}
+	}
+
+	// route the dropped late messages to the late data vertex, bypassing the edge conditions
+	if lateData := u.VertexInstance.Vertex.Spec.UDF.GroupBy.LateData; lateData != nil {
+		var lateDataEdge *dfv1.CombinedEdge
+		for _, edge := range u.VertexInstance.Vertex.Spec.ToEdges {
+			if edge.To == lateData.ToVertex {
+				lateDataEdge = &edge
+				break
+			}
+		}
+		if lateDataEdge == nil {
+			return fmt.Errorf("late data vertex %q is not connected to vertex %q", lateData.ToVertex, vertexName)
+		}
+		lateDataDecider := forwarder.GoWhere(func(keys []string, _ []string, msgId string, _ *isb.Message) ([]forwarder.VertexBuffer, error) {
+			partitionIdx := isb.DefaultPartitionIdx
+			if lateDataEdge.GetToVertexPartitionCount() > 1 {
+				s := shuffleFuncMap[lateDataEdge.From+":"+lateDataEdge.To]
+				if lateDataEdge.ToVertexType == dfv1.VertexTypeReduceUDF {
+					partitionIdx = s.ShuffleOnKeys(keys)
+				} else {
+					partitionIdx = s.ShuffleOnId(msgId)
+				}
+			}
+			return []forwarder.VertexBuffer{{ToVertexName: lateDataEdge.To, ToVertexPartitionIdx: partitionIdx}}, nil
+		})
+		opts = append(opts, reduce.WithLateData(lateDataDecider, lateData.Fallback))
+	}
+
 	// create and start the compactor if the window type is unaligned
 	// the compactor will delete the persisted messages which belongs to the materialized window
 	// create a gc events tracker which tracks the gc events, will be used by the pnf
 	// to track the gc events and the compactor will delete the persisted messages based on the gc events
-	if windowType.Session != nil || windowType.Accumulator != nil {
+	if windowType.Session != nil || windowType.Accumulator != nil || windowType.Count != nil || windowType.Global != nil {
 		gcEventsTracker, err := unalignedfs.NewGCEventsWAL(ctx, pipelineName, vertexName, vertexReplica)
 		if err != nil {
 			return fmt.Errorf("failed to create gc events tracker, %w", err)
@@ -380,7 +498,12 @@ func (u *ReduceUDFProcessor) Start(ctx context.Context) error {
 
 		pnfOpts = append(pnfOpts, pnf.WithGCEventsTracker(gcEventsTracker), pnf.WithWindowType(window.Unaligned))
 
-		compactor, err := unalignedfs.NewCompactor(ctx, pipelineName, vertexName, vertexReplica, &window.SharedUnalignedPartition, dfv1.DefaultGCEventsWALEventsPath, dfv1.DefaultSegmentWALPath, dfv1.DefaultCompactWALPath)
+		var compactorOpts []unalignedfs.CompactorOption
+		// the panes of the global (and count) windows are open until the trigger fires
+		if windowType.Count != nil || windowType.Global != nil {
+			compactorOpts = append(compactorOpts, unalignedfs.WithKeepUnclosedKeys())
+		}
+		compactor, err := unalignedfs.NewCompactor(ctx, pipelineName, vertexName, vertexReplica, &window.SharedUnalignedPartition, dfv1.DefaultGCEventsWALEventsPath, dfv1.DefaultSegmentWALPath, dfv1.DefaultCompactWALPath, compactorOpts...)
 		if err != nil {
 			return fmt.Errorf("failed to create compactor, %w", err)
 		}
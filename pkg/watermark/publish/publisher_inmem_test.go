@@ -18,7 +18,6 @@ package publish
 
 import (
 	"context"
-	"fmt"
 	"strconv"
 	"testing"
 	"time"
@@ -26,6 +25,7 @@ import (
 	"github.com/stretchr/testify/assert"
 
 	"github.com/numaproj/numaflow/pkg/isb"
+	"github.com/numaproj/numaflow/pkg/shared/kvs"
 	"github.com/numaproj/numaflow/pkg/watermark/entity"
 	"github.com/numaproj/numaflow/pkg/watermark/store"
 	"github.com/numaproj/numaflow/pkg/watermark/wmb"
@@ -62,6 +62,6 @@ func TestPublisherWithSharedOTBuckets_InMem(t *testing.T) {
 	_ = p.Close()
 
 	_, err = p.heartbeatStore.GetValue(ctx, publishEntity.GetName())
-	assert.Equal(t, fmt.Errorf("key publisherTestPod1 not found"), err)
+	assert.ErrorIs(t, err, kvs.ErrKeyNotFound)
 
 }
@@ -28,6 +28,7 @@ import (
 	"github.com/numaproj/numaflow/pkg/isb"
 	natsclient "github.com/numaproj/numaflow/pkg/shared/clients/nats"
 	natstest "github.com/numaproj/numaflow/pkg/shared/clients/nats/test"
+	"github.com/numaproj/numaflow/pkg/shared/kvs"
 	"github.com/numaproj/numaflow/pkg/watermark/entity"
 	"github.com/numaproj/numaflow/pkg/watermark/store"
 	"github.com/numaproj/numaflow/pkg/watermark/wmb"
@@ -110,5 +111,5 @@ func TestPublisherWithSharedOTBucket(t *testing.T) {
 	_ = p.Close()
 
 	_, err = p.heartbeatStore.GetValue(ctx, publishEntity.GetName())
-	assert.Equal(t, nats.ErrKeyNotFound, err)
+	assert.ErrorIs(t, err, kvs.ErrKeyNotFound)
 }
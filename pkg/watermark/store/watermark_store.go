@@ -21,10 +21,12 @@ import (
 	"fmt"
 
 	jsclient "github.com/numaproj/numaflow/pkg/shared/clients/nats"
+	redisclient "github.com/numaproj/numaflow/pkg/shared/clients/redis"
 	"github.com/numaproj/numaflow/pkg/shared/kvs"
 	"github.com/numaproj/numaflow/pkg/shared/kvs/inmem"
 	"github.com/numaproj/numaflow/pkg/shared/kvs/jetstream"
 	noopkv "github.com/numaproj/numaflow/pkg/shared/kvs/noop"
+	rediskv "github.com/numaproj/numaflow/pkg/shared/kvs/redis"
 )
 
 // watermarkStore wraps a pair of heartbeatStore and offsetTimelineStore,
@@ -90,6 +92,28 @@ func BuildJetStreamWatermarkStore(ctx context.Context, bucket string, client *js
 	}, nil
 }
 
+// BuildRedisWatermarkStore returns a Redis WatermarkStore instance
+func BuildRedisWatermarkStore(ctx context.Context, bucket string, client *redisclient.RedisClient) (WatermarkStore, error) {
+	// build heartBeat store
+	hbKVName := RedisProcessorKVName(bucket)
+	hbStore, err := rediskv.NewKVRedisKVStore(ctx, hbKVName, client)
+	if err != nil {
+		return nil, fmt.Errorf("failed at new Redis HB KV store %q, %w", hbKVName, err)
+	}
+
+	// build offsetTimeline store
+	otStoreKVName := RedisOTKVName(bucket)
+	otStore, err := rediskv.NewKVRedisKVStore(ctx, otStoreKVName, client)
+	if err != nil {
+		hbStore.Close()
+		return nil, fmt.Errorf("failed at new Redis OT KV store %q, %w", otStoreKVName, err)
+	}
+	return &watermarkStore{
+		heartbeatStore:      hbStore,
+		offsetTimelineStore: otStore,
+	}, nil
+}
+
 func JetStreamProcessorKVName(bucketName string) string {
 	return fmt.Sprintf("%s_PROCESSORS", bucketName)
 }
@@ -97,3 +121,11 @@ func JetStreamProcessorKVName(bucketName string) string {
 func JetStreamOTKVName(bucketName string) string {
 	return fmt.Sprintf("%s_OT", bucketName)
 }
+
+func RedisProcessorKVName(bucketName string) string {
+	return fmt.Sprintf("%s_PROCESSORS", bucketName)
+}
+
+func RedisOTKVName(bucketName string) string {
+	return fmt.Sprintf("%s_OT", bucketName)
+}
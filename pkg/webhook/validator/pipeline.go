@@ -115,9 +115,15 @@ func validatePipelineUpdate(old, new *dfv1.Pipeline) error {
 			}
 		}
 	}
-	// TODO - rule 3: if the structure of the pipeline is updated, the update must be valid
-	// example of structure change can be a vertex is added or removed, an edge is added or removed or updated, etc.
-	// for now, we consider structure change as valid
+	// rule 4: if the structure of the pipeline is updated, e.g. a vertex or an edge is removed, or the partition count
+	// of a vertex is decreased, the pipeline must be paused and drained, otherwise the messages in the buffers to be
+	// deleted would be stranded. With the BlueGreen upgrade strategy, the pipeline is drained by the controller before
+	// the change is applied.
+	if new.GetUpgradeStrategy().GetType() != dfv1.PipelineUpgradeStrategyBlueGreen {
+		if err := validator.ValidateTopologyChange(validator.DiffPipelineTopology(old, new), old); err != nil {
+			return err
+		}
+	}
 	return nil
 }
 
@@ -117,4 +117,64 @@ func TestValidatePipelineUpdate(t *testing.T) {
 		assert.False(t, r.Allowed)
 		assert.Contains(t, r.Result.Message, "storage is immutable for a reduce vertex")
 	})
+
+	t.Run("test adding a vertex and an edge is allowed", func(t *testing.T) {
+		newPipeline := pipeline.DeepCopy()
+		output := newPipeline.Spec.Vertices[3].DeepCopy()
+		output.Name = "output-2"
+		newPipeline.Spec.Vertices = append(newPipeline.Spec.Vertices, *output)
+		newPipeline.Spec.Edges = append(newPipeline.Spec.Edges, dfv1.Edge{From: "reduce", To: "output-2"})
+		v := NewPipelineValidator(&fk, pipeline, newPipeline)
+		r := v.ValidateUpdate(contextWithLogger(t))
+		assert.True(t, r.Allowed)
+	})
+
+	t.Run("test removing a vertex requires the pipeline to be paused and drained", func(t *testing.T) {
+		oldPipeline := pipeline.DeepCopy()
+		output := oldPipeline.Spec.Vertices[3].DeepCopy()
+		output.Name = "output-2"
+		oldPipeline.Spec.Vertices = append(oldPipeline.Spec.Vertices, *output)
+		oldPipeline.Spec.Edges = append(oldPipeline.Spec.Edges, dfv1.Edge{From: "reduce", To: "output-2"})
+		v := NewPipelineValidator(&fk, oldPipeline, pipeline)
+		r := v.ValidateUpdate(contextWithLogger(t))
+		assert.False(t, r.Allowed)
+		assert.Contains(t, r.Result.Message, `vertex "output-2" is removed`)
+		assert.Contains(t, r.Result.Message, `edge "reduce->output-2" is removed`)
+
+		oldPipeline.Status.Phase = dfv1.PipelinePhasePaused
+		oldPipeline.Status.DrainedOnPause = true
+		v = NewPipelineValidator(&fk, oldPipeline, pipeline)
+		r = v.ValidateUpdate(contextWithLogger(t))
+		assert.True(t, r.Allowed)
+	})
+
+	t.Run("test decreasing the partition count of a map vertex requires the pipeline to be paused and drained", func(t *testing.T) {
+		var oldPartitionCount, newPartitionCount int32 = 3, 2
+		oldPipeline := pipeline.DeepCopy()
+		oldPipeline.Spec.Vertices[1].Partitions = &oldPartitionCount
+		newPipeline := pipeline.DeepCopy()
+		newPipeline.Spec.Vertices[1].Partitions = &newPartitionCount
+		v := NewPipelineValidator(&fk, oldPipeline, newPipeline)
+		r := v.ValidateUpdate(contextWithLogger(t))
+		assert.False(t, r.Allowed)
+		assert.Contains(t, r.Result.Message, `partition count of vertex "map" is decreased from 3 to 2`)
+
+		// increasing is always allowed
+		v = NewPipelineValidator(&fk, newPipeline, oldPipeline)
+		r = v.ValidateUpdate(contextWithLogger(t))
+		assert.True(t, r.Allowed)
+	})
+
+	t.Run("test removing a vertex is allowed with the BlueGreen upgrade strategy", func(t *testing.T) {
+		oldPipeline := pipeline.DeepCopy()
+		output := oldPipeline.Spec.Vertices[3].DeepCopy()
+		output.Name = "output-2"
+		oldPipeline.Spec.Vertices = append(oldPipeline.Spec.Vertices, *output)
+		oldPipeline.Spec.Edges = append(oldPipeline.Spec.Edges, dfv1.Edge{From: "reduce", To: "output-2"})
+		newPipeline := pipeline.DeepCopy()
+		newPipeline.Spec.UpgradeStrategy = &dfv1.PipelineUpgradeStrategy{Type: dfv1.PipelineUpgradeStrategyBlueGreen}
+		v := NewPipelineValidator(&fk, oldPipeline, newPipeline)
+		r := v.ValidateUpdate(contextWithLogger(t))
+		assert.True(t, r.Allowed)
+	})
 }
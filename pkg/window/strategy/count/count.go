@@ -0,0 +1,37 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package count implements Count windows. Count windows are tumbling windows defined by the number of messages rather
+// than by time, e.g. every 1000 messages of a key. They are a special case of the global window which fires every
+// `count` messages, optionally a partially filled window is also closed once the key has been idle for the timeout.
+package count
+
+import (
+	"time"
+
+	dfv1 "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1"
+	"github.com/numaproj/numaflow/pkg/window"
+	"github.com/numaproj/numaflow/pkg/window/strategy/global"
+)
+
+// NewWindower returns a new TimedWindower for count windows. A zero timeout keeps a partially filled window open
+// until it receives enough messages.
+func NewWindower(vertexInstance *dfv1.VertexInstance, count int, timeout time.Duration) window.TimedWindower {
+	return global.NewWindowerWithStrategy(vertexInstance, window.Count, global.Trigger{
+		Count:       count,
+		IdleTimeout: timeout,
+	})
+}
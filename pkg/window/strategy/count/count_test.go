@@ -0,0 +1,91 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package count
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	dfv1 "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1"
+	"github.com/numaproj/numaflow/pkg/isb"
+	"github.com/numaproj/numaflow/pkg/window"
+)
+
+var keyedVertex = &dfv1.VertexInstance{
+	Vertex: &dfv1.Vertex{Spec: dfv1.VertexSpec{
+		PipelineName: "test-pl",
+		AbstractVertex: dfv1.AbstractVertex{
+			Name: "testVertex",
+			UDF:  &dfv1.UDF{GroupBy: &dfv1.GroupBy{Keyed: true}},
+		},
+	}},
+	Hostname: "test-host",
+	Replica:  0,
+}
+
+func buildReadMessage(eventTime time.Time, keys []string) *isb.ReadMessage {
+	return &isb.ReadMessage{
+		Message: isb.Message{
+			Header: isb.Header{
+				MessageInfo: isb.MessageInfo{
+					EventTime: eventTime,
+				},
+				Keys: keys,
+			},
+		},
+	}
+}
+
+func TestCount_AssignWindows(t *testing.T) {
+	windower := NewWindower(keyedVertex, 1000, 0)
+	assert.Equal(t, window.Count, windower.Strategy())
+	assert.Equal(t, window.Unaligned, windower.Type())
+
+	baseTime := time.UnixMilli(60000)
+	closed := map[string]int{}
+	for i := 0; i < 2500; i++ {
+		key := []string{"key-1"}
+		if i%2 == 1 {
+			key = []string{"key-2"}
+		}
+		for _, r := range windower.AssignWindows(buildReadMessage(baseTime.Add(time.Duration(i)*time.Millisecond), key)) {
+			if r.Operation == window.Close {
+				closed[r.Windows[0].Keys()[0]]++
+			}
+		}
+	}
+
+	// every key has seen 1250 messages, so only the first window of each key is closed
+	assert.Equal(t, map[string]int{"key-1": 1, "key-2": 1}, closed)
+
+	// without a timeout the partially filled windows stay open
+	assert.Empty(t, windower.CloseWindows(baseTime.Add(time.Hour)))
+}
+
+func TestCount_Timeout(t *testing.T) {
+	windower := NewWindower(keyedVertex, 1000, time.Minute)
+	baseTime := time.UnixMilli(60000)
+
+	windower.AssignWindows(buildReadMessage(baseTime, []string{"key-1"}))
+
+	assert.Empty(t, windower.CloseWindows(baseTime.Add(time.Minute)))
+	requests := windower.CloseWindows(baseTime.Add(time.Minute + time.Millisecond))
+	assert.Len(t, requests, 1)
+	assert.Equal(t, window.Close, requests[0].Operation)
+}
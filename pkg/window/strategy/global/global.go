@@ -0,0 +1,254 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package global implements Global windows. A Global window spans all the messages of a key, since it never ends
+// by itself it is fired by a Trigger. Every time the trigger fires, the messages collected so far for the key (a pane)
+// are closed and handed over to the reduce UDF, and the next message for the key opens a new pane.
+// Panes are unaligned (tracked per key), hence they share the unaligned PBQ and are replayed from the unaligned WAL.
+package global
+
+import (
+	"strconv"
+	"strings"
+	"time"
+
+	dfv1 "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1"
+	"github.com/numaproj/numaflow/pkg/isb"
+	"github.com/numaproj/numaflow/pkg/metrics"
+	"github.com/numaproj/numaflow/pkg/window"
+)
+
+// Trigger decides when a pane of the global window fires. The pane fires as soon as any of the enabled conditions is
+// met, a zero value disables the condition.
+type Trigger struct {
+	// Count fires the pane once it has seen Count messages.
+	Count int
+	// Interval fires the pane once the watermark has progressed Interval past the start of the pane.
+	Interval time.Duration
+	// IdleTimeout fires the pane once the watermark has progressed IdleTimeout past the last message of the pane.
+	IdleTimeout time.Duration
+}
+
+// pane is the currently open portion of the global window for a key.
+type pane struct {
+	// window spans the event times of the messages in the pane, [earliest, latest + 1ms).
+	window window.TimedWindow
+	// count is the number of messages assigned to the pane.
+	count int
+	// lastSeenEventTime is the latest event time seen in the pane.
+	lastSeenEventTime time.Time
+}
+
+// Windower is an implementation of TimedWindower for the global window, it assigns the messages to the open pane of
+// the key and fires the panes based on the trigger.
+type Windower struct {
+	vertexName    string
+	pipelineName  string
+	vertexReplica int32
+	strategy      window.Strategy
+	trigger       Trigger
+
+	// activePanes is a map of keys to the open pane, key is join of all the keys of the message.
+	activePanes map[string]*pane
+
+	// closedWindows is a list of fired panes which are yet to be GCed, they are tracked for publishing
+	// the watermark.
+	closedWindows *window.SortedWindowListByEndTime
+
+	// firedEndTimes tracks the end time of the last fired pane for each key. The compactor drops every persisted
+	// message of a key whose event time is before the end time of the fired pane, so the next pane must not start
+	// before it. A message assigned to such a pane is persisted at the start of the pane, else it will be lost during
+	// replay.
+	firedEndTimes map[string]time.Time
+}
+
+// NewWindower returns a new Windower for the global window.
+func NewWindower(vertexInstance *dfv1.VertexInstance, trigger Trigger) window.TimedWindower {
+	return newWindower(vertexInstance, window.Global, trigger)
+}
+
+// NewWindowerWithStrategy returns a global windower which reports the given strategy. It is used by strategies
+// which are a special case of the global window, e.g. count windows.
+func NewWindowerWithStrategy(vertexInstance *dfv1.VertexInstance, strategy window.Strategy, trigger Trigger) window.TimedWindower {
+	return newWindower(vertexInstance, strategy, trigger)
+}
+
+func newWindower(vertexInstance *dfv1.VertexInstance, strategy window.Strategy, trigger Trigger) *Windower {
+	return &Windower{
+		vertexName:    vertexInstance.Vertex.Name,
+		pipelineName:  vertexInstance.Vertex.Spec.PipelineName,
+		vertexReplica: vertexInstance.Replica,
+		strategy:      strategy,
+		trigger:       trigger,
+		activePanes:   make(map[string]*pane),
+		closedWindows: window.NewSortedWindowListByEndTime(),
+		firedEndTimes: make(map[string]time.Time),
+	}
+}
+
+var _ window.TimedWindower = (*Windower)(nil)
+
+// Strategy returns the window strategy.
+func (w *Windower) Strategy() window.Strategy {
+	return w.strategy
+}
+
+// Type returns the window type. Panes are created per key, hence the global window is an unaligned window.
+func (w *Windower) Type() window.Type {
+	return window.Unaligned
+}
+
+// AssignWindows assigns the message to the open pane of its key. This assignment could trigger the following
+// - Open a new pane, if there is no open pane for the key
+// - Expand the pane, if the event time of the message is outside the pane
+// - Append to the pane
+// - Close the pane, if the count trigger fires
+//
+// AssignWindows only depends on the order of the messages, so replaying the WAL assigns the persisted messages to the
+// same panes.
+func (w *Windower) AssignWindows(message *isb.ReadMessage) []*window.TimedWindowRequest {
+	var (
+		combinedKey      = strings.Join(message.Keys, dfv1.KeysDelimitter)
+		windowOperations = make([]*window.TimedWindowRequest, 0, 2)
+	)
+
+	// a message which belongs to a new pane cannot be older than the pane which was fired before, the pane is
+	// assigned as if the message was at the start of the new pane. The event time of the message is left intact.
+	eventTime := message.EventTime
+	if firedEndTime, ok := w.firedEndTimes[combinedKey]; ok && eventTime.Before(firedEndTime) {
+		eventTime = firedEndTime
+	}
+
+	p, ok := w.activePanes[combinedKey]
+	if !ok {
+		p = &pane{
+			window:            window.NewUnalignedTimedWindow(eventTime, eventTime.Add(time.Millisecond), "slot-0", message.Keys),
+			lastSeenEventTime: eventTime,
+		}
+		w.activePanes[combinedKey] = p
+		windowOperations = append(windowOperations, createWindowOperation(message, window.Open, p.window))
+	} else if eventTime.Before(p.window.StartTime()) || !eventTime.Before(p.window.EndTime()) {
+		oldWindow := cloneWindow(p.window)
+		p.window.Merge(window.NewUnalignedTimedWindow(eventTime, eventTime.Add(time.Millisecond), p.window.Slot(), p.window.Keys()))
+		windowOperations = append(windowOperations, &window.TimedWindowRequest{
+			ReadMessage: message,
+			Operation:   window.Expand,
+			Windows:     []window.TimedWindow{oldWindow, p.window},
+			ID:          &window.SharedUnalignedPartition,
+		})
+	} else {
+		windowOperations = append(windowOperations, createWindowOperation(message, window.Append, p.window))
+	}
+
+	p.count++
+	if eventTime.After(p.lastSeenEventTime) {
+		p.lastSeenEventTime = eventTime
+	}
+
+	if w.trigger.Count > 0 && p.count >= w.trigger.Count {
+		windowOperations = append(windowOperations, w.firePane(combinedKey, p))
+	}
+
+	return windowOperations
+}
+
+// InsertWindow is not applicable for the global window, since the panes are created per key.
+func (w *Windower) InsertWindow(window.TimedWindow) {
+	// Not Applicable for UnAligned
+}
+
+// CloseWindows fires the panes for which the time based triggers have fired as per the given time (watermark).
+func (w *Windower) CloseWindows(currentTime time.Time) []*window.TimedWindowRequest {
+	windowOperations := make([]*window.TimedWindowRequest, 0)
+
+	for key, p := range w.activePanes {
+		intervalFired := w.trigger.Interval > 0 && !currentTime.Before(p.window.StartTime().Add(w.trigger.Interval))
+		idleFired := w.trigger.IdleTimeout > 0 && currentTime.After(p.lastSeenEventTime.Add(w.trigger.IdleTimeout))
+		if intervalFired || idleFired {
+			windowOperations = append(windowOperations, w.firePane(key, p))
+		}
+	}
+
+	// once the watermark has passed the end of the fired pane, no on-time message can be older than it.
+	for key, endTime := range w.firedEndTimes {
+		if _, ok := w.activePanes[key]; !ok && !currentTime.Before(endTime) {
+			delete(w.firedEndTimes, key)
+		}
+	}
+
+	metrics.ActiveWindowsCount.With(map[string]string{
+		metrics.LabelVertex:             w.vertexName,
+		metrics.LabelPipeline:           w.pipelineName,
+		metrics.LabelVertexReplicaIndex: strconv.Itoa(int(w.vertexReplica)),
+	}).Set(float64(len(w.activePanes)))
+
+	metrics.ClosedWindowsCount.With(map[string]string{
+		metrics.LabelVertex:             w.vertexName,
+		metrics.LabelPipeline:           w.pipelineName,
+		metrics.LabelVertexReplicaIndex: strconv.Itoa(int(w.vertexReplica)),
+	}).Set(float64(w.closedWindows.Len()))
+
+	return windowOperations
+}
+
+// firePane closes the pane of the key and returns the close operation for it.
+func (w *Windower) firePane(key string, p *pane) *window.TimedWindowRequest {
+	delete(w.activePanes, key)
+	w.firedEndTimes[key] = p.window.EndTime()
+	w.closedWindows.Insert(p.window)
+	return createWindowOperation(nil, window.Close, p.window)
+}
+
+// NextWindowToBeClosed returns the next window yet to be closed. Since the panes are fired by the trigger, there is
+// no window to be closed.
+func (w *Windower) NextWindowToBeClosed() window.TimedWindow {
+	return nil
+}
+
+// DeleteClosedWindow deletes the fired pane from the closed windows list.
+func (w *Windower) DeleteClosedWindow(window window.TimedWindow) {
+	w.closedWindows.Delete(window)
+}
+
+// OldestWindowEndTime returns the end time of the oldest pane among both open and fired panes.
+// If there are no panes, it returns -1.
+func (w *Windower) OldestWindowEndTime() time.Time {
+	if win := w.closedWindows.Front(); win != nil {
+		return win.EndTime()
+	}
+
+	var minEndTime = time.UnixMilli(-1)
+	for _, p := range w.activePanes {
+		if minEndTime.UnixMilli() == -1 || p.window.EndTime().Before(minEndTime) {
+			minEndTime = p.window.EndTime()
+		}
+	}
+
+	return minEndTime
+}
+
+func createWindowOperation(message *isb.ReadMessage, operation window.Operation, win window.TimedWindow) *window.TimedWindowRequest {
+	return &window.TimedWindowRequest{
+		ReadMessage: message,
+		Operation:   operation,
+		Windows:     []window.TimedWindow{win},
+		ID:          &window.SharedUnalignedPartition,
+	}
+}
+
+func cloneWindow(win window.TimedWindow) window.TimedWindow {
+	return window.NewUnalignedTimedWindow(win.StartTime(), win.EndTime(), win.Slot(), win.Keys())
+}
@@ -0,0 +1,179 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package global
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	dfv1 "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1"
+	"github.com/numaproj/numaflow/pkg/isb"
+	"github.com/numaproj/numaflow/pkg/window"
+)
+
+var keyedVertex = &dfv1.VertexInstance{
+	Vertex: &dfv1.Vertex{Spec: dfv1.VertexSpec{
+		PipelineName: "test-pl",
+		AbstractVertex: dfv1.AbstractVertex{
+			Name: "testVertex",
+			UDF:  &dfv1.UDF{GroupBy: &dfv1.GroupBy{Keyed: true}},
+		},
+	}},
+	Hostname: "test-host",
+	Replica:  0,
+}
+
+func buildReadMessage(eventTime time.Time, keys []string) *isb.ReadMessage {
+	return &isb.ReadMessage{
+		Message: isb.Message{
+			Header: isb.Header{
+				MessageInfo: isb.MessageInfo{
+					EventTime: eventTime,
+				},
+				Keys: keys,
+			},
+		},
+	}
+}
+
+func operations(requests []*window.TimedWindowRequest) []window.Operation {
+	ops := make([]window.Operation, 0, len(requests))
+	for _, r := range requests {
+		ops = append(ops, r.Operation)
+	}
+	return ops
+}
+
+func TestGlobal_AssignWindowsCountTrigger(t *testing.T) {
+	windower := NewWindower(keyedVertex, Trigger{Count: 3})
+	assert.Equal(t, window.Global, windower.Strategy())
+	assert.Equal(t, window.Unaligned, windower.Type())
+
+	baseTime := time.UnixMilli(60000)
+	keys := []string{"key-1"}
+
+	requests := windower.AssignWindows(buildReadMessage(baseTime, keys))
+	assert.Equal(t, []window.Operation{window.Open}, operations(requests))
+	assert.Equal(t, baseTime, requests[0].Windows[0].StartTime())
+	assert.Equal(t, baseTime.Add(time.Millisecond), requests[0].Windows[0].EndTime())
+	assert.Equal(t, &window.SharedUnalignedPartition, requests[0].ID)
+
+	// same event time, appended to the pane
+	requests = windower.AssignWindows(buildReadMessage(baseTime, keys))
+	assert.Equal(t, []window.Operation{window.Append}, operations(requests))
+
+	// newer event time, the pane is expanded and the count trigger fires
+	requests = windower.AssignWindows(buildReadMessage(baseTime.Add(10*time.Millisecond), keys))
+	assert.Equal(t, []window.Operation{window.Expand, window.Close}, operations(requests))
+	assert.Equal(t, baseTime.Add(time.Millisecond), requests[0].Windows[0].EndTime())
+	assert.Equal(t, baseTime.Add(11*time.Millisecond), requests[0].Windows[1].EndTime())
+	assert.Equal(t, baseTime, requests[1].Windows[0].StartTime())
+	assert.Equal(t, baseTime.Add(11*time.Millisecond), requests[1].Windows[0].EndTime())
+	assert.Equal(t, baseTime.Add(11*time.Millisecond), windower.OldestWindowEndTime())
+
+	// the next message opens a new pane, the pane of an older event time starts at the end of the fired pane
+	// and the event time of the message is left intact
+	msg := buildReadMessage(baseTime.Add(5*time.Millisecond), keys)
+	requests = windower.AssignWindows(msg)
+	assert.Equal(t, []window.Operation{window.Open}, operations(requests))
+	assert.Equal(t, baseTime.Add(5*time.Millisecond), msg.EventTime)
+	assert.Equal(t, baseTime.Add(11*time.Millisecond), requests[0].Windows[0].StartTime())
+
+	// panes are tracked per key
+	requests = windower.AssignWindows(buildReadMessage(baseTime, []string{"key-2"}))
+	assert.Equal(t, []window.Operation{window.Open}, operations(requests))
+	assert.Equal(t, baseTime, requests[0].Windows[0].StartTime())
+}
+
+func TestGlobal_CloseWindowsIntervalTrigger(t *testing.T) {
+	windower := NewWindower(keyedVertex, Trigger{Interval: time.Second})
+	baseTime := time.UnixMilli(60000)
+
+	windower.AssignWindows(buildReadMessage(baseTime, []string{"key-1"}))
+	windower.AssignWindows(buildReadMessage(baseTime.Add(500*time.Millisecond), []string{"key-2"}))
+	// the interval trigger does not depend on the activity of the key
+	windower.AssignWindows(buildReadMessage(baseTime.Add(900*time.Millisecond), []string{"key-1"}))
+
+	assert.Empty(t, windower.CloseWindows(baseTime.Add(999*time.Millisecond)))
+
+	requests := windower.CloseWindows(baseTime.Add(time.Second))
+	assert.Equal(t, []window.Operation{window.Close}, operations(requests))
+	assert.Equal(t, []string{"key-1"}, requests[0].Windows[0].Keys())
+	assert.Equal(t, baseTime.Add(901*time.Millisecond), requests[0].Windows[0].EndTime())
+
+	requests = windower.CloseWindows(baseTime.Add(1500 * time.Millisecond))
+	assert.Equal(t, []window.Operation{window.Close}, operations(requests))
+	assert.Equal(t, []string{"key-2"}, requests[0].Windows[0].Keys())
+}
+
+func TestGlobal_CloseWindowsIdleTimeout(t *testing.T) {
+	windower := NewWindower(keyedVertex, Trigger{Count: 10, IdleTimeout: time.Second})
+	baseTime := time.UnixMilli(60000)
+
+	windower.AssignWindows(buildReadMessage(baseTime, []string{"key-1"}))
+	windower.AssignWindows(buildReadMessage(baseTime.Add(500*time.Millisecond), []string{"key-1"}))
+
+	assert.Empty(t, windower.CloseWindows(baseTime.Add(time.Second)))
+	assert.Empty(t, windower.CloseWindows(baseTime.Add(1500*time.Millisecond)))
+
+	requests := windower.CloseWindows(baseTime.Add(1501 * time.Millisecond))
+	assert.Equal(t, []window.Operation{window.Close}, operations(requests))
+}
+
+func TestGlobal_DeleteClosedWindow(t *testing.T) {
+	windower := NewWindower(keyedVertex, Trigger{Count: 1})
+	baseTime := time.UnixMilli(60000)
+
+	assert.Equal(t, time.UnixMilli(-1), windower.OldestWindowEndTime())
+
+	requests := windower.AssignWindows(buildReadMessage(baseTime, []string{"key-1"}))
+	assert.Equal(t, []window.Operation{window.Open, window.Close}, operations(requests))
+	assert.Equal(t, baseTime.Add(time.Millisecond), windower.OldestWindowEndTime())
+
+	// the window in the EOF response of the UDF is rebuilt from the start, end and keys
+	windower.DeleteClosedWindow(window.NewUnalignedTimedWindow(baseTime, baseTime.Add(time.Millisecond), "slot-0", []string{"key-1"}))
+	assert.Equal(t, time.UnixMilli(-1), windower.OldestWindowEndTime())
+	assert.Nil(t, windower.NextWindowToBeClosed())
+}
+
+func TestGlobal_ReplayAssignsSamePanes(t *testing.T) {
+	baseTime := time.UnixMilli(60000)
+	messages := make([]*isb.ReadMessage, 0)
+	for i := 0; i < 10; i++ {
+		messages = append(messages, buildReadMessage(baseTime.Add(time.Duration(i%4)*time.Millisecond), []string{"key-1"}))
+	}
+
+	assign := func() []string {
+		windower := NewWindower(keyedVertex, Trigger{Count: 4})
+		var closed []string
+		for _, msg := range messages {
+			for _, r := range windower.AssignWindows(msg) {
+				if r.Operation == window.Close {
+					closed = append(closed, r.Windows[0].ID())
+				}
+			}
+		}
+		return closed
+	}
+
+	// the messages are mutated during the first assignment, replay sees the persisted (mutated) messages
+	first := assign()
+	assert.Len(t, first, 2)
+	assert.Equal(t, first, assign())
+}
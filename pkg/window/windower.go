@@ -241,6 +241,8 @@ type TimedWindowRequest struct {
 	ID *partition.ID
 	// windows is the list of windows on which the operation is performed
 	Windows []TimedWindow
+	// Firing is the kind of result asked for by the Fire operation.
+	Firing Firing
 }
 
 // TimedWindowResponse is the response from the UDF based on how the result is propagated back.
@@ -275,6 +277,9 @@ const (
 	Append
 	// Expand expands the existing window, used in SessionWindow after adding a new element or after a window merge operation.
 	Expand
+	// Fire asks for the result of the window computed so far without closing the window, used for the early and late
+	// firings of Aligned windows. It is never sent to the UDF.
+	Fire
 )
 
 func (e Operation) String() string {
@@ -291,11 +296,38 @@ func (e Operation) String() string {
 		return "Append"
 	case Expand:
 		return "Expand"
+	case Fire:
+		return "Fire"
 	default:
 		return "Unknown"
 	}
 }
 
+// Firing represents why a result of a window is emitted.
+type Firing int
+
+const (
+	// OnTime is the result emitted once the watermark passes the end of the window.
+	OnTime Firing = iota
+	// Early is a speculative partial result emitted before the watermark passes the end of the window.
+	Early
+	// Late is an updated result emitted after late data has arrived for the window.
+	Late
+)
+
+func (f Firing) String() string {
+	switch f {
+	case OnTime:
+		return "on-time"
+	case Early:
+		return "early"
+	case Late:
+		return "late"
+	default:
+		return "unknown"
+	}
+}
+
 // Type represents window type
 type Type int
 
@@ -323,6 +355,8 @@ const (
 	Sliding
 	Session
 	Accumulator
+	Count
+	Global
 )
 
 func (s Strategy) String() string {
@@ -335,6 +369,10 @@ func (s Strategy) String() string {
 		return "Session"
 	case Accumulator:
 		return "Accumulator"
+	case Count:
+		return "Count"
+	case Global:
+		return "Global"
 	default:
 		return "Unknown"
 	}
@@ -91,10 +91,12 @@ pub async fn create_sqs_client(config: SqsConfig) -> Result<Client, Error> {
     let mut config_builder =
         aws_config::defaults(BehaviorVersion::v2025_01_17()).region(region_provider);
 
-    // Apply endpoint URL if configured for source
-    if let SqsConfig::Source(cfg) = &config
-        && let Some(endpoint_url) = &cfg.endpoint_url
-    {
+    // Apply endpoint URL if configured
+    let endpoint_url = match &config {
+        SqsConfig::Source(cfg) => &cfg.endpoint_url,
+        SqsConfig::Sink(cfg) => &cfg.endpoint_url,
+    };
+    if let Some(endpoint_url) = endpoint_url {
         config_builder = config_builder.endpoint_url(endpoint_url);
     }
 
@@ -20,6 +20,8 @@ pub struct SqsSinkConfig {
     pub queue_name: &'static str,
     /// AWS account ID of the queue owner
     pub queue_owner_aws_account_id: &'static str,
+    /// Optional custom endpoint URL for the SQS API
+    pub endpoint_url: Option<String>,
 }
 
 /// Message to be sent to SQS.
@@ -62,6 +64,7 @@ impl Default for SqsSinkBuilder {
             region: SQS_DEFAULT_REGION,
             queue_name: "",
             queue_owner_aws_account_id: "",
+            endpoint_url: None,
         })
     }
 }
@@ -207,6 +210,7 @@ mod tests {
             region: "us-west-2",
             queue_name: "test-queue",
             queue_owner_aws_account_id: "123456789012",
+            endpoint_url: None,
         };
 
         let result = crate::create_sqs_client(SqsConfig::Sink(config.clone())).await;
@@ -233,6 +237,7 @@ mod tests {
             region: SQS_DEFAULT_REGION,
             queue_name: "test-q",
             queue_owner_aws_account_id: "123456789012",
+            endpoint_url: None,
         };
 
         let sink = SqsSinkBuilder::new(config.clone())
@@ -265,6 +270,7 @@ mod tests {
             region: SQS_DEFAULT_REGION,
             queue_name: "test-q",
             queue_owner_aws_account_id: "123456789012",
+            endpoint_url: None,
         };
 
         let sink = SqsSinkBuilder::new(config.clone())
@@ -307,6 +313,7 @@ mod tests {
             region: SQS_DEFAULT_REGION,
             queue_name: "test-q",
             queue_owner_aws_account_id: "123456789012",
+            endpoint_url: None,
         };
 
         let sink = SqsSinkBuilder::new(config.clone())
@@ -352,6 +359,7 @@ mod tests {
             region: SQS_DEFAULT_REGION,
             queue_name: "test-q",
             queue_owner_aws_account_id: "123456789012",
+            endpoint_url: None,
         };
 
         let sink = SqsSinkBuilder::new(config.clone())
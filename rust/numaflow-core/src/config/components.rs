@@ -620,6 +620,7 @@ pub(crate) mod sink {
                 queue_owner_aws_account_id: Box::leak(
                     value.queue_owner_aws_account_id.into_boxed_str(),
                 ),
+                endpoint_url: value.endpoint_url,
             };
             Ok(SinkType::Sqs(sqs_sink_config))
         }
@@ -1711,6 +1712,7 @@ mod sink_tests {
             queue_name: "test-queue",
             region: "us-west-2",
             queue_owner_aws_account_id: "123456789012",
+            endpoint_url: None,
         };
         let sink_config = SinkConfig {
             sink_type: SinkType::Sqs(sqs_config.clone()),
@@ -1732,6 +1734,7 @@ mod sink_tests {
             aws_region: "us-west-2".to_string(),
             queue_name: "test-queue".to_string(),
             queue_owner_aws_account_id: "123456789012".to_string(),
+            endpoint_url: None,
         });
 
         let result = SinkType::try_from(valid_sqs_sink);
@@ -1749,6 +1752,7 @@ mod sink_tests {
             aws_region: "".to_string(),
             queue_name: "test-queue".to_string(),
             queue_owner_aws_account_id: "123456789012".to_string(),
+            endpoint_url: None,
         });
 
         let result = SinkType::try_from(invalid_sqs_sink);
@@ -1779,6 +1783,7 @@ mod sink_tests {
                     aws_region: "us-west-2".to_string(),
                     queue_name: "fallback-queue".to_string(),
                     queue_owner_aws_account_id: "123456789012".to_string(),
+                    endpoint_url: None,
                 })),
                 kafka: None,
                 pulsar: None,
@@ -1834,6 +1839,7 @@ mod sink_tests {
                     aws_region: "".to_string(),
                     queue_name: "fallback-queue".to_string(),
                     queue_owner_aws_account_id: "123456789012".to_string(),
+                    endpoint_url: None,
                 })),
                 kafka: None,
                 pulsar: None,
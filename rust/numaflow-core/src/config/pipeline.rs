@@ -1093,6 +1093,7 @@ mod tests {
     #[test]
     fn test_map_vertex_config_user_defined() {
         let udf = Udf {
+            builtin: None,
             container: Some(Box::from(Container {
                 args: None,
                 command: None,
@@ -1376,10 +1376,13 @@ mod tests {
                         streams: vertex1_streams.clone(),
                         ..Default::default()
                     },
-                    conditions: Some(Box::new(ForwardConditions::new(TagConditions {
-                        operator: Some("and".to_string()),
-                        values: vec!["tag1".to_string(), "tag2".to_string()],
-                    }))),
+                    conditions: Some(Box::new(ForwardConditions {
+                        tags: Some(Box::new(TagConditions {
+                            operator: Some("and".to_string()),
+                            values: vec!["tag1".to_string(), "tag2".to_string()],
+                        })),
+                        ..ForwardConditions::new()
+                    })),
                     to_vertex_type: VertexType::Sink,
                 },
                 ToVertexConfig {
@@ -1389,10 +1392,13 @@ mod tests {
                         streams: vertex2_streams.clone(),
                         ..Default::default()
                     },
-                    conditions: Some(Box::new(ForwardConditions::new(TagConditions {
-                        operator: Some("or".to_string()),
-                        values: vec!["tag2".to_string()],
-                    }))),
+                    conditions: Some(Box::new(ForwardConditions {
+                        tags: Some(Box::new(TagConditions {
+                            operator: Some("or".to_string()),
+                            values: vec!["tag2".to_string()],
+                        })),
+                        ..ForwardConditions::new()
+                    })),
                     to_vertex_type: VertexType::Sink,
                 },
                 ToVertexConfig {
@@ -1402,10 +1408,13 @@ mod tests {
                         streams: vertex3_streams.clone(),
                         ..Default::default()
                     },
-                    conditions: Some(Box::new(ForwardConditions::new(TagConditions {
-                        operator: Some("not".to_string()),
-                        values: vec!["tag1".to_string()],
-                    }))),
+                    conditions: Some(Box::new(ForwardConditions {
+                        tags: Some(Box::new(TagConditions {
+                            operator: Some("not".to_string()),
+                            values: vec!["tag1".to_string()],
+                        })),
+                        ..ForwardConditions::new()
+                    })),
                     to_vertex_type: VertexType::Sink,
                 },
             ],
@@ -15,16 +15,20 @@ pub(crate) fn should_forward(
         return true;
     };
 
-    // Return true if there are no tags in the edge condition
-    if conditions.tags.values.is_empty() {
+    // Return true if there are no tags in the edge condition, expression conditions are only
+    // evaluated by the Go data plane.
+    let Some(tag_conditions) = conditions.tags else {
+        return true;
+    };
+    if tag_conditions.values.is_empty() {
         return true;
     }
 
     // Treat missing tags as empty and check the condition
     let tags = tags.unwrap_or_else(|| Arc::from(vec![]));
     // Default operator is "or", if not specified
-    let operator = conditions.tags.operator.as_deref().unwrap_or("or");
-    check_operator_condition(operator, &conditions.tags.values, &tags)
+    let operator = tag_conditions.operator.as_deref().unwrap_or("or");
+    check_operator_condition(operator, &tag_conditions.values, &tags)
 }
 
 /// Determine the partition to write the message to by hashing the message id.
@@ -84,7 +88,10 @@ mod tests {
     async fn test_evaluate_write_condition_and_operator() {
         let mut tag_conditions = TagConditions::new(vec!["tag1".to_string(), "tag2".to_string()]);
         tag_conditions.operator = Some("and".to_string());
-        let conditions = ForwardConditions::new(tag_conditions);
+        let conditions = ForwardConditions {
+            tags: Some(Box::new(tag_conditions)),
+            ..ForwardConditions::new()
+        };
         let tags = Some(Arc::from(vec!["tag1".to_string(), "tag2".to_string()]));
         let result = should_forward(tags, Some(Box::new(conditions)));
         assert!(result);
@@ -94,7 +101,10 @@ mod tests {
     async fn test_evaluate_write_condition_or_operator() {
         let mut tag_conditions = TagConditions::new(vec!["tag1".to_string()]);
         tag_conditions.operator = Some("or".to_string());
-        let conditions = ForwardConditions::new(tag_conditions);
+        let conditions = ForwardConditions {
+            tags: Some(Box::new(tag_conditions)),
+            ..ForwardConditions::new()
+        };
         let tags = Some(Arc::from(vec!["tag2".to_string(), "tag1".to_string()]));
         let result = should_forward(tags, Some(Box::new(conditions)));
         assert!(result);
@@ -104,7 +114,10 @@ mod tests {
     async fn test_evaluate_write_condition_not_operator() {
         let mut tag_conditions = TagConditions::new(vec!["tag1".to_string()]);
         tag_conditions.operator = Some("not".to_string());
-        let conditions = ForwardConditions::new(tag_conditions);
+        let conditions = ForwardConditions {
+            tags: Some(Box::new(tag_conditions)),
+            ..ForwardConditions::new()
+        };
         let tags = Some(Arc::from(vec!["tag2".to_string()]));
         let result = should_forward(tags, Some(Box::new(conditions)));
         assert!(result);
@@ -114,7 +127,10 @@ mod tests {
     async fn test_empty_tags_with_and_operator() {
         let mut tag_conditions = TagConditions::new(vec!["tag1".to_string(), "tag2".to_string()]);
         tag_conditions.operator = Some("and".to_string());
-        let conditions = ForwardConditions::new(tag_conditions);
+        let conditions = ForwardConditions {
+            tags: Some(Box::new(tag_conditions)),
+            ..ForwardConditions::new()
+        };
 
         // Empty tags array (explicit empty)
         let tags = Some(Arc::from(Vec::<String>::new()));
@@ -130,7 +146,10 @@ mod tests {
     async fn test_empty_tags_with_or_operator() {
         let mut tag_conditions = TagConditions::new(vec!["tag1".to_string(), "tag2".to_string()]);
         tag_conditions.operator = Some("or".to_string());
-        let conditions = ForwardConditions::new(tag_conditions);
+        let conditions = ForwardConditions {
+            tags: Some(Box::new(tag_conditions)),
+            ..ForwardConditions::new()
+        };
 
         // Empty tags array (explicit empty)
         let tags = Some(Arc::from(Vec::<String>::new()));
@@ -146,7 +165,10 @@ mod tests {
     async fn test_empty_tags_with_not_operator() {
         let mut tag_conditions = TagConditions::new(vec!["tag1".to_string(), "tag2".to_string()]);
         tag_conditions.operator = Some("not".to_string());
-        let conditions = ForwardConditions::new(tag_conditions);
+        let conditions = ForwardConditions {
+            tags: Some(Box::new(tag_conditions)),
+            ..ForwardConditions::new()
+        };
 
         // Empty tags array (explicit empty)
         let tags = Some(Arc::from(Vec::<String>::new()));
@@ -161,7 +183,10 @@ mod tests {
     #[tokio::test]
     async fn test_default_operator() {
         let tag_conditions = TagConditions::new(vec!["tag1".to_string(), "tag2".to_string()]);
-        let conditions = ForwardConditions::new(tag_conditions);
+        let conditions = ForwardConditions {
+            tags: Some(Box::new(tag_conditions)),
+            ..ForwardConditions::new()
+        };
         let tags = Some(Arc::from(vec!["tag1".to_string(), "tag2".to_string()]));
         let result = should_forward(tags, Some(Box::new(conditions)));
         assert!(result);
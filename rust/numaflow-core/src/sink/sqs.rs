@@ -267,6 +267,7 @@ pub mod tests {
             region: SQS_DEFAULT_REGION,
             queue_name: "test-q",
             queue_owner_aws_account_id: "12345678912",
+            endpoint_url: None,
         })
         .client(sqs_client)
         .build()
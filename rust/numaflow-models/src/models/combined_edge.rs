@@ -35,9 +35,14 @@ pub struct CombinedEdge {
     /// From vertex type.
     #[serde(rename = "fromVertexType")]
     pub from_vertex_type: String,
+    #[serde(rename = "hotKeySalting", skip_serializing_if = "Option::is_none")]
+    pub hot_key_salting: Option<Box<crate::models::HotKeySalting>>,
     /// OnFull specifies the behaviour for the write actions when the inter step buffer is full. There are currently two options, retryUntilSuccess and discardLatest. if not provided, the default value is set to \"retryUntilSuccess\"
     #[serde(rename = "onFull", skip_serializing_if = "Option::is_none")]
     pub on_full: Option<String>,
+    /// Partitioning specifies how the messages are assigned to the partitions of the to vertex based on the keys. There are currently two options, modulo and jumpHash. With modulo, changing the partition count remaps nearly every key, while jumpHash only moves ~1/N of the keys, which is preferred for keyed reduce vertices. if not provided, the default value is set to \"modulo\"
+    #[serde(rename = "partitioning", skip_serializing_if = "Option::is_none")]
+    pub partitioning: Option<String>,
     #[serde(rename = "to")]
     pub to: String,
     #[serde(rename = "toVertexLimits", skip_serializing_if = "Option::is_none")]
@@ -67,7 +72,9 @@ impl CombinedEdge {
             from_vertex_limits: None,
             from_vertex_partition_count: None,
             from_vertex_type,
+            hot_key_salting: None,
             on_full: None,
+            partitioning: None,
             to,
             to_vertex_limits: None,
             to_vertex_partition_count: None,
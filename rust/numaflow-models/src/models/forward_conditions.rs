@@ -18,14 +18,18 @@ limitations under the License.
 
 #[derive(Clone, Debug, PartialEq, Serialize, Deserialize)]
 pub struct ForwardConditions {
-    #[serde(rename = "tags")]
-    pub tags: Box<crate::models::TagConditions>,
+    /// Expression is a boolean expression evaluated against the payload and the headers of a message, the message is forwarded only if it evaluates to true. The payload is available as \"payload\" and the headers as \"headers\", along with the json(), int() and string() functions, e.g. `json(payload).amount > 100 && headers[\"type\"] == \"order\"`. A message which fails the evaluation, e.g. a payload which is not a valid JSON, is not forwarded. If both tags and expression are specified, the message needs to match both of them.
+    #[serde(rename = "expression", skip_serializing_if = "Option::is_none")]
+    pub expression: Option<String>,
+    #[serde(rename = "tags", skip_serializing_if = "Option::is_none")]
+    pub tags: Option<Box<crate::models::TagConditions>>,
 }
 
 impl ForwardConditions {
-    pub fn new(tags: crate::models::TagConditions) -> ForwardConditions {
+    pub fn new() -> ForwardConditions {
         ForwardConditions {
-            tags: Box::new(tags),
+            expression: None,
+            tags: None,
         }
     }
 }
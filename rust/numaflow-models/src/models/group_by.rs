@@ -22,8 +22,15 @@ limitations under the License.
 pub struct GroupBy {
     #[serde(rename = "allowedLateness", skip_serializing_if = "Option::is_none")]
     pub allowed_lateness: Option<kube::core::Duration>,
+    #[serde(rename = "earlyFiring", skip_serializing_if = "Option::is_none")]
+    pub early_firing: Option<Box<crate::models::EarlyFiring>>,
     #[serde(rename = "keyed", skip_serializing_if = "Option::is_none")]
     pub keyed: Option<bool>,
+    #[serde(rename = "lateData", skip_serializing_if = "Option::is_none")]
+    pub late_data: Option<Box<crate::models::LateDataOutput>>,
+    /// LateFiring emits the result of a fixed or sliding window once the watermark passes the end of the window, and re-emits the updated result whenever late data arrives within AllowedLateness.
+    #[serde(rename = "lateFiring", skip_serializing_if = "Option::is_none")]
+    pub late_firing: Option<bool>,
     #[serde(rename = "storage", skip_serializing_if = "Option::is_none")]
     pub storage: Option<Box<crate::models::PbqStorage>>,
     #[serde(rename = "window")]
@@ -35,7 +42,10 @@ impl GroupBy {
     pub fn new(window: crate::models::Window) -> GroupBy {
         GroupBy {
             allowed_lateness: None,
+            early_firing: None,
             keyed: None,
+            late_data: None,
+            late_firing: None,
             storage: None,
             window: Box::new(window),
         }
@@ -18,8 +18,13 @@ limitations under the License.
 
 #[derive(Clone, Debug, PartialEq, Serialize, Deserialize)]
 pub struct HttpSource {
+    #[serde(rename = "ackTimeout", skip_serializing_if = "Option::is_none")]
+    pub ack_timeout: Option<kube::core::Duration>,
     #[serde(rename = "auth", skip_serializing_if = "Option::is_none")]
     pub auth: Option<Box<crate::models::Authorization>>,
+    /// DurableAck makes the requests return only after their messages are written to the inter-step buffer, so that an accepted request is not lost when the pod restarts. When the source is not able to keep up, the requests are rejected with 429 and a Retry-After header instead of being blocked.
+    #[serde(rename = "durableAck", skip_serializing_if = "Option::is_none")]
+    pub durable_ack: Option<bool>,
     /// Whether to create a ClusterIP Service
     #[serde(rename = "service", skip_serializing_if = "Option::is_none")]
     pub service: Option<bool>,
@@ -28,7 +33,9 @@ pub struct HttpSource {
 impl HttpSource {
     pub fn new() -> HttpSource {
         HttpSource {
+            ack_timeout: None,
             auth: None,
+            durable_ack: None,
             service: None,
         }
     }
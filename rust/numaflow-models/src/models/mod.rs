@@ -26,14 +26,26 @@ pub mod container_builder;
 pub use self::container_builder::ContainerBuilder;
 pub mod container_template;
 pub use self::container_template::ContainerTemplate;
+pub mod count_window;
+pub use self::count_window::CountWindow;
+pub mod cpu_scale_policy;
+pub use self::cpu_scale_policy::CpuScalePolicy;
 pub mod daemon_template;
 pub use self::daemon_template::DaemonTemplate;
+pub mod dead_letter_output;
+pub use self::dead_letter_output::DeadLetterOutput;
+pub mod dedup_window;
+pub use self::dedup_window::DedupWindow;
+pub mod early_firing;
+pub use self::early_firing::EarlyFiring;
 pub mod edge;
 pub use self::edge::Edge;
 pub mod fixed_window;
 pub use self::fixed_window::FixedWindow;
 pub mod forward_conditions;
 pub use self::forward_conditions::ForwardConditions;
+pub mod function;
+pub use self::function::Function;
 pub mod generator_source;
 pub use self::generator_source::GeneratorSource;
 pub mod get_container_req;
@@ -58,10 +70,14 @@ pub mod get_side_input_deployment_req;
 pub use self::get_side_input_deployment_req::GetSideInputDeploymentReq;
 pub mod get_vertex_pod_spec_req;
 pub use self::get_vertex_pod_spec_req::GetVertexPodSpecReq;
+pub mod global_window;
+pub use self::global_window::GlobalWindow;
 pub mod group_by;
 pub use self::group_by::GroupBy;
 pub mod gssapi;
 pub use self::gssapi::Gssapi;
+pub mod hot_key_salting;
+pub use self::hot_key_salting::HotKeySalting;
 pub mod http_source;
 pub use self::http_source::HttpSource;
 pub mod idle_source;
@@ -88,6 +104,8 @@ pub mod kafka_sink;
 pub use self::kafka_sink::KafkaSink;
 pub mod kafka_source;
 pub use self::kafka_source::KafkaSource;
+pub mod late_data_output;
+pub use self::late_data_output::LateDataOutput;
 pub mod lifecycle;
 pub use self::lifecycle::Lifecycle;
 pub mod log;
@@ -114,6 +132,8 @@ pub mod nats_source;
 pub use self::nats_source::NatsSource;
 pub mod no_store;
 pub use self::no_store::NoStore;
+pub mod object_store_storage;
+pub use self::object_store_storage::ObjectStoreStorage;
 pub mod pbq_storage;
 pub use self::pbq_storage::PbqStorage;
 pub mod persistence_strategy;
@@ -128,8 +148,14 @@ pub mod pipeline_spec;
 pub use self::pipeline_spec::PipelineSpec;
 pub mod pipeline_status;
 pub use self::pipeline_status::PipelineStatus;
+pub mod pipeline_upgrade_status;
+pub use self::pipeline_upgrade_status::PipelineUpgradeStatus;
+pub mod pipeline_upgrade_strategy;
+pub use self::pipeline_upgrade_strategy::PipelineUpgradeStrategy;
 pub mod ports;
 pub use self::ports::Ports;
+pub mod predictive_scale_policy;
+pub use self::predictive_scale_policy::PredictiveScalePolicy;
 pub mod probe;
 pub use self::probe::Probe;
 pub mod pulsar_auth;
@@ -158,6 +184,12 @@ pub mod saslo_auth;
 pub use self::saslo_auth::SasloAuth;
 pub mod scale;
 pub use self::scale::Scale;
+pub mod scale_policy;
+pub use self::scale_policy::ScalePolicy;
+pub mod scale_schedule;
+pub use self::scale_schedule::ScaleSchedule;
+pub mod scaling_decision;
+pub use self::scaling_decision::ScalingDecision;
 pub mod serve_sink;
 pub use self::serve_sink::ServeSink;
 pub mod serving_pipeline;
@@ -180,6 +212,8 @@ pub mod side_input;
 pub use self::side_input::SideInput;
 pub mod side_input_trigger;
 pub use self::side_input_trigger::SideInputTrigger;
+pub mod side_input_watch;
+pub use self::side_input_watch::SideInputWatch;
 pub mod side_inputs_manager_template;
 pub use self::side_inputs_manager_template::SideInputsManagerTemplate;
 pub mod sink;
@@ -196,6 +230,8 @@ pub mod status;
 pub use self::status::Status;
 pub mod tag_conditions;
 pub use self::tag_conditions::TagConditions;
+pub mod target_lag_scale_policy;
+pub use self::target_lag_scale_policy::TargetLagScalePolicy;
 pub mod templates;
 pub use self::templates::Templates;
 pub mod tls;
@@ -230,3 +266,5 @@ pub mod watermark;
 pub use self::watermark::Watermark;
 pub mod window;
 pub use self::window::Window;
+pub mod window_trigger;
+pub use self::window_trigger::WindowTrigger;
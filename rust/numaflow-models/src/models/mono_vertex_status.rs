@@ -18,6 +18,12 @@ limitations under the License.
 
 #[derive(Clone, Debug, PartialEq, Serialize, Deserialize)]
 pub struct MonoVertexStatus {
+    /// The name of the active scale schedule, if there's any.
+    #[serde(
+        rename = "activeScaleSchedule",
+        skip_serializing_if = "Option::is_none"
+    )]
+    pub active_scale_schedule: Option<String>,
     /// Conditions are the latest available observations of a resource's current state.
     #[serde(rename = "conditions", skip_serializing_if = "Option::is_none")]
     pub conditions: Option<Vec<k8s_openapi::apimachinery::pkg::apis::meta::v1::Condition>>,
@@ -65,6 +71,7 @@ pub struct MonoVertexStatus {
 impl MonoVertexStatus {
     pub fn new() -> MonoVertexStatus {
         MonoVertexStatus {
+            active_scale_schedule: None,
             conditions: None,
             current_hash: None,
             desired_replicas: None,
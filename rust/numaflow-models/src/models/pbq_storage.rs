@@ -24,6 +24,8 @@ pub struct PbqStorage {
     pub empty_dir: Option<k8s_openapi::api::core::v1::EmptyDirVolumeSource>,
     #[serde(rename = "no_store", skip_serializing_if = "Option::is_none")]
     pub no_store: Option<Box<crate::models::NoStore>>,
+    #[serde(rename = "objectStore", skip_serializing_if = "Option::is_none")]
+    pub object_store: Option<Box<crate::models::ObjectStoreStorage>>,
     #[serde(
         rename = "persistentVolumeClaim",
         skip_serializing_if = "Option::is_none"
@@ -37,6 +39,7 @@ impl PbqStorage {
         PbqStorage {
             empty_dir: None,
             no_store: None,
+            object_store: None,
             persistent_volume_claim: None,
         }
     }
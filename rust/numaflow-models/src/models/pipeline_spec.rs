@@ -38,6 +38,8 @@ pub struct PipelineSpec {
     pub side_inputs: Option<Vec<crate::models::SideInput>>,
     #[serde(rename = "templates", skip_serializing_if = "Option::is_none")]
     pub templates: Option<Box<crate::models::Templates>>,
+    #[serde(rename = "upgradeStrategy", skip_serializing_if = "Option::is_none")]
+    pub upgrade_strategy: Option<Box<crate::models::PipelineUpgradeStrategy>>,
     #[serde(rename = "vertices", skip_serializing_if = "Option::is_none")]
     pub vertices: Option<Vec<crate::models::AbstractVertex>>,
     #[serde(rename = "watermark", skip_serializing_if = "Option::is_none")]
@@ -54,6 +56,7 @@ impl PipelineSpec {
             limits: None,
             side_inputs: None,
             templates: None,
+            upgrade_strategy: None,
             vertices: None,
             watermark: None,
         }
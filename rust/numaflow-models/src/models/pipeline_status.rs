@@ -43,6 +43,8 @@ pub struct PipelineStatus {
     pub source_count: Option<i64>,
     #[serde(rename = "udfCount", skip_serializing_if = "Option::is_none")]
     pub udf_count: Option<i64>,
+    #[serde(rename = "upgrade", skip_serializing_if = "Option::is_none")]
+    pub upgrade: Option<Box<crate::models::PipelineUpgradeStatus>>,
     #[serde(rename = "vertexCount", skip_serializing_if = "Option::is_none")]
     pub vertex_count: Option<i64>,
 }
@@ -61,6 +63,7 @@ impl PipelineStatus {
             sink_count: None,
             source_count: None,
             udf_count: None,
+            upgrade: None,
             vertex_count: None,
         }
     }
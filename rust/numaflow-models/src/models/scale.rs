@@ -23,6 +23,9 @@ pub struct Scale {
     /// Whether to disable autoscaling. Set to \"true\" when using Kubernetes HPA or any other 3rd party autoscaling strategies.
     #[serde(rename = "disabled", skip_serializing_if = "Option::is_none")]
     pub disabled: Option<bool>,
+    /// DryRun is used to only calculate the desired replicas and record the decision in the vertex status, the replicas of the vertex will not be changed. It's not supported by MonoVertex yet.
+    #[serde(rename = "dryRun", skip_serializing_if = "Option::is_none")]
+    pub dry_run: Option<bool>,
     /// Lookback seconds to calculate the average pending messages and processing rate.
     #[serde(rename = "lookbackSeconds", skip_serializing_if = "Option::is_none")]
     pub lookback_seconds: Option<i64>,
@@ -32,6 +35,8 @@ pub struct Scale {
     /// Minimum replicas.
     #[serde(rename = "min", skip_serializing_if = "Option::is_none")]
     pub min: Option<i32>,
+    #[serde(rename = "policy", skip_serializing_if = "Option::is_none")]
+    pub policy: Option<Box<crate::models::ScalePolicy>>,
     /// DeprecatedReplicasPerScale defines the number of maximum replicas that can be changed in a single scale up or down operation. The is use to prevent from too aggressive scaling operations Deprecated: Use ReplicasPerScaleUp and ReplicasPerScaleDown instead
     #[serde(rename = "replicasPerScale", skip_serializing_if = "Option::is_none")]
     pub replicas_per_scale: Option<i64>,
@@ -56,6 +61,9 @@ pub struct Scale {
         skip_serializing_if = "Option::is_none"
     )]
     pub scale_up_cooldown_seconds: Option<i64>,
+    /// Schedules override the min and max replicas during the scheduled time windows, for example, to keep more replicas running during the predictable traffic peaks. If multiple schedules are active at the same time, the first one in the list takes effect.
+    #[serde(rename = "schedules", skip_serializing_if = "Option::is_none")]
+    pub schedules: Option<Vec<crate::models::ScaleSchedule>>,
     /// TargetBufferAvailability is used to define the target percentage of the buffer availability. A valid and meaningful value should be less than the BufferUsageLimit defined in the Edge spec (or Pipeline spec), for example, 50. It only applies to UDF and Sink vertices because only they have buffers to read.
     #[serde(
         rename = "targetBufferAvailability",
@@ -81,14 +89,17 @@ impl Scale {
     pub fn new() -> Scale {
         Scale {
             disabled: None,
+            dry_run: None,
             lookback_seconds: None,
             max: None,
             min: None,
+            policy: None,
             replicas_per_scale: None,
             replicas_per_scale_down: None,
             replicas_per_scale_up: None,
             scale_down_cooldown_seconds: None,
             scale_up_cooldown_seconds: None,
+            schedules: None,
             target_buffer_availability: None,
             target_processing_seconds: None,
             zero_replica_sleep_seconds: None,
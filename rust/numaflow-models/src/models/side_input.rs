@@ -24,6 +24,12 @@ pub struct SideInput {
     pub container: Box<crate::models::Container>,
     #[serde(rename = "name")]
     pub name: String,
+    /// PinnedVersion pins the vertices to the given version of the side input, instead of the latest broadcast one. The version must be retained in the side inputs store.
+    #[serde(rename = "pinnedVersion", skip_serializing_if = "Option::is_none")]
+    pub pinned_version: Option<i64>,
+    /// RetainedVersions is the number of versions of the side input retained in the side inputs store, including the latest one. The retained versions can be used to roll back. Defaults to 5.
+    #[serde(rename = "retainedVersions", skip_serializing_if = "Option::is_none")]
+    pub retained_versions: Option<i32>,
     #[serde(rename = "trigger")]
     pub trigger: Box<crate::models::SideInputTrigger>,
     #[serde(rename = "volumes", skip_serializing_if = "Option::is_none")]
@@ -40,6 +46,8 @@ impl SideInput {
         SideInput {
             container: Box::new(container),
             name,
+            pinned_version: None,
+            retained_versions: None,
             trigger: Box::new(trigger),
             volumes: None,
         }
@@ -18,18 +18,24 @@ limitations under the License.
 
 #[derive(Clone, Debug, PartialEq, Serialize, Deserialize)]
 pub struct SideInputTrigger {
-    /// The schedule to trigger the retrievement of the side input data. It supports cron format, for example, \"0 30 * * * *\". Or interval based format, such as \"@hourly\", \"@every 1h30m\", etc.
-    #[serde(rename = "schedule")]
-    pub schedule: String,
+    #[serde(rename = "auth", skip_serializing_if = "Option::is_none")]
+    pub auth: Option<Box<crate::models::Authorization>>,
+    /// The schedule to trigger the retrievement of the side input data. It supports cron format, for example, \"0 30 * * * *\". Or interval based format, such as \"@hourly\", \"@every 1h30m\", etc. Either schedule or watch is required.
+    #[serde(rename = "schedule", skip_serializing_if = "Option::is_none")]
+    pub schedule: Option<String>,
     #[serde(rename = "timezone", skip_serializing_if = "Option::is_none")]
     pub timezone: Option<String>,
+    #[serde(rename = "watch", skip_serializing_if = "Option::is_none")]
+    pub watch: Option<Box<crate::models::SideInputWatch>>,
 }
 
 impl SideInputTrigger {
-    pub fn new(schedule: String) -> SideInputTrigger {
+    pub fn new() -> SideInputTrigger {
         SideInputTrigger {
-            schedule,
+            auth: None,
+            schedule: None,
             timezone: None,
+            watch: None,
         }
     }
 }
@@ -18,6 +18,8 @@ limitations under the License.
 
 #[derive(Clone, Debug, PartialEq, Serialize, Deserialize)]
 pub struct Source {
+    #[serde(rename = "dedup", skip_serializing_if = "Option::is_none")]
+    pub dedup: Option<Box<crate::models::DedupWindow>>,
     #[serde(rename = "generator", skip_serializing_if = "Option::is_none")]
     pub generator: Option<Box<crate::models::GeneratorSource>>,
     #[serde(rename = "http", skip_serializing_if = "Option::is_none")]
@@ -43,6 +45,7 @@ pub struct Source {
 impl Source {
     pub fn new() -> Source {
         Source {
+            dedup: None,
             generator: None,
             http: None,
             jetstream: None,
@@ -18,17 +18,28 @@ limitations under the License.
 
 #[derive(Clone, Debug, PartialEq, Serialize, Deserialize)]
 pub struct Udf {
+    #[serde(rename = "builtin", skip_serializing_if = "Option::is_none")]
+    pub builtin: Option<Box<crate::models::Function>>,
     #[serde(rename = "container", skip_serializing_if = "Option::is_none")]
     pub container: Option<Box<crate::models::Container>>,
+    /// DeadLetter routes the messages failed by the map UDF to a side output, once the RetryStrategy is exhausted with the \"fallback\" OnFailure strategy.
+    #[serde(rename = "deadLetter", skip_serializing_if = "Option::is_none")]
+    pub dead_letter: Option<Box<crate::models::DeadLetterOutput>>,
     #[serde(rename = "groupBy", skip_serializing_if = "Option::is_none")]
     pub group_by: Option<Box<crate::models::GroupBy>>,
+    /// RetryStrategy defines how the failures of a map UDF are handled, it is not supported by reduce vertices. Without it, a failing batch stops the forwarder and is processed again after the restart. With it, the batch is retried with the BackOff, after which the UDF is applied to each of the messages on its own, and the ones still failing are dropped or routed to the DeadLetter vertex, depending on OnFailure (\"drop\" or \"fallback\").
+    #[serde(rename = "retryStrategy", skip_serializing_if = "Option::is_none")]
+    pub retry_strategy: Option<Box<crate::models::RetryStrategy>>,
 }
 
 impl Udf {
     pub fn new() -> Udf {
         Udf {
+            builtin: None,
             container: None,
+            dead_letter: None,
             group_by: None,
+            retry_strategy: None,
         }
     }
 }
@@ -18,6 +18,12 @@ limitations under the License.
 
 #[derive(Clone, Debug, PartialEq, Serialize, Deserialize)]
 pub struct VertexStatus {
+    /// The name of the active scale schedule, if there's any.
+    #[serde(
+        rename = "activeScaleSchedule",
+        skip_serializing_if = "Option::is_none"
+    )]
+    pub active_scale_schedule: Option<String>,
     /// Conditions are the latest available observations of a resource's current state.
     #[serde(rename = "conditions", skip_serializing_if = "Option::is_none")]
     pub conditions: Option<Vec<k8s_openapi::apimachinery::pkg::apis::meta::v1::Condition>>,
@@ -27,8 +33,19 @@ pub struct VertexStatus {
     /// The number of desired replicas.
     #[serde(rename = "desiredReplicas", skip_serializing_if = "Option::is_none")]
     pub desired_replicas: Option<i64>,
+    /// The reason the predictive scale policy can not forecast the processing rate, in which case the default policy is used. Empty when the forecast is available, or the vertex doesn't use the predictive scale policy.
+    #[serde(
+        rename = "forecastUnavailableReason",
+        skip_serializing_if = "Option::is_none"
+    )]
+    pub forecast_unavailable_reason: Option<String>,
     #[serde(rename = "lastScaledAt", skip_serializing_if = "Option::is_none")]
     pub last_scaled_at: Option<k8s_openapi::apimachinery::pkg::apis::meta::v1::Time>,
+    #[serde(
+        rename = "lastScalingDecision",
+        skip_serializing_if = "Option::is_none"
+    )]
+    pub last_scaling_decision: Option<Box<crate::models::ScalingDecision>>,
     #[serde(rename = "message", skip_serializing_if = "Option::is_none")]
     pub message: Option<String>,
     /// The generation observed by the Vertex controller.
@@ -63,10 +80,13 @@ pub struct VertexStatus {
 impl VertexStatus {
     pub fn new() -> VertexStatus {
         VertexStatus {
+            active_scale_schedule: None,
             conditions: None,
             current_hash: None,
             desired_replicas: None,
+            forecast_unavailable_reason: None,
             last_scaled_at: None,
+            last_scaling_decision: None,
             message: None,
             observed_generation: None,
             phase: None,
@@ -103,9 +103,9 @@ impl FromStr for PipelineDCG {
             .iter()
             .map(|e| {
                 let conditions = e.conditions.clone().map(|c| Conditions {
-                    tags: Some(Tag {
-                        operator: c.tags.operator.map(|o| o.into()),
-                        values: c.tags.values.clone(),
+                    tags: c.tags.map(|t| Tag {
+                        operator: t.operator.map(|o| o.into()),
+                        values: t.values,
                     }),
                 });
 
@@ -19,10 +19,12 @@ package v1
 import (
 	"bufio"
 	"context"
+	"crypto/tls"
 	"encoding/json"
 	"fmt"
 	"io"
 	"math"
+	"net"
 	"net/http"
 	"reflect"
 	"slices"
@@ -101,6 +103,7 @@ type handler struct {
 	dexObj                *DexObject
 	localUsersAuthObject  *LocalUsersAuthObject
 	healthChecker         *HealthChecker
+	httpClient            *http.Client
 	opts                  *handlerOptions
 }
 
@@ -143,7 +146,13 @@ func NewHandler(ctx context.Context, dexObj *DexObject, localUsersAuthObject *Lo
 		dexObj:                dexObj,
 		localUsersAuthObject:  localUsersAuthObject,
 		healthChecker:         NewHealthChecker(ctx),
-		opts:                  o,
+		httpClient: &http.Client{
+			Transport: &http.Transport{
+				TLSClientConfig: &tls.Config{InsecureSkipVerify: true},
+			},
+			Timeout: time.Minute,
+		},
+		opts: o,
 	}, nil
 }
 
@@ -599,6 +608,163 @@ func (h *handler) PatchPipeline(c *gin.Context) {
 	c.JSON(http.StatusOK, NewNumaflowAPIResponse(nil, nil))
 }
 
+// TriggerSideInput is used to trigger the retrievement of a side input of a pipeline immediately,
+// instead of waiting for the next scheduled time.
+func (h *handler) TriggerSideInput(c *gin.Context) {
+	if h.opts.readonly {
+		errMsg := "Failed to perform this operation in read only mode"
+		c.JSON(http.StatusForbidden, NewNumaflowAPIResponse(&errMsg, nil))
+		return
+	}
+
+	ns, pipeline, sideInput := c.Param("namespace"), c.Param("pipeline"), c.Param("side-input")
+
+	pods, err := h.kubeClient.CoreV1().Pods(ns).List(c, metav1.ListOptions{
+		LabelSelector: fmt.Sprintf("%s=%s,%s=%s,%s=%s", dfv1.KeyComponent, dfv1.ComponentSideInputManager, dfv1.KeyPipelineName, pipeline, dfv1.KeySideInputName, sideInput),
+	})
+	if err != nil {
+		h.respondWithError(c, fmt.Sprintf("Failed to get the side inputs manager pods of pipeline %q side input %q, %s", pipeline, sideInput, err.Error()))
+		return
+	}
+	podIP := ""
+	for _, pod := range pods.Items {
+		if pod.DeletionTimestamp == nil && pod.Status.Phase == corev1.PodRunning && pod.Status.PodIP != "" {
+			podIP = pod.Status.PodIP
+			break
+		}
+	}
+	if podIP == "" {
+		h.respondWithError(c, fmt.Sprintf("No running side inputs manager pod found for pipeline %q side input %q", pipeline, sideInput))
+		return
+	}
+
+	url := fmt.Sprintf("https://%s/trigger", net.JoinHostPort(podIP, strconv.Itoa(dfv1.SideInputsManagerPort)))
+	req, err := http.NewRequestWithContext(c, http.MethodPost, url, nil)
+	if err != nil {
+		h.respondWithError(c, fmt.Sprintf("Failed to create the request to trigger side input %q, %s", sideInput, err.Error()))
+		return
+	}
+	// the side inputs manager requires the bearer token of the trigger, which is passed through from the caller.
+	if auth := c.GetHeader("Authorization"); auth != "" {
+		req.Header.Set("Authorization", auth)
+	}
+	resp, err := h.httpClient.Do(req)
+	if err != nil {
+		h.respondWithError(c, fmt.Sprintf("Failed to trigger side input %q of pipeline %q, %s", sideInput, pipeline, err.Error()))
+		return
+	}
+	defer func() { _ = resp.Body.Close() }()
+	if resp.StatusCode != http.StatusNoContent && resp.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(resp.Body)
+		h.respondWithError(c, fmt.Sprintf("Failed to trigger side input %q of pipeline %q, status %d: %s", sideInput, pipeline, resp.StatusCode, string(body)))
+		return
+	}
+
+	c.JSON(http.StatusOK, NewNumaflowAPIResponse(nil, nil))
+}
+
+// ListSideInputVersions is used to provide the retained versions of a side input of a pipeline, the latest first.
+func (h *handler) ListSideInputVersions(c *gin.Context) {
+	ns, pipeline, sideInput := c.Param("namespace"), c.Param("pipeline"), c.Param("side-input")
+
+	client, err := h.getPipelineDaemonClient(ns, pipeline)
+	if err != nil || client == nil {
+		h.respondWithError(c, fmt.Sprintf("failed to get daemon service client for pipeline %q, %v", pipeline, err))
+		return
+	}
+
+	versions, err := client.ListSideInputVersions(c, pipeline, sideInput)
+	if err != nil {
+		h.respondWithError(c, fmt.Sprintf("Failed to get the versions of pipeline %q side input %q: %s", pipeline, sideInput, err.Error()))
+		return
+	}
+
+	c.JSON(http.StatusOK, NewNumaflowAPIResponse(nil, versions))
+}
+
+// RollbackSideInput is used to broadcast a retained version of a side input of a pipeline to the vertices.
+func (h *handler) RollbackSideInput(c *gin.Context) {
+	if h.opts.readonly {
+		errMsg := "Failed to perform this operation in read only mode"
+		c.JSON(http.StatusForbidden, NewNumaflowAPIResponse(&errMsg, nil))
+		return
+	}
+
+	ns, pipeline, sideInput := c.Param("namespace"), c.Param("pipeline"), c.Param("side-input")
+
+	var requestBody RollbackSideInputRequest
+	if err := bindJson(c, &requestBody); err != nil {
+		h.respondWithError(c, fmt.Sprintf("Failed to decode JSON request body, %s", err.Error()))
+		return
+	}
+
+	client, err := h.getPipelineDaemonClient(ns, pipeline)
+	if err != nil || client == nil {
+		h.respondWithError(c, fmt.Sprintf("failed to get daemon service client for pipeline %q, %v", pipeline, err))
+		return
+	}
+
+	version, err := client.RollbackSideInput(c, pipeline, sideInput, requestBody.Version)
+	if err != nil {
+		h.respondWithError(c, fmt.Sprintf("Failed to roll back pipeline %q side input %q to version %d: %s", pipeline, sideInput, requestBody.Version, err.Error()))
+		return
+	}
+
+	c.JSON(http.StatusOK, NewNumaflowAPIResponse(nil, version))
+}
+
+// PeekPipelineBuffer is used to provide the messages of a buffer of a pipeline without acknowledging them.
+func (h *handler) PeekPipelineBuffer(c *gin.Context) {
+	ns, pipeline, buffer := c.Param("namespace"), c.Param("pipeline"), c.Param("buffer")
+	offset, _ := strconv.ParseInt(c.Query("offset"), 10, 64)
+	count, _ := strconv.ParseInt(c.Query("count"), 10, 64)
+
+	client, err := h.getPipelineDaemonClient(ns, pipeline)
+	if err != nil || client == nil {
+		h.respondWithError(c, fmt.Sprintf("failed to get daemon service client for pipeline %q, %v", pipeline, err))
+		return
+	}
+
+	messages, err := client.PeekBuffer(c, pipeline, buffer, offset, count)
+	if err != nil {
+		h.respondWithError(c, fmt.Sprintf("Failed to peek the messages of pipeline %q buffer %q: %s", pipeline, buffer, err.Error()))
+		return
+	}
+
+	c.JSON(http.StatusOK, NewNumaflowAPIResponse(nil, messages))
+}
+
+// ReplayPipelineBuffer is used to write the messages of a buffer of a pipeline from an offset to it again.
+func (h *handler) ReplayPipelineBuffer(c *gin.Context) {
+	if h.opts.readonly {
+		errMsg := "Failed to perform this operation in read only mode"
+		c.JSON(http.StatusForbidden, NewNumaflowAPIResponse(&errMsg, nil))
+		return
+	}
+
+	ns, pipeline, buffer := c.Param("namespace"), c.Param("pipeline"), c.Param("buffer")
+
+	var requestBody ReplayFromOffsetRequest
+	if err := bindJson(c, &requestBody); err != nil {
+		h.respondWithError(c, fmt.Sprintf("Failed to decode JSON request body, %s", err.Error()))
+		return
+	}
+
+	client, err := h.getPipelineDaemonClient(ns, pipeline)
+	if err != nil || client == nil {
+		h.respondWithError(c, fmt.Sprintf("failed to get daemon service client for pipeline %q, %v", pipeline, err))
+		return
+	}
+
+	result, err := client.ReplayFromOffset(c, pipeline, buffer, requestBody.Offset, requestBody.Count)
+	if err != nil {
+		h.respondWithError(c, fmt.Sprintf("Failed to replay the messages of pipeline %q buffer %q from offset %d: %s", pipeline, buffer, requestBody.Offset, err.Error()))
+		return
+	}
+
+	c.JSON(http.StatusOK, NewNumaflowAPIResponse(nil, result))
+}
+
 func (h *handler) CreateInterStepBufferService(c *gin.Context) {
 	if h.opts.readonly {
 		errMsg := "Failed to perform this operation in read only mode"
@@ -1090,3 +1090,46 @@ func TestHandler_IsNotSidecarContainer(t *testing.T) {
 		})
 	}
 }
+
+func TestHandler_TriggerSideInput(t *testing.T) {
+	params := []gin.Param{
+		{Key: "namespace", Value: "default"},
+		{Key: "pipeline", Value: "test-pl"},
+		{Key: "side-input", Value: "test-si"},
+	}
+
+	t.Run("read only mode", func(t *testing.T) {
+		h := &handler{opts: &handlerOptions{readonly: true}}
+		w := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(w)
+		c.Params = params
+		h.TriggerSideInput(c)
+		assert.Equal(t, http.StatusForbidden, w.Code)
+	})
+
+	t.Run("no running pod", func(t *testing.T) {
+		kubeClient := fakeClient.NewSimpleClientset(&corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "test-pl-si-test-si-abc",
+				Namespace: "default",
+				Labels: map[string]string{
+					dfv1.KeyComponent:     dfv1.ComponentSideInputManager,
+					dfv1.KeyPipelineName:  "test-pl",
+					dfv1.KeySideInputName: "test-si",
+				},
+			},
+			Status: corev1.PodStatus{Phase: corev1.PodPending},
+		})
+		h := &handler{kubeClient: kubeClient, opts: defaultHandlerOptions()}
+		w := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(w)
+		c.Request = httptest.NewRequest(http.MethodPost, "/", nil)
+		c.Params = params
+		h.TriggerSideInput(c)
+		assert.Equal(t, http.StatusOK, w.Code)
+		var resp NumaflowAPIResponse
+		assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &resp))
+		assert.NotNil(t, resp.ErrMsg)
+		assert.Contains(t, *resp.ErrMsg, "No running side inputs manager pod found")
+	})
+}
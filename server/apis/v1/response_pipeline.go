@@ -40,3 +40,17 @@ func NewPipelineInfo(status string, lag *int64, pl *v1alpha1.Pipeline) PipelineI
 		Pipeline: *pl,
 	}
 }
+
+// RollbackSideInputRequest is the request body to roll back a side input of a pipeline
+type RollbackSideInputRequest struct {
+	// Version is the retained version to roll back to.
+	Version int64 `json:"version"`
+}
+
+// ReplayFromOffsetRequest is the request body to replay the messages of a buffer of a pipeline
+type ReplayFromOffsetRequest struct {
+	// Offset is the offset to start replaying from, 0 means the first message not yet acknowledged.
+	Offset int64 `json:"offset"`
+	// Count is the maximum number of messages to replay.
+	Count int64 `json:"count"`
+}
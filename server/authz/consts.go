@@ -30,6 +30,7 @@ const (
 	ObjectMonoVertex = "mono-vertex"
 	ObjectISBSvc     = "isbsvc"
 	ObjectEvents     = "events"
+	ObjectMessages   = "messages"
 
 	// Resouces for the RBAC policy
 	ResourceAll       = "*"
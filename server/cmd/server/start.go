@@ -177,41 +177,46 @@ func UrlRewrite(r *gin.Engine) gin.HandlerFunc {
 // The value is a RouteInfo object.
 func CreateAuthRouteMap(baseHref string) authz.RouteMap {
 	return authz.RouteMap{
-		"GET:" + baseHref + "api/v1/sysinfo":                                                              authz.NewRouteInfo(authz.ObjectPipeline, false),
-		"GET:" + baseHref + "api/v1/authinfo":                                                             authz.NewRouteInfo(authz.ObjectEvents, false),
-		"GET:" + baseHref + "api/v1/namespaces":                                                           authz.NewRouteInfo(authz.ObjectEvents, false),
-		"GET:" + baseHref + "api/v1/cluster-summary":                                                      authz.NewRouteInfo(authz.ObjectPipeline, false),
-		"GET:" + baseHref + "api/v1/namespaces/:namespace/pipelines":                                      authz.NewRouteInfo(authz.ObjectPipeline, true),
-		"POST:" + baseHref + "api/v1/namespaces/:namespace/pipelines":                                     authz.NewRouteInfo(authz.ObjectPipeline, true),
-		"GET:" + baseHref + "api/v1/namespaces/:namespace/pipelines/:pipeline":                            authz.NewRouteInfo(authz.ObjectPipeline, true),
-		"GET:" + baseHref + "api/v1/namespaces/:namespace/pipelines/:pipeline/health":                     authz.NewRouteInfo(authz.ObjectPipeline, true),
-		"PUT:" + baseHref + "api/v1/namespaces/:namespace/pipelines/:pipeline":                            authz.NewRouteInfo(authz.ObjectPipeline, true),
-		"DELETE:" + baseHref + "api/v1/namespaces/:namespace/pipelines/:pipeline":                         authz.NewRouteInfo(authz.ObjectPipeline, true),
-		"PATCH:" + baseHref + "api/v1/namespaces/:namespace/pipelines/:pipeline":                          authz.NewRouteInfo(authz.ObjectPipeline, true),
-		"POST:" + baseHref + "api/v1/namespaces/:namespace/isb-services":                                  authz.NewRouteInfo(authz.ObjectISBSvc, true),
-		"GET:" + baseHref + "api/v1/namespaces/:namespace/isb-services":                                   authz.NewRouteInfo(authz.ObjectISBSvc, true),
-		"GET:" + baseHref + "api/v1/namespaces/:namespace/isb-services/:isb-service":                      authz.NewRouteInfo(authz.ObjectISBSvc, true),
-		"PUT:" + baseHref + "api/v1/namespaces/:namespace/isb-services/:isb-service":                      authz.NewRouteInfo(authz.ObjectISBSvc, true),
-		"DELETE:" + baseHref + "api/v1/namespaces/:namespace/isb-services/:isb-service":                   authz.NewRouteInfo(authz.ObjectISBSvc, true),
-		"GET:" + baseHref + "api/v1/namespaces/:namespace/pipelines/:pipeline/isbs":                       authz.NewRouteInfo(authz.ObjectPipeline, true),
-		"GET:" + baseHref + "api/v1/namespaces/:namespace/pipelines/:pipeline/watermarks":                 authz.NewRouteInfo(authz.ObjectPipeline, true),
-		"PUT:" + baseHref + "api/v1/namespaces/:namespace/pipelines/:pipeline/vertices/:vertex":           authz.NewRouteInfo(authz.ObjectPipeline, true),
-		"GET:" + baseHref + "api/v1/namespaces/:namespace/pipelines/:pipeline/vertices/metrics":           authz.NewRouteInfo(authz.ObjectPipeline, true),
-		"GET:" + baseHref + "api/v1/namespaces/:namespace/pipelines/:pipeline/vertices/:vertex/pods":      authz.NewRouteInfo(authz.ObjectPipeline, true),
-		"GET:" + baseHref + "api/v1/metrics/namespaces/:namespace/pods":                                   authz.NewRouteInfo(authz.ObjectPipeline, true),
-		"GET:" + baseHref + "api/v1/namespaces/:namespace/pods/:pod/logs":                                 authz.NewRouteInfo(authz.ObjectPipeline, true),
-		"GET:" + baseHref + "api/v1/namespaces/:namespace/mono-vertices/:mono-vertex/pods-info":           authz.NewRouteInfo(authz.ObjectMonoVertex, true),
-		"GET:" + baseHref + "api/v1/namespaces/:namespace/pipelines/:pipeline/vertices/:vertex/pods-info": authz.NewRouteInfo(authz.ObjectPipeline, true),
-		"GET:" + baseHref + "api/v1/namespaces/:namespace/events":                                         authz.NewRouteInfo(authz.ObjectEvents, true),
-		"GET:" + baseHref + "api/v1/namespaces/:namespace/mono-vertices":                                  authz.NewRouteInfo(authz.ObjectMonoVertex, true),
-		"GET:" + baseHref + "api/v1/namespaces/:namespace/mono-vertices/:mono-vertex":                     authz.NewRouteInfo(authz.ObjectMonoVertex, true),
-		"GET:" + baseHref + "api/v1/namespaces/:namespace/mono-vertices/:mono-vertex/pods":                authz.NewRouteInfo(authz.ObjectMonoVertex, true),
-		"GET:" + baseHref + "api/v1/namespaces/:namespace/mono-vertices/:mono-vertex/metrics":             authz.NewRouteInfo(authz.ObjectMonoVertex, true),
-		"POST:" + baseHref + "api/v1/namespaces/:namespace/mono-vertices":                                 authz.NewRouteInfo(authz.ObjectMonoVertex, true),
-		"GET:" + baseHref + "api/v1/namespaces/:namespace/mono-vertices/:mono-vertex/health":              authz.NewRouteInfo(authz.ObjectMonoVertex, true),
-		"GET:" + baseHref + "api/v1/namespaces/:namespace/pipelines/:pipeline/vertices/:vertex/errors":    authz.NewRouteInfo(authz.ObjectPipeline, true),
-		"GET:" + baseHref + "api/v1/namespaces/:namespace/mono-vertices/:mono-vertex/errors":              authz.NewRouteInfo(authz.ObjectMonoVertex, true),
-		"POST:" + baseHref + "api/v1/metrics-proxy":                                                       authz.NewRouteInfo(authz.ObjectAll, true),
-		"GET:" + baseHref + "api/v1/metrics-discovery/object/:object":                                     authz.NewRouteInfo(authz.ObjectAll, true),
+		"GET:" + baseHref + "api/v1/sysinfo":                                                                     authz.NewRouteInfo(authz.ObjectPipeline, false),
+		"GET:" + baseHref + "api/v1/authinfo":                                                                    authz.NewRouteInfo(authz.ObjectEvents, false),
+		"GET:" + baseHref + "api/v1/namespaces":                                                                  authz.NewRouteInfo(authz.ObjectEvents, false),
+		"GET:" + baseHref + "api/v1/cluster-summary":                                                             authz.NewRouteInfo(authz.ObjectPipeline, false),
+		"GET:" + baseHref + "api/v1/namespaces/:namespace/pipelines":                                             authz.NewRouteInfo(authz.ObjectPipeline, true),
+		"POST:" + baseHref + "api/v1/namespaces/:namespace/pipelines":                                            authz.NewRouteInfo(authz.ObjectPipeline, true),
+		"GET:" + baseHref + "api/v1/namespaces/:namespace/pipelines/:pipeline":                                   authz.NewRouteInfo(authz.ObjectPipeline, true),
+		"GET:" + baseHref + "api/v1/namespaces/:namespace/pipelines/:pipeline/health":                            authz.NewRouteInfo(authz.ObjectPipeline, true),
+		"PUT:" + baseHref + "api/v1/namespaces/:namespace/pipelines/:pipeline":                                   authz.NewRouteInfo(authz.ObjectPipeline, true),
+		"DELETE:" + baseHref + "api/v1/namespaces/:namespace/pipelines/:pipeline":                                authz.NewRouteInfo(authz.ObjectPipeline, true),
+		"PATCH:" + baseHref + "api/v1/namespaces/:namespace/pipelines/:pipeline":                                 authz.NewRouteInfo(authz.ObjectPipeline, true),
+		"POST:" + baseHref + "api/v1/namespaces/:namespace/pipelines/:pipeline/side-inputs/:side-input/trigger":  authz.NewRouteInfo(authz.ObjectPipeline, true),
+		"GET:" + baseHref + "api/v1/namespaces/:namespace/pipelines/:pipeline/side-inputs/:side-input/versions":  authz.NewRouteInfo(authz.ObjectPipeline, true),
+		"POST:" + baseHref + "api/v1/namespaces/:namespace/pipelines/:pipeline/side-inputs/:side-input/rollback": authz.NewRouteInfo(authz.ObjectPipeline, true),
+		"POST:" + baseHref + "api/v1/namespaces/:namespace/isb-services":                                         authz.NewRouteInfo(authz.ObjectISBSvc, true),
+		"GET:" + baseHref + "api/v1/namespaces/:namespace/isb-services":                                          authz.NewRouteInfo(authz.ObjectISBSvc, true),
+		"GET:" + baseHref + "api/v1/namespaces/:namespace/isb-services/:isb-service":                             authz.NewRouteInfo(authz.ObjectISBSvc, true),
+		"PUT:" + baseHref + "api/v1/namespaces/:namespace/isb-services/:isb-service":                             authz.NewRouteInfo(authz.ObjectISBSvc, true),
+		"DELETE:" + baseHref + "api/v1/namespaces/:namespace/isb-services/:isb-service":                          authz.NewRouteInfo(authz.ObjectISBSvc, true),
+		"GET:" + baseHref + "api/v1/namespaces/:namespace/pipelines/:pipeline/isbs":                              authz.NewRouteInfo(authz.ObjectPipeline, true),
+		"GET:" + baseHref + "api/v1/namespaces/:namespace/pipelines/:pipeline/isbs/:buffer/messages":             authz.NewRouteInfo(authz.ObjectMessages, true),
+		"POST:" + baseHref + "api/v1/namespaces/:namespace/pipelines/:pipeline/isbs/:buffer/replay":              authz.NewRouteInfo(authz.ObjectMessages, true),
+		"GET:" + baseHref + "api/v1/namespaces/:namespace/pipelines/:pipeline/watermarks":                        authz.NewRouteInfo(authz.ObjectPipeline, true),
+		"PUT:" + baseHref + "api/v1/namespaces/:namespace/pipelines/:pipeline/vertices/:vertex":                  authz.NewRouteInfo(authz.ObjectPipeline, true),
+		"GET:" + baseHref + "api/v1/namespaces/:namespace/pipelines/:pipeline/vertices/metrics":                  authz.NewRouteInfo(authz.ObjectPipeline, true),
+		"GET:" + baseHref + "api/v1/namespaces/:namespace/pipelines/:pipeline/vertices/:vertex/pods":             authz.NewRouteInfo(authz.ObjectPipeline, true),
+		"GET:" + baseHref + "api/v1/metrics/namespaces/:namespace/pods":                                          authz.NewRouteInfo(authz.ObjectPipeline, true),
+		"GET:" + baseHref + "api/v1/namespaces/:namespace/pods/:pod/logs":                                        authz.NewRouteInfo(authz.ObjectPipeline, true),
+		"GET:" + baseHref + "api/v1/namespaces/:namespace/mono-vertices/:mono-vertex/pods-info":                  authz.NewRouteInfo(authz.ObjectMonoVertex, true),
+		"GET:" + baseHref + "api/v1/namespaces/:namespace/pipelines/:pipeline/vertices/:vertex/pods-info":        authz.NewRouteInfo(authz.ObjectPipeline, true),
+		"GET:" + baseHref + "api/v1/namespaces/:namespace/events":                                                authz.NewRouteInfo(authz.ObjectEvents, true),
+		"GET:" + baseHref + "api/v1/namespaces/:namespace/mono-vertices":                                         authz.NewRouteInfo(authz.ObjectMonoVertex, true),
+		"GET:" + baseHref + "api/v1/namespaces/:namespace/mono-vertices/:mono-vertex":                            authz.NewRouteInfo(authz.ObjectMonoVertex, true),
+		"GET:" + baseHref + "api/v1/namespaces/:namespace/mono-vertices/:mono-vertex/pods":                       authz.NewRouteInfo(authz.ObjectMonoVertex, true),
+		"GET:" + baseHref + "api/v1/namespaces/:namespace/mono-vertices/:mono-vertex/metrics":                    authz.NewRouteInfo(authz.ObjectMonoVertex, true),
+		"POST:" + baseHref + "api/v1/namespaces/:namespace/mono-vertices":                                        authz.NewRouteInfo(authz.ObjectMonoVertex, true),
+		"GET:" + baseHref + "api/v1/namespaces/:namespace/mono-vertices/:mono-vertex/health":                     authz.NewRouteInfo(authz.ObjectMonoVertex, true),
+		"GET:" + baseHref + "api/v1/namespaces/:namespace/pipelines/:pipeline/vertices/:vertex/errors":           authz.NewRouteInfo(authz.ObjectPipeline, true),
+		"GET:" + baseHref + "api/v1/namespaces/:namespace/mono-vertices/:mono-vertex/errors":                     authz.NewRouteInfo(authz.ObjectMonoVertex, true),
+		"POST:" + baseHref + "api/v1/metrics-proxy":                                                              authz.NewRouteInfo(authz.ObjectAll, true),
+		"GET:" + baseHref + "api/v1/metrics-discovery/object/:object":                                            authz.NewRouteInfo(authz.ObjectAll, true),
 	}
 }
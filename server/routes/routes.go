@@ -137,6 +137,12 @@ func v1Routes(ctx context.Context, r gin.IRouter, dexObj *v1.DexObject, localUse
 	r.DELETE("/namespaces/:namespace/pipelines/:pipeline", handler.DeletePipeline)
 	// Patch the pipeline spec to achieve operations such as "pause" and "resume".
 	r.PATCH("/namespaces/:namespace/pipelines/:pipeline", handler.PatchPipeline)
+	// Trigger the retrievement of a side input of a pipeline immediately.
+	r.POST("/namespaces/:namespace/pipelines/:pipeline/side-inputs/:side-input/trigger", handler.TriggerSideInput)
+	// List the retained versions of a side input of a pipeline.
+	r.GET("/namespaces/:namespace/pipelines/:pipeline/side-inputs/:side-input/versions", handler.ListSideInputVersions)
+	// Roll back a side input of a pipeline to a retained version.
+	r.POST("/namespaces/:namespace/pipelines/:pipeline/side-inputs/:side-input/rollback", handler.RollbackSideInput)
 	// Create an InterStepBufferService object.
 	r.POST("/namespaces/:namespace/isb-services", handler.CreateInterStepBufferService)
 	// List all the InterStepBufferService objects for a given namespace.
@@ -149,6 +155,10 @@ func v1Routes(ctx context.Context, r gin.IRouter, dexObj *v1.DexObject, localUse
 	r.DELETE("/namespaces/:namespace/isb-services/:isb-service", handler.DeleteInterStepBufferService)
 	// Get all the Inter-Step Buffers of a pipeline.
 	r.GET("/namespaces/:namespace/pipelines/:pipeline/isbs", handler.ListPipelineBuffers)
+	// Peek the messages of an Inter-Step Buffer of a pipeline.
+	r.GET("/namespaces/:namespace/pipelines/:pipeline/isbs/:buffer/messages", handler.PeekPipelineBuffer)
+	// Replay the messages of an Inter-Step Buffer of a pipeline from an offset.
+	r.POST("/namespaces/:namespace/pipelines/:pipeline/isbs/:buffer/replay", handler.ReplayPipelineBuffer)
 	// Get all the watermarks information of a pipeline.
 	r.GET("/namespaces/:namespace/pipelines/:pipeline/watermarks", handler.GetPipelineWatermarks)
 	// Update a vertex spec.